@@ -41,6 +41,7 @@ require (
 	go.etcd.io/etcd/server/v3 v3.5.0
 	go.uber.org/atomic v1.7.0 // indirect
 	go.uber.org/zap v1.17.0
+	golang.org/x/crypto v0.0.0-20201002170205-7f63de1d35b0
 	golang.org/x/exp v0.0.0-20200224162631-6cc2880d07d6
 	google.golang.org/grpc v1.38.0
 	gopkg.in/natefinch/lumberjack.v2 v2.0.0
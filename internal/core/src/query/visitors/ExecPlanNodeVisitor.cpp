@@ -103,6 +103,7 @@ ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
         bitset_holder = std::move(expr_ret);
     }
     segment->mask_with_timestamps(bitset_holder, timestamp_);
+    segment->mask_with_delete(bitset_holder, active_count, timestamp_);
 
     if (!bitset_holder.empty()) {
         bitset_holder.flip();
@@ -14,8 +14,12 @@
 #include "AckResponder.h"
 #include "common/Schema.h"
 #include "knowhere/index/vector_index/IndexIVF.h"
+#include <algorithm>
 #include <utility>
+#include <vector>
+#include <shared_mutex>
 #include <memory>
+#include <unordered_map>
 #include "segcore/Record.h"
 
 namespace milvus::segcore {
@@ -55,6 +59,63 @@ struct DeletedRecord {
         lru_ = std::move(new_entry);
     }
 
+    // the latest timestamp before timestamp the uid is deleted at, 0 if the uid isn't deleted before timestamp
+    Timestamp
+    get_deleted_timestamp(idx_t uid, Timestamp timestamp) const {
+        update_deleted_index();
+        std::shared_lock lck(index_mutex_);
+        auto iter = deleted_index_.find(uid);
+        if (iter == deleted_index_.end()) {
+            return 0;
+        }
+        return latest_before(iter->second, timestamp);
+    }
+
+    // visit each uid deleted before timestamp with the latest timestamp it is deleted at
+    template <typename Visitor>
+    void
+    for_each_deleted(Timestamp timestamp, Visitor visitor) const {
+        update_deleted_index();
+        std::shared_lock lck(index_mutex_);
+        for (auto& [uid, del_tss] : deleted_index_) {
+            auto del_ts = latest_before(del_tss, timestamp);
+            if (del_ts != 0) {
+                visitor(uid, del_ts);
+            }
+        }
+    }
+
+    bool
+    empty() const {
+        return ack_responder_.GetAck() == 0;
+    }
+
+ private:
+    // index the acknowledged deletes which aren't indexed yet, every delete is indexed only once
+    void
+    update_deleted_index() const {
+        auto ack = ack_responder_.GetAck();
+        if (indexed_.load() >= ack) {
+            return;
+        }
+        std::lock_guard lck(index_mutex_);
+        for (auto del_index = indexed_.load(); del_index < ack; ++del_index) {
+            auto& del_tss = deleted_index_[uids_[del_index]];
+            auto del_ts = timestamps_[del_index];
+            del_tss.insert(std::upper_bound(del_tss.begin(), del_tss.end(), del_ts), del_ts);
+        }
+        indexed_.store(std::max(indexed_.load(), ack));
+    }
+
+    static Timestamp
+    latest_before(const std::vector<Timestamp>& del_tss, Timestamp timestamp) {
+        auto iter = std::lower_bound(del_tss.begin(), del_tss.end(), timestamp);
+        if (iter == del_tss.begin()) {
+            return 0;
+        }
+        return *std::prev(iter);
+    }
+
  public:
     std::atomic<int64_t> reserved = 0;
     AckResponder ack_responder_;
@@ -64,6 +125,11 @@ struct DeletedRecord {
  private:
     std::shared_ptr<TmpBitmap> lru_;
     std::shared_mutex shared_mutex_;
+
+    // the sorted delete timestamps of each uid, built incrementally from the acknowledged deletes
+    mutable std::unordered_map<idx_t, std::vector<Timestamp>> deleted_index_;
+    mutable std::atomic<int64_t> indexed_ = 0;
+    mutable std::shared_mutex index_mutex_;
 };
 
 inline auto
@@ -25,6 +25,9 @@ class ScalarIndexBase {
  public:
     virtual std::pair<std::unique_ptr<IdArray>, std::vector<SegOffset>>
     do_search_ids(const IdArray& ids) const = 0;
+    // all the offsets of id, an upserted key may be repeated
+    virtual std::vector<SegOffset>
+    do_search_offsets(int64_t id) const = 0;
     virtual ~ScalarIndexBase() = default;
     virtual std::string
     debug() const = 0;
@@ -44,6 +47,9 @@ class ScalarIndexVector : public ScalarIndexBase {
     std::pair<std::unique_ptr<IdArray>, std::vector<SegOffset>>
     do_search_ids(const IdArray& ids) const override;
 
+    std::vector<SegOffset>
+    do_search_offsets(int64_t id) const override;
+
     std::string
     debug() const override {
         std::string dbg_str;
@@ -446,10 +446,16 @@ SegmentGrowingImpl::search_ids(const IdArray& id_array, Timestamp timestamp) con
     std::vector<SegOffset> res_offsets;
     for (auto uid : src_int_arr.data()) {
         auto [iter_b, iter_e] = uid2offset_.equal_range(uid);
+        auto del_ts = deleted_record_.get_deleted_timestamp(uid, timestamp);
         SegOffset the_offset(-1);
         for (auto iter = iter_b; iter != iter_e; ++iter) {
             auto offset = SegOffset(iter->second);
-            if (record_.timestamps_[offset.get()] < timestamp) {
+            auto ins_ts = record_.timestamps_[offset.get()];
+            // rows inserted before the latest delete of the uid are deleted
+            if (ins_ts < del_ts) {
+                continue;
+            }
+            if (ins_ts < timestamp) {
                 the_offset = std::max(the_offset, offset);
             }
         }
@@ -482,4 +488,29 @@ SegmentGrowingImpl::mask_with_timestamps(boost::dynamic_bitset<>& bitset_chunk,
     // DO NOTHING
 }
 
+void
+SegmentGrowingImpl::mask_with_delete(boost::dynamic_bitset<>& bitset,
+                                     int64_t ins_barrier,
+                                     Timestamp timestamp) const {
+    if (deleted_record_.empty()) {
+        return;
+    }
+    if (bitset.empty()) {
+        bitset.resize(ins_barrier, true);
+    }
+    deleted_record_.for_each_deleted(timestamp, [&](idx_t uid, Timestamp del_ts) {
+        auto [iter_b, iter_e] = uid2offset_.equal_range(uid);
+        for (auto iter = iter_b; iter != iter_e; ++iter) {
+            auto offset = iter->second;
+            if (offset >= ins_barrier || offset >= bitset.size()) {
+                continue;
+            }
+            // a row inserted at the same timestamp as the delete is the new version of an upsert
+            if (record_.timestamps_[offset] < del_ts) {
+                bitset.reset(offset);
+            }
+        }
+    });
+}
+
 }  // namespace milvus::segcore
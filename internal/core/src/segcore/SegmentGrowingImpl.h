@@ -166,6 +166,9 @@ class SegmentGrowingImpl : public SegmentGrowing {
     void
     mask_with_timestamps(boost::dynamic_bitset<>& bitset_chunk, Timestamp timestamp) const override;
 
+    void
+    mask_with_delete(boost::dynamic_bitset<>& bitset, int64_t ins_barrier, Timestamp timestamp) const override;
+
     void
     vector_search(int64_t vec_count,
                   query::SearchInfo search_info,
@@ -112,6 +112,10 @@ class SegmentInternalInterface : public SegmentInterface {
     virtual void
     mask_with_timestamps(boost::dynamic_bitset<>& bitset_chunk, Timestamp timestamp) const = 0;
 
+    // clear the bits of the rows deleted before timestamp, within the first ins_barrier rows
+    virtual void
+    mask_with_delete(boost::dynamic_bitset<>& bitset, int64_t ins_barrier, Timestamp timestamp) const = 0;
+
     // count of chunks
     virtual int64_t
     num_chunk() const = 0;
@@ -34,6 +34,11 @@ class SegmentSealed : public SegmentInternalInterface {
     HasIndex(FieldId field_id) const = 0;
     virtual bool
     HasFieldData(FieldId field_id) const = 0;
+
+    virtual int64_t
+    PreDelete(int64_t size) = 0;
+    virtual Status
+    Delete(int64_t reserved_offset, int64_t size, const int64_t* row_ids, const Timestamp* timestamps) = 0;
 };
 
 using SegmentSealedPtr = std::unique_ptr<SegmentSealed>;
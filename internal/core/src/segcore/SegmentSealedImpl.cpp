@@ -446,7 +446,31 @@ SegmentSealedImpl::search_ids(const IdArray& id_array, Timestamp timestamp) cons
     AssertInfo(id_array.has_int_id(), "string ids are not implemented");
     auto arr = id_array.int_id();
     Assert(primary_key_index_);
-    return primary_key_index_->do_search_ids(id_array);
+    if (deleted_record_.empty()) {
+        return primary_key_index_->do_search_ids(id_array);
+    }
+
+    auto res_id_arr = std::make_unique<IdArray>();
+    auto res_int_id_arr = res_id_arr->mutable_int_id();
+    std::vector<SegOffset> res_offsets;
+    for (auto uid : arr.data()) {
+        auto del_ts = deleted_record_.get_deleted_timestamp(uid, timestamp);
+        SegOffset the_offset(-1);
+        for (auto offset : primary_key_index_->do_search_offsets(uid)) {
+            // rows inserted before the latest delete of the uid are deleted
+            if (timestamps_[offset.get()] < del_ts) {
+                continue;
+            }
+            the_offset = std::max(the_offset, offset);
+        }
+        // if not found, skip
+        if (the_offset == SegOffset(-1)) {
+            continue;
+        }
+        res_int_id_arr->add_data(uid);
+        res_offsets.push_back(the_offset);
+    }
+    return {std::move(res_id_arr), std::move(res_offsets)};
 }
 
 std::string
@@ -486,6 +510,59 @@ SegmentSealedImpl::mask_with_timestamps(boost::dynamic_bitset<>& bitset_chunk, T
     bitset_chunk &= mask;
 }
 
+void
+SegmentSealedImpl::mask_with_delete(boost::dynamic_bitset<>& bitset,
+                                    int64_t ins_barrier,
+                                    Timestamp timestamp) const {
+    if (deleted_record_.empty()) {
+        return;
+    }
+    Assert(primary_key_index_);
+    if (bitset.empty()) {
+        bitset.resize(ins_barrier, true);
+    }
+    deleted_record_.for_each_deleted(timestamp, [&](idx_t uid, Timestamp del_ts) {
+        for (auto offset : primary_key_index_->do_search_offsets(uid)) {
+            if (offset.get() >= ins_barrier || offset.get() >= bitset.size()) {
+                continue;
+            }
+            // a row inserted at the same timestamp as the delete is the new version of an upsert
+            if (timestamps_[offset.get()] < del_ts) {
+                bitset.reset(offset.get());
+            }
+        }
+    });
+}
+
+int64_t
+SegmentSealedImpl::PreDelete(int64_t size) {
+    auto reserved_begin = deleted_record_.reserved.fetch_add(size);
+    return reserved_begin;
+}
+
+Status
+SegmentSealedImpl::Delete(int64_t reserved_begin,
+                          int64_t size,
+                          const int64_t* uids_raw,
+                          const Timestamp* timestamps_raw) {
+    std::vector<std::tuple<Timestamp, idx_t>> ordering(size);
+    for (int i = 0; i < size; ++i) {
+        ordering[i] = std::make_tuple(timestamps_raw[i], uids_raw[i]);
+    }
+    std::sort(ordering.begin(), ordering.end());
+    std::vector<idx_t> uids(size);
+    std::vector<Timestamp> timestamps(size);
+    for (int index = 0; index < size; ++index) {
+        auto [t, uid] = ordering[index];
+        timestamps[index] = t;
+        uids[index] = uid;
+    }
+    deleted_record_.timestamps_.set_data(reserved_begin, timestamps.data(), size);
+    deleted_record_.uids_.set_data(reserved_begin, uids.data(), size);
+    deleted_record_.ack_responder_.AddSegment(reserved_begin, reserved_begin + size);
+    return Status::OK();
+}
+
 SegmentSealedPtr
 CreateSealedSegment(SchemaPtr schema) {
     return std::make_unique<SegmentSealedImpl>(schema);
@@ -14,6 +14,7 @@
 #include "segcore/SegmentSealed.h"
 #include "SealedIndexingRecord.h"
 #include "ScalarIndex.h"
+#include "segcore/DeletedRecord.h"
 #include <deque>
 #include <map>
 #include <vector>
@@ -40,6 +41,11 @@ class SegmentSealedImpl : public SegmentSealed {
     bool
     HasFieldData(FieldId field_id) const override;
 
+    int64_t
+    PreDelete(int64_t size) override;
+    Status
+    Delete(int64_t reserved_offset, int64_t size, const int64_t* row_ids, const Timestamp* timestamps) override;
+
  public:
     int64_t
     GetMemoryUsageInBytes() const override;
@@ -109,6 +115,9 @@ class SegmentSealedImpl : public SegmentSealed {
     void
     mask_with_timestamps(boost::dynamic_bitset<>& bitset_chunk, Timestamp timestamp) const override;
 
+    void
+    mask_with_delete(boost::dynamic_bitset<>& bitset, int64_t ins_barrier, Timestamp timestamp) const override;
+
     void
     vector_search(int64_t vec_count,
                   query::SearchInfo search_info,
@@ -150,6 +159,7 @@ class SegmentSealedImpl : public SegmentSealed {
     aligned_vector<idx_t> row_ids_;
     aligned_vector<Timestamp> timestamps_;
     TimestampIndex timestamp_index_;
+    DeletedRecord deleted_record_;
     SchemaPtr schema_;
 };
 }  // namespace milvus::segcore
@@ -151,10 +151,16 @@ Delete(CSegmentInterface c_segment,
        int64_t size,
        const int64_t* row_ids,
        const uint64_t* timestamps) {
-    auto segment = (milvus::segcore::SegmentGrowing*)c_segment;
+    auto segment_interface = reinterpret_cast<milvus::segcore::SegmentInterface*>(c_segment);
 
     try {
-        auto res = segment->Delete(reserved_offset, size, row_ids, timestamps);
+        if (auto growing = dynamic_cast<milvus::segcore::SegmentGrowing*>(segment_interface)) {
+            auto res = growing->Delete(reserved_offset, size, row_ids, timestamps);
+            return milvus::SuccessCStatus();
+        }
+        auto sealed = dynamic_cast<milvus::segcore::SegmentSealed*>(segment_interface);
+        AssertInfo(sealed != nullptr, "segment conversion failed");
+        auto res = sealed->Delete(reserved_offset, size, row_ids, timestamps);
         return milvus::SuccessCStatus();
     } catch (std::exception& e) {
         return milvus::FailureCStatus(UnexpectedError, e.what());
@@ -163,9 +169,13 @@ Delete(CSegmentInterface c_segment,
 
 int64_t
 PreDelete(CSegmentInterface c_segment, int64_t size) {
-    auto segment = (milvus::segcore::SegmentGrowing*)c_segment;
-
-    return segment->PreDelete(size);
+    auto segment_interface = reinterpret_cast<milvus::segcore::SegmentInterface*>(c_segment);
+    if (auto growing = dynamic_cast<milvus::segcore::SegmentGrowing*>(segment_interface)) {
+        return growing->PreDelete(size);
+    }
+    auto sealed = dynamic_cast<milvus::segcore::SegmentSealed*>(segment_interface);
+    AssertInfo(sealed != nullptr, "segment conversion failed");
+    return sealed->PreDelete(size);
 }
 
 //////////////////////////////    interfaces for sealed segment    //////////////////////////////
@@ -155,3 +155,95 @@ TEST(GetEntityByIds, PrimaryKey) {
         }
     }
 }
+
+TEST(GetEntityByIds, SealedDelete) {
+    auto schema = std::make_shared<Schema>();
+    auto fid_64 = schema->AddDebugField("counter_i64", DataType::INT64);
+    auto DIM = 16;
+    auto fid_vec = schema->AddDebugField("vector_64", DataType::VECTOR_FLOAT, DIM, MetricType::METRIC_L2);
+    schema->set_primary_key(FieldOffset(0));
+
+    int64_t N = 10000;
+    int64_t req_size = 10;
+    int64_t del_size = 4;
+    auto choose = [=](int i) { return i * 3 % N; };
+
+    auto dataset = DataGen(schema, N);
+    auto segment = CreateSealedSegment(schema);
+    SealedLoader(dataset, *segment);
+
+    auto i64_col = dataset.get_col<int64_t>(0);
+    std::vector<int64_t> del_ids;
+    std::vector<Timestamp> del_tss(del_size, N);
+    for (int i = 0; i < del_size; ++i) {
+        del_ids.push_back(i64_col[choose(i)]);
+    }
+    auto del_offset = segment->PreDelete(del_size);
+    segment->Delete(del_offset, del_size, del_ids.data(), del_tss.data());
+
+    auto req_ids = std::make_unique<IdArray>();
+    auto req_ids_arr = req_ids->mutable_int_id();
+    for (int i = 0; i < req_size; ++i) {
+        req_ids_arr->add_data(i64_col[choose(i)]);
+    }
+
+    std::vector<FieldOffset> target_offsets{FieldOffset(0)};
+    // deletes are invisible before their timestamp
+    auto retrieve_results = segment->GetEntityById(target_offsets, *req_ids, N);
+    ASSERT_EQ(retrieve_results->ids().int_id().data_size(), req_size);
+
+    retrieve_results = segment->GetEntityById(target_offsets, *req_ids, MAX_TIMESTAMP);
+    auto ids = retrieve_results->ids().int_id();
+    ASSERT_EQ(ids.data_size(), req_size - del_size);
+    for (int i = 0; i < req_size - del_size; ++i) {
+        ASSERT_EQ(ids.data(i), i64_col[choose(i + del_size)]);
+    }
+}
+
+TEST(GetEntityByIds, GrowingUpsert) {
+    auto schema = std::make_shared<Schema>();
+    auto fid_64 = schema->AddDebugField("counter_i64", DataType::INT64);
+    auto DIM = 16;
+    auto fid_vec = schema->AddDebugField("vector_64", DataType::VECTOR_FLOAT, DIM, MetricType::METRIC_L2);
+    schema->set_primary_key(FieldOffset(0));
+
+    int64_t N = 1000;
+    auto dataset = DataGen(schema, N);
+    auto segment = CreateGrowingSegment(schema);
+    segment->PreInsert(N);
+    ColumnBasedRawData raw_data;
+    raw_data.columns_ = dataset.cols_;
+    raw_data.count = N;
+    segment->Insert(0, N, dataset.row_ids_.data(), dataset.timestamps_.data(), raw_data);
+
+    auto i64_col = dataset.get_col<int64_t>(0);
+    // delete row 0 and row 1 at timestamp N, and upsert row 0 at the same timestamp
+    std::vector<int64_t> del_ids{i64_col[0], i64_col[1]};
+    std::vector<Timestamp> del_tss{Timestamp(N), Timestamp(N)};
+    auto del_offset = segment->PreDelete(del_ids.size());
+    segment->Delete(del_offset, del_ids.size(), del_ids.data(), del_tss.data());
+
+    ColumnBasedRawData upsert_data;
+    for (auto& col : dataset.cols_) {
+        auto sizeof_per_row = col.size() / N;
+        upsert_data.columns_.emplace_back(col.begin(), col.begin() + sizeof_per_row);
+    }
+    upsert_data.count = 1;
+    idx_t upsert_row_id = N;
+    Timestamp upsert_ts = N;
+    auto ins_offset = segment->PreInsert(1);
+    segment->Insert(ins_offset, 1, &upsert_row_id, &upsert_ts, upsert_data);
+
+    auto req_ids = std::make_unique<IdArray>();
+    auto req_ids_arr = req_ids->mutable_int_id();
+    req_ids_arr->add_data(i64_col[0]);
+    req_ids_arr->add_data(i64_col[1]);
+    req_ids_arr->add_data(i64_col[2]);
+
+    std::vector<FieldOffset> target_offsets{FieldOffset(0)};
+    auto retrieve_results = segment->GetEntityById(target_offsets, *req_ids, MAX_TIMESTAMP);
+    auto ids = retrieve_results->ids().int_id();
+    ASSERT_EQ(ids.data_size(), 2);
+    ASSERT_EQ(ids.data(0), i64_col[0]);
+    ASSERT_EQ(ids.data(1), i64_col[2]);
+}
@@ -18,10 +18,12 @@
 // #include "segment/SegmentReader.h"
 // #include "segment/SegmentWriter.h"
 #include "segcore/SegmentGrowing.h"
+#include "segcore/DeletedRecord.h"
 // #include "utils/Json.h"
 #include "test_utils/DataGen.h"
 #include <random>
 #include <optional>
+#include <map>
 using std::cin;
 using std::cout;
 using std::endl;
@@ -121,3 +123,29 @@ TEST(SegmentCoreTest, SmallIndex) {
     int N = 1024 * 1024;
     auto data = DataGen(schema, N);
 }
+
+TEST(SegmentCoreTest, DeletedRecordIndex) {
+    using namespace milvus::segcore;
+    DeletedRecord record;
+    ASSERT_TRUE(record.empty());
+
+    std::vector<idx_t> uids = {1, 2, 1};
+    std::vector<Timestamp> timestamps = {10, 20, 30};
+    record.uids_.set_data(0, uids.data(), 2);
+    record.timestamps_.set_data(0, timestamps.data(), 2);
+    record.ack_responder_.AddSegment(0, 2);
+    ASSERT_EQ(record.get_deleted_timestamp(1, 100), 10);
+    ASSERT_EQ(record.get_deleted_timestamp(1, 10), 0);
+
+    // the deletes acknowledged later are added to the index
+    record.uids_.set_data(2, uids.data() + 2, 1);
+    record.timestamps_.set_data(2, timestamps.data() + 2, 1);
+    record.ack_responder_.AddSegment(2, 3);
+    ASSERT_EQ(record.get_deleted_timestamp(1, 100), 30);
+    ASSERT_EQ(record.get_deleted_timestamp(1, 30), 10);
+    ASSERT_EQ(record.get_deleted_timestamp(3, 100), 0);
+
+    std::map<idx_t, Timestamp> deleted;
+    record.for_each_deleted(25, [&](idx_t uid, Timestamp del_ts) { deleted[uid] = del_ts; });
+    ASSERT_EQ(deleted, (std::map<idx_t, Timestamp>{{1, 10}, {2, 20}}));
+}
@@ -34,6 +34,9 @@ const nodeEventChBufferSize = 1024
 
 const eventTimeout = 5 * time.Second
 
+// channelReleaseTimeout bounds the time for a data node to sync the buffered data of a channel and release it
+const channelReleaseTimeout = 30 * time.Second
+
 type EventType int
 
 const (
@@ -41,6 +44,8 @@ const (
 	UnRegister    EventType = 2
 	WatchChannel  EventType = 3
 	FlushSegments EventType = 4
+	Drain         EventType = 5
+	MoveChannel   EventType = 6
 )
 
 type NodeEventType int
@@ -60,6 +65,12 @@ type WatchChannelParams struct {
 	CollectionID UniqueID
 }
 
+type MoveChannelParams struct {
+	Channel string
+	NodeID  UniqueID
+	errCh   chan error
+}
+
 type Cluster struct {
 	ctx              context.Context
 	cancel           context.CancelFunc
@@ -72,7 +83,11 @@ type Cluster struct {
 	registerPolicy   dataNodeRegisterPolicy
 	unregisterPolicy dataNodeUnregisterPolicy
 	assignPolicy     channelAssignPolicy
+	balancePolicy    channelBalancePolicy
 	eventCh          chan *Event
+	stoppingNodes    map[UniqueID]struct{} // nodes going to stop, no channel is assigned to them
+	movingChannels   map[string]struct{}   // channels being moved between the nodes
+	balancing        bool                  // whether the channels planned by the last balance are being moved
 }
 
 type ClusterOption func(c *Cluster)
@@ -89,6 +104,10 @@ func withAssignPolicy(p channelAssignPolicy) ClusterOption {
 	return func(c *Cluster) { c.assignPolicy = p }
 }
 
+func withBalancePolicy(p channelBalancePolicy) ClusterOption {
+	return func(c *Cluster) { c.balancePolicy = p }
+}
+
 func defaultRegisterPolicy() dataNodeRegisterPolicy {
 	return newAssignBufferRegisterPolicy()
 }
@@ -101,6 +120,10 @@ func defaultAssignPolicy() channelAssignPolicy {
 	return newBalancedAssignPolicy()
 }
 
+func defaultBalancePolicy() channelBalancePolicy {
+	return newChannelCountBalancePolicy()
+}
+
 func NewCluster(ctx context.Context, kv kv.TxnKV, store ClusterStore,
 	posProvider positionProvider, opts ...ClusterOption) (*Cluster, error) {
 	ctx, cancel := context.WithCancel(ctx)
@@ -114,7 +137,10 @@ func NewCluster(ctx context.Context, kv kv.TxnKV, store ClusterStore,
 		registerPolicy:   defaultRegisterPolicy(),
 		unregisterPolicy: defaultUnregisterPolicy(),
 		assignPolicy:     defaultAssignPolicy(),
+		balancePolicy:    defaultBalancePolicy(),
 		eventCh:          make(chan *Event, nodeEventChBufferSize),
+		stoppingNodes:    make(map[UniqueID]struct{}),
+		movingChannels:   make(map[string]struct{}),
 	}
 
 	for _, opt := range opts {
@@ -177,6 +203,14 @@ func (c *Cluster) UnRegister(node *NodeInfo) {
 	}
 }
 
+// Drain marks the node as stopping, its channels are moved to other nodes after it unregisters
+func (c *Cluster) Drain(node *NodeInfo) {
+	c.eventCh <- &Event{
+		Type: Drain,
+		Data: node,
+	}
+}
+
 func (c *Cluster) Watch(channel string, collectionID UniqueID) {
 	c.eventCh <- &Event{
 		Type: WatchChannel,
@@ -187,23 +221,60 @@ func (c *Cluster) Watch(channel string, collectionID UniqueID) {
 	}
 }
 
+// MoveChannel hands the channel over to the data node, it returns after the old data node releases the channel
+func (c *Cluster) MoveChannel(ctx context.Context, channel string, nodeID UniqueID) error {
+	errCh := make(chan error, 1)
+	event := &Event{
+		Type: MoveChannel,
+		Data: &MoveChannelParams{
+			Channel: channel,
+			NodeID:  nodeID,
+			errCh:   errCh,
+		},
+	}
+	select {
+	case c.eventCh <- event:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func (c *Cluster) handleNodeEvent() {
 	defer c.wg.Done()
+	var balanceCh <-chan time.Time
+	if Params.ChannelAutoBalance {
+		ticker := time.NewTicker(time.Duration(Params.ChannelBalanceIntervalSeconds) * time.Second)
+		defer ticker.Stop()
+		balanceCh = ticker.C
+	}
 	for {
 		select {
 		case <-c.ctx.Done():
 			return
+		case <-balanceCh:
+			c.handleBalance()
 		case e := <-c.eventCh:
 			switch e.Type {
 			case Register:
 				c.handleRegister(e.Data.(*NodeInfo))
 			case UnRegister:
 				c.handleUnRegister(e.Data.(*NodeInfo))
+			case Drain:
+				c.handleDrain(e.Data.(*NodeInfo))
 			case WatchChannel:
 				params := e.Data.(*WatchChannelParams)
 				c.handleWatchChannel(params.Channel, params.CollectionID)
 			case FlushSegments:
 				c.handleFlush(e.Data.([]*datapb.SegmentInfo))
+			case MoveChannel:
+				params := e.Data.(*MoveChannelParams)
+				c.handleMoveChannel(params.Channel, params.NodeID, params.errCh)
 			default:
 				log.Warn("Unknow node event type")
 			}
@@ -385,8 +456,9 @@ func (c *Cluster) handleUnRegister(n *NodeInfo) {
 	deleted := node.Clone(SetChannels(nil))
 	c.saveNode(deleted)
 	c.nodes.DeleteNode(n.Info.GetVersion())
+	delete(c.stoppingNodes, n.Info.GetVersion())
 
-	cNodes := c.nodes.GetNodes()
+	cNodes := c.getAvailableNodes()
 	log.Debug("channels info before unregister policy applied", zap.Any("node.Channels", node.Info.GetChannels()), zap.Any("buffer", c.chanBuffer), zap.Any("nodes", cNodes))
 	var rets []*NodeInfo
 	if len(cNodes) == 0 {
@@ -408,9 +480,31 @@ func (c *Cluster) handleUnRegister(n *NodeInfo) {
 	}
 }
 
-func (c *Cluster) handleWatchChannel(channel string, collectionID UniqueID) {
+func (c *Cluster) handleDrain(n *NodeInfo) {
 	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.nodes.GetNode(n.Info.GetVersion()) == nil {
+		return
+	}
+	log.Debug("datanode is stopping", zap.Int64("nodeID", n.Info.GetVersion()))
+	c.stoppingNodes[n.Info.GetVersion()] = struct{}{}
+}
+
+// getAvailableNodes returns the nodes which are not stopping, the caller should hold the lock
+func (c *Cluster) getAvailableNodes() []*NodeInfo {
 	cNodes := c.nodes.GetNodes()
+	ret := make([]*NodeInfo, 0, len(cNodes))
+	for _, node := range cNodes {
+		if _, ok := c.stoppingNodes[node.Info.GetVersion()]; !ok {
+			ret = append(ret, node)
+		}
+	}
+	return ret
+}
+
+func (c *Cluster) handleWatchChannel(channel string, collectionID UniqueID) {
+	c.mu.Lock()
+	cNodes := c.getAvailableNodes()
 	var rets []*NodeInfo
 	if len(cNodes) == 0 { // no nodes to assign, put into buffer
 		c.chanBuffer = append(c.chanBuffer, &datapb.ChannelStatus{
@@ -431,6 +525,156 @@ func (c *Cluster) handleWatchChannel(channel string, collectionID UniqueID) {
 	}
 }
 
+// handleBalance moves the channels planned by the balance policy one by one in the background,
+// no balance is planned until the moves of the last one finish
+func (c *Cluster) handleBalance() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.balancing || len(c.movingChannels) > 0 {
+		return
+	}
+	moves := c.balancePolicy(c.getAvailableNodes())
+	if len(moves) == 0 {
+		return
+	}
+	c.balancing = true
+	for _, move := range moves {
+		c.movingChannels[move.Channel.GetName()] = struct{}{}
+	}
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		for _, move := range moves {
+			log.Debug("balance channel",
+				zap.String("channel", move.Channel.GetName()),
+				zap.Int64("from", move.From),
+				zap.Int64("to", move.To))
+			if err := c.moveChannel(move.Channel, move.From, move.To); err != nil {
+				log.Warn("failed to balance channel", zap.String("channel", move.Channel.GetName()), zap.Error(err))
+			}
+			c.mu.Lock()
+			delete(c.movingChannels, move.Channel.GetName())
+			c.mu.Unlock()
+		}
+		c.mu.Lock()
+		c.balancing = false
+		c.mu.Unlock()
+	}()
+}
+
+// handleMoveChannel moves the channel to the data node in the background, the result is sent to errCh
+func (c *Cluster) handleMoveChannel(channel string, nodeID UniqueID, errCh chan<- error) {
+	c.mu.Lock()
+	var from *NodeInfo
+	var chStat *datapb.ChannelStatus
+	for _, node := range c.nodes.GetNodes() {
+		for _, ch := range node.Info.GetChannels() {
+			if ch.GetName() == channel {
+				from, chStat = node, ch
+			}
+		}
+	}
+	to := c.nodes.GetNode(nodeID)
+	_, stopping := c.stoppingNodes[nodeID]
+	_, moving := c.movingChannels[channel]
+
+	var err error
+	switch {
+	case from == nil:
+		err = fmt.Errorf("channel %s is not assigned to any data node", channel)
+	case to == nil || stopping:
+		err = fmt.Errorf("data node %d is not available", nodeID)
+	case from.Info.GetVersion() == nodeID:
+	case moving:
+		err = fmt.Errorf("channel %s is being moved", channel)
+	case chStat.GetState() != datapb.ChannelWatchState_Complete:
+		err = fmt.Errorf("channel %s is being watched by data node %d", channel, from.Info.GetVersion())
+	default:
+		c.movingChannels[channel] = struct{}{}
+		c.mu.Unlock()
+
+		c.wg.Add(1)
+		go func() {
+			defer c.wg.Done()
+			err := c.moveChannel(chStat, from.Info.GetVersion(), nodeID)
+			c.mu.Lock()
+			delete(c.movingChannels, channel)
+			c.mu.Unlock()
+			errCh <- err
+		}()
+		return
+	}
+	c.mu.Unlock()
+	errCh <- err
+}
+
+// moveChannel releases the channel on the data node from, and then watches it on the data node to.
+// The old data node syncs the buffered data and stops consuming before the positions of the channel
+// are collected, so the new data node consumes the channel from the checkpoints without gap or duplication
+func (c *Cluster) moveChannel(channel *datapb.ChannelStatus, from UniqueID, to UniqueID) error {
+	cli, err := c.getOrCreateClient(c.ctx, from)
+	if err != nil {
+		return err
+	}
+	tCtx, cancel := context.WithTimeout(c.ctx, channelReleaseTimeout)
+	resp, err := cli.ReleaseDmChannels(tCtx, &datapb.ReleaseDmChannelsRequest{
+		Base: &commonpb.MsgBase{
+			SourceID: Params.NodeID,
+		},
+		ChannelNames: []string{channel.GetName()},
+	})
+	cancel()
+	if err = VerifyResponse(resp, err); err != nil {
+		// the channel may be released anyway, watch it on the old node again,
+		// which is ignored by the node if it is still watching the channel
+		c.mu.Lock()
+		var rets []*NodeInfo
+		if node := c.nodes.GetNode(from); node != nil {
+			rets = append(rets, node.Clone(SetChannelState(channel.GetName(), datapb.ChannelWatchState_Uncomplete)))
+		}
+		c.txnSaveNodesAndBuffer(rets, c.chanBuffer)
+		for _, node := range rets {
+			c.nodes.SetNode(node.Info.GetVersion(), node)
+		}
+		c.mu.Unlock()
+		for _, node := range rets {
+			c.watch(node)
+		}
+		return fmt.Errorf("failed to release channel %s on data node %d: %w", channel.GetName(), from, err)
+	}
+
+	c.mu.Lock()
+	var rets []*NodeInfo
+	if node := c.nodes.GetNode(from); node != nil {
+		rets = append(rets, node.Clone(RemoveChannel(channel.GetName())))
+	}
+	if node := c.nodes.GetNode(to); node != nil {
+		rets = append(rets, node.Clone(AddChannels([]*datapb.ChannelStatus{{
+			Name:         channel.GetName(),
+			State:        datapb.ChannelWatchState_Uncomplete,
+			CollectionID: channel.GetCollectionID(),
+		}})))
+	} else {
+		// the new node is gone, keep the channel in buffer until a node registers
+		c.chanBuffer = append(c.chanBuffer, &datapb.ChannelStatus{
+			Name:         channel.GetName(),
+			State:        datapb.ChannelWatchState_Uncomplete,
+			CollectionID: channel.GetCollectionID(),
+		})
+	}
+	c.txnSaveNodesAndBuffer(rets, c.chanBuffer)
+	for _, node := range rets {
+		c.nodes.SetNode(node.Info.GetVersion(), node)
+	}
+	c.mu.Unlock()
+	for _, node := range rets {
+		c.watch(node)
+	}
+	log.Debug("channel moved", zap.String("channel", channel.GetName()), zap.Int64("from", from), zap.Int64("to", to))
+	return nil
+}
+
 func (c *Cluster) handleFlush(segments []*datapb.SegmentInfo) {
 	m := make(map[string]map[UniqueID][]UniqueID) // channel-> map[collectionID]segmentIDs
 	for _, seg := range segments {
@@ -185,3 +185,25 @@ func SetChannels(channels []*datapb.ChannelStatus) NodeOpt {
 		n.Info.Channels = channels
 	}
 }
+
+func RemoveChannel(channelName string) NodeOpt {
+	return func(n *NodeInfo) {
+		channels := make([]*datapb.ChannelStatus, 0, len(n.Info.Channels))
+		for _, ch := range n.Info.Channels {
+			if ch.GetName() != channelName {
+				channels = append(channels, ch)
+			}
+		}
+		n.Info.Channels = channels
+	}
+}
+
+func SetChannelState(channelName string, state datapb.ChannelWatchState) NodeOpt {
+	return func(n *NodeInfo) {
+		for _, ch := range n.Info.Channels {
+			if ch.GetName() == channelName {
+				ch.State = state
+			}
+		}
+	}
+}
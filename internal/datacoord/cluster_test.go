@@ -14,6 +14,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -233,6 +234,51 @@ func TestUnregister(t *testing.T) {
 	})
 }
 
+func TestDrain(t *testing.T) {
+	ch := make(chan interface{})
+	kv := memkv.NewMemoryKV()
+	spyClusterStore := &SpyClusterStore{
+		NodesInfo: NewNodesInfo(),
+		ch:        ch,
+	}
+	cluster, err := NewCluster(context.TODO(), kv, spyClusterStore, dummyPosProvider{})
+	assert.Nil(t, err)
+	defer cluster.Close()
+	nodeInfo1 := &datapb.DataNodeInfo{
+		Address:  "localhost:8080",
+		Version:  1,
+		Channels: []*datapb.ChannelStatus{},
+	}
+	nodeInfo2 := &datapb.DataNodeInfo{
+		Address:  "localhost:8081",
+		Version:  2,
+		Channels: []*datapb.ChannelStatus{},
+	}
+	node1 := NewNodeInfo(context.TODO(), nodeInfo1)
+	node2 := NewNodeInfo(context.TODO(), nodeInfo2)
+	node1.client, err = newMockDataNodeClient(1, make(chan interface{}))
+	assert.Nil(t, err)
+	node2.client, err = newMockDataNodeClient(2, make(chan interface{}))
+	assert.Nil(t, err)
+	cluster.Startup([]*NodeInfo{node1, node2})
+	<-ch
+	<-ch
+
+	// the stopping node gets no channel
+	cluster.Drain(node1)
+	for i := 0; i < 4; i++ {
+		cluster.Watch(fmt.Sprintf("ch_%d", i), 100)
+		<-ch
+	}
+	for _, node := range cluster.GetNodes() {
+		if node.Info.GetVersion() == 1 {
+			assert.EqualValues(t, 0, len(node.Info.GetChannels()))
+		} else {
+			assert.EqualValues(t, 4, len(node.Info.GetChannels()))
+		}
+	}
+}
+
 func TestWatchIfNeeded(t *testing.T) {
 	ch := make(chan interface{})
 	kv := memkv.NewMemoryKV()
@@ -269,3 +315,102 @@ func TestWatchIfNeeded(t *testing.T) {
 	cluster.Watch(chName, 0)
 	<-pch
 }
+
+func TestMoveChannel(t *testing.T) {
+	ch := make(chan interface{}, 10)
+	kv := memkv.NewMemoryKV()
+	spyClusterStore := &SpyClusterStore{
+		NodesInfo: NewNodesInfo(),
+		ch:        ch,
+	}
+	// always assign the channels to node 1
+	assignPolicy := func(cluster []*NodeInfo, channel string, collectionID UniqueID) []*NodeInfo {
+		for _, node := range cluster {
+			if node.Info.GetVersion() == 1 {
+				return []*NodeInfo{node.Clone(AddChannels([]*datapb.ChannelStatus{{
+					Name:         channel,
+					State:        datapb.ChannelWatchState_Uncomplete,
+					CollectionID: collectionID,
+				}}))}
+			}
+		}
+		return nil
+	}
+	cluster, err := NewCluster(context.TODO(), kv, spyClusterStore, dummyPosProvider{}, withAssignPolicy(assignPolicy))
+	assert.Nil(t, err)
+	defer cluster.Close()
+	node1 := NewNodeInfo(context.TODO(), &datapb.DataNodeInfo{
+		Address:  "localhost:8080",
+		Version:  1,
+		Channels: []*datapb.ChannelStatus{},
+	})
+	node2 := NewNodeInfo(context.TODO(), &datapb.DataNodeInfo{
+		Address:  "localhost:8081",
+		Version:  2,
+		Channels: []*datapb.ChannelStatus{},
+	})
+	// node 1 blocks in releasing the channel until the request is received
+	releaseCh := make(chan interface{})
+	node1.client, err = newMockDataNodeClient(1, releaseCh)
+	assert.Nil(t, err)
+	node2.client, err = newMockDataNodeClient(2, make(chan interface{}, 1))
+	assert.Nil(t, err)
+	cluster.Startup([]*NodeInfo{node1, node2})
+	<-ch
+	<-ch
+
+	cluster.Watch("ch_0", 100)
+	<-ch
+	// wait until node 1 watches the channel
+	watched := func() bool {
+		for _, node := range cluster.GetNodes() {
+			for _, chStat := range node.Info.GetChannels() {
+				if chStat.GetState() == datapb.ChannelWatchState_Complete {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	for !watched() {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	err = cluster.MoveChannel(context.TODO(), "ch_1", 2)
+	assert.NotNil(t, err)
+	err = cluster.MoveChannel(context.TODO(), "ch_0", 3)
+	assert.NotNil(t, err)
+
+	moveErrCh := make(chan error, 1)
+	go func() {
+		moveErrCh <- cluster.MoveChannel(context.TODO(), "ch_0", 2)
+	}()
+	moving := func() bool {
+		cluster.mu.Lock()
+		defer cluster.mu.Unlock()
+		_, ok := cluster.movingChannels["ch_0"]
+		return ok
+	}
+	for !moving() {
+		time.Sleep(10 * time.Millisecond)
+	}
+	// the events are still handled while the channel is being moved
+	err = cluster.MoveChannel(context.TODO(), "ch_0", 2)
+	assert.NotNil(t, err)
+	req := (<-releaseCh).(*datapb.ReleaseDmChannelsRequest)
+	assert.EqualValues(t, []string{"ch_0"}, req.GetChannelNames())
+	err = <-moveErrCh
+	assert.Nil(t, err)
+	for _, node := range cluster.GetNodes() {
+		if node.Info.GetVersion() == 1 {
+			assert.EqualValues(t, 0, len(node.Info.GetChannels()))
+		} else {
+			assert.EqualValues(t, 1, len(node.Info.GetChannels()))
+			assert.EqualValues(t, "ch_0", node.Info.GetChannels()[0].GetName())
+		}
+	}
+
+	// moving to the node watching the channel is a no-op
+	err = cluster.MoveChannel(context.TODO(), "ch_0", 2)
+	assert.Nil(t, err)
+}
@@ -270,7 +270,7 @@ func (s *Server) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPath
 
 	// set segment to SegmentState_Flushing and save binlogs and checkpoints
 	err := s.meta.UpdateFlushSegmentsInfo(req.GetSegmentID(), req.GetFlushed(),
-		req.GetField2BinlogPaths(), req.GetCheckPoints(), req.GetStartPositions())
+		req.GetField2BinlogPaths(), req.GetField2StatslogPaths(), req.GetDeltalogs(), req.GetCheckPoints(), req.GetStartPositions())
 	if err != nil {
 		log.Error("save binlog and checkpoints failed",
 			zap.Int64("segmentID", req.GetSegmentID()),
@@ -331,6 +331,9 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 	}
 	segmentIDs := s.meta.GetSegmentsOfPartition(collectionID, partitionID)
 	segment2Binlogs := make(map[UniqueID][]*datapb.FieldBinlog)
+	segment2NumOfRows := make(map[UniqueID]int64)
+	segment2Statslogs := make(map[UniqueID][]*datapb.FieldBinlog)
+	segment2Deltalogs := make(map[UniqueID][]string)
 	for _, id := range segmentIDs {
 		segment := s.meta.GetSegment(id)
 		if segment == nil {
@@ -343,6 +346,9 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 			continue
 		}
 
+		segment2NumOfRows[id] = segment.GetNumOfRows()
+		segment2Statslogs[id] = segment.GetStatslogs()
+		segment2Deltalogs[id] = segment.GetDeltalogs()
 		binlogs := segment.GetBinlogs()
 		field2Binlog := make(map[UniqueID][]string)
 		for _, field := range binlogs {
@@ -363,6 +369,9 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 		sbl := &datapb.SegmentBinlogs{
 			SegmentID:    segmentID,
 			FieldBinlogs: fieldBinlogs,
+			NumOfRows:    segment2NumOfRows[segmentID],
+			Statslogs:    segment2Statslogs[segmentID],
+			Deltalogs:    segment2Deltalogs[segmentID],
 		}
 		binlogs = append(binlogs, sbl)
 	}
@@ -432,6 +441,66 @@ func (s *Server) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedS
 	}, nil
 }
 
+// GetFlushState checks whether all the segments of the request are flushed,
+// the segments not found in meta are regarded as flushed since they are dropped with their collection
+func (s *Server) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
+	resp := &milvuspb.GetFlushStateResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if s.isClosed() {
+		resp.Status.Reason = serverNotServingErrMsg
+		return resp, nil
+	}
+
+	var unflushed []UniqueID
+	for _, id := range req.GetSegmentIDs() {
+		segment := s.meta.GetSegment(id)
+		if segment == nil {
+			continue
+		}
+		// the privilege of the caller is only checked on the collection of the request
+		if req.GetCollectionID() != 0 && segment.GetCollectionID() != req.GetCollectionID() {
+			resp.Status.Reason = fmt.Sprintf("segment %d doesn't belong to collection %d", id, req.GetCollectionID())
+			return resp, nil
+		}
+		if segment.GetState() == commonpb.SegmentState_Flushed {
+			continue
+		}
+		unflushed = append(unflushed, id)
+	}
+	if len(unflushed) > 0 {
+		log.Debug("segments are not flushed yet", zap.Int64s("segmentIDs", unflushed))
+	}
+
+	resp.Flushed = len(unflushed) == 0
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// MoveChannel hands a dm channel over to the data node of the request
+func (s *Server) MoveChannel(ctx context.Context, req *datapb.MoveChannelRequest) (*commonpb.Status, error) {
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+	if s.isClosed() {
+		resp.Reason = serverNotServingErrMsg
+		return resp, nil
+	}
+	log.Debug("receive move channel request",
+		zap.String("channel", req.GetChannelName()),
+		zap.Int64("dstNodeID", req.GetDstNodeID()))
+
+	if err := s.cluster.MoveChannel(ctx, req.GetChannelName(), req.GetDstNodeID()); err != nil {
+		log.Warn("failed to move channel", zap.String("channel", req.GetChannelName()), zap.Error(err))
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
 func (s *Server) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	log.Debug("DataCoord.GetMetrics",
 		zap.Int64("node_id", Params.NodeID),
@@ -152,7 +152,7 @@ func (m *meta) SetState(segmentID UniqueID, state commonpb.SegmentState) error {
 }
 
 func (m *meta) UpdateFlushSegmentsInfo(segmentID UniqueID, flushed bool,
-	binlogs []*datapb.FieldBinlog, checkpoints []*datapb.CheckPoint,
+	binlogs []*datapb.FieldBinlog, statslogs []*datapb.FieldBinlog, deltalogs []string, checkpoints []*datapb.CheckPoint,
 	startPositions []*datapb.SegmentStartPosition) error {
 	m.Lock()
 	defer m.Unlock()
@@ -188,6 +188,20 @@ func (m *meta) UpdateFlushSegmentsInfo(segmentID UniqueID, flushed bool,
 		}
 	}
 	m.segments.SetBinlogs(segmentID, currBinlogs)
+
+	currStatslogs := segment.Clone().SegmentInfo.GetStatslogs()
+	for _, tStatslogs := range statslogs {
+		fieldStatslogs := getFieldBinlogs(tStatslogs.GetFieldID(), currStatslogs)
+		if fieldStatslogs == nil {
+			currStatslogs = append(currStatslogs, tStatslogs)
+		} else {
+			fieldStatslogs.Binlogs = append(fieldStatslogs.Binlogs, tStatslogs.Binlogs...)
+		}
+	}
+	m.segments.SetStatslogs(segmentID, currStatslogs)
+	if len(deltalogs) > 0 {
+		m.segments.AddDeltalogs(segmentID, deltalogs)
+	}
 	modSegments[segmentID] = struct{}{}
 
 	for _, pos := range startPositions {
@@ -205,6 +219,7 @@ func (m *meta) UpdateFlushSegmentsInfo(segmentID UniqueID, flushed bool,
 		}
 		m.segments.SetDmlPosition(cp.GetSegmentID(), cp.GetPosition())
 		m.segments.SetRowCount(cp.GetSegmentID(), cp.GetNumOfRows())
+		m.segments.SetMemorySize(cp.GetSegmentID(), cp.GetMemorySize())
 		modSegments[segmentID] = struct{}{}
 	}
 
@@ -336,6 +351,12 @@ func (m *meta) SetCurrentRows(segmentID UniqueID, rows int64) {
 	m.segments.SetCurrentRows(segmentID, rows)
 }
 
+func (m *meta) SetCurrentSize(segmentID UniqueID, size int64) {
+	m.Lock()
+	defer m.Unlock()
+	m.segments.SetCurrentSize(segmentID, size)
+}
+
 func (m *meta) SetLastFlushTime(segmentID UniqueID, t time.Time) {
 	m.Lock()
 	defer m.Unlock()
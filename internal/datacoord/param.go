@@ -28,6 +28,12 @@ type ParamTable struct {
 	IP   string
 	Port int
 
+	EnableActiveStandby bool
+
+	// channel balance
+	ChannelAutoBalance            bool
+	ChannelBalanceIntervalSeconds int64
+
 	// --- ETCD ---
 	EtcdEndpoints           []string
 	MetaRootPath            string
@@ -92,6 +98,11 @@ func (p *ParamTable) Init() {
 
 		p.initFlushStreamPosSubPath()
 		p.initStatsStreamPosSubPath()
+
+		p.initEnableActiveStandby()
+
+		p.initChannelAutoBalance()
+		p.initChannelBalanceIntervalSeconds()
 	})
 }
 
@@ -252,3 +263,15 @@ func (p *ParamTable) initStatsStreamPosSubPath() {
 	}
 	p.StatsStreamPosSubPath = subPath
 }
+
+func (p *ParamTable) initEnableActiveStandby() {
+	p.EnableActiveStandby = p.ParseBool("dataCoord.enableActiveStandby", false)
+}
+
+func (p *ParamTable) initChannelAutoBalance() {
+	p.ChannelAutoBalance = p.ParseBool("dataCoord.autoBalance", true)
+}
+
+func (p *ParamTable) initChannelBalanceIntervalSeconds() {
+	p.ChannelBalanceIntervalSeconds = p.ParseInt64("dataCoord.balanceIntervalSeconds")
+}
@@ -137,3 +137,64 @@ var balancedAssignFunc channelAssignPolicy = func(cluster []*NodeInfo, channel s
 func newBalancedAssignPolicy() channelAssignPolicy {
 	return balancedAssignFunc
 }
+
+// channelMove moves a watched channel from the data node From to the data node To
+type channelMove struct {
+	Channel *datapb.ChannelStatus
+	From    UniqueID
+	To      UniqueID
+}
+
+// channelBalancePolicy, function shortcut for policy, plans the channel moves to balance the cluster
+type channelBalancePolicy func(cluster []*NodeInfo) []*channelMove
+
+// balance the amount of channels, move the watched channels from the datanode with most channels
+// to the datanode with least channels until their difference is no more than 1
+var channelCountBalanceFunc channelBalancePolicy = func(cluster []*NodeInfo) []*channelMove {
+	if len(cluster) < 2 {
+		return nil
+	}
+	channels := make([][]*datapb.ChannelStatus, len(cluster))
+	for i, node := range cluster {
+		channels[i] = append(channels[i], node.Info.GetChannels()...)
+	}
+
+	var moves []*channelMove
+	for {
+		maxIdx, minIdx := 0, 0
+		for i := range channels {
+			if len(channels[i]) > len(channels[maxIdx]) {
+				maxIdx = i
+			}
+			if len(channels[i]) < len(channels[minIdx]) {
+				minIdx = i
+			}
+		}
+		if len(channels[maxIdx])-len(channels[minIdx]) <= 1 {
+			return moves
+		}
+		// the channels being watched are not moved
+		movable := -1
+		for i, ch := range channels[maxIdx] {
+			if ch.GetState() == datapb.ChannelWatchState_Complete {
+				movable = i
+				break
+			}
+		}
+		if movable < 0 {
+			return moves
+		}
+		ch := channels[maxIdx][movable]
+		channels[maxIdx] = append(channels[maxIdx][:movable], channels[maxIdx][movable+1:]...)
+		channels[minIdx] = append(channels[minIdx], ch)
+		moves = append(moves, &channelMove{
+			Channel: ch,
+			From:    cluster[maxIdx].Info.GetVersion(),
+			To:      cluster[minIdx].Info.GetVersion(),
+		})
+	}
+}
+
+func newChannelCountBalancePolicy() channelBalancePolicy {
+	return channelCountBalanceFunc
+}
@@ -13,6 +13,7 @@ package datacoord
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -169,3 +170,54 @@ func TestBalancedAssign(t *testing.T) {
 		}
 	}
 }
+
+func TestChannelCountBalance(t *testing.T) {
+	newNode := func(version int64, channels ...string) *NodeInfo {
+		info := &datapb.DataNodeInfo{
+			Address: fmt.Sprintf("addr%d", version),
+			Version: version,
+		}
+		for _, ch := range channels {
+			info.Channels = append(info.Channels, &datapb.ChannelStatus{
+				Name:  ch,
+				State: datapb.ChannelWatchState_Complete,
+			})
+		}
+		return NewNodeInfo(context.TODO(), info)
+	}
+
+	t.Run("single node", func(t *testing.T) {
+		moves := channelCountBalanceFunc([]*NodeInfo{newNode(1, "ch1", "ch2")})
+		assert.Empty(t, moves)
+	})
+
+	t.Run("balanced", func(t *testing.T) {
+		moves := channelCountBalanceFunc([]*NodeInfo{newNode(1, "ch1", "ch2"), newNode(2, "ch3")})
+		assert.Empty(t, moves)
+	})
+
+	t.Run("new nodes joined", func(t *testing.T) {
+		cluster := []*NodeInfo{newNode(1, "ch1", "ch2", "ch3"), newNode(2, "ch4", "ch5", "ch6"), newNode(3), newNode(4)}
+		moves := channelCountBalanceFunc(cluster)
+		assert.EqualValues(t, 2, len(moves))
+		counts := map[int64]int{1: 3, 2: 3, 3: 0, 4: 0}
+		for _, move := range moves {
+			counts[move.From]--
+			counts[move.To]++
+		}
+		for _, cnt := range counts {
+			assert.True(t, cnt == 1 || cnt == 2)
+		}
+	})
+
+	t.Run("channels being watched", func(t *testing.T) {
+		node := newNode(1, "ch1", "ch2", "ch3")
+		node.Info.Channels[0].State = datapb.ChannelWatchState_Uncomplete
+		node.Info.Channels[1].State = datapb.ChannelWatchState_Uncomplete
+		moves := channelCountBalanceFunc([]*NodeInfo{node, newNode(2)})
+		assert.EqualValues(t, 1, len(moves))
+		assert.EqualValues(t, "ch3", moves[0].Channel.GetName())
+		assert.EqualValues(t, 1, moves[0].From)
+		assert.EqualValues(t, 2, moves[0].To)
+	})
+}
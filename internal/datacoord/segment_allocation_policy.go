@@ -22,9 +22,11 @@ import (
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
-type calUpperLimitPolicy func(schema *schemapb.CollectionSchema) (int, error)
+// calRowSizePolicy estimates the bytes of a row, which converts the rows requested into bytes
+type calRowSizePolicy func(schema *schemapb.CollectionSchema, segments []*SegmentInfo) (int64, error)
 
-func calBySchemaPolicy(schema *schemapb.CollectionSchema) (int, error) {
+// calBySchemaPolicy estimates the row size by the schema, which is inaccurate for the variable-length fields
+func calBySchemaPolicy(schema *schemapb.CollectionSchema) (int64, error) {
 	if schema == nil {
 		return -1, errors.New("nil schema")
 	}
@@ -36,17 +38,47 @@ func calBySchemaPolicy(schema *schemapb.CollectionSchema) (int, error) {
 	if sizePerRecord == 0 {
 		return -1, errors.New("zero size record schema found")
 	}
-	threshold := Params.SegmentMaxSize * 1024 * 1024
-	return int(threshold / float64(sizePerRecord)), nil
+	return int64(sizePerRecord), nil
+}
+
+// calByStatisticsPolicy estimates the row size by the bytes and rows written into the segments of the collection,
+// and falls back to the schema before any data is reported by the data nodes
+func calByStatisticsPolicy(schema *schemapb.CollectionSchema, segments []*SegmentInfo) (int64, error) {
+	var size, rows int64
+	for _, segment := range segments {
+		segmentSize, segmentRows := segment.getSegmentSize(), segment.currRows
+		if segmentRows < segment.GetNumOfRows() {
+			segmentRows = segment.GetNumOfRows()
+		}
+		// segments written before the size is tracked
+		if segmentSize <= 0 || segmentRows <= 0 {
+			continue
+		}
+		size += segmentSize
+		rows += segmentRows
+	}
+	if rows == 0 {
+		return calBySchemaPolicy(schema)
+	}
+	return (size + rows - 1) / rows, nil
+}
+
+// getSegmentMaxSize returns the max bytes of a segment
+func getSegmentMaxSize() int64 {
+	return int64(Params.SegmentMaxSize * 1024 * 1024)
 }
 
 type AllocatePolicy func(segments []*SegmentInfo, count int64,
-	maxCountPerSegment int64) ([]*Allocation, []*Allocation)
+	rowSize int64, maxSize int64) ([]*Allocation, []*Allocation)
 
 func AllocatePolicyV1(segments []*SegmentInfo, count int64,
-	maxCountPerSegment int64) ([]*Allocation, []*Allocation) {
+	rowSize int64, maxSize int64) ([]*Allocation, []*Allocation) {
 	newSegmentAllocations := make([]*Allocation, 0)
 	existedSegmentAllocations := make([]*Allocation, 0)
+	maxCountPerSegment := maxSize / rowSize
+	if maxCountPerSegment <= 0 {
+		maxCountPerSegment = 1
+	}
 	// create new segment if count >= max num
 	for count >= maxCountPerSegment {
 		allocation := &Allocation{
@@ -63,10 +95,10 @@ func AllocatePolicyV1(segments []*SegmentInfo, count int64,
 	for _, segment := range segments {
 		var allocSize int64
 		for _, allocation := range segment.allocations {
-			allocSize += allocation.NumOfRows
+			allocSize += allocation.NumOfRows * rowSize
 		}
-		free := segment.GetMaxRowNum() - segment.GetNumOfRows() - allocSize
-		if free < count {
+		free := maxSize - segment.getSegmentSize() - allocSize
+		if free < count*rowSize {
 			continue
 		}
 		allocation := &Allocation{
@@ -90,14 +122,11 @@ type sealPolicy func(maxCount, writtenCount, allocatedCount int64) bool
 // segmentSealPolicy seal policy applies to segment
 type segmentSealPolicy func(segment *SegmentInfo, ts Timestamp) bool
 
-// getSegmentCapacityPolicy get segmentSealPolicy with segment size factor policy
-func getSegmentCapacityPolicy(sizeFactor float64) segmentSealPolicy {
+// getSegmentCapacityPolicy get segmentSealPolicy with segment size factor policy,
+// the segment is sealed once the bytes written reach the factor of maxSize
+func getSegmentCapacityPolicy(sizeFactor float64, maxSize int64) segmentSealPolicy {
 	return func(segment *SegmentInfo, ts Timestamp) bool {
-		var allocSize int64
-		for _, allocation := range segment.allocations {
-			allocSize += allocation.NumOfRows
-		}
-		return float64(segment.currRows) >= sizeFactor*float64(segment.GetMaxRowNum())
+		return float64(segment.getSegmentSize()) >= sizeFactor*float64(maxSize)
 	}
 }
 
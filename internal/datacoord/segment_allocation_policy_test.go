@@ -12,10 +12,10 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func TestUpperLimitCalBySchema(t *testing.T) {
+func TestRowSizeCalBySchema(t *testing.T) {
 	type testCase struct {
 		schema    *schemapb.CollectionSchema
-		expected  int
+		expected  int64
 		expectErr bool
 	}
 	testCases := []testCase{
@@ -62,7 +62,7 @@ func TestUpperLimitCalBySchema(t *testing.T) {
 					},
 				},
 			},
-			expected:  int(Params.SegmentMaxSize * 1024 * 1024 / float64(524)),
+			expected:  524,
 			expectErr: false,
 		},
 	}
@@ -160,4 +160,90 @@ func TestSealSegmentPolicy(t *testing.T) {
 		shouldSeal = p(segment, tsoutil.ComposeTS(sealTs, 0))
 		assert.True(t, shouldSeal)
 	})
+
+	t.Run("test seal segment by size", func(t *testing.T) {
+		p := getSegmentCapacityPolicy(0.75, 1000)
+
+		segment := &SegmentInfo{
+			SegmentInfo: &datapb.SegmentInfo{
+				ID:        1,
+				NumOfRows: 1000000, // rows are not taken into account
+			},
+			currSize: 700,
+		}
+		assert.False(t, p(segment, 0))
+
+		segment.currSize = 750
+		assert.True(t, p(segment, 0))
+
+		// size reported is lost, the checkpointed size is used
+		segment.currSize = 0
+		segment.MemorySize = 800
+		assert.True(t, p(segment, 0))
+	})
+}
+
+func TestRowSizeCalByStatistics(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				DataType: schemapb.DataType_Int64,
+			},
+		},
+	}
+
+	// no statistics, estimated by schema
+	rowSize, err := calByStatisticsPolicy(schema, nil)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 8, rowSize)
+
+	segments := []*SegmentInfo{
+		{
+			SegmentInfo: &datapb.SegmentInfo{ID: 1},
+			currRows:    10,
+			currSize:    1000,
+		},
+		{
+			SegmentInfo: &datapb.SegmentInfo{ID: 2, NumOfRows: 10, MemorySize: 2000},
+		},
+		{
+			// written before the size is tracked
+			SegmentInfo: &datapb.SegmentInfo{ID: 3, NumOfRows: 100},
+		},
+	}
+	rowSize, err = calByStatisticsPolicy(schema, segments)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 150, rowSize)
+}
+
+func TestAllocatePolicyV1(t *testing.T) {
+	segments := []*SegmentInfo{
+		{
+			SegmentInfo: &datapb.SegmentInfo{ID: 1},
+			currSize:    900,
+		},
+		{
+			SegmentInfo: &datapb.SegmentInfo{ID: 2},
+			currSize:    500,
+			allocations: []*Allocation{{SegmentID: 2, NumOfRows: 30}},
+		},
+		{
+			SegmentInfo: &datapb.SegmentInfo{ID: 3},
+			currSize:    500,
+		},
+	}
+
+	// segment 1 and segment 2 are out of space
+	newAllocations, existedAllocations := AllocatePolicyV1(segments, 30, 10, 1000)
+	assert.Empty(t, newAllocations)
+	assert.EqualValues(t, 1, len(existedAllocations))
+	assert.EqualValues(t, 3, existedAllocations[0].SegmentID)
+	assert.EqualValues(t, 30, existedAllocations[0].NumOfRows)
+
+	newAllocations, existedAllocations = AllocatePolicyV1(segments, 260, 10, 1000)
+	assert.EqualValues(t, 3, len(newAllocations))
+	assert.EqualValues(t, 100, newAllocations[0].NumOfRows)
+	assert.EqualValues(t, 100, newAllocations[1].NumOfRows)
+	assert.EqualValues(t, 60, newAllocations[2].NumOfRows)
+	assert.Empty(t, existedAllocations)
 }
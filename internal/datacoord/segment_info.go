@@ -16,6 +16,7 @@ type SegmentsInfo struct {
 type SegmentInfo struct {
 	*datapb.SegmentInfo
 	currRows      int64
+	currSize      int64
 	allocations   []*Allocation
 	lastFlushTime time.Time
 }
@@ -104,12 +105,36 @@ func (s *SegmentsInfo) SetCurrentRows(segmentID UniqueID, rows int64) {
 	}
 }
 
+func (s *SegmentsInfo) SetCurrentSize(segmentID UniqueID, size int64) {
+	if segment, ok := s.segments[segmentID]; ok {
+		s.segments[segmentID] = segment.ShadowClone(SetCurrentSize(size))
+	}
+}
+
+func (s *SegmentsInfo) SetMemorySize(segmentID UniqueID, size int64) {
+	if segment, ok := s.segments[segmentID]; ok {
+		s.segments[segmentID] = segment.ShadowClone(SetMemorySize(size))
+	}
+}
+
 func (s *SegmentsInfo) SetBinlogs(segmentID UniqueID, binlogs []*datapb.FieldBinlog) {
 	if segment, ok := s.segments[segmentID]; ok {
 		s.segments[segmentID] = segment.Clone(SetBinlogs(binlogs))
 	}
 }
 
+func (s *SegmentsInfo) SetStatslogs(segmentID UniqueID, statslogs []*datapb.FieldBinlog) {
+	if segment, ok := s.segments[segmentID]; ok {
+		s.segments[segmentID] = segment.Clone(SetStatslogs(statslogs))
+	}
+}
+
+func (s *SegmentsInfo) AddDeltalogs(segmentID UniqueID, deltalogs []string) {
+	if segment, ok := s.segments[segmentID]; ok {
+		s.segments[segmentID] = segment.Clone(addDeltalogs(deltalogs))
+	}
+}
+
 func (s *SegmentsInfo) SetFlushTime(segmentID UniqueID, t time.Time) {
 	if segment, ok := s.segments[segmentID]; ok {
 		s.segments[segmentID] = segment.ShadowClone(SetFlushTime(t))
@@ -127,6 +152,7 @@ func (s *SegmentInfo) Clone(opts ...SegmentInfoOption) *SegmentInfo {
 	cloned := &SegmentInfo{
 		SegmentInfo:   info,
 		currRows:      s.currRows,
+		currSize:      s.currSize,
 		allocations:   s.allocations,
 		lastFlushTime: s.lastFlushTime,
 	}
@@ -140,6 +166,7 @@ func (s *SegmentInfo) ShadowClone(opts ...SegmentInfoOption) *SegmentInfo {
 	cloned := &SegmentInfo{
 		SegmentInfo:   s.SegmentInfo,
 		currRows:      s.currRows,
+		currSize:      s.currSize,
 		allocations:   s.allocations,
 		lastFlushTime: s.lastFlushTime,
 	}
@@ -150,6 +177,15 @@ func (s *SegmentInfo) ShadowClone(opts ...SegmentInfoOption) *SegmentInfo {
 	return cloned
 }
 
+// getSegmentSize returns the bytes written into the segment, the size reported by the data node
+// is lost when data coord restarts, the checkpointed size is used until the next report
+func (s *SegmentInfo) getSegmentSize() int64 {
+	if s.currSize > s.GetMemorySize() {
+		return s.currSize
+	}
+	return s.GetMemorySize()
+}
+
 type SegmentInfoOption func(segment *SegmentInfo)
 
 func SetRowCount(rowCount int64) SegmentInfoOption {
@@ -201,12 +237,36 @@ func SetCurrentRows(rows int64) SegmentInfoOption {
 	}
 }
 
+func SetCurrentSize(size int64) SegmentInfoOption {
+	return func(segment *SegmentInfo) {
+		segment.currSize = size
+	}
+}
+
+func SetMemorySize(size int64) SegmentInfoOption {
+	return func(segment *SegmentInfo) {
+		segment.MemorySize = size
+	}
+}
+
 func SetBinlogs(binlogs []*datapb.FieldBinlog) SegmentInfoOption {
 	return func(segment *SegmentInfo) {
 		segment.Binlogs = binlogs
 	}
 }
 
+func SetStatslogs(statslogs []*datapb.FieldBinlog) SegmentInfoOption {
+	return func(segment *SegmentInfo) {
+		segment.Statslogs = statslogs
+	}
+}
+
+func addDeltalogs(deltalogs []string) SegmentInfoOption {
+	return func(segment *SegmentInfo) {
+		segment.Deltalogs = append(segment.Deltalogs, deltalogs...)
+	}
+}
+
 func SetFlushTime(t time.Time) SegmentInfoOption {
 	return func(segment *SegmentInfo) {
 		segment.lastFlushTime = t
@@ -59,7 +59,7 @@ type SegmentManager struct {
 	allocator           allocator
 	helper              allocHelper
 	segments            []UniqueID
-	estimatePolicy      calUpperLimitPolicy
+	estimatePolicy      calRowSizePolicy
 	allocPolicy         AllocatePolicy
 	segmentSealPolicies []segmentSealPolicy
 	channelSealPolicies []channelSealPolicy
@@ -97,7 +97,7 @@ func defaultAllocHelper() allocHelper {
 }
 
 // get allocOption with estimatePolicy
-func withCalUpperLimitPolicy(policy calUpperLimitPolicy) allocOption {
+func withCalRowSizePolicy(policy calRowSizePolicy) allocOption {
 	return allocFunc(func(manager *SegmentManager) { manager.estimatePolicy = policy })
 }
 
@@ -127,8 +127,8 @@ func withFlushPolicy(policy flushPolicy) allocOption {
 	return allocFunc(func(manager *SegmentManager) { manager.flushPolicy = policy })
 }
 
-func defaultCalUpperLimitPolicy() calUpperLimitPolicy {
-	return calBySchemaPolicy
+func defaultCalRowSizePolicy() calRowSizePolicy {
+	return calByStatisticsPolicy
 }
 
 func defaultAlocatePolicy() AllocatePolicy {
@@ -138,7 +138,7 @@ func defaultAlocatePolicy() AllocatePolicy {
 func defaultSegmentSealPolicy() []segmentSealPolicy {
 	return []segmentSealPolicy{
 		sealByLifetimePolicy(segmentMaxLifetime),
-		getSegmentCapacityPolicy(Params.SegmentSealProportion),
+		getSegmentCapacityPolicy(Params.SegmentSealProportion, getSegmentMaxSize()),
 	}
 }
 
@@ -153,7 +153,7 @@ func newSegmentManager(meta *meta, allocator allocator, opts ...allocOption) *Se
 		allocator:           allocator,
 		helper:              defaultAllocHelper(),
 		segments:            make([]UniqueID, 0),
-		estimatePolicy:      defaultCalUpperLimitPolicy(),
+		estimatePolicy:      defaultCalRowSizePolicy(),
 		allocPolicy:         defaultAlocatePolicy(),
 		segmentSealPolicies: defaultSegmentSealPolicy(), // default only segment size policy
 		channelSealPolicies: []channelSealPolicy{},      // no default channel seal policy
@@ -212,13 +212,18 @@ func (s *SegmentManager) AllocSegment(ctx context.Context, collectionID UniqueID
 
 	// filter segments
 	segments := make([]*SegmentInfo, 0)
+	collSegments := make([]*SegmentInfo, 0)
 	for _, segmentID := range s.segments {
 		segment := s.meta.GetSegment(segmentID)
 		if segment == nil {
 			log.Warn("Failed to get seginfo from meta", zap.Int64("id", segmentID))
 			continue
 		}
-		if segment.State == commonpb.SegmentState_Sealed || segment.CollectionID != collectionID ||
+		if segment.CollectionID != collectionID {
+			continue
+		}
+		collSegments = append(collSegments, segment)
+		if segment.State == commonpb.SegmentState_Sealed ||
 			segment.PartitionID != partitionID || segment.InsertChannel != channelName {
 			continue
 		}
@@ -226,12 +231,13 @@ func (s *SegmentManager) AllocSegment(ctx context.Context, collectionID UniqueID
 	}
 
 	// apply allocate policy
-	maxCountPerSegment, err := s.estimateMaxNumOfRows(collectionID)
+	rowSize, err := s.estimateRowSize(collectionID, collSegments)
 	if err != nil {
 		return nil, err
 	}
+	maxSize := getSegmentMaxSize()
 	newSegmentAllocations, existedSegmentAllocations := s.allocPolicy(segments,
-		requestRows, int64(maxCountPerSegment))
+		requestRows, rowSize, maxSize)
 
 	// create new segments and add allocations
 	expireTs, err := s.genExpireTs(ctx)
@@ -239,7 +245,7 @@ func (s *SegmentManager) AllocSegment(ctx context.Context, collectionID UniqueID
 		return nil, err
 	}
 	for _, allocation := range newSegmentAllocations {
-		segment, err := s.openNewSegment(ctx, collectionID, partitionID, channelName)
+		segment, err := s.openNewSegment(ctx, collectionID, partitionID, channelName, maxSize/rowSize)
 		if err != nil {
 			return nil, err
 		}
@@ -272,17 +278,15 @@ func (s *SegmentManager) genExpireTs(ctx context.Context) (Timestamp, error) {
 	return expireTs, nil
 }
 
-func (s *SegmentManager) openNewSegment(ctx context.Context, collectionID UniqueID, partitionID UniqueID, channelName string) (*SegmentInfo, error) {
+// openNewSegment creates a growing segment, maxNumOfRows is only an estimation since the segment is sealed by its size
+func (s *SegmentManager) openNewSegment(ctx context.Context, collectionID UniqueID, partitionID UniqueID, channelName string,
+	maxNumOfRows int64) (*SegmentInfo, error) {
 	sp, _ := trace.StartSpanFromContext(ctx)
 	defer sp.Finish()
 	id, err := s.allocator.allocID(ctx)
 	if err != nil {
 		return nil, err
 	}
-	maxNumOfRows, err := s.estimateMaxNumOfRows(collectionID)
-	if err != nil {
-		return nil, err
-	}
 
 	segmentInfo := &datapb.SegmentInfo{
 		ID:             id,
@@ -291,7 +295,7 @@ func (s *SegmentManager) openNewSegment(ctx context.Context, collectionID Unique
 		InsertChannel:  channelName,
 		NumOfRows:      0,
 		State:          commonpb.SegmentState_Growing,
-		MaxRowNum:      int64(maxNumOfRows),
+		MaxRowNum:      maxNumOfRows,
 		LastExpireTime: 0,
 		StartPosition: &internalpb.MsgPosition{
 			ChannelName: channelName,
@@ -308,19 +312,19 @@ func (s *SegmentManager) openNewSegment(ctx context.Context, collectionID Unique
 	log.Debug("datacoord: estimateTotalRows: ",
 		zap.Int64("CollectionID", segmentInfo.CollectionID),
 		zap.Int64("SegmentID", segmentInfo.ID),
-		zap.Int("Rows", maxNumOfRows),
+		zap.Int64("Rows", maxNumOfRows),
 		zap.String("Channel", segmentInfo.InsertChannel))
 
 	s.helper.afterCreateSegment(segmentInfo)
 	return segment, nil
 }
 
-func (s *SegmentManager) estimateMaxNumOfRows(collectionID UniqueID) (int, error) {
+func (s *SegmentManager) estimateRowSize(collectionID UniqueID, segments []*SegmentInfo) (int64, error) {
 	collMeta := s.meta.GetCollection(collectionID)
 	if collMeta == nil {
 		return -1, fmt.Errorf("Failed to get collection %d", collectionID)
 	}
-	return s.estimatePolicy(collMeta.Schema)
+	return s.estimatePolicy(collMeta.Schema, segments)
 }
 
 func (s *SegmentManager) DropSegment(ctx context.Context, segmentID UniqueID) {
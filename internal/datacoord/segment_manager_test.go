@@ -151,10 +151,11 @@ func TestAllocRowsLargerThanOneSegment(t *testing.T) {
 	assert.Nil(t, err)
 	meta.AddCollection(&datapb.CollectionInfo{ID: collID, Schema: schema})
 
-	var mockPolicy = func(schema *schemapb.CollectionSchema) (int, error) {
-		return 1, nil
+	// a row fills a segment
+	var mockPolicy = func(schema *schemapb.CollectionSchema, segments []*SegmentInfo) (int64, error) {
+		return getSegmentMaxSize(), nil
 	}
-	segmentManager := newSegmentManager(meta, mockAllocator, withCalUpperLimitPolicy(mockPolicy))
+	segmentManager := newSegmentManager(meta, mockAllocator, withCalRowSizePolicy(mockPolicy))
 	allocations, err := segmentManager.AllocSegment(context.TODO(), collID, 0, "c1", 2)
 	assert.Nil(t, err)
 	assert.EqualValues(t, 2, len(allocations))
@@ -173,10 +174,10 @@ func TestExpireAllocation(t *testing.T) {
 	assert.Nil(t, err)
 	meta.AddCollection(&datapb.CollectionInfo{ID: collID, Schema: schema})
 
-	var mockPolicy = func(schema *schemapb.CollectionSchema) (int, error) {
-		return 10000000, nil
+	var mockPolicy = func(schema *schemapb.CollectionSchema, segments []*SegmentInfo) (int64, error) {
+		return 1, nil
 	}
-	segmentManager := newSegmentManager(meta, mockAllocator, withCalUpperLimitPolicy(mockPolicy))
+	segmentManager := newSegmentManager(meta, mockAllocator, withCalRowSizePolicy(mockPolicy))
 	// alloc 100 times and expire
 	var maxts Timestamp
 	var id int64 = -1
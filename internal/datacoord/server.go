@@ -154,9 +154,10 @@ func defaultRootCoordCreatorFunc(ctx context.Context, metaRootPath string, etcdE
 	return rootcoordclient.NewClient(ctx, metaRootPath, etcdEndpoints)
 }
 
-// Register register data service at etcd
+// Register register data service at etcd, a standby data service blocks here until the active one quits
 func (s *Server) Register() error {
 	s.session = sessionutil.NewSession(s.ctx, Params.MetaRootPath, Params.EtcdEndpoints)
+	s.session.SetEnableActiveStandBy(Params.EnableActiveStandby)
 	s.activeCh = s.session.Init(typeutil.DataCoordRole, Params.IP, true)
 	Params.NodeID = s.session.ServerID
 	return nil
@@ -230,6 +231,7 @@ func (s *Server) initServiceDiscovery() error {
 	log.Debug("registered sessions", zap.Any("sessions", sessions))
 
 	datanodes := make([]*NodeInfo, 0, len(sessions))
+	var stoppings []*NodeInfo
 	for _, session := range sessions {
 		info := &datapb.DataNodeInfo{
 			Address:  session.Address,
@@ -238,9 +240,15 @@ func (s *Server) initServiceDiscovery() error {
 		}
 		nodeInfo := NewNodeInfo(s.ctx, info)
 		datanodes = append(datanodes, nodeInfo)
+		if session.Stopping {
+			stoppings = append(stoppings, nodeInfo)
+		}
 	}
 
 	s.cluster.Startup(datanodes)
+	for _, nodeInfo := range stoppings {
+		s.cluster.Drain(nodeInfo)
+	}
 
 	s.eventCh = s.session.WatchServices(typeutil.DataNodeRole, rev+1)
 	return nil
@@ -329,6 +337,7 @@ func (s *Server) startStatsChannel(ctx context.Context) {
 			ssMsg := msg.(*msgstream.SegmentStatisticsMsg)
 			for _, stat := range ssMsg.SegStats {
 				s.meta.SetCurrentRows(stat.GetSegmentID(), stat.GetNumRows())
+				s.meta.SetCurrentSize(stat.GetSegmentID(), stat.GetMemorySize())
 			}
 		}
 	}
@@ -436,6 +445,13 @@ func (s *Server) startWatchService(ctx context.Context) {
 					zap.String("address", info.Address),
 					zap.Int64("serverID", info.Version))
 				s.cluster.UnRegister(node)
+			case sessionutil.SessionUpdateEvent:
+				if event.Session.Stopping {
+					log.Info("received datanode stopping",
+						zap.String("address", info.Address),
+						zap.Int64("serverID", info.Version))
+					s.cluster.Drain(node)
+				}
 			default:
 				log.Warn("receive unknown service event type",
 					zap.Any("type", event.EventType))
@@ -584,9 +600,8 @@ func (s *Server) loadCollectionFromRootCoord(ctx context.Context, collectionID i
 			Timestamp: 0,
 			SourceID:  Params.NodeID,
 		},
-		DbName:         "",
-		CollectionName: resp.Schema.Name,
-		CollectionID:   resp.CollectionID,
+		DbName:       "",
+		CollectionID: resp.CollectionID,
 	})
 	if err = VerifyResponse(presp, err); err != nil {
 		log.Error("show partitions error", zap.String("collectionName", resp.Schema.Name),
@@ -287,6 +287,65 @@ func TestGetSegmentInfo(t *testing.T) {
 	assert.EqualValues(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 }
 
+func TestGetFlushState(t *testing.T) {
+	svr := newTestServer(t, nil)
+	defer closeTestServer(t, svr)
+
+	err := svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+		ID:           1,
+		CollectionID: 100,
+		State:        commonpb.SegmentState_Flushed,
+	}))
+	assert.Nil(t, err)
+	err = svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+		ID:           2,
+		CollectionID: 100,
+		State:        commonpb.SegmentState_Flushing,
+	}))
+	assert.Nil(t, err)
+	err = svr.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{
+		ID:           4,
+		CollectionID: 101,
+		State:        commonpb.SegmentState_Flushed,
+	}))
+	assert.Nil(t, err)
+
+	t.Run("all flushed", func(t *testing.T) {
+		resp, err := svr.GetFlushState(svr.ctx, &milvuspb.GetFlushStateRequest{SegmentIDs: []int64{1, 3}})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.True(t, resp.Flushed)
+	})
+
+	t.Run("not flushed", func(t *testing.T) {
+		resp, err := svr.GetFlushState(svr.ctx, &milvuspb.GetFlushStateRequest{SegmentIDs: []int64{1, 2}})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.False(t, resp.Flushed)
+	})
+
+	t.Run("segment of another collection", func(t *testing.T) {
+		resp, err := svr.GetFlushState(svr.ctx, &milvuspb.GetFlushStateRequest{SegmentIDs: []int64{1, 3}, CollectionID: 100})
+		assert.Nil(t, err)
+		assert.EqualValues(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.True(t, resp.Flushed)
+
+		resp, err = svr.GetFlushState(svr.ctx, &milvuspb.GetFlushStateRequest{SegmentIDs: []int64{1, 4}, CollectionID: 100})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.False(t, resp.Flushed)
+	})
+
+	t.Run("server is closed", func(t *testing.T) {
+		stateSave := atomic.LoadInt64(&svr.isServing)
+		atomic.StoreInt64(&svr.isServing, ServerStateInitializing)
+		defer atomic.StoreInt64(&svr.isServing, stateSave)
+		resp, err := svr.GetFlushState(svr.ctx, &milvuspb.GetFlushStateRequest{SegmentIDs: []int64{1}})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+}
+
 func TestServer_GetMetrics(t *testing.T) {
 	svr := newTestServer(t, nil)
 	defer closeTestServer(t, svr)
@@ -488,6 +547,7 @@ func TestSaveBinlogPaths(t *testing.T) {
 					},
 				},
 			},
+			Deltalogs: []string{"/by-dev/delta/0/1/2/Allo3"},
 			CheckPoints: []*datapb.CheckPoint{
 				{
 					SegmentID: 0,
@@ -515,6 +575,7 @@ func TestSaveBinlogPaths(t *testing.T) {
 		assert.EqualValues(t, 1, fieldBinlogs.GetFieldID())
 		assert.EqualValues(t, "/by-dev/test/0/1/2/1/Allo1", fieldBinlogs.GetBinlogs()[0])
 		assert.EqualValues(t, "/by-dev/test/0/1/2/1/Allo2", fieldBinlogs.GetBinlogs()[1])
+		assert.EqualValues(t, []string{"/by-dev/delta/0/1/2/Allo3"}, segment.GetDeltalogs())
 
 		segmentInfo := svr.meta.GetSegment(0)
 		assert.NotNil(t, segmentInfo)
@@ -935,6 +996,14 @@ func TestGetRecoveryInfo(t *testing.T) {
 					},
 				},
 			},
+			Field2StatslogPaths: []*datapb.FieldBinlog{
+				{
+					FieldID: 1,
+					Binlogs: []string{
+						"/stats_log/file1",
+					},
+				},
+			},
 		}
 		segment := createSegment(0, 0, 0, 100, 10, "ch1", commonpb.SegmentState_Flushed)
 		err := svr.meta.AddSegment(NewSegmentInfo(segment))
@@ -955,6 +1024,8 @@ func TestGetRecoveryInfo(t *testing.T) {
 		assert.EqualValues(t, 1, len(resp.GetBinlogs()[0].GetFieldBinlogs()))
 		assert.EqualValues(t, 1, resp.GetBinlogs()[0].GetFieldBinlogs()[0].GetFieldID())
 		assert.ElementsMatch(t, []string{"/binlog/file1", "/binlog/file2"}, resp.GetBinlogs()[0].GetFieldBinlogs()[0].GetBinlogs())
+		assert.EqualValues(t, 1, len(resp.GetBinlogs()[0].GetStatslogs()))
+		assert.ElementsMatch(t, []string{"/stats_log/file1"}, resp.GetBinlogs()[0].GetStatslogs()[0].GetBinlogs())
 	})
 }
 
@@ -0,0 +1,150 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datanode
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+)
+
+// bufferManager accounts the bytes buffered by the insert buffer nodes of all the flow graphs on a data node,
+// so that the data node keeps the buffered data within its memory budget. Once the data node buffers more than
+// its budget, the largest segments of the data node are picked to sync, each by the flow graph owning it.
+//
+// `limit` is the memory budget in bytes, the budget is unlimited if it's not positive.
+// `buffers` is a map of vchannel name to the bytes buffered for each segment by the flow graph of the vchannel.
+// `toSync` is a map of vchannel name to the segments picked to sync, which the flow graph takes at its next update.
+type bufferManager struct {
+	mu      sync.Mutex
+	limit   int64
+	buffers map[string]map[UniqueID]int64
+	toSync  map[string]map[UniqueID]bool
+}
+
+func newBufferManager(limit int64) *bufferManager {
+	return &bufferManager{
+		limit:   limit,
+		buffers: make(map[string]map[UniqueID]int64),
+		toSync:  make(map[string]map[UniqueID]bool),
+	}
+}
+
+// update records the bytes buffered for the segments by the flow graph of the vchannel, picks the largest
+// segments of the data node to sync if it buffers more than the budget, and returns the picked segments
+// the flow graph of the vchannel owns.
+func (bm *bufferManager) update(channelName string, segmentSizes map[UniqueID]int64) []UniqueID {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+
+	bm.recordPrivate(channelName, segmentSizes)
+	bm.pickPrivate()
+
+	segIDs := make([]UniqueID, 0, len(bm.toSync[channelName]))
+	for segID := range bm.toSync[channelName] {
+		// the segment may be synced or flushed since it was picked
+		if _, ok := segmentSizes[segID]; ok {
+			segIDs = append(segIDs, segID)
+		}
+	}
+	delete(bm.toSync, channelName)
+	sort.Slice(segIDs, func(i, j int) bool {
+		return segmentSizes[segIDs[i]] > segmentSizes[segIDs[j]]
+	})
+	return segIDs
+}
+
+// record records the bytes buffered for the segments by the flow graph of the vchannel without picking segments.
+func (bm *bufferManager) record(channelName string, segmentSizes map[UniqueID]int64) {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+	bm.recordPrivate(channelName, segmentSizes)
+}
+
+func (bm *bufferManager) recordPrivate(channelName string, segmentSizes map[UniqueID]int64) {
+	bm.buffers[channelName] = segmentSizes
+	var size int64 = 0
+	for _, s := range segmentSizes {
+		size += s
+	}
+	metrics.DataNodeInsertBufferBytes.WithLabelValues(channelName).Set(float64(size))
+}
+
+// pickPrivate picks the largest segments of the data node not picked yet,
+// until the bytes of the picked segments cover the bytes over the budget.
+func (bm *bufferManager) pickPrivate() {
+	if bm.limit <= 0 {
+		return
+	}
+	excess := bm.totalSizePrivate() - bm.limit
+	type segment struct {
+		channelName string
+		segID       UniqueID
+		size        int64
+	}
+	candidates := make([]segment, 0)
+	for channelName, segmentSizes := range bm.buffers {
+		for segID, size := range segmentSizes {
+			if bm.toSync[channelName][segID] {
+				// the picked segments are going to be synced
+				excess -= size
+				continue
+			}
+			candidates = append(candidates, segment{channelName: channelName, segID: segID, size: size})
+		}
+	}
+	if excess <= 0 {
+		return
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].size > candidates[j].size
+	})
+	for _, c := range candidates {
+		if excess <= 0 {
+			return
+		}
+		if _, ok := bm.toSync[c.channelName]; !ok {
+			bm.toSync[c.channelName] = make(map[UniqueID]bool)
+		}
+		bm.toSync[c.channelName][c.segID] = true
+		excess -= c.size
+	}
+}
+
+// remove drops the buffer record of the vchannel once its flow graph is released.
+func (bm *bufferManager) remove(channelName string) {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+
+	delete(bm.buffers, channelName)
+	delete(bm.toSync, channelName)
+	metrics.DataNodeInsertBufferBytes.DeleteLabelValues(channelName)
+}
+
+// totalSize returns the bytes buffered by all the flow graphs of the data node.
+func (bm *bufferManager) totalSize() int64 {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+	return bm.totalSizePrivate()
+}
+
+func (bm *bufferManager) totalSizePrivate() int64 {
+	var size int64 = 0
+	for _, segmentSizes := range bm.buffers {
+		for _, s := range segmentSizes {
+			size += s
+		}
+	}
+	return size
+}
@@ -0,0 +1,51 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package datanode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBufferManager(t *testing.T) {
+	bm := newBufferManager(100)
+
+	assert.Equal(t, 0, len(bm.update("ch-1", map[UniqueID]int64{1: 20, 2: 20})))
+	assert.Equal(t, 0, len(bm.update("ch-2", map[UniqueID]int64{3: 60})))
+	assert.Equal(t, int64(100), bm.totalSize())
+
+	// the largest segment of the data node is picked, though it belongs to another flow graph
+	assert.Equal(t, 0, len(bm.update("ch-1", map[UniqueID]int64{1: 50, 2: 20})))
+	assert.Equal(t, int64(130), bm.totalSize())
+	// the picked segment covers the excess, no more segment is picked
+	assert.Equal(t, 0, len(bm.update("ch-1", map[UniqueID]int64{1: 50, 2: 20})))
+	assert.Equal(t, []UniqueID{3}, bm.update("ch-2", map[UniqueID]int64{3: 60}))
+	bm.record("ch-2", map[UniqueID]int64{})
+	assert.Equal(t, int64(70), bm.totalSize())
+
+	// the segments are picked until the excess is covered
+	assert.Equal(t, 0, len(bm.update("ch-2", map[UniqueID]int64{4: 10, 5: 30})))
+	assert.Equal(t, []UniqueID{1}, bm.update("ch-1", map[UniqueID]int64{1: 50, 2: 20}))
+
+	// the picked segments gone from the buffer aren't synced
+	bm.record("ch-1", map[UniqueID]int64{2: 20, 6: 90})
+	assert.Equal(t, 0, len(bm.update("ch-2", map[UniqueID]int64{4: 10, 5: 30})))
+	assert.Equal(t, 0, len(bm.update("ch-1", map[UniqueID]int64{2: 20})))
+	assert.Equal(t, 0, len(bm.toSync))
+
+	bm.remove("ch-1")
+	assert.Equal(t, int64(40), bm.totalSize())
+
+	unlimited := newBufferManager(0)
+	assert.Equal(t, 0, len(unlimited.update("ch-1", map[UniqueID]int64{1: 1 << 40})))
+}
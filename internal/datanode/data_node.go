@@ -31,6 +31,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/logutil"
 	"github.com/milvus-io/milvus/internal/metrics"
@@ -88,6 +89,7 @@ type DataNode struct {
 	vchan2FlushCh     map[string]chan<- *flushMsg // vchannel name
 	clearSignal       chan UniqueID               // collection ID
 	segmentCache      *Cache
+	bufferManager     *bufferManager
 
 	rootCoord types.RootCoord
 	dataCoord types.DataCoord
@@ -160,13 +162,15 @@ func (node *DataNode) Register() error {
 	return nil
 }
 
-// Init function do nothing now.
+// Init creates the insert buffer manager of the data node.
 func (node *DataNode) Init() error {
 	log.Debug("DataNode Init",
 		zap.String("SegmentStatisticsChannelName", Params.SegmentStatisticsChannelName),
 		zap.String("TimeTickChannelName", Params.TimeTickChannelName),
 	)
 
+	node.bufferManager = newBufferManager(Params.FlushNodeBufferSize)
+
 	return nil
 }
 
@@ -241,7 +245,20 @@ func (node *DataNode) NewDataSyncService(vchan *datapb.VchannelInfo) error {
 		return nil
 	}
 
-	replica := newReplica(node.rootCoord, vchan.CollectionID)
+	option := &miniokv.Option{
+		Address:           Params.MinioAddress,
+		AccessKeyID:       Params.MinioAccessKeyID,
+		SecretAccessKeyID: Params.MinioSecretAccessKey,
+		UseSSL:            Params.MinioUseSSL,
+		CreateBucket:      true,
+		BucketName:        Params.MinioBucketName,
+	}
+	minIOKV, err := miniokv.NewMinIOKV(node.ctx, option)
+	if err != nil {
+		return err
+	}
+
+	replica := newReplica(node.rootCoord, vchan.CollectionID, minIOKV)
 
 	var alloc allocatorInterface = newAllocator(node.rootCoord)
 
@@ -251,7 +268,7 @@ func (node *DataNode) NewDataSyncService(vchan *datapb.VchannelInfo) error {
 	)
 
 	flushChan := make(chan *flushMsg, 100)
-	dataSyncService, err := newDataSyncService(node.ctx, flushChan, replica, alloc, node.msFactory, vchan, node.clearSignal, node.dataCoord)
+	dataSyncService, err := newDataSyncService(node.ctx, flushChan, replica, alloc, node.msFactory, vchan, node.clearSignal, node.dataCoord, node.bufferManager)
 	if err != nil {
 		return err
 	}
@@ -539,6 +556,18 @@ func (node *DataNode) FlushSegments(ctx context.Context, req *datapb.FlushSegmen
 }
 
 func (node *DataNode) Stop() error {
+	// announce the stopping and sync the buffered data first, the data coord reassigns the channels
+	// from the latest checkpoints once the session is revoked
+	graceful := node.session != nil && node.isHealthy()
+	if graceful {
+		if err := node.session.GoingStop(); err != nil {
+			log.Warn("DataNode going stop failed", zap.Int64("node_id", Params.NodeID), zap.Error(err))
+			graceful = false
+		} else {
+			node.syncAllBuffers(Params.GracefulStopTimeout)
+		}
+	}
+
 	node.cancel()
 
 	node.chanMut.RLock()
@@ -553,9 +582,83 @@ func (node *DataNode) Stop() error {
 	if node.closer != nil {
 		node.closer.Close()
 	}
+	if graceful {
+		node.session.Revoke(time.Second)
+	}
+	return nil
+}
+
+// syncAllBuffers syncs the buffered data of all the flowgraphs into binlogs, or gives up when the timeout expires
+func (node *DataNode) syncAllBuffers(timeout time.Duration) {
+	node.chanMut.RLock()
+	flushChs := make([]chan<- *flushMsg, 0, len(node.vchan2FlushCh))
+	for _, flushCh := range node.vchan2FlushCh {
+		flushChs = append(flushChs, flushCh)
+	}
+	node.chanMut.RUnlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := syncBuffers(ctx, flushChs); err != nil {
+		log.Warn("DataNode sync buffers timeout", zap.Int64("node_id", Params.NodeID))
+		return
+	}
+	log.Debug("DataNode all buffers synced", zap.Int64("node_id", Params.NodeID), zap.Int("num of channels", len(flushChs)))
+}
+
+// syncBuffers asks the flowgraphs of flushChs to sync all their buffered data and waits until they are done
+func syncBuffers(ctx context.Context, flushChs []chan<- *flushMsg) error {
+	dmlFlushedCh := make(chan []*datapb.FieldBinlog, len(flushChs))
+	for _, flushCh := range flushChs {
+		select {
+		case flushCh <- &flushMsg{syncAll: true, dmlFlushedCh: dmlFlushedCh}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	for range flushChs {
+		select {
+		case <-dmlFlushedCh:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
 	return nil
 }
 
+// ReleaseDmChannels syncs the buffered data of the channels and releases their flowgraphs, the data coord
+// hands the channels over to other data nodes from the checkpoints afterwards
+func (node *DataNode) ReleaseDmChannels(ctx context.Context, req *datapb.ReleaseDmChannelsRequest) (*commonpb.Status, error) {
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+	if !node.isHealthy() {
+		status.Reason = fmt.Sprintf("DataNode %d not healthy, please re-send message", node.NodeID)
+		return status, nil
+	}
+
+	node.chanMut.RLock()
+	flushChs := make([]chan<- *flushMsg, 0, len(req.GetChannelNames()))
+	for _, channel := range req.GetChannelNames() {
+		if flushCh, ok := node.vchan2FlushCh[channel]; ok {
+			flushChs = append(flushChs, flushCh)
+		}
+	}
+	node.chanMut.RUnlock()
+
+	// the data not synced is consumed again by the next data node, so the channels are released anyway
+	if err := syncBuffers(ctx, flushChs); err != nil {
+		log.Warn("DataNode sync buffers of released channels failed", zap.Strings("channels", req.GetChannelNames()), zap.Error(err))
+	}
+	for _, channel := range req.GetChannelNames() {
+		node.ReleaseDataSyncService(channel)
+	}
+
+	log.Debug("DataNode ReleaseDmChannels Done", zap.Strings("channels", req.GetChannelNames()))
+	status.ErrorCode = commonpb.ErrorCode_Success
+	return status, nil
+}
+
 func (node *DataNode) GetTimeTickChannel(ctx context.Context) (*milvuspb.StringResponse, error) {
 	return &milvuspb.StringResponse{
 		Status: &commonpb.Status{
@@ -321,6 +321,31 @@ func TestDataNode(t *testing.T) {
 
 	})
 
+	t.Run("Test ReleaseDmChannels", func(t *testing.T) {
+		dmChannelName := "fake-dm-channel-test-ReleaseDmChannels"
+
+		vchan := &datapb.VchannelInfo{
+			CollectionID:      1,
+			ChannelName:       dmChannelName,
+			UnflushedSegments: []*datapb.SegmentInfo{},
+		}
+
+		err := node.NewDataSyncService(vchan)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(node.vchan2SyncService))
+
+		// no message is consumed to sync the buffers, the channel is released when the request expires
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		status, err := node.ReleaseDmChannels(ctx, &datapb.ReleaseDmChannelsRequest{
+			ChannelNames: []string{dmChannelName},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		assert.Equal(t, 0, len(node.vchan2FlushCh))
+		assert.Equal(t, 0, len(node.vchan2SyncService))
+	})
+
 	t.Run("Test GetChannelName", func(t *testing.T) {
 		ctx, cancel := context.WithCancel(context.Background())
 		node := newIDLEDataNodeMock(ctx)
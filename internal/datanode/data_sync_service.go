@@ -36,6 +36,8 @@ type dataSyncService struct {
 	collectionID UniqueID
 	dataCoord    types.DataCoord
 	clearSignal  chan<- UniqueID
+
+	bufferManager *bufferManager
 }
 
 func newDataSyncService(ctx context.Context,
@@ -46,7 +48,7 @@ func newDataSyncService(ctx context.Context,
 	vchan *datapb.VchannelInfo,
 	clearSignal chan<- UniqueID,
 	dataCoord types.DataCoord,
-
+	bufferManager *bufferManager,
 ) (*dataSyncService, error) {
 
 	ctx1, cancel := context.WithCancel(ctx)
@@ -62,6 +64,8 @@ func newDataSyncService(ctx context.Context,
 		collectionID: vchan.GetCollectionID(),
 		dataCoord:    dataCoord,
 		clearSignal:  clearSignal,
+
+		bufferManager: bufferManager,
 	}
 
 	if err := service.initNodes(vchan); err != nil {
@@ -109,12 +113,17 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 		for k, v := range fu.field2Path {
 			id2path = append(id2path, &datapb.FieldBinlog{FieldID: k, Binlogs: []string{v}})
 		}
+		id2stats := []*datapb.FieldBinlog{}
+		for k, v := range fu.field2Stats {
+			id2stats = append(id2stats, &datapb.FieldBinlog{FieldID: k, Binlogs: []string{v}})
+		}
 		for k, v := range fu.checkPoint {
 			v := v
 			checkPoints = append(checkPoints, &datapb.CheckPoint{
-				SegmentID: k,
-				NumOfRows: v.numRows,
-				Position:  &v.pos,
+				SegmentID:  k,
+				NumOfRows:  v.numRows,
+				MemorySize: v.memorySize,
+				Position:   &v.pos,
 			})
 		}
 		log.Debug("SaveBinlogPath",
@@ -130,12 +139,14 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 				Timestamp: 0, //TODO time stamp
 				SourceID:  Params.NodeID,
 			},
-			SegmentID:         fu.segID,
-			CollectionID:      fu.collID,
-			Field2BinlogPaths: id2path,
-			CheckPoints:       checkPoints,
-			StartPositions:    fu.startPositions,
-			Flushed:           fu.flushed,
+			SegmentID:           fu.segID,
+			CollectionID:        fu.collID,
+			Field2BinlogPaths:   id2path,
+			Field2StatslogPaths: id2stats,
+			CheckPoints:         checkPoints,
+			StartPositions:      fu.startPositions,
+			Flushed:             fu.flushed,
+			Deltalogs:           fu.deltalogs,
 		}
 		rsp, err := dsService.dataCoord.SaveBinlogPaths(dsService.ctx, req)
 		if err != nil {
@@ -162,17 +173,23 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 		dsService.msFactory,
 		dsService.idAllocator,
 		dsService.flushChan,
-		saveBinlog,
 		vchanInfo.GetChannelName(),
+		dsService.bufferManager,
 	)
 	if err != nil {
 		return err
 	}
 
-	var deleteNode Node = newDeleteDNode(
+	var deleteNode Node
+	deleteNode, err = newDeleteDNode(
 		dsService.ctx,
 		dsService.replica,
+		dsService.idAllocator,
+		saveBinlog,
 	)
+	if err != nil {
+		return err
+	}
 
 	// recover segment checkpoints
 	for _, us := range vchanInfo.GetUnflushedSegments() {
@@ -193,8 +210,10 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 			zap.Int64("NumOfRows", us.GetNumOfRows()),
 		)
 
-		dsService.replica.addNormalSegment(us.GetID(), us.CollectionID, us.PartitionID, us.GetInsertChannel(),
-			us.GetNumOfRows(), &segmentCheckPoint{us.GetNumOfRows(), *us.GetDmlPosition()})
+		if err := dsService.replica.addNormalSegment(us.GetID(), us.CollectionID, us.PartitionID, us.GetInsertChannel(),
+			us.GetNumOfRows(), us.GetStatslogs(), &segmentCheckPoint{us.GetNumOfRows(), us.GetMemorySize(), *us.GetDmlPosition()}); err != nil {
+			return err
+		}
 	}
 
 	dsService.fg.AddNode(dmStreamNode)
@@ -74,6 +74,7 @@ func (ddn *ddNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 
 	var iMsg = insertMsg{
 		insertMessages: make([]*msgstream.InsertMsg, 0),
+		deleteMessages: make([]*msgstream.DeleteMsg, 0),
 		timeRange: TimeRange{
 			timestampMin: msMsg.TimestampMin(),
 			timestampMax: msMsg.TimestampMax(),
@@ -109,6 +110,12 @@ func (ddn *ddNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 				continue
 			}
 			iMsg.insertMessages = append(iMsg.insertMessages, msg.(*msgstream.InsertMsg))
+		case commonpb.MsgType_Delete:
+			dmsg := msg.(*msgstream.DeleteMsg)
+			if dmsg.CollectionID != ddn.collectionID {
+				continue
+			}
+			iMsg.deleteMessages = append(iMsg.deleteMessages, dmsg)
 		}
 	}
 
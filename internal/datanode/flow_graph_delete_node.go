@@ -15,16 +15,39 @@ import (
 	"context"
 	"encoding/binary"
 	"errors"
+	"fmt"
+	"path"
 
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/kv"
+	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/storage"
 )
 
 type deleteNode struct {
 	BaseNode
 
-	replica Replica
+	replica     Replica
+	idAllocator allocatorInterface
+	minIOKV     kv.BaseKV
+
+	// delBuf buffers the deleted primary keys of each segment until delta logs are flushed
+	delBuf map[UniqueID]*DelDataBuf
+
+	dsSaveBinlog func(fu *segmentFlushUnit) error
+}
+
+// DelDataBuf buffers the primary keys deleted from a segment and the timestamps they are deleted at
+type DelDataBuf struct {
+	pks []int64
+	tss []Timestamp
+}
+
+func (ddb *DelDataBuf) size() int {
+	return len(ddb.pks)
 }
 
 func (ddn *deleteNode) Name() string {
@@ -43,20 +66,139 @@ func (ddn *deleteNode) Operate(in []Msg) []Msg {
 		return []Msg{}
 	}
 
-	msMsg, ok := in[0].(*MsgStreamMsg)
+	dMsg, ok := in[0].(*deleteMsg)
 	if !ok {
-		log.Error("type assertion failed for MsgStreamMsg")
+		log.Error("type assertion failed for deleteMsg")
 		return []Msg{}
 		// TODO: add error handling
 	}
 
-	if msMsg == nil {
+	if dMsg == nil {
 		return []Msg{}
 	}
 
+	for _, msg := range dMsg.deleteMessages {
+		if err := ddn.bufferDeleteMsg(msg); err != nil {
+			log.Error("buffer delete msg failed", zap.Error(err))
+		}
+	}
+
+	if len(dMsg.flushUnits) > 0 {
+		ddn.saveFlushUnits(dMsg.flushUnits)
+	}
+	for _, done := range dMsg.flushDone {
+		done()
+	}
+
 	return []Msg{}
 }
 
+// saveFlushUnits saves the binlog paths of the segments synced or flushed by the insert buffer node,
+// with the delta logs of their buffered deletes. The checkpoints saved with the units skip the buffered
+// deletes of the flushed segments as well, so their delta logs are saved too.
+func (ddn *deleteNode) saveFlushUnits(flushUnits []*segmentFlushUnit) {
+	units := make(map[UniqueID]struct{}, len(flushUnits))
+	for _, fu := range flushUnits {
+		units[fu.segID] = struct{}{}
+	}
+	for segID := range ddn.delBuf {
+		if _, ok := units[segID]; ok || ddn.replica.hasSegment(segID, false) {
+			continue
+		}
+		collID, _, err := ddn.replica.getCollectionAndPartitionID(segID)
+		if err != nil {
+			log.Warn("failed to get the collection of segment", zap.Int64("segmentID", segID), zap.Error(err))
+			continue
+		}
+		flushUnits = append(flushUnits, &segmentFlushUnit{
+			collID:     collID,
+			segID:      segID,
+			field2Path: map[UniqueID]string{},
+		})
+	}
+
+	for _, fu := range flushUnits {
+		if buf, ok := ddn.delBuf[fu.segID]; ok {
+			deltalog, err := ddn.flushDelData(fu.segID, buf)
+			if err != nil {
+				log.Error("failed to write delta log", zap.Int64("segmentID", fu.segID), zap.Error(err))
+				continue
+			}
+			fu.deltalogs = append(fu.deltalogs, deltalog)
+		}
+		if err := ddn.dsSaveBinlog(fu); err != nil {
+			log.Error("data service save binlog path failed", zap.Int64("segmentID", fu.segID), zap.Error(err))
+			continue
+		}
+		delete(ddn.delBuf, fu.segID)
+		if fu.flushed {
+			ddn.replica.segmentFlushed(fu.segID)
+		}
+	}
+}
+
+// flushDelData writes the buffered deletes of the segment into a delta log and returns its path
+func (ddn *deleteNode) flushDelData(segID UniqueID, buf *DelDataBuf) (string, error) {
+	collID, partitionID, err := ddn.replica.getCollectionAndPartitionID(segID)
+	if err != nil {
+		return "", err
+	}
+	blob, err := storage.NewDeleteCodec().Serialize(collID, partitionID, segID, &storage.DeleteData{
+		Pks: buf.pks,
+		Tss: buf.tss,
+	})
+	if err != nil {
+		return "", err
+	}
+	logidx, err := ddn.idAllocator.allocID()
+	if err != nil {
+		return "", err
+	}
+	k, _ := ddn.idAllocator.genKey(false, collID, partitionID, segID, logidx)
+	key := path.Join(Params.DeltaBinlogRootPath, k)
+	if err := ddn.minIOKV.Save(key, string(blob.Value)); err != nil {
+		return "", err
+	}
+	log.Debug("save delta log", zap.Int64("segmentID", segID), zap.Int("deleted", buf.size()), zap.String("path", key))
+	return key, nil
+}
+
+func (ddn *deleteNode) bufferDeleteMsg(msg *msgstream.DeleteMsg) error {
+	if len(msg.PrimaryKeys) != len(msg.Timestamps) {
+		return fmt.Errorf("misaligned delete msg, %d pks but %d timestamps", len(msg.PrimaryKeys), len(msg.Timestamps))
+	}
+	if len(msg.PrimaryKeys) == 0 {
+		return nil
+	}
+
+	pk2ts := make(map[int64]Timestamp, len(msg.PrimaryKeys))
+	for i, pk := range msg.PrimaryKeys {
+		if ts, ok := pk2ts[pk]; !ok || msg.Timestamps[i] > ts {
+			pk2ts[pk] = msg.Timestamps[i]
+		}
+	}
+
+	segIDToPks, err := ddn.replica.filterSegmentsByPKs(msg.PrimaryKeys)
+	if err != nil {
+		return err
+	}
+
+	for segID, pks := range segIDToPks {
+		buf, ok := ddn.delBuf[segID]
+		if !ok {
+			buf = &DelDataBuf{}
+			ddn.delBuf[segID] = buf
+		}
+		for _, pk := range pks {
+			buf.pks = append(buf.pks, pk)
+			buf.tss = append(buf.tss, pk2ts[pk])
+		}
+		log.Debug("buffer delete msg", zap.Int64("segmentID", segID), zap.Int("deleted", len(pks)),
+			zap.Int("buffered", buf.size()))
+	}
+	return nil
+}
+
 func getSegmentsByPKs(pks []int64, segments []*Segment) (map[int64][]int64, error) {
 	if pks == nil {
 		return nil, errors.New("pks is nil when getSegmentsByPKs")
@@ -78,12 +220,35 @@ func getSegmentsByPKs(pks []int64, segments []*Segment) (map[int64][]int64, erro
 	return results, nil
 }
 
-func newDeleteDNode(ctx context.Context, replica Replica) *deleteNode {
+func newDeleteDNode(
+	ctx context.Context,
+	replica Replica,
+	idAllocator allocatorInterface,
+	saveBinlog func(*segmentFlushUnit) error,
+) (*deleteNode, error) {
 	baseNode := BaseNode{}
 	baseNode.SetMaxParallelism(Params.FlowGraphMaxQueueLength)
 
-	return &deleteNode{
-		BaseNode: baseNode,
-		replica:  replica,
+	// MinIO
+	option := &miniokv.Option{
+		Address:           Params.MinioAddress,
+		AccessKeyID:       Params.MinioAccessKeyID,
+		SecretAccessKeyID: Params.MinioSecretAccessKey,
+		UseSSL:            Params.MinioUseSSL,
+		CreateBucket:      true,
+		BucketName:        Params.MinioBucketName,
 	}
+	minIOKV, err := miniokv.NewMinIOKV(ctx, option)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deleteNode{
+		BaseNode:     baseNode,
+		replica:      replica,
+		idAllocator:  idAllocator,
+		minIOKV:      minIOKV,
+		delBuf:       make(map[UniqueID]*DelDataBuf),
+		dsSaveBinlog: saveBinlog,
+	}, nil
 }
@@ -14,16 +14,23 @@ package datanode
 import (
 	"context"
 	"encoding/binary"
+	"errors"
 	"testing"
 
 	"github.com/bits-and-blooms/bloom/v3"
 	"github.com/stretchr/testify/assert"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
 )
 
 func TestFlowGraphDeleteNode_Operate_Nil(t *testing.T) {
 	ctx := context.Background()
 	var replica Replica
-	deleteNode := newDeleteDNode(ctx, replica)
+	deleteNode, err := newDeleteDNode(ctx, replica, NewAllocatorFactory(), nil)
+	assert.Nil(t, err)
 	result := deleteNode.Operate([]Msg{})
 	assert.Equal(t, len(result), 0)
 }
@@ -31,13 +38,118 @@ func TestFlowGraphDeleteNode_Operate_Nil(t *testing.T) {
 func TestFlowGraphDeleteNode_Operate_Invalid_Size(t *testing.T) {
 	ctx := context.Background()
 	var replica Replica
-	deleteNode := newDeleteDNode(ctx, replica)
+	deleteNode, err := newDeleteDNode(ctx, replica, NewAllocatorFactory(), nil)
+	assert.Nil(t, err)
 	var Msg1 Msg
 	var Msg2 Msg
 	result := deleteNode.Operate([]Msg{Msg1, Msg2})
 	assert.Equal(t, len(result), 0)
 }
 
+func TestFlowGraphDeleteNode_Operate(t *testing.T) {
+	ctx := context.Background()
+	collID := UniqueID(1)
+	replica := newReplica(&RootCoordFactory{}, collID, memkv.NewMemoryKV())
+	pos := &internalpb.MsgPosition{}
+	err := replica.addNewSegment(100, collID, 0, "insert-01", pos, pos)
+	assert.Nil(t, err)
+	replica.updateSegmentPKRange(100, []int64{0, 1, 2})
+	err = replica.addNewSegment(200, collID, 0, "insert-01", pos, pos)
+	assert.Nil(t, err)
+	replica.updateSegmentPKRange(200, []int64{3, 4})
+
+	var units []segmentFlushUnit
+	saveBinlog := func(fu *segmentFlushUnit) error {
+		units = append(units, *fu)
+		return nil
+	}
+	deleteNode, err := newDeleteDNode(ctx, replica, NewAllocatorFactory(), saveBinlog)
+	assert.Nil(t, err)
+	deleteNode.minIOKV = memkv.NewMemoryKV()
+	dMsg := &deleteMsg{
+		deleteMessages: []*msgstream.DeleteMsg{
+			{
+				DeleteRequest: internalpb.DeleteRequest{
+					CollectionID: collID,
+					PrimaryKeys:  []int64{1, 4, 1000},
+					Timestamps:   []uint64{10, 10, 10},
+				},
+			},
+			{
+				DeleteRequest: internalpb.DeleteRequest{
+					CollectionID: collID,
+					PrimaryKeys:  []int64{2},
+					Timestamps:   []uint64{10, 20},
+				},
+			},
+		},
+	}
+	result := deleteNode.Operate([]Msg{dMsg})
+	assert.Equal(t, 0, len(result))
+
+	assert.Equal(t, 2, len(deleteNode.delBuf))
+	assert.Equal(t, []int64{1}, deleteNode.delBuf[100].pks)
+	assert.Equal(t, []Timestamp{10}, deleteNode.delBuf[100].tss)
+	assert.Equal(t, []int64{4}, deleteNode.delBuf[200].pks)
+	assert.Equal(t, []Timestamp{10}, deleteNode.delBuf[200].tss)
+
+	// the deletes of a flushed segment are saved with the units of other segments
+	err = replica.addNewSegment(300, collID, 0, "insert-01", pos, pos)
+	assert.Nil(t, err)
+	replica.updateSegmentPKRange(300, []int64{5})
+	replica.segmentFlushed(300)
+
+	done := false
+	dMsg = &deleteMsg{
+		deleteMessages: []*msgstream.DeleteMsg{
+			{
+				DeleteRequest: internalpb.DeleteRequest{
+					CollectionID: collID,
+					PrimaryKeys:  []int64{0, 5},
+					Timestamps:   []uint64{30, 30},
+				},
+			},
+		},
+		flushUnits: []*segmentFlushUnit{{collID: collID, segID: 100, field2Path: map[UniqueID]string{}, flushed: true}},
+		flushDone:  []func(){func() { done = true }},
+	}
+	result = deleteNode.Operate([]Msg{dMsg})
+	assert.Equal(t, 0, len(result))
+	assert.True(t, done)
+
+	assert.Equal(t, 2, len(units))
+	segDeltalogs := make(map[UniqueID][]string)
+	for _, unit := range units {
+		assert.Equal(t, 1, len(unit.deltalogs))
+		segDeltalogs[unit.segID] = unit.deltalogs
+	}
+	assert.True(t, units[0].flushed)
+	assert.False(t, replica.hasSegment(100, false))
+
+	value, err := deleteNode.minIOKV.Load(segDeltalogs[100][0])
+	assert.Nil(t, err)
+	_, segID, data, err := storage.NewDeleteCodec().Deserialize([]*storage.Blob{{Value: []byte(value)}})
+	assert.Nil(t, err)
+	assert.Equal(t, UniqueID(100), segID)
+	assert.Equal(t, []int64{1, 0}, data.Pks)
+	assert.Equal(t, []Timestamp{10, 30}, data.Tss)
+	_, ok := segDeltalogs[300]
+	assert.True(t, ok)
+
+	// only the segment not saved keeps its deletes
+	assert.Equal(t, 1, len(deleteNode.delBuf))
+	assert.Equal(t, []int64{4}, deleteNode.delBuf[200].pks)
+
+	deleteNode.dsSaveBinlog = func(fu *segmentFlushUnit) error {
+		return errors.New("mock")
+	}
+	dMsg = &deleteMsg{
+		flushUnits: []*segmentFlushUnit{{collID: collID, segID: 200, field2Path: map[UniqueID]string{}}},
+	}
+	deleteNode.Operate([]Msg{dMsg})
+	assert.Equal(t, []int64{4}, deleteNode.delBuf[200].pks)
+}
+
 func TestGetSegmentsByPKs(t *testing.T) {
 	buf := make([]byte, 8)
 	filter1 := bloom.NewWithEstimates(1000000, 0.01)
@@ -62,30 +62,35 @@ type insertBufferNode struct {
 	timeTickStream          msgstream.MsgStream
 	segmentStatisticsStream msgstream.MsgStream
 
-	dsSaveBinlog          func(fu *segmentFlushUnit) error
 	segmentCheckPoints    map[UniqueID]segmentCheckPoint
 	segmentCheckPointLock sync.Mutex
+
+	bufferManager *bufferManager // shared by all the flow graphs of the data node, nil if not limited
 }
 
 type segmentCheckPoint struct {
-	numRows int64
-	pos     internalpb.MsgPosition
+	numRows    int64
+	memorySize int64
+	pos        internalpb.MsgPosition
 }
 
 type segmentFlushUnit struct {
 	collID         UniqueID
 	segID          UniqueID
 	field2Path     map[UniqueID]string
+	field2Stats    map[UniqueID]string
 	checkPoint     map[UniqueID]segmentCheckPoint
 	startPositions []*datapb.SegmentStartPosition
 	flushed        bool
+	deltalogs      []string
 }
 
 type insertBuffer struct {
 	insertData map[UniqueID]*InsertData // SegmentID to InsertData
-	maxSize    int64
+	maxSize    int64                    // max bytes buffered for a segment
 }
 
+// size returns the bytes of the data buffered for the segment
 func (ib *insertBuffer) size(segmentID UniqueID) int64 {
 	if ib.insertData == nil || len(ib.insertData) <= 0 {
 		return 0
@@ -95,36 +100,70 @@ func (ib *insertBuffer) size(segmentID UniqueID) int64 {
 		return 0
 	}
 
-	var maxSize int64 = 0
+	var size int64 = 0
 	for _, data := range idata.Data {
-		fdata, ok := data.(*storage.FloatVectorFieldData)
-		if ok {
-			totalNumRows := int64(0)
-			if fdata.NumRows != nil {
-				for _, numRow := range fdata.NumRows {
-					totalNumRows += numRow
-				}
-			}
-			if totalNumRows > maxSize {
-				maxSize = totalNumRows
-			}
-		}
+		size += fieldDataMemorySize(data)
+	}
+	return size
+}
 
-		bdata, ok := data.(*storage.BinaryVectorFieldData)
-		if ok {
-			totalNumRows := int64(0)
-			if bdata.NumRows != nil {
-				for _, numRow := range bdata.NumRows {
-					totalNumRows += numRow
-				}
-			}
-			if totalNumRows > maxSize {
-				maxSize = totalNumRows
-			}
+// totalSize returns the bytes of the data buffered for all the segments
+func (ib *insertBuffer) totalSize() int64 {
+	var size int64 = 0
+	for segmentID := range ib.insertData {
+		size += ib.size(segmentID)
+	}
+	return size
+}
+
+// segmentSizes returns the bytes of the data buffered for each segment
+func (ib *insertBuffer) segmentSizes() map[UniqueID]int64 {
+	sizes := make(map[UniqueID]int64, len(ib.insertData))
+	for segmentID := range ib.insertData {
+		sizes[segmentID] = ib.size(segmentID)
+	}
+	return sizes
+}
+
+// fieldDataMemorySize returns the bytes of the values in the field data
+func fieldDataMemorySize(data storage.FieldData) int64 {
+	switch fdata := data.(type) {
+	case *storage.BoolFieldData:
+		return int64(len(fdata.Data))
+	case *storage.Int8FieldData:
+		return int64(len(fdata.Data))
+	case *storage.Int16FieldData:
+		return int64(len(fdata.Data)) * 2
+	case *storage.Int32FieldData:
+		return int64(len(fdata.Data)) * 4
+	case *storage.Int64FieldData:
+		return int64(len(fdata.Data)) * 8
+	case *storage.FloatFieldData:
+		return int64(len(fdata.Data)) * 4
+	case *storage.DoubleFieldData:
+		return int64(len(fdata.Data)) * 8
+	case *storage.StringFieldData:
+		var size int64 = 0
+		for _, str := range fdata.Data {
+			size += int64(len(str))
 		}
+		return size
+	case *storage.BinaryVectorFieldData:
+		return int64(len(fdata.Data))
+	case *storage.FloatVectorFieldData:
+		return int64(len(fdata.Data)) * 4
+	default:
+		return 0
+	}
+}
 
+// insertMsgMemorySize returns the bytes of the rows in the insert message, including their row ids and timestamps
+func insertMsgMemorySize(msg *msgstream.InsertMsg) int64 {
+	size := int64(len(msg.RowIDs)+len(msg.Timestamps)) * 8
+	for _, blob := range msg.RowData {
+		size += int64(len(blob.GetValue()))
 	}
-	return maxSize
+	return size
 }
 
 func (ib *insertBuffer) full(segmentID UniqueID) bool {
@@ -154,6 +193,9 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 	if iMsg == nil {
 		ibNode.timeTickStream.Close()
 		ibNode.segmentStatisticsStream.Close()
+		if ibNode.bufferManager != nil {
+			ibNode.bufferManager.remove(ibNode.channelName)
+		}
 		return []Msg{}
 	}
 
@@ -174,6 +216,7 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 
 	// Updating segment statistics
 	uniqueSeg := make(map[UniqueID]int64)
+	uniqueSegSize := make(map[UniqueID]int64)
 	for _, msg := range iMsg.insertMessages {
 
 		currentSegID := msg.GetSegmentID()
@@ -195,13 +238,14 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 
 		segNum := uniqueSeg[currentSegID]
 		uniqueSeg[currentSegID] = segNum + int64(len(msg.RowIDs))
+		uniqueSegSize[currentSegID] += insertMsgMemorySize(msg)
 	}
 
 	segToUpdate := make([]UniqueID, 0, len(uniqueSeg))
 	for id, num := range uniqueSeg {
 		segToUpdate = append(segToUpdate, id)
 
-		err := ibNode.replica.updateStatistics(id, num)
+		err := ibNode.replica.updateStatistics(id, num, uniqueSegSize[id])
 		if err != nil {
 			log.Error("update Segment Row number wrong", zap.Int64("segID", id), zap.Error(err))
 		}
@@ -241,6 +285,8 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 
 		// 1.2 Get Fields
 		var pos int = 0 // Record position of blob
+		// primary keys of the rows, row ids are the primary keys if the primary key is auto generated
+		pks := msg.GetRowIDs()
 		var fieldIDs []int64
 		var fieldTypes []schemapb.DataType
 		for _, field := range collSchema.Fields {
@@ -437,6 +483,9 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 					}
 					pos += int(unsafe.Sizeof(*(&v)))
 					fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
+					if field.IsPrimaryKey && !field.AutoID {
+						pks = fieldData.Data[len(fieldData.Data)-len(msg.RowData):]
+					}
 				}
 
 			case schemapb.DataType_Float:
@@ -488,7 +537,7 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		// store current endPositions as Segment->EndPostion
 		ibNode.replica.updateSegmentEndPosition(currentSegID, iMsg.endPositions[0])
 		// update segment pk filter
-		ibNode.replica.updateSegmentPKRange(currentSegID, msg.GetRowIDs())
+		ibNode.replica.updateSegmentPKRange(currentSegID, pks)
 	}
 
 	if len(iMsg.insertMessages) > 0 {
@@ -504,51 +553,29 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		}
 	}
 
-	finishCh := make(chan segmentFlushUnit, len(segToUpdate))
-	finishCnt := sync.WaitGroup{}
-	for _, segToFlush := range segToUpdate {
-		// If full, auto flush
-		if ibNode.insertBuffer.full(segToFlush) {
-			log.Debug(". Insert Buffer full, auto flushing ",
-				zap.Int64("num of rows", ibNode.insertBuffer.size(segToFlush)))
-
-			collMeta, err := ibNode.getCollMetabySegID(segToFlush, iMsg.timeRange.timestampMax)
-			if err != nil {
-				log.Error("Auto flush failed .. cannot get collection meta ..", zap.Error(err))
-				continue
-			}
-
-			ibNode.flushMap.Store(segToFlush, ibNode.insertBuffer.insertData[segToFlush])
-			delete(ibNode.insertBuffer.insertData, segToFlush)
-
-			collID, partitionID, err := ibNode.getCollectionandPartitionIDbySegID(segToFlush)
-			if err != nil {
-				log.Error("Auto flush failed .. cannot get collection ID or partition ID..", zap.Error(err))
-				continue
-			}
-			finishCnt.Add(1)
-
-			go flushSegment(collMeta, segToFlush, partitionID, collID,
-				&ibNode.flushMap, ibNode.minIOKV, finishCh, &finishCnt, ibNode, ibNode.idAllocator)
-		}
-	}
-	finishCnt.Wait()
-	close(finishCh)
-	for fu := range finishCh {
-		if fu.field2Path == nil {
-			log.Debug("segment is empty")
-			continue
-		}
-		fu.checkPoint = ibNode.replica.listSegmentsCheckPoints()
-		fu.flushed = false
-		if err := ibNode.dsSaveBinlog(&fu); err != nil {
-			log.Debug("data service save bin log path failed", zap.Error(err))
-		}
-	}
+	// the binlog paths of the synced and flushed segments are saved by the delete node,
+	// together with the delta logs of the deletes in the same pack
+	flushUnits := ibNode.syncSegments(segToUpdate, iMsg.timeRange.timestampMax, false)
+	flushUnits = append(flushUnits, ibNode.syncOverBudget(iMsg.timeRange.timestampMax)...)
+	var flushDone []func()
 
 	// iMsg is Flush() msg from datacoord
 	select {
 	case fmsg := <-ibNode.flushChan:
+		if fmsg.syncAll {
+			// the data node is stopping or releasing the channel, sync all the buffered data without flushing the segments
+			segIDs := make([]UniqueID, 0, len(ibNode.insertBuffer.insertData))
+			for segID := range ibNode.insertBuffer.insertData {
+				segIDs = append(segIDs, segID)
+			}
+			log.Debug(". Receiving sync all message", zap.Int("num of segments", len(segIDs)))
+			flushUnits = append(flushUnits, ibNode.syncSegments(segIDs, iMsg.timeRange.timestampMax, true)...)
+			flushDone = append(flushDone, func() {
+				fmsg.dmlFlushedCh <- []*datapb.FieldBinlog{}
+			})
+			break
+		}
+
 		currentSegID := fmsg.segmentID
 		log.Debug(". Receiving flush message",
 			zap.Int64("segmentID", currentSegID),
@@ -557,15 +584,16 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 
 		if ibNode.insertBuffer.size(currentSegID) <= 0 {
 			log.Debug(".. Buffer empty ...")
-			ibNode.dsSaveBinlog(&segmentFlushUnit{
+			flushUnits = append(flushUnits, &segmentFlushUnit{
 				collID:     fmsg.collectionID,
 				segID:      currentSegID,
 				field2Path: map[UniqueID]string{},
 				checkPoint: ibNode.replica.listSegmentsCheckPoints(),
 				flushed:    true,
 			})
-			ibNode.replica.segmentFlushed(currentSegID)
-			fmsg.dmlFlushedCh <- []*datapb.FieldBinlog{{FieldID: currentSegID, Binlogs: []string{}}}
+			flushDone = append(flushDone, func() {
+				fmsg.dmlFlushedCh <- []*datapb.FieldBinlog{{FieldID: currentSegID, Binlogs: []string{}}}
+			})
 		} else { //insertBuffer(not empty) -> binLogs -> minIO/S3
 			log.Debug(".. Buffer not empty, flushing ..")
 			finishCh := make(chan segmentFlushUnit, 1)
@@ -603,18 +631,20 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 			if fu.field2Path != nil {
 				fu.checkPoint = ibNode.replica.listSegmentsCheckPoints()
 				fu.flushed = true
-				if err := ibNode.dsSaveBinlog(&fu); err != nil {
-					log.Debug("Data service save binlog path failed", zap.Error(err))
-				} else {
-					ibNode.replica.segmentFlushed(fu.segID)
-				}
+				flushUnits = append(flushUnits, &fu)
 			}
-			fmsg.dmlFlushedCh <- []*datapb.FieldBinlog{{FieldID: currentSegID, Binlogs: []string{}}}
+			flushDone = append(flushDone, func() {
+				fmsg.dmlFlushedCh <- []*datapb.FieldBinlog{{FieldID: currentSegID, Binlogs: []string{}}}
+			})
 		}
 
 	default:
 	}
 
+	if ibNode.bufferManager != nil {
+		ibNode.bufferManager.record(ibNode.channelName, ibNode.insertBuffer.segmentSizes())
+	}
+
 	// TODO write timetick
 	if err := ibNode.writeHardTimeTick(iMsg.timeRange.timestampMax); err != nil {
 		log.Error("send hard time tick into pulsar channel failed", zap.Error(err))
@@ -624,7 +654,81 @@ func (ibNode *insertBufferNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		sp.Finish()
 	}
 
-	return nil
+	// deletes are buffered by the delete node, after the inserts of the same pack
+	// have been applied to the segments' pk filters
+	var res Msg = &deleteMsg{
+		deleteMessages: iMsg.deleteMessages,
+		timeRange:      iMsg.timeRange,
+		flushUnits:     flushUnits,
+		flushDone:      flushDone,
+	}
+	return []Msg{res}
+}
+
+// syncSegments saves the buffered data of the segments into binlogs without flushing the segments,
+// only the segments with a full buffer are synced unless force is set
+func (ibNode *insertBufferNode) syncSegments(segIDs []UniqueID, ts Timestamp, force bool) []*segmentFlushUnit {
+	finishCh := make(chan segmentFlushUnit, len(segIDs))
+	finishCnt := sync.WaitGroup{}
+	for _, segToFlush := range segIDs {
+		// If full, auto flush
+		if force || ibNode.insertBuffer.full(segToFlush) {
+			log.Debug(". Insert Buffer full, auto flushing ",
+				zap.Int64("buffer size", ibNode.insertBuffer.size(segToFlush)))
+
+			collMeta, err := ibNode.getCollMetabySegID(segToFlush, ts)
+			if err != nil {
+				log.Error("Auto flush failed .. cannot get collection meta ..", zap.Error(err))
+				continue
+			}
+
+			ibNode.flushMap.Store(segToFlush, ibNode.insertBuffer.insertData[segToFlush])
+			delete(ibNode.insertBuffer.insertData, segToFlush)
+
+			collID, partitionID, err := ibNode.getCollectionandPartitionIDbySegID(segToFlush)
+			if err != nil {
+				log.Error("Auto flush failed .. cannot get collection ID or partition ID..", zap.Error(err))
+				continue
+			}
+			finishCnt.Add(1)
+
+			go flushSegment(collMeta, segToFlush, partitionID, collID,
+				&ibNode.flushMap, ibNode.minIOKV, finishCh, &finishCnt, ibNode, ibNode.idAllocator)
+		}
+	}
+	finishCnt.Wait()
+	close(finishCh)
+	var flushUnits []*segmentFlushUnit
+	for fu := range finishCh {
+		if fu.field2Path == nil {
+			log.Debug("segment is empty")
+			continue
+		}
+		fu := fu
+		fu.checkPoint = ibNode.replica.listSegmentsCheckPoints()
+		fu.flushed = false
+		flushUnits = append(flushUnits, &fu)
+	}
+	return flushUnits
+}
+
+// syncOverBudget syncs the segments of the flow graph picked by the buffer manager,
+// which are the largest segments of the data node when it buffers more data than its memory budget
+func (ibNode *insertBufferNode) syncOverBudget(ts Timestamp) []*segmentFlushUnit {
+	if ibNode.bufferManager == nil {
+		return nil
+	}
+	segIDs := ibNode.bufferManager.update(ibNode.channelName, ibNode.insertBuffer.segmentSizes())
+	if len(segIDs) == 0 {
+		return nil
+	}
+
+	log.Debug(". Data node insert buffers exceed the budget, syncing the largest segments",
+		zap.String("channel", ibNode.channelName),
+		zap.Int64s("segmentIDs", segIDs))
+	flushUnits := ibNode.syncSegments(segIDs, ts, true)
+	ibNode.bufferManager.record(ibNode.channelName, ibNode.insertBuffer.segmentSizes())
+	return flushUnits
 }
 
 func flushSegment(
@@ -669,6 +773,7 @@ func flushSegment(
 
 	log.Debug(".. Saving binlogs to MinIO ..", zap.Int("number", len(binLogs)))
 	field2Path := make(map[UniqueID]string, len(binLogs))
+	field2Stats := make(map[UniqueID]string, len(statsBinlogs))
 	kvs := make(map[string]string, len(binLogs))
 	paths := make([]string, 0, len(binLogs))
 	field2Logidx := make(map[UniqueID]UniqueID, len(binLogs))
@@ -716,6 +821,10 @@ func flushSegment(
 
 		key := path.Join(Params.StatsBinlogRootPath, k)
 		kvs[key] = string(blob.Value[:])
+		paths = append(paths, key)
+		if len(blob.Value) > 0 {
+			field2Stats[fieldID] = key
+		}
 	}
 	log.Debug("save binlog file to MinIO/S3")
 
@@ -729,7 +838,7 @@ func flushSegment(
 
 	ibNode.replica.updateSegmentCheckPoint(segID)
 	startPos := ibNode.replica.listNewSegmentsStartPositions()
-	flushUnit <- segmentFlushUnit{collID: collID, segID: segID, field2Path: field2Path, startPositions: startPos}
+	flushUnit <- segmentFlushUnit{collID: collID, segID: segID, field2Path: field2Path, field2Stats: field2Stats, startPositions: startPos}
 	clearFn(true)
 }
 
@@ -824,8 +933,8 @@ func newInsertBufferNode(
 	factory msgstream.Factory,
 	idAllocator allocatorInterface,
 	flushCh <-chan *flushMsg,
-	saveBinlog func(*segmentFlushUnit) error,
 	channelName string,
+	bufferManager *bufferManager,
 ) (*insertBufferNode, error) {
 
 	maxQueueLength := Params.FlowGraphMaxQueueLength
@@ -889,7 +998,7 @@ func newInsertBufferNode(
 		flushMap:           sync.Map{},
 		flushChan:          flushCh,
 		idAllocator:        idAllocator,
-		dsSaveBinlog:       saveBinlog,
 		segmentCheckPoints: make(map[UniqueID]segmentCheckPoint),
+		bufferManager:      bufferManager,
 	}, nil
 }
@@ -66,7 +66,7 @@ func TestFLowGraphInsertBufferNodeCreate(t *testing.T) {
 	collMeta := Factory.CollectionMetaFactory(UniqueID(0), "coll1")
 	mockRootCoord := &RootCoordFactory{}
 
-	replica := newReplica(mockRootCoord, collMeta.ID)
+	replica := newReplica(mockRootCoord, collMeta.ID, memkv.NewMemoryKV())
 
 	err = replica.addNewSegment(1, collMeta.ID, 0, insertChannelName, &internalpb.MsgPosition{}, &internalpb.MsgPosition{})
 	require.NoError(t, err)
@@ -79,19 +79,14 @@ func TestFLowGraphInsertBufferNodeCreate(t *testing.T) {
 	err = msFactory.SetParams(m)
 	assert.Nil(t, err)
 
-	saveBinlog := func(fu *segmentFlushUnit) error {
-		t.Log(fu)
-		return nil
-	}
-
 	flushChan := make(chan *flushMsg, 100)
-	iBNode, err := newInsertBufferNode(ctx, replica, msFactory, NewAllocatorFactory(), flushChan, saveBinlog, "string")
+	iBNode, err := newInsertBufferNode(ctx, replica, msFactory, NewAllocatorFactory(), flushChan, "string", nil)
 	assert.NotNil(t, iBNode)
 	require.NoError(t, err)
 
 	ctxDone, cancel := context.WithCancel(ctx)
 	cancel() // cancel now to make context done
-	_, err = newInsertBufferNode(ctxDone, replica, msFactory, NewAllocatorFactory(), flushChan, saveBinlog, "string")
+	_, err = newInsertBufferNode(ctxDone, replica, msFactory, NewAllocatorFactory(), flushChan, "string", nil)
 	assert.Error(t, err)
 
 	cdf := &CDFMsFactory{
@@ -99,13 +94,13 @@ func TestFLowGraphInsertBufferNodeCreate(t *testing.T) {
 		cd:      0,
 	}
 
-	_, err = newInsertBufferNode(ctx, replica, cdf, NewAllocatorFactory(), flushChan, saveBinlog, "string")
+	_, err = newInsertBufferNode(ctx, replica, cdf, NewAllocatorFactory(), flushChan, "string", nil)
 	assert.Error(t, err)
 	cdf = &CDFMsFactory{
 		Factory: msFactory,
 		cd:      1,
 	}
-	_, err = newInsertBufferNode(ctx, replica, cdf, NewAllocatorFactory(), flushChan, saveBinlog, "string")
+	_, err = newInsertBufferNode(ctx, replica, cdf, NewAllocatorFactory(), flushChan, "string", nil)
 	assert.Error(t, err)
 }
 
@@ -124,7 +119,7 @@ func TestFlowGraphInsertBufferNode_Operate(t *testing.T) {
 	collMeta := Factory.CollectionMetaFactory(UniqueID(0), "coll1")
 	mockRootCoord := &RootCoordFactory{}
 
-	replica := newReplica(mockRootCoord, collMeta.ID)
+	replica := newReplica(mockRootCoord, collMeta.ID, memkv.NewMemoryKV())
 
 	err = replica.addNewSegment(1, collMeta.ID, 0, insertChannelName, &internalpb.MsgPosition{}, &internalpb.MsgPosition{})
 	require.NoError(t, err)
@@ -143,7 +138,9 @@ func TestFlowGraphInsertBufferNode_Operate(t *testing.T) {
 	}
 
 	flushChan := make(chan *flushMsg, 100)
-	iBNode, err := newInsertBufferNode(ctx, replica, msFactory, NewAllocatorFactory(), flushChan, saveBinlog, "string")
+	iBNode, err := newInsertBufferNode(ctx, replica, msFactory, NewAllocatorFactory(), flushChan, "string", nil)
+	require.NoError(t, err)
+	dNode, err := newDeleteDNode(ctx, replica, NewAllocatorFactory(), saveBinlog)
 	require.NoError(t, err)
 
 	dmlFlushedCh := make(chan []*datapb.FieldBinlog, 1)
@@ -158,7 +155,7 @@ func TestFlowGraphInsertBufferNode_Operate(t *testing.T) {
 
 	inMsg := genInsertMsg(insertChannelName)
 	var iMsg flowgraph.Msg = &inMsg
-	iBNode.Operate([]flowgraph.Msg{iMsg})
+	dNode.Operate(iBNode.Operate([]flowgraph.Msg{iMsg}))
 	isflushed := <-dmlFlushedCh
 	assert.NotNil(t, isflushed)
 	log.Debug("DML binlog paths", zap.Any("paths", isflushed))
@@ -213,7 +210,7 @@ func TestFlushSegment(t *testing.T) {
 	flushMap := sync.Map{}
 	mockRootCoord := &RootCoordFactory{}
 
-	replica := newReplica(mockRootCoord, collMeta.ID)
+	replica := newReplica(mockRootCoord, collMeta.ID, memkv.NewMemoryKV())
 
 	err := replica.addNewSegment(segmentID, collMeta.ID, 0, insertChannelName, &internalpb.MsgPosition{}, &internalpb.MsgPosition{})
 	require.NoError(t, err)
@@ -246,10 +243,7 @@ func TestFlushSegment(t *testing.T) {
 	err = msFactory.SetParams(m)
 	assert.Nil(t, err)
 	flushChan := make(chan *flushMsg, 100)
-	saveBinlog := func(*segmentFlushUnit) error {
-		return nil
-	}
-	ibNode, err := newInsertBufferNode(ctx, replica, msFactory, NewAllocatorFactory(), flushChan, saveBinlog, "string")
+	ibNode, err := newInsertBufferNode(ctx, replica, msFactory, NewAllocatorFactory(), flushChan, "string", nil)
 	require.NoError(t, err)
 
 	flushSegment(collMeta,
@@ -364,17 +358,20 @@ func TestFlowGraphInsertBufferNode_AutoFlush(t *testing.T) {
 	}
 
 	flushChan := make(chan *flushMsg, 100)
-	iBNode, err := newInsertBufferNode(ctx, colRep, msFactory, NewAllocatorFactory(), flushChan, saveBinlog, "string")
+	iBNode, err := newInsertBufferNode(ctx, colRep, msFactory, NewAllocatorFactory(), flushChan, "string", nil)
+	require.NoError(t, err)
+	// the flush units are saved by the delete node
+	dNode, err := newDeleteDNode(ctx, colRep, NewAllocatorFactory(), saveBinlog)
 	require.NoError(t, err)
 
-	// Auto flush number of rows set to 2
+	// Auto flush buffer size set to 2 rows, after the size of a row is known
 
 	inMsg := genInsertMsg("datanode-03-test-autoflush")
 	inMsg.insertMessages = dataFactory.GetMsgStreamInsertMsgs(2)
 	var iMsg flowgraph.Msg = &inMsg
 
 	t.Run("Pure auto flush", func(t *testing.T) {
-		iBNode.insertBuffer.maxSize = 2
+		iBNode.insertBuffer.maxSize = math.MaxInt64
 
 		for i := range inMsg.insertMessages {
 			inMsg.insertMessages[i].SegmentID = int64(i%2) + 1
@@ -397,7 +394,10 @@ func TestFlowGraphInsertBufferNode_AutoFlush(t *testing.T) {
 			{2, 1, 100, 123, 0, 100},
 		}
 
-		iBNode.Operate([]flowgraph.Msg{iMsg})
+		dNode.Operate(iBNode.Operate([]flowgraph.Msg{iMsg}))
+		rowSize := iBNode.insertBuffer.size(1)
+		require.Less(t, int64(0), rowSize)
+		iBNode.insertBuffer.maxSize = 2 * rowSize
 		require.Equal(t, 2, len(colRep.newSegments))
 		require.Equal(t, 0, len(colRep.normalSegments))
 		assert.Equal(t, 0, len(flushUnit))
@@ -420,7 +420,7 @@ func TestFlowGraphInsertBufferNode_AutoFlush(t *testing.T) {
 		iMsg = &inMsg
 
 		// Triger auto flush
-		iBNode.Operate([]flowgraph.Msg{iMsg})
+		dNode.Operate(iBNode.Operate([]flowgraph.Msg{iMsg}))
 		require.Equal(t, 0, len(colRep.newSegments))
 		require.Equal(t, 3, len(colRep.normalSegments))
 
@@ -452,7 +452,7 @@ func TestFlowGraphInsertBufferNode_AutoFlush(t *testing.T) {
 				assert.Equal(t, test.expectedSegID, flushUnit[0].segID)
 				assert.Equal(t, int64(0), iBNode.insertBuffer.size(UniqueID(i+1)))
 			} else {
-				assert.Equal(t, int64(1), iBNode.insertBuffer.size(UniqueID(i+1)))
+				assert.Equal(t, rowSize, iBNode.insertBuffer.size(UniqueID(i+1)))
 			}
 		}
 
@@ -466,7 +466,7 @@ func TestFlowGraphInsertBufferNode_AutoFlush(t *testing.T) {
 
 		inMsg.startPositions = []*internalpb.MsgPosition{{Timestamp: 234}}
 		inMsg.endPositions = []*internalpb.MsgPosition{{Timestamp: 345}}
-		iBNode.Operate([]flowgraph.Msg{iMsg})
+		dNode.Operate(iBNode.Operate([]flowgraph.Msg{iMsg}))
 		assert.Equal(t, len(iBNode.segmentCheckPoints), 3)
 		assert.Equal(t, iBNode.segmentCheckPoints[1].numRows, int64(50+16000+100+32000))
 		assert.Equal(t, iBNode.segmentCheckPoints[2].numRows, int64(100+32000))
@@ -502,7 +502,7 @@ func TestFlowGraphInsertBufferNode_AutoFlush(t *testing.T) {
 		inMsg.insertMessages = []*msgstream.InsertMsg{}
 		inMsg.endPositions = []*internalpb.MsgPosition{{Timestamp: 345}}
 		inMsg.endPositions = []*internalpb.MsgPosition{{Timestamp: 456}}
-		iBNode.Operate([]flowgraph.Msg{iMsg})
+		dNode.Operate(iBNode.Operate([]flowgraph.Msg{iMsg}))
 
 		flushSeg := <-dmlFlushedCh
 		assert.NotNil(t, flushSeg)
@@ -537,7 +537,7 @@ func TestFlowGraphInsertBufferNode_AutoFlush(t *testing.T) {
 			collectionID: UniqueID(3),
 			dmlFlushedCh: dmlFlushedCh,
 		}
-		iBNode.Operate([]flowgraph.Msg{iMsg})
+		dNode.Operate(iBNode.Operate([]flowgraph.Msg{iMsg}))
 		flushSeg = <-dmlFlushedCh
 		assert.NotNil(t, flushSeg)
 		assert.Equal(t, len(flushSeg), 1)
@@ -560,3 +560,26 @@ func TestFlowGraphInsertBufferNode_AutoFlush(t *testing.T) {
 
 	})
 }
+
+func TestInsertBuffer_size(t *testing.T) {
+	ib := &insertBuffer{
+		insertData: map[UniqueID]*InsertData{
+			1: {Data: map[UniqueID]storage.FieldData{
+				0:   &storage.Int64FieldData{Data: []int64{1, 2}},
+				100: &storage.FloatVectorFieldData{Data: []float32{1, 2, 3, 4}, Dim: 2},
+				101: &storage.BinaryVectorFieldData{Data: []byte{1, 2}, Dim: 8},
+			}},
+			2: {Data: map[UniqueID]storage.FieldData{
+				102: &storage.StringFieldData{Data: []string{"abc", "de"}},
+				103: &storage.BoolFieldData{Data: []bool{true, false}},
+			}},
+		},
+	}
+
+	assert.Equal(t, int64(16+16+2), ib.size(1))
+	assert.Equal(t, int64(5+2), ib.size(2))
+	assert.Equal(t, int64(0), ib.size(3))
+	assert.Equal(t, int64(34+7), ib.totalSize())
+
+	assert.Equal(t, map[UniqueID]int64{1: 34, 2: 7}, ib.segmentSizes())
+}
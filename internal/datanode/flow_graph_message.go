@@ -25,19 +25,36 @@ type (
 
 type insertMsg struct {
 	insertMessages []*msgstream.InsertMsg
+	deleteMessages []*msgstream.DeleteMsg
 	timeRange      TimeRange
 	startPositions []*internalpb.MsgPosition
 	endPositions   []*internalpb.MsgPosition
 }
 
+type deleteMsg struct {
+	deleteMessages []*msgstream.DeleteMsg
+	timeRange      TimeRange
+	// flushUnits are the segments synced or flushed by the insert buffer node in the pack,
+	// they are saved after the deletes of the pack are buffered
+	flushUnits []*segmentFlushUnit
+	// flushDone notifies the flush requests once the flush units are saved
+	flushDone []func()
+}
+
 type flushMsg struct {
 	msgID        UniqueID
 	timestamp    Timestamp
 	segmentID    UniqueID
 	collectionID UniqueID
 	dmlFlushedCh chan<- []*datapb.FieldBinlog
+	// syncAll syncs all the buffered segments without flushing them, segmentID is ignored
+	syncAll bool
 }
 
 func (iMsg *insertMsg) TimeTick() Timestamp {
 	return iMsg.timeRange.timestampMax
 }
+
+func (dMsg *deleteMsg) TimeTick() Timestamp {
+	return dMsg.timeRange.timestampMax
+}
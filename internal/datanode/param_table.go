@@ -16,6 +16,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
@@ -31,8 +32,11 @@ type ParamTable struct {
 	FlowGraphMaxQueueLength int32
 	FlowGraphMaxParallelism int32
 	FlushInsertBufferSize   int64
+	FlushNodeBufferSize     int64
+	GracefulStopTimeout     time.Duration
 	InsertBinlogRootPath    string
 	StatsBinlogRootPath     string
+	DeltaBinlogRootPath     string
 	Log                     log.Config
 	Alias                   string // Different datanode in one machine
 
@@ -83,8 +87,11 @@ func (p *ParamTable) Init() {
 		p.initFlowGraphMaxQueueLength()
 		p.initFlowGraphMaxParallelism()
 		p.initFlushInsertBufferSize()
+		p.initFlushNodeBufferSize()
+		p.initGracefulStopTimeout()
 		p.initInsertBinlogRootPath()
 		p.initStatsBinlogRootPath()
+		p.initDeltaBinlogRootPath()
 		p.initLogCfg()
 
 		// === DataNode External Components Configs ===
@@ -127,6 +134,14 @@ func (p *ParamTable) initFlushInsertBufferSize() {
 	p.FlushInsertBufferSize = p.ParseInt64("datanode.flush.insertBufSize")
 }
 
+func (p *ParamTable) initFlushNodeBufferSize() {
+	p.FlushNodeBufferSize = p.ParseInt64("datanode.flush.nodeBufSize")
+}
+
+func (p *ParamTable) initGracefulStopTimeout() {
+	p.GracefulStopTimeout = time.Duration(p.ParseInt64("dataNode.gracefulStopTimeout")) * time.Second
+}
+
 func (p *ParamTable) initInsertBinlogRootPath() {
 	// GOOSE TODO: rootPath change to  TenentID
 	rootPath, err := p.Load("etcd.rootPath")
@@ -144,6 +159,14 @@ func (p *ParamTable) initStatsBinlogRootPath() {
 	p.StatsBinlogRootPath = path.Join(rootPath, "stats_log")
 }
 
+func (p *ParamTable) initDeltaBinlogRootPath() {
+	rootPath, err := p.Load("etcd.rootPath")
+	if err != nil {
+		panic(err)
+	}
+	p.DeltaBinlogRootPath = path.Join(rootPath, "delta_log")
+}
+
 // ---- Pulsar ----
 func (p *ParamTable) initPulsarAddress() {
 	url, err := p.Load("_PulsarAddress")
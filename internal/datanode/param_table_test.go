@@ -44,11 +44,26 @@ func TestParamTable_DataNode(t *testing.T) {
 		log.Println("FlushInsertBufferSize:", size)
 	})
 
+	t.Run("Test FlushNodeBufSize", func(t *testing.T) {
+		size := Params.FlushNodeBufferSize
+		log.Println("FlushNodeBufferSize:", size)
+	})
+
+	t.Run("Test GracefulStopTimeout", func(t *testing.T) {
+		timeout := Params.GracefulStopTimeout
+		log.Println("GracefulStopTimeout:", timeout)
+	})
+
 	t.Run("Test InsertBinlogRootPath", func(t *testing.T) {
 		path := Params.InsertBinlogRootPath
 		log.Println("InsertBinlogRootPath:", path)
 	})
 
+	t.Run("Test DeltaBinlogRootPath", func(t *testing.T) {
+		path := Params.DeltaBinlogRootPath
+		log.Println("DeltaBinlogRootPath:", path)
+	})
+
 	t.Run("Test PulsarAddress", func(t *testing.T) {
 		address := Params.PulsarAddress
 		log.Println("PulsarAddress:", address)
@@ -22,17 +22,20 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/bits-and-blooms/bloom/v3"
+	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 )
 
 const (
 	// TODO silverxia maybe need set from config
-	bloomFilterSize       uint    = 100000
-	maxBloomFalsePositive float64 = 0.005
+	// shares the storage settings so that filters loaded from stats binlogs can be merged
+	bloomFilterSize       uint    = storage.BloomFilterSize
+	maxBloomFalsePositive float64 = storage.MaxBloomFalsePositive
 )
 
 type Replica interface {
@@ -41,15 +44,16 @@ type Replica interface {
 	getCollectionAndPartitionID(segID UniqueID) (collID, partitionID UniqueID, err error)
 
 	addNewSegment(segID, collID, partitionID UniqueID, channelName string, startPos, endPos *internalpb.MsgPosition) error
-	addNormalSegment(segID, collID, partitionID UniqueID, channelName string, numOfRows int64, cp *segmentCheckPoint) error
+	addNormalSegment(segID, collID, partitionID UniqueID, channelName string, numOfRows int64, statsBinlogs []*datapb.FieldBinlog, cp *segmentCheckPoint) error
 	listNewSegmentsStartPositions() []*datapb.SegmentStartPosition
 	listSegmentsCheckPoints() map[UniqueID]segmentCheckPoint
 	updateSegmentEndPosition(segID UniqueID, endPos *internalpb.MsgPosition)
 	updateSegmentCheckPoint(segID UniqueID)
 	updateSegmentPKRange(segID UniqueID, rowIDs []int64)
+	filterSegmentsByPKs(pks []int64) (map[UniqueID][]int64, error)
 	hasSegment(segID UniqueID, countFlushed bool) bool
 
-	updateStatistics(segID UniqueID, numRows int64) error
+	updateStatistics(segID UniqueID, numRows int64, memorySize int64) error
 	getSegmentStatisticsUpdates(segID UniqueID) (*internalpb.SegmentStatisticsUpdates, error)
 	segmentFlushed(segID UniqueID)
 }
@@ -87,6 +91,7 @@ type SegmentReplica struct {
 	flushedSegments map[UniqueID]*Segment
 
 	metaService *metaService
+	minIOKV     kv.BaseKV
 }
 
 func (s *Segment) updatePKRange(rowIDs []int64) {
@@ -105,7 +110,7 @@ func (s *Segment) updatePKRange(rowIDs []int64) {
 
 var _ Replica = &SegmentReplica{}
 
-func newReplica(rc types.RootCoord, collID UniqueID) Replica {
+func newReplica(rc types.RootCoord, collID UniqueID, minIOKV kv.BaseKV) Replica {
 	metaService := newMetaService(rc, collID)
 
 	var replica Replica = &SegmentReplica{
@@ -116,6 +121,7 @@ func newReplica(rc types.RootCoord, collID UniqueID) Replica {
 		flushedSegments: make(map[UniqueID]*Segment),
 
 		metaService: metaService,
+		minIOKV:     minIOKV,
 	}
 	return replica
 }
@@ -174,6 +180,10 @@ func (replica *SegmentReplica) getCollectionAndPartitionID(segID UniqueID) (coll
 		return seg.collectionID, seg.partitionID, nil
 	}
 
+	if seg, ok := replica.flushedSegments[segID]; ok {
+		return seg.collectionID, seg.partitionID, nil
+	}
+
 	return 0, 0, fmt.Errorf("Cannot find segment, id = %v", segID)
 }
 
@@ -202,7 +212,7 @@ func (replica *SegmentReplica) addNewSegment(segID, collID, partitionID UniqueID
 		segmentID:    segID,
 		channelName:  channelName,
 
-		checkPoint: segmentCheckPoint{0, *startPos},
+		checkPoint: segmentCheckPoint{0, 0, *startPos},
 		startPos:   startPos,
 		endPos:     endPos,
 
@@ -219,10 +229,7 @@ func (replica *SegmentReplica) addNewSegment(segID, collID, partitionID UniqueID
 }
 
 // addNormalSegment adds a *NotNew* and *NotFlushed* segment
-func (replica *SegmentReplica) addNormalSegment(segID, collID, partitionID UniqueID, channelName string, numOfRows int64, cp *segmentCheckPoint) error {
-	replica.segMu.Lock()
-	defer replica.segMu.Unlock()
-
+func (replica *SegmentReplica) addNormalSegment(segID, collID, partitionID UniqueID, channelName string, numOfRows int64, statsBinlogs []*datapb.FieldBinlog, cp *segmentCheckPoint) error {
 	if collID != replica.collectionID {
 		log.Warn("Mismatch collection", zap.Int64("ID", collID))
 		return fmt.Errorf("Mismatch collection, ID=%d", collID)
@@ -241,23 +248,65 @@ func (replica *SegmentReplica) addNormalSegment(segID, collID, partitionID Uniqu
 		segmentID:    segID,
 		channelName:  channelName,
 		numRows:      numOfRows,
+		memorySize:   cp.memorySize,
 
 		checkPoint: *cp,
 		endPos:     &cp.pos,
 
-		//TODO silverxia, normal segments bloom filter and pk range should be loaded from serialized files
 		pkFilter: bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive),
 		minPK:    math.MaxInt64, // use max value, represents no value
 		maxPK:    math.MinInt64, // use min value represents no value
 	}
 
+	if err := replica.initPKBloomFilter(seg, statsBinlogs); err != nil {
+		log.Warn("Failed to load pk stats of normal segment", zap.Int64("segment ID", segID), zap.Error(err))
+		return err
+	}
+
 	seg.isNew.Store(false)
 	seg.isFlushed.Store(false)
 
+	replica.segMu.Lock()
+	defer replica.segMu.Unlock()
 	replica.normalSegments[segID] = seg
 	return nil
 }
 
+// initPKBloomFilter restores the pk bloom filter and pk range of a segment from its stats binlogs.
+// Only the stats of the primary key field carry a bloom filter, stats of other fields are skipped.
+func (replica *SegmentReplica) initPKBloomFilter(s *Segment, statsBinlogs []*datapb.FieldBinlog) error {
+	for _, fieldStats := range statsBinlogs {
+		if len(fieldStats.GetBinlogs()) == 0 {
+			continue
+		}
+		values, err := replica.minIOKV.MultiLoad(fieldStats.GetBinlogs())
+		if err != nil {
+			return err
+		}
+		for _, value := range values {
+			statsReader := &storage.StatsReader{}
+			statsReader.SetBuffer([]byte(value))
+			stats, err := statsReader.GetPrimaryKeyStats()
+			if err != nil {
+				return err
+			}
+			if stats.BF == nil {
+				continue
+			}
+			if err := s.pkFilter.Merge(stats.BF); err != nil {
+				return err
+			}
+			if stats.Max > s.maxPK {
+				s.maxPK = stats.Max
+			}
+			if stats.Min < s.minPK {
+				s.minPK = stats.Min
+			}
+		}
+	}
+	return nil
+}
+
 // listNewSegmentsStartPositions gets all *New Segments* start positions and
 //   transfer segments states from *New* to *Normal*.
 func (replica *SegmentReplica) listNewSegmentsStartPositions() []*datapb.SegmentStartPosition {
@@ -335,6 +384,22 @@ func (replica *SegmentReplica) updateSegmentPKRange(segID UniqueID, rowIDs []int
 	log.Warn("No match segment to update PK range", zap.Int64("ID", segID))
 }
 
+// filterSegmentsByPKs returns the primary keys that may be inside each segment according to the pk bloom filters.
+func (replica *SegmentReplica) filterSegmentsByPKs(pks []int64) (map[UniqueID][]int64, error) {
+	replica.segMu.RLock()
+	defer replica.segMu.RUnlock()
+
+	segments := make([]*Segment, 0, len(replica.newSegments)+len(replica.normalSegments)+len(replica.flushedSegments))
+	for _, segMap := range []map[UniqueID]*Segment{replica.newSegments, replica.normalSegments, replica.flushedSegments} {
+		for _, seg := range segMap {
+			if seg.pkFilter != nil {
+				segments = append(segments, seg)
+			}
+		}
+	}
+	return getSegmentsByPKs(pks, segments)
+}
+
 func (replica *SegmentReplica) removeSegment(segID UniqueID) error {
 	return nil
 }
@@ -355,20 +420,20 @@ func (replica *SegmentReplica) hasSegment(segID UniqueID, countFlushed bool) boo
 	return inNew || inNormal || inFlush
 }
 
-// updateStatistics updates the number of rows of a segment in replica.
-func (replica *SegmentReplica) updateStatistics(segID UniqueID, numRows int64) error {
+// updateStatistics updates the number of rows and the bytes of a segment in replica.
+func (replica *SegmentReplica) updateStatistics(segID UniqueID, numRows int64, memorySize int64) error {
 	replica.segMu.Lock()
 	defer replica.segMu.Unlock()
 
-	log.Debug("updating segment", zap.Int64("Segment ID", segID), zap.Int64("numRows", numRows))
+	log.Debug("updating segment", zap.Int64("Segment ID", segID), zap.Int64("numRows", numRows), zap.Int64("memorySize", memorySize))
 	if seg, ok := replica.newSegments[segID]; ok {
-		seg.memorySize = 0
+		seg.memorySize += memorySize
 		seg.numRows += numRows
 		return nil
 	}
 
 	if seg, ok := replica.normalSegments[segID]; ok {
-		seg.memorySize = 0
+		seg.memorySize += memorySize
 		seg.numRows += numRows
 		return nil
 	}
@@ -386,11 +451,13 @@ func (replica *SegmentReplica) getSegmentStatisticsUpdates(segID UniqueID) (*int
 
 	if seg, ok := replica.newSegments[segID]; ok {
 		updates.NumRows = seg.numRows
+		updates.MemorySize = seg.memorySize
 		return updates, nil
 	}
 
 	if seg, ok := replica.normalSegments[segID]; ok {
 		updates.NumRows = seg.numRows
+		updates.MemorySize = seg.memorySize
 		return updates, nil
 	}
 
@@ -435,12 +502,12 @@ func (replica *SegmentReplica) updateSegmentCheckPoint(segID UniqueID) {
 	defer replica.segMu.Unlock()
 
 	if seg, ok := replica.newSegments[segID]; ok {
-		seg.checkPoint = segmentCheckPoint{seg.numRows, *seg.endPos}
+		seg.checkPoint = segmentCheckPoint{seg.numRows, seg.memorySize, *seg.endPos}
 		return
 	}
 
 	if seg, ok := replica.normalSegments[segID]; ok {
-		seg.checkPoint = segmentCheckPoint{seg.numRows, *seg.endPos}
+		seg.checkPoint = segmentCheckPoint{seg.numRows, seg.memorySize, *seg.endPos}
 		return
 	}
 
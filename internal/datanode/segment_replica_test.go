@@ -13,6 +13,7 @@ package datanode
 
 import (
 	"encoding/binary"
+	"fmt"
 	"math"
 	"math/rand"
 	"testing"
@@ -21,7 +22,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 )
 
@@ -36,6 +40,7 @@ func newSegmentReplica(rc types.RootCoord, collID UniqueID) *SegmentReplica {
 		flushedSegments: make(map[UniqueID]*Segment),
 
 		metaService: metaService,
+		minIOKV:     memkv.NewMemoryKV(),
 	}
 	return replica
 }
@@ -145,13 +150,14 @@ func TestSegmentReplica(t *testing.T) {
 		assert.True(t, seg.isNew.Load().(bool))
 		assert.False(t, seg.isFlushed.Load().(bool))
 
-		err = replica.updateStatistics(0, 10)
+		err = replica.updateStatistics(0, 10, 100)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(10), seg.numRows)
+		assert.Equal(t, int64(100), seg.memorySize)
 
 		cpPos := &internalpb.MsgPosition{ChannelName: "insert-01", Timestamp: Timestamp(10)}
-		cp := &segmentCheckPoint{int64(10), *cpPos}
-		err = replica.addNormalSegment(1, 1, 2, "insert-01", int64(10), cp)
+		cp := &segmentCheckPoint{int64(10), int64(100), *cpPos}
+		err = replica.addNormalSegment(1, 1, 2, "insert-01", int64(10), nil, cp)
 		assert.NoError(t, err)
 		assert.True(t, replica.hasSegment(1, true))
 		assert.Equal(t, 1, len(replica.normalSegments))
@@ -168,9 +174,10 @@ func TestSegmentReplica(t *testing.T) {
 		assert.False(t, seg.isNew.Load().(bool))
 		assert.False(t, seg.isFlushed.Load().(bool))
 
-		err = replica.updateStatistics(1, 10)
+		err = replica.updateStatistics(1, 10, 100)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(20), seg.numRows)
+		assert.Equal(t, int64(200), seg.memorySize)
 
 		segPos := replica.listNewSegmentsStartPositions()
 		assert.Equal(t, 1, len(segPos))
@@ -195,11 +202,13 @@ func TestSegmentReplica(t *testing.T) {
 		updates, err = replica.getSegmentStatisticsUpdates(1)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(20), updates.NumRows)
+		assert.Equal(t, int64(200), updates.MemorySize)
 
 		replica.updateSegmentCheckPoint(0)
 		assert.Equal(t, int64(10), replica.normalSegments[UniqueID(0)].checkPoint.numRows)
 		replica.updateSegmentCheckPoint(1)
 		assert.Equal(t, int64(20), replica.normalSegments[UniqueID(1)].checkPoint.numRows)
+		assert.Equal(t, int64(200), replica.normalSegments[UniqueID(1)].checkPoint.memorySize)
 	})
 }
 
@@ -234,13 +243,13 @@ func TestReplicaUpdatePKRange(t *testing.T) {
 	startPos := &internalpb.MsgPosition{ChannelName: chanName, Timestamp: Timestamp(100)}
 	endPos := &internalpb.MsgPosition{ChannelName: chanName, Timestamp: Timestamp(200)}
 	cpPos := &internalpb.MsgPosition{ChannelName: chanName, Timestamp: Timestamp(10)}
-	cp := &segmentCheckPoint{int64(10), *cpPos}
+	cp := &segmentCheckPoint{int64(10), int64(100), *cpPos}
 
 	replica := newSegmentReplica(rc, collID)
 
 	err := replica.addNewSegment(1, collID, partID, chanName, startPos, endPos)
 	assert.Nil(t, err)
-	err = replica.addNormalSegment(2, collID, partID, chanName, 100, cp)
+	err = replica.addNormalSegment(2, collID, partID, chanName, 100, nil, cp)
 	assert.Nil(t, err)
 
 	segNew := replica.newSegments[1]
@@ -268,3 +277,57 @@ func TestReplicaUpdatePKRange(t *testing.T) {
 	}
 
 }
+
+func TestReplicaLoadPKStats(t *testing.T) {
+	rc := &RootCoordFactory{}
+	collID := UniqueID(1)
+	partID := UniqueID(2)
+	chanName := "insert-03"
+	cpPos := &internalpb.MsgPosition{ChannelName: chanName, Timestamp: Timestamp(10)}
+	cp := &segmentCheckPoint{int64(10), int64(100), *cpPos}
+
+	replica := newSegmentReplica(rc, collID)
+
+	pks := [][]int64{{10, 30, 20}, {50, 40}}
+	statsBinlogs := &datapb.FieldBinlog{FieldID: 106}
+	for i, batch := range pks {
+		sw := &storage.StatsWriter{}
+		err := sw.StatsPrimaryKey(batch)
+		require.NoError(t, err)
+		key := fmt.Sprintf("stats_log/%d", i)
+		err = replica.minIOKV.Save(key, string(sw.GetBuffer()))
+		require.NoError(t, err)
+		statsBinlogs.Binlogs = append(statsBinlogs.Binlogs, key)
+	}
+
+	// stats of a non primary key field carry no bloom filter
+	sw := &storage.StatsWriter{}
+	err := sw.StatsInt64([]int64{1, 1000})
+	require.NoError(t, err)
+	err = replica.minIOKV.Save("stats_log/rowID", string(sw.GetBuffer()))
+	require.NoError(t, err)
+	rowIDStats := &datapb.FieldBinlog{FieldID: 0, Binlogs: []string{"stats_log/rowID"}}
+
+	err = replica.addNormalSegment(1, collID, partID, chanName, 5, []*datapb.FieldBinlog{rowIDStats, statsBinlogs}, cp)
+	assert.NoError(t, err)
+	seg := replica.normalSegments[1]
+	require.NotNil(t, seg)
+	assert.Equal(t, int64(10), seg.minPK)
+	assert.Equal(t, int64(50), seg.maxPK)
+	for _, batch := range pks {
+		for _, pk := range batch {
+			assert.True(t, seg.pkFilter.Test(storage.PrimaryKeyBytes(pk)))
+		}
+	}
+
+	segPKs, err := replica.filterSegmentsByPKs([]int64{40, 60})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{40}, segPKs[1])
+
+	err = replica.minIOKV.Save("stats_log/invalid", "invalid")
+	require.NoError(t, err)
+	err = replica.addNormalSegment(2, collID, partID, chanName, 5,
+		[]*datapb.FieldBinlog{{FieldID: 106, Binlogs: []string{"stats_log/invalid"}}}, cp)
+	assert.Error(t, err)
+	assert.False(t, replica.hasSegment(2, true))
+}
@@ -91,10 +91,14 @@ func (c *Client) connect(retryOptions ...retry.Option) error {
 		}
 		opts := trace.GetInterceptorOpts()
 		log.Debug("DataCoordClient try reconnect ", zap.String("address", c.addr))
+		tlsOpt, err := Params.TLS.DialOption()
+		if err != nil {
+			return retry.NoRetryError(err)
+		}
 		ctx, cancel := context.WithTimeout(c.ctx, 15*time.Second)
 		defer cancel()
 		conn, err := grpc.DialContext(ctx, c.addr,
-			grpc.WithInsecure(), grpc.WithBlock(),
+			tlsOpt, grpc.WithBlock(),
 			grpc.WithDefaultCallOptions(
 				grpc.MaxCallRecvMsgSize(Params.ClientMaxRecvSize),
 				grpc.MaxCallSendMsgSize(Params.ClientMaxSendSize)),
@@ -256,6 +260,20 @@ func (c *Client) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedS
 	return ret.(*datapb.GetFlushedSegmentsResponse), err
 }
 
+func (c *Client) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.GetFlushState(ctx, req)
+	})
+	return ret.(*milvuspb.GetFlushStateResponse), err
+}
+
+func (c *Client) MoveChannel(ctx context.Context, req *datapb.MoveChannelRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.MoveChannel(ctx, req)
+	})
+	return ret.(*commonpb.Status), err
+}
+
 func (c *Client) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
 		return c.grpcClient.GetMetrics(ctx, req)
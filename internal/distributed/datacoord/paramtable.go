@@ -31,6 +31,8 @@ type ParamTable struct {
 
 	ServerMaxSendSize int
 	ServerMaxRecvSize int
+
+	TLS grpcconfigs.TLSConfig
 }
 
 var Params ParamTable
@@ -45,6 +47,7 @@ func (pt *ParamTable) Init() {
 
 		pt.initServerMaxSendSize()
 		pt.initServerMaxRecvSize()
+		pt.initTLS()
 	})
 }
 
@@ -122,3 +125,7 @@ func (pt *ParamTable) initServerMaxRecvSize() {
 	log.Debug("initServerMaxRecvSize",
 		zap.Int("dataCoord.grpc.serverMaxRecvSize", pt.ServerMaxRecvSize))
 }
+
+func (pt *ParamTable) initTLS() {
+	pt.TLS = grpcconfigs.InternalTLSConfig(&pt.BaseTable)
+}
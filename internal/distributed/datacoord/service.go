@@ -122,13 +122,21 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	defer cancel()
 
 	opts := trace.GetInterceptorOpts()
-	s.grpcServer = grpc.NewServer(
+	tlsOpts, err := Params.TLS.ServerOptions()
+	if err != nil {
+		log.Error("GrpcServer:failed to load tls config", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+	grpcOpts := []grpc.ServerOption{
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(
 			grpc_opentracing.UnaryServerInterceptor(opts...)),
 		grpc.StreamInterceptor(
-			grpc_opentracing.StreamServerInterceptor(opts...)))
+			grpc_opentracing.StreamServerInterceptor(opts...)),
+	}
+	s.grpcServer = grpc.NewServer(append(grpcOpts, tlsOpts...)...)
 	//grpc.UnaryInterceptor(grpc_prometheus.UnaryServerInterceptor))
 	datapb.RegisterDataCoordServer(s.grpcServer, s)
 	grpc_prometheus.Register(s.grpcServer)
@@ -234,6 +242,14 @@ func (s *Server) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedS
 	return s.dataCoord.GetFlushedSegments(ctx, req)
 }
 
+func (s *Server) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
+	return s.dataCoord.GetFlushState(ctx, req)
+}
+
+func (s *Server) MoveChannel(ctx context.Context, req *datapb.MoveChannelRequest) (*commonpb.Status, error) {
+	return s.dataCoord.MoveChannel(ctx, req)
+}
+
 func (s *Server) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return s.dataCoord.GetMetrics(ctx, req)
 }
@@ -69,10 +69,14 @@ func (c *Client) connect(retryOptions ...retry.Option) error {
 	connectGrpcFunc := func() error {
 		opts := trace.GetInterceptorOpts()
 		log.Debug("DataNode connect ", zap.String("address", c.addr))
+		tlsOpt, err := Params.TLS.DialOption()
+		if err != nil {
+			return retry.NoRetryError(err)
+		}
 		ctx, cancel := context.WithTimeout(c.ctx, 15*time.Second)
 		defer cancel()
 		conn, err := grpc.DialContext(ctx, c.addr,
-			grpc.WithInsecure(), grpc.WithBlock(),
+			tlsOpt, grpc.WithBlock(),
 			grpc.WithDefaultCallOptions(
 				grpc.MaxCallRecvMsgSize(Params.ClientMaxRecvSize),
 				grpc.MaxCallSendMsgSize(Params.ClientMaxSendSize)),
@@ -170,6 +174,13 @@ func (c *Client) FlushSegments(ctx context.Context, req *datapb.FlushSegmentsReq
 	return ret.(*commonpb.Status), err
 }
 
+func (c *Client) ReleaseDmChannels(ctx context.Context, req *datapb.ReleaseDmChannelsRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpc.ReleaseDmChannels(ctx, req)
+	})
+	return ret.(*commonpb.Status), err
+}
+
 func (c *Client) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	ret, err := c.recall(func() (interface{}, error) {
 		return c.grpc.GetMetrics(ctx, req)
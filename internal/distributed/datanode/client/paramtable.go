@@ -27,6 +27,8 @@ type ParamTable struct {
 
 	ClientMaxSendSize int
 	ClientMaxRecvSize int
+
+	TLS grpcconfigs.TLSConfig
 }
 
 var Params ParamTable
@@ -38,6 +40,7 @@ func (pt *ParamTable) Init() {
 
 		pt.initClientMaxSendSize()
 		pt.initClientMaxRecvSize()
+		pt.initTLS()
 	})
 }
 
@@ -86,3 +89,7 @@ func (pt *ParamTable) initClientMaxRecvSize() {
 	log.Debug("initClientMaxRecvSize",
 		zap.Int("dataNode.grpc.clientMaxRecvSize", pt.ClientMaxRecvSize))
 }
+
+func (pt *ParamTable) initTLS() {
+	pt.TLS = grpcconfigs.InternalTLSConfig(&pt.BaseTable)
+}
@@ -39,6 +39,8 @@ type ParamTable struct {
 
 	ServerMaxSendSize int
 	ServerMaxRecvSize int
+
+	TLS grpcconfigs.TLSConfig
 }
 
 func (pt *ParamTable) Init() {
@@ -50,6 +52,7 @@ func (pt *ParamTable) Init() {
 
 		pt.initServerMaxSendSize()
 		pt.initServerMaxRecvSize()
+		pt.initTLS()
 	})
 }
 
@@ -134,3 +137,7 @@ func (pt *ParamTable) initServerMaxRecvSize() {
 	log.Debug("initServerMaxRecvSize",
 		zap.Int("dataNode.grpc.serverMaxRecvSize", pt.ServerMaxRecvSize))
 }
+
+func (pt *ParamTable) initTLS() {
+	pt.TLS = grpcconfigs.InternalTLSConfig(&pt.BaseTable)
+}
@@ -92,13 +92,21 @@ func (s *Server) startGrpcLoop(listener net.Listener) {
 	defer s.wg.Done()
 
 	opts := trace.GetInterceptorOpts()
-	s.grpcServer = grpc.NewServer(
+	tlsOpts, err := Params.TLS.ServerOptions()
+	if err != nil {
+		log.Error("GrpcServer:failed to load tls config", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+	grpcOpts := []grpc.ServerOption{
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(
 			grpc_opentracing.UnaryServerInterceptor(opts...)),
 		grpc.StreamInterceptor(
-			grpc_opentracing.StreamServerInterceptor(opts...)))
+			grpc_opentracing.StreamServerInterceptor(opts...)),
+	}
+	s.grpcServer = grpc.NewServer(append(grpcOpts, tlsOpts...)...)
 	datapb.RegisterDataNodeServer(s.grpcServer, s)
 
 	ctx, cancel := context.WithCancel(s.ctx)
@@ -273,6 +281,10 @@ func (s *Server) FlushSegments(ctx context.Context, req *datapb.FlushSegmentsReq
 	return s.datanode.FlushSegments(ctx, req)
 }
 
+func (s *Server) ReleaseDmChannels(ctx context.Context, req *datapb.ReleaseDmChannelsRequest) (*commonpb.Status, error) {
+	return s.datanode.ReleaseDmChannels(ctx, req)
+}
+
 func (s *Server) GetMetrics(ctx context.Context, request *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return s.datanode.GetMetrics(ctx, request)
 }
@@ -0,0 +1,303 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package grpcconfigs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+const (
+	internalTLSPrefix = "tls.internal"
+	proxyTLSPrefix    = "tls.proxy"
+
+	// certificate files are checked for rotation at most once per certCheckInterval
+	certCheckInterval = 10 * time.Second
+)
+
+// TLSConfig is the TLS setting of a gRPC endpoint, both the server and its clients read the same setting
+type TLSConfig struct {
+	Enabled bool
+	// Mutual requires the clients to present a certificate signed by CAPath
+	Mutual   bool
+	CertPath string
+	KeyPath  string
+	CAPath   string
+	// ServerName overrides the name clients verify in the server certificate, the dialed host is used if empty
+	ServerName string
+}
+
+// InternalTLSConfig returns the mutual TLS setting between coordinators and nodes
+func InternalTLSConfig(bt *paramtable.BaseTable) TLSConfig {
+	return loadTLSConfig(bt, internalTLSPrefix, true)
+}
+
+// ProxyTLSConfig returns the one-way TLS setting of the public proxy endpoint
+func ProxyTLSConfig(bt *paramtable.BaseTable) TLSConfig {
+	return loadTLSConfig(bt, proxyTLSPrefix, false)
+}
+
+func loadTLSConfig(bt *paramtable.BaseTable, prefix string, mutual bool) TLSConfig {
+	load := func(key string) string {
+		value, _ := bt.LoadWithDefault(prefix+"."+key, "")
+		return value
+	}
+	cfg := TLSConfig{
+		Enabled:    bt.ParseBool(prefix+".enabled", false),
+		Mutual:     mutual,
+		CertPath:   load("certPath"),
+		KeyPath:    load("keyPath"),
+		CAPath:     load("caPath"),
+		ServerName: load("serverName"),
+	}
+	log.Debug("load tls config", zap.String("prefix", prefix), zap.Bool("enabled", cfg.Enabled),
+		zap.Bool("mutual", cfg.Mutual), zap.String("certPath", cfg.CertPath), zap.String("caPath", cfg.CAPath))
+	return cfg
+}
+
+// ServerOptions returns the grpc server options serving TLS, nothing if TLS isn't enabled
+func (c TLSConfig) ServerOptions() ([]grpc.ServerOption, error) {
+	if !c.Enabled {
+		return nil, nil
+	}
+	tlsConfig, err := c.serverTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(tlsConfig))}, nil
+}
+
+// DialOption returns the grpc dial option to connect the server, plaintext if TLS isn't enabled
+func (c TLSConfig) DialOption() (grpc.DialOption, error) {
+	if !c.Enabled {
+		return grpc.WithInsecure(), nil
+	}
+	tlsConfig, err := c.clientTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)), nil
+}
+
+func (c TLSConfig) serverTLSConfig() (*tls.Config, error) {
+	if c.CertPath == "" || c.KeyPath == "" {
+		return nil, errors.New("tls is enabled, but certPath or keyPath is empty")
+	}
+	if c.Mutual && c.CAPath == "" {
+		return nil, errors.New("mutual tls is enabled, but caPath is empty")
+	}
+	certs := newCertReloader(c.CertPath, c.KeyPath)
+	if _, err := certs.getCertificate(); err != nil {
+		return nil, err
+	}
+	var cas *caReloader
+	if c.Mutual {
+		cas = newCAReloader(c.CAPath)
+		if _, err := cas.getCertPool(); err != nil {
+			return nil, err
+		}
+	}
+
+	// a new config is built for every handshake, so rotated certificates take effect without restarting
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, err := certs.getCertificate()
+			if err != nil {
+				return nil, err
+			}
+			config := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				NextProtos:   []string{"h2"},
+			}
+			if cas != nil {
+				pool, err := cas.getCertPool()
+				if err != nil {
+					return nil, err
+				}
+				config.ClientCAs = pool
+				config.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+			return config, nil
+		},
+	}, nil
+}
+
+func (c TLSConfig) clientTLSConfig() (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: c.ServerName,
+	}
+	// the system pool is used to verify the server if caPath is empty, the pool is loaded
+	// again when the client reconnects
+	if c.CAPath != "" {
+		pool, err := newCAReloader(c.CAPath).getCertPool()
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = pool
+	}
+	if c.Mutual {
+		if c.CertPath == "" || c.KeyPath == "" {
+			return nil, errors.New("mutual tls is enabled, but certPath or keyPath is empty")
+		}
+		certs := newCertReloader(c.CertPath, c.KeyPath)
+		if _, err := certs.getCertificate(); err != nil {
+			return nil, err
+		}
+		config.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return certs.getCertificate()
+		}
+	}
+	return config, nil
+}
+
+// fileReloader tells whether any of the watched files changed since the last load
+type fileReloader struct {
+	paths     []string
+	checkedAt time.Time
+	modTimes  []time.Time
+}
+
+// changed returns true on the first call and whenever a file is modified, files are
+// checked at most once per certCheckInterval
+func (r *fileReloader) changed() (bool, error) {
+	now := time.Now()
+	if r.modTimes != nil && now.Sub(r.checkedAt) < certCheckInterval {
+		return false, nil
+	}
+	r.checkedAt = now
+	modTimes := make([]time.Time, len(r.paths))
+	for i, path := range r.paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return false, err
+		}
+		modTimes[i] = info.ModTime()
+	}
+	changed := r.modTimes == nil
+	for i := range modTimes {
+		if !changed && !modTimes[i].Equal(r.modTimes[i]) {
+			changed = true
+		}
+	}
+	r.modTimes = modTimes
+	return changed, nil
+}
+
+type certReloader struct {
+	certPath string
+	keyPath  string
+
+	mu    sync.Mutex
+	files fileReloader
+	cert  *tls.Certificate
+}
+
+func newCertReloader(certPath, keyPath string) *certReloader {
+	return &certReloader{
+		certPath: certPath,
+		keyPath:  keyPath,
+		files:    fileReloader{paths: []string{certPath, keyPath}},
+	}
+}
+
+// getCertificate returns the certificate, it's reloaded if the files were rotated.
+// The last good certificate is kept if the rotated files can't be loaded.
+func (r *certReloader) getCertificate() (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	changed, err := r.files.changed()
+	if err != nil && r.cert == nil {
+		return nil, err
+	}
+	if changed {
+		cert, err := tls.LoadX509KeyPair(r.certPath, r.keyPath)
+		if err != nil {
+			if r.cert == nil {
+				return nil, fmt.Errorf("load certificate %s failed: %w", r.certPath, err)
+			}
+			log.Warn("reload certificate failed, keep using the old one", zap.String("certPath", r.certPath), zap.Error(err))
+		} else {
+			if r.cert != nil {
+				log.Info("certificate reloaded", zap.String("certPath", r.certPath))
+			}
+			r.cert = &cert
+		}
+	}
+	return r.cert, nil
+}
+
+type caReloader struct {
+	caPath string
+
+	mu    sync.Mutex
+	files fileReloader
+	pool  *x509.CertPool
+}
+
+func newCAReloader(caPath string) *caReloader {
+	return &caReloader{
+		caPath: caPath,
+		files:  fileReloader{paths: []string{caPath}},
+	}
+}
+
+// getCertPool returns the CA pool, it's reloaded if the file was rotated
+func (r *caReloader) getCertPool() (*x509.CertPool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	changed, err := r.files.changed()
+	if err != nil && r.pool == nil {
+		return nil, err
+	}
+	if changed {
+		pool, err := loadCertPool(r.caPath)
+		if err != nil {
+			if r.pool == nil {
+				return nil, err
+			}
+			log.Warn("reload ca failed, keep using the old one", zap.String("caPath", r.caPath), zap.Error(err))
+		} else {
+			r.pool = pool
+		}
+	}
+	return r.pool, nil
+}
+
+func loadCertPool(caPath string) (*x509.CertPool, error) {
+	pem, err := ioutil.ReadFile(caPath)
+	if err != nil {
+		return nil, fmt.Errorf("read ca %s failed: %w", caPath, err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificate found in ca %s", caPath)
+	}
+	return pool, nil
+}
@@ -0,0 +1,224 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package grpcconfigs
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "milvus test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	assert.Nil(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.Nil(t, err)
+	return &testCA{
+		cert: cert,
+		key:  key,
+		pem:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// issue signs a certificate for 127.0.0.1 usable by both servers and clients, returns the PEM cert and key
+func (ca *testCA) issue(t *testing.T, serial int64) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "milvus"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	assert.Nil(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.Nil(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+func writeFile(t *testing.T, dir, name string, content []byte) string {
+	path := filepath.Join(dir, name)
+	err := ioutil.WriteFile(path, content, 0600)
+	assert.Nil(t, err)
+	return path
+}
+
+func startHealthServer(t *testing.T, cfg TLSConfig) (string, func()) {
+	opts, err := cfg.ServerOptions()
+	assert.Nil(t, err)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	server := grpc.NewServer(opts...)
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	go server.Serve(lis)
+	return lis.Addr().String(), server.Stop
+}
+
+func checkHealth(t *testing.T, addr string, cfg TLSConfig) error {
+	opt, err := cfg.DialOption()
+	assert.Nil(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, addr, opt, grpc.WithBlock())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	return err
+}
+
+func TestTLSConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "milvus_tls")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	ca := newTestCA(t)
+	caPath := writeFile(t, dir, "ca.pem", ca.pem)
+	certPEM, keyPEM := ca.issue(t, 2)
+	certPath := writeFile(t, dir, "cert.pem", certPEM)
+	keyPath := writeFile(t, dir, "key.pem", keyPEM)
+
+	t.Run("disabled", func(t *testing.T) {
+		cfg := TLSConfig{}
+		opts, err := cfg.ServerOptions()
+		assert.Nil(t, err)
+		assert.Empty(t, opts)
+
+		addr, stop := startHealthServer(t, cfg)
+		defer stop()
+		assert.Nil(t, checkHealth(t, addr, cfg))
+	})
+
+	t.Run("missing files", func(t *testing.T) {
+		_, err := TLSConfig{Enabled: true}.ServerOptions()
+		assert.NotNil(t, err)
+		_, err = TLSConfig{Enabled: true, Mutual: true, CertPath: certPath, KeyPath: keyPath}.ServerOptions()
+		assert.NotNil(t, err)
+		_, err = TLSConfig{Enabled: true, CertPath: certPath, KeyPath: filepath.Join(dir, "none.pem")}.ServerOptions()
+		assert.NotNil(t, err)
+		_, err = TLSConfig{Enabled: true, CAPath: filepath.Join(dir, "none.pem")}.DialOption()
+		assert.NotNil(t, err)
+	})
+
+	t.Run("one-way tls", func(t *testing.T) {
+		serverCfg := TLSConfig{Enabled: true, CertPath: certPath, KeyPath: keyPath}
+		addr, stop := startHealthServer(t, serverCfg)
+		defer stop()
+
+		assert.Nil(t, checkHealth(t, addr, TLSConfig{Enabled: true, CAPath: caPath}))
+		// the server certificate can't be verified without the ca
+		assert.NotNil(t, checkHealth(t, addr, TLSConfig{Enabled: true}))
+		assert.NotNil(t, checkHealth(t, addr, TLSConfig{}))
+	})
+
+	t.Run("mutual tls", func(t *testing.T) {
+		cfg := TLSConfig{Enabled: true, Mutual: true, CertPath: certPath, KeyPath: keyPath, CAPath: caPath}
+		addr, stop := startHealthServer(t, cfg)
+		defer stop()
+
+		assert.Nil(t, checkHealth(t, addr, cfg))
+		// clients without a certificate are rejected
+		assert.NotNil(t, checkHealth(t, addr, TLSConfig{Enabled: true, CAPath: caPath}))
+
+		// clients with a certificate from another ca are rejected
+		otherCA := newTestCA(t)
+		otherCert, otherKey := otherCA.issue(t, 3)
+		otherCfg := TLSConfig{
+			Enabled:  true,
+			Mutual:   true,
+			CertPath: writeFile(t, dir, "other_cert.pem", otherCert),
+			KeyPath:  writeFile(t, dir, "other_key.pem", otherKey),
+			CAPath:   caPath,
+		}
+		assert.NotNil(t, checkHealth(t, addr, otherCfg))
+	})
+
+	t.Run("cert reload", func(t *testing.T) {
+		reloader := newCertReloader(certPath, keyPath)
+		cert, err := reloader.getCertificate()
+		assert.Nil(t, err)
+		leaf, err := x509.ParseCertificate(cert.Certificate[0])
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), leaf.SerialNumber.Int64())
+
+		// rotate the certificate, it's picked up after the check interval
+		rotatedCert, rotatedKey := ca.issue(t, 4)
+		writeFile(t, dir, "cert.pem", rotatedCert)
+		writeFile(t, dir, "key.pem", rotatedKey)
+		modTime := time.Now().Add(time.Minute)
+		assert.Nil(t, os.Chtimes(certPath, modTime, modTime))
+		assert.Nil(t, os.Chtimes(keyPath, modTime, modTime))
+
+		cert, err = reloader.getCertificate()
+		assert.Nil(t, err)
+		leaf, err = x509.ParseCertificate(cert.Certificate[0])
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), leaf.SerialNumber.Int64())
+
+		reloader.files.checkedAt = time.Now().Add(-certCheckInterval)
+		cert, err = reloader.getCertificate()
+		assert.Nil(t, err)
+		leaf, err = x509.ParseCertificate(cert.Certificate[0])
+		assert.Nil(t, err)
+		assert.Equal(t, int64(4), leaf.SerialNumber.Int64())
+
+		// a broken rotation keeps the last good certificate
+		writeFile(t, dir, "cert.pem", []byte("broken"))
+		modTime = modTime.Add(time.Minute)
+		assert.Nil(t, os.Chtimes(certPath, modTime, modTime))
+		reloader.files.checkedAt = time.Now().Add(-certCheckInterval)
+		cert, err = reloader.getCertificate()
+		assert.Nil(t, err)
+		leaf, err = x509.ParseCertificate(cert.Certificate[0])
+		assert.Nil(t, err)
+		assert.Equal(t, int64(4), leaf.SerialNumber.Int64())
+	})
+}
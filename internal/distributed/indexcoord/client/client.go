@@ -92,10 +92,14 @@ func (c *Client) connect(retryOptions ...retry.Option) error {
 		}
 		opts := trace.GetInterceptorOpts()
 		log.Debug("IndexCoordClient try connect ", zap.String("address", c.addr))
+		tlsOpt, err := Params.TLS.DialOption()
+		if err != nil {
+			return retry.NoRetryError(err)
+		}
 		ctx, cancel := context.WithTimeout(c.ctx, 15*time.Second)
 		defer cancel()
 		conn, err := grpc.DialContext(ctx, c.addr,
-			grpc.WithInsecure(), grpc.WithBlock(),
+			tlsOpt, grpc.WithBlock(),
 			grpc.WithDefaultCallOptions(
 				grpc.MaxCallRecvMsgSize(Params.ClientMaxRecvSize),
 				grpc.MaxCallSendMsgSize(Params.ClientMaxSendSize)),
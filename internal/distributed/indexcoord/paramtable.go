@@ -30,6 +30,8 @@ type ParamTable struct {
 
 	ServerMaxSendSize int
 	ServerMaxRecvSize int
+
+	TLS grpcconfigs.TLSConfig
 }
 
 var Params ParamTable
@@ -48,6 +50,7 @@ func (pt *ParamTable) initParams() {
 
 	pt.initServerMaxSendSize()
 	pt.initServerMaxRecvSize()
+	pt.initTLS()
 }
 
 func (pt *ParamTable) initServicePort() {
@@ -107,3 +110,7 @@ func (pt *ParamTable) initServerMaxRecvSize() {
 	log.Debug("initServerMaxRecvSize",
 		zap.Int("indexCoord.grpc.serverMaxRecvSize", pt.ServerMaxRecvSize))
 }
+
+func (pt *ParamTable) initTLS() {
+	pt.TLS = grpcconfigs.InternalTLSConfig(&pt.BaseTable)
+}
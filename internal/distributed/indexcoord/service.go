@@ -166,11 +166,19 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	defer cancel()
 
 	opts := trace.GetInterceptorOpts()
-	s.grpcServer = grpc.NewServer(
+	tlsOpts, err := Params.TLS.ServerOptions()
+	if err != nil {
+		log.Error("GrpcServer:failed to load tls config", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+	grpcOpts := []grpc.ServerOption{
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(ot.UnaryServerInterceptor(opts...)),
-		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)))
+		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)),
+	}
+	s.grpcServer = grpc.NewServer(append(grpcOpts, tlsOpts...)...)
 	indexpb.RegisterIndexCoordServer(s.grpcServer, s)
 
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
@@ -64,10 +64,14 @@ func (c *Client) connect(retryOptions ...retry.Option) error {
 	connectGrpcFunc := func() error {
 		opts := trace.GetInterceptorOpts()
 		log.Debug("ProxyClient try connect ", zap.String("address", c.addr))
+		tlsOpt, err := Params.TLS.DialOption()
+		if err != nil {
+			return retry.NoRetryError(err)
+		}
 		ctx, cancel := context.WithTimeout(c.ctx, 15*time.Second)
 		defer cancel()
 		conn, err := grpc.DialContext(ctx, c.addr,
-			grpc.WithInsecure(), grpc.WithBlock(),
+			tlsOpt, grpc.WithBlock(),
 			grpc.WithDefaultCallOptions(
 				grpc.MaxCallRecvMsgSize(Params.ClientMaxRecvSize),
 				grpc.MaxCallSendMsgSize(Params.ClientMaxSendSize)),
@@ -162,3 +166,10 @@ func (c *Client) ReleaseDQLMessageStream(ctx context.Context, req *proxypb.Relea
 	})
 	return ret.(*commonpb.Status), err
 }
+
+func (c *Client) RefreshPolicyInfoCache(ctx context.Context, req *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.RefreshPolicyInfoCache(ctx, req)
+	})
+	return ret.(*commonpb.Status), err
+}
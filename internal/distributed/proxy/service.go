@@ -17,6 +17,7 @@ import (
 	"io"
 	"net"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -28,6 +29,8 @@ import (
 	grpcquerycoordclient "github.com/milvus-io/milvus/internal/distributed/querycoord/client"
 	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
@@ -43,6 +46,8 @@ import (
 
 const (
 	GRPCMaxMagSize = 2 << 30
+
+	milvusServicePrefix = "/milvus.proto.milvus.MilvusService/"
 )
 
 type Server struct {
@@ -93,14 +98,24 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	defer cancel()
 
 	opts := trace.GetInterceptorOpts()
-	s.grpcServer = grpc.NewServer(
+	tlsOpts, err := Params.TLS.ServerOptions()
+	if err != nil {
+		log.Error("GrpcServer:failed to load tls config", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+	grpcOpts := []grpc.ServerOption{
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.MaxRecvMsgSize(GRPCMaxMagSize),
-		grpc.UnaryInterceptor(
-			grpc_opentracing.UnaryServerInterceptor(opts...)),
-		grpc.StreamInterceptor(
-			grpc_opentracing.StreamServerInterceptor(opts...)))
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+			grpc_opentracing.UnaryServerInterceptor(opts...),
+			grpc_auth.UnaryServerInterceptor(proxy.AuthenticationInterceptor))),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			grpc_opentracing.StreamServerInterceptor(opts...),
+			grpc_auth.StreamServerInterceptor(proxy.AuthenticationInterceptor))),
+	}
+	s.grpcServer = grpc.NewServer(append(grpcOpts, tlsOpts...)...)
 	proxypb.RegisterProxyServer(s.grpcServer, s)
 	milvuspb.RegisterMilvusServiceServer(s.grpcServer, s)
 
@@ -111,6 +126,15 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 
 }
 
+// AuthFuncOverride only authenticates the requests of milvus service, the internal requests
+// from coordinators are not authenticated
+func (s *Server) AuthFuncOverride(ctx context.Context, fullMethodName string) (context.Context, error) {
+	if !strings.HasPrefix(fullMethodName, milvusServicePrefix) {
+		return ctx, nil
+	}
+	return proxy.AuthenticationInterceptor(ctx)
+}
+
 func (s *Server) Run() error {
 
 	if err := s.init(); err != nil {
@@ -287,6 +311,10 @@ func (s *Server) ReleaseDQLMessageStream(ctx context.Context, request *proxypb.R
 	return s.proxy.ReleaseDQLMessageStream(ctx, request)
 }
 
+func (s *Server) RefreshPolicyInfoCache(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+	return s.proxy.RefreshPolicyInfoCache(ctx, request)
+}
+
 func (s *Server) CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
 	return s.proxy.CreateCollection(ctx, request)
 }
@@ -319,6 +347,50 @@ func (s *Server) ShowCollections(ctx context.Context, request *milvuspb.ShowColl
 	return s.proxy.ShowCollections(ctx, request)
 }
 
+func (s *Server) CreateDatabase(ctx context.Context, request *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateDatabase(ctx, request)
+}
+
+func (s *Server) DropDatabase(ctx context.Context, request *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return s.proxy.DropDatabase(ctx, request)
+}
+
+func (s *Server) ListDatabases(ctx context.Context, request *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return s.proxy.ListDatabases(ctx, request)
+}
+
+func (s *Server) CreateCredential(ctx context.Context, request *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateCredential(ctx, request)
+}
+
+func (s *Server) DeleteCredential(ctx context.Context, request *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	return s.proxy.DeleteCredential(ctx, request)
+}
+
+func (s *Server) ListCredUsers(ctx context.Context, request *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	return s.proxy.ListCredUsers(ctx, request)
+}
+
+func (s *Server) CreateRole(ctx context.Context, request *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateRole(ctx, request)
+}
+
+func (s *Server) DropRole(ctx context.Context, request *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	return s.proxy.DropRole(ctx, request)
+}
+
+func (s *Server) OperateUserRole(ctx context.Context, request *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	return s.proxy.OperateUserRole(ctx, request)
+}
+
+func (s *Server) OperatePrivilege(ctx context.Context, request *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	return s.proxy.OperatePrivilege(ctx, request)
+}
+
+func (s *Server) SelectGrant(ctx context.Context, request *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	return s.proxy.SelectGrant(ctx, request)
+}
+
 func (s *Server) CreatePartition(ctx context.Context, request *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	return s.proxy.CreatePartition(ctx, request)
 }
@@ -347,6 +419,10 @@ func (s *Server) ShowPartitions(ctx context.Context, request *milvuspb.ShowParti
 	return s.proxy.ShowPartitions(ctx, request)
 }
 
+func (s *Server) GetLoadingProgress(ctx context.Context, request *milvuspb.GetLoadingProgressRequest) (*milvuspb.GetLoadingProgressResponse, error) {
+	return s.proxy.GetLoadingProgress(ctx, request)
+}
+
 func (s *Server) CreateIndex(ctx context.Context, request *milvuspb.CreateIndexRequest) (*commonpb.Status, error) {
 	return s.proxy.CreateIndex(ctx, request)
 }
@@ -373,10 +449,18 @@ func (s *Server) Insert(ctx context.Context, request *milvuspb.InsertRequest) (*
 	return s.proxy.Insert(ctx, request)
 }
 
+func (s *Server) InsertRows(ctx context.Context, request *milvuspb.InsertRowsRequest) (*milvuspb.MutationResult, error) {
+	return s.proxy.InsertRows(ctx, request)
+}
+
 func (s *Server) Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error) {
 	return s.proxy.Delete(ctx, request)
 }
 
+func (s *Server) Upsert(ctx context.Context, request *milvuspb.UpsertRequest) (*milvuspb.MutationResult, error) {
+	return s.proxy.Upsert(ctx, request)
+}
+
 func (s *Server) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
 	return s.proxy.Search(ctx, request)
 }
@@ -401,6 +485,14 @@ func (s *Server) GetPersistentSegmentInfo(ctx context.Context, request *milvuspb
 	return s.proxy.GetPersistentSegmentInfo(ctx, request)
 }
 
+func (s *Server) GetFlushState(ctx context.Context, request *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
+	return s.proxy.GetFlushState(ctx, request)
+}
+
+func (s *Server) SubscribeChanges(request *milvuspb.SubscribeChangesRequest, stream milvuspb.MilvusService_SubscribeChangesServer) error {
+	return s.proxy.SubscribeChanges(request, stream)
+}
+
 func (s *Server) GetQuerySegmentInfo(ctx context.Context, request *milvuspb.GetQuerySegmentInfoRequest) (*milvuspb.GetQuerySegmentInfoResponse, error) {
 	return s.proxy.GetQuerySegmentInfo(ctx, request)
 
@@ -92,10 +92,14 @@ func (c *Client) connect(retryOptions ...retry.Option) error {
 		}
 		opts := trace.GetInterceptorOpts()
 		log.Debug("QueryCoordClient try reconnect ", zap.String("address", c.addr))
+		tlsOpt, err := Params.TLS.DialOption()
+		if err != nil {
+			return retry.NoRetryError(err)
+		}
 		ctx, cancel := context.WithTimeout(c.ctx, 15*time.Second)
 		defer cancel()
 		conn, err := grpc.DialContext(ctx, c.addr,
-			grpc.WithInsecure(), grpc.WithBlock(),
+			tlsOpt, grpc.WithBlock(),
 			grpc.WithDefaultCallOptions(
 				grpc.MaxCallRecvMsgSize(Params.ClientMaxRecvSize),
 				grpc.MaxCallSendMsgSize(Params.ClientMaxSendSize)),
@@ -254,3 +258,38 @@ func (c *Client) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest
 	})
 	return ret.(*milvuspb.GetMetricsResponse), err
 }
+
+func (c *Client) GetReplicas(ctx context.Context, req *querypb.GetReplicasRequest) (*querypb.GetReplicasResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.GetReplicas(ctx, req)
+	})
+	return ret.(*querypb.GetReplicasResponse), err
+}
+
+func (c *Client) GetLoadingProgress(ctx context.Context, req *querypb.GetLoadingProgressRequest) (*querypb.GetLoadingProgressResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.GetLoadingProgress(ctx, req)
+	})
+	return ret.(*querypb.GetLoadingProgressResponse), err
+}
+
+func (c *Client) GetShardLeaders(ctx context.Context, req *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.GetShardLeaders(ctx, req)
+	})
+	return ret.(*querypb.GetShardLeadersResponse), err
+}
+
+func (c *Client) TransferNode(ctx context.Context, req *querypb.TransferNodeRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.TransferNode(ctx, req)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *Client) ListResourceGroups(ctx context.Context, req *querypb.ListResourceGroupsRequest) (*querypb.ListResourceGroupsResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.ListResourceGroups(ctx, req)
+	})
+	return ret.(*querypb.ListResourceGroupsResponse), err
+}
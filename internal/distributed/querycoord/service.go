@@ -21,6 +21,7 @@ import (
 
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	dsc "github.com/milvus-io/milvus/internal/distributed/datacoord/client"
+	isc "github.com/milvus-io/milvus/internal/distributed/indexcoord/client"
 	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
@@ -49,8 +50,9 @@ type Server struct {
 
 	msFactory msgstream.Factory
 
-	dataCoord *dsc.Client
-	rootCoord *rcc.GrpcClient
+	dataCoord  *dsc.Client
+	rootCoord  *rcc.GrpcClient
+	indexCoord *isc.Client
 
 	closer io.Closer
 }
@@ -161,6 +163,32 @@ func (s *Server) init() error {
 	}
 	log.Debug("QueryCoord report DataCoord ready")
 
+	// --- IndexCoord client ---
+	log.Debug("QueryCoord try to new IndexCoord client")
+	indexCoord, err := isc.NewClient(s.loopCtx, qc.Params.MetaRootPath, qc.Params.EtcdEndpoints)
+	if err != nil {
+		log.Debug("QueryCoord try to new IndexCoord client failed", zap.Error(err))
+		panic(err)
+	}
+	if err = indexCoord.Init(); err != nil {
+		log.Debug("QueryCoord IndexCoordClient Init failed", zap.Error(err))
+		panic(err)
+	}
+	if err = indexCoord.Start(); err != nil {
+		log.Debug("QueryCoord IndexCoordClient Start failed", zap.Error(err))
+		panic(err)
+	}
+	log.Debug("QueryCoord try to wait for IndexCoord ready")
+	err = funcutil.WaitForComponentHealthy(s.loopCtx, indexCoord, "IndexCoord", 1000000, time.Millisecond*200)
+	if err != nil {
+		log.Debug("QueryCoord wait for IndexCoord ready failed", zap.Error(err))
+		panic(err)
+	}
+	if err := s.SetIndexCoord(indexCoord); err != nil {
+		panic(err)
+	}
+	log.Debug("QueryCoord report IndexCoord ready")
+
 	s.queryCoord.UpdateStateCode(internalpb.StateCode_Initializing)
 	log.Debug("QueryCoord", zap.Any("State", internalpb.StateCode_Initializing))
 	if err := s.queryCoord.Init(); err != nil {
@@ -185,13 +213,21 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	defer cancel()
 
 	opts := trace.GetInterceptorOpts()
-	s.grpcServer = grpc.NewServer(
+	tlsOpts, err := Params.TLS.ServerOptions()
+	if err != nil {
+		log.Error("GrpcServer:failed to load tls config", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+	grpcOpts := []grpc.ServerOption{
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
 		grpc.UnaryInterceptor(
 			grpc_opentracing.UnaryServerInterceptor(opts...)),
 		grpc.StreamInterceptor(
-			grpc_opentracing.StreamServerInterceptor(opts...)))
+			grpc_opentracing.StreamServerInterceptor(opts...)),
+	}
+	s.grpcServer = grpc.NewServer(append(grpcOpts, tlsOpts...)...)
 	querypb.RegisterQueryCoordServer(s.grpcServer, s)
 
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
@@ -228,6 +264,11 @@ func (s *Server) SetDataCoord(d types.DataCoord) error {
 	return nil
 }
 
+func (s *Server) SetIndexCoord(d types.IndexCoord) error {
+	s.queryCoord.SetIndexCoord(d)
+	return nil
+}
+
 func (s *Server) GetComponentStates(ctx context.Context, req *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error) {
 	return s.queryCoord.GetComponentStates(ctx)
 }
@@ -279,3 +320,23 @@ func (s *Server) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfo
 func (s *Server) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return s.queryCoord.GetMetrics(ctx, req)
 }
+
+func (s *Server) GetReplicas(ctx context.Context, req *querypb.GetReplicasRequest) (*querypb.GetReplicasResponse, error) {
+	return s.queryCoord.GetReplicas(ctx, req)
+}
+
+func (s *Server) GetLoadingProgress(ctx context.Context, req *querypb.GetLoadingProgressRequest) (*querypb.GetLoadingProgressResponse, error) {
+	return s.queryCoord.GetLoadingProgress(ctx, req)
+}
+
+func (s *Server) GetShardLeaders(ctx context.Context, req *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error) {
+	return s.queryCoord.GetShardLeaders(ctx, req)
+}
+
+func (s *Server) TransferNode(ctx context.Context, req *querypb.TransferNodeRequest) (*commonpb.Status, error) {
+	return s.queryCoord.TransferNode(ctx, req)
+}
+
+func (s *Server) ListResourceGroups(ctx context.Context, req *querypb.ListResourceGroupsRequest) (*querypb.ListResourceGroupsResponse, error) {
+	return s.queryCoord.ListResourceGroups(ctx, req)
+}
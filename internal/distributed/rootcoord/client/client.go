@@ -100,10 +100,14 @@ func (c *GrpcClient) connect(retryOptions ...retry.Option) error {
 		}
 		opts := trace.GetInterceptorOpts()
 		log.Debug("RootCoordClient try reconnect ", zap.String("address", c.addr))
+		tlsOpt, err := Params.TLS.DialOption()
+		if err != nil {
+			return retry.NoRetryError(err)
+		}
 		ctx, cancel := context.WithTimeout(c.ctx, 15*time.Second)
 		defer cancel()
 		conn, err := grpc.DialContext(ctx, c.addr,
-			grpc.WithInsecure(), grpc.WithBlock(),
+			tlsOpt, grpc.WithBlock(),
 			grpc.WithDefaultCallOptions(
 				grpc.MaxCallRecvMsgSize(Params.ClientMaxRecvSize),
 				grpc.MaxCallSendMsgSize(Params.ClientMaxSendSize)),
@@ -227,6 +231,91 @@ func (c *GrpcClient) ShowCollections(ctx context.Context, in *milvuspb.ShowColle
 	})
 	return ret.(*milvuspb.ShowCollectionsResponse), err
 }
+
+func (c *GrpcClient) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.CreateDatabase(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.DropDatabase(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.ListDatabases(ctx, in)
+	})
+	return ret.(*milvuspb.ListDatabasesResponse), err
+}
+
+func (c *GrpcClient) CreateCredential(ctx context.Context, in *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.CreateCredential(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.DeleteCredential(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) ListCredUsers(ctx context.Context, in *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.ListCredUsers(ctx, in)
+	})
+	return ret.(*milvuspb.ListCredUsersResponse), err
+}
+
+func (c *GrpcClient) CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.CreateRole(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.DropRole(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) OperateUserRole(ctx context.Context, in *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.OperateUserRole(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.OperatePrivilege(ctx, in)
+	})
+	return ret.(*commonpb.Status), err
+}
+
+func (c *GrpcClient) SelectGrant(ctx context.Context, in *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.SelectGrant(ctx, in)
+	})
+	return ret.(*milvuspb.SelectGrantResponse), err
+}
+
+func (c *GrpcClient) ListPolicy(ctx context.Context, in *rootcoordpb.ListPolicyRequest) (*rootcoordpb.ListPolicyResponse, error) {
+	ret, err := c.recall(func() (interface{}, error) {
+		return c.grpcClient.ListPolicy(ctx, in)
+	})
+	return ret.(*rootcoordpb.ListPolicyResponse), err
+}
+
 func (c *GrpcClient) CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	ret, err := c.recall(func() (interface{}, error) {
 		return c.grpcClient.CreatePartition(ctx, in)
@@ -37,6 +37,8 @@ type ParamTable struct {
 
 	ServerMaxSendSize int
 	ServerMaxRecvSize int
+
+	TLS grpcconfigs.TLSConfig
 }
 
 func (p *ParamTable) Init() {
@@ -54,6 +56,7 @@ func (p *ParamTable) Init() {
 
 		p.initServerMaxSendSize()
 		p.initServerMaxRecvSize()
+		p.initTLS()
 	})
 }
 
@@ -138,3 +141,7 @@ func (p *ParamTable) initServerMaxRecvSize() {
 	log.Debug("initServerMaxRecvSize",
 		zap.Int("rootCoord.grpc.serverMaxRecvSize", p.ServerMaxRecvSize))
 }
+
+func (p *ParamTable) initTLS() {
+	p.TLS = grpcconfigs.InternalTLSConfig(&p.BaseTable)
+}
@@ -313,6 +313,17 @@ func TestGrpcService(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
 	})
 
+	t.Run("create database", func(t *testing.T) {
+		status, err := svr.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_CreateDatabase,
+			},
+			DbName: dbName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	})
+
 	t.Run("create collection", func(t *testing.T) {
 		schema := schemapb.CollectionSchema{
 			Name:   collName,
@@ -452,7 +463,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("describe collection", func(t *testing.T) {
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.DescribeCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -503,7 +514,7 @@ func TestGrpcService(t *testing.T) {
 		status, err := cli.CreatePartition(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(collMeta.PartitionIDs))
 		partName2, err := core.MetaTable.GetPartitionNameByID(collMeta.ID, collMeta.PartitionIDs[1], 0)
@@ -531,7 +542,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("show partition", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.ShowPartitionsRequest{
 			Base: &commonpb.MsgBase{
@@ -552,7 +563,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("show segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		partID := coll.PartitionIDs[1]
 		_, err = core.MetaTable.GetPartitionNameByID(coll.ID, partID, 0)
@@ -597,13 +608,13 @@ func TestGrpcService(t *testing.T) {
 				},
 			},
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Zero(t, len(collMeta.FieldIndexes))
 		rsp, err := cli.CreateIndex(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.FieldIndexes))
 
@@ -619,7 +630,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("describe segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 
 		req := &milvuspb.DescribeSegmentRequest{
@@ -659,7 +670,7 @@ func TestGrpcService(t *testing.T) {
 	})
 
 	t.Run("flush segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		partID := coll.PartitionIDs[1]
 		_, err = core.MetaTable.GetPartitionNameByID(coll.ID, partID, 0)
@@ -720,7 +731,7 @@ func TestGrpcService(t *testing.T) {
 			FieldName:      fieldName,
 			IndexName:      rootcoord.Params.DefaultIndexName,
 		}
-		_, idx, err := core.MetaTable.GetIndexByName(collName, rootcoord.Params.DefaultIndexName)
+		_, idx, err := core.MetaTable.GetIndexByName("", collName, rootcoord.Params.DefaultIndexName)
 		assert.Nil(t, err)
 		assert.Equal(t, len(idx), 1)
 		rsp, err := cli.DropIndex(ctx, req)
@@ -748,7 +759,7 @@ func TestGrpcService(t *testing.T) {
 		status, err := cli.DropPartition(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.PartitionIDs))
 		partName, err := core.MetaTable.GetPartitionNameByID(collMeta.ID, collMeta.PartitionIDs[0], 0)
@@ -62,6 +62,7 @@ type IndexCoord struct {
 
 	sched   *TaskScheduler
 	session *sessionutil.Session
+	liveCh  <-chan bool
 
 	eventChan <-chan *sessionutil.SessionEvent
 
@@ -93,10 +94,11 @@ func NewIndexCoord(ctx context.Context) (*IndexCoord, error) {
 	return i, nil
 }
 
-// Register register index service at etcd
+// Register register index service at etcd, a standby index service blocks here until the active one quits
 func (i *IndexCoord) Register() error {
 	i.session = sessionutil.NewSession(i.loopCtx, Params.MetaRootPath, Params.EtcdEndpoints)
-	i.session.Init(typeutil.IndexCoordRole, Params.Address, true)
+	i.session.SetEnableActiveStandBy(Params.EnableActiveStandby)
+	i.liveCh = i.session.Init(typeutil.IndexCoordRole, Params.Address, true)
 	return nil
 }
 
@@ -209,6 +211,9 @@ func (i *IndexCoord) Start() error {
 	i.loopWg.Add(1)
 	go i.watchMetaLoop()
 
+	i.loopWg.Add(1)
+	go i.watchLivenessLoop()
+
 	i.sched.Start()
 	// Start callbacks
 	for _, cb := range i.startCallbacks {
@@ -232,6 +237,28 @@ func (i *IndexCoord) UpdateStateCode(code internalpb.StateCode) {
 	i.stateCode.Store(code)
 }
 
+// watchLivenessLoop stops the index service once its session expires, so that a standby can take over
+func (i *IndexCoord) watchLivenessLoop() {
+	defer i.loopWg.Done()
+	for {
+		select {
+		case <-i.loopCtx.Done():
+			return
+		case _, ok := <-i.liveCh:
+			if ok {
+				continue
+			}
+			if i.loopCtx.Err() != nil {
+				// the session is closed by stopping
+				return
+			}
+			log.Error("IndexCoord disconnect with etcd, stop IndexCoord")
+			go i.Stop()
+			return
+		}
+	}
+}
+
 func (i *IndexCoord) isHealthy() bool {
 	code := i.stateCode.Load().(internalpb.StateCode)
 	return code == internalpb.StateCode_Healthy
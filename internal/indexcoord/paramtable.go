@@ -27,6 +27,8 @@ type ParamTable struct {
 	Address string
 	Port    int
 
+	EnableActiveStandby bool
+
 	RootCoordAddress string
 
 	EtcdEndpoints []string
@@ -58,6 +60,7 @@ func (pt *ParamTable) Init() {
 		pt.initMinIOSecretAccessKey()
 		pt.initMinIOUseSSL()
 		pt.initMinioBucketName()
+		pt.initEnableActiveStandby()
 	})
 }
 
@@ -169,3 +172,7 @@ func (pt *ParamTable) initLogCfg() {
 		pt.Log.File.Filename = ""
 	}
 }
+
+func (pt *ParamTable) initEnableActiveStandby() {
+	pt.EnableActiveStandby = pt.ParseBool("indexCoord.enableActiveStandby", false)
+}
@@ -86,12 +86,13 @@ func (bt *BaseTask) Notify(err error) {
 
 type IndexBuildTask struct {
 	BaseTask
-	index     Index
-	kv        kv.BaseKV
-	etcdKV    *etcdkv.EtcdKV
-	savePaths []string
-	req       *indexpb.CreateIndexRequest
-	nodeID    UniqueID
+	index          Index
+	kv             kv.BaseKV
+	etcdKV         *etcdkv.EtcdKV
+	savePaths      []string
+	serializedSize int64
+	req            *indexpb.CreateIndexRequest
+	nodeID         UniqueID
 }
 
 func (it *IndexBuildTask) Ctx() context.Context {
@@ -151,6 +152,7 @@ func (it *IndexBuildTask) checkIndexMeta(ctx context.Context, pre bool) error {
 			return nil
 		}
 		indexMeta.IndexFilePaths = it.savePaths
+		indexMeta.SerializedSize = it.serializedSize
 		indexMeta.State = commonpb.IndexState_Finished
 		if it.err != nil {
 			log.Debug("IndexNode CreateIndex Failed", zap.Int64("IndexBuildID", indexMeta.IndexBuildID), zap.Any("err", err))
@@ -353,6 +355,10 @@ func (it *IndexBuildTask) Execute(ctx context.Context) error {
 		}
 
 		it.savePaths = make([]string, len(serializedIndexBlobs))
+		it.serializedSize = 0
+		for _, blob := range serializedIndexBlobs {
+			it.serializedSize += int64(len(blob.Value))
+		}
 		saveIndexFile := func(idx int) error {
 			blob := serializedIndexBlobs[idx]
 			key, value := blob.Key, blob.Value
@@ -91,6 +91,15 @@ func (kv *MinIOKV) Exist(key string) bool {
 	return err == nil
 }
 
+// GetObjectSize returns the size in bytes of the object stored with the key
+func (kv *MinIOKV) GetObjectSize(key string) (int64, error) {
+	info, err := kv.minioClient.StatObject(kv.ctx, kv.bucketName, key, minio.StatObjectOptions{})
+	if err != nil {
+		return 0, err
+	}
+	return info.Size, nil
+}
+
 func (kv *MinIOKV) LoadWithPrefix(key string) ([]string, []string, error) {
 	objects := kv.minioClient.ListObjects(kv.ctx, kv.bucketName, minio.ListObjectsOptions{Prefix: key})
 
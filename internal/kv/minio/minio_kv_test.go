@@ -123,6 +123,28 @@ func TestMinIOKV_MultiSave(t *testing.T) {
 	assert.Equal(t, val, "123")
 }
 
+func TestMinIOKV_GetObjectSize(t *testing.T) {
+	Params.Init()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bucketName := "fantastic-tech-test"
+	MinIOKV, err := newMinIOKVClient(ctx, bucketName)
+	assert.Nil(t, err)
+
+	defer MinIOKV.RemoveWithPrefix("")
+
+	err = MinIOKV.Save("key_1", "12345")
+	assert.Nil(t, err)
+
+	size, err := MinIOKV.GetObjectSize("key_1")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), size)
+
+	_, err = MinIOKV.GetObjectSize("key_not_exist")
+	assert.NotNil(t, err)
+}
+
 func TestMinIOKV_Remove(t *testing.T) {
 	Params.Init()
 
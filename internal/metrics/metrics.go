@@ -628,12 +628,22 @@ var (
 			Name:      "watch_dm_channels_total",
 			Help:      "Counter of watch dm channel",
 		}, []string{"type"})
+
+	// DataNodeInsertBufferBytes records the bytes buffered in the insert buffer of each vchannel
+	DataNodeInsertBufferBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: subSystemDataNode,
+			Name:      "insert_buffer_bytes",
+			Help:      "Bytes of the data buffered in the insert buffer of each vchannel",
+		}, []string{"channel_name"})
 )
 
 //RegisterDataNode register DataNode metrics
 func RegisterDataNode() {
 	prometheus.Register(DataNodeFlushSegmentsCounter)
 	prometheus.Register(DataNodeWatchDmChannelsCounter)
+	prometheus.Register(DataNodeInsertBufferBytes)
 }
 
 //RegisterIndexCoord register IndexCoord metrics
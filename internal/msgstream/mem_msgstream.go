@@ -63,6 +63,10 @@ func (mms *MemMsgStream) Close() {
 	mms.wait.Wait()
 }
 
+// Unsubscribe does nothing, the consumer groups are destroyed on Close
+func (mms *MemMsgStream) Unsubscribe() {
+}
+
 func (mms *MemMsgStream) SetRepackFunc(repackFunc RepackFunc) {
 	mms.repackFunc = repackFunc
 }
@@ -171,6 +171,16 @@ func (ms *mqMsgStream) Close() {
 	}
 }
 
+func (ms *mqMsgStream) Unsubscribe() {
+	ms.consumerLock.Lock()
+	defer ms.consumerLock.Unlock()
+	for _, consumer := range ms.consumers {
+		if consumer != nil {
+			consumer.Unsubscribe()
+		}
+	}
+}
+
 func (ms *mqMsgStream) ComputeProduceChannelIndexes(tsMsgs []TsMsg) [][]int32 {
 	if len(tsMsgs) <= 0 {
 		return nil
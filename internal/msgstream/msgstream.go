@@ -46,6 +46,8 @@ type MsgStream interface {
 	Broadcast(*MsgPack) error
 	Consume() *MsgPack
 	Seek(offset []*MsgPosition) error
+	// Unsubscribe removes the subscriptions of the consumers, it must be called before Close
+	Unsubscribe()
 }
 
 type Factory interface {
@@ -29,6 +29,9 @@ func (ms *SimpleMsgStream) Start() {
 func (ms *SimpleMsgStream) Close() {
 }
 
+func (ms *SimpleMsgStream) Unsubscribe() {
+}
+
 func (ms *SimpleMsgStream) Chan() <-chan *MsgPack {
 	return ms.msgChan
 }
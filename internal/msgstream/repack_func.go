@@ -106,6 +106,7 @@ func DeleteRepackFunc(tsMsgs []TsMsg, hashKeys [][]int32) (map[int32]*MsgPack, e
 					SourceID:  deleteRequest.Base.SourceID,
 				},
 				CollectionName: deleteRequest.CollectionName,
+				CollectionID:   deleteRequest.CollectionID,
 				ChannelID:      deleteRequest.ChannelID,
 				Timestamps:     []uint64{deleteRequest.Timestamps[index]},
 				PrimaryKeys:    []int64{deleteRequest.PrimaryKeys[index]},
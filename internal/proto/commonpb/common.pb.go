@@ -49,6 +49,7 @@ const (
 	ErrorCode_OutOfMemory           ErrorCode = 24
 	ErrorCode_IndexNotExist         ErrorCode = 25
 	ErrorCode_EmptyCollection       ErrorCode = 26
+	ErrorCode_RateLimit             ErrorCode = 27
 	// internal error code.
 	ErrorCode_DDRequestRace ErrorCode = 1000
 )
@@ -80,6 +81,7 @@ var ErrorCode_name = map[int32]string{
 	24:   "OutOfMemory",
 	25:   "IndexNotExist",
 	26:   "EmptyCollection",
+	27:   "RateLimit",
 	1000: "DDRequestRace",
 }
 
@@ -110,6 +112,7 @@ var ErrorCode_value = map[string]int32{
 	"OutOfMemory":           24,
 	"IndexNotExist":         25,
 	"EmptyCollection":       26,
+	"RateLimit":             27,
 	"DDRequestRace":         1000,
 }
 
@@ -205,6 +208,11 @@ const (
 	MsgType_GetSystemConfigs   MsgType = 105
 	MsgType_LoadCollection     MsgType = 106
 	MsgType_ReleaseCollection  MsgType = 107
+	MsgType_GetLoadingProgress MsgType = 108
+	// DEFINITION REQUESTS: DATABASE
+	MsgType_CreateDatabase MsgType = 150
+	MsgType_DropDatabase   MsgType = 151
+	MsgType_ListDatabases  MsgType = 152
 	// DEFINITION REQUESTS: PARTITION
 	MsgType_CreatePartition   MsgType = 200
 	MsgType_DropPartition     MsgType = 201
@@ -253,6 +261,18 @@ const (
 	MsgType_SegmentStatistics MsgType = 1206
 	MsgType_SegmentFlushDone  MsgType = 1207
 	MsgType_DataNodeTt        MsgType = 1208
+	// CREDENTIALS
+	MsgType_CreateCredential  MsgType = 1500
+	MsgType_DeleteCredential  MsgType = 1501
+	MsgType_ListCredUsernames MsgType = 1502
+	// RBAC
+	MsgType_CreateRole             MsgType = 1600
+	MsgType_DropRole               MsgType = 1601
+	MsgType_OperateUserRole        MsgType = 1602
+	MsgType_OperatePrivilege       MsgType = 1603
+	MsgType_SelectGrant            MsgType = 1604
+	MsgType_ListPolicy             MsgType = 1605
+	MsgType_RefreshPolicyInfoCache MsgType = 1606
 )
 
 var MsgType_name = map[int32]string{
@@ -265,6 +285,10 @@ var MsgType_name = map[int32]string{
 	105:  "GetSystemConfigs",
 	106:  "LoadCollection",
 	107:  "ReleaseCollection",
+	108:  "GetLoadingProgress",
+	150:  "CreateDatabase",
+	151:  "DropDatabase",
+	152:  "ListDatabases",
 	200:  "CreatePartition",
 	201:  "DropPartition",
 	202:  "HasPartition",
@@ -306,6 +330,16 @@ var MsgType_name = map[int32]string{
 	1206: "SegmentStatistics",
 	1207: "SegmentFlushDone",
 	1208: "DataNodeTt",
+	1500: "CreateCredential",
+	1501: "DeleteCredential",
+	1502: "ListCredUsernames",
+	1600: "CreateRole",
+	1601: "DropRole",
+	1602: "OperateUserRole",
+	1603: "OperatePrivilege",
+	1604: "SelectGrant",
+	1605: "ListPolicy",
+	1606: "RefreshPolicyInfoCache",
 }
 
 var MsgType_value = map[string]int32{
@@ -318,6 +352,10 @@ var MsgType_value = map[string]int32{
 	"GetSystemConfigs":        105,
 	"LoadCollection":          106,
 	"ReleaseCollection":       107,
+	"GetLoadingProgress":      108,
+	"CreateDatabase":          150,
+	"DropDatabase":            151,
+	"ListDatabases":           152,
 	"CreatePartition":         200,
 	"DropPartition":           201,
 	"HasPartition":            202,
@@ -359,6 +397,16 @@ var MsgType_value = map[string]int32{
 	"SegmentStatistics":       1206,
 	"SegmentFlushDone":        1207,
 	"DataNodeTt":              1208,
+	"CreateCredential":        1500,
+	"DeleteCredential":        1501,
+	"ListCredUsernames":       1502,
+	"CreateRole":              1600,
+	"DropRole":                1601,
+	"OperateUserRole":         1602,
+	"OperatePrivilege":        1603,
+	"SelectGrant":             1604,
+	"ListPolicy":              1605,
+	"RefreshPolicyInfoCache":  1606,
 }
 
 func (x MsgType) String() string {
@@ -394,6 +442,37 @@ func (DslType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_555bd8c177793206, []int{4}
 }
 
+type ConsistencyLevel int32
+
+const (
+	ConsistencyLevel_Strong     ConsistencyLevel = 0
+	ConsistencyLevel_Session    ConsistencyLevel = 1
+	ConsistencyLevel_Bounded    ConsistencyLevel = 2
+	ConsistencyLevel_Eventually ConsistencyLevel = 3
+)
+
+var ConsistencyLevel_name = map[int32]string{
+	0: "Strong",
+	1: "Session",
+	2: "Bounded",
+	3: "Eventually",
+}
+
+var ConsistencyLevel_value = map[string]int32{
+	"Strong":     0,
+	"Session":    1,
+	"Bounded":    2,
+	"Eventually": 3,
+}
+
+func (x ConsistencyLevel) String() string {
+	return proto.EnumName(ConsistencyLevel_name, int32(x))
+}
+
+func (ConsistencyLevel) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_555bd8c177793206, []int{5}
+}
+
 type Status struct {
 	ErrorCode            ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=milvus.proto.common.ErrorCode" json:"error_code,omitempty"`
 	Reason               string    `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
@@ -683,6 +762,7 @@ func init() {
 	proto.RegisterEnum("milvus.proto.common.SegmentState", SegmentState_name, SegmentState_value)
 	proto.RegisterEnum("milvus.proto.common.MsgType", MsgType_name, MsgType_value)
 	proto.RegisterEnum("milvus.proto.common.DslType", DslType_name, DslType_value)
+	proto.RegisterEnum("milvus.proto.common.ConsistencyLevel", ConsistencyLevel_name, ConsistencyLevel_value)
 	proto.RegisterType((*Status)(nil), "milvus.proto.common.Status")
 	proto.RegisterType((*KeyValuePair)(nil), "milvus.proto.common.KeyValuePair")
 	proto.RegisterType((*Blob)(nil), "milvus.proto.common.Blob")
@@ -694,86 +774,99 @@ func init() {
 func init() { proto.RegisterFile("common.proto", fileDescriptor_555bd8c177793206) }
 
 var fileDescriptor_555bd8c177793206 = []byte{
-	// 1288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x55, 0xd9, 0x6e, 0xdb, 0x46,
-	0x17, 0xb6, 0x44, 0xd9, 0x32, 0xc7, 0xb2, 0x3d, 0x1e, 0x2f, 0x71, 0xf2, 0x1b, 0x3f, 0x02, 0x5f,
-	0x05, 0x06, 0x62, 0xb7, 0x0d, 0xda, 0x5e, 0xe5, 0x22, 0x16, 0xbd, 0x08, 0x89, 0x97, 0x52, 0x4e,
-	0x5a, 0xf4, 0x26, 0x18, 0x93, 0x47, 0xd2, 0x34, 0xe4, 0x8c, 0x3a, 0x33, 0x74, 0xac, 0xfb, 0x3e,
-	0x40, 0x9b, 0xe7, 0x68, 0x8b, 0xee, 0x2d, 0xfa, 0x04, 0xdd, 0xaf, 0xfb, 0x08, 0x7d, 0x80, 0xae,
-	0x59, 0x8b, 0x33, 0xa4, 0x24, 0x06, 0x48, 0xef, 0x78, 0xbe, 0xb3, 0x7d, 0xf3, 0x9d, 0x39, 0x43,
-	0xd2, 0x88, 0x54, 0x9a, 0x2a, 0xb9, 0xd9, 0xd7, 0xca, 0x2a, 0xb6, 0x98, 0x8a, 0xe4, 0x2c, 0x33,
-	0xb9, 0xb5, 0x99, 0xbb, 0xd6, 0xef, 0x92, 0xa9, 0xb6, 0xe5, 0x36, 0x33, 0xec, 0x3a, 0x21, 0xa0,
-	0xb5, 0xd2, 0x77, 0x23, 0x15, 0xc3, 0x6a, 0xe5, 0x72, 0xe5, 0xca, 0xdc, 0x2b, 0xff, 0xdf, 0x7c,
-	0x41, 0xce, 0xe6, 0x0e, 0x86, 0x35, 0x55, 0x0c, 0xa1, 0x0f, 0xc3, 0x4f, 0xb6, 0x42, 0xa6, 0x34,
-	0x70, 0xa3, 0xe4, 0x6a, 0xf5, 0x72, 0xe5, 0x8a, 0x1f, 0x16, 0xd6, 0xfa, 0x6b, 0xa4, 0x71, 0x13,
-	0x06, 0x77, 0x78, 0x92, 0xc1, 0x31, 0x17, 0x9a, 0x51, 0xe2, 0xdd, 0x83, 0x81, 0xab, 0xef, 0x87,
-	0xf8, 0xc9, 0x96, 0xc8, 0xe4, 0x19, 0xba, 0x8b, 0xc4, 0xdc, 0x58, 0x5f, 0x23, 0xb5, 0xed, 0x44,
-	0x9d, 0x8e, 0xbd, 0x98, 0xd1, 0x18, 0x7a, 0xaf, 0x92, 0xfa, 0x8d, 0x38, 0xd6, 0x60, 0x0c, 0x9b,
-	0x23, 0x55, 0xd1, 0x2f, 0xea, 0x55, 0x45, 0x9f, 0x31, 0x52, 0xeb, 0x2b, 0x6d, 0x5d, 0x35, 0x2f,
-	0x74, 0xdf, 0xeb, 0x0f, 0x2a, 0xa4, 0x7e, 0x60, 0xba, 0xdb, 0xdc, 0x00, 0x7b, 0x9d, 0x4c, 0xa7,
-	0xa6, 0x7b, 0xd7, 0x0e, 0xfa, 0xc3, 0x53, 0xae, 0xbd, 0xf0, 0x94, 0x07, 0xa6, 0x7b, 0x32, 0xe8,
-	0x43, 0x58, 0x4f, 0xf3, 0x0f, 0x64, 0x92, 0x9a, 0x6e, 0x2b, 0x28, 0x2a, 0xe7, 0x06, 0x5b, 0x23,
-	0xbe, 0x15, 0x29, 0x18, 0xcb, 0xd3, 0xfe, 0xaa, 0x77, 0xb9, 0x72, 0xa5, 0x16, 0x8e, 0x01, 0x76,
-	0x89, 0x4c, 0x1b, 0x95, 0xe9, 0x08, 0x5a, 0xc1, 0x6a, 0xcd, 0xa5, 0x8d, 0xec, 0xf5, 0xeb, 0xc4,
-	0x3f, 0x30, 0xdd, 0x7d, 0xe0, 0x31, 0x68, 0xf6, 0x12, 0xa9, 0x9d, 0x72, 0x93, 0x33, 0x9a, 0xf9,
-	0x6f, 0x46, 0x78, 0x82, 0xd0, 0x45, 0x6e, 0x7c, 0x5b, 0x23, 0xfe, 0x68, 0x12, 0x6c, 0x86, 0xd4,
-	0xdb, 0x59, 0x14, 0x81, 0x31, 0x74, 0x82, 0x2d, 0x92, 0xf9, 0xdb, 0x12, 0xce, 0xfb, 0x10, 0x59,
-	0x88, 0x5d, 0x0c, 0xad, 0xb0, 0x05, 0x32, 0xdb, 0x54, 0x52, 0x42, 0x64, 0x77, 0xb9, 0x48, 0x20,
-	0xa6, 0x55, 0xb6, 0x44, 0xe8, 0x31, 0xe8, 0x54, 0x18, 0x23, 0x94, 0x0c, 0x40, 0x0a, 0x88, 0xa9,
-	0xc7, 0x2e, 0x90, 0xc5, 0xa6, 0x4a, 0x12, 0x88, 0xac, 0x50, 0xf2, 0x50, 0xd9, 0x9d, 0x73, 0x61,
-	0xac, 0xa1, 0x35, 0x2c, 0xdb, 0x4a, 0x12, 0xe8, 0xf2, 0xe4, 0x86, 0xee, 0x66, 0x29, 0x48, 0x4b,
-	0x27, 0xb1, 0x46, 0x01, 0x06, 0x22, 0x05, 0x89, 0x95, 0x68, 0xbd, 0x84, 0xb6, 0x64, 0x0c, 0xe7,
-	0xa8, 0x1f, 0x9d, 0x66, 0x17, 0xc9, 0x72, 0x81, 0x96, 0x1a, 0xf0, 0x14, 0xa8, 0xcf, 0xe6, 0xc9,
-	0x4c, 0xe1, 0x3a, 0x39, 0x3a, 0xbe, 0x49, 0x49, 0xa9, 0x42, 0xa8, 0xee, 0x87, 0x10, 0x29, 0x1d,
-	0xd3, 0x99, 0x12, 0x85, 0x3b, 0x10, 0x59, 0xa5, 0x5b, 0x01, 0x6d, 0x20, 0xe1, 0x02, 0x6c, 0x03,
-	0xd7, 0x51, 0x2f, 0x04, 0x93, 0x25, 0x96, 0xce, 0x32, 0x4a, 0x1a, 0xbb, 0x22, 0x81, 0x43, 0x65,
-	0x77, 0x55, 0x26, 0x63, 0x3a, 0xc7, 0xe6, 0x08, 0x39, 0x00, 0xcb, 0x0b, 0x05, 0xe6, 0xb1, 0x6d,
-	0x93, 0x47, 0x3d, 0x28, 0x00, 0xca, 0x56, 0x08, 0x6b, 0x72, 0x29, 0x95, 0x6d, 0x6a, 0xe0, 0x16,
-	0x76, 0x55, 0x12, 0x83, 0xa6, 0x0b, 0x48, 0xe7, 0x39, 0x5c, 0x24, 0x40, 0xd9, 0x38, 0x3a, 0x80,
-	0x04, 0x46, 0xd1, 0x8b, 0xe3, 0xe8, 0x02, 0xc7, 0xe8, 0x25, 0x24, 0xbf, 0x9d, 0x89, 0x24, 0x76,
-	0x92, 0xe4, 0x63, 0x59, 0x46, 0x8e, 0x05, 0xf9, 0xc3, 0x5b, 0xad, 0xf6, 0x09, 0x5d, 0x61, 0xcb,
-	0x64, 0xa1, 0x40, 0x0e, 0xc0, 0x6a, 0x11, 0x39, 0xf1, 0x2e, 0x20, 0xd5, 0xa3, 0xcc, 0x1e, 0x75,
-	0x0e, 0x20, 0x55, 0x7a, 0x40, 0x57, 0x71, 0xa0, 0xae, 0xd2, 0x70, 0x44, 0xf4, 0x22, 0x76, 0xd8,
-	0x49, 0xfb, 0x76, 0x30, 0x96, 0x97, 0x5e, 0x62, 0x8c, 0xcc, 0x06, 0x41, 0x08, 0xef, 0x66, 0x60,
-	0x6c, 0xc8, 0x23, 0xa0, 0xbf, 0xd5, 0x37, 0xde, 0x22, 0xc4, 0xe5, 0xe2, 0xee, 0x03, 0x63, 0x64,
-	0x6e, 0x6c, 0x1d, 0x2a, 0x09, 0x74, 0x82, 0x35, 0xc8, 0xf4, 0x6d, 0x29, 0x8c, 0xc9, 0x20, 0xa6,
-	0x15, 0xd4, 0xad, 0x25, 0x8f, 0xb5, 0xea, 0xe2, 0xca, 0xd1, 0x2a, 0x7a, 0x77, 0x85, 0x14, 0xa6,
-	0xe7, 0x6e, 0x0c, 0x21, 0x53, 0x85, 0x80, 0xb5, 0x8d, 0x0e, 0x69, 0xb4, 0xa1, 0x8b, 0x97, 0x23,
-	0xaf, 0xbd, 0x44, 0x68, 0xd9, 0x1e, 0x57, 0x1f, 0xd1, 0xae, 0xe0, 0xe5, 0xdd, 0xd3, 0xea, 0xbe,
-	0x90, 0x5d, 0x5a, 0xc5, 0x62, 0x6d, 0xe0, 0x89, 0x2b, 0x3c, 0x43, 0xea, 0xbb, 0x49, 0xe6, 0xba,
-	0xd4, 0x5c, 0x4f, 0x34, 0x30, 0x6c, 0x72, 0xe3, 0xbd, 0x69, 0xb7, 0xd2, 0x6e, 0x33, 0x67, 0x89,
-	0x7f, 0x5b, 0xc6, 0xd0, 0x11, 0x12, 0x62, 0x3a, 0xe1, 0xd4, 0x77, 0x53, 0x2a, 0xc9, 0x10, 0xe3,
-	0x21, 0x03, 0xad, 0xfa, 0x25, 0x0c, 0x50, 0xc2, 0x7d, 0x6e, 0x4a, 0x50, 0x07, 0x47, 0x1a, 0x80,
-	0x89, 0xb4, 0x38, 0x2d, 0xa7, 0x77, 0x51, 0xda, 0x76, 0x4f, 0xdd, 0x1f, 0x63, 0x86, 0xf6, 0xb0,
-	0xd3, 0x1e, 0xd8, 0xf6, 0xc0, 0x58, 0x48, 0x9b, 0x4a, 0x76, 0x44, 0xd7, 0x50, 0x81, 0x9d, 0x6e,
-	0x29, 0x1e, 0x97, 0xd2, 0xdf, 0xc1, 0xa1, 0x86, 0x90, 0x00, 0x37, 0xe5, 0xaa, 0xf7, 0xd8, 0x12,
-	0x99, 0xcf, 0xa9, 0x1e, 0x73, 0x6d, 0x85, 0x03, 0xbf, 0xab, 0xb8, 0x89, 0x69, 0xd5, 0x1f, 0x63,
-	0xdf, 0xe3, 0xfa, 0x36, 0xf6, 0xb9, 0x19, 0x43, 0x3f, 0x54, 0xd8, 0x0a, 0x59, 0x18, 0x52, 0x1d,
-	0xe3, 0x3f, 0x56, 0xd8, 0x22, 0x99, 0x43, 0xaa, 0x23, 0xcc, 0xd0, 0x9f, 0x1c, 0x88, 0xa4, 0x4a,
-	0xe0, 0xcf, 0xae, 0x42, 0xc1, 0xaa, 0x84, 0xff, 0xe2, 0x9a, 0x61, 0x85, 0x62, 0x70, 0x86, 0x3e,
-	0xac, 0x20, 0xd3, 0x61, 0xb3, 0x02, 0xa6, 0x8f, 0x5c, 0x20, 0x56, 0x1d, 0x05, 0x3e, 0x76, 0x81,
-	0x45, 0xcd, 0x11, 0xfa, 0xc4, 0xa1, 0xfb, 0x5c, 0xc6, 0xaa, 0xd3, 0x19, 0xa1, 0x4f, 0x2b, 0x6c,
-	0x95, 0x2c, 0x62, 0xfa, 0x36, 0x4f, 0xb8, 0x8c, 0xc6, 0xf1, 0xcf, 0x2a, 0x8c, 0x92, 0x99, 0x5c,
-	0x18, 0x77, 0x31, 0xe9, 0x87, 0x55, 0x27, 0x4a, 0x41, 0x20, 0xc7, 0x3e, 0xaa, 0xb2, 0x39, 0xe2,
-	0xa3, 0x50, 0xb9, 0xfd, 0x71, 0x95, 0xcd, 0x90, 0xa9, 0x96, 0x34, 0xa0, 0x2d, 0x7d, 0x1f, 0x2f,
-	0xcf, 0x54, 0xbe, 0x7e, 0xf4, 0x03, 0xbc, 0xa2, 0x93, 0xee, 0xf2, 0xd0, 0x07, 0xce, 0x91, 0x3f,
-	0x14, 0xf4, 0x77, 0xcf, 0x1d, 0xb5, 0xfc, 0x6a, 0xfc, 0xe1, 0x61, 0xa7, 0x3d, 0xb0, 0xe3, 0x8d,
-	0xa0, 0x7f, 0x7a, 0xec, 0x12, 0x59, 0x1e, 0x62, 0x6e, 0x87, 0x47, 0xbb, 0xf0, 0x97, 0xc7, 0xd6,
-	0xc8, 0x85, 0x3d, 0xb0, 0xe3, 0xb9, 0x62, 0x92, 0x30, 0x56, 0x44, 0x86, 0xfe, 0xed, 0xb1, 0xff,
-	0x91, 0x95, 0x3d, 0xb0, 0x23, 0x7d, 0x4b, 0xce, 0x7f, 0x3c, 0x36, 0x4b, 0xa6, 0x43, 0x5c, 0x72,
-	0x38, 0x03, 0xfa, 0xd0, 0xc3, 0x21, 0x0d, 0xcd, 0x82, 0xce, 0x23, 0x0f, 0xa5, 0x7b, 0x93, 0xdb,
-	0xa8, 0x17, 0xa4, 0xcd, 0x1e, 0x97, 0x12, 0x12, 0x43, 0x1f, 0x7b, 0x6c, 0x99, 0xd0, 0x10, 0x52,
-	0x75, 0x06, 0x25, 0xf8, 0x09, 0x3e, 0xde, 0xcc, 0x05, 0xbf, 0x91, 0x81, 0x1e, 0x8c, 0x1c, 0x4f,
-	0x3d, 0x94, 0x3a, 0x8f, 0x7f, 0xde, 0xf3, 0xcc, 0x43, 0xa9, 0x0b, 0xe5, 0x5b, 0xb2, 0xa3, 0xe8,
-	0xaf, 0x35, 0x64, 0x75, 0x22, 0x52, 0x38, 0x11, 0xd1, 0x3d, 0xfa, 0x89, 0x8f, 0xac, 0x5c, 0xd2,
-	0xa1, 0x8a, 0x01, 0xe9, 0x1b, 0xfa, 0xa9, 0x8f, 0xd2, 0xe3, 0xe8, 0x72, 0xe9, 0x3f, 0x73, 0x76,
-	0xf1, 0xc6, 0xb4, 0x02, 0xfa, 0x39, 0x3e, 0xe8, 0xa4, 0xb0, 0x4f, 0xda, 0x47, 0xf4, 0x0b, 0x1f,
-	0x8f, 0x71, 0x23, 0x49, 0x54, 0xc4, 0xed, 0xe8, 0x02, 0x7d, 0xe9, 0xe3, 0x0d, 0x2c, 0x3d, 0x0f,
-	0x85, 0x30, 0x5f, 0xf9, 0x78, 0xbc, 0x02, 0x77, 0x63, 0x0b, 0xf0, 0xd9, 0xf8, 0xda, 0x55, 0x0d,
-	0xb8, 0xe5, 0xc8, 0xe4, 0xc4, 0xd2, 0x6f, 0xfc, 0x8d, 0x75, 0x52, 0x0f, 0x4c, 0xe2, 0x5e, 0x81,
-	0x3a, 0xf1, 0x02, 0x93, 0xd0, 0x09, 0x7c, 0xac, 0xb6, 0x95, 0x4a, 0x76, 0xce, 0xfb, 0xfa, 0xce,
-	0xcb, 0xb4, 0xb2, 0xfd, 0xea, 0xdb, 0xd7, 0xba, 0xc2, 0xf6, 0xb2, 0x53, 0xfc, 0x91, 0x6e, 0xe5,
-	0x7f, 0xd6, 0xab, 0x42, 0x15, 0x5f, 0x5b, 0x42, 0x5a, 0xd0, 0x92, 0x27, 0x5b, 0xee, 0x67, 0xbb,
-	0x95, 0xff, 0x6c, 0xfb, 0xa7, 0xa7, 0x53, 0xce, 0xbe, 0xf6, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xe0, 0x24, 0x61, 0xe5, 0x46, 0x09, 0x00, 0x00,
+	// 1494 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x56, 0x49, 0x93, 0x1b, 0x49,
+	0x15, 0x6e, 0xa9, 0xe4, 0x56, 0x2b, 0xa5, 0x96, 0x9f, 0xb3, 0x17, 0xf7, 0x78, 0x1c, 0x84, 0xa3,
+	0x4f, 0x8e, 0x8e, 0x18, 0x1b, 0x98, 0x00, 0x4e, 0x73, 0x70, 0x4b, 0xbd, 0x28, 0xa6, 0x37, 0x4a,
+	0x6d, 0x43, 0x70, 0x71, 0x64, 0x57, 0x3d, 0x49, 0x89, 0xb3, 0x32, 0x45, 0x66, 0x56, 0xdb, 0xfa,
+	0x17, 0x30, 0x07, 0xe0, 0x3f, 0xb0, 0x04, 0x3b, 0x1c, 0xd9, 0x83, 0x61, 0x3b, 0x73, 0x00, 0xce,
+	0xfc, 0x00, 0xd6, 0x59, 0x89, 0x97, 0x55, 0x92, 0x6a, 0x22, 0x3c, 0xb7, 0x7a, 0x5f, 0xbe, 0xf5,
+	0x7b, 0x2f, 0x5f, 0x25, 0xeb, 0x24, 0x26, 0xcb, 0x8c, 0x7e, 0x30, 0xb5, 0xc6, 0x1b, 0xbe, 0x91,
+	0x49, 0x75, 0x9d, 0xbb, 0x42, 0x7a, 0x50, 0x1c, 0xed, 0x3e, 0x65, 0xab, 0x43, 0x2f, 0x7c, 0xee,
+	0xf8, 0x1b, 0x8c, 0xa1, 0xb5, 0xc6, 0x3e, 0x4d, 0x4c, 0x8a, 0x3b, 0xb5, 0x7b, 0xb5, 0xfb, 0xdd,
+	0x4f, 0x7f, 0xe2, 0xc1, 0x4b, 0x6c, 0x1e, 0x1c, 0x90, 0x5a, 0xcf, 0xa4, 0x18, 0xb7, 0x70, 0xfe,
+	0xc9, 0xb7, 0xd9, 0xaa, 0x45, 0xe1, 0x8c, 0xde, 0xa9, 0xdf, 0xab, 0xdd, 0x6f, 0xc5, 0xa5, 0xb4,
+	0xfb, 0x59, 0xd6, 0x79, 0x13, 0x67, 0x4f, 0x84, 0xca, 0xf1, 0x42, 0x48, 0xcb, 0x81, 0x45, 0xcf,
+	0x70, 0x16, 0xfc, 0xb7, 0x62, 0xfa, 0xe4, 0x9b, 0xec, 0xc6, 0x35, 0x1d, 0x97, 0x86, 0x85, 0xb0,
+	0x7b, 0x97, 0x35, 0xf6, 0x95, 0xb9, 0x5a, 0x9e, 0x92, 0x45, 0x67, 0x7e, 0xfa, 0x1a, 0x6b, 0x3e,
+	0x4a, 0x53, 0x8b, 0xce, 0xf1, 0x2e, 0xab, 0xcb, 0x69, 0xe9, 0xaf, 0x2e, 0xa7, 0x9c, 0xb3, 0xc6,
+	0xd4, 0x58, 0x1f, 0xbc, 0x45, 0x71, 0xf8, 0xde, 0x7d, 0xab, 0xc6, 0x9a, 0xa7, 0x6e, 0xbc, 0x2f,
+	0x1c, 0xf2, 0xcf, 0xb1, 0xb5, 0xcc, 0x8d, 0x9f, 0xfa, 0xd9, 0x74, 0x5e, 0xe5, 0xdd, 0x97, 0x56,
+	0x79, 0xea, 0xc6, 0x97, 0xb3, 0x29, 0xc6, 0xcd, 0xac, 0xf8, 0xa0, 0x4c, 0x32, 0x37, 0x1e, 0xf4,
+	0x4b, 0xcf, 0x85, 0xc0, 0xef, 0xb2, 0x96, 0x97, 0x19, 0x3a, 0x2f, 0xb2, 0xe9, 0x4e, 0x74, 0xaf,
+	0x76, 0xbf, 0x11, 0x2f, 0x01, 0x7e, 0x87, 0xad, 0x39, 0x93, 0xdb, 0x04, 0x07, 0xfd, 0x9d, 0x46,
+	0x30, 0x5b, 0xc8, 0xbb, 0x6f, 0xb0, 0xd6, 0xa9, 0x1b, 0x1f, 0xa3, 0x48, 0xd1, 0xf2, 0x4f, 0xb2,
+	0xc6, 0x95, 0x70, 0x45, 0x46, 0xed, 0x8f, 0xcf, 0x88, 0x2a, 0x88, 0x83, 0xe6, 0xde, 0xdb, 0x0d,
+	0xd6, 0x5a, 0x74, 0x82, 0xb7, 0x59, 0x73, 0x98, 0x27, 0x09, 0x3a, 0x07, 0x2b, 0x7c, 0x83, 0xdd,
+	0x7c, 0xac, 0xf1, 0xc5, 0x14, 0x13, 0x8f, 0x69, 0xd0, 0x81, 0x1a, 0xbf, 0xc5, 0xd6, 0x7b, 0x46,
+	0x6b, 0x4c, 0xfc, 0xa1, 0x90, 0x0a, 0x53, 0xa8, 0xf3, 0x4d, 0x06, 0x17, 0x68, 0x33, 0xe9, 0x9c,
+	0x34, 0xba, 0x8f, 0x5a, 0x62, 0x0a, 0x11, 0xbf, 0xcd, 0x36, 0x7a, 0x46, 0x29, 0x4c, 0xbc, 0x34,
+	0xfa, 0xcc, 0xf8, 0x83, 0x17, 0xd2, 0x79, 0x07, 0x0d, 0x72, 0x3b, 0x50, 0x0a, 0xc7, 0x42, 0x3d,
+	0xb2, 0xe3, 0x3c, 0x43, 0xed, 0xe1, 0x06, 0xf9, 0x28, 0xc1, 0xbe, 0xcc, 0x50, 0x93, 0x27, 0x68,
+	0x56, 0xd0, 0x81, 0x4e, 0xf1, 0x05, 0xf1, 0x07, 0x6b, 0xfc, 0x15, 0xb6, 0x55, 0xa2, 0x95, 0x00,
+	0x22, 0x43, 0x68, 0xf1, 0x9b, 0xac, 0x5d, 0x1e, 0x5d, 0x9e, 0x5f, 0xbc, 0x09, 0xac, 0xe2, 0x21,
+	0x36, 0xcf, 0x63, 0x4c, 0x8c, 0x4d, 0xa1, 0x5d, 0x49, 0xe1, 0x09, 0x26, 0xde, 0xd8, 0x41, 0x1f,
+	0x3a, 0x94, 0x70, 0x09, 0x0e, 0x51, 0xd8, 0x64, 0x12, 0xa3, 0xcb, 0x95, 0x87, 0x75, 0x0e, 0xac,
+	0x73, 0x28, 0x15, 0x9e, 0x19, 0x7f, 0x68, 0x72, 0x9d, 0x42, 0x97, 0x77, 0x19, 0x3b, 0x45, 0x2f,
+	0x4a, 0x06, 0x6e, 0x52, 0xd8, 0x9e, 0x48, 0x26, 0x58, 0x02, 0xc0, 0xb7, 0x19, 0xef, 0x09, 0xad,
+	0x8d, 0xef, 0x59, 0x14, 0x1e, 0x0f, 0x8d, 0x4a, 0xd1, 0xc2, 0x2d, 0x4a, 0xe7, 0x23, 0xb8, 0x54,
+	0x08, 0x7c, 0xa9, 0xdd, 0x47, 0x85, 0x0b, 0xed, 0x8d, 0xa5, 0x76, 0x89, 0x93, 0xf6, 0x26, 0x25,
+	0xbf, 0x9f, 0x4b, 0x95, 0x06, 0x4a, 0x8a, 0xb6, 0x6c, 0x51, 0x8e, 0x65, 0xf2, 0x67, 0x27, 0x83,
+	0xe1, 0x25, 0x6c, 0xf3, 0x2d, 0x76, 0xab, 0x44, 0x4e, 0xd1, 0x5b, 0x99, 0x04, 0xf2, 0x6e, 0x53,
+	0xaa, 0xe7, 0xb9, 0x3f, 0x1f, 0x9d, 0x62, 0x66, 0xec, 0x0c, 0x76, 0xa8, 0xa1, 0xc1, 0xd3, 0xbc,
+	0x45, 0xf0, 0x0a, 0x45, 0x38, 0xc8, 0xa6, 0x7e, 0xb6, 0xa4, 0x17, 0xee, 0xf0, 0x75, 0xd6, 0x8a,
+	0x85, 0xc7, 0x13, 0x99, 0x49, 0x0f, 0xaf, 0x72, 0xce, 0xd6, 0xfb, 0xfd, 0x18, 0xbf, 0x92, 0xa3,
+	0xf3, 0xb1, 0x48, 0x10, 0xfe, 0xd1, 0xdc, 0xfb, 0x22, 0x63, 0xc1, 0x15, 0xad, 0x02, 0xe4, 0x9c,
+	0x75, 0x97, 0xd2, 0x99, 0xd1, 0x08, 0x2b, 0xbc, 0xc3, 0xd6, 0x1e, 0x6b, 0xe9, 0x5c, 0x8e, 0x29,
+	0xd4, 0x88, 0xc6, 0x81, 0xbe, 0xb0, 0x66, 0x4c, 0x37, 0x10, 0xea, 0x74, 0x7a, 0x28, 0xb5, 0x74,
+	0x93, 0x30, 0x40, 0x8c, 0xad, 0x96, 0x7c, 0x36, 0xf6, 0x46, 0xac, 0x33, 0xc4, 0x31, 0xcd, 0x4a,
+	0xe1, 0x7b, 0x93, 0x41, 0x55, 0x5e, 0x7a, 0x5f, 0x54, 0x51, 0xa3, 0x59, 0x3e, 0xb2, 0xe6, 0xb9,
+	0xd4, 0x63, 0xa8, 0x93, 0xb3, 0x21, 0x0a, 0x15, 0x1c, 0xb7, 0x59, 0xf3, 0x50, 0xe5, 0x21, 0x4a,
+	0x23, 0xc4, 0x24, 0x81, 0xd4, 0x6e, 0xec, 0x7d, 0x8b, 0x85, 0x1b, 0x1e, 0x2e, 0xea, 0x3a, 0x6b,
+	0x3d, 0xd6, 0x29, 0x8e, 0xa4, 0xc6, 0x14, 0x56, 0x42, 0x33, 0x42, 0xd3, 0x2a, 0xac, 0xa4, 0x54,
+	0x64, 0xdf, 0x9a, 0x69, 0x05, 0x43, 0x62, 0xf4, 0x58, 0xb8, 0x0a, 0x34, 0xa2, 0x0e, 0xf7, 0xd1,
+	0x25, 0x56, 0x5e, 0x55, 0xcd, 0xc7, 0xc4, 0xf4, 0x70, 0x62, 0x9e, 0x2f, 0x31, 0x07, 0x13, 0x8a,
+	0x74, 0x84, 0x7e, 0x38, 0x73, 0x1e, 0xb3, 0x9e, 0xd1, 0x23, 0x39, 0x76, 0x20, 0x29, 0xd2, 0x89,
+	0x11, 0x69, 0xc5, 0xfc, 0xcb, 0xd4, 0xe3, 0x18, 0x15, 0x0a, 0x57, 0xf5, 0xfa, 0x8c, 0xa2, 0x1d,
+	0xa1, 0x27, 0x6d, 0xa9, 0xc7, 0x0b, 0x7e, 0x15, 0xdf, 0x60, 0xdd, 0xa2, 0x84, 0xbe, 0xf0, 0x82,
+	0x6e, 0x3f, 0x7c, 0x9d, 0x2e, 0x74, 0x87, 0x2a, 0x58, 0x40, 0xdf, 0xa8, 0x51, 0x6f, 0x4f, 0xa4,
+	0xf3, 0x73, 0xc8, 0xc1, 0x37, 0x6b, 0x7c, 0x93, 0xdd, 0x2c, 0x6c, 0x2f, 0x84, 0xf5, 0x32, 0x04,
+	0xfa, 0x5d, 0xd0, 0x24, 0xe3, 0x25, 0xf6, 0x76, 0x70, 0x78, 0x2c, 0xdc, 0x12, 0xfa, 0x7d, 0x8d,
+	0x6f, 0xb3, 0x5b, 0xf3, 0xf2, 0x97, 0xf8, 0x1f, 0x6a, 0x94, 0x10, 0x95, 0xbf, 0xc0, 0x1c, 0xfc,
+	0x31, 0x80, 0x94, 0x7a, 0x05, 0xfc, 0x53, 0xf0, 0x50, 0x56, 0x5a, 0xc1, 0xff, 0x1c, 0x82, 0x91,
+	0x87, 0x72, 0x18, 0x1c, 0xbc, 0x13, 0x32, 0x9d, 0x07, 0x2b, 0x61, 0x78, 0x37, 0x28, 0x92, 0xd7,
+	0x85, 0xe2, 0x7b, 0x41, 0xb1, 0xf4, 0xb9, 0x40, 0xdf, 0x0f, 0xe8, 0xb1, 0xd0, 0xa9, 0x19, 0x8d,
+	0x16, 0xe8, 0x07, 0x35, 0xbe, 0xc3, 0x36, 0xc8, 0x7c, 0x5f, 0x28, 0xa1, 0x93, 0xa5, 0xfe, 0x87,
+	0x35, 0x0e, 0xac, 0x5d, 0x10, 0x13, 0x86, 0x1d, 0xbe, 0x5d, 0x0f, 0xa4, 0x94, 0x09, 0x14, 0xd8,
+	0x77, 0xea, 0xbc, 0xcb, 0x5a, 0x44, 0x54, 0x21, 0x7f, 0xb7, 0xce, 0xdb, 0x6c, 0x75, 0xa0, 0x1d,
+	0x5a, 0x0f, 0x5f, 0xa5, 0x81, 0x5c, 0x2d, 0x6e, 0x38, 0x7c, 0x8d, 0xc6, 0xfe, 0x46, 0x18, 0x48,
+	0x78, 0x2b, 0x1c, 0x14, 0xbb, 0x08, 0xfe, 0x19, 0x85, 0x52, 0xab, 0x8b, 0xe9, 0x5f, 0x11, 0x45,
+	0x3a, 0x42, 0xbf, 0xbc, 0x65, 0xf0, 0xef, 0x88, 0xdf, 0x61, 0x5b, 0x73, 0x2c, 0xac, 0x89, 0x45,
+	0xff, 0xff, 0x13, 0xf1, 0xbb, 0xec, 0xf6, 0x11, 0xfa, 0xe5, 0xac, 0x90, 0x91, 0x74, 0x5e, 0x26,
+	0x0e, 0xfe, 0x1b, 0xf1, 0x57, 0xd9, 0xf6, 0x11, 0xfa, 0x05, 0xbf, 0x95, 0xc3, 0xff, 0x45, 0x7c,
+	0x9d, 0xad, 0xc5, 0xb4, 0x47, 0xf0, 0x1a, 0xe1, 0x9d, 0x88, 0x9a, 0x34, 0x17, 0xcb, 0x74, 0xde,
+	0x8d, 0x88, 0xba, 0x2f, 0x08, 0x9f, 0x4c, 0xfa, 0x59, 0x6f, 0x22, 0xb4, 0x46, 0xe5, 0xe0, 0xbd,
+	0x88, 0x6f, 0x31, 0x88, 0x31, 0x33, 0xd7, 0x58, 0x81, 0xdf, 0xa7, 0xff, 0x03, 0x0f, 0xca, 0x9f,
+	0xcf, 0xd1, 0xce, 0x16, 0x07, 0x1f, 0x44, 0x44, 0x75, 0xa1, 0xff, 0xd1, 0x93, 0x0f, 0x23, 0xa2,
+	0xba, 0x64, 0x7e, 0xa0, 0x47, 0x06, 0xfe, 0xd2, 0xa0, 0xac, 0x2e, 0x65, 0x86, 0x97, 0x32, 0x79,
+	0x06, 0xdf, 0x6b, 0x51, 0x56, 0xc1, 0xe8, 0xcc, 0xa4, 0x48, 0xe9, 0x3b, 0xf8, 0x7e, 0x8b, 0xa8,
+	0xa7, 0xd6, 0x15, 0xd4, 0xff, 0x20, 0xc8, 0xe5, 0xde, 0x1a, 0xf4, 0xe1, 0x87, 0xf4, 0xcf, 0x60,
+	0xa5, 0x7c, 0x39, 0x3c, 0x87, 0x1f, 0xb5, 0xa8, 0x8c, 0x47, 0x4a, 0x99, 0x44, 0xf8, 0xc5, 0x00,
+	0xfd, 0xb8, 0x45, 0x13, 0x58, 0x59, 0x39, 0x25, 0x31, 0x3f, 0x69, 0x51, 0x79, 0x25, 0x1e, 0xda,
+	0xd6, 0xa7, 0x55, 0xf4, 0xd3, 0xe0, 0x95, 0xee, 0x0f, 0x65, 0x72, 0xe9, 0xe1, 0x67, 0x41, 0xaf,
+	0xdc, 0x1f, 0x16, 0x53, 0xd4, 0x5e, 0x0a, 0x05, 0x7f, 0x6d, 0x13, 0x5c, 0xf4, 0xbe, 0x02, 0xff,
+	0xad, 0x4d, 0xd1, 0xe8, 0x0a, 0x12, 0xf8, 0xd8, 0xa1, 0xd5, 0x22, 0x43, 0x07, 0x7f, 0x6f, 0x93,
+	0xdb, 0xc2, 0x4b, 0x6c, 0x14, 0xc2, 0xcf, 0x3b, 0xc4, 0x00, 0x0d, 0x56, 0x10, 0x7f, 0xd1, 0xa1,
+	0xdc, 0xcf, 0xa7, 0x68, 0x85, 0x47, 0x32, 0x0b, 0xe8, 0x2f, 0x3b, 0x14, 0xa4, 0x44, 0x2f, 0xac,
+	0xbc, 0x96, 0x0a, 0xc7, 0x08, 0xbf, 0xea, 0x14, 0x7c, 0xd2, 0x28, 0x1c, 0x59, 0xa1, 0x3d, 0xfc,
+	0xba, 0x43, 0xee, 0x29, 0xec, 0x85, 0x51, 0x32, 0x99, 0xc1, 0x6f, 0x3a, 0x34, 0x13, 0x31, 0x8e,
+	0x2c, 0xba, 0x49, 0x81, 0x11, 0xf1, 0xe1, 0x57, 0x07, 0xbf, 0xed, 0xec, 0xed, 0xb2, 0x66, 0xdf,
+	0xa9, 0xb0, 0x2c, 0x9b, 0x2c, 0xea, 0x3b, 0x05, 0x2b, 0xb4, 0xd3, 0xf7, 0x8d, 0x51, 0x07, 0x2f,
+	0xa6, 0xf6, 0xc9, 0xa7, 0xa0, 0xb6, 0x77, 0xcc, 0xa0, 0x67, 0xb4, 0x93, 0xce, 0xa3, 0x4e, 0x66,
+	0x27, 0x78, 0x8d, 0x2a, 0x2c, 0x63, 0x6f, 0x8d, 0x1e, 0xc3, 0x4a, 0x78, 0x71, 0x60, 0x78, 0x39,
+	0x14, 0x2b, 0x7b, 0x9f, 0x7e, 0xb1, 0xe1, 0x59, 0xd1, 0x65, 0xec, 0xe0, 0x1a, 0xb5, 0xcf, 0x85,
+	0x52, 0x33, 0x88, 0xf6, 0x3f, 0xf3, 0xa5, 0xd7, 0xc7, 0xd2, 0x4f, 0xf2, 0x2b, 0x7a, 0xc8, 0x3c,
+	0x2c, 0x5e, 0x36, 0xaf, 0x49, 0x53, 0x7e, 0x3d, 0x94, 0xda, 0x13, 0x4f, 0xea, 0x61, 0x78, 0xec,
+	0x3c, 0x2c, 0x1e, 0x3b, 0xd3, 0xab, 0xab, 0xd5, 0x20, 0xbf, 0xfe, 0xff, 0x01, 0x00, 0x07, 0xba,
+	0x7c, 0x89, 0xc6, 0x0a, 0x00, 0x00,
 }
@@ -1208,6 +1208,54 @@ func (m *FlushSegmentsRequest) GetSegmentIDs() []int64 {
 	return nil
 }
 
+// ReleaseDmChannelsRequest syncs the buffered data of the channels and stops consuming them
+type ReleaseDmChannelsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ChannelNames         []string          `protobuf:"bytes,2,rep,name=channelNames,proto3" json:"channelNames,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ReleaseDmChannelsRequest) Reset()         { *m = ReleaseDmChannelsRequest{} }
+func (m *ReleaseDmChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleaseDmChannelsRequest) ProtoMessage()    {}
+func (*ReleaseDmChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{21}
+}
+
+func (m *ReleaseDmChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseDmChannelsRequest.Unmarshal(m, b)
+}
+func (m *ReleaseDmChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseDmChannelsRequest.Marshal(b, m, deterministic)
+}
+func (m *ReleaseDmChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseDmChannelsRequest.Merge(m, src)
+}
+func (m *ReleaseDmChannelsRequest) XXX_Size() int {
+	return xxx_messageInfo_ReleaseDmChannelsRequest.Size(m)
+}
+func (m *ReleaseDmChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseDmChannelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseDmChannelsRequest proto.InternalMessageInfo
+
+func (m *ReleaseDmChannelsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ReleaseDmChannelsRequest) GetChannelNames() []string {
+	if m != nil {
+		return m.ChannelNames
+	}
+	return nil
+}
+
 type SegmentMsg struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Segment              *SegmentInfo      `protobuf:"bytes,2,opt,name=segment,proto3" json:"segment,omitempty"`
@@ -1220,7 +1268,7 @@ func (m *SegmentMsg) Reset()         { *m = SegmentMsg{} }
 func (m *SegmentMsg) String() string { return proto.CompactTextString(m) }
 func (*SegmentMsg) ProtoMessage()    {}
 func (*SegmentMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{21}
+	return fileDescriptor_82cd95f524594f49, []int{22}
 }
 
 func (m *SegmentMsg) XXX_Unmarshal(b []byte) error {
@@ -1267,7 +1315,7 @@ func (m *DDLFlushMeta) Reset()         { *m = DDLFlushMeta{} }
 func (m *DDLFlushMeta) String() string { return proto.CompactTextString(m) }
 func (*DDLFlushMeta) ProtoMessage()    {}
 func (*DDLFlushMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{22}
+	return fileDescriptor_82cd95f524594f49, []int{23}
 }
 
 func (m *DDLFlushMeta) XXX_Unmarshal(b []byte) error {
@@ -1315,7 +1363,7 @@ func (m *CollectionInfo) Reset()         { *m = CollectionInfo{} }
 func (m *CollectionInfo) String() string { return proto.CompactTextString(m) }
 func (*CollectionInfo) ProtoMessage()    {}
 func (*CollectionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{23}
+	return fileDescriptor_82cd95f524594f49, []int{24}
 }
 
 func (m *CollectionInfo) XXX_Unmarshal(b []byte) error {
@@ -1369,6 +1417,9 @@ type SegmentInfo struct {
 	StartPosition        *internalpb.MsgPosition `protobuf:"bytes,9,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
 	DmlPosition          *internalpb.MsgPosition `protobuf:"bytes,10,opt,name=dml_position,json=dmlPosition,proto3" json:"dml_position,omitempty"`
 	Binlogs              []*FieldBinlog          `protobuf:"bytes,11,rep,name=binlogs,proto3" json:"binlogs,omitempty"`
+	Statslogs            []*FieldBinlog          `protobuf:"bytes,12,rep,name=statslogs,proto3" json:"statslogs,omitempty"`
+	MemorySize           int64                   `protobuf:"varint,13,opt,name=memory_size,json=memorySize,proto3" json:"memory_size,omitempty"`
+	Deltalogs            []string                `protobuf:"bytes,14,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -1378,7 +1429,7 @@ func (m *SegmentInfo) Reset()         { *m = SegmentInfo{} }
 func (m *SegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentInfo) ProtoMessage()    {}
 func (*SegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{24}
+	return fileDescriptor_82cd95f524594f49, []int{25}
 }
 
 func (m *SegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -1476,6 +1527,27 @@ func (m *SegmentInfo) GetBinlogs() []*FieldBinlog {
 	return nil
 }
 
+func (m *SegmentInfo) GetStatslogs() []*FieldBinlog {
+	if m != nil {
+		return m.Statslogs
+	}
+	return nil
+}
+
+func (m *SegmentInfo) GetMemorySize() int64 {
+	if m != nil {
+		return m.MemorySize
+	}
+	return 0
+}
+
+func (m *SegmentInfo) GetDeltalogs() []string {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
+}
+
 type SegmentStartPosition struct {
 	StartPosition        *internalpb.MsgPosition `protobuf:"bytes,1,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
 	SegmentID            int64                   `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
@@ -1488,7 +1560,7 @@ func (m *SegmentStartPosition) Reset()         { *m = SegmentStartPosition{} }
 func (m *SegmentStartPosition) String() string { return proto.CompactTextString(m) }
 func (*SegmentStartPosition) ProtoMessage()    {}
 func (*SegmentStartPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{25}
+	return fileDescriptor_82cd95f524594f49, []int{26}
 }
 
 func (m *SegmentStartPosition) XXX_Unmarshal(b []byte) error {
@@ -1531,6 +1603,8 @@ type SaveBinlogPathsRequest struct {
 	CheckPoints          []*CheckPoint           `protobuf:"bytes,5,rep,name=checkPoints,proto3" json:"checkPoints,omitempty"`
 	StartPositions       []*SegmentStartPosition `protobuf:"bytes,6,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
 	Flushed              bool                    `protobuf:"varint,7,opt,name=flushed,proto3" json:"flushed,omitempty"`
+	Field2StatslogPaths  []*FieldBinlog          `protobuf:"bytes,8,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
+	Deltalogs            []string                `protobuf:"bytes,9,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -1540,7 +1614,7 @@ func (m *SaveBinlogPathsRequest) Reset()         { *m = SaveBinlogPathsRequest{}
 func (m *SaveBinlogPathsRequest) String() string { return proto.CompactTextString(m) }
 func (*SaveBinlogPathsRequest) ProtoMessage()    {}
 func (*SaveBinlogPathsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{26}
+	return fileDescriptor_82cd95f524594f49, []int{27}
 }
 
 func (m *SaveBinlogPathsRequest) XXX_Unmarshal(b []byte) error {
@@ -1610,10 +1684,25 @@ func (m *SaveBinlogPathsRequest) GetFlushed() bool {
 	return false
 }
 
+func (m *SaveBinlogPathsRequest) GetField2StatslogPaths() []*FieldBinlog {
+	if m != nil {
+		return m.Field2StatslogPaths
+	}
+	return nil
+}
+
+func (m *SaveBinlogPathsRequest) GetDeltalogs() []string {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
+}
+
 type CheckPoint struct {
 	SegmentID            int64                   `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	Position             *internalpb.MsgPosition `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
 	NumOfRows            int64                   `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	MemorySize           int64                   `protobuf:"varint,4,opt,name=memory_size,json=memorySize,proto3" json:"memory_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -1623,7 +1712,7 @@ func (m *CheckPoint) Reset()         { *m = CheckPoint{} }
 func (m *CheckPoint) String() string { return proto.CompactTextString(m) }
 func (*CheckPoint) ProtoMessage()    {}
 func (*CheckPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{27}
+	return fileDescriptor_82cd95f524594f49, []int{28}
 }
 
 func (m *CheckPoint) XXX_Unmarshal(b []byte) error {
@@ -1665,6 +1754,13 @@ func (m *CheckPoint) GetNumOfRows() int64 {
 	return 0
 }
 
+func (m *CheckPoint) GetMemorySize() int64 {
+	if m != nil {
+		return m.MemorySize
+	}
+	return 0
+}
+
 type DataNodeTtMsg struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	ChannelName          string            `protobuf:"bytes,2,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
@@ -1678,7 +1774,7 @@ func (m *DataNodeTtMsg) Reset()         { *m = DataNodeTtMsg{} }
 func (m *DataNodeTtMsg) String() string { return proto.CompactTextString(m) }
 func (*DataNodeTtMsg) ProtoMessage()    {}
 func (*DataNodeTtMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{28}
+	return fileDescriptor_82cd95f524594f49, []int{29}
 }
 
 func (m *DataNodeTtMsg) XXX_Unmarshal(b []byte) error {
@@ -1733,7 +1829,7 @@ func (m *ChannelStatus) Reset()         { *m = ChannelStatus{} }
 func (m *ChannelStatus) String() string { return proto.CompactTextString(m) }
 func (*ChannelStatus) ProtoMessage()    {}
 func (*ChannelStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{29}
+	return fileDescriptor_82cd95f524594f49, []int{30}
 }
 
 func (m *ChannelStatus) XXX_Unmarshal(b []byte) error {
@@ -1788,7 +1884,7 @@ func (m *DataNodeInfo) Reset()         { *m = DataNodeInfo{} }
 func (m *DataNodeInfo) String() string { return proto.CompactTextString(m) }
 func (*DataNodeInfo) ProtoMessage()    {}
 func (*DataNodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{30}
+	return fileDescriptor_82cd95f524594f49, []int{31}
 }
 
 func (m *DataNodeInfo) XXX_Unmarshal(b []byte) error {
@@ -1833,6 +1929,9 @@ func (m *DataNodeInfo) GetChannels() []*ChannelStatus {
 type SegmentBinlogs struct {
 	SegmentID            int64          `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	FieldBinlogs         []*FieldBinlog `protobuf:"bytes,2,rep,name=fieldBinlogs,proto3" json:"fieldBinlogs,omitempty"`
+	NumOfRows            int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	Statslogs            []*FieldBinlog `protobuf:"bytes,4,rep,name=statslogs,proto3" json:"statslogs,omitempty"`
+	Deltalogs            []string       `protobuf:"bytes,5,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -1842,7 +1941,7 @@ func (m *SegmentBinlogs) Reset()         { *m = SegmentBinlogs{} }
 func (m *SegmentBinlogs) String() string { return proto.CompactTextString(m) }
 func (*SegmentBinlogs) ProtoMessage()    {}
 func (*SegmentBinlogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{31}
+	return fileDescriptor_82cd95f524594f49, []int{32}
 }
 
 func (m *SegmentBinlogs) XXX_Unmarshal(b []byte) error {
@@ -1877,6 +1976,27 @@ func (m *SegmentBinlogs) GetFieldBinlogs() []*FieldBinlog {
 	return nil
 }
 
+func (m *SegmentBinlogs) GetNumOfRows() int64 {
+	if m != nil {
+		return m.NumOfRows
+	}
+	return 0
+}
+
+func (m *SegmentBinlogs) GetStatslogs() []*FieldBinlog {
+	if m != nil {
+		return m.Statslogs
+	}
+	return nil
+}
+
+func (m *SegmentBinlogs) GetDeltalogs() []string {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
+}
+
 type FieldBinlog struct {
 	FieldID              int64    `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
 	Binlogs              []string `protobuf:"bytes,2,rep,name=binlogs,proto3" json:"binlogs,omitempty"`
@@ -1889,7 +2009,7 @@ func (m *FieldBinlog) Reset()         { *m = FieldBinlog{} }
 func (m *FieldBinlog) String() string { return proto.CompactTextString(m) }
 func (*FieldBinlog) ProtoMessage()    {}
 func (*FieldBinlog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{32}
+	return fileDescriptor_82cd95f524594f49, []int{33}
 }
 
 func (m *FieldBinlog) XXX_Unmarshal(b []byte) error {
@@ -1937,7 +2057,7 @@ func (m *GetRecoveryInfoResponse) Reset()         { *m = GetRecoveryInfoResponse
 func (m *GetRecoveryInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRecoveryInfoResponse) ProtoMessage()    {}
 func (*GetRecoveryInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{33}
+	return fileDescriptor_82cd95f524594f49, []int{34}
 }
 
 func (m *GetRecoveryInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -1992,7 +2112,7 @@ func (m *GetRecoveryInfoRequest) Reset()         { *m = GetRecoveryInfoRequest{}
 func (m *GetRecoveryInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRecoveryInfoRequest) ProtoMessage()    {}
 func (*GetRecoveryInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{34}
+	return fileDescriptor_82cd95f524594f49, []int{35}
 }
 
 func (m *GetRecoveryInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -2047,7 +2167,7 @@ func (m *GetFlushedSegmentsRequest) Reset()         { *m = GetFlushedSegmentsReq
 func (m *GetFlushedSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetFlushedSegmentsRequest) ProtoMessage()    {}
 func (*GetFlushedSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{35}
+	return fileDescriptor_82cd95f524594f49, []int{36}
 }
 
 func (m *GetFlushedSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -2101,7 +2221,7 @@ func (m *GetFlushedSegmentsResponse) Reset()         { *m = GetFlushedSegmentsRe
 func (m *GetFlushedSegmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetFlushedSegmentsResponse) ProtoMessage()    {}
 func (*GetFlushedSegmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{36}
+	return fileDescriptor_82cd95f524594f49, []int{37}
 }
 
 func (m *GetFlushedSegmentsResponse) XXX_Unmarshal(b []byte) error {
@@ -2136,6 +2256,62 @@ func (m *GetFlushedSegmentsResponse) GetSegments() []int64 {
 	return nil
 }
 
+// MoveChannelRequest hands a dm channel over to the data node dst_nodeID
+type MoveChannelRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ChannelName          string            `protobuf:"bytes,2,opt,name=channelName,proto3" json:"channelName,omitempty"`
+	DstNodeID            int64             `protobuf:"varint,3,opt,name=dst_nodeID,json=dstNodeID,proto3" json:"dst_nodeID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *MoveChannelRequest) Reset()         { *m = MoveChannelRequest{} }
+func (m *MoveChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*MoveChannelRequest) ProtoMessage()    {}
+func (*MoveChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{38}
+}
+
+func (m *MoveChannelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoveChannelRequest.Unmarshal(m, b)
+}
+func (m *MoveChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoveChannelRequest.Marshal(b, m, deterministic)
+}
+func (m *MoveChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveChannelRequest.Merge(m, src)
+}
+func (m *MoveChannelRequest) XXX_Size() int {
+	return xxx_messageInfo_MoveChannelRequest.Size(m)
+}
+func (m *MoveChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveChannelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveChannelRequest proto.InternalMessageInfo
+
+func (m *MoveChannelRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *MoveChannelRequest) GetChannelName() string {
+	if m != nil {
+		return m.ChannelName
+	}
+	return ""
+}
+
+func (m *MoveChannelRequest) GetDstNodeID() int64 {
+	if m != nil {
+		return m.DstNodeID
+	}
+	return 0
+}
+
 type SegmentFlushCompletedMsg struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Segment              *SegmentInfo      `protobuf:"bytes,2,opt,name=segment,proto3" json:"segment,omitempty"`
@@ -2148,7 +2324,7 @@ func (m *SegmentFlushCompletedMsg) Reset()         { *m = SegmentFlushCompletedM
 func (m *SegmentFlushCompletedMsg) String() string { return proto.CompactTextString(m) }
 func (*SegmentFlushCompletedMsg) ProtoMessage()    {}
 func (*SegmentFlushCompletedMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{37}
+	return fileDescriptor_82cd95f524594f49, []int{39}
 }
 
 func (m *SegmentFlushCompletedMsg) XXX_Unmarshal(b []byte) error {
@@ -2196,7 +2372,7 @@ func (m *ChannelWatchInfo) Reset()         { *m = ChannelWatchInfo{} }
 func (m *ChannelWatchInfo) String() string { return proto.CompactTextString(m) }
 func (*ChannelWatchInfo) ProtoMessage()    {}
 func (*ChannelWatchInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{38}
+	return fileDescriptor_82cd95f524594f49, []int{40}
 }
 
 func (m *ChannelWatchInfo) XXX_Unmarshal(b []byte) error {
@@ -2251,7 +2427,7 @@ func (m *SegmentFieldBinlogMeta) Reset()         { *m = SegmentFieldBinlogMeta{}
 func (m *SegmentFieldBinlogMeta) String() string { return proto.CompactTextString(m) }
 func (*SegmentFieldBinlogMeta) ProtoMessage()    {}
 func (*SegmentFieldBinlogMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{39}
+	return fileDescriptor_82cd95f524594f49, []int{41}
 }
 
 func (m *SegmentFieldBinlogMeta) XXX_Unmarshal(b []byte) error {
@@ -2309,6 +2485,7 @@ func init() {
 	proto.RegisterType((*VchannelInfo)(nil), "milvus.proto.data.VchannelInfo")
 	proto.RegisterType((*WatchDmChannelsRequest)(nil), "milvus.proto.data.WatchDmChannelsRequest")
 	proto.RegisterType((*FlushSegmentsRequest)(nil), "milvus.proto.data.FlushSegmentsRequest")
+	proto.RegisterType((*ReleaseDmChannelsRequest)(nil), "milvus.proto.data.ReleaseDmChannelsRequest")
 	proto.RegisterType((*SegmentMsg)(nil), "milvus.proto.data.SegmentMsg")
 	proto.RegisterType((*DDLFlushMeta)(nil), "milvus.proto.data.DDLFlushMeta")
 	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.data.CollectionInfo")
@@ -2325,6 +2502,7 @@ func init() {
 	proto.RegisterType((*GetRecoveryInfoRequest)(nil), "milvus.proto.data.GetRecoveryInfoRequest")
 	proto.RegisterType((*GetFlushedSegmentsRequest)(nil), "milvus.proto.data.GetFlushedSegmentsRequest")
 	proto.RegisterType((*GetFlushedSegmentsResponse)(nil), "milvus.proto.data.GetFlushedSegmentsResponse")
+	proto.RegisterType((*MoveChannelRequest)(nil), "milvus.proto.data.MoveChannelRequest")
 	proto.RegisterType((*SegmentFlushCompletedMsg)(nil), "milvus.proto.data.SegmentFlushCompletedMsg")
 	proto.RegisterType((*ChannelWatchInfo)(nil), "milvus.proto.data.ChannelWatchInfo")
 	proto.RegisterType((*SegmentFieldBinlogMeta)(nil), "milvus.proto.data.SegmentFieldBinlogMeta")
@@ -2333,132 +2511,144 @@ func init() {
 func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
 
 var fileDescriptor_82cd95f524594f49 = []byte{
-	// 1992 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xdb, 0x6f, 0x1b, 0x59,
-	0x19, 0xef, 0x78, 0x72, 0xb1, 0x3f, 0x3b, 0x4e, 0x72, 0x28, 0x59, 0xe3, 0x76, 0xd3, 0x74, 0x60,
-	0xdb, 0x6c, 0x61, 0x93, 0xad, 0x0b, 0x62, 0x45, 0x59, 0xd0, 0x36, 0xde, 0x46, 0x11, 0x49, 0x09,
-	0x93, 0xee, 0xae, 0xc4, 0x3e, 0x58, 0x13, 0xfb, 0xc4, 0x19, 0xea, 0x99, 0xf1, 0xce, 0x39, 0x4e,
-	0xd3, 0xa7, 0xae, 0x8a, 0xb4, 0x08, 0x84, 0xb8, 0x08, 0xf1, 0x86, 0x04, 0xe2, 0x09, 0x89, 0x17,
-	0xfe, 0x0c, 0xfe, 0x25, 0xde, 0xd0, 0xb9, 0xce, 0xd5, 0xf6, 0xc4, 0xd9, 0x36, 0x6f, 0x3e, 0x67,
-	0xbe, 0xdb, 0xf9, 0xce, 0x77, 0xf9, 0x9d, 0x2f, 0x81, 0x95, 0x9e, 0x43, 0x9d, 0x4e, 0x37, 0x08,
-	0xc2, 0xde, 0xd6, 0x30, 0x0c, 0x68, 0x80, 0x56, 0x3d, 0x77, 0x70, 0x36, 0x22, 0x62, 0xb5, 0xc5,
-	0x3e, 0x37, 0x6b, 0xdd, 0xc0, 0xf3, 0x02, 0x5f, 0x6c, 0x35, 0xeb, 0xae, 0x4f, 0x71, 0xe8, 0x3b,
-	0x03, 0xb9, 0xae, 0xc5, 0x19, 0x9a, 0x35, 0xd2, 0x3d, 0xc5, 0x9e, 0x23, 0x56, 0xd6, 0x39, 0xd4,
-	0x1e, 0x0f, 0x46, 0xe4, 0xd4, 0xc6, 0x5f, 0x8c, 0x30, 0xa1, 0xe8, 0x7d, 0x98, 0x3b, 0x76, 0x08,
-	0x6e, 0x18, 0x1b, 0xc6, 0x66, 0xb5, 0x75, 0x73, 0x2b, 0xa1, 0x4b, 0x6a, 0x39, 0x20, 0xfd, 0x47,
-	0x0e, 0xc1, 0x36, 0xa7, 0x44, 0x08, 0xe6, 0x7a, 0xc7, 0x7b, 0xed, 0x46, 0x69, 0xc3, 0xd8, 0x34,
-	0x6d, 0xfe, 0x1b, 0x59, 0x50, 0xeb, 0x06, 0x83, 0x01, 0xee, 0x52, 0x37, 0xf0, 0xf7, 0xda, 0x8d,
-	0x39, 0xfe, 0x2d, 0xb1, 0x67, 0xfd, 0xcd, 0x80, 0x25, 0xa9, 0x9a, 0x0c, 0x03, 0x9f, 0x60, 0xf4,
-	0x00, 0x16, 0x08, 0x75, 0xe8, 0x88, 0x48, 0xed, 0x37, 0x72, 0xb5, 0x1f, 0x71, 0x12, 0x5b, 0x92,
-	0x16, 0x52, 0x6f, 0x66, 0xd5, 0xa3, 0x75, 0x00, 0x82, 0xfb, 0x1e, 0xf6, 0xe9, 0x5e, 0x9b, 0x34,
-	0xe6, 0x36, 0xcc, 0x4d, 0xd3, 0x8e, 0xed, 0x58, 0x7f, 0x36, 0x60, 0xe5, 0x48, 0x2d, 0x95, 0x77,
-	0xae, 0xc3, 0x7c, 0x37, 0x18, 0xf9, 0x94, 0x1b, 0xb8, 0x64, 0x8b, 0x05, 0xba, 0x0d, 0xb5, 0xee,
-	0xa9, 0xe3, 0xfb, 0x78, 0xd0, 0xf1, 0x1d, 0x0f, 0x73, 0x53, 0x2a, 0x76, 0x55, 0xee, 0x3d, 0x71,
-	0x3c, 0x5c, 0xc8, 0xa2, 0x0d, 0xa8, 0x0e, 0x9d, 0x90, 0xba, 0x09, 0x9f, 0xc5, 0xb7, 0xac, 0x7f,
-	0x18, 0xb0, 0xf6, 0x11, 0x21, 0x6e, 0xdf, 0xcf, 0x58, 0xb6, 0x06, 0x0b, 0x7e, 0xd0, 0xc3, 0x7b,
-	0x6d, 0x6e, 0x9a, 0x69, 0xcb, 0x15, 0xba, 0x01, 0x95, 0x21, 0xc6, 0x61, 0x27, 0x0c, 0x06, 0xca,
-	0xb0, 0x32, 0xdb, 0xb0, 0x83, 0x01, 0x46, 0xbf, 0x80, 0x55, 0x92, 0x12, 0x44, 0x1a, 0xe6, 0x86,
-	0xb9, 0x59, 0x6d, 0x7d, 0x7b, 0x2b, 0x13, 0x65, 0x5b, 0x69, 0xa5, 0x76, 0x96, 0xdb, 0xfa, 0xb2,
-	0x04, 0xdf, 0xd0, 0x74, 0xc2, 0x56, 0xf6, 0x9b, 0x79, 0x8e, 0xe0, 0xbe, 0x36, 0x4f, 0x2c, 0x8a,
-	0x78, 0x4e, 0xbb, 0xdc, 0x8c, 0xbb, 0xbc, 0x40, 0x80, 0xa5, 0xfd, 0x39, 0x9f, 0xf1, 0x27, 0xba,
-	0x05, 0x55, 0x7c, 0x3e, 0x74, 0x43, 0xdc, 0xa1, 0xae, 0x87, 0x1b, 0x0b, 0x1b, 0xc6, 0xe6, 0x9c,
-	0x0d, 0x62, 0xeb, 0xa9, 0xeb, 0xc5, 0x23, 0x72, 0xb1, 0x70, 0x44, 0x5a, 0xff, 0x34, 0xe0, 0xad,
-	0xcc, 0x2d, 0xc9, 0x10, 0xb7, 0x61, 0x85, 0x9f, 0x3c, 0xf2, 0x0c, 0x0b, 0x76, 0xe6, 0xf0, 0x3b,
-	0x93, 0x1c, 0x1e, 0x91, 0xdb, 0x19, 0xfe, 0x98, 0x91, 0xa5, 0xe2, 0x46, 0x3e, 0x83, 0xb7, 0x76,
-	0x31, 0x95, 0x0a, 0xd8, 0x37, 0x4c, 0x66, 0x2f, 0x01, 0xc9, 0x5c, 0x2a, 0x65, 0x72, 0xe9, 0x3f,
-	0x25, 0x9d, 0x4b, 0x5c, 0xd5, 0x9e, 0x7f, 0x12, 0xa0, 0x9b, 0x50, 0xd1, 0x24, 0x32, 0x2a, 0xa2,
-	0x0d, 0xf4, 0x43, 0x98, 0x67, 0x96, 0x8a, 0x90, 0xa8, 0xb7, 0x6e, 0xe7, 0x9f, 0x29, 0x26, 0xd3,
-	0x16, 0xf4, 0x68, 0x0f, 0xea, 0x84, 0x3a, 0x21, 0xed, 0x0c, 0x03, 0xc2, 0xef, 0x99, 0x07, 0x4e,
-	0xb5, 0x65, 0x25, 0x25, 0xe8, 0x12, 0x79, 0x40, 0xfa, 0x87, 0x92, 0xd2, 0x5e, 0xe2, 0x9c, 0x6a,
-	0x89, 0x3e, 0x86, 0x1a, 0xf6, 0x7b, 0x91, 0xa0, 0xb9, 0xc2, 0x82, 0xaa, 0xd8, 0xef, 0x69, 0x31,
-	0xd1, 0xfd, 0xcc, 0x17, 0xbf, 0x9f, 0xdf, 0x1b, 0xd0, 0xc8, 0x5e, 0xd0, 0x65, 0x0a, 0xe5, 0x43,
-	0xc1, 0x84, 0xc5, 0x05, 0x4d, 0xcc, 0x70, 0x7d, 0x49, 0xb6, 0x64, 0xb1, 0x5c, 0xf8, 0x66, 0x64,
-	0x0d, 0xff, 0xf2, 0xda, 0x82, 0xe5, 0xd7, 0x06, 0xac, 0xa5, 0x75, 0x5d, 0xe6, 0xdc, 0xdf, 0x87,
-	0x79, 0xd7, 0x3f, 0x09, 0xd4, 0xb1, 0xd7, 0x27, 0xe4, 0x19, 0xd3, 0x25, 0x88, 0x2d, 0x0f, 0x6e,
-	0xec, 0x62, 0xba, 0xe7, 0x13, 0x1c, 0xd2, 0x47, 0xae, 0x3f, 0x08, 0xfa, 0x87, 0x0e, 0x3d, 0xbd,
-	0x44, 0x8e, 0x24, 0xc2, 0xbd, 0x94, 0x0a, 0x77, 0xeb, 0x5f, 0x06, 0xdc, 0xcc, 0xd7, 0x27, 0x8f,
-	0xde, 0x84, 0xf2, 0x89, 0x8b, 0x07, 0x3d, 0xe6, 0x33, 0x83, 0xfb, 0x4c, 0xaf, 0x59, 0xae, 0x0c,
-	0x19, 0xb1, 0x3c, 0xe1, 0xed, 0x31, 0x01, 0x7a, 0x44, 0x43, 0xd7, 0xef, 0xef, 0xbb, 0x84, 0xda,
-	0x82, 0x3e, 0xe6, 0x4f, 0xb3, 0x78, 0x64, 0xfe, 0xce, 0x80, 0xf5, 0x5d, 0x4c, 0x77, 0x74, 0xa9,
-	0x65, 0xdf, 0x5d, 0x42, 0xdd, 0x2e, 0x79, 0xbd, 0x20, 0x22, 0xa7, 0x67, 0x5a, 0x7f, 0x34, 0xe0,
-	0xd6, 0x58, 0x63, 0xa4, 0xeb, 0x64, 0x29, 0x51, 0x85, 0x36, 0xbf, 0x94, 0xfc, 0x0c, 0xbf, 0xf8,
-	0xd4, 0x19, 0x8c, 0xf0, 0xa1, 0xe3, 0x86, 0xa2, 0x94, 0xcc, 0x58, 0x58, 0xff, 0x6d, 0xc0, 0xdb,
-	0xbb, 0x98, 0x1e, 0xaa, 0x36, 0x73, 0x85, 0xde, 0x29, 0x80, 0x28, 0xfe, 0x20, 0x2e, 0x33, 0xd7,
-	0xda, 0x2b, 0x71, 0xdf, 0x3a, 0xcf, 0x83, 0x58, 0x42, 0xee, 0x08, 0x2c, 0x20, 0x9d, 0x67, 0xfd,
-	0xb5, 0x04, 0xb5, 0x4f, 0x25, 0x3e, 0xe0, 0x6d, 0x24, 0xed, 0x07, 0x23, 0xdf, 0x0f, 0x31, 0x48,
-	0x91, 0x87, 0x32, 0x76, 0x61, 0x89, 0x60, 0xfc, 0x6c, 0x96, 0xa6, 0x51, 0x63, 0x8c, 0xba, 0xd8,
-	0xef, 0xc3, 0xea, 0xc8, 0x3f, 0x61, 0xb0, 0x16, 0xf7, 0xe4, 0x29, 0x04, 0xba, 0x9c, 0x5e, 0x79,
-	0xb2, 0x8c, 0x68, 0x13, 0x96, 0xd3, 0xb2, 0xe6, 0x79, 0xf2, 0xa7, 0xb7, 0xad, 0xdf, 0x1a, 0xb0,
-	0xf6, 0x99, 0x43, 0xbb, 0xa7, 0x6d, 0x4f, 0x7a, 0xec, 0x12, 0xf1, 0xf6, 0x21, 0x54, 0xce, 0xa4,
-	0x77, 0x54, 0x51, 0xb9, 0x95, 0x63, 0x7c, 0xfc, 0x1e, 0xec, 0x88, 0x83, 0xc1, 0xd4, 0xeb, 0x1c,
-	0xd9, 0x2b, 0xeb, 0xde, 0x7c, 0xe4, 0x4f, 0x43, 0xf7, 0xe7, 0x00, 0xd2, 0xb8, 0x03, 0xd2, 0x9f,
-	0xc1, 0xae, 0x0f, 0x60, 0x51, 0x4a, 0x93, 0xc1, 0x3d, 0xed, 0x72, 0x15, 0xb9, 0xf5, 0x09, 0xd4,
-	0xda, 0xed, 0x7d, 0xee, 0x9e, 0x03, 0x4c, 0x9d, 0x42, 0xf1, 0x7b, 0x1b, 0x6a, 0xc7, 0xbc, 0x27,
-	0x74, 0xa2, 0x3a, 0x5f, 0xb1, 0xab, 0xc7, 0x51, 0x9f, 0xb0, 0x5e, 0x42, 0x3d, 0x2a, 0x82, 0x3c,
-	0x31, 0xea, 0x50, 0xd2, 0xe2, 0x4a, 0x7b, 0x6d, 0xf4, 0x21, 0x2c, 0x88, 0x97, 0x9f, 0xb4, 0xf8,
-	0x9d, 0xa4, 0xc5, 0xf2, 0x55, 0x18, 0xab, 0xa4, 0x7c, 0xc3, 0x96, 0x4c, 0xcc, 0xa3, 0xba, 0x70,
-	0x88, 0x47, 0x82, 0x69, 0xc7, 0x76, 0xac, 0xff, 0x99, 0x50, 0x8d, 0x1d, 0x38, 0xa3, 0x3e, 0x7d,
-	0xce, 0xd2, 0xf4, 0x7a, 0x65, 0x66, 0x11, 0xfb, 0x3b, 0x50, 0x77, 0x79, 0x8f, 0xec, 0xc8, 0x68,
-	0xe3, 0x45, 0xad, 0x62, 0x2f, 0x89, 0x5d, 0x19, 0xfa, 0x68, 0x1d, 0xaa, 0xfe, 0xc8, 0xeb, 0x04,
-	0x27, 0x9d, 0x30, 0x78, 0x4e, 0x24, 0xf4, 0xaf, 0xf8, 0x23, 0xef, 0xe7, 0x27, 0x76, 0xf0, 0x9c,
-	0x44, 0xe8, 0x72, 0xe1, 0x82, 0xe8, 0x72, 0x1d, 0xaa, 0x9e, 0x73, 0xce, 0xa4, 0x76, 0xfc, 0x91,
-	0xc7, 0x5f, 0x05, 0xa6, 0x5d, 0xf1, 0x9c, 0x73, 0x3b, 0x78, 0xfe, 0x64, 0xe4, 0xa1, 0x4d, 0x58,
-	0x19, 0x38, 0x84, 0x76, 0xe2, 0xcf, 0x8a, 0x32, 0x7f, 0x56, 0xd4, 0xd9, 0xfe, 0xc7, 0xd1, 0xd3,
-	0x22, 0x8b, 0x53, 0x2b, 0x97, 0xc0, 0xa9, 0x3d, 0x6f, 0x10, 0x09, 0x82, 0xe2, 0x38, 0xb5, 0xe7,
-	0x0d, 0xb4, 0x98, 0x0f, 0x60, 0x51, 0x44, 0x14, 0x69, 0x54, 0xc7, 0x16, 0xac, 0xc7, 0x0c, 0x74,
-	0x08, 0x80, 0x62, 0x2b, 0x72, 0xeb, 0x25, 0x5c, 0x8f, 0x9c, 0x15, 0x33, 0x2c, 0x7b, 0x46, 0x63,
-	0xd6, 0x33, 0x4e, 0x86, 0x4f, 0xbf, 0x31, 0x61, 0xed, 0xc8, 0x39, 0xc3, 0xaf, 0x1f, 0xa9, 0x15,
-	0xaa, 0x3e, 0xfb, 0xb0, 0xca, 0xc1, 0x59, 0x2b, 0x66, 0xcf, 0x84, 0x26, 0x10, 0xf7, 0x69, 0x96,
-	0x11, 0xfd, 0x94, 0x75, 0x2f, 0xdc, 0x7d, 0x76, 0x18, 0xb8, 0xaa, 0x01, 0x54, 0x5b, 0x6f, 0xe7,
-	0xc8, 0xd9, 0xd1, 0x54, 0x76, 0x9c, 0x03, 0x1d, 0xc2, 0x72, 0xf2, 0x1a, 0x48, 0x63, 0x81, 0x0b,
-	0xb9, 0x3b, 0xf1, 0x09, 0x10, 0x79, 0xdf, 0xae, 0x27, 0x2e, 0x83, 0xa0, 0x06, 0x2c, 0xca, 0x06,
-	0xc4, 0x53, 0xa0, 0x6c, 0xab, 0x25, 0x43, 0x87, 0x10, 0xd9, 0x31, 0xe5, 0x91, 0xf7, 0x13, 0x28,
-	0xeb, 0xc8, 0x28, 0x15, 0x8e, 0x0c, 0xcd, 0x93, 0x4e, 0x73, 0x33, 0x95, 0xe6, 0xd6, 0x2b, 0x03,
-	0x96, 0xda, 0x0e, 0x75, 0x9e, 0x04, 0x3d, 0xfc, 0x74, 0xc6, 0x4a, 0x5f, 0x60, 0x44, 0x71, 0x13,
-	0x2a, 0x2c, 0xd1, 0x09, 0x75, 0xbc, 0x21, 0x37, 0x62, 0xce, 0x8e, 0x36, 0xd8, 0x7b, 0x66, 0x49,
-	0xd6, 0xa5, 0x23, 0x3d, 0xb2, 0xe2, 0xa2, 0x0c, 0x2e, 0x8a, 0xff, 0x46, 0x3f, 0x4a, 0xbe, 0x77,
-	0xbf, 0x93, 0x7b, 0xbd, 0x5c, 0x08, 0xef, 0xf2, 0x89, 0xa2, 0x54, 0x04, 0x28, 0x7f, 0x69, 0x40,
-	0x4d, 0xb9, 0x82, 0xd7, 0xe7, 0x06, 0x2c, 0x3a, 0xbd, 0x5e, 0x88, 0x09, 0x91, 0x76, 0xa8, 0x25,
-	0xfb, 0x72, 0x86, 0x43, 0xa2, 0x2e, 0xc5, 0xb4, 0xd5, 0x12, 0xfd, 0x18, 0xca, 0x1a, 0x16, 0x88,
-	0x31, 0xd1, 0xc6, 0x78, 0x3b, 0x25, 0xb0, 0xd3, 0x1c, 0x56, 0x08, 0x75, 0x19, 0x5c, 0x22, 0xba,
-	0xc9, 0x94, 0xe8, 0x78, 0x04, 0xb5, 0x93, 0x28, 0x33, 0x26, 0xbd, 0xdf, 0xe2, 0x09, 0x94, 0xe0,
-	0xb1, 0x3e, 0x82, 0x6a, 0xec, 0x23, 0x8f, 0x5b, 0xf1, 0x6a, 0x92, 0xea, 0xd4, 0x92, 0x7d, 0x39,
-	0x8e, 0xe9, 0xa9, 0x44, 0xc5, 0xed, 0xbf, 0x06, 0x1f, 0x95, 0xd8, 0xb8, 0x1b, 0x9c, 0xe1, 0xf0,
-	0xc5, 0xe5, 0x1f, 0xa4, 0x0f, 0x63, 0x5e, 0x2c, 0x08, 0xae, 0x34, 0x03, 0x7a, 0x18, 0xd9, 0x69,
-	0xe6, 0xe1, 0xf1, 0x78, 0x0e, 0x4b, 0x27, 0x44, 0x47, 0xf9, 0x93, 0x78, 0x5a, 0x27, 0x8f, 0x32,
-	0x6b, 0x99, 0xfc, 0x5a, 0x1a, 0xba, 0xf5, 0x17, 0x03, 0xbe, 0xb5, 0x8b, 0xe9, 0xe3, 0x24, 0x9c,
-	0xbd, 0x6a, 0xab, 0x3c, 0x68, 0xe6, 0x19, 0x75, 0x99, 0x5b, 0x6f, 0x42, 0x99, 0x28, 0x0c, 0x2f,
-	0x86, 0x1e, 0x7a, 0x6d, 0x7d, 0x65, 0x40, 0x43, 0x6a, 0xe1, 0x3a, 0x77, 0x02, 0x6f, 0x38, 0xc0,
-	0x14, 0xf7, 0xde, 0x34, 0x38, 0xfd, 0xbb, 0x01, 0x2b, 0xf1, 0x32, 0xc3, 0x2b, 0xc5, 0x0f, 0x60,
-	0x9e, 0x63, 0x7b, 0x69, 0xc1, 0xd4, 0x60, 0x15, 0xd4, 0x2c, 0xa3, 0x78, 0xd7, 0x78, 0x4a, 0x54,
-	0x19, 0x91, 0xcb, 0xa8, 0xd6, 0x99, 0x17, 0xae, 0x75, 0xd6, 0x11, 0xac, 0x29, 0x4f, 0x45, 0x79,
-	0xcd, 0x81, 0xf4, 0xf8, 0xdc, 0xbe, 0x05, 0xd5, 0x18, 0x7c, 0x96, 0x15, 0x1c, 0x22, 0xf4, 0x7c,
-	0xef, 0x3e, 0xac, 0x66, 0x14, 0xa2, 0x3a, 0xc0, 0x27, 0x7e, 0x57, 0xde, 0xc4, 0xca, 0x35, 0x54,
-	0x83, 0xb2, 0xba, 0x97, 0x15, 0xa3, 0xf5, 0x6a, 0x09, 0x2a, 0xac, 0x9e, 0xee, 0x04, 0x41, 0xd8,
-	0x43, 0x43, 0x40, 0x7c, 0x0a, 0xe1, 0x0d, 0x03, 0x5f, 0x8f, 0xeb, 0xd0, 0xfb, 0x63, 0x9a, 0x59,
-	0x96, 0x54, 0xc6, 0x7b, 0xf3, 0xce, 0x18, 0x8e, 0x14, 0xb9, 0x75, 0x0d, 0x79, 0x5c, 0x23, 0x43,
-	0x92, 0x4f, 0xdd, 0xee, 0x33, 0x85, 0x7b, 0x27, 0x68, 0x4c, 0x91, 0x2a, 0x8d, 0xa9, 0x29, 0xa0,
-	0x5c, 0x88, 0x51, 0x91, 0x0a, 0x78, 0xeb, 0x1a, 0xfa, 0x02, 0xae, 0xb3, 0x67, 0xb9, 0x9e, 0x0e,
-	0x28, 0x85, 0xad, 0xf1, 0x0a, 0x33, 0xc4, 0x17, 0x54, 0xb9, 0x0f, 0xf3, 0x3c, 0x19, 0x50, 0x5e,
-	0xc0, 0xc5, 0xff, 0x66, 0xd5, 0xdc, 0x18, 0x4f, 0xa0, 0xa5, 0xfd, 0x0a, 0x96, 0x53, 0x33, 0x79,
-	0xf4, 0x6e, 0x0e, 0x5b, 0xfe, 0x5f, 0x57, 0x9a, 0xf7, 0x8a, 0x90, 0x6a, 0x5d, 0x7d, 0xa8, 0x27,
-	0x67, 0x18, 0x68, 0x33, 0x87, 0x3f, 0x77, 0x9e, 0xda, 0x7c, 0xb7, 0x00, 0xa5, 0x56, 0xe4, 0xc1,
-	0x4a, 0x7a, 0x46, 0x8c, 0xee, 0x4d, 0x14, 0x90, 0x0c, 0xb7, 0xef, 0x16, 0xa2, 0xd5, 0xea, 0x5e,
-	0xf0, 0x20, 0xc8, 0xcc, 0x28, 0xd1, 0x56, 0xbe, 0x98, 0x71, 0xc3, 0xd3, 0xe6, 0x76, 0x61, 0x7a,
-	0xad, 0xfa, 0x95, 0x68, 0xc2, 0x79, 0x73, 0x3e, 0x74, 0x3f, 0x5f, 0xdc, 0x84, 0x01, 0x65, 0xb3,
-	0x75, 0x11, 0x16, 0x6d, 0xc4, 0x4b, 0xde, 0x3d, 0x73, 0x66, 0x65, 0xe9, 0xbc, 0x53, 0xf2, 0xc6,
-	0x0f, 0x01, 0x9b, 0xf7, 0x2f, 0xc0, 0xa1, 0x0d, 0x08, 0xd2, 0x53, 0x78, 0x95, 0x86, 0xdb, 0x53,
-	0xa3, 0x66, 0xb6, 0x1c, 0xfc, 0x1c, 0x96, 0x53, 0xcf, 0xaa, 0xdc, 0xac, 0xc9, 0x7f, 0x7a, 0x35,
-	0x27, 0xf5, 0x45, 0x91, 0x92, 0x29, 0x30, 0x82, 0xc6, 0x44, 0x7f, 0x0e, 0x60, 0x69, 0xde, 0x2b,
-	0x42, 0xaa, 0x0f, 0x42, 0x78, 0xb9, 0x4c, 0x35, 0x74, 0xf4, 0xbd, 0x7c, 0x19, 0xf9, 0x60, 0xa4,
-	0xf9, 0x5e, 0x41, 0x6a, 0xad, 0xb4, 0x03, 0xb0, 0x8b, 0xe9, 0x01, 0xa6, 0x21, 0x8b, 0x91, 0x3b,
-	0xb9, 0x2e, 0x8f, 0x08, 0x94, 0x9a, 0xbb, 0x53, 0xe9, 0x94, 0x82, 0xd6, 0x57, 0x73, 0x50, 0x56,
-	0xa0, 0xfe, 0x0a, 0x7a, 0xd0, 0x15, 0x34, 0x85, 0xcf, 0x61, 0x39, 0x35, 0xe5, 0xcc, 0x8d, 0x99,
-	0xfc, 0x49, 0xe8, 0xb4, 0x80, 0xfc, 0x4c, 0xfe, 0x43, 0x82, 0x8e, 0x8f, 0xbb, 0xe3, 0x1a, 0x4b,
-	0x3a, 0x34, 0xa6, 0x08, 0x7e, 0xdd, 0x81, 0xf0, 0xe8, 0xc1, 0x2f, 0xef, 0xf7, 0x5d, 0x7a, 0x3a,
-	0x3a, 0x66, 0xaa, 0xb7, 0x05, 0xe5, 0x7b, 0x6e, 0x20, 0x7f, 0x6d, 0xab, 0x1b, 0xd8, 0xe6, 0x92,
-	0xb6, 0xd9, 0x39, 0x86, 0xc7, 0xc7, 0x0b, 0x7c, 0xf5, 0xe0, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xd5, 0xbb, 0xa2, 0xe1, 0x62, 0x22, 0x00, 0x00,
+	// 2179 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x1a, 0x4d, 0x6f, 0x1b, 0xc7,
+	0xd5, 0xcb, 0xa5, 0x64, 0xf2, 0x91, 0xa2, 0xa8, 0x89, 0xaa, 0xb0, 0xb4, 0x2d, 0xcb, 0xdb, 0xda,
+	0x96, 0xdd, 0x46, 0xb2, 0xe9, 0x16, 0x0d, 0xea, 0xa4, 0x45, 0x24, 0xc6, 0x02, 0x51, 0xc9, 0x55,
+	0x97, 0x4e, 0x02, 0x34, 0x07, 0x62, 0x45, 0x8e, 0xa8, 0xad, 0xb9, 0xbb, 0x0c, 0x67, 0x28, 0xcb,
+	0xb9, 0x38, 0x48, 0x81, 0x16, 0x2d, 0x8a, 0x7e, 0xa0, 0xe8, 0xad, 0x40, 0x8b, 0x9e, 0x0a, 0xe4,
+	0xd2, 0xbf, 0xd0, 0x5b, 0xff, 0x49, 0x6f, 0x3d, 0xb6, 0xd7, 0x62, 0x3e, 0xf6, 0x7b, 0x48, 0xae,
+	0xc4, 0x38, 0xba, 0x71, 0x66, 0xde, 0xd7, 0xbc, 0xaf, 0x79, 0xef, 0x2d, 0xa1, 0xda, 0xb3, 0xa8,
+	0xd5, 0xe9, 0x7a, 0xde, 0xa8, 0xb7, 0x35, 0x1c, 0x79, 0xd4, 0x43, 0x2b, 0x8e, 0x3d, 0x38, 0x1d,
+	0x13, 0xb1, 0xda, 0x62, 0xc7, 0xf5, 0x72, 0xd7, 0x73, 0x1c, 0xcf, 0x15, 0x5b, 0xf5, 0x8a, 0xed,
+	0x52, 0x3c, 0x72, 0xad, 0x81, 0x5c, 0x97, 0xa3, 0x08, 0xf5, 0x32, 0xe9, 0x9e, 0x60, 0xc7, 0x12,
+	0x2b, 0xe3, 0x0c, 0xca, 0x4f, 0x06, 0x63, 0x72, 0x62, 0xe2, 0x4f, 0xc6, 0x98, 0x50, 0xf4, 0x00,
+	0xf2, 0x47, 0x16, 0xc1, 0x35, 0x6d, 0x43, 0xdb, 0x2c, 0x35, 0xae, 0x6f, 0xc5, 0x78, 0x49, 0x2e,
+	0x07, 0xa4, 0xbf, 0x63, 0x11, 0x6c, 0x72, 0x48, 0x84, 0x20, 0xdf, 0x3b, 0x6a, 0x35, 0x6b, 0xb9,
+	0x0d, 0x6d, 0x53, 0x37, 0xf9, 0x6f, 0x64, 0x40, 0xb9, 0xeb, 0x0d, 0x06, 0xb8, 0x4b, 0x6d, 0xcf,
+	0x6d, 0x35, 0x6b, 0x79, 0x7e, 0x16, 0xdb, 0x33, 0xfe, 0xac, 0xc1, 0x92, 0x64, 0x4d, 0x86, 0x9e,
+	0x4b, 0x30, 0x7a, 0x04, 0x8b, 0x84, 0x5a, 0x74, 0x4c, 0x24, 0xf7, 0x6b, 0x4a, 0xee, 0x6d, 0x0e,
+	0x62, 0x4a, 0xd0, 0x4c, 0xec, 0xf5, 0x34, 0x7b, 0xb4, 0x0e, 0x40, 0x70, 0xdf, 0xc1, 0x2e, 0x6d,
+	0x35, 0x49, 0x2d, 0xbf, 0xa1, 0x6f, 0xea, 0x66, 0x64, 0xc7, 0xf8, 0x83, 0x06, 0xd5, 0xb6, 0xbf,
+	0xf4, 0xb5, 0xb3, 0x0a, 0x0b, 0x5d, 0x6f, 0xec, 0x52, 0x2e, 0xe0, 0x92, 0x29, 0x16, 0xe8, 0x16,
+	0x94, 0xbb, 0x27, 0x96, 0xeb, 0xe2, 0x41, 0xc7, 0xb5, 0x1c, 0xcc, 0x45, 0x29, 0x9a, 0x25, 0xb9,
+	0xf7, 0xd4, 0x72, 0x70, 0x26, 0x89, 0x36, 0xa0, 0x34, 0xb4, 0x46, 0xd4, 0x8e, 0xe9, 0x2c, 0xba,
+	0x65, 0xfc, 0x55, 0x83, 0xb5, 0xf7, 0x08, 0xb1, 0xfb, 0x6e, 0x4a, 0xb2, 0x35, 0x58, 0x74, 0xbd,
+	0x1e, 0x6e, 0x35, 0xb9, 0x68, 0xba, 0x29, 0x57, 0xe8, 0x1a, 0x14, 0x87, 0x18, 0x8f, 0x3a, 0x23,
+	0x6f, 0xe0, 0x0b, 0x56, 0x60, 0x1b, 0xa6, 0x37, 0xc0, 0xe8, 0x27, 0xb0, 0x42, 0x12, 0x84, 0x48,
+	0x4d, 0xdf, 0xd0, 0x37, 0x4b, 0x8d, 0x6f, 0x6c, 0xa5, 0xbc, 0x6c, 0x2b, 0xc9, 0xd4, 0x4c, 0x63,
+	0x1b, 0x9f, 0xe5, 0xe0, 0x8d, 0x00, 0x4e, 0xc8, 0xca, 0x7e, 0x33, 0xcd, 0x11, 0xdc, 0x0f, 0xc4,
+	0x13, 0x8b, 0x2c, 0x9a, 0x0b, 0x54, 0xae, 0x47, 0x55, 0x9e, 0xc1, 0xc1, 0x92, 0xfa, 0x5c, 0x48,
+	0xe9, 0x13, 0xdd, 0x84, 0x12, 0x3e, 0x1b, 0xda, 0x23, 0xdc, 0xa1, 0xb6, 0x83, 0x6b, 0x8b, 0x1b,
+	0xda, 0x66, 0xde, 0x04, 0xb1, 0xf5, 0xcc, 0x76, 0xa2, 0x1e, 0x79, 0x35, 0xb3, 0x47, 0x1a, 0x7f,
+	0xd3, 0xe0, 0xcd, 0x94, 0x95, 0xa4, 0x8b, 0x9b, 0x50, 0xe5, 0x37, 0x0f, 0x35, 0xc3, 0x9c, 0x9d,
+	0x29, 0xfc, 0xce, 0x34, 0x85, 0x87, 0xe0, 0x66, 0x0a, 0x3f, 0x22, 0x64, 0x2e, 0xbb, 0x90, 0xcf,
+	0xe1, 0xcd, 0x3d, 0x4c, 0x25, 0x03, 0x76, 0x86, 0xc9, 0xc5, 0x53, 0x40, 0x3c, 0x96, 0x72, 0xa9,
+	0x58, 0xfa, 0x47, 0x0e, 0xaa, 0x51, 0x56, 0x2d, 0xf7, 0xd8, 0x43, 0xd7, 0xa1, 0x18, 0x80, 0x48,
+	0xaf, 0x08, 0x37, 0xd0, 0xf7, 0x60, 0x81, 0x49, 0x2a, 0x5c, 0xa2, 0xd2, 0xb8, 0xa5, 0xbe, 0x53,
+	0x84, 0xa6, 0x29, 0xe0, 0x51, 0x0b, 0x2a, 0x84, 0x5a, 0x23, 0xda, 0x19, 0x7a, 0x84, 0xdb, 0x99,
+	0x3b, 0x4e, 0xa9, 0x61, 0xc4, 0x29, 0x04, 0x29, 0xf2, 0x80, 0xf4, 0x0f, 0x25, 0xa4, 0xb9, 0xc4,
+	0x31, 0xfd, 0x25, 0x7a, 0x1f, 0xca, 0xd8, 0xed, 0x85, 0x84, 0xf2, 0x99, 0x09, 0x95, 0xb0, 0xdb,
+	0x0b, 0xc8, 0x84, 0xf6, 0x59, 0xc8, 0x6e, 0x9f, 0xdf, 0x68, 0x50, 0x4b, 0x1b, 0x68, 0x9e, 0x44,
+	0xf9, 0x58, 0x20, 0x61, 0x61, 0xa0, 0xa9, 0x11, 0x1e, 0x18, 0xc9, 0x94, 0x28, 0x86, 0x0d, 0x5f,
+	0x0b, 0xa5, 0xe1, 0x27, 0xaf, 0xcd, 0x59, 0x7e, 0xae, 0xc1, 0x5a, 0x92, 0xd7, 0x3c, 0xf7, 0xfe,
+	0x0e, 0x2c, 0xd8, 0xee, 0xb1, 0xe7, 0x5f, 0x7b, 0x7d, 0x4a, 0x9c, 0x31, 0x5e, 0x02, 0xd8, 0x70,
+	0xe0, 0xda, 0x1e, 0xa6, 0x2d, 0x97, 0xe0, 0x11, 0xdd, 0xb1, 0xdd, 0x81, 0xd7, 0x3f, 0xb4, 0xe8,
+	0xc9, 0x1c, 0x31, 0x12, 0x73, 0xf7, 0x5c, 0xc2, 0xdd, 0x8d, 0xbf, 0x6b, 0x70, 0x5d, 0xcd, 0x4f,
+	0x5e, 0xbd, 0x0e, 0x85, 0x63, 0x1b, 0x0f, 0x7a, 0xad, 0xa6, 0x48, 0x18, 0xba, 0x19, 0xac, 0x59,
+	0xac, 0x0c, 0x19, 0xb0, 0xbc, 0xe1, 0xad, 0x09, 0x0e, 0xda, 0xa6, 0x23, 0xdb, 0xed, 0xef, 0xdb,
+	0x84, 0x9a, 0x02, 0x3e, 0xa2, 0x4f, 0x3d, 0xbb, 0x67, 0xfe, 0x5a, 0x83, 0xf5, 0x3d, 0x4c, 0x77,
+	0x83, 0x54, 0xcb, 0xce, 0x6d, 0x42, 0xed, 0x2e, 0x79, 0xbd, 0x45, 0x84, 0xe2, 0xcd, 0x34, 0x7e,
+	0xa7, 0xc1, 0xcd, 0x89, 0xc2, 0x48, 0xd5, 0xc9, 0x54, 0xe2, 0x27, 0x5a, 0x75, 0x2a, 0xf9, 0x11,
+	0x7e, 0xf9, 0xa1, 0x35, 0x18, 0xe3, 0x43, 0xcb, 0x1e, 0x89, 0x54, 0x72, 0xc1, 0xc4, 0xfa, 0x85,
+	0x06, 0x37, 0xf6, 0x30, 0x3d, 0xf4, 0x9f, 0x99, 0x4b, 0xd4, 0x4e, 0x86, 0x8a, 0xe2, 0xb7, 0xc2,
+	0x98, 0x4a, 0x69, 0x2f, 0x45, 0x7d, 0xeb, 0x3c, 0x0e, 0x22, 0x01, 0xb9, 0x2b, 0x6a, 0x01, 0xa9,
+	0x3c, 0xe3, 0x4f, 0x39, 0x28, 0x7f, 0x28, 0xeb, 0x03, 0x76, 0x9c, 0xd2, 0x83, 0xa6, 0xd6, 0x43,
+	0xa4, 0xa4, 0x50, 0x55, 0x19, 0x7b, 0xb0, 0x44, 0x30, 0x7e, 0x7e, 0x91, 0x47, 0xa3, 0xcc, 0x10,
+	0xfd, 0x15, 0xda, 0x87, 0x95, 0xb1, 0x7b, 0xcc, 0xca, 0x5a, 0xdc, 0x93, 0xb7, 0x10, 0xd5, 0xe5,
+	0xec, 0xcc, 0x93, 0x46, 0x44, 0x9b, 0xb0, 0x9c, 0xa4, 0xb5, 0xc0, 0x83, 0x3f, 0xb9, 0x6d, 0xfc,
+	0x4a, 0x83, 0xb5, 0x8f, 0x2c, 0xda, 0x3d, 0x69, 0x3a, 0x52, 0x63, 0x73, 0xf8, 0xdb, 0xbb, 0x50,
+	0x3c, 0x95, 0xda, 0xf1, 0x93, 0xca, 0x4d, 0x85, 0xf0, 0x51, 0x3b, 0x98, 0x21, 0x06, 0x2b, 0x53,
+	0x57, 0x79, 0x65, 0xef, 0x4b, 0xf7, 0xd5, 0x7b, 0xfe, 0xac, 0xea, 0x7e, 0x08, 0x35, 0x13, 0x0f,
+	0xb0, 0x45, 0xf0, 0x97, 0xa1, 0x2f, 0x03, 0xca, 0x11, 0x67, 0x12, 0x2a, 0x2b, 0x9a, 0xb1, 0x3d,
+	0xe3, 0x0c, 0x40, 0xaa, 0xe3, 0x80, 0xf4, 0x2f, 0xc0, 0xe3, 0x6d, 0xb8, 0x2a, 0xe5, 0x97, 0xe1,
+	0x34, 0xcb, 0x9d, 0x7c, 0x70, 0xe3, 0x03, 0x28, 0x37, 0x9b, 0xfb, 0xdc, 0x20, 0x07, 0x98, 0x5a,
+	0x99, 0x22, 0xe6, 0x16, 0x94, 0x8f, 0xf8, 0x2b, 0xd4, 0x09, 0x5f, 0x96, 0xa2, 0x59, 0x3a, 0x0a,
+	0x5f, 0x26, 0xe3, 0x15, 0x54, 0xc2, 0xb4, 0xcb, 0x43, 0xb1, 0x02, 0xb9, 0x80, 0x5c, 0xae, 0xd5,
+	0x44, 0xef, 0xc2, 0xa2, 0xe8, 0x35, 0xa5, 0xc4, 0xb7, 0xe3, 0x12, 0x8b, 0xb3, 0xad, 0x48, 0xee,
+	0xe6, 0x1b, 0xa6, 0x44, 0x62, 0x36, 0x0c, 0x52, 0x95, 0x68, 0x4b, 0x74, 0x33, 0xb2, 0x63, 0xfc,
+	0x37, 0x0f, 0xa5, 0xc8, 0x85, 0x53, 0xec, 0x93, 0xf7, 0xcc, 0xcd, 0xce, 0x90, 0x7a, 0xba, 0x47,
+	0xb8, 0x0d, 0x15, 0x9b, 0xbf, 0xca, 0x1d, 0x69, 0x4e, 0x9e, 0x46, 0x8b, 0xe6, 0x92, 0xd8, 0x95,
+	0xce, 0x83, 0xd6, 0xa1, 0xe4, 0x8e, 0x9d, 0x8e, 0x77, 0xdc, 0x19, 0x79, 0x2f, 0x88, 0x6c, 0x36,
+	0x8a, 0xee, 0xd8, 0xf9, 0xf1, 0xb1, 0xe9, 0xbd, 0x20, 0x61, 0x3d, 0xbb, 0x78, 0xce, 0x7a, 0x76,
+	0x1d, 0x4a, 0x8e, 0x75, 0xc6, 0xa8, 0x76, 0xdc, 0xb1, 0xc3, 0xfb, 0x10, 0xdd, 0x2c, 0x3a, 0xd6,
+	0x99, 0xe9, 0xbd, 0x78, 0x3a, 0x76, 0xd0, 0x26, 0x54, 0x07, 0x16, 0xa1, 0x9d, 0x68, 0x23, 0x53,
+	0xe0, 0x8d, 0x4c, 0x85, 0xed, 0xbf, 0x1f, 0x36, 0x33, 0xe9, 0xca, 0xb8, 0x38, 0x47, 0x65, 0xdc,
+	0x73, 0x06, 0x21, 0x21, 0xc8, 0x5e, 0x19, 0xf7, 0x9c, 0x41, 0x40, 0xe6, 0x6d, 0xb8, 0x2a, 0x3c,
+	0x8a, 0xd4, 0x4a, 0x13, 0x53, 0xe4, 0x13, 0x56, 0xe6, 0x88, 0x92, 0xc8, 0xf4, 0xc1, 0xd1, 0x3b,
+	0x50, 0xe4, 0x8f, 0x0c, 0xc7, 0x2d, 0x67, 0xc2, 0x0d, 0x11, 0x58, 0xdf, 0xe7, 0x60, 0xc7, 0x1b,
+	0xbd, 0xec, 0x10, 0xfb, 0x53, 0x5c, 0x5b, 0xe2, 0x3a, 0x05, 0xb1, 0xd5, 0xb6, 0x3f, 0xe5, 0xc5,
+	0x5a, 0x0f, 0x0f, 0xa8, 0xc5, 0xc9, 0x57, 0xb8, 0xef, 0x87, 0x1b, 0xc6, 0x2b, 0x58, 0x0d, 0x2d,
+	0x15, 0xd1, 0x4a, 0x5a, 0xc1, 0xda, 0x45, 0x15, 0x3c, 0xbd, 0x5a, 0xfc, 0x9f, 0x0e, 0x6b, 0x6d,
+	0xeb, 0x14, 0xbf, 0xfe, 0xc2, 0x34, 0x53, 0xb2, 0xdd, 0x87, 0x15, 0x5e, 0x8b, 0x36, 0x22, 0xf2,
+	0xd4, 0xf2, 0x99, 0x8c, 0x92, 0x46, 0x44, 0x3f, 0x64, 0x8f, 0x35, 0xee, 0x3e, 0x3f, 0xf4, 0x6c,
+	0xff, 0xbd, 0x2b, 0x35, 0x6e, 0x28, 0xe8, 0xec, 0x06, 0x50, 0x66, 0x14, 0x03, 0x1d, 0xc2, 0x72,
+	0xdc, 0x0c, 0xa4, 0xb6, 0xc8, 0x89, 0xdc, 0x9d, 0xda, 0xf1, 0x84, 0xda, 0x37, 0x2b, 0x31, 0x63,
+	0x10, 0x54, 0x83, 0xab, 0xf2, 0xbd, 0xe5, 0xf1, 0x57, 0x30, 0xfd, 0x25, 0x3a, 0x84, 0x37, 0xc4,
+	0x0d, 0xda, 0xd2, 0xb9, 0xc4, 0xe5, 0x0b, 0x99, 0x2e, 0xaf, 0x42, 0x8d, 0xbb, 0x5e, 0x31, 0xe9,
+	0x7a, 0x5f, 0x68, 0x00, 0xe1, 0xbd, 0x67, 0xf4, 0xd0, 0x3f, 0x80, 0x42, 0xe0, 0x89, 0xb9, 0xcc,
+	0x9e, 0x18, 0xe0, 0x24, 0x73, 0x9a, 0x9e, 0xcc, 0x69, 0x89, 0x30, 0xca, 0x27, 0xc3, 0xc8, 0xf8,
+	0x5c, 0x83, 0xa5, 0xa6, 0x45, 0xad, 0xa7, 0x5e, 0x0f, 0x3f, 0xbb, 0xe0, 0xbb, 0x97, 0x61, 0x44,
+	0x74, 0x1d, 0x8a, 0x2c, 0xed, 0x11, 0x6a, 0x39, 0x43, 0x2e, 0x65, 0xde, 0x0c, 0x37, 0x58, 0x3f,
+	0xb9, 0x24, 0xb3, 0x74, 0x3b, 0x18, 0x19, 0x72, 0x52, 0x1a, 0x27, 0xc5, 0x7f, 0xa3, 0xef, 0xc7,
+	0xe7, 0x0d, 0xdf, 0x54, 0xfa, 0x1b, 0x27, 0xc2, 0xab, 0xac, 0x58, 0x8a, 0xce, 0xd2, 0xa8, 0x7c,
+	0xa6, 0x41, 0xd9, 0x57, 0x05, 0x7f, 0xad, 0x6a, 0x70, 0xd5, 0xea, 0xf5, 0x46, 0x98, 0x10, 0x29,
+	0x87, 0xbf, 0x64, 0x27, 0xa7, 0x78, 0x44, 0x7c, 0xab, 0xe9, 0xa6, 0xbf, 0x44, 0xef, 0x40, 0x21,
+	0x28, 0xcb, 0xc4, 0x98, 0x6e, 0x63, 0xb2, 0x9c, 0xb2, 0xb0, 0x0e, 0x30, 0x8c, 0xff, 0x68, 0x50,
+	0x91, 0xee, 0xbe, 0x23, 0xd3, 0xe8, 0x74, 0xff, 0xd9, 0x81, 0xf2, 0x71, 0xe8, 0xae, 0xd3, 0x1a,
+	0xe8, 0xa8, 0x57, 0xc7, 0x70, 0x66, 0xfa, 0x50, 0x2c, 0x91, 0xe7, 0xcf, 0x9b, 0xc8, 0x63, 0xc1,
+	0xb2, 0x90, 0x0c, 0x96, 0xf7, 0xa0, 0x14, 0xc1, 0xe3, 0x51, 0x2c, 0x5a, 0x66, 0x79, 0x55, 0x7f,
+	0xc9, 0x4e, 0x8e, 0x22, 0x77, 0x2c, 0x06, 0xef, 0x8c, 0xf1, 0x2f, 0x8d, 0xcf, 0xc9, 0x4c, 0xdc,
+	0xf5, 0x4e, 0xf1, 0xe8, 0xe5, 0xfc, 0xd3, 0x88, 0xc7, 0x11, 0x13, 0x66, 0xac, 0xac, 0x03, 0x04,
+	0xf4, 0x38, 0x94, 0x53, 0x57, 0x35, 0x63, 0xd1, 0x8c, 0x26, 0x0d, 0x10, 0x5e, 0xe5, 0xf7, 0x62,
+	0xae, 0x12, 0xbf, 0xca, 0x3c, 0x15, 0xef, 0xdc, 0xb5, 0x95, 0xf1, 0x47, 0x0d, 0xbe, 0xbe, 0x87,
+	0xe9, 0x93, 0x78, 0x2f, 0x73, 0xd9, 0x52, 0x39, 0x50, 0x57, 0x09, 0x35, 0x8f, 0xd5, 0xeb, 0x50,
+	0x20, 0x7e, 0x03, 0x27, 0x26, 0x5e, 0xc1, 0xda, 0xf8, 0xa5, 0x06, 0xe8, 0xc0, 0x3b, 0xc5, 0xf1,
+	0x46, 0xf7, 0x02, 0xb7, 0x9f, 0xdd, 0xe5, 0xde, 0x00, 0xe8, 0x11, 0xda, 0x91, 0x1f, 0x0a, 0x64,
+	0x2c, 0xf6, 0x08, 0xe5, 0x49, 0xa9, 0x69, 0xfc, 0x42, 0x83, 0x9a, 0xbc, 0x2f, 0xbf, 0xfd, 0xae,
+	0xe7, 0x0c, 0x07, 0x98, 0xe2, 0xde, 0x57, 0xdd, 0xb1, 0xfc, 0x45, 0x83, 0x6a, 0x34, 0xdb, 0xb2,
+	0x53, 0xf4, 0x5d, 0x58, 0xe0, 0x2d, 0xa6, 0x94, 0x60, 0x66, 0xd8, 0x08, 0x68, 0x16, 0xdb, 0xfc,
+	0x35, 0x7f, 0x46, 0xfc, 0x6c, 0x2a, 0x97, 0x61, 0xca, 0xd7, 0xcf, 0x9d, 0xf2, 0x8d, 0x36, 0xac,
+	0xf9, 0x9a, 0x0a, 0x33, 0x0c, 0xef, 0xae, 0x26, 0x67, 0x99, 0x9b, 0x50, 0x8a, 0xf4, 0x54, 0xd2,
+	0x3e, 0x10, 0xb6, 0x54, 0xf7, 0x1f, 0xc2, 0x4a, 0x8a, 0x21, 0xaa, 0x00, 0x7c, 0xe0, 0x76, 0xa5,
+	0x25, 0xaa, 0x57, 0x50, 0x19, 0x0a, 0xbe, 0x5d, 0xaa, 0x5a, 0xe3, 0x9f, 0x15, 0x28, 0xb2, 0x67,
+	0x65, 0x97, 0x7d, 0x1f, 0x44, 0x43, 0x40, 0x7c, 0x18, 0xe6, 0x0c, 0x3d, 0x37, 0x98, 0x1a, 0xa3,
+	0x07, 0x13, 0x1e, 0xfd, 0x34, 0xa8, 0xf4, 0xbd, 0xfa, 0x9d, 0x09, 0x18, 0x09, 0x70, 0xe3, 0x0a,
+	0x72, 0x38, 0x47, 0xd6, 0x5e, 0x3c, 0xb3, 0xbb, 0xcf, 0xfd, 0x66, 0x68, 0x0a, 0xc7, 0x04, 0xa8,
+	0xcf, 0x31, 0x31, 0x8c, 0x96, 0x0b, 0x31, 0xb1, 0xf4, 0x43, 0xcf, 0xb8, 0x82, 0x3e, 0x81, 0x55,
+	0x36, 0x1d, 0x0a, 0x86, 0x54, 0x3e, 0xc3, 0xc6, 0x64, 0x86, 0x29, 0xe0, 0x73, 0xb2, 0xdc, 0x87,
+	0x05, 0x1e, 0x0c, 0x48, 0xe5, 0x70, 0xd1, 0x4f, 0xa7, 0xf5, 0x8d, 0xc9, 0x00, 0x01, 0xb5, 0x9f,
+	0xc1, 0x72, 0xe2, 0xd3, 0x10, 0xba, 0xa7, 0x40, 0x53, 0x7f, 0xe4, 0xab, 0xdf, 0xcf, 0x02, 0x1a,
+	0xf0, 0xea, 0x43, 0x25, 0x3e, 0x4a, 0x43, 0x9b, 0x0a, 0x7c, 0xe5, 0x58, 0xbf, 0x7e, 0x2f, 0x03,
+	0x64, 0xc0, 0xc8, 0x81, 0x6a, 0xf2, 0x53, 0x05, 0xba, 0x3f, 0x95, 0x40, 0xdc, 0xdd, 0xbe, 0x95,
+	0x09, 0x36, 0x60, 0xf7, 0x12, 0x56, 0x55, 0xa3, 0x72, 0xb4, 0xa5, 0x26, 0x33, 0x69, 0x86, 0x5f,
+	0xdf, 0xce, 0x0c, 0x1f, 0xb0, 0xfe, 0x5c, 0x94, 0x03, 0xaa, 0x71, 0x33, 0x7a, 0xa8, 0x26, 0x37,
+	0x65, 0x4e, 0x5e, 0x6f, 0x9c, 0x07, 0x25, 0x10, 0xe2, 0x15, 0xac, 0xa9, 0x47, 0xb6, 0xe8, 0x81,
+	0x9a, 0xde, 0xe4, 0x59, 0x74, 0xfd, 0xe1, 0x39, 0x30, 0x02, 0x01, 0xbc, 0xe4, 0xc7, 0x20, 0x3f,
+	0x0c, 0xb7, 0x67, 0x7a, 0xcd, 0xc5, 0x62, 0xf0, 0x63, 0x58, 0x4e, 0xb4, 0xbb, 0xca, 0xa8, 0x51,
+	0xb7, 0xc4, 0xf5, 0x69, 0x2f, 0xb4, 0x08, 0xc9, 0x44, 0x59, 0x84, 0x26, 0x78, 0xbf, 0xa2, 0x74,
+	0xaa, 0xdf, 0xcf, 0x02, 0x1a, 0x5c, 0x84, 0xf0, 0x74, 0x99, 0x28, 0x2d, 0xd0, 0xb7, 0xd5, 0x34,
+	0xd4, 0x65, 0x51, 0xfd, 0xad, 0x8c, 0xd0, 0x01, 0xd3, 0x13, 0x58, 0xf2, 0xcf, 0xc5, 0x93, 0x72,
+	0x4f, 0xa9, 0xf5, 0x18, 0xcc, 0x84, 0xeb, 0xa9, 0x41, 0x03, 0x4e, 0x6d, 0x28, 0x45, 0x2a, 0x19,
+	0x74, 0x5b, 0x21, 0x69, 0xba, 0xd2, 0x99, 0x65, 0x9f, 0x0e, 0xc0, 0x1e, 0xa6, 0x07, 0x98, 0x8e,
+	0x98, 0x8b, 0xdf, 0x99, 0x24, 0x90, 0x04, 0xf0, 0x89, 0xde, 0x9d, 0x09, 0xe7, 0x4b, 0xdd, 0xf8,
+	0x77, 0x1e, 0x0a, 0x7e, 0x6b, 0x76, 0x09, 0x4f, 0xe8, 0x25, 0xbc, 0x69, 0x1f, 0xc3, 0x72, 0xe2,
+	0x5b, 0x81, 0xd2, 0xe5, 0xd5, 0xdf, 0x13, 0x66, 0xd9, 0xeb, 0x23, 0xf9, 0xb7, 0x9e, 0xc0, 0xbd,
+	0xef, 0x4e, 0x7a, 0x17, 0x93, 0x9e, 0x3d, 0xd3, 0x11, 0x56, 0x52, 0x33, 0x7b, 0xa4, 0x7a, 0x3b,
+	0x26, 0x4d, 0xf6, 0x2f, 0xdb, 0xd3, 0x76, 0x1e, 0xfd, 0xf4, 0x61, 0xdf, 0xa6, 0x27, 0xe3, 0x23,
+	0xc6, 0x7a, 0x5b, 0x40, 0xbe, 0x65, 0x7b, 0xf2, 0xd7, 0xb6, 0x6f, 0xe2, 0x6d, 0x4e, 0x69, 0x9b,
+	0xdd, 0x65, 0x78, 0x74, 0xb4, 0xc8, 0x57, 0x8f, 0xfe, 0x3f, 0x00, 0xea, 0xca, 0x93, 0x32, 0x09,
+	0x26, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2487,6 +2677,8 @@ type DataCoordClient interface {
 	SaveBinlogPaths(ctx context.Context, in *SaveBinlogPathsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error)
 	GetFlushedSegments(ctx context.Context, in *GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*GetFlushedSegmentsResponse, error)
+	GetFlushState(ctx context.Context, in *milvuspb.GetFlushStateRequest, opts ...grpc.CallOption) (*milvuspb.GetFlushStateResponse, error)
+	MoveChannel(ctx context.Context, in *MoveChannelRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
 }
@@ -2625,6 +2817,24 @@ func (c *dataCoordClient) GetFlushedSegments(ctx context.Context, in *GetFlushed
 	return out, nil
 }
 
+func (c *dataCoordClient) GetFlushState(ctx context.Context, in *milvuspb.GetFlushStateRequest, opts ...grpc.CallOption) (*milvuspb.GetFlushStateResponse, error) {
+	out := new(milvuspb.GetFlushStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetFlushState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) MoveChannel(ctx context.Context, in *MoveChannelRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/MoveChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
 	out := new(milvuspb.GetMetricsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetMetrics", in, out, opts...)
@@ -2650,6 +2860,8 @@ type DataCoordServer interface {
 	SaveBinlogPaths(context.Context, *SaveBinlogPathsRequest) (*commonpb.Status, error)
 	GetRecoveryInfo(context.Context, *GetRecoveryInfoRequest) (*GetRecoveryInfoResponse, error)
 	GetFlushedSegments(context.Context, *GetFlushedSegmentsRequest) (*GetFlushedSegmentsResponse, error)
+	GetFlushState(context.Context, *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error)
+	MoveChannel(context.Context, *MoveChannelRequest) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 }
@@ -2700,6 +2912,12 @@ func (*UnimplementedDataCoordServer) GetRecoveryInfo(ctx context.Context, req *G
 func (*UnimplementedDataCoordServer) GetFlushedSegments(ctx context.Context, req *GetFlushedSegmentsRequest) (*GetFlushedSegmentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFlushedSegments not implemented")
 }
+func (*UnimplementedDataCoordServer) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFlushState not implemented")
+}
+func (*UnimplementedDataCoordServer) MoveChannel(ctx context.Context, req *MoveChannelRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveChannel not implemented")
+}
 func (*UnimplementedDataCoordServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
@@ -2960,6 +3178,42 @@ func _DataCoord_GetFlushedSegments_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_GetFlushState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetFlushStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetFlushState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetFlushState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetFlushState(ctx, req.(*milvuspb.GetFlushStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_MoveChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).MoveChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/MoveChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).MoveChannel(ctx, req.(*MoveChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataCoord_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.GetMetricsRequest)
 	if err := dec(in); err != nil {
@@ -3038,6 +3292,14 @@ var _DataCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetFlushedSegments",
 			Handler:    _DataCoord_GetFlushedSegments_Handler,
 		},
+		{
+			MethodName: "GetFlushState",
+			Handler:    _DataCoord_GetFlushState_Handler,
+		},
+		{
+			MethodName: "MoveChannel",
+			Handler:    _DataCoord_MoveChannel_Handler,
+		},
 		{
 			MethodName: "GetMetrics",
 			Handler:    _DataCoord_GetMetrics_Handler,
@@ -3055,6 +3317,7 @@ type DataNodeClient interface {
 	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
 	WatchDmChannels(ctx context.Context, in *WatchDmChannelsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	FlushSegments(ctx context.Context, in *FlushSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ReleaseDmChannels(ctx context.Context, in *ReleaseDmChannelsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
 }
@@ -3103,6 +3366,15 @@ func (c *dataNodeClient) FlushSegments(ctx context.Context, in *FlushSegmentsReq
 	return out, nil
 }
 
+func (c *dataNodeClient) ReleaseDmChannels(ctx context.Context, in *ReleaseDmChannelsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/ReleaseDmChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataNodeClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
 	out := new(milvuspb.GetMetricsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/GetMetrics", in, out, opts...)
@@ -3118,6 +3390,7 @@ type DataNodeServer interface {
 	GetStatisticsChannel(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)
 	WatchDmChannels(context.Context, *WatchDmChannelsRequest) (*commonpb.Status, error)
 	FlushSegments(context.Context, *FlushSegmentsRequest) (*commonpb.Status, error)
+	ReleaseDmChannels(context.Context, *ReleaseDmChannelsRequest) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 }
@@ -3138,6 +3411,9 @@ func (*UnimplementedDataNodeServer) WatchDmChannels(ctx context.Context, req *Wa
 func (*UnimplementedDataNodeServer) FlushSegments(ctx context.Context, req *FlushSegmentsRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FlushSegments not implemented")
 }
+func (*UnimplementedDataNodeServer) ReleaseDmChannels(ctx context.Context, req *ReleaseDmChannelsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseDmChannels not implemented")
+}
 func (*UnimplementedDataNodeServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
@@ -3218,6 +3494,24 @@ func _DataNode_FlushSegments_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataNode_ReleaseDmChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseDmChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).ReleaseDmChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataNode/ReleaseDmChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).ReleaseDmChannels(ctx, req.(*ReleaseDmChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataNode_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.GetMetricsRequest)
 	if err := dec(in); err != nil {
@@ -3256,6 +3550,10 @@ var _DataNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FlushSegments",
 			Handler:    _DataNode_FlushSegments_Handler,
 		},
+		{
+			MethodName: "ReleaseDmChannels",
+			Handler:    _DataNode_ReleaseDmChannels_Handler,
+		},
 		{
 			MethodName: "GetMetrics",
 			Handler:    _DataNode_GetMetrics_Handler,
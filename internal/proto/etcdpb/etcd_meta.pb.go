@@ -252,6 +252,8 @@ type CollectionInfo struct {
 	VirtualChannelNames        []string                   `protobuf:"bytes,7,rep,name=virtual_channel_names,json=virtualChannelNames,proto3" json:"virtual_channel_names,omitempty"`
 	PhysicalChannelNames       []string                   `protobuf:"bytes,8,rep,name=physical_channel_names,json=physicalChannelNames,proto3" json:"physical_channel_names,omitempty"`
 	PartitionCreatedTimestamps []uint64                   `protobuf:"varint,9,rep,packed,name=partition_created_timestamps,json=partitionCreatedTimestamps,proto3" json:"partition_created_timestamps,omitempty"`
+	ConsistencyLevel           commonpb.ConsistencyLevel  `protobuf:"varint,10,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	DbName                     string                     `protobuf:"bytes,11,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	XXX_NoUnkeyedLiteral       struct{}                   `json:"-"`
 	XXX_unrecognized           []byte                     `json:"-"`
 	XXX_sizecache              int32                      `json:"-"`
@@ -345,6 +347,232 @@ func (m *CollectionInfo) GetPartitionCreatedTimestamps() []uint64 {
 	return nil
 }
 
+func (m *CollectionInfo) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
+}
+
+func (m *CollectionInfo) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+type DatabaseInfo struct {
+	ID                   int64    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreateTime           uint64   `protobuf:"varint,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DatabaseInfo) Reset()         { *m = DatabaseInfo{} }
+func (m *DatabaseInfo) String() string { return proto.CompactTextString(m) }
+func (*DatabaseInfo) ProtoMessage()    {}
+func (*DatabaseInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975d306d62b73e88, []int{5}
+}
+
+func (m *DatabaseInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DatabaseInfo.Unmarshal(m, b)
+}
+func (m *DatabaseInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DatabaseInfo.Marshal(b, m, deterministic)
+}
+func (m *DatabaseInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseInfo.Merge(m, src)
+}
+func (m *DatabaseInfo) XXX_Size() int {
+	return xxx_messageInfo_DatabaseInfo.Size(m)
+}
+func (m *DatabaseInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatabaseInfo proto.InternalMessageInfo
+
+func (m *DatabaseInfo) GetID() int64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *DatabaseInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DatabaseInfo) GetCreateTime() uint64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+type CredentialInfo struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	EncryptedPassword    string   `protobuf:"bytes,2,opt,name=encrypted_password,json=encryptedPassword,proto3" json:"encrypted_password,omitempty"`
+	Roles                []string `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CredentialInfo) Reset()         { *m = CredentialInfo{} }
+func (m *CredentialInfo) String() string { return proto.CompactTextString(m) }
+func (*CredentialInfo) ProtoMessage()    {}
+func (*CredentialInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975d306d62b73e88, []int{6}
+}
+
+func (m *CredentialInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CredentialInfo.Unmarshal(m, b)
+}
+func (m *CredentialInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CredentialInfo.Marshal(b, m, deterministic)
+}
+func (m *CredentialInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CredentialInfo.Merge(m, src)
+}
+func (m *CredentialInfo) XXX_Size() int {
+	return xxx_messageInfo_CredentialInfo.Size(m)
+}
+func (m *CredentialInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CredentialInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CredentialInfo proto.InternalMessageInfo
+
+func (m *CredentialInfo) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *CredentialInfo) GetEncryptedPassword() string {
+	if m != nil {
+		return m.EncryptedPassword
+	}
+	return ""
+}
+
+func (m *CredentialInfo) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+type GrantInfo struct {
+	DbName               string   `protobuf:"bytes,1,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	ObjectName           string   `protobuf:"bytes,2,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	Privilege            string   `protobuf:"bytes,3,opt,name=privilege,proto3" json:"privilege,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GrantInfo) Reset()         { *m = GrantInfo{} }
+func (m *GrantInfo) String() string { return proto.CompactTextString(m) }
+func (*GrantInfo) ProtoMessage()    {}
+func (*GrantInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975d306d62b73e88, []int{7}
+}
+
+func (m *GrantInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GrantInfo.Unmarshal(m, b)
+}
+func (m *GrantInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GrantInfo.Marshal(b, m, deterministic)
+}
+func (m *GrantInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantInfo.Merge(m, src)
+}
+func (m *GrantInfo) XXX_Size() int {
+	return xxx_messageInfo_GrantInfo.Size(m)
+}
+func (m *GrantInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantInfo proto.InternalMessageInfo
+
+func (m *GrantInfo) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *GrantInfo) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
+	}
+	return ""
+}
+
+func (m *GrantInfo) GetPrivilege() string {
+	if m != nil {
+		return m.Privilege
+	}
+	return ""
+}
+
+type RoleInfo struct {
+	Name                 string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Grants               []*GrantInfo `protobuf:"bytes,2,rep,name=grants,proto3" json:"grants,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *RoleInfo) Reset()         { *m = RoleInfo{} }
+func (m *RoleInfo) String() string { return proto.CompactTextString(m) }
+func (*RoleInfo) ProtoMessage()    {}
+func (*RoleInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975d306d62b73e88, []int{8}
+}
+
+func (m *RoleInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoleInfo.Unmarshal(m, b)
+}
+func (m *RoleInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoleInfo.Marshal(b, m, deterministic)
+}
+func (m *RoleInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleInfo.Merge(m, src)
+}
+func (m *RoleInfo) XXX_Size() int {
+	return xxx_messageInfo_RoleInfo.Size(m)
+}
+func (m *RoleInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleInfo proto.InternalMessageInfo
+
+func (m *RoleInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RoleInfo) GetGrants() []*GrantInfo {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
 type SegmentIndexInfo struct {
 	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	PartitionID          int64    `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
@@ -362,7 +590,7 @@ func (m *SegmentIndexInfo) Reset()         { *m = SegmentIndexInfo{} }
 func (m *SegmentIndexInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentIndexInfo) ProtoMessage()    {}
 func (*SegmentIndexInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_975d306d62b73e88, []int{5}
+	return fileDescriptor_975d306d62b73e88, []int{9}
 }
 
 func (m *SegmentIndexInfo) XXX_Unmarshal(b []byte) error {
@@ -448,7 +676,7 @@ func (m *CollectionMeta) Reset()         { *m = CollectionMeta{} }
 func (m *CollectionMeta) String() string { return proto.CompactTextString(m) }
 func (*CollectionMeta) ProtoMessage()    {}
 func (*CollectionMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_975d306d62b73e88, []int{6}
+	return fileDescriptor_975d306d62b73e88, []int{10}
 }
 
 func (m *CollectionMeta) XXX_Unmarshal(b []byte) error {
@@ -517,6 +745,10 @@ func init() {
 	proto.RegisterType((*IndexInfo)(nil), "milvus.proto.etcd.IndexInfo")
 	proto.RegisterType((*FieldIndexInfo)(nil), "milvus.proto.etcd.FieldIndexInfo")
 	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.etcd.CollectionInfo")
+	proto.RegisterType((*DatabaseInfo)(nil), "milvus.proto.etcd.DatabaseInfo")
+	proto.RegisterType((*CredentialInfo)(nil), "milvus.proto.etcd.CredentialInfo")
+	proto.RegisterType((*GrantInfo)(nil), "milvus.proto.etcd.GrantInfo")
+	proto.RegisterType((*RoleInfo)(nil), "milvus.proto.etcd.RoleInfo")
 	proto.RegisterType((*SegmentIndexInfo)(nil), "milvus.proto.etcd.SegmentIndexInfo")
 	proto.RegisterType((*CollectionMeta)(nil), "milvus.proto.etcd.CollectionMeta")
 }
@@ -524,49 +756,61 @@ func init() {
 func init() { proto.RegisterFile("etcd_meta.proto", fileDescriptor_975d306d62b73e88) }
 
 var fileDescriptor_975d306d62b73e88 = []byte{
-	// 693 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0x96, 0xeb, 0x34, 0xa9, 0x27, 0x69, 0xda, 0xee, 0xef, 0x8f, 0xac, 0xaa, 0x80, 0x6b, 0xa9,
-	0x25, 0x12, 0x22, 0x11, 0x2d, 0xe2, 0x86, 0x04, 0xd4, 0xaa, 0x14, 0x21, 0xaa, 0xe2, 0x46, 0x1c,
-	0xb8, 0x58, 0x1b, 0x7b, 0x92, 0xac, 0x64, 0xaf, 0x83, 0x77, 0x5d, 0x35, 0x37, 0xce, 0x3c, 0x02,
-	0x4f, 0xc3, 0xdb, 0x70, 0xe0, 0x25, 0x90, 0x77, 0x6d, 0x27, 0x69, 0xc3, 0x91, 0x9b, 0xe7, 0x9b,
-	0x99, 0xdd, 0x6f, 0xbe, 0xf9, 0xbc, 0xb0, 0x87, 0x32, 0x8c, 0x82, 0x04, 0x25, 0xed, 0xcf, 0xb3,
-	0x54, 0xa6, 0xe4, 0x20, 0x61, 0xf1, 0x6d, 0x2e, 0x74, 0xd4, 0x2f, 0xb2, 0x87, 0x9d, 0x30, 0x4d,
-	0x92, 0x94, 0x6b, 0xe8, 0xb0, 0x23, 0xc2, 0x19, 0x26, 0x65, 0xb9, 0xfb, 0xdd, 0x00, 0x18, 0x21,
-	0xa7, 0x5c, 0x7e, 0x40, 0x49, 0x49, 0x17, 0xb6, 0x86, 0x9e, 0x6d, 0x38, 0x46, 0xcf, 0xf4, 0xb7,
-	0x86, 0x1e, 0x39, 0x85, 0x3d, 0x9e, 0x27, 0xc1, 0x97, 0x1c, 0xb3, 0x45, 0xc0, 0xd3, 0x08, 0x85,
-	0xbd, 0xa5, 0x92, 0xbb, 0x3c, 0x4f, 0x3e, 0x16, 0xe8, 0x55, 0x01, 0x92, 0x67, 0x70, 0xc0, 0xb8,
-	0xc0, 0x4c, 0x06, 0xe1, 0x8c, 0x72, 0x8e, 0xf1, 0xd0, 0x13, 0xb6, 0xe9, 0x98, 0x3d, 0xcb, 0xdf,
-	0xd7, 0x89, 0x8b, 0x1a, 0x27, 0x4f, 0x61, 0x4f, 0x1f, 0x58, 0xd7, 0xda, 0x0d, 0xc7, 0xe8, 0x59,
-	0x7e, 0x57, 0xc1, 0x75, 0xa5, 0xfb, 0xd5, 0x00, 0xeb, 0x3a, 0x4b, 0xef, 0x16, 0x1b, 0xb9, 0xbd,
-	0x82, 0x16, 0x8d, 0xa2, 0x0c, 0x85, 0xe6, 0xd4, 0x3e, 0x3b, 0xea, 0xaf, 0xcd, 0x5e, 0x4e, 0xfd,
-	0x56, 0xd7, 0xf8, 0x55, 0x71, 0xc1, 0x35, 0x43, 0x91, 0xc7, 0x9b, 0xb8, 0xea, 0xc4, 0x92, 0xab,
-	0xfb, 0xcd, 0x00, 0x6b, 0xc8, 0x23, 0xbc, 0x1b, 0xf2, 0x49, 0x4a, 0x1e, 0x01, 0xb0, 0x22, 0x08,
-	0x38, 0x4d, 0x50, 0x51, 0xb1, 0x7c, 0x4b, 0x21, 0x57, 0x34, 0x41, 0x62, 0x43, 0x4b, 0x05, 0x43,
-	0xaf, 0x54, 0xa9, 0x0a, 0x89, 0x07, 0x1d, 0xdd, 0x38, 0xa7, 0x19, 0x4d, 0xf4, 0x75, 0xed, 0xb3,
-	0xe3, 0x8d, 0x84, 0xdf, 0xe3, 0xe2, 0x13, 0x8d, 0x73, 0xbc, 0xa6, 0x2c, 0xf3, 0xdb, 0xaa, 0xed,
-	0x5a, 0x75, 0xb9, 0x1e, 0x74, 0x2f, 0x19, 0xc6, 0xd1, 0x92, 0x90, 0x0d, 0xad, 0x09, 0x8b, 0x31,
-	0xaa, 0x85, 0xa9, 0xc2, 0x3f, 0x73, 0x71, 0x7f, 0x98, 0xd0, 0xbd, 0x48, 0xe3, 0x18, 0x43, 0xc9,
-	0x52, 0xae, 0x8e, 0xb9, 0x2f, 0xed, 0x6b, 0x68, 0x6a, 0x97, 0x94, 0xca, 0x9e, 0xac, 0x13, 0x2d,
-	0x1d, 0xb4, 0x3c, 0xe4, 0x46, 0x01, 0x7e, 0xd9, 0x44, 0x9e, 0x40, 0x3b, 0xcc, 0x90, 0x4a, 0x0c,
-	0x24, 0x4b, 0xd0, 0x36, 0x1d, 0xa3, 0xd7, 0xf0, 0x41, 0x43, 0x23, 0x96, 0x20, 0x71, 0xa1, 0x33,
-	0xa7, 0x99, 0x64, 0x8a, 0x80, 0x27, 0xec, 0x86, 0x63, 0xf6, 0x4c, 0x7f, 0x0d, 0x23, 0xa7, 0xd0,
-	0xad, 0xe3, 0x42, 0x5d, 0x61, 0x6f, 0xab, 0x1d, 0xdd, 0x43, 0xc9, 0x25, 0xec, 0x4e, 0x0a, 0x51,
-	0x02, 0x35, 0x1f, 0x0a, 0xbb, 0xb9, 0x49, 0xdb, 0xe2, 0x47, 0xe8, 0xaf, 0x8b, 0xe7, 0x77, 0x26,
-	0x75, 0x8c, 0x82, 0x9c, 0xc1, 0x7f, 0xb7, 0x2c, 0x93, 0x39, 0x8d, 0x2b, 0x5f, 0xa8, 0x2d, 0x0b,
-	0xbb, 0xa5, 0xae, 0xfd, 0xa7, 0x4c, 0x96, 0xde, 0xd0, 0x77, 0xbf, 0x84, 0xff, 0xe7, 0xb3, 0x85,
-	0x60, 0xe1, 0x83, 0xa6, 0x1d, 0xd5, 0xf4, 0x6f, 0x95, 0x5d, 0xeb, 0x7a, 0x03, 0x47, 0xf5, 0x0c,
-	0x81, 0x56, 0x25, 0x52, 0x4a, 0x09, 0x49, 0x93, 0xb9, 0xb0, 0x2d, 0xc7, 0xec, 0x35, 0xfc, 0xc3,
-	0xba, 0xe6, 0x42, 0x97, 0x8c, 0xea, 0x0a, 0xf7, 0xa7, 0x01, 0xfb, 0x37, 0x38, 0x4d, 0x90, 0xcb,
-	0xa5, 0x17, 0x5c, 0xe8, 0x84, 0xcb, 0xb5, 0x56, 0xeb, 0x5c, 0xc3, 0x88, 0x03, 0xed, 0x15, 0x91,
-	0x4b, 0x67, 0xac, 0x42, 0xe4, 0x08, 0x2c, 0x51, 0x9e, 0xec, 0xa9, 0xcd, 0x99, 0xfe, 0x12, 0xd0,
-	0x7e, 0x2b, 0x44, 0xd3, 0xbf, 0xac, 0xf2, 0x9b, 0x0a, 0x57, 0xfd, 0xb6, 0xbd, 0xee, 0x7d, 0x1b,
-	0x5a, 0xe3, 0x9c, 0xa9, 0x9e, 0xa6, 0xce, 0x94, 0x21, 0x39, 0x86, 0x0e, 0x72, 0x3a, 0x8e, 0x51,
-	0xef, 0xce, 0x6e, 0x39, 0x46, 0x6f, 0xc7, 0x6f, 0x6b, 0x4c, 0x0d, 0xe6, 0xfe, 0x32, 0x56, 0xcd,
-	0xba, 0xf1, 0x1d, 0xf8, 0xdb, 0x66, 0x7d, 0x0c, 0x50, 0x0b, 0x50, 0x59, 0x75, 0x05, 0x21, 0x27,
-	0x2b, 0x46, 0x0d, 0x24, 0x9d, 0x56, 0x46, 0xdd, 0xad, 0xd1, 0x11, 0x9d, 0x8a, 0x07, 0x9e, 0x6f,
-	0x3e, 0xf4, 0xfc, 0xbb, 0xf3, 0xcf, 0x2f, 0xa6, 0x4c, 0xce, 0xf2, 0x71, 0xf1, 0x16, 0x0c, 0xf4,
-	0x18, 0xcf, 0x59, 0x5a, 0x7e, 0x0d, 0x18, 0x97, 0x98, 0x71, 0x1a, 0x0f, 0xd4, 0x64, 0x83, 0xc2,
-	0xd3, 0xf3, 0xf1, 0xb8, 0xa9, 0xa2, 0xf3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbd, 0xeb, 0x22,
-	0x81, 0x0b, 0x06, 0x00, 0x00,
+	// 887 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x96, 0xeb, 0x34, 0xa9, 0x5f, 0xd2, 0xb4, 0x1d, 0x16, 0xb0, 0xaa, 0x02, 0x59, 0x4b, 0x5d,
+	0x22, 0xa1, 0x6d, 0x45, 0x77, 0xc5, 0x0d, 0x09, 0x48, 0xb4, 0x28, 0x02, 0x56, 0x65, 0x1a, 0x71,
+	0xe0, 0x62, 0x4d, 0xec, 0xd7, 0x74, 0x90, 0x3d, 0xce, 0xce, 0x8c, 0x4b, 0x73, 0xe3, 0xcc, 0x4f,
+	0xe0, 0x3f, 0xf0, 0xbb, 0x38, 0xf0, 0x27, 0xd0, 0xcc, 0xd8, 0x8e, 0xd3, 0x06, 0x71, 0xe2, 0xe6,
+	0xf7, 0xcd, 0x7b, 0x33, 0xdf, 0xfb, 0xfc, 0xde, 0x07, 0x47, 0xa8, 0x93, 0x34, 0xce, 0x51, 0xb3,
+	0x8b, 0x95, 0x2c, 0x74, 0x41, 0x4e, 0x72, 0x9e, 0xdd, 0x97, 0xca, 0x45, 0x17, 0xe6, 0xf4, 0x74,
+	0x90, 0x14, 0x79, 0x5e, 0x08, 0x07, 0x9d, 0x0e, 0x54, 0x72, 0x87, 0x79, 0x95, 0x1e, 0xfd, 0xe1,
+	0x01, 0xcc, 0x51, 0x30, 0xa1, 0x7f, 0x40, 0xcd, 0xc8, 0x10, 0xf6, 0x66, 0xd3, 0xd0, 0x1b, 0x79,
+	0x63, 0x9f, 0xee, 0xcd, 0xa6, 0xe4, 0x05, 0x1c, 0x89, 0x32, 0x8f, 0xdf, 0x95, 0x28, 0xd7, 0xb1,
+	0x28, 0x52, 0x54, 0xe1, 0x9e, 0x3d, 0x3c, 0x14, 0x65, 0xfe, 0xa3, 0x41, 0xdf, 0x1a, 0x90, 0x7c,
+	0x06, 0x27, 0x5c, 0x28, 0x94, 0x3a, 0x4e, 0xee, 0x98, 0x10, 0x98, 0xcd, 0xa6, 0x2a, 0xf4, 0x47,
+	0xfe, 0x38, 0xa0, 0xc7, 0xee, 0x60, 0xd2, 0xe0, 0xe4, 0x53, 0x38, 0x72, 0x17, 0x36, 0xb9, 0x61,
+	0x67, 0xe4, 0x8d, 0x03, 0x3a, 0xb4, 0x70, 0x93, 0x19, 0xfd, 0xe6, 0x41, 0x70, 0x2d, 0x8b, 0x87,
+	0xf5, 0x4e, 0x6e, 0x5f, 0x40, 0x8f, 0xa5, 0xa9, 0x44, 0xe5, 0x38, 0xf5, 0xaf, 0xce, 0x2e, 0xb6,
+	0x7a, 0xaf, 0xba, 0xfe, 0xda, 0xe5, 0xd0, 0x3a, 0xd9, 0x70, 0x95, 0xa8, 0xca, 0x6c, 0x17, 0x57,
+	0x77, 0xb0, 0xe1, 0x1a, 0xfd, 0xee, 0x41, 0x30, 0x13, 0x29, 0x3e, 0xcc, 0xc4, 0x6d, 0x41, 0x3e,
+	0x02, 0xe0, 0x26, 0x88, 0x05, 0xcb, 0xd1, 0x52, 0x09, 0x68, 0x60, 0x91, 0xb7, 0x2c, 0x47, 0x12,
+	0x42, 0xcf, 0x06, 0xb3, 0x69, 0xa5, 0x52, 0x1d, 0x92, 0x29, 0x0c, 0x5c, 0xe1, 0x8a, 0x49, 0x96,
+	0xbb, 0xe7, 0xfa, 0x57, 0xcf, 0x77, 0x12, 0xfe, 0x0e, 0xd7, 0x3f, 0xb1, 0xac, 0xc4, 0x6b, 0xc6,
+	0x25, 0xed, 0xdb, 0xb2, 0x6b, 0x5b, 0x15, 0x4d, 0x61, 0xf8, 0x86, 0x63, 0x96, 0x6e, 0x08, 0x85,
+	0xd0, 0xbb, 0xe5, 0x19, 0xa6, 0x8d, 0x30, 0x75, 0xf8, 0xef, 0x5c, 0xa2, 0x3f, 0x3b, 0x30, 0x9c,
+	0x14, 0x59, 0x86, 0x89, 0xe6, 0x85, 0xb0, 0xd7, 0x3c, 0x96, 0xf6, 0x4b, 0xe8, 0xba, 0x29, 0xa9,
+	0x94, 0x3d, 0xdf, 0x26, 0x5a, 0x4d, 0xd0, 0xe6, 0x92, 0x1b, 0x0b, 0xd0, 0xaa, 0x88, 0x7c, 0x02,
+	0xfd, 0x44, 0x22, 0xd3, 0x18, 0x6b, 0x9e, 0x63, 0xe8, 0x8f, 0xbc, 0x71, 0x87, 0x82, 0x83, 0xe6,
+	0x3c, 0x47, 0x12, 0xc1, 0x60, 0xc5, 0xa4, 0xe6, 0x96, 0xc0, 0x54, 0x85, 0x9d, 0x91, 0x3f, 0xf6,
+	0xe9, 0x16, 0x46, 0x5e, 0xc0, 0xb0, 0x89, 0x8d, 0xba, 0x2a, 0xdc, 0xb7, 0xff, 0xe8, 0x11, 0x4a,
+	0xde, 0xc0, 0xe1, 0xad, 0x11, 0x25, 0xb6, 0xfd, 0xa1, 0x0a, 0xbb, 0xbb, 0xb4, 0x35, 0x8b, 0x70,
+	0xb1, 0x2d, 0x1e, 0x1d, 0xdc, 0x36, 0x31, 0x2a, 0x72, 0x05, 0xef, 0xdf, 0x73, 0xa9, 0x4b, 0x96,
+	0xd5, 0x73, 0x61, 0xff, 0xb2, 0x0a, 0x7b, 0xf6, 0xd9, 0xf7, 0xaa, 0xc3, 0x6a, 0x36, 0xdc, 0xdb,
+	0xaf, 0xe1, 0x83, 0xd5, 0xdd, 0x5a, 0xf1, 0xe4, 0x49, 0xd1, 0x81, 0x2d, 0x7a, 0x56, 0x9f, 0x6e,
+	0x55, 0x7d, 0x05, 0x67, 0x4d, 0x0f, 0xb1, 0x53, 0x25, 0xb5, 0x4a, 0x29, 0xcd, 0xf2, 0x95, 0x0a,
+	0x83, 0x91, 0x3f, 0xee, 0xd0, 0xd3, 0x26, 0x67, 0xe2, 0x52, 0xe6, 0x4d, 0x06, 0xa1, 0x70, 0x92,
+	0x14, 0x42, 0x71, 0xa5, 0x51, 0x24, 0xeb, 0x38, 0xc3, 0x7b, 0xcc, 0x42, 0x18, 0x79, 0xe3, 0xe1,
+	0xd5, 0xf9, 0xce, 0x99, 0x9a, 0x6c, 0xb2, 0xbf, 0x37, 0xc9, 0xf4, 0x38, 0x79, 0x84, 0x90, 0x0f,
+	0xa1, 0x97, 0x2e, 0xdc, 0x60, 0xf7, 0xed, 0x60, 0x77, 0xd3, 0x85, 0xe1, 0x1b, 0xdd, 0xc0, 0x60,
+	0xca, 0x34, 0x5b, 0x30, 0x85, 0x3b, 0x87, 0x85, 0x40, 0xc7, 0x56, 0xed, 0xd9, 0x2a, 0xfb, 0xfd,
+	0x9f, 0x13, 0x10, 0xbd, 0x83, 0xe1, 0x44, 0x62, 0x8a, 0x42, 0x73, 0x96, 0xd9, 0x6b, 0x4f, 0xe1,
+	0xa0, 0x54, 0x28, 0x5b, 0x9b, 0xd5, 0xc4, 0xe4, 0x25, 0x10, 0x14, 0x89, 0x5c, 0xaf, 0x8c, 0x52,
+	0x2b, 0xa6, 0xd4, 0xaf, 0x85, 0x4c, 0xab, 0x07, 0x4f, 0x9a, 0x93, 0xeb, 0xea, 0x80, 0x3c, 0x83,
+	0x7d, 0x59, 0x64, 0x58, 0x6f, 0xb5, 0x0b, 0xa2, 0x04, 0x82, 0x6f, 0x25, 0x13, 0xda, 0xbe, 0xd6,
+	0xea, 0xd6, 0x6b, 0x77, 0x6b, 0x98, 0x17, 0x8b, 0x5f, 0x30, 0xd1, 0x71, 0xab, 0x29, 0x70, 0x90,
+	0x4d, 0x38, 0x83, 0x60, 0x25, 0xf9, 0x3d, 0xcf, 0x70, 0xe9, 0x1a, 0x0b, 0xe8, 0x06, 0x88, 0xe6,
+	0x70, 0x40, 0x8b, 0xcc, 0x09, 0x55, 0x0b, 0xe3, 0xb5, 0x84, 0x79, 0x0d, 0xdd, 0xa5, 0x21, 0x61,
+	0x3c, 0xcb, 0x7f, 0xea, 0x59, 0x76, 0x4c, 0x1b, 0x96, 0xb4, 0xca, 0x8d, 0xfe, 0xf2, 0xe0, 0xf8,
+	0x06, 0x97, 0x39, 0x0a, 0xdd, 0x8c, 0xaf, 0x59, 0xa2, 0x64, 0xb3, 0xc6, 0xf5, 0x1f, 0xd9, 0xc2,
+	0xc8, 0x08, 0xfa, 0xad, 0xa5, 0xaa, 0x9c, 0xa0, 0x0d, 0x99, 0x76, 0x54, 0x75, 0xf3, 0xd4, 0xb6,
+	0xe3, 0xd3, 0x0d, 0xe0, 0xfc, 0xc5, 0x2c, 0x89, 0xb3, 0x68, 0x9f, 0xd6, 0x61, 0xdb, 0x5f, 0xf6,
+	0xb7, 0xbd, 0x2e, 0x84, 0xde, 0xa2, 0xe4, 0xb6, 0xa6, 0xeb, 0x4e, 0xaa, 0x90, 0x3c, 0x87, 0x01,
+	0x0a, 0xb6, 0xc8, 0xd0, 0xed, 0x6a, 0xd8, 0x1b, 0x79, 0xe3, 0x03, 0xda, 0x77, 0x98, 0x6d, 0x2c,
+	0xfa, 0xdb, 0x6b, 0x9b, 0xd3, 0x4e, 0xdf, 0xff, 0xbf, 0xcd, 0xe9, 0x63, 0x80, 0x46, 0x80, 0xda,
+	0x9a, 0x5a, 0x08, 0x39, 0x6f, 0x19, 0x53, 0xac, 0xd9, 0xb2, 0x36, 0xa6, 0xc3, 0x06, 0x9d, 0xb3,
+	0xa5, 0x7a, 0xe2, 0x71, 0xdd, 0xa7, 0x1e, 0xf7, 0xcd, 0xab, 0x9f, 0x3f, 0x5f, 0x72, 0x7d, 0x57,
+	0x2e, 0xcc, 0x9e, 0x5e, 0xba, 0x36, 0x5e, 0xf2, 0xa2, 0xfa, 0xba, 0xe4, 0x42, 0x9b, 0x05, 0xc8,
+	0x2e, 0x6d, 0x67, 0x97, 0x66, 0x38, 0x56, 0x8b, 0x45, 0xd7, 0x46, 0xaf, 0xfe, 0x19, 0x00, 0x23,
+	0x15, 0xdf, 0x3f, 0xfb, 0x07, 0x00, 0x00,
 }
@@ -550,6 +550,7 @@ type IndexFilePathInfo struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	IndexBuildID         int64            `protobuf:"varint,2,opt,name=indexBuildID,proto3" json:"indexBuildID,omitempty"`
 	IndexFilePaths       []string         `protobuf:"bytes,3,rep,name=index_file_paths,json=indexFilePaths,proto3" json:"index_file_paths,omitempty"`
+	SerializedSize       int64            `protobuf:"varint,4,opt,name=serialized_size,json=serializedSize,proto3" json:"serialized_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -601,6 +602,13 @@ func (m *IndexFilePathInfo) GetIndexFilePaths() []string {
 	return nil
 }
 
+func (m *IndexFilePathInfo) GetSerializedSize() int64 {
+	if m != nil {
+		return m.SerializedSize
+	}
+	return 0
+}
+
 type GetIndexFilePathsResponse struct {
 	Status               *commonpb.Status     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	FilePaths            []*IndexFilePathInfo `protobuf:"bytes,2,rep,name=file_paths,json=filePaths,proto3" json:"file_paths,omitempty"`
@@ -658,6 +666,7 @@ type IndexMeta struct {
 	NodeID               int64               `protobuf:"varint,7,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
 	Version              int64               `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
 	Recycled             bool                `protobuf:"varint,9,opt,name=recycled,proto3" json:"recycled,omitempty"`
+	SerializedSize       int64               `protobuf:"varint,10,opt,name=serialized_size,json=serializedSize,proto3" json:"serialized_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -751,6 +760,13 @@ func (m *IndexMeta) GetRecycled() bool {
 	return false
 }
 
+func (m *IndexMeta) GetSerializedSize() int64 {
+	if m != nil {
+		return m.SerializedSize
+	}
+	return 0
+}
+
 type DropIndexRequest struct {
 	IndexID              int64    `protobuf:"varint,1,opt,name=indexID,proto3" json:"indexID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -809,68 +825,70 @@ func init() {
 func init() { proto.RegisterFile("index_coord.proto", fileDescriptor_f9e019eb3fda53c2) }
 
 var fileDescriptor_f9e019eb3fda53c2 = []byte{
-	// 975 bytes of a gzipped FileDescriptorProto
+	// 1002 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x5f, 0x6f, 0x1b, 0x45,
-	0x10, 0xcf, 0xf9, 0x12, 0x3b, 0x1e, 0x87, 0xa8, 0x59, 0x4a, 0x75, 0xb8, 0x54, 0x75, 0x8e, 0x52,
-	0x0c, 0x6a, 0x9d, 0xca, 0xa5, 0xf0, 0x84, 0x04, 0x89, 0x45, 0x64, 0xa1, 0x54, 0xd1, 0x36, 0xe2,
-	0x01, 0x09, 0x59, 0x1b, 0xdf, 0x24, 0x59, 0xf5, 0xfe, 0xe5, 0x76, 0x5d, 0x91, 0x77, 0xde, 0x79,
-	0x2b, 0xe2, 0x93, 0xf0, 0x39, 0xfa, 0xcc, 0x97, 0x41, 0xbb, 0xb7, 0x77, 0xb9, 0x3b, 0x9f, 0x53,
-	0x87, 0x50, 0x78, 0xe1, 0xed, 0x66, 0xf6, 0x37, 0x33, 0x3b, 0xbf, 0x9d, 0xfd, 0xdd, 0xc2, 0x16,
-	0x0f, 0x3d, 0xfc, 0x79, 0x32, 0x8d, 0xa2, 0xc4, 0x1b, 0xc4, 0x49, 0x24, 0x23, 0x42, 0x02, 0xee,
-	0xbf, 0x9a, 0x89, 0xd4, 0x1a, 0xe8, 0xf5, 0xee, 0xc6, 0x34, 0x0a, 0x82, 0x28, 0x4c, 0x7d, 0xdd,
-	0x4d, 0x1e, 0x4a, 0x4c, 0x42, 0xe6, 0x1b, 0x7b, 0xa3, 0x18, 0xe1, 0xfe, 0x66, 0xc1, 0xfb, 0x14,
-	0x4f, 0xb9, 0x90, 0x98, 0x3c, 0x8f, 0x3c, 0xa4, 0x78, 0x3e, 0x43, 0x21, 0xc9, 0x13, 0x58, 0x3d,
-	0x66, 0x02, 0x1d, 0xab, 0x67, 0xf5, 0x3b, 0xc3, 0x8f, 0x06, 0xa5, 0x32, 0x26, 0xff, 0x81, 0x38,
-	0xdd, 0x65, 0x02, 0xa9, 0x46, 0x92, 0x2f, 0xa1, 0xc5, 0x3c, 0x2f, 0x41, 0x21, 0x9c, 0xc6, 0x15,
-	0x41, 0xdf, 0xa6, 0x18, 0x9a, 0x81, 0xc9, 0x1d, 0x68, 0x86, 0x91, 0x87, 0xe3, 0x91, 0x63, 0xf7,
-	0xac, 0xbe, 0x4d, 0x8d, 0xe5, 0xfe, 0x6a, 0xc1, 0xed, 0xf2, 0xce, 0x44, 0x1c, 0x85, 0x02, 0xc9,
-	0x53, 0x68, 0x0a, 0xc9, 0xe4, 0x4c, 0x98, 0xcd, 0xdd, 0xad, 0xad, 0xf3, 0x42, 0x43, 0xa8, 0x81,
-	0x92, 0x5d, 0xe8, 0xf0, 0x90, 0xcb, 0x49, 0xcc, 0x12, 0x16, 0x64, 0x3b, 0xdc, 0x1e, 0x54, 0xd8,
-	0x33, 0x44, 0x8d, 0x43, 0x2e, 0x0f, 0x35, 0x90, 0x02, 0xcf, 0xbf, 0xdd, 0xaf, 0xe1, 0x83, 0x7d,
-	0x94, 0x63, 0xc5, 0xb1, 0xca, 0x8e, 0x22, 0x23, 0xeb, 0x01, 0xbc, 0xa7, 0x99, 0xdf, 0x9d, 0x71,
-	0xdf, 0x1b, 0x8f, 0xd4, 0xc6, 0xec, 0xbe, 0x4d, 0xcb, 0x4e, 0xf7, 0x0f, 0x0b, 0xda, 0x3a, 0x78,
-	0x1c, 0x9e, 0x44, 0xe4, 0x19, 0xac, 0xa9, 0xad, 0xa5, 0x0c, 0x6f, 0x0e, 0xef, 0xd7, 0x36, 0x71,
-	0x59, 0x8b, 0xa6, 0x68, 0xe2, 0xc2, 0x46, 0x31, 0xab, 0x6e, 0xc4, 0xa6, 0x25, 0x1f, 0x71, 0xa0,
-	0xa5, 0xed, 0x9c, 0xd2, 0xcc, 0x24, 0xf7, 0x00, 0xd2, 0x11, 0x0a, 0x59, 0x80, 0xce, 0x6a, 0xcf,
-	0xea, 0xb7, 0x69, 0x5b, 0x7b, 0x9e, 0xb3, 0x00, 0xd5, 0x51, 0x24, 0xc8, 0x44, 0x14, 0x3a, 0x6b,
-	0x7a, 0xc9, 0x58, 0xee, 0x2f, 0x16, 0xdc, 0xa9, 0x76, 0x7e, 0x93, 0xc3, 0x78, 0x96, 0x06, 0xa1,
-	0x3a, 0x07, 0xbb, 0xdf, 0x19, 0xde, 0x1b, 0xcc, 0x4f, 0xf1, 0x20, 0xa7, 0x8a, 0x1a, 0xb0, 0xfb,
-	0xa6, 0x01, 0x64, 0x2f, 0x41, 0x26, 0x51, 0xaf, 0x65, 0xec, 0x57, 0x29, 0xb1, 0x6a, 0x28, 0x29,
-	0x37, 0xde, 0xa8, 0x36, 0xbe, 0x98, 0x31, 0x07, 0x5a, 0xaf, 0x30, 0x11, 0x3c, 0x0a, 0x35, 0x5d,
-	0x36, 0xcd, 0x4c, 0x72, 0x17, 0xda, 0x01, 0x4a, 0x36, 0x89, 0x99, 0x3c, 0x33, 0x7c, 0xad, 0x2b,
-	0xc7, 0x21, 0x93, 0x67, 0xaa, 0x9e, 0xc7, 0xcc, 0xa2, 0x70, 0x9a, 0x3d, 0x5b, 0xd5, 0x53, 0x1e,
-	0xb5, 0xaa, 0xa7, 0x51, 0x5e, 0xc4, 0x98, 0x4d, 0x63, 0x4b, 0xb3, 0xb0, 0x5d, 0x4b, 0xdd, 0xf7,
-	0x78, 0xf1, 0x03, 0xf3, 0x67, 0x78, 0xc8, 0x78, 0x42, 0x41, 0x45, 0xa5, 0xd3, 0x48, 0x46, 0xa6,
-	0xed, 0x2c, 0xc9, 0xfa, 0xb2, 0x49, 0x3a, 0x3a, 0xcc, 0xcc, 0xf4, 0xef, 0x0d, 0xd8, 0x4a, 0x49,
-	0xfa, 0xd7, 0x28, 0x2d, 0x73, 0xb3, 0xf6, 0x16, 0x6e, 0x9a, 0xff, 0x04, 0x37, 0xad, 0xbf, 0xc5,
-	0x4d, 0x00, 0xa4, 0x48, 0xcd, 0x4d, 0x26, 0x7e, 0x89, 0x6b, 0xeb, 0x7e, 0x03, 0x4e, 0x76, 0xc9,
-	0xbe, 0xe3, 0x3e, 0x6a, 0x36, 0xae, 0xa7, 0x30, 0xaf, 0x2d, 0xd8, 0x2a, 0xc5, 0x6b, 0xa5, 0x79,
-	0x57, 0x1b, 0x26, 0x7d, 0xb8, 0x95, 0xb2, 0x7c, 0xc2, 0x7d, 0x34, 0xc7, 0x69, 0xeb, 0xe3, 0xdc,
-	0xe4, 0xa5, 0x2e, 0xd4, 0xc6, 0x3e, 0xac, 0xe9, 0xed, 0x26, 0x8c, 0x8e, 0x00, 0x0a, 0x65, 0x53,
-	0x1d, 0xf9, 0x64, 0xa1, 0x8e, 0x14, 0x09, 0xa1, 0xed, 0x93, 0x7c, 0x63, 0x7f, 0x36, 0x8c, 0x26,
-	0x1f, 0xa0, 0x64, 0x4b, 0x8d, 0x7d, 0xae, 0xdb, 0x8d, 0x6b, 0xe9, 0xf6, 0x7d, 0xe8, 0x9c, 0x30,
-	0xee, 0x4f, 0x8c, 0xbe, 0xda, 0xfa, 0xba, 0x80, 0x72, 0x51, 0xed, 0x21, 0x5f, 0x81, 0x9d, 0xe0,
-	0xb9, 0x16, 0x99, 0x05, 0x8d, 0xcc, 0x5d, 0x53, 0xaa, 0x22, 0x6a, 0x4f, 0x61, 0xad, 0xee, 0x14,
-	0xc8, 0x36, 0x6c, 0x04, 0x2c, 0x79, 0x39, 0xf1, 0xd0, 0x47, 0x89, 0x9e, 0xd3, 0xec, 0x59, 0xfd,
-	0x75, 0xda, 0x51, 0xbe, 0x51, 0xea, 0x2a, 0xfc, 0x8c, 0x5b, 0xc5, 0x9f, 0x71, 0x51, 0x06, 0xd7,
-	0xcb, 0x32, 0xd8, 0x85, 0xf5, 0x04, 0xa7, 0x17, 0x53, 0x1f, 0x3d, 0xa7, 0xad, 0x13, 0xe6, 0xb6,
-	0xfb, 0x08, 0x6e, 0x8d, 0x92, 0x28, 0x2e, 0x49, 0x4b, 0x41, 0x17, 0xac, 0x92, 0x2e, 0x0c, 0xdf,
-	0x34, 0x01, 0x34, 0x74, 0x4f, 0xbd, 0x6f, 0x48, 0x0c, 0x64, 0x1f, 0xe5, 0x5e, 0x14, 0xc4, 0x51,
-	0x88, 0xa1, 0x4c, 0xff, 0x3b, 0xe4, 0xc9, 0x82, 0x5f, 0xf6, 0x3c, 0xd4, 0x14, 0xec, 0x3e, 0x5c,
-	0x10, 0x51, 0x81, 0xbb, 0x2b, 0x24, 0xd0, 0x15, 0x8f, 0x78, 0x80, 0x47, 0x7c, 0xfa, 0x72, 0xef,
-	0x8c, 0x85, 0x21, 0xfa, 0x57, 0x55, 0xac, 0x40, 0xb3, 0x8a, 0x1f, 0x97, 0x23, 0x8c, 0xf1, 0x42,
-	0x26, 0x3c, 0x3c, 0xcd, 0x86, 0xde, 0x5d, 0x21, 0xe7, 0x70, 0x7b, 0x1f, 0x75, 0x75, 0x2e, 0x24,
-	0x9f, 0x8a, 0xac, 0xe0, 0x70, 0x71, 0xc1, 0x39, 0xf0, 0x35, 0x4b, 0xfe, 0x04, 0x70, 0x39, 0x45,
-	0x64, 0xb9, 0x29, 0x9b, 0x27, 0xb0, 0x0a, 0xcb, 0xd3, 0x73, 0xd8, 0x2c, 0x3f, 0x13, 0xc8, 0x67,
-	0x75, 0xb1, 0xb5, 0x8f, 0xa8, 0xee, 0xe7, 0xcb, 0x40, 0xf3, 0x52, 0x09, 0x6c, 0xcd, 0x09, 0x0a,
-	0x79, 0x74, 0x55, 0x8a, 0xaa, 0xa6, 0x76, 0x1f, 0x2f, 0x89, 0xce, 0x6b, 0x1e, 0x42, 0x3b, 0x1f,
-	0x67, 0xf2, 0xa0, 0x2e, 0xba, 0x3a, 0xed, 0xdd, 0xab, 0xa4, 0xcc, 0x5d, 0x21, 0x13, 0x80, 0x7d,
-	0x94, 0x07, 0x28, 0x13, 0x3e, 0x15, 0xe4, 0x61, 0xed, 0x21, 0x5e, 0x02, 0xb2, 0xa4, 0x9f, 0xbe,
-	0x15, 0x97, 0x6d, 0x79, 0xf8, 0x7a, 0xd5, 0xe8, 0x9b, 0x7a, 0x41, 0xff, 0x7f, 0xa5, 0xde, 0xc1,
-	0x95, 0x3a, 0x82, 0x4e, 0xe1, 0x4d, 0x4a, 0x6a, 0x2f, 0xcb, 0xfc, 0xa3, 0xf5, 0xbf, 0x1e, 0x8c,
-	0xdd, 0x2f, 0x7e, 0x1c, 0x9e, 0x72, 0x79, 0x36, 0x3b, 0x56, 0xa5, 0x77, 0x52, 0xe4, 0x63, 0x1e,
-	0x99, 0xaf, 0x9d, 0x8c, 0xa1, 0x1d, 0x9d, 0x69, 0x47, 0xb7, 0x11, 0x1f, 0x1f, 0x37, 0xb5, 0xf9,
-	0xf4, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x75, 0x9d, 0x20, 0xf1, 0x89, 0x0e, 0x00, 0x00,
+	0x10, 0xcf, 0xe5, 0x12, 0xff, 0x19, 0x07, 0xd3, 0x2c, 0xa5, 0x3a, 0x5c, 0xaa, 0xba, 0x47, 0x49,
+	0x0d, 0x6a, 0x9d, 0xca, 0xa5, 0xf0, 0x84, 0x04, 0x89, 0x45, 0x64, 0xa1, 0x54, 0xd1, 0x26, 0xe2,
+	0x01, 0x09, 0x59, 0x1b, 0xdf, 0x24, 0x59, 0xf5, 0xfe, 0x38, 0xbb, 0xeb, 0x8a, 0xe4, 0xb9, 0xef,
+	0xbc, 0x15, 0xf1, 0x49, 0x78, 0xe4, 0x33, 0xf4, 0x1b, 0xa1, 0xdb, 0xdb, 0xbb, 0xdc, 0xd9, 0xe7,
+	0xc4, 0x21, 0x14, 0x5e, 0xfa, 0x76, 0x33, 0xfb, 0x9b, 0x99, 0xdd, 0xdf, 0xce, 0xfc, 0x6e, 0x61,
+	0x9d, 0x87, 0x1e, 0xfe, 0x3a, 0x1c, 0x45, 0x91, 0xf0, 0xba, 0x63, 0x11, 0xa9, 0x88, 0x90, 0x80,
+	0xfb, 0xaf, 0x26, 0x32, 0xb1, 0xba, 0x7a, 0xbd, 0xb5, 0x36, 0x8a, 0x82, 0x20, 0x0a, 0x13, 0x5f,
+	0xab, 0xc9, 0x43, 0x85, 0x22, 0x64, 0xbe, 0xb1, 0xd7, 0xf2, 0x11, 0xee, 0xef, 0x16, 0x7c, 0x44,
+	0xf1, 0x98, 0x4b, 0x85, 0xe2, 0x45, 0xe4, 0x21, 0xc5, 0xd3, 0x09, 0x4a, 0x45, 0x9e, 0xc2, 0xca,
+	0x21, 0x93, 0xe8, 0x58, 0x6d, 0xab, 0xd3, 0xe8, 0x7d, 0xda, 0x2d, 0x94, 0x31, 0xf9, 0x77, 0xe5,
+	0xf1, 0x16, 0x93, 0x48, 0x35, 0x92, 0x7c, 0x0d, 0x55, 0xe6, 0x79, 0x02, 0xa5, 0x74, 0x96, 0x2f,
+	0x09, 0xfa, 0x3e, 0xc1, 0xd0, 0x14, 0x4c, 0xee, 0x40, 0x25, 0x8c, 0x3c, 0x1c, 0xf4, 0x1d, 0xbb,
+	0x6d, 0x75, 0x6c, 0x6a, 0x2c, 0xf7, 0x37, 0x0b, 0x6e, 0x17, 0x77, 0x26, 0xc7, 0x51, 0x28, 0x91,
+	0x3c, 0x83, 0x8a, 0x54, 0x4c, 0x4d, 0xa4, 0xd9, 0xdc, 0xdd, 0xd2, 0x3a, 0xfb, 0x1a, 0x42, 0x0d,
+	0x94, 0x6c, 0x41, 0x83, 0x87, 0x5c, 0x0d, 0xc7, 0x4c, 0xb0, 0x20, 0xdd, 0xe1, 0x83, 0xee, 0x14,
+	0x7b, 0x86, 0xa8, 0x41, 0xc8, 0xd5, 0x9e, 0x06, 0x52, 0xe0, 0xd9, 0xb7, 0xfb, 0x2d, 0x7c, 0xbc,
+	0x83, 0x6a, 0x10, 0x73, 0x1c, 0x67, 0x47, 0x99, 0x92, 0xf5, 0x10, 0x3e, 0xd0, 0xcc, 0x6f, 0x4d,
+	0xb8, 0xef, 0x0d, 0xfa, 0xf1, 0xc6, 0xec, 0x8e, 0x4d, 0x8b, 0x4e, 0xf7, 0x4f, 0x0b, 0xea, 0x3a,
+	0x78, 0x10, 0x1e, 0x45, 0xe4, 0x39, 0xac, 0xc6, 0x5b, 0x4b, 0x18, 0x6e, 0xf6, 0xee, 0x97, 0x1e,
+	0xe2, 0xa2, 0x16, 0x4d, 0xd0, 0xc4, 0x85, 0xb5, 0x7c, 0x56, 0x7d, 0x10, 0x9b, 0x16, 0x7c, 0xc4,
+	0x81, 0xaa, 0xb6, 0x33, 0x4a, 0x53, 0x93, 0xdc, 0x03, 0x48, 0x5a, 0x28, 0x64, 0x01, 0x3a, 0x2b,
+	0x6d, 0xab, 0x53, 0xa7, 0x75, 0xed, 0x79, 0xc1, 0x02, 0x8c, 0xaf, 0x42, 0x20, 0x93, 0x51, 0xe8,
+	0xac, 0xea, 0x25, 0x63, 0xb9, 0xaf, 0x2d, 0xb8, 0x33, 0x7d, 0xf2, 0x9b, 0x5c, 0xc6, 0xf3, 0x24,
+	0x08, 0xe3, 0x7b, 0xb0, 0x3b, 0x8d, 0xde, 0xbd, 0xee, 0x6c, 0x17, 0x77, 0x33, 0xaa, 0xa8, 0x01,
+	0xbb, 0x6f, 0x97, 0x81, 0x6c, 0x0b, 0x64, 0x0a, 0xf5, 0x5a, 0xca, 0xfe, 0x34, 0x25, 0x56, 0x09,
+	0x25, 0xc5, 0x83, 0x2f, 0x4f, 0x1f, 0x7c, 0x3e, 0x63, 0x0e, 0x54, 0x5f, 0xa1, 0x90, 0x3c, 0x0a,
+	0x35, 0x5d, 0x36, 0x4d, 0x4d, 0x72, 0x17, 0xea, 0x01, 0x2a, 0x36, 0x1c, 0x33, 0x75, 0x62, 0xf8,
+	0xaa, 0xc5, 0x8e, 0x3d, 0xa6, 0x4e, 0xe2, 0x7a, 0x1e, 0x33, 0x8b, 0xd2, 0xa9, 0xb4, 0xed, 0xb8,
+	0x9e, 0xc7, 0x92, 0x55, 0xdd, 0x8d, 0xea, 0x6c, 0x8c, 0x69, 0x37, 0x56, 0xdb, 0xf6, 0x6c, 0x37,
+	0x1a, 0xea, 0x7e, 0xc4, 0xb3, 0x9f, 0x98, 0x3f, 0xc1, 0x3d, 0xc6, 0x05, 0x85, 0x38, 0x2a, 0xe9,
+	0x46, 0xd2, 0x37, 0xc7, 0x4e, 0x93, 0xd4, 0x16, 0x4d, 0xd2, 0xd0, 0x61, 0xa6, 0xa7, 0xff, 0x58,
+	0x86, 0xf5, 0x84, 0xa4, 0xff, 0x8c, 0xd2, 0x22, 0x37, 0xab, 0x57, 0x70, 0x53, 0xf9, 0x37, 0xb8,
+	0xa9, 0xfe, 0x23, 0x6e, 0x02, 0x20, 0x79, 0x6a, 0x6e, 0xd2, 0xf1, 0x0b, 0x8c, 0xad, 0xfb, 0x1d,
+	0x38, 0xe9, 0x90, 0xfd, 0xc0, 0x7d, 0xd4, 0x6c, 0x5c, 0x4f, 0x61, 0xfe, 0xb2, 0x60, 0xbd, 0x10,
+	0xaf, 0x95, 0xe6, 0x5d, 0x6d, 0x98, 0x74, 0xe0, 0x56, 0xc2, 0xf2, 0x11, 0xf7, 0xd1, 0x5c, 0xa7,
+	0xad, 0xaf, 0xb3, 0xc9, 0x0b, 0xa7, 0x20, 0x8f, 0xe0, 0x43, 0x89, 0x82, 0x33, 0x9f, 0x9f, 0xa3,
+	0x37, 0x94, 0xfc, 0x1c, 0xcd, 0x34, 0x35, 0x2f, 0xdc, 0xfb, 0xfc, 0x1c, 0xdd, 0x37, 0x16, 0x7c,
+	0x52, 0x42, 0xc2, 0x4d, 0xa8, 0xef, 0x03, 0xe4, 0xf6, 0x97, 0x08, 0xce, 0xe7, 0x73, 0x05, 0x27,
+	0xcf, 0x1c, 0xad, 0x1f, 0x19, 0x4b, 0xba, 0xaf, 0x6d, 0x23, 0xde, 0xbb, 0xa8, 0xd8, 0x42, 0xf3,
+	0x91, 0x09, 0xfc, 0xf2, 0xb5, 0x04, 0xfe, 0x3e, 0x34, 0x8e, 0x18, 0xf7, 0x87, 0x46, 0x88, 0x6d,
+	0x3d, 0x57, 0x10, 0xbb, 0xa8, 0xf6, 0x90, 0x6f, 0xc0, 0x16, 0x78, 0xaa, 0xf9, 0x9b, 0x73, 0x90,
+	0x99, 0x79, 0xa6, 0x71, 0x44, 0xe9, 0x75, 0xad, 0x96, 0x5e, 0xd7, 0x03, 0x58, 0x0b, 0x98, 0x78,
+	0x39, 0xf4, 0xd0, 0x47, 0x85, 0x9e, 0x53, 0x69, 0x5b, 0x9d, 0x1a, 0x6d, 0xc4, 0xbe, 0x7e, 0xe2,
+	0xca, 0xfd, 0xb5, 0xab, 0xf9, 0xbf, 0x76, 0x5e, 0x2f, 0x6b, 0x45, 0xbd, 0x6c, 0x41, 0x4d, 0xe0,
+	0xe8, 0x6c, 0xe4, 0xa3, 0xe7, 0xd4, 0x75, 0xc2, 0xcc, 0x2e, 0xeb, 0x0f, 0x28, 0xed, 0x8f, 0xc7,
+	0x70, 0xab, 0x2f, 0xa2, 0x71, 0x41, 0xac, 0x72, 0x4a, 0x63, 0x15, 0x94, 0xa6, 0xf7, 0xb6, 0x02,
+	0xa0, 0xa1, 0xdb, 0xf1, 0x8b, 0x89, 0x8c, 0x81, 0xec, 0xa0, 0xda, 0x8e, 0x82, 0x71, 0x14, 0x62,
+	0xa8, 0x92, 0x3f, 0x19, 0x79, 0x3a, 0xe7, 0x11, 0x30, 0x0b, 0x35, 0x05, 0x5b, 0x1b, 0x73, 0x22,
+	0xa6, 0xe0, 0xee, 0x12, 0x09, 0x74, 0xc5, 0x03, 0x1e, 0xe0, 0x01, 0x1f, 0xbd, 0xdc, 0x3e, 0x61,
+	0x61, 0x88, 0xfe, 0x65, 0x15, 0xa7, 0xa0, 0x69, 0xc5, 0xcf, 0x8a, 0x11, 0xc6, 0xd8, 0x57, 0x82,
+	0x87, 0xc7, 0xe9, 0x74, 0xb8, 0x4b, 0xe4, 0x14, 0x6e, 0xef, 0xa0, 0xae, 0xce, 0xa5, 0xe2, 0x23,
+	0x99, 0x16, 0xec, 0xcd, 0x2f, 0x38, 0x03, 0xbe, 0x66, 0xc9, 0x5f, 0x00, 0x2e, 0xda, 0x8d, 0x2c,
+	0xd6, 0x8e, 0xad, 0x8d, 0xab, 0x60, 0x59, 0x7a, 0x0e, 0xcd, 0xe2, 0xc3, 0x83, 0x7c, 0x51, 0x16,
+	0x5b, 0xfa, 0x2c, 0x6b, 0x7d, 0xb9, 0x08, 0x34, 0x2b, 0x25, 0x60, 0x7d, 0x46, 0x79, 0xc8, 0xe3,
+	0xcb, 0x52, 0x4c, 0xab, 0x74, 0xeb, 0xc9, 0x82, 0xe8, 0xac, 0xe6, 0x1e, 0xd4, 0xb3, 0x76, 0x26,
+	0x0f, 0xcb, 0xa2, 0xa7, 0xbb, 0xbd, 0x75, 0x99, 0xe6, 0xb9, 0x4b, 0x64, 0x08, 0xb0, 0x83, 0x6a,
+	0x17, 0x95, 0xe0, 0x23, 0x49, 0x36, 0x4a, 0x2f, 0xf1, 0x02, 0x90, 0x26, 0x7d, 0x74, 0x25, 0x2e,
+	0xdd, 0x72, 0xef, 0xcd, 0x8a, 0x11, 0xc2, 0xf8, 0x4d, 0xfe, 0x7e, 0xa4, 0xde, 0xc1, 0x48, 0x1d,
+	0x40, 0x23, 0xf7, 0xca, 0x25, 0xa5, 0xc3, 0x32, 0xfb, 0x0c, 0xfe, 0xbf, 0x1b, 0x63, 0xeb, 0xab,
+	0x9f, 0x7b, 0xc7, 0x5c, 0x9d, 0x4c, 0x0e, 0xe3, 0xd2, 0x9b, 0x09, 0xf2, 0x09, 0x8f, 0xcc, 0xd7,
+	0x66, 0xca, 0xd0, 0xa6, 0xce, 0xb4, 0xa9, 0x8f, 0x31, 0x3e, 0x3c, 0xac, 0x68, 0xf3, 0xd9, 0xdf,
+	0x03, 0x00, 0x6e, 0xab, 0xb6, 0x95, 0xdb, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1059,15 +1059,17 @@ type SearchRequest struct {
 	PartitionIDs    []int64           `protobuf:"varint,5,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
 	Dsl             string            `protobuf:"bytes,6,opt,name=dsl,proto3" json:"dsl,omitempty"`
 	// serialized `PlaceholderGroup`
-	PlaceholderGroup     []byte           `protobuf:"bytes,7,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
-	DslType              commonpb.DslType `protobuf:"varint,8,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
-	SerializedExprPlan   []byte           `protobuf:"bytes,9,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
-	OutputFieldsId       []int64          `protobuf:"varint,10,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
-	TravelTimestamp      uint64           `protobuf:"varint,11,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64           `protobuf:"varint,12,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	PlaceholderGroup   []byte           `protobuf:"bytes,7,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
+	DslType            commonpb.DslType `protobuf:"varint,8,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
+	SerializedExprPlan []byte           `protobuf:"bytes,9,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
+	OutputFieldsId     []int64          `protobuf:"varint,10,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
+	TravelTimestamp    uint64           `protobuf:"varint,11,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp uint64           `protobuf:"varint,12,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	// only the query nodes of the replica serve the request, all query nodes serve it if 0
+	ReplicaID            int64    `protobuf:"varint,13,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
@@ -1179,6 +1181,13 @@ func (m *SearchRequest) GetGuaranteeTimestamp() uint64 {
 	return 0
 }
 
+func (m *SearchRequest) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
 type SearchResults struct {
 	Base                     *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Status                   *commonpb.Status  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1193,6 +1202,7 @@ type SearchResults struct {
 	SlicedBlob           []byte   `protobuf:"bytes,10,opt,name=sliced_blob,json=slicedBlob,proto3" json:"sliced_blob,omitempty"`
 	SlicedNumCount       int64    `protobuf:"varint,11,opt,name=sliced_num_count,json=slicedNumCount,proto3" json:"sliced_num_count,omitempty"`
 	SlicedOffset         int64    `protobuf:"varint,12,opt,name=sliced_offset,json=slicedOffset,proto3" json:"sliced_offset,omitempty"`
+	ReplicaID            int64    `protobuf:"varint,13,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1307,19 +1317,30 @@ func (m *SearchResults) GetSlicedOffset() int64 {
 	return 0
 }
 
+func (m *SearchResults) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
 type RetrieveRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	ResultChannelID      string            `protobuf:"bytes,2,opt,name=result_channelID,json=resultChannelID,proto3" json:"result_channelID,omitempty"`
-	DbID                 int64             `protobuf:"varint,3,opt,name=dbID,proto3" json:"dbID,omitempty"`
-	CollectionID         int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionIDs         []int64           `protobuf:"varint,5,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
-	Ids                  *schemapb.IDs     `protobuf:"bytes,6,opt,name=ids,proto3" json:"ids,omitempty"`
-	OutputFieldsId       []int64           `protobuf:"varint,7,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
-	TravelTimestamp      uint64            `protobuf:"varint,8,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64            `protobuf:"varint,9,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base               *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ResultChannelID    string            `protobuf:"bytes,2,opt,name=result_channelID,json=resultChannelID,proto3" json:"result_channelID,omitempty"`
+	DbID               int64             `protobuf:"varint,3,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	CollectionID       int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionIDs       []int64           `protobuf:"varint,5,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	Ids                *schemapb.IDs     `protobuf:"bytes,6,opt,name=ids,proto3" json:"ids,omitempty"`
+	OutputFieldsId     []int64           `protobuf:"varint,7,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
+	TravelTimestamp    uint64            `protobuf:"varint,8,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp uint64            `protobuf:"varint,9,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	OrderBy            []*OrderByField   `protobuf:"bytes,10,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Limit              int64             `protobuf:"varint,11,opt,name=limit,proto3" json:"limit,omitempty"`
+	// only the query nodes of the replica serve the request, all query nodes serve it if 0
+	ReplicaID            int64    `protobuf:"varint,12,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *RetrieveRequest) Reset()         { *m = RetrieveRequest{} }
@@ -1410,6 +1431,74 @@ func (m *RetrieveRequest) GetGuaranteeTimestamp() uint64 {
 	return 0
 }
 
+func (m *RetrieveRequest) GetOrderBy() []*OrderByField {
+	if m != nil {
+		return m.OrderBy
+	}
+	return nil
+}
+
+func (m *RetrieveRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *RetrieveRequest) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
+type OrderByField struct {
+	FieldID              int64    `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	Descending           bool     `protobuf:"varint,2,opt,name=descending,proto3" json:"descending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrderByField) Reset()         { *m = OrderByField{} }
+func (m *OrderByField) String() string { return proto.CompactTextString(m) }
+func (*OrderByField) ProtoMessage()    {}
+func (*OrderByField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{19}
+}
+
+func (m *OrderByField) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderByField.Unmarshal(m, b)
+}
+func (m *OrderByField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderByField.Marshal(b, m, deterministic)
+}
+func (m *OrderByField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderByField.Merge(m, src)
+}
+func (m *OrderByField) XXX_Size() int {
+	return xxx_messageInfo_OrderByField.Size(m)
+}
+func (m *OrderByField) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderByField.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderByField proto.InternalMessageInfo
+
+func (m *OrderByField) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
+func (m *OrderByField) GetDescending() bool {
+	if m != nil {
+		return m.Descending
+	}
+	return false
+}
+
 type RetrieveResults struct {
 	Base                      *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Status                    *commonpb.Status      `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1419,6 +1508,7 @@ type RetrieveResults struct {
 	SealedSegmentIDsRetrieved []int64               `protobuf:"varint,6,rep,packed,name=sealed_segmentIDs_retrieved,json=sealedSegmentIDsRetrieved,proto3" json:"sealed_segmentIDs_retrieved,omitempty"`
 	ChannelIDsRetrieved       []string              `protobuf:"bytes,7,rep,name=channelIDs_retrieved,json=channelIDsRetrieved,proto3" json:"channelIDs_retrieved,omitempty"`
 	GlobalSealedSegmentIDs    []int64               `protobuf:"varint,8,rep,packed,name=global_sealed_segmentIDs,json=globalSealedSegmentIDs,proto3" json:"global_sealed_segmentIDs,omitempty"`
+	ReplicaID                 int64                 `protobuf:"varint,9,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
 	XXX_NoUnkeyedLiteral      struct{}              `json:"-"`
 	XXX_unrecognized          []byte                `json:"-"`
 	XXX_sizecache             int32                 `json:"-"`
@@ -1428,7 +1518,7 @@ func (m *RetrieveResults) Reset()         { *m = RetrieveResults{} }
 func (m *RetrieveResults) String() string { return proto.CompactTextString(m) }
 func (*RetrieveResults) ProtoMessage()    {}
 func (*RetrieveResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{19}
+	return fileDescriptor_41f4a519b878ee3b, []int{20}
 }
 
 func (m *RetrieveResults) XXX_Unmarshal(b []byte) error {
@@ -1505,12 +1595,20 @@ func (m *RetrieveResults) GetGlobalSealedSegmentIDs() []int64 {
 	return nil
 }
 
+func (m *RetrieveResults) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
 type DeleteRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	CollectionName       string            `protobuf:"bytes,2,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
 	ChannelID            string            `protobuf:"bytes,3,opt,name=channelID,proto3" json:"channelID,omitempty"`
 	Timestamps           []uint64          `protobuf:"varint,4,rep,packed,name=timestamps,proto3" json:"timestamps,omitempty"`
 	PrimaryKeys          []int64           `protobuf:"varint,5,rep,packed,name=primary_keys,json=primaryKeys,proto3" json:"primary_keys,omitempty"`
+	CollectionID         int64             `protobuf:"varint,6,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1520,7 +1618,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{20}
+	return fileDescriptor_41f4a519b878ee3b, []int{21}
 }
 
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
@@ -1576,6 +1674,13 @@ func (m *DeleteRequest) GetPrimaryKeys() []int64 {
 	return nil
 }
 
+func (m *DeleteRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
 type LoadBalanceSegmentsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	SegmentIDs           []int64           `protobuf:"varint,2,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
@@ -1588,7 +1693,7 @@ func (m *LoadBalanceSegmentsRequest) Reset()         { *m = LoadBalanceSegmentsR
 func (m *LoadBalanceSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadBalanceSegmentsRequest) ProtoMessage()    {}
 func (*LoadBalanceSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{21}
+	return fileDescriptor_41f4a519b878ee3b, []int{22}
 }
 
 func (m *LoadBalanceSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -1639,7 +1744,7 @@ func (m *LoadIndex) Reset()         { *m = LoadIndex{} }
 func (m *LoadIndex) String() string { return proto.CompactTextString(m) }
 func (*LoadIndex) ProtoMessage()    {}
 func (*LoadIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{22}
+	return fileDescriptor_41f4a519b878ee3b, []int{23}
 }
 
 func (m *LoadIndex) XXX_Unmarshal(b []byte) error {
@@ -1719,7 +1824,7 @@ func (m *SegmentStatisticsUpdates) Reset()         { *m = SegmentStatisticsUpdat
 func (m *SegmentStatisticsUpdates) String() string { return proto.CompactTextString(m) }
 func (*SegmentStatisticsUpdates) ProtoMessage()    {}
 func (*SegmentStatisticsUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{23}
+	return fileDescriptor_41f4a519b878ee3b, []int{24}
 }
 
 func (m *SegmentStatisticsUpdates) XXX_Unmarshal(b []byte) error {
@@ -1801,7 +1906,7 @@ func (m *SegmentStatistics) Reset()         { *m = SegmentStatistics{} }
 func (m *SegmentStatistics) String() string { return proto.CompactTextString(m) }
 func (*SegmentStatistics) ProtoMessage()    {}
 func (*SegmentStatistics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{24}
+	return fileDescriptor_41f4a519b878ee3b, []int{25}
 }
 
 func (m *SegmentStatistics) XXX_Unmarshal(b []byte) error {
@@ -1848,7 +1953,7 @@ func (m *IndexStats) Reset()         { *m = IndexStats{} }
 func (m *IndexStats) String() string { return proto.CompactTextString(m) }
 func (*IndexStats) ProtoMessage()    {}
 func (*IndexStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{25}
+	return fileDescriptor_41f4a519b878ee3b, []int{26}
 }
 
 func (m *IndexStats) XXX_Unmarshal(b []byte) error {
@@ -1896,7 +2001,7 @@ func (m *FieldStats) Reset()         { *m = FieldStats{} }
 func (m *FieldStats) String() string { return proto.CompactTextString(m) }
 func (*FieldStats) ProtoMessage()    {}
 func (*FieldStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{26}
+	return fileDescriptor_41f4a519b878ee3b, []int{27}
 }
 
 func (m *FieldStats) XXX_Unmarshal(b []byte) error {
@@ -1952,7 +2057,7 @@ func (m *SegmentStats) Reset()         { *m = SegmentStats{} }
 func (m *SegmentStats) String() string { return proto.CompactTextString(m) }
 func (*SegmentStats) ProtoMessage()    {}
 func (*SegmentStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{27}
+	return fileDescriptor_41f4a519b878ee3b, []int{28}
 }
 
 func (m *SegmentStats) XXX_Unmarshal(b []byte) error {
@@ -2002,19 +2107,22 @@ func (m *SegmentStats) GetRecentlyModified() bool {
 }
 
 type QueryNodeStats struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	SegStats             []*SegmentStats   `protobuf:"bytes,2,rep,name=seg_stats,json=segStats,proto3" json:"seg_stats,omitempty"`
-	FieldStats           []*FieldStats     `protobuf:"bytes,3,rep,name=field_stats,json=fieldStats,proto3" json:"field_stats,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base       *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SegStats   []*SegmentStats   `protobuf:"bytes,2,rep,name=seg_stats,json=segStats,proto3" json:"seg_stats,omitempty"`
+	FieldStats []*FieldStats     `protobuf:"bytes,3,rep,name=field_stats,json=fieldStats,proto3" json:"field_stats,omitempty"`
+	// tsafe of the dm channels watched by the query node
+	ChannelNames         []string `protobuf:"bytes,4,rep,name=channelNames,proto3" json:"channelNames,omitempty"`
+	Tsafes               []uint64 `protobuf:"varint,5,rep,packed,name=tsafes,proto3" json:"tsafes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *QueryNodeStats) Reset()         { *m = QueryNodeStats{} }
 func (m *QueryNodeStats) String() string { return proto.CompactTextString(m) }
 func (*QueryNodeStats) ProtoMessage()    {}
 func (*QueryNodeStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{28}
+	return fileDescriptor_41f4a519b878ee3b, []int{29}
 }
 
 func (m *QueryNodeStats) XXX_Unmarshal(b []byte) error {
@@ -2056,6 +2164,20 @@ func (m *QueryNodeStats) GetFieldStats() []*FieldStats {
 	return nil
 }
 
+func (m *QueryNodeStats) GetChannelNames() []string {
+	if m != nil {
+		return m.ChannelNames
+	}
+	return nil
+}
+
+func (m *QueryNodeStats) GetTsafes() []uint64 {
+	if m != nil {
+		return m.Tsafes
+	}
+	return nil
+}
+
 type MsgPosition struct {
 	ChannelName          string   `protobuf:"bytes,1,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
 	MsgID                []byte   `protobuf:"bytes,2,opt,name=msgID,proto3" json:"msgID,omitempty"`
@@ -2070,7 +2192,7 @@ func (m *MsgPosition) Reset()         { *m = MsgPosition{} }
 func (m *MsgPosition) String() string { return proto.CompactTextString(m) }
 func (*MsgPosition) ProtoMessage()    {}
 func (*MsgPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{29}
+	return fileDescriptor_41f4a519b878ee3b, []int{30}
 }
 
 func (m *MsgPosition) XXX_Unmarshal(b []byte) error {
@@ -2133,7 +2255,7 @@ func (m *ChannelTimeTickMsg) Reset()         { *m = ChannelTimeTickMsg{} }
 func (m *ChannelTimeTickMsg) String() string { return proto.CompactTextString(m) }
 func (*ChannelTimeTickMsg) ProtoMessage()    {}
 func (*ChannelTimeTickMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{30}
+	return fileDescriptor_41f4a519b878ee3b, []int{31}
 }
 
 func (m *ChannelTimeTickMsg) XXX_Unmarshal(b []byte) error {
@@ -2203,6 +2325,7 @@ func init() {
 	proto.RegisterType((*SearchRequest)(nil), "milvus.proto.internal.SearchRequest")
 	proto.RegisterType((*SearchResults)(nil), "milvus.proto.internal.SearchResults")
 	proto.RegisterType((*RetrieveRequest)(nil), "milvus.proto.internal.RetrieveRequest")
+	proto.RegisterType((*OrderByField)(nil), "milvus.proto.internal.OrderByField")
 	proto.RegisterType((*RetrieveResults)(nil), "milvus.proto.internal.RetrieveResults")
 	proto.RegisterType((*DeleteRequest)(nil), "milvus.proto.internal.DeleteRequest")
 	proto.RegisterType((*LoadBalanceSegmentsRequest)(nil), "milvus.proto.internal.LoadBalanceSegmentsRequest")
@@ -2220,128 +2343,134 @@ func init() {
 func init() { proto.RegisterFile("internal.proto", fileDescriptor_41f4a519b878ee3b) }
 
 var fileDescriptor_41f4a519b878ee3b = []byte{
-	// 1959 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x5b, 0x6f, 0x24, 0x47,
-	0x15, 0xa6, 0xa7, 0xc7, 0x9e, 0x99, 0x33, 0x63, 0x7b, 0xb6, 0xec, 0x6c, 0xda, 0xde, 0xcd, 0xae,
-	0xd3, 0x09, 0x60, 0xb2, 0x62, 0xbd, 0x38, 0x40, 0x22, 0x84, 0xd8, 0xc4, 0x9e, 0xb0, 0x8c, 0x36,
-	0x36, 0xa6, 0xbd, 0x89, 0x04, 0x2f, 0xad, 0x9a, 0xee, 0xf2, 0xb8, 0xd9, 0xbe, 0xa5, 0xab, 0xda,
-	0xbb, 0x93, 0x27, 0x1e, 0x78, 0x02, 0x81, 0x04, 0x12, 0x7f, 0x83, 0x57, 0x9e, 0xb8, 0x88, 0x27,
-	0x24, 0x7e, 0x01, 0xff, 0x04, 0x21, 0x1e, 0x50, 0x9d, 0xaa, 0xbe, 0xcc, 0x78, 0x6c, 0x66, 0xbd,
-	0x02, 0x82, 0xe0, 0x6d, 0xea, 0x3b, 0xa7, 0x2e, 0xe7, 0x3b, 0x97, 0x3a, 0x5d, 0x03, 0xab, 0x41,
-	0x2c, 0x58, 0x16, 0xd3, 0xf0, 0x7e, 0x9a, 0x25, 0x22, 0x21, 0xaf, 0x44, 0x41, 0x78, 0x9e, 0x73,
-	0x35, 0xba, 0x5f, 0x08, 0xb7, 0x7a, 0x5e, 0x12, 0x45, 0x49, 0xac, 0xe0, 0xad, 0x1e, 0xf7, 0xce,
-	0x58, 0x44, 0xd5, 0xc8, 0xfe, 0x9d, 0x01, 0x2b, 0x07, 0x49, 0x94, 0x26, 0x31, 0x8b, 0xc5, 0x30,
-	0x3e, 0x4d, 0xc8, 0x4d, 0x58, 0x8e, 0x13, 0x9f, 0x0d, 0x07, 0x96, 0xb1, 0x6d, 0xec, 0x98, 0x8e,
-	0x1e, 0x11, 0x02, 0xcd, 0x2c, 0x09, 0x99, 0xd5, 0xd8, 0x36, 0x76, 0x3a, 0x0e, 0xfe, 0x26, 0x0f,
-	0x01, 0xb8, 0xa0, 0x82, 0xb9, 0x5e, 0xe2, 0x33, 0xcb, 0xdc, 0x36, 0x76, 0x56, 0xf7, 0xb6, 0xef,
-	0xcf, 0x3d, 0xc5, 0xfd, 0x13, 0xa9, 0x78, 0x90, 0xf8, 0xcc, 0xe9, 0xf0, 0xe2, 0x27, 0x79, 0x0f,
-	0x80, 0x3d, 0x17, 0x19, 0x75, 0x83, 0xf8, 0x34, 0xb1, 0x9a, 0xdb, 0xe6, 0x4e, 0x77, 0xef, 0xf5,
-	0xe9, 0x05, 0xf4, 0xe1, 0x1f, 0xb3, 0xc9, 0xc7, 0x34, 0xcc, 0xd9, 0x31, 0x0d, 0x32, 0xa7, 0x83,
-	0x93, 0xe4, 0x71, 0xed, 0xbf, 0x18, 0xb0, 0x56, 0x1a, 0x80, 0x7b, 0x70, 0xf2, 0x0d, 0x58, 0xc2,
-	0x2d, 0xd0, 0x82, 0xee, 0xde, 0x9b, 0x97, 0x9c, 0x68, 0xca, 0x6e, 0x47, 0x4d, 0x21, 0x1f, 0xc1,
-	0x3a, 0xcf, 0x47, 0x5e, 0x21, 0x72, 0x11, 0xe5, 0x56, 0x03, 0x8f, 0xb6, 0xd8, 0x4a, 0xa4, 0xbe,
-	0x80, 0x3e, 0xd2, 0xdb, 0xb0, 0x2c, 0x57, 0xca, 0x39, 0xb2, 0xd4, 0xdd, 0xbb, 0x35, 0xd7, 0xc8,
-	0x13, 0x54, 0x71, 0xb4, 0xaa, 0x7d, 0x0b, 0x36, 0x1f, 0x31, 0x31, 0x63, 0x9d, 0xc3, 0x3e, 0xc9,
-	0x19, 0x17, 0x5a, 0xf8, 0x24, 0x88, 0xd8, 0x93, 0xc0, 0x7b, 0x7a, 0x70, 0x46, 0xe3, 0x98, 0x85,
-	0x85, 0xf0, 0x35, 0xb8, 0xf5, 0x88, 0xe1, 0x84, 0x80, 0x8b, 0xc0, 0xe3, 0x33, 0xe2, 0x57, 0x60,
-	0xfd, 0x11, 0x13, 0x03, 0x7f, 0x06, 0xfe, 0x18, 0xda, 0x47, 0xd2, 0xd9, 0x32, 0x0c, 0xbe, 0x0e,
-	0x2d, 0xea, 0xfb, 0x19, 0xe3, 0x5c, 0xb3, 0x78, 0x7b, 0xee, 0x89, 0xdf, 0x57, 0x3a, 0x4e, 0xa1,
-	0x3c, 0x2f, 0x4c, 0xec, 0x1f, 0x02, 0x0c, 0xe3, 0x40, 0x1c, 0xd3, 0x8c, 0x46, 0xfc, 0xd2, 0x00,
-	0x1b, 0x40, 0x8f, 0x0b, 0x9a, 0x09, 0x37, 0x45, 0x3d, 0x4d, 0xf9, 0x02, 0xd1, 0xd0, 0xc5, 0x69,
-	0x6a, 0x75, 0xfb, 0xfb, 0x00, 0x27, 0x22, 0x0b, 0xe2, 0xf1, 0x87, 0x01, 0x17, 0x72, 0xaf, 0x73,
-	0xa9, 0x27, 0x8d, 0x30, 0x77, 0x3a, 0x8e, 0x1e, 0xd5, 0xdc, 0xd1, 0x58, 0xdc, 0x1d, 0x0f, 0xa1,
-	0x5b, 0xd0, 0x7d, 0xc8, 0xc7, 0xe4, 0x01, 0x34, 0x47, 0x94, 0xb3, 0x2b, 0xe9, 0x39, 0xe4, 0xe3,
-	0x7d, 0xca, 0x99, 0x83, 0x9a, 0xf6, 0x4f, 0x4c, 0x78, 0xf5, 0x20, 0x63, 0x18, 0xfc, 0x61, 0xc8,
-	0x3c, 0x11, 0x24, 0xb1, 0xe6, 0xfe, 0xc5, 0x57, 0x23, 0xaf, 0x42, 0xcb, 0x1f, 0xb9, 0x31, 0x8d,
-	0x0a, 0xb2, 0x97, 0xfd, 0xd1, 0x11, 0x8d, 0x18, 0xf9, 0x02, 0xac, 0x7a, 0xe5, 0xfa, 0x12, 0xc1,
-	0x98, 0xeb, 0x38, 0x33, 0x28, 0x79, 0x13, 0x56, 0x52, 0x9a, 0x89, 0xa0, 0x54, 0x6b, 0xa2, 0xda,
-	0x34, 0x28, 0x1d, 0xea, 0x8f, 0x86, 0x03, 0x6b, 0x09, 0x9d, 0x85, 0xbf, 0x89, 0x0d, 0xbd, 0x6a,
-	0xad, 0xe1, 0xc0, 0x5a, 0x46, 0xd9, 0x14, 0x46, 0xb6, 0xa1, 0x5b, 0x2e, 0x34, 0x1c, 0x58, 0x2d,
-	0x54, 0xa9, 0x43, 0xd2, 0x39, 0xaa, 0x16, 0x59, 0xed, 0x6d, 0x63, 0xa7, 0xe7, 0xe8, 0x11, 0x79,
-	0x00, 0xeb, 0xe7, 0x41, 0x26, 0x72, 0x1a, 0xea, 0xf8, 0x94, 0xe7, 0xe0, 0x56, 0x07, 0x3d, 0x38,
-	0x4f, 0x44, 0xf6, 0x60, 0x23, 0x3d, 0x9b, 0xf0, 0xc0, 0x9b, 0x99, 0x02, 0x38, 0x65, 0xae, 0xcc,
-	0xfe, 0xa3, 0x01, 0xaf, 0x0c, 0xb2, 0x24, 0xfd, 0x4c, 0xb8, 0xa2, 0x20, 0xb9, 0x79, 0x05, 0xc9,
-	0x4b, 0x17, 0x49, 0xb6, 0x7f, 0xd6, 0x80, 0x9b, 0x2a, 0xa2, 0x8e, 0x0b, 0x62, 0xff, 0x05, 0x56,
-	0x7c, 0x11, 0xd6, 0xaa, 0x5d, 0x95, 0xc2, 0x7c, 0x33, 0x3e, 0x0f, 0xab, 0xa5, 0x83, 0x95, 0xde,
-	0xbf, 0x37, 0xa4, 0xec, 0x9f, 0x36, 0x60, 0x43, 0x3a, 0xf5, 0xff, 0x6c, 0x48, 0x36, 0x7e, 0xdf,
-	0x00, 0xa2, 0xa2, 0x63, 0x18, 0xfb, 0xec, 0xf9, 0x7f, 0x92, 0x8b, 0xd7, 0x00, 0x4e, 0x03, 0x16,
-	0xfa, 0x75, 0x1e, 0x3a, 0x88, 0xbc, 0x14, 0x07, 0x16, 0xb4, 0x70, 0x91, 0xd2, 0xfe, 0x62, 0x28,
-	0x6f, 0x13, 0xd5, 0x59, 0xe8, 0xdb, 0xa4, 0xbd, 0xf0, 0x6d, 0x82, 0xd3, 0xf4, 0x6d, 0xf2, 0x6b,
-	0x13, 0x56, 0x86, 0x31, 0x67, 0x99, 0xf8, 0x5f, 0x0e, 0x24, 0x72, 0x1b, 0x3a, 0x9c, 0x8d, 0x23,
-	0xd9, 0xe0, 0x0c, 0xb0, 0x58, 0x9b, 0x4e, 0x05, 0x48, 0xa9, 0xa7, 0x2a, 0xeb, 0x70, 0x60, 0x75,
-	0x94, 0x6b, 0x4b, 0x80, 0xdc, 0x01, 0x10, 0x41, 0xc4, 0xb8, 0xa0, 0x51, 0xaa, 0x2a, 0x72, 0xd3,
-	0xa9, 0x21, 0xf2, 0x16, 0xc8, 0x92, 0x67, 0xc3, 0x01, 0xb7, 0xba, 0xdb, 0xa6, 0x6c, 0x07, 0xd4,
-	0x88, 0x7c, 0x15, 0xda, 0x59, 0xf2, 0xcc, 0xf5, 0xa9, 0xa0, 0x56, 0x0f, 0x9d, 0xb7, 0x39, 0x97,
-	0xec, 0xfd, 0x30, 0x19, 0x39, 0xad, 0x2c, 0x79, 0x36, 0xa0, 0x82, 0xda, 0x7f, 0x35, 0x61, 0xe5,
-	0x84, 0xd1, 0xcc, 0x3b, 0xbb, 0xbe, 0xc3, 0xbe, 0x04, 0xfd, 0x8c, 0xf1, 0x3c, 0x14, 0x6e, 0x65,
-	0x96, 0xf2, 0xdc, 0x9a, 0xc2, 0x0f, 0x4a, 0xe3, 0x0a, 0xca, 0xcd, 0x2b, 0x28, 0x6f, 0xce, 0xa1,
-	0xdc, 0x86, 0x5e, 0x8d, 0x5f, 0x6e, 0x2d, 0xa1, 0xe9, 0x53, 0x18, 0xe9, 0x83, 0xe9, 0xf3, 0x10,
-	0x3d, 0xd6, 0x71, 0xe4, 0x4f, 0x72, 0x0f, 0x6e, 0xa4, 0x21, 0xf5, 0xd8, 0x59, 0x12, 0xfa, 0x2c,
-	0x73, 0xc7, 0x59, 0x92, 0xa7, 0xe8, 0xae, 0x9e, 0xd3, 0xaf, 0x09, 0x1e, 0x49, 0x9c, 0xbc, 0x03,
-	0x6d, 0x9f, 0x87, 0xae, 0x98, 0xa4, 0x0c, 0x5d, 0xb6, 0x7a, 0x89, 0xed, 0x03, 0x1e, 0x3e, 0x99,
-	0xa4, 0xcc, 0x69, 0xf9, 0xea, 0x07, 0x79, 0x00, 0x1b, 0x9c, 0x65, 0x01, 0x0d, 0x83, 0x4f, 0x99,
-	0xef, 0xb2, 0xe7, 0x69, 0xe6, 0xa6, 0x21, 0x8d, 0xd1, 0xb3, 0x3d, 0x87, 0x54, 0xb2, 0x0f, 0x9e,
-	0xa7, 0xd9, 0x71, 0x48, 0x63, 0xb2, 0x03, 0xfd, 0x24, 0x17, 0x69, 0x2e, 0x5c, 0xcc, 0x3e, 0xee,
-	0x06, 0x3e, 0x3a, 0xda, 0x74, 0x56, 0x15, 0xfe, 0x6d, 0x84, 0x87, 0xbe, 0xa4, 0x56, 0x64, 0xf4,
-	0x9c, 0x85, 0x6e, 0x19, 0x01, 0x56, 0x77, 0xdb, 0xd8, 0x69, 0x3a, 0x6b, 0x0a, 0x7f, 0x52, 0xc0,
-	0x64, 0x17, 0xd6, 0xc7, 0x39, 0xcd, 0x68, 0x2c, 0x18, 0xab, 0x69, 0xf7, 0x50, 0x9b, 0x94, 0xa2,
-	0x72, 0x82, 0xfd, 0x8b, 0x66, 0xe5, 0x7a, 0xe9, 0x25, 0x7e, 0x0d, 0xd7, 0x5f, 0xa7, 0x2f, 0x9c,
-	0x1b, 0x2f, 0xe6, 0xfc, 0x78, 0xb9, 0x0b, 0xdd, 0x88, 0x89, 0x2c, 0xf0, 0x94, 0x5f, 0x54, 0x1a,
-	0x83, 0x82, 0x90, 0xfc, 0xbb, 0xd0, 0x8d, 0xf3, 0xc8, 0xfd, 0x24, 0x67, 0x59, 0xc0, 0xb8, 0x4e,
-	0x65, 0x88, 0xf3, 0xe8, 0x7b, 0x0a, 0x21, 0xeb, 0xb0, 0x24, 0x92, 0xd4, 0x7d, 0xaa, 0x33, 0xb9,
-	0x29, 0x92, 0xf4, 0x31, 0xf9, 0x26, 0x6c, 0x71, 0x46, 0x43, 0xe6, 0xbb, 0x65, 0x56, 0x72, 0x97,
-	0x23, 0x17, 0xcc, 0xb7, 0x5a, 0xe8, 0x0a, 0x4b, 0x69, 0x9c, 0x94, 0x0a, 0x27, 0x5a, 0x2e, 0x99,
-	0x2e, 0x0f, 0x5e, 0x9b, 0xd6, 0xc6, 0xe6, 0x89, 0x54, 0xa2, 0x72, 0xc2, 0xbb, 0x60, 0x8d, 0xc3,
-	0x64, 0x44, 0x43, 0xf7, 0xc2, 0xae, 0xd8, 0xa5, 0x99, 0xce, 0x4d, 0x25, 0x3f, 0x99, 0xd9, 0x52,
-	0x9a, 0xc7, 0xc3, 0xc0, 0x63, 0xbe, 0x3b, 0x0a, 0x93, 0x91, 0x05, 0x18, 0x52, 0xa0, 0x20, 0x99,
-	0xc8, 0x32, 0x94, 0xb4, 0x82, 0xa4, 0xc1, 0x4b, 0xf2, 0x58, 0x60, 0x80, 0x98, 0xce, 0xaa, 0xc2,
-	0x8f, 0xf2, 0xe8, 0x40, 0xa2, 0xe4, 0x0d, 0x58, 0xd1, 0x9a, 0xc9, 0xe9, 0x29, 0x67, 0x02, 0x23,
-	0xc3, 0x74, 0x7a, 0x0a, 0xfc, 0x2e, 0x62, 0xf6, 0xdf, 0x1b, 0xb0, 0xe6, 0x48, 0x76, 0xd9, 0x39,
-	0xfb, 0xaf, 0x2f, 0x08, 0x6f, 0x81, 0x19, 0xf8, 0x1c, 0x1d, 0xdf, 0xdd, 0xb3, 0xa6, 0xcf, 0xad,
-	0x3f, 0xea, 0x87, 0x03, 0xee, 0x48, 0xa5, 0xb9, 0x29, 0xd9, 0x5a, 0x38, 0x25, 0xdb, 0x2f, 0x94,
-	0x92, 0x9d, 0x4b, 0x53, 0xf2, 0xb7, 0x66, 0x9d, 0xfe, 0xcf, 0x6a, 0x52, 0x6a, 0x5e, 0x9b, 0x8b,
-	0xf0, 0xfa, 0x10, 0xba, 0x9a, 0x50, 0xbc, 0x98, 0x96, 0xf0, 0x62, 0xba, 0x33, 0x77, 0x0e, 0x32,
-	0x2c, 0x2f, 0x25, 0x47, 0xb5, 0x3e, 0x5c, 0xfe, 0x26, 0xdf, 0x82, 0x5b, 0x17, 0x53, 0x35, 0xd3,
-	0x1c, 0xf9, 0xd6, 0x32, 0xfa, 0x68, 0x73, 0x36, 0x57, 0x0b, 0x12, 0x7d, 0xf2, 0x15, 0xd8, 0xa8,
-	0x25, 0x6b, 0x35, 0xb1, 0xa5, 0xbe, 0x8e, 0x2a, 0x59, 0x35, 0xe5, 0xaa, 0x74, 0x6d, 0x5f, 0x95,
-	0xae, 0xf6, 0x9f, 0x0d, 0x58, 0x19, 0xb0, 0x90, 0x89, 0x97, 0x48, 0x9e, 0x39, 0x5d, 0x4e, 0x63,
-	0x6e, 0x97, 0x33, 0xd5, 0x46, 0x98, 0x57, 0xb7, 0x11, 0xcd, 0x0b, 0x6d, 0xc4, 0xeb, 0xd0, 0x4b,
-	0xb3, 0x20, 0xa2, 0xd9, 0xc4, 0x7d, 0xca, 0x26, 0x45, 0x02, 0x75, 0x35, 0xf6, 0x98, 0x4d, 0xb8,
-	0x1d, 0xc3, 0xd6, 0x87, 0x09, 0xf5, 0xf7, 0x69, 0x48, 0x63, 0x8f, 0x69, 0x33, 0xf9, 0xf5, 0x2d,
-	0xbb, 0x03, 0x50, 0x63, 0xb2, 0x81, 0x1b, 0xd6, 0x10, 0xfb, 0x6f, 0x06, 0x74, 0xe4, 0x86, 0xd8,
-	0x7c, 0x5f, 0x63, 0xfd, 0xa9, 0xae, 0xab, 0x31, 0xa7, 0xeb, 0x2a, 0xfb, 0xe7, 0x82, 0xae, 0xaa,
-	0xa1, 0xae, 0x35, 0xc6, 0xcd, 0xe9, 0xc6, 0xf8, 0x2e, 0x74, 0x03, 0x79, 0x20, 0x37, 0xa5, 0xe2,
-	0x4c, 0xf1, 0xd4, 0x71, 0x00, 0xa1, 0x63, 0x89, 0xc8, 0xce, 0xb9, 0x50, 0xc0, 0xce, 0x79, 0x79,
-	0xe1, 0xce, 0x59, 0x2f, 0x82, 0x9d, 0xf3, 0x1f, 0x1a, 0x60, 0x69, 0x8a, 0xab, 0x67, 0xa8, 0x8f,
-	0x52, 0x1f, 0x5f, 0xc3, 0x6e, 0x43, 0xa7, 0x8c, 0x32, 0xfd, 0x0a, 0x54, 0x01, 0x92, 0xd7, 0x43,
-	0x16, 0x25, 0xd9, 0xe4, 0x24, 0xf8, 0x94, 0x69, 0xc3, 0x6b, 0x88, 0xb4, 0xed, 0x28, 0x8f, 0x9c,
-	0xe4, 0x19, 0xd7, 0x65, 0xb6, 0x18, 0x4a, 0xdb, 0x3c, 0xfc, 0xde, 0xc1, 0xea, 0x84, 0x96, 0x37,
-	0x1d, 0x50, 0x90, 0xac, 0x4a, 0x64, 0x13, 0xda, 0x2c, 0xf6, 0x95, 0x74, 0x09, 0xa5, 0x2d, 0x16,
-	0xfb, 0x28, 0x1a, 0xc2, 0xaa, 0x7e, 0x7e, 0x4a, 0x38, 0x96, 0x5c, 0x5d, 0x68, 0xed, 0x4b, 0xde,
-	0xfc, 0x0e, 0xf9, 0xf8, 0x58, 0x6b, 0x3a, 0x2b, 0xea, 0x05, 0x4a, 0x0f, 0xc9, 0x07, 0xd0, 0x93,
-	0xbb, 0x94, 0x0b, 0xb5, 0x16, 0x5e, 0xa8, 0xcb, 0x62, 0xbf, 0x18, 0xd8, 0xbf, 0x34, 0xe0, 0xc6,
-	0x05, 0x0a, 0xaf, 0x11, 0x47, 0x8f, 0xa1, 0x7d, 0xc2, 0xc6, 0x72, 0x89, 0xe2, 0x51, 0x6d, 0xf7,
-	0xb2, 0x37, 0xda, 0x4b, 0x1c, 0xe6, 0x94, 0x0b, 0xd8, 0x3f, 0x36, 0x00, 0x30, 0xa0, 0x71, 0x78,
-	0x21, 0x58, 0x8c, 0xeb, 0x04, 0x8b, 0x6c, 0x39, 0xe5, 0x75, 0x9f, 0xb1, 0x90, 0x8a, 0xaa, 0x3e,
-	0x71, 0xed, 0x7b, 0x12, 0xe7, 0x91, 0xa3, 0x44, 0x45, 0xd2, 0xda, 0x3f, 0x37, 0x00, 0xb0, 0xc0,
-	0xaa, 0x63, 0xcc, 0x5e, 0xb1, 0xc6, 0xd5, 0xdf, 0x8a, 0x8d, 0xe9, 0x94, 0xd8, 0x2f, 0x52, 0x82,
-	0x23, 0x47, 0xe6, 0x3c, 0x1b, 0x4a, 0x8e, 0x2a, 0xe3, 0x75, 0xd6, 0x28, 0x5e, 0x7e, 0x65, 0x40,
-	0xaf, 0x46, 0x1f, 0x9f, 0xce, 0x5e, 0x63, 0x36, 0x7b, 0xb1, 0x11, 0x94, 0x11, 0xed, 0xf2, 0x5a,
-	0x90, 0x47, 0x55, 0x90, 0x6f, 0x42, 0x1b, 0x29, 0xa9, 0x45, 0x79, 0xac, 0xa3, 0xfc, 0x1e, 0xdc,
-	0xc8, 0x98, 0xc7, 0x62, 0x11, 0x4e, 0xdc, 0x28, 0xf1, 0x83, 0xd3, 0x80, 0xf9, 0x18, 0xeb, 0x6d,
-	0xa7, 0x5f, 0x08, 0x0e, 0x35, 0x6e, 0xff, 0xc9, 0x80, 0x55, 0xd9, 0x3b, 0x4e, 0x8e, 0x12, 0x9f,
-	0xa9, 0x93, 0xbd, 0x78, 0x04, 0xbd, 0x87, 0xb6, 0x68, 0x7a, 0x54, 0x08, 0xbd, 0xf1, 0xcf, 0x43,
-	0x88, 0x3b, 0x6d, 0xae, 0xc3, 0x46, 0x52, 0xac, 0xbe, 0xff, 0x17, 0xa1, 0xb8, 0x72, 0xac, 0xbe,
-	0x3a, 0x15, 0xc5, 0x3f, 0x32, 0xa0, 0x5b, 0x4b, 0x16, 0x59, 0xf2, 0xf5, 0xfd, 0xa0, 0xae, 0x15,
-	0x03, 0x8b, 0x60, 0xd7, 0xab, 0x5e, 0xf9, 0xc8, 0x06, 0x2c, 0x45, 0x7c, 0xac, 0x3d, 0xde, 0x73,
-	0xd4, 0x80, 0x6c, 0x41, 0x3b, 0xe2, 0x63, 0xfc, 0x4c, 0xd2, 0x95, 0xb3, 0x1c, 0x4b, 0xb7, 0x55,
-	0x9d, 0x8d, 0x2a, 0x20, 0x15, 0x60, 0xff, 0xc6, 0x00, 0xa2, 0x1b, 0x87, 0x97, 0x7a, 0x0a, 0xc6,
-	0x80, 0xad, 0xbf, 0x54, 0x36, 0xb0, 0x0c, 0x4f, 0x61, 0x33, 0x57, 0x9e, 0x79, 0xe1, 0xca, 0xbb,
-	0x07, 0x37, 0x7c, 0x76, 0x4a, 0x65, 0x8f, 0x33, 0x7b, 0xe4, 0xbe, 0x16, 0x94, 0xad, 0xd8, 0x5b,
-	0xef, 0x42, 0xa7, 0xfc, 0x07, 0x86, 0xf4, 0xa1, 0x37, 0x8c, 0x03, 0x81, 0xdf, 0x71, 0x41, 0x3c,
-	0xee, 0x7f, 0x8e, 0x74, 0xa1, 0xf5, 0x1d, 0x46, 0x43, 0x71, 0x36, 0xe9, 0x1b, 0xa4, 0x07, 0xed,
-	0xf7, 0x47, 0x71, 0x92, 0x45, 0x34, 0xec, 0x37, 0xf6, 0xdf, 0xf9, 0xc1, 0xd7, 0xc6, 0x81, 0x38,
-	0xcb, 0x47, 0xd2, 0x92, 0x5d, 0x65, 0xda, 0x97, 0x83, 0x44, 0xff, 0xda, 0x2d, 0xbc, 0xb6, 0x8b,
-	0xd6, 0x96, 0xc3, 0x74, 0x34, 0x5a, 0x46, 0xe4, 0xed, 0x7f, 0x04, 0x00, 0x00, 0xff, 0xff, 0x1b,
-	0x58, 0x94, 0x0b, 0xa7, 0x1a, 0x00, 0x00,
+	// 2064 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x93, 0x1b, 0x47,
+	0x15, 0x67, 0x34, 0xda, 0x95, 0xf4, 0xa4, 0x5d, 0xcb, 0xed, 0x3f, 0x19, 0xaf, 0x1d, 0x5b, 0x19,
+	0x07, 0x58, 0xe2, 0xc2, 0x6b, 0x36, 0x40, 0x52, 0x14, 0x15, 0x27, 0xbb, 0x0a, 0x8e, 0xca, 0x59,
+	0x67, 0xe9, 0x75, 0x52, 0x05, 0x97, 0xa9, 0xd6, 0x4c, 0xaf, 0x76, 0xf0, 0xfc, 0xcb, 0x74, 0x6b,
+	0xbd, 0xca, 0x89, 0x43, 0x4e, 0x50, 0x70, 0xa0, 0x0a, 0x3e, 0x06, 0x1c, 0xb9, 0x01, 0xc5, 0xb7,
+	0xa0, 0xf8, 0x10, 0x70, 0xe6, 0x44, 0xf5, 0xeb, 0x9e, 0xd1, 0x48, 0xab, 0xdd, 0xc8, 0xeb, 0x02,
+	0x42, 0xc1, 0x4d, 0xfd, 0xde, 0xeb, 0x9e, 0x7e, 0xbf, 0xf7, 0x7b, 0xaf, 0x5f, 0xb7, 0x60, 0x3d,
+	0x4c, 0x24, 0xcf, 0x13, 0x16, 0xdd, 0xcf, 0xf2, 0x54, 0xa6, 0xe4, 0x5a, 0x1c, 0x46, 0xc7, 0x63,
+	0xa1, 0x47, 0xf7, 0x0b, 0xe5, 0x46, 0xc7, 0x4f, 0xe3, 0x38, 0x4d, 0xb4, 0x78, 0xa3, 0x23, 0xfc,
+	0x23, 0x1e, 0x33, 0x3d, 0x72, 0xff, 0x60, 0xc1, 0xda, 0x6e, 0x1a, 0x67, 0x69, 0xc2, 0x13, 0x39,
+	0x48, 0x0e, 0x53, 0x72, 0x1d, 0x56, 0x93, 0x34, 0xe0, 0x83, 0xbe, 0x63, 0xf5, 0xac, 0x4d, 0x9b,
+	0x9a, 0x11, 0x21, 0x50, 0xcf, 0xd3, 0x88, 0x3b, 0xb5, 0x9e, 0xb5, 0xd9, 0xa2, 0xf8, 0x9b, 0x3c,
+	0x04, 0x10, 0x92, 0x49, 0xee, 0xf9, 0x69, 0xc0, 0x1d, 0xbb, 0x67, 0x6d, 0xae, 0x6f, 0xf7, 0xee,
+	0x2f, 0xdc, 0xc5, 0xfd, 0x03, 0x65, 0xb8, 0x9b, 0x06, 0x9c, 0xb6, 0x44, 0xf1, 0x93, 0xbc, 0x0b,
+	0xc0, 0x4f, 0x64, 0xce, 0xbc, 0x30, 0x39, 0x4c, 0x9d, 0x7a, 0xcf, 0xde, 0x6c, 0x6f, 0xbf, 0x36,
+	0xbb, 0x80, 0xd9, 0xfc, 0x63, 0x3e, 0xf9, 0x84, 0x45, 0x63, 0xbe, 0xcf, 0xc2, 0x9c, 0xb6, 0x70,
+	0x92, 0xda, 0xae, 0xfb, 0x17, 0x0b, 0x2e, 0x95, 0x0e, 0xe0, 0x37, 0x04, 0xf9, 0x1e, 0xac, 0xe0,
+	0x27, 0xd0, 0x83, 0xf6, 0xf6, 0xeb, 0x67, 0xec, 0x68, 0xc6, 0x6f, 0xaa, 0xa7, 0x90, 0x8f, 0xe1,
+	0x8a, 0x18, 0x0f, 0xfd, 0x42, 0xe5, 0xa1, 0x54, 0x38, 0xb5, 0x9e, 0xbd, 0xf4, 0x4a, 0xa4, 0xba,
+	0x80, 0xd9, 0xd2, 0x9b, 0xb0, 0xaa, 0x56, 0x1a, 0x0b, 0x44, 0xa9, 0xbd, 0x7d, 0x73, 0xa1, 0x93,
+	0x07, 0x68, 0x42, 0x8d, 0xa9, 0x7b, 0x13, 0x6e, 0x3c, 0xe2, 0x72, 0xce, 0x3b, 0xca, 0x3f, 0x1d,
+	0x73, 0x21, 0x8d, 0xf2, 0x69, 0x18, 0xf3, 0xa7, 0xa1, 0xff, 0x6c, 0xf7, 0x88, 0x25, 0x09, 0x8f,
+	0x0a, 0xe5, 0xab, 0x70, 0xf3, 0x11, 0xc7, 0x09, 0xa1, 0x90, 0xa1, 0x2f, 0xe6, 0xd4, 0xd7, 0xe0,
+	0xca, 0x23, 0x2e, 0xfb, 0xc1, 0x9c, 0xf8, 0x13, 0x68, 0x3e, 0x51, 0xc1, 0x56, 0x34, 0xf8, 0x2e,
+	0x34, 0x58, 0x10, 0xe4, 0x5c, 0x08, 0x83, 0xe2, 0xad, 0x85, 0x3b, 0x7e, 0x4f, 0xdb, 0xd0, 0xc2,
+	0x78, 0x11, 0x4d, 0xdc, 0x9f, 0x00, 0x0c, 0x92, 0x50, 0xee, 0xb3, 0x9c, 0xc5, 0xe2, 0x4c, 0x82,
+	0xf5, 0xa1, 0x23, 0x24, 0xcb, 0xa5, 0x97, 0xa1, 0x9d, 0x53, 0x5b, 0x96, 0x0d, 0x6d, 0x9c, 0xa6,
+	0x57, 0x77, 0x7f, 0x04, 0x70, 0x20, 0xf3, 0x30, 0x19, 0x7d, 0x18, 0x0a, 0xa9, 0xbe, 0x75, 0xac,
+	0xec, 0x94, 0x13, 0xf6, 0x66, 0x8b, 0x9a, 0x51, 0x25, 0x1c, 0xb5, 0xe5, 0xc3, 0xf1, 0x10, 0xda,
+	0x05, 0xdc, 0x7b, 0x62, 0x44, 0x1e, 0x40, 0x7d, 0xc8, 0x04, 0x3f, 0x17, 0x9e, 0x3d, 0x31, 0xda,
+	0x61, 0x82, 0x53, 0xb4, 0x74, 0x7f, 0x66, 0xc3, 0x2b, 0xbb, 0x39, 0x47, 0xf2, 0x47, 0x11, 0xf7,
+	0x65, 0x98, 0x26, 0x06, 0xfb, 0x17, 0x5f, 0x8d, 0xbc, 0x02, 0x8d, 0x60, 0xe8, 0x25, 0x2c, 0x2e,
+	0xc0, 0x5e, 0x0d, 0x86, 0x4f, 0x58, 0xcc, 0xc9, 0xd7, 0x60, 0xdd, 0x2f, 0xd7, 0x57, 0x12, 0xe4,
+	0x5c, 0x8b, 0xce, 0x49, 0xc9, 0xeb, 0xb0, 0x96, 0xb1, 0x5c, 0x86, 0xa5, 0x59, 0x1d, 0xcd, 0x66,
+	0x85, 0x2a, 0xa0, 0xc1, 0x70, 0xd0, 0x77, 0x56, 0x30, 0x58, 0xf8, 0x9b, 0xb8, 0xd0, 0x99, 0xae,
+	0x35, 0xe8, 0x3b, 0xab, 0xa8, 0x9b, 0x91, 0x91, 0x1e, 0xb4, 0xcb, 0x85, 0x06, 0x7d, 0xa7, 0x81,
+	0x26, 0x55, 0x91, 0x0a, 0x8e, 0xae, 0x45, 0x4e, 0xb3, 0x67, 0x6d, 0x76, 0xa8, 0x19, 0x91, 0x07,
+	0x70, 0xe5, 0x38, 0xcc, 0xe5, 0x98, 0x45, 0x86, 0x9f, 0x6a, 0x1f, 0xc2, 0x69, 0x61, 0x04, 0x17,
+	0xa9, 0xc8, 0x36, 0x5c, 0xcd, 0x8e, 0x26, 0x22, 0xf4, 0xe7, 0xa6, 0x00, 0x4e, 0x59, 0xa8, 0x73,
+	0xff, 0x6c, 0xc1, 0xb5, 0x7e, 0x9e, 0x66, 0x5f, 0x8a, 0x50, 0x14, 0x20, 0xd7, 0xcf, 0x01, 0x79,
+	0xe5, 0x34, 0xc8, 0xee, 0x2f, 0x6a, 0x70, 0x5d, 0x33, 0x6a, 0xbf, 0x00, 0xf6, 0x5f, 0xe0, 0xc5,
+	0xd7, 0xe1, 0xd2, 0xf4, 0xab, 0x5e, 0x72, 0xb6, 0x1b, 0x5f, 0x85, 0xf5, 0x32, 0xc0, 0xda, 0xee,
+	0xdf, 0x4b, 0x29, 0xf7, 0xe7, 0x35, 0xb8, 0xaa, 0x82, 0xfa, 0x7f, 0x34, 0x14, 0x1a, 0x7f, 0xac,
+	0x01, 0xd1, 0xec, 0x18, 0x24, 0x01, 0x3f, 0xf9, 0x4f, 0x62, 0xf1, 0x2a, 0xc0, 0x61, 0xc8, 0xa3,
+	0xa0, 0x8a, 0x43, 0x0b, 0x25, 0x2f, 0x85, 0x81, 0x03, 0x0d, 0x5c, 0xa4, 0xf4, 0xbf, 0x18, 0xaa,
+	0xd3, 0x44, 0x77, 0x16, 0xe6, 0x34, 0x69, 0x2e, 0x7d, 0x9a, 0xe0, 0x34, 0x73, 0x9a, 0xfc, 0xd6,
+	0x86, 0xb5, 0x41, 0x22, 0x78, 0x2e, 0xff, 0x97, 0x89, 0x44, 0x6e, 0x41, 0x4b, 0xf0, 0x51, 0xac,
+	0x1a, 0x9c, 0x3e, 0x16, 0x6b, 0x9b, 0x4e, 0x05, 0x4a, 0xeb, 0xeb, 0xca, 0x3a, 0xe8, 0x3b, 0x2d,
+	0x1d, 0xda, 0x52, 0x40, 0x6e, 0x03, 0xc8, 0x30, 0xe6, 0x42, 0xb2, 0x38, 0xd3, 0x15, 0xb9, 0x4e,
+	0x2b, 0x12, 0x75, 0x0a, 0xe4, 0xe9, 0xf3, 0x41, 0x5f, 0x38, 0xed, 0x9e, 0xad, 0xda, 0x01, 0x3d,
+	0x22, 0xdf, 0x86, 0x66, 0x9e, 0x3e, 0xf7, 0x02, 0x26, 0x99, 0xd3, 0xc1, 0xe0, 0xdd, 0x58, 0x08,
+	0xf6, 0x4e, 0x94, 0x0e, 0x69, 0x23, 0x4f, 0x9f, 0xf7, 0x99, 0x64, 0xee, 0x6f, 0xea, 0xb0, 0x76,
+	0xc0, 0x59, 0xee, 0x1f, 0x5d, 0x3c, 0x60, 0xdf, 0x80, 0x6e, 0xce, 0xc5, 0x38, 0x92, 0xde, 0xd4,
+	0x2d, 0x1d, 0xb9, 0x4b, 0x5a, 0xbe, 0x5b, 0x3a, 0x57, 0x40, 0x6e, 0x9f, 0x03, 0x79, 0x7d, 0x01,
+	0xe4, 0x2e, 0x74, 0x2a, 0xf8, 0x0a, 0x67, 0x05, 0x5d, 0x9f, 0x91, 0x91, 0x2e, 0xd8, 0x81, 0x88,
+	0x30, 0x62, 0x2d, 0xaa, 0x7e, 0x92, 0x7b, 0x70, 0x39, 0x8b, 0x98, 0xcf, 0x8f, 0xd2, 0x28, 0xe0,
+	0xb9, 0x37, 0xca, 0xd3, 0x71, 0x86, 0xe1, 0xea, 0xd0, 0x6e, 0x45, 0xf1, 0x48, 0xc9, 0xc9, 0x5b,
+	0xd0, 0x0c, 0x44, 0xe4, 0xc9, 0x49, 0xc6, 0x31, 0x64, 0xeb, 0x67, 0xf8, 0xde, 0x17, 0xd1, 0xd3,
+	0x49, 0xc6, 0x69, 0x23, 0xd0, 0x3f, 0xc8, 0x03, 0xb8, 0x2a, 0x78, 0x1e, 0xb2, 0x28, 0xfc, 0x8c,
+	0x07, 0x1e, 0x3f, 0xc9, 0x72, 0x2f, 0x8b, 0x58, 0x82, 0x91, 0xed, 0x50, 0x32, 0xd5, 0xbd, 0x7f,
+	0x92, 0xe5, 0xfb, 0x11, 0x4b, 0xc8, 0x26, 0x74, 0xd3, 0xb1, 0xcc, 0xc6, 0xd2, 0xc3, 0xec, 0x13,
+	0x5e, 0x18, 0x60, 0xa0, 0x6d, 0xba, 0xae, 0xe5, 0x3f, 0x40, 0xf1, 0x20, 0x50, 0xd0, 0xca, 0x9c,
+	0x1d, 0xf3, 0xc8, 0x2b, 0x19, 0xe0, 0xb4, 0x7b, 0xd6, 0x66, 0x9d, 0x5e, 0xd2, 0xf2, 0xa7, 0x85,
+	0x98, 0x6c, 0xc1, 0x95, 0xd1, 0x98, 0xe5, 0x2c, 0x91, 0x9c, 0x57, 0xac, 0x3b, 0x68, 0x4d, 0x4a,
+	0xd5, 0x74, 0xc2, 0x2d, 0x68, 0xe5, 0x3c, 0x8b, 0x42, 0x9f, 0x0d, 0xfa, 0xce, 0x9a, 0x26, 0x69,
+	0x29, 0x70, 0x7f, 0x57, 0x21, 0x86, 0x8a, 0xa1, 0xb8, 0x00, 0x31, 0x2e, 0xd2, 0x35, 0x2e, 0x64,
+	0x93, 0xbd, 0x98, 0x4d, 0x77, 0xa0, 0x1d, 0x73, 0x99, 0x87, 0xbe, 0x8e, 0x9a, 0x4e, 0x72, 0xd0,
+	0x22, 0x0c, 0xcd, 0x1d, 0x68, 0x27, 0xe3, 0xd8, 0xfb, 0x74, 0xcc, 0xf3, 0x90, 0x0b, 0x93, 0xe8,
+	0x90, 0x8c, 0xe3, 0x1f, 0x6a, 0x09, 0xb9, 0x02, 0x2b, 0x32, 0xcd, 0xbc, 0x67, 0x26, 0xcf, 0xeb,
+	0x32, 0xcd, 0x1e, 0x93, 0xef, 0xc3, 0x86, 0xe0, 0x2c, 0xe2, 0x81, 0x57, 0xe6, 0xac, 0xf0, 0x04,
+	0x62, 0xc1, 0x03, 0xa7, 0x81, 0x81, 0x72, 0xb4, 0xc5, 0x41, 0x69, 0x70, 0x60, 0xf4, 0x2a, 0x0e,
+	0xe5, 0xc6, 0x2b, 0xd3, 0x9a, 0xd8, 0x5a, 0x91, 0xa9, 0xaa, 0x9c, 0xf0, 0x36, 0x38, 0xa3, 0x28,
+	0x1d, 0xb2, 0xc8, 0x3b, 0xf5, 0x55, 0xec, 0xe1, 0x6c, 0x7a, 0x5d, 0xeb, 0x0f, 0xe6, 0x3e, 0xa9,
+	0xdc, 0x13, 0x51, 0xe8, 0xf3, 0xc0, 0x1b, 0x46, 0xe9, 0xd0, 0x01, 0x24, 0x1c, 0x68, 0x91, 0x4a,
+	0x73, 0x45, 0x34, 0x63, 0xa0, 0x60, 0xf0, 0xd3, 0x71, 0x22, 0x91, 0x3e, 0x36, 0x5d, 0xd7, 0xf2,
+	0x27, 0xe3, 0x78, 0x57, 0x49, 0xc9, 0x5d, 0x58, 0x33, 0x96, 0xe9, 0xe1, 0xa1, 0xe0, 0x12, 0x79,
+	0x63, 0xd3, 0x8e, 0x16, 0x7e, 0x84, 0xb2, 0x2f, 0x60, 0xcc, 0xdf, 0x6d, 0xb8, 0x44, 0x15, 0xf6,
+	0xfc, 0x98, 0xff, 0xd7, 0x17, 0x93, 0x37, 0xc0, 0x0e, 0x03, 0x81, 0xb4, 0x68, 0x6f, 0x3b, 0xb3,
+	0xfb, 0x36, 0x0f, 0x02, 0x83, 0xbe, 0xa0, 0xca, 0x68, 0x61, 0x3a, 0x37, 0x96, 0x4e, 0xe7, 0xe6,
+	0x0b, 0xa5, 0x73, 0xeb, 0xcc, 0x74, 0x7e, 0x07, 0x9a, 0x69, 0xae, 0xca, 0xdc, 0x70, 0x82, 0xc5,
+	0xa4, 0xbd, 0x7d, 0xf7, 0x8c, 0xdb, 0xf7, 0x47, 0xca, 0x6c, 0x67, 0x82, 0xbb, 0xa2, 0x8d, 0x54,
+	0x8f, 0xc8, 0x55, 0x58, 0x89, 0xc2, 0x38, 0x2c, 0x08, 0xa2, 0x07, 0xb3, 0x21, 0xef, 0xcc, 0x87,
+	0xfc, 0x03, 0xe8, 0x54, 0x17, 0xab, 0xb6, 0x17, 0xd6, 0x6c, 0x7b, 0x71, 0x1b, 0x20, 0xe0, 0xc2,
+	0xe7, 0x49, 0x10, 0x26, 0x23, 0x0c, 0x68, 0x93, 0x56, 0x24, 0xee, 0x5f, 0x67, 0xc8, 0xf3, 0x65,
+	0x2d, 0x38, 0x86, 0x15, 0xf5, 0x65, 0x58, 0xf1, 0x10, 0xda, 0x86, 0x0e, 0x78, 0x24, 0xaf, 0x60,
+	0x48, 0x6e, 0x2f, 0x9c, 0x83, 0xe0, 0xa9, 0xe3, 0x98, 0xea, 0xa6, 0x4f, 0xa8, 0xdf, 0xe4, 0x1d,
+	0xb8, 0x79, 0xba, 0x0c, 0xe5, 0x06, 0xa3, 0xc0, 0x59, 0x45, 0x86, 0xdd, 0x98, 0xaf, 0x43, 0x05,
+	0x88, 0x01, 0xf9, 0x16, 0x5c, 0xad, 0x14, 0xa2, 0xe9, 0xc4, 0x86, 0xbe, 0x17, 0x4e, 0x75, 0xd3,
+	0x29, 0xe7, 0x95, 0xa2, 0xe6, 0xb9, 0xa5, 0x68, 0x86, 0x27, 0xad, 0x79, 0x9e, 0xfc, 0xcd, 0x82,
+	0xb5, 0x3e, 0x8f, 0xb8, 0x7c, 0x89, 0xc2, 0xb0, 0xa0, 0xfb, 0xab, 0x2d, 0xec, 0xfe, 0x66, 0xda,
+	0x2b, 0xfb, 0xfc, 0xf6, 0xaa, 0x7e, 0xaa, 0xbd, 0x7a, 0x0d, 0x3a, 0x59, 0x1e, 0xc6, 0x2c, 0x9f,
+	0x78, 0xcf, 0xf8, 0xa4, 0x28, 0x0e, 0x6d, 0x23, 0x7b, 0xcc, 0x27, 0x62, 0x99, 0x1e, 0xd1, 0x4d,
+	0x60, 0xe3, 0xc3, 0x94, 0x05, 0x3b, 0x2c, 0x62, 0x89, 0xcf, 0x0d, 0x50, 0xe2, 0xe2, 0xde, 0xdf,
+	0x06, 0xa8, 0xc4, 0xa2, 0x86, 0x9b, 0xaa, 0x48, 0xdc, 0x7f, 0x58, 0xd0, 0x52, 0x1f, 0xc4, 0x8b,
+	0xcb, 0x05, 0xd6, 0x9f, 0xe9, 0x58, 0x6b, 0x0b, 0x3a, 0xd6, 0xf2, 0xee, 0x51, 0x40, 0x5a, 0x0a,
+	0xaa, 0x59, 0x5f, 0x9f, 0xcd, 0xfa, 0x3b, 0xd0, 0x0e, 0xd5, 0x86, 0xbc, 0x8c, 0xc9, 0x23, 0x8d,
+	0x65, 0x8b, 0x02, 0x8a, 0xf6, 0x95, 0x44, 0xdd, 0x3a, 0x0a, 0x03, 0xbc, 0x75, 0xac, 0x2e, 0x7d,
+	0xeb, 0x30, 0x8b, 0xe0, 0xad, 0xe3, 0x4f, 0x35, 0x70, 0x0c, 0xc4, 0xd3, 0x27, 0xbc, 0x8f, 0xb3,
+	0x00, 0x5f, 0x12, 0x6f, 0x41, 0xab, 0xe4, 0xa9, 0xa9, 0x4a, 0x53, 0x81, 0xc2, 0x75, 0x8f, 0xc7,
+	0x69, 0x3e, 0x39, 0x08, 0x3f, 0xe3, 0xc6, 0xf1, 0x8a, 0x44, 0xf9, 0xf6, 0x64, 0x1c, 0xd3, 0xf4,
+	0xb9, 0x30, 0xc7, 0x4c, 0x31, 0x54, 0xbe, 0xf9, 0x78, 0x57, 0xc4, 0xea, 0x8c, 0x9e, 0xd7, 0x29,
+	0x68, 0x91, 0xaa, 0xca, 0xe4, 0x06, 0x34, 0x79, 0x12, 0x68, 0xed, 0x0a, 0x6a, 0x1b, 0x3c, 0x09,
+	0x50, 0x35, 0x80, 0x75, 0xf3, 0x74, 0x97, 0x0a, 0x3c, 0x72, 0xcc, 0x41, 0xe3, 0x9e, 0x51, 0xb1,
+	0xf7, 0xc4, 0x68, 0xdf, 0x58, 0xd2, 0x35, 0xfd, 0x7a, 0x67, 0x86, 0xe4, 0x7d, 0xe8, 0xa8, 0xaf,
+	0x94, 0x0b, 0x35, 0x96, 0x5e, 0xa8, 0xcd, 0x93, 0xa0, 0x18, 0xb8, 0xbf, 0xb2, 0xe0, 0xf2, 0x29,
+	0x08, 0x2f, 0xc0, 0xa3, 0xc7, 0xd0, 0x3c, 0xe0, 0x23, 0xb5, 0x44, 0xf1, 0x20, 0xb9, 0x75, 0xd6,
+	0xfb, 0xf6, 0x19, 0x01, 0xa3, 0xe5, 0x02, 0xee, 0xe7, 0x96, 0x7a, 0x08, 0x0d, 0xf8, 0x09, 0x0e,
+	0x4f, 0x91, 0xc5, 0xba, 0x08, 0x59, 0x54, 0xbb, 0xae, 0x9a, 0xa1, 0x9c, 0x47, 0x4c, 0x4e, 0x2b,
+	0x9c, 0x30, 0xb1, 0x27, 0xc9, 0x38, 0xa6, 0x5a, 0x55, 0x24, 0xad, 0xfb, 0x4b, 0x0b, 0x00, 0x4b,
+	0xb4, 0xde, 0xc6, 0x7c, 0xfa, 0x5b, 0xe7, 0xdf, 0xb3, 0x6b, 0xb3, 0x29, 0xb1, 0x53, 0xa4, 0x84,
+	0x40, 0x8c, 0xec, 0x45, 0x3e, 0x94, 0x18, 0x4d, 0x9d, 0x37, 0x59, 0xa3, 0x71, 0xf9, 0xb5, 0x05,
+	0x9d, 0x0a, 0x7c, 0x62, 0x36, 0x7b, 0xad, 0xf9, 0xec, 0xc5, 0x36, 0x59, 0x31, 0xda, 0x13, 0x15,
+	0x92, 0xc7, 0x53, 0x92, 0xdf, 0x80, 0x26, 0x42, 0x52, 0x61, 0x79, 0x62, 0x58, 0x7e, 0x0f, 0x2e,
+	0xe7, 0xdc, 0xe7, 0x89, 0x8c, 0x26, 0x5e, 0x9c, 0x06, 0xe1, 0x61, 0xc8, 0x03, 0xe4, 0x7a, 0x93,
+	0x76, 0x0b, 0xc5, 0x9e, 0x91, 0xbb, 0x9f, 0xd7, 0x60, 0x5d, 0x75, 0xd6, 0x13, 0xf5, 0x2a, 0xae,
+	0x77, 0xf6, 0xe2, 0x0c, 0x7a, 0x17, 0x7d, 0xf1, 0x44, 0x85, 0x42, 0x77, 0xbf, 0x98, 0x42, 0x82,
+	0x36, 0x85, 0xa1, 0x8d, 0x82, 0x58, 0xbf, 0x9d, 0x2c, 0x03, 0xf1, 0x34, 0xb0, 0xe6, 0xf0, 0x9d,
+	0x06, 0xb9, 0xfa, 0x32, 0x5a, 0xc7, 0xd2, 0x35, 0x23, 0x53, 0x37, 0x71, 0x29, 0xd8, 0x21, 0xd7,
+	0x85, 0xad, 0x4e, 0xcd, 0xc8, 0xfd, 0xa9, 0x05, 0xed, 0x4a, 0xa2, 0xa9, 0x23, 0xc5, 0xcc, 0xd3,
+	0xc7, 0x96, 0x85, 0x05, 0xb4, 0x5d, 0x59, 0x4b, 0x35, 0x5f, 0xb1, 0x18, 0x19, 0xb6, 0x74, 0xa8,
+	0x1e, 0x90, 0x0d, 0x68, 0xc6, 0x62, 0x84, 0xd7, 0x53, 0x53, 0x75, 0xcb, 0xb1, 0x0a, 0xf9, 0xb4,
+	0x2b, 0xd4, 0xc5, 0x67, 0x2a, 0x70, 0x7f, 0x6f, 0x01, 0x31, 0x6d, 0xcb, 0x4b, 0x3d, 0xc1, 0x9f,
+	0xc2, 0xa1, 0xb6, 0x00, 0x87, 0xd9, 0x23, 0xd5, 0x3e, 0x75, 0xa4, 0xde, 0x83, 0xcb, 0x01, 0x3f,
+	0x64, 0xaa, 0xc3, 0x9a, 0xdf, 0x72, 0xd7, 0x28, 0xca, 0x36, 0xf6, 0x8d, 0xb7, 0xa1, 0x55, 0xfe,
+	0xf3, 0x45, 0xba, 0xd0, 0x51, 0x7f, 0x84, 0xe0, 0xfd, 0x39, 0x4c, 0x46, 0xdd, 0xaf, 0x90, 0x36,
+	0x34, 0x3e, 0xe0, 0x2c, 0x92, 0x47, 0x93, 0xae, 0x45, 0x3a, 0xd0, 0x7c, 0x6f, 0x98, 0xa4, 0x79,
+	0xcc, 0xa2, 0x6e, 0x6d, 0xe7, 0xad, 0x1f, 0x7f, 0x67, 0x14, 0xca, 0xa3, 0xf1, 0x50, 0x79, 0xb2,
+	0xa5, 0x5d, 0xfb, 0x66, 0x98, 0x9a, 0x5f, 0x5b, 0x45, 0xc4, 0xb7, 0xd0, 0xdb, 0x72, 0x98, 0x0d,
+	0x87, 0xab, 0x28, 0x79, 0xf3, 0x9f, 0x03, 0x00, 0x2b, 0x27, 0xf6, 0xca, 0x1f, 0x1c, 0x00, 0x00,
 }
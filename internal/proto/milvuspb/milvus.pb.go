@@ -51,6 +51,56 @@ func (ShowType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{0}
 }
 
+type OperateUserRoleType int32
+
+const (
+	OperateUserRoleType_AddUserToRole      OperateUserRoleType = 0
+	OperateUserRoleType_RemoveUserFromRole OperateUserRoleType = 1
+)
+
+var OperateUserRoleType_name = map[int32]string{
+	0: "AddUserToRole",
+	1: "RemoveUserFromRole",
+}
+
+var OperateUserRoleType_value = map[string]int32{
+	"AddUserToRole":      0,
+	"RemoveUserFromRole": 1,
+}
+
+func (x OperateUserRoleType) String() string {
+	return proto.EnumName(OperateUserRoleType_name, int32(x))
+}
+
+func (OperateUserRoleType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{1}
+}
+
+type OperatePrivilegeType int32
+
+const (
+	OperatePrivilegeType_Grant  OperatePrivilegeType = 0
+	OperatePrivilegeType_Revoke OperatePrivilegeType = 1
+)
+
+var OperatePrivilegeType_name = map[int32]string{
+	0: "Grant",
+	1: "Revoke",
+}
+
+var OperatePrivilegeType_value = map[string]int32{
+	"Grant":  0,
+	"Revoke": 1,
+}
+
+func (x OperatePrivilegeType) String() string {
+	return proto.EnumName(OperatePrivilegeType_name, int32(x))
+}
+
+func (OperatePrivilegeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{2}
+}
+
 type PlaceholderType int32
 
 const (
@@ -76,7 +126,7 @@ func (x PlaceholderType) String() string {
 }
 
 func (PlaceholderType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{1}
+	return fileDescriptor_02345ba45cc0e303, []int{3}
 }
 
 type CreateCollectionRequest struct {
@@ -84,11 +134,12 @@ type CreateCollectionRequest struct {
 	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
 	// `schema` is the serialized `schema.CollectionSchema`
-	Schema               []byte   `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
-	ShardsNum            int32    `protobuf:"varint,5,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Schema               []byte                    `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	ShardsNum            int32                     `protobuf:"varint,5,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
+	ConsistencyLevel     commonpb.ConsistencyLevel `protobuf:"varint,6,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *CreateCollectionRequest) Reset()         { *m = CreateCollectionRequest{} }
@@ -151,6 +202,13 @@ func (m *CreateCollectionRequest) GetShardsNum() int32 {
 	return 0
 }
 
+func (m *CreateCollectionRequest) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
+}
+
 type DropCollectionRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
@@ -442,6 +500,8 @@ type DescribeCollectionResponse struct {
 	PhysicalChannelNames []string                   `protobuf:"bytes,5,rep,name=physical_channel_names,json=physicalChannelNames,proto3" json:"physical_channel_names,omitempty"`
 	CreatedTimestamp     uint64                     `protobuf:"varint,6,opt,name=created_timestamp,json=createdTimestamp,proto3" json:"created_timestamp,omitempty"`
 	CreatedUtcTimestamp  uint64                     `protobuf:"varint,7,opt,name=created_utc_timestamp,json=createdUtcTimestamp,proto3" json:"created_utc_timestamp,omitempty"`
+	ConsistencyLevel     commonpb.ConsistencyLevel  `protobuf:"varint,8,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	LoadedFields         []string                   `protobuf:"bytes,9,rep,name=loaded_fields,json=loadedFields,proto3" json:"loaded_fields,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -521,10 +581,28 @@ func (m *DescribeCollectionResponse) GetCreatedUtcTimestamp() uint64 {
 	return 0
 }
 
+func (m *DescribeCollectionResponse) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
+}
+
+func (m *DescribeCollectionResponse) GetLoadedFields() []string {
+	if m != nil {
+		return m.LoadedFields
+	}
+	return nil
+}
+
 type LoadCollectionRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	ReplicaNumber        int32             `protobuf:"varint,4,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
+	Async                bool              `protobuf:"varint,5,opt,name=async,proto3" json:"async,omitempty"`
+	LoadFields           []string          `protobuf:"bytes,6,rep,name=load_fields,json=loadFields,proto3" json:"load_fields,omitempty"`
+	ResourceGroups       []string          `protobuf:"bytes,7,rep,name=resource_groups,json=resourceGroups,proto3" json:"resource_groups,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -576,6 +654,34 @@ func (m *LoadCollectionRequest) GetCollectionName() string {
 	return ""
 }
 
+func (m *LoadCollectionRequest) GetReplicaNumber() int32 {
+	if m != nil {
+		return m.ReplicaNumber
+	}
+	return 0
+}
+
+func (m *LoadCollectionRequest) GetAsync() bool {
+	if m != nil {
+		return m.Async
+	}
+	return false
+}
+
+func (m *LoadCollectionRequest) GetLoadFields() []string {
+	if m != nil {
+		return m.LoadFields
+	}
+	return nil
+}
+
+func (m *LoadCollectionRequest) GetResourceGroups() []string {
+	if m != nil {
+		return m.ResourceGroups
+	}
+	return nil
+}
+
 type ReleaseCollectionRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
@@ -811,6 +917,7 @@ type ShowCollectionsResponse struct {
 	CreatedTimestamps    []uint64         `protobuf:"varint,4,rep,packed,name=created_timestamps,json=createdTimestamps,proto3" json:"created_timestamps,omitempty"`
 	CreatedUtcTimestamps []uint64         `protobuf:"varint,5,rep,packed,name=created_utc_timestamps,json=createdUtcTimestamps,proto3" json:"created_utc_timestamps,omitempty"`
 	InMemoryPercentages  []int64          `protobuf:"varint,6,rep,packed,name=inMemory_percentages,json=inMemoryPercentages,proto3" json:"inMemory_percentages,omitempty"`
+	LoadedFields         []*LoadedFields  `protobuf:"bytes,7,rep,name=loaded_fields,json=loadedFields,proto3" json:"loaded_fields,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -883,294 +990,1112 @@ func (m *ShowCollectionsResponse) GetInMemoryPercentages() []int64 {
 	return nil
 }
 
-type CreatePartitionRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *ShowCollectionsResponse) GetLoadedFields() []*LoadedFields {
+	if m != nil {
+		return m.LoadedFields
+	}
+	return nil
 }
 
-func (m *CreatePartitionRequest) Reset()         { *m = CreatePartitionRequest{} }
-func (m *CreatePartitionRequest) String() string { return proto.CompactTextString(m) }
-func (*CreatePartitionRequest) ProtoMessage()    {}
-func (*CreatePartitionRequest) Descriptor() ([]byte, []int) {
+type LoadedFields struct {
+	FieldNames           []string `protobuf:"bytes,1,rep,name=field_names,json=fieldNames,proto3" json:"field_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoadedFields) Reset()         { *m = LoadedFields{} }
+func (m *LoadedFields) String() string { return proto.CompactTextString(m) }
+func (*LoadedFields) ProtoMessage()    {}
+func (*LoadedFields) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{13}
 }
 
-func (m *CreatePartitionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreatePartitionRequest.Unmarshal(m, b)
+func (m *LoadedFields) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadedFields.Unmarshal(m, b)
 }
-func (m *CreatePartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreatePartitionRequest.Marshal(b, m, deterministic)
+func (m *LoadedFields) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadedFields.Marshal(b, m, deterministic)
 }
-func (m *CreatePartitionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreatePartitionRequest.Merge(m, src)
+func (m *LoadedFields) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadedFields.Merge(m, src)
 }
-func (m *CreatePartitionRequest) XXX_Size() int {
-	return xxx_messageInfo_CreatePartitionRequest.Size(m)
+func (m *LoadedFields) XXX_Size() int {
+	return xxx_messageInfo_LoadedFields.Size(m)
 }
-func (m *CreatePartitionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreatePartitionRequest.DiscardUnknown(m)
+func (m *LoadedFields) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadedFields.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreatePartitionRequest proto.InternalMessageInfo
+var xxx_messageInfo_LoadedFields proto.InternalMessageInfo
 
-func (m *CreatePartitionRequest) GetBase() *commonpb.MsgBase {
+func (m *LoadedFields) GetFieldNames() []string {
 	if m != nil {
-		return m.Base
+		return m.FieldNames
 	}
 	return nil
 }
 
-func (m *CreatePartitionRequest) GetDbName() string {
-	if m != nil {
-		return m.DbName
-	}
-	return ""
-}
-
-func (m *CreatePartitionRequest) GetCollectionName() string {
-	if m != nil {
-		return m.CollectionName
-	}
-	return ""
-}
-
-func (m *CreatePartitionRequest) GetPartitionName() string {
-	if m != nil {
-		return m.PartitionName
-	}
-	return ""
-}
-
-type DropPartitionRequest struct {
+type CreateDatabaseRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *DropPartitionRequest) Reset()         { *m = DropPartitionRequest{} }
-func (m *DropPartitionRequest) String() string { return proto.CompactTextString(m) }
-func (*DropPartitionRequest) ProtoMessage()    {}
-func (*DropPartitionRequest) Descriptor() ([]byte, []int) {
+func (m *CreateDatabaseRequest) Reset()         { *m = CreateDatabaseRequest{} }
+func (m *CreateDatabaseRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateDatabaseRequest) ProtoMessage()    {}
+func (*CreateDatabaseRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{14}
 }
 
-func (m *DropPartitionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DropPartitionRequest.Unmarshal(m, b)
+func (m *CreateDatabaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDatabaseRequest.Unmarshal(m, b)
 }
-func (m *DropPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DropPartitionRequest.Marshal(b, m, deterministic)
+func (m *CreateDatabaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDatabaseRequest.Marshal(b, m, deterministic)
 }
-func (m *DropPartitionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DropPartitionRequest.Merge(m, src)
+func (m *CreateDatabaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDatabaseRequest.Merge(m, src)
 }
-func (m *DropPartitionRequest) XXX_Size() int {
-	return xxx_messageInfo_DropPartitionRequest.Size(m)
+func (m *CreateDatabaseRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateDatabaseRequest.Size(m)
 }
-func (m *DropPartitionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DropPartitionRequest.DiscardUnknown(m)
+func (m *CreateDatabaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDatabaseRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DropPartitionRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateDatabaseRequest proto.InternalMessageInfo
 
-func (m *DropPartitionRequest) GetBase() *commonpb.MsgBase {
+func (m *CreateDatabaseRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *DropPartitionRequest) GetDbName() string {
+func (m *CreateDatabaseRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *DropPartitionRequest) GetCollectionName() string {
-	if m != nil {
-		return m.CollectionName
-	}
-	return ""
-}
-
-func (m *DropPartitionRequest) GetPartitionName() string {
-	if m != nil {
-		return m.PartitionName
-	}
-	return ""
-}
-
-type HasPartitionRequest struct {
+type DropDatabaseRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *HasPartitionRequest) Reset()         { *m = HasPartitionRequest{} }
-func (m *HasPartitionRequest) String() string { return proto.CompactTextString(m) }
-func (*HasPartitionRequest) ProtoMessage()    {}
-func (*HasPartitionRequest) Descriptor() ([]byte, []int) {
+func (m *DropDatabaseRequest) Reset()         { *m = DropDatabaseRequest{} }
+func (m *DropDatabaseRequest) String() string { return proto.CompactTextString(m) }
+func (*DropDatabaseRequest) ProtoMessage()    {}
+func (*DropDatabaseRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{15}
 }
 
-func (m *HasPartitionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HasPartitionRequest.Unmarshal(m, b)
+func (m *DropDatabaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropDatabaseRequest.Unmarshal(m, b)
 }
-func (m *HasPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HasPartitionRequest.Marshal(b, m, deterministic)
+func (m *DropDatabaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropDatabaseRequest.Marshal(b, m, deterministic)
 }
-func (m *HasPartitionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HasPartitionRequest.Merge(m, src)
+func (m *DropDatabaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropDatabaseRequest.Merge(m, src)
 }
-func (m *HasPartitionRequest) XXX_Size() int {
-	return xxx_messageInfo_HasPartitionRequest.Size(m)
+func (m *DropDatabaseRequest) XXX_Size() int {
+	return xxx_messageInfo_DropDatabaseRequest.Size(m)
 }
-func (m *HasPartitionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_HasPartitionRequest.DiscardUnknown(m)
+func (m *DropDatabaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropDatabaseRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HasPartitionRequest proto.InternalMessageInfo
+var xxx_messageInfo_DropDatabaseRequest proto.InternalMessageInfo
 
-func (m *HasPartitionRequest) GetBase() *commonpb.MsgBase {
+func (m *DropDatabaseRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *HasPartitionRequest) GetDbName() string {
+func (m *DropDatabaseRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *HasPartitionRequest) GetCollectionName() string {
-	if m != nil {
-		return m.CollectionName
-	}
-	return ""
-}
-
-func (m *HasPartitionRequest) GetPartitionName() string {
-	if m != nil {
-		return m.PartitionName
-	}
-	return ""
-}
-
-type LoadPartitionsRequest struct {
+type ListDatabasesRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionNames       []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *LoadPartitionsRequest) Reset()         { *m = LoadPartitionsRequest{} }
-func (m *LoadPartitionsRequest) String() string { return proto.CompactTextString(m) }
-func (*LoadPartitionsRequest) ProtoMessage()    {}
-func (*LoadPartitionsRequest) Descriptor() ([]byte, []int) {
+func (m *ListDatabasesRequest) Reset()         { *m = ListDatabasesRequest{} }
+func (m *ListDatabasesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDatabasesRequest) ProtoMessage()    {}
+func (*ListDatabasesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{16}
 }
 
-func (m *LoadPartitionsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LoadPartitionsRequest.Unmarshal(m, b)
+func (m *ListDatabasesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDatabasesRequest.Unmarshal(m, b)
 }
-func (m *LoadPartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LoadPartitionsRequest.Marshal(b, m, deterministic)
+func (m *ListDatabasesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDatabasesRequest.Marshal(b, m, deterministic)
 }
-func (m *LoadPartitionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LoadPartitionsRequest.Merge(m, src)
+func (m *ListDatabasesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDatabasesRequest.Merge(m, src)
 }
-func (m *LoadPartitionsRequest) XXX_Size() int {
-	return xxx_messageInfo_LoadPartitionsRequest.Size(m)
+func (m *ListDatabasesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDatabasesRequest.Size(m)
 }
-func (m *LoadPartitionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LoadPartitionsRequest.DiscardUnknown(m)
+func (m *ListDatabasesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDatabasesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LoadPartitionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListDatabasesRequest proto.InternalMessageInfo
 
-func (m *LoadPartitionsRequest) GetBase() *commonpb.MsgBase {
+func (m *ListDatabasesRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *LoadPartitionsRequest) GetDbName() string {
+type ListDatabasesResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DbNames              []string         `protobuf:"bytes,2,rep,name=db_names,json=dbNames,proto3" json:"db_names,omitempty"`
+	CreatedTimestamps    []uint64         `protobuf:"varint,3,rep,packed,name=created_timestamps,json=createdTimestamps,proto3" json:"created_timestamps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListDatabasesResponse) Reset()         { *m = ListDatabasesResponse{} }
+func (m *ListDatabasesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDatabasesResponse) ProtoMessage()    {}
+func (*ListDatabasesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{17}
+}
+
+func (m *ListDatabasesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDatabasesResponse.Unmarshal(m, b)
+}
+func (m *ListDatabasesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDatabasesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListDatabasesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDatabasesResponse.Merge(m, src)
+}
+func (m *ListDatabasesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDatabasesResponse.Size(m)
+}
+func (m *ListDatabasesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDatabasesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDatabasesResponse proto.InternalMessageInfo
+
+func (m *ListDatabasesResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.DbName
+		return m.Status
 	}
-	return ""
+	return nil
 }
 
-func (m *LoadPartitionsRequest) GetCollectionName() string {
+func (m *ListDatabasesResponse) GetDbNames() []string {
 	if m != nil {
-		return m.CollectionName
+		return m.DbNames
 	}
-	return ""
+	return nil
 }
 
-func (m *LoadPartitionsRequest) GetPartitionNames() []string {
+func (m *ListDatabasesResponse) GetCreatedTimestamps() []uint64 {
 	if m != nil {
-		return m.PartitionNames
+		return m.CreatedTimestamps
 	}
 	return nil
 }
 
-type ReleasePartitionsRequest struct {
+type CreateCredentialRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionNames       []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	Username             string            `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password             string            `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *ReleasePartitionsRequest) Reset()         { *m = ReleasePartitionsRequest{} }
-func (m *ReleasePartitionsRequest) String() string { return proto.CompactTextString(m) }
-func (*ReleasePartitionsRequest) ProtoMessage()    {}
-func (*ReleasePartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{17}
+func (m *CreateCredentialRequest) Reset()         { *m = CreateCredentialRequest{} }
+func (m *CreateCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateCredentialRequest) ProtoMessage()    {}
+func (*CreateCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{18}
 }
 
-func (m *ReleasePartitionsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReleasePartitionsRequest.Unmarshal(m, b)
+func (m *CreateCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCredentialRequest.Unmarshal(m, b)
 }
-func (m *ReleasePartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReleasePartitionsRequest.Marshal(b, m, deterministic)
+func (m *CreateCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCredentialRequest.Marshal(b, m, deterministic)
 }
-func (m *ReleasePartitionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReleasePartitionsRequest.Merge(m, src)
+func (m *CreateCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCredentialRequest.Merge(m, src)
 }
-func (m *ReleasePartitionsRequest) XXX_Size() int {
-	return xxx_messageInfo_ReleasePartitionsRequest.Size(m)
+func (m *CreateCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateCredentialRequest.Size(m)
 }
-func (m *ReleasePartitionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReleasePartitionsRequest.DiscardUnknown(m)
+func (m *CreateCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCredentialRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReleasePartitionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateCredentialRequest proto.InternalMessageInfo
 
-func (m *ReleasePartitionsRequest) GetBase() *commonpb.MsgBase {
+func (m *CreateCredentialRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CreateCredentialRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *CreateCredentialRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type DeleteCredentialRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Username             string            `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DeleteCredentialRequest) Reset()         { *m = DeleteCredentialRequest{} }
+func (m *DeleteCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteCredentialRequest) ProtoMessage()    {}
+func (*DeleteCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{19}
+}
+
+func (m *DeleteCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCredentialRequest.Unmarshal(m, b)
+}
+func (m *DeleteCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCredentialRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCredentialRequest.Merge(m, src)
+}
+func (m *DeleteCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteCredentialRequest.Size(m)
+}
+func (m *DeleteCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCredentialRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCredentialRequest proto.InternalMessageInfo
+
+func (m *DeleteCredentialRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DeleteCredentialRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+type ListCredUsersRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListCredUsersRequest) Reset()         { *m = ListCredUsersRequest{} }
+func (m *ListCredUsersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCredUsersRequest) ProtoMessage()    {}
+func (*ListCredUsersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{20}
+}
+
+func (m *ListCredUsersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCredUsersRequest.Unmarshal(m, b)
+}
+func (m *ListCredUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCredUsersRequest.Marshal(b, m, deterministic)
+}
+func (m *ListCredUsersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCredUsersRequest.Merge(m, src)
+}
+func (m *ListCredUsersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCredUsersRequest.Size(m)
+}
+func (m *ListCredUsersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCredUsersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCredUsersRequest proto.InternalMessageInfo
+
+func (m *ListCredUsersRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type ListCredUsersResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Usernames            []string         `protobuf:"bytes,2,rep,name=usernames,proto3" json:"usernames,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListCredUsersResponse) Reset()         { *m = ListCredUsersResponse{} }
+func (m *ListCredUsersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCredUsersResponse) ProtoMessage()    {}
+func (*ListCredUsersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{21}
+}
+
+func (m *ListCredUsersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCredUsersResponse.Unmarshal(m, b)
+}
+func (m *ListCredUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCredUsersResponse.Marshal(b, m, deterministic)
+}
+func (m *ListCredUsersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCredUsersResponse.Merge(m, src)
+}
+func (m *ListCredUsersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCredUsersResponse.Size(m)
+}
+func (m *ListCredUsersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCredUsersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCredUsersResponse proto.InternalMessageInfo
+
+func (m *ListCredUsersResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListCredUsersResponse) GetUsernames() []string {
+	if m != nil {
+		return m.Usernames
+	}
+	return nil
+}
+
+type CreateRoleRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	RoleName             string            `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CreateRoleRequest) Reset()         { *m = CreateRoleRequest{} }
+func (m *CreateRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateRoleRequest) ProtoMessage()    {}
+func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{22}
+}
+
+func (m *CreateRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRoleRequest.Unmarshal(m, b)
+}
+func (m *CreateRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRoleRequest.Merge(m, src)
+}
+func (m *CreateRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateRoleRequest.Size(m)
+}
+func (m *CreateRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRoleRequest proto.InternalMessageInfo
+
+func (m *CreateRoleRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CreateRoleRequest) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+type DropRoleRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	RoleName             string            `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DropRoleRequest) Reset()         { *m = DropRoleRequest{} }
+func (m *DropRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*DropRoleRequest) ProtoMessage()    {}
+func (*DropRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{23}
+}
+
+func (m *DropRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropRoleRequest.Unmarshal(m, b)
+}
+func (m *DropRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *DropRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropRoleRequest.Merge(m, src)
+}
+func (m *DropRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_DropRoleRequest.Size(m)
+}
+func (m *DropRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropRoleRequest proto.InternalMessageInfo
+
+func (m *DropRoleRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DropRoleRequest) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+type OperateUserRoleRequest struct {
+	Base                 *commonpb.MsgBase   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Username             string              `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	RoleName             string              `protobuf:"bytes,3,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	Type                 OperateUserRoleType `protobuf:"varint,4,opt,name=type,proto3,enum=milvus.proto.milvus.OperateUserRoleType" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *OperateUserRoleRequest) Reset()         { *m = OperateUserRoleRequest{} }
+func (m *OperateUserRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*OperateUserRoleRequest) ProtoMessage()    {}
+func (*OperateUserRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{24}
+}
+
+func (m *OperateUserRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OperateUserRoleRequest.Unmarshal(m, b)
+}
+func (m *OperateUserRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OperateUserRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *OperateUserRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperateUserRoleRequest.Merge(m, src)
+}
+func (m *OperateUserRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_OperateUserRoleRequest.Size(m)
+}
+func (m *OperateUserRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperateUserRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperateUserRoleRequest proto.InternalMessageInfo
+
+func (m *OperateUserRoleRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *OperateUserRoleRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *OperateUserRoleRequest) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+func (m *OperateUserRoleRequest) GetType() OperateUserRoleType {
+	if m != nil {
+		return m.Type
+	}
+	return OperateUserRoleType_AddUserToRole
+}
+
+// GrantEntity allows a role to perform `privilege` on `object_name` in database `db_name`.
+// `privilege` is the name of a request type, e.g. Search, Insert, Retrieve (for Query) or CreateCollection;
+// both `object_name` and `privilege` accept "*" as a wildcard.
+type GrantEntity struct {
+	RoleName             string   `protobuf:"bytes,1,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	DbName               string   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	ObjectName           string   `protobuf:"bytes,3,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	Privilege            string   `protobuf:"bytes,4,opt,name=privilege,proto3" json:"privilege,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GrantEntity) Reset()         { *m = GrantEntity{} }
+func (m *GrantEntity) String() string { return proto.CompactTextString(m) }
+func (*GrantEntity) ProtoMessage()    {}
+func (*GrantEntity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{25}
+}
+
+func (m *GrantEntity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GrantEntity.Unmarshal(m, b)
+}
+func (m *GrantEntity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GrantEntity.Marshal(b, m, deterministic)
+}
+func (m *GrantEntity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantEntity.Merge(m, src)
+}
+func (m *GrantEntity) XXX_Size() int {
+	return xxx_messageInfo_GrantEntity.Size(m)
+}
+func (m *GrantEntity) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantEntity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantEntity proto.InternalMessageInfo
+
+func (m *GrantEntity) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+func (m *GrantEntity) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *GrantEntity) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
+	}
+	return ""
+}
+
+func (m *GrantEntity) GetPrivilege() string {
+	if m != nil {
+		return m.Privilege
+	}
+	return ""
+}
+
+type OperatePrivilegeRequest struct {
+	Base                 *commonpb.MsgBase    `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Entity               *GrantEntity         `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	Type                 OperatePrivilegeType `protobuf:"varint,3,opt,name=type,proto3,enum=milvus.proto.milvus.OperatePrivilegeType" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *OperatePrivilegeRequest) Reset()         { *m = OperatePrivilegeRequest{} }
+func (m *OperatePrivilegeRequest) String() string { return proto.CompactTextString(m) }
+func (*OperatePrivilegeRequest) ProtoMessage()    {}
+func (*OperatePrivilegeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{26}
+}
+
+func (m *OperatePrivilegeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OperatePrivilegeRequest.Unmarshal(m, b)
+}
+func (m *OperatePrivilegeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OperatePrivilegeRequest.Marshal(b, m, deterministic)
+}
+func (m *OperatePrivilegeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatePrivilegeRequest.Merge(m, src)
+}
+func (m *OperatePrivilegeRequest) XXX_Size() int {
+	return xxx_messageInfo_OperatePrivilegeRequest.Size(m)
+}
+func (m *OperatePrivilegeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatePrivilegeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatePrivilegeRequest proto.InternalMessageInfo
+
+func (m *OperatePrivilegeRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *OperatePrivilegeRequest) GetEntity() *GrantEntity {
+	if m != nil {
+		return m.Entity
+	}
+	return nil
+}
+
+func (m *OperatePrivilegeRequest) GetType() OperatePrivilegeType {
+	if m != nil {
+		return m.Type
+	}
+	return OperatePrivilegeType_Grant
+}
+
+type SelectGrantRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	RoleName             string            `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SelectGrantRequest) Reset()         { *m = SelectGrantRequest{} }
+func (m *SelectGrantRequest) String() string { return proto.CompactTextString(m) }
+func (*SelectGrantRequest) ProtoMessage()    {}
+func (*SelectGrantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{27}
+}
+
+func (m *SelectGrantRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectGrantRequest.Unmarshal(m, b)
+}
+func (m *SelectGrantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectGrantRequest.Marshal(b, m, deterministic)
+}
+func (m *SelectGrantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectGrantRequest.Merge(m, src)
+}
+func (m *SelectGrantRequest) XXX_Size() int {
+	return xxx_messageInfo_SelectGrantRequest.Size(m)
+}
+func (m *SelectGrantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectGrantRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelectGrantRequest proto.InternalMessageInfo
+
+func (m *SelectGrantRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SelectGrantRequest) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
+}
+
+type SelectGrantResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Entities             []*GrantEntity   `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SelectGrantResponse) Reset()         { *m = SelectGrantResponse{} }
+func (m *SelectGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*SelectGrantResponse) ProtoMessage()    {}
+func (*SelectGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{28}
+}
+
+func (m *SelectGrantResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectGrantResponse.Unmarshal(m, b)
+}
+func (m *SelectGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectGrantResponse.Marshal(b, m, deterministic)
+}
+func (m *SelectGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectGrantResponse.Merge(m, src)
+}
+func (m *SelectGrantResponse) XXX_Size() int {
+	return xxx_messageInfo_SelectGrantResponse.Size(m)
+}
+func (m *SelectGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectGrantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelectGrantResponse proto.InternalMessageInfo
+
+func (m *SelectGrantResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *SelectGrantResponse) GetEntities() []*GrantEntity {
+	if m != nil {
+		return m.Entities
+	}
+	return nil
+}
+
+type CreatePartitionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CreatePartitionRequest) Reset()         { *m = CreatePartitionRequest{} }
+func (m *CreatePartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreatePartitionRequest) ProtoMessage()    {}
+func (*CreatePartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{29}
+}
+
+func (m *CreatePartitionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreatePartitionRequest.Unmarshal(m, b)
+}
+func (m *CreatePartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreatePartitionRequest.Marshal(b, m, deterministic)
+}
+func (m *CreatePartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePartitionRequest.Merge(m, src)
+}
+func (m *CreatePartitionRequest) XXX_Size() int {
+	return xxx_messageInfo_CreatePartitionRequest.Size(m)
+}
+func (m *CreatePartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePartitionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatePartitionRequest proto.InternalMessageInfo
+
+func (m *CreatePartitionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CreatePartitionRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *CreatePartitionRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *CreatePartitionRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+type DropPartitionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DropPartitionRequest) Reset()         { *m = DropPartitionRequest{} }
+func (m *DropPartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*DropPartitionRequest) ProtoMessage()    {}
+func (*DropPartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{30}
+}
+
+func (m *DropPartitionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropPartitionRequest.Unmarshal(m, b)
+}
+func (m *DropPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropPartitionRequest.Marshal(b, m, deterministic)
+}
+func (m *DropPartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropPartitionRequest.Merge(m, src)
+}
+func (m *DropPartitionRequest) XXX_Size() int {
+	return xxx_messageInfo_DropPartitionRequest.Size(m)
+}
+func (m *DropPartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropPartitionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropPartitionRequest proto.InternalMessageInfo
+
+func (m *DropPartitionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DropPartitionRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *DropPartitionRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *DropPartitionRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+type HasPartitionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *HasPartitionRequest) Reset()         { *m = HasPartitionRequest{} }
+func (m *HasPartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*HasPartitionRequest) ProtoMessage()    {}
+func (*HasPartitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{31}
+}
+
+func (m *HasPartitionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HasPartitionRequest.Unmarshal(m, b)
+}
+func (m *HasPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HasPartitionRequest.Marshal(b, m, deterministic)
+}
+func (m *HasPartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HasPartitionRequest.Merge(m, src)
+}
+func (m *HasPartitionRequest) XXX_Size() int {
+	return xxx_messageInfo_HasPartitionRequest.Size(m)
+}
+func (m *HasPartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HasPartitionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HasPartitionRequest proto.InternalMessageInfo
+
+func (m *HasPartitionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *HasPartitionRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *HasPartitionRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *HasPartitionRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+type LoadPartitionsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionNames       []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	ReplicaNumber        int32             `protobuf:"varint,5,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
+	Async                bool              `protobuf:"varint,6,opt,name=async,proto3" json:"async,omitempty"`
+	ResourceGroups       []string          `protobuf:"bytes,7,rep,name=resource_groups,json=resourceGroups,proto3" json:"resource_groups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *LoadPartitionsRequest) Reset()         { *m = LoadPartitionsRequest{} }
+func (m *LoadPartitionsRequest) String() string { return proto.CompactTextString(m) }
+func (*LoadPartitionsRequest) ProtoMessage()    {}
+func (*LoadPartitionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{32}
+}
+
+func (m *LoadPartitionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadPartitionsRequest.Unmarshal(m, b)
+}
+func (m *LoadPartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadPartitionsRequest.Marshal(b, m, deterministic)
+}
+func (m *LoadPartitionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadPartitionsRequest.Merge(m, src)
+}
+func (m *LoadPartitionsRequest) XXX_Size() int {
+	return xxx_messageInfo_LoadPartitionsRequest.Size(m)
+}
+func (m *LoadPartitionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadPartitionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadPartitionsRequest proto.InternalMessageInfo
+
+func (m *LoadPartitionsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *LoadPartitionsRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *LoadPartitionsRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *LoadPartitionsRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *LoadPartitionsRequest) GetReplicaNumber() int32 {
+	if m != nil {
+		return m.ReplicaNumber
+	}
+	return 0
+}
+
+func (m *LoadPartitionsRequest) GetAsync() bool {
+	if m != nil {
+		return m.Async
+	}
+	return false
+}
+
+func (m *LoadPartitionsRequest) GetResourceGroups() []string {
+	if m != nil {
+		return m.ResourceGroups
+	}
+	return nil
+}
+
+type ReleasePartitionsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionNames       []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ReleasePartitionsRequest) Reset()         { *m = ReleasePartitionsRequest{} }
+func (m *ReleasePartitionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleasePartitionsRequest) ProtoMessage()    {}
+func (*ReleasePartitionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{33}
+}
+
+func (m *ReleasePartitionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleasePartitionsRequest.Unmarshal(m, b)
+}
+func (m *ReleasePartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleasePartitionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ReleasePartitionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleasePartitionsRequest.Merge(m, src)
+}
+func (m *ReleasePartitionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ReleasePartitionsRequest.Size(m)
+}
+func (m *ReleasePartitionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleasePartitionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleasePartitionsRequest proto.InternalMessageInfo
+
+func (m *ReleasePartitionsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
@@ -1212,7 +2137,7 @@ func (m *GetPartitionStatisticsRequest) Reset()         { *m = GetPartitionStati
 func (m *GetPartitionStatisticsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPartitionStatisticsRequest) ProtoMessage()    {}
 func (*GetPartitionStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{18}
+	return fileDescriptor_02345ba45cc0e303, []int{34}
 }
 
 func (m *GetPartitionStatisticsRequest) XXX_Unmarshal(b []byte) error {
@@ -1273,7 +2198,7 @@ func (m *GetPartitionStatisticsResponse) Reset()         { *m = GetPartitionStat
 func (m *GetPartitionStatisticsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPartitionStatisticsResponse) ProtoMessage()    {}
 func (*GetPartitionStatisticsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{19}
+	return fileDescriptor_02345ba45cc0e303, []int{35}
 }
 
 func (m *GetPartitionStatisticsResponse) XXX_Unmarshal(b []byte) error {
@@ -1324,7 +2249,7 @@ func (m *ShowPartitionsRequest) Reset()         { *m = ShowPartitionsRequest{} }
 func (m *ShowPartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowPartitionsRequest) ProtoMessage()    {}
 func (*ShowPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{20}
+	return fileDescriptor_02345ba45cc0e303, []int{36}
 }
 
 func (m *ShowPartitionsRequest) XXX_Unmarshal(b []byte) error {
@@ -1403,7 +2328,7 @@ func (m *ShowPartitionsResponse) Reset()         { *m = ShowPartitionsResponse{}
 func (m *ShowPartitionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowPartitionsResponse) ProtoMessage()    {}
 func (*ShowPartitionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{21}
+	return fileDescriptor_02345ba45cc0e303, []int{37}
 }
 
 func (m *ShowPartitionsResponse) XXX_Unmarshal(b []byte) error {
@@ -1433,35 +2358,328 @@ func (m *ShowPartitionsResponse) GetStatus() *commonpb.Status {
 
 func (m *ShowPartitionsResponse) GetPartitionNames() []string {
 	if m != nil {
-		return m.PartitionNames
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *ShowPartitionsResponse) GetPartitionIDs() []int64 {
+	if m != nil {
+		return m.PartitionIDs
+	}
+	return nil
+}
+
+func (m *ShowPartitionsResponse) GetCreatedTimestamps() []uint64 {
+	if m != nil {
+		return m.CreatedTimestamps
+	}
+	return nil
+}
+
+func (m *ShowPartitionsResponse) GetCreatedUtcTimestamps() []uint64 {
+	if m != nil {
+		return m.CreatedUtcTimestamps
+	}
+	return nil
+}
+
+func (m *ShowPartitionsResponse) GetInMemoryPercentages() []int64 {
+	if m != nil {
+		return m.InMemoryPercentages
+	}
+	return nil
+}
+
+type GetLoadingProgressRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionNames       []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetLoadingProgressRequest) Reset()         { *m = GetLoadingProgressRequest{} }
+func (m *GetLoadingProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLoadingProgressRequest) ProtoMessage()    {}
+func (*GetLoadingProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{38}
+}
+
+func (m *GetLoadingProgressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLoadingProgressRequest.Unmarshal(m, b)
+}
+func (m *GetLoadingProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLoadingProgressRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLoadingProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLoadingProgressRequest.Merge(m, src)
+}
+func (m *GetLoadingProgressRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLoadingProgressRequest.Size(m)
+}
+func (m *GetLoadingProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLoadingProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLoadingProgressRequest proto.InternalMessageInfo
+
+func (m *GetLoadingProgressRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetLoadingProgressRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *GetLoadingProgressRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *GetLoadingProgressRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+// LoadingProgress counts the segments to load and the ones loaded, the replicas of a segment
+// are counted separately
+type LoadingProgress struct {
+	TotalSegments        int64    `protobuf:"varint,1,opt,name=total_segments,json=totalSegments,proto3" json:"total_segments,omitempty"`
+	LoadedSegments       int64    `protobuf:"varint,2,opt,name=loaded_segments,json=loadedSegments,proto3" json:"loaded_segments,omitempty"`
+	TotalRows            int64    `protobuf:"varint,3,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	LoadedRows           int64    `protobuf:"varint,4,opt,name=loaded_rows,json=loadedRows,proto3" json:"loaded_rows,omitempty"`
+	Percentage           int64    `protobuf:"varint,5,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoadingProgress) Reset()         { *m = LoadingProgress{} }
+func (m *LoadingProgress) String() string { return proto.CompactTextString(m) }
+func (*LoadingProgress) ProtoMessage()    {}
+func (*LoadingProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{39}
+}
+
+func (m *LoadingProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadingProgress.Unmarshal(m, b)
+}
+func (m *LoadingProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadingProgress.Marshal(b, m, deterministic)
+}
+func (m *LoadingProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadingProgress.Merge(m, src)
+}
+func (m *LoadingProgress) XXX_Size() int {
+	return xxx_messageInfo_LoadingProgress.Size(m)
+}
+func (m *LoadingProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadingProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadingProgress proto.InternalMessageInfo
+
+func (m *LoadingProgress) GetTotalSegments() int64 {
+	if m != nil {
+		return m.TotalSegments
+	}
+	return 0
+}
+
+func (m *LoadingProgress) GetLoadedSegments() int64 {
+	if m != nil {
+		return m.LoadedSegments
+	}
+	return 0
+}
+
+func (m *LoadingProgress) GetTotalRows() int64 {
+	if m != nil {
+		return m.TotalRows
+	}
+	return 0
+}
+
+func (m *LoadingProgress) GetLoadedRows() int64 {
+	if m != nil {
+		return m.LoadedRows
+	}
+	return 0
+}
+
+func (m *LoadingProgress) GetPercentage() int64 {
+	if m != nil {
+		return m.Percentage
+	}
+	return 0
+}
+
+type PartitionLoadingProgress struct {
+	PartitionName        string           `protobuf:"bytes,1,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	Progress             *LoadingProgress `protobuf:"bytes,2,opt,name=progress,proto3" json:"progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *PartitionLoadingProgress) Reset()         { *m = PartitionLoadingProgress{} }
+func (m *PartitionLoadingProgress) String() string { return proto.CompactTextString(m) }
+func (*PartitionLoadingProgress) ProtoMessage()    {}
+func (*PartitionLoadingProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{40}
+}
+
+func (m *PartitionLoadingProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartitionLoadingProgress.Unmarshal(m, b)
+}
+func (m *PartitionLoadingProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartitionLoadingProgress.Marshal(b, m, deterministic)
+}
+func (m *PartitionLoadingProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionLoadingProgress.Merge(m, src)
+}
+func (m *PartitionLoadingProgress) XXX_Size() int {
+	return xxx_messageInfo_PartitionLoadingProgress.Size(m)
+}
+func (m *PartitionLoadingProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionLoadingProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionLoadingProgress proto.InternalMessageInfo
+
+func (m *PartitionLoadingProgress) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *PartitionLoadingProgress) GetProgress() *LoadingProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+type NodeLoadingProgress struct {
+	NodeID               int64            `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Progress             *LoadingProgress `protobuf:"bytes,2,opt,name=progress,proto3" json:"progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *NodeLoadingProgress) Reset()         { *m = NodeLoadingProgress{} }
+func (m *NodeLoadingProgress) String() string { return proto.CompactTextString(m) }
+func (*NodeLoadingProgress) ProtoMessage()    {}
+func (*NodeLoadingProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{41}
+}
+
+func (m *NodeLoadingProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeLoadingProgress.Unmarshal(m, b)
+}
+func (m *NodeLoadingProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeLoadingProgress.Marshal(b, m, deterministic)
+}
+func (m *NodeLoadingProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeLoadingProgress.Merge(m, src)
+}
+func (m *NodeLoadingProgress) XXX_Size() int {
+	return xxx_messageInfo_NodeLoadingProgress.Size(m)
+}
+func (m *NodeLoadingProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeLoadingProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeLoadingProgress proto.InternalMessageInfo
+
+func (m *NodeLoadingProgress) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *NodeLoadingProgress) GetProgress() *LoadingProgress {
+	if m != nil {
+		return m.Progress
 	}
 	return nil
 }
 
-func (m *ShowPartitionsResponse) GetPartitionIDs() []int64 {
+type GetLoadingProgressResponse struct {
+	Status               *commonpb.Status            `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Progress             *LoadingProgress            `protobuf:"bytes,2,opt,name=progress,proto3" json:"progress,omitempty"`
+	PartitionProgresses  []*PartitionLoadingProgress `protobuf:"bytes,3,rep,name=partition_progresses,json=partitionProgresses,proto3" json:"partition_progresses,omitempty"`
+	NodeProgresses       []*NodeLoadingProgress      `protobuf:"bytes,4,rep,name=node_progresses,json=nodeProgresses,proto3" json:"node_progresses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *GetLoadingProgressResponse) Reset()         { *m = GetLoadingProgressResponse{} }
+func (m *GetLoadingProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLoadingProgressResponse) ProtoMessage()    {}
+func (*GetLoadingProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{42}
+}
+
+func (m *GetLoadingProgressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLoadingProgressResponse.Unmarshal(m, b)
+}
+func (m *GetLoadingProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLoadingProgressResponse.Marshal(b, m, deterministic)
+}
+func (m *GetLoadingProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLoadingProgressResponse.Merge(m, src)
+}
+func (m *GetLoadingProgressResponse) XXX_Size() int {
+	return xxx_messageInfo_GetLoadingProgressResponse.Size(m)
+}
+func (m *GetLoadingProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLoadingProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLoadingProgressResponse proto.InternalMessageInfo
+
+func (m *GetLoadingProgressResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.PartitionIDs
+		return m.Status
 	}
 	return nil
 }
 
-func (m *ShowPartitionsResponse) GetCreatedTimestamps() []uint64 {
+func (m *GetLoadingProgressResponse) GetProgress() *LoadingProgress {
 	if m != nil {
-		return m.CreatedTimestamps
+		return m.Progress
 	}
 	return nil
 }
 
-func (m *ShowPartitionsResponse) GetCreatedUtcTimestamps() []uint64 {
+func (m *GetLoadingProgressResponse) GetPartitionProgresses() []*PartitionLoadingProgress {
 	if m != nil {
-		return m.CreatedUtcTimestamps
+		return m.PartitionProgresses
 	}
 	return nil
 }
 
-func (m *ShowPartitionsResponse) GetInMemoryPercentages() []int64 {
+func (m *GetLoadingProgressResponse) GetNodeProgresses() []*NodeLoadingProgress {
 	if m != nil {
-		return m.InMemoryPercentages
+		return m.NodeProgresses
 	}
 	return nil
 }
@@ -1479,7 +2697,7 @@ func (m *DescribeSegmentRequest) Reset()         { *m = DescribeSegmentRequest{}
 func (m *DescribeSegmentRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeSegmentRequest) ProtoMessage()    {}
 func (*DescribeSegmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{22}
+	return fileDescriptor_02345ba45cc0e303, []int{43}
 }
 
 func (m *DescribeSegmentRequest) XXX_Unmarshal(b []byte) error {
@@ -1535,7 +2753,7 @@ func (m *DescribeSegmentResponse) Reset()         { *m = DescribeSegmentResponse
 func (m *DescribeSegmentResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeSegmentResponse) ProtoMessage()    {}
 func (*DescribeSegmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{23}
+	return fileDescriptor_02345ba45cc0e303, []int{44}
 }
 
 func (m *DescribeSegmentResponse) XXX_Unmarshal(b []byte) error {
@@ -1597,7 +2815,7 @@ func (m *ShowSegmentsRequest) Reset()         { *m = ShowSegmentsRequest{} }
 func (m *ShowSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowSegmentsRequest) ProtoMessage()    {}
 func (*ShowSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{24}
+	return fileDescriptor_02345ba45cc0e303, []int{45}
 }
 
 func (m *ShowSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -1651,7 +2869,7 @@ func (m *ShowSegmentsResponse) Reset()         { *m = ShowSegmentsResponse{} }
 func (m *ShowSegmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowSegmentsResponse) ProtoMessage()    {}
 func (*ShowSegmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{25}
+	return fileDescriptor_02345ba45cc0e303, []int{46}
 }
 
 func (m *ShowSegmentsResponse) XXX_Unmarshal(b []byte) error {
@@ -1701,7 +2919,7 @@ func (m *CreateIndexRequest) Reset()         { *m = CreateIndexRequest{} }
 func (m *CreateIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateIndexRequest) ProtoMessage()    {}
 func (*CreateIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{26}
+	return fileDescriptor_02345ba45cc0e303, []int{47}
 }
 
 func (m *CreateIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -1772,7 +2990,7 @@ func (m *DescribeIndexRequest) Reset()         { *m = DescribeIndexRequest{} }
 func (m *DescribeIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeIndexRequest) ProtoMessage()    {}
 func (*DescribeIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{27}
+	return fileDescriptor_02345ba45cc0e303, []int{48}
 }
 
 func (m *DescribeIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -1842,7 +3060,7 @@ func (m *IndexDescription) Reset()         { *m = IndexDescription{} }
 func (m *IndexDescription) String() string { return proto.CompactTextString(m) }
 func (*IndexDescription) ProtoMessage()    {}
 func (*IndexDescription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{28}
+	return fileDescriptor_02345ba45cc0e303, []int{49}
 }
 
 func (m *IndexDescription) XXX_Unmarshal(b []byte) error {
@@ -1903,7 +3121,7 @@ func (m *DescribeIndexResponse) Reset()         { *m = DescribeIndexResponse{} }
 func (m *DescribeIndexResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeIndexResponse) ProtoMessage()    {}
 func (*DescribeIndexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{29}
+	return fileDescriptor_02345ba45cc0e303, []int{50}
 }
 
 func (m *DescribeIndexResponse) XXX_Unmarshal(b []byte) error {
@@ -1953,7 +3171,7 @@ func (m *GetIndexBuildProgressRequest) Reset()         { *m = GetIndexBuildProgr
 func (m *GetIndexBuildProgressRequest) String() string { return proto.CompactTextString(m) }
 func (*GetIndexBuildProgressRequest) ProtoMessage()    {}
 func (*GetIndexBuildProgressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{30}
+	return fileDescriptor_02345ba45cc0e303, []int{51}
 }
 
 func (m *GetIndexBuildProgressRequest) XXX_Unmarshal(b []byte) error {
@@ -2022,7 +3240,7 @@ func (m *GetIndexBuildProgressResponse) Reset()         { *m = GetIndexBuildProg
 func (m *GetIndexBuildProgressResponse) String() string { return proto.CompactTextString(m) }
 func (*GetIndexBuildProgressResponse) ProtoMessage()    {}
 func (*GetIndexBuildProgressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{31}
+	return fileDescriptor_02345ba45cc0e303, []int{52}
 }
 
 func (m *GetIndexBuildProgressResponse) XXX_Unmarshal(b []byte) error {
@@ -2079,7 +3297,7 @@ func (m *GetIndexStateRequest) Reset()         { *m = GetIndexStateRequest{} }
 func (m *GetIndexStateRequest) String() string { return proto.CompactTextString(m) }
 func (*GetIndexStateRequest) ProtoMessage()    {}
 func (*GetIndexStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{32}
+	return fileDescriptor_02345ba45cc0e303, []int{53}
 }
 
 func (m *GetIndexStateRequest) XXX_Unmarshal(b []byte) error {
@@ -2148,7 +3366,7 @@ func (m *GetIndexStateResponse) Reset()         { *m = GetIndexStateResponse{} }
 func (m *GetIndexStateResponse) String() string { return proto.CompactTextString(m) }
 func (*GetIndexStateResponse) ProtoMessage()    {}
 func (*GetIndexStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{33}
+	return fileDescriptor_02345ba45cc0e303, []int{54}
 }
 
 func (m *GetIndexStateResponse) XXX_Unmarshal(b []byte) error {
@@ -2205,7 +3423,7 @@ func (m *DropIndexRequest) Reset()         { *m = DropIndexRequest{} }
 func (m *DropIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*DropIndexRequest) ProtoMessage()    {}
 func (*DropIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{34}
+	return fileDescriptor_02345ba45cc0e303, []int{55}
 }
 
 func (m *DropIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -2278,7 +3496,7 @@ func (m *InsertRequest) Reset()         { *m = InsertRequest{} }
 func (m *InsertRequest) String() string { return proto.CompactTextString(m) }
 func (*InsertRequest) ProtoMessage()    {}
 func (*InsertRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{35}
+	return fileDescriptor_02345ba45cc0e303, []int{56}
 }
 
 func (m *InsertRequest) XXX_Unmarshal(b []byte) error {
@@ -2348,6 +3566,79 @@ func (m *InsertRequest) GetNumRows() uint32 {
 	return 0
 }
 
+type InsertRowsRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName  string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	// each row is a JSON object keyed by field name, vectors are arrays of numbers,
+	// a binary vector is an array of its bytes
+	Rows                 [][]byte `protobuf:"bytes,5,rep,name=rows,proto3" json:"rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InsertRowsRequest) Reset()         { *m = InsertRowsRequest{} }
+func (m *InsertRowsRequest) String() string { return proto.CompactTextString(m) }
+func (*InsertRowsRequest) ProtoMessage()    {}
+func (*InsertRowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{57}
+}
+
+func (m *InsertRowsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InsertRowsRequest.Unmarshal(m, b)
+}
+func (m *InsertRowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InsertRowsRequest.Marshal(b, m, deterministic)
+}
+func (m *InsertRowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertRowsRequest.Merge(m, src)
+}
+func (m *InsertRowsRequest) XXX_Size() int {
+	return xxx_messageInfo_InsertRowsRequest.Size(m)
+}
+func (m *InsertRowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertRowsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsertRowsRequest proto.InternalMessageInfo
+
+func (m *InsertRowsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *InsertRowsRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *InsertRowsRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *InsertRowsRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *InsertRowsRequest) GetRows() [][]byte {
+	if m != nil {
+		return m.Rows
+	}
+	return nil
+}
+
 type MutationResult struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	IDs                  *schemapb.IDs    `protobuf:"bytes,2,opt,name=IDs,proto3" json:"IDs,omitempty"`
@@ -2358,6 +3649,7 @@ type MutationResult struct {
 	DeleteCnt            int64            `protobuf:"varint,7,opt,name=delete_cnt,json=deleteCnt,proto3" json:"delete_cnt,omitempty"`
 	UpsertCnt            int64            `protobuf:"varint,8,opt,name=upsert_cnt,json=upsertCnt,proto3" json:"upsert_cnt,omitempty"`
 	Timestamp            uint64           `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	ErrReasons           []string         `protobuf:"bytes,10,rep,name=err_reasons,json=errReasons,proto3" json:"err_reasons,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -2367,7 +3659,7 @@ func (m *MutationResult) Reset()         { *m = MutationResult{} }
 func (m *MutationResult) String() string { return proto.CompactTextString(m) }
 func (*MutationResult) ProtoMessage()    {}
 func (*MutationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{36}
+	return fileDescriptor_02345ba45cc0e303, []int{58}
 }
 
 func (m *MutationResult) XXX_Unmarshal(b []byte) error {
@@ -2451,6 +3743,13 @@ func (m *MutationResult) GetTimestamp() uint64 {
 	return 0
 }
 
+func (m *MutationResult) GetErrReasons() []string {
+	if m != nil {
+		return m.ErrReasons
+	}
+	return nil
+}
+
 type DeleteRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
@@ -2466,7 +3765,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{37}
+	return fileDescriptor_02345ba45cc0e303, []int{59}
 }
 
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
@@ -2522,6 +3821,94 @@ func (m *DeleteRequest) GetExpr() string {
 	return ""
 }
 
+// the entities are inserted, the existing entities with the same primary keys are deleted atomically
+type UpsertRequest struct {
+	Base                 *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string                `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	FieldsData           []*schemapb.FieldData `protobuf:"bytes,5,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	HashKeys             []uint32              `protobuf:"varint,6,rep,packed,name=hash_keys,json=hashKeys,proto3" json:"hash_keys,omitempty"`
+	NumRows              uint32                `protobuf:"varint,7,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UpsertRequest) Reset()         { *m = UpsertRequest{} }
+func (m *UpsertRequest) String() string { return proto.CompactTextString(m) }
+func (*UpsertRequest) ProtoMessage()    {}
+func (*UpsertRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{60}
+}
+
+func (m *UpsertRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertRequest.Unmarshal(m, b)
+}
+func (m *UpsertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertRequest.Marshal(b, m, deterministic)
+}
+func (m *UpsertRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertRequest.Merge(m, src)
+}
+func (m *UpsertRequest) XXX_Size() int {
+	return xxx_messageInfo_UpsertRequest.Size(m)
+}
+func (m *UpsertRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpsertRequest proto.InternalMessageInfo
+
+func (m *UpsertRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *UpsertRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *UpsertRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *UpsertRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *UpsertRequest) GetFieldsData() []*schemapb.FieldData {
+	if m != nil {
+		return m.FieldsData
+	}
+	return nil
+}
+
+func (m *UpsertRequest) GetHashKeys() []uint32 {
+	if m != nil {
+		return m.HashKeys
+	}
+	return nil
+}
+
+func (m *UpsertRequest) GetNumRows() uint32 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
 type PlaceholderValue struct {
 	Tag  string          `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
 	Type PlaceholderType `protobuf:"varint,2,opt,name=type,proto3,enum=milvus.proto.milvus.PlaceholderType" json:"type,omitempty"`
@@ -2536,7 +3923,7 @@ func (m *PlaceholderValue) Reset()         { *m = PlaceholderValue{} }
 func (m *PlaceholderValue) String() string { return proto.CompactTextString(m) }
 func (*PlaceholderValue) ProtoMessage()    {}
 func (*PlaceholderValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{38}
+	return fileDescriptor_02345ba45cc0e303, []int{61}
 }
 
 func (m *PlaceholderValue) XXX_Unmarshal(b []byte) error {
@@ -2589,7 +3976,7 @@ func (m *PlaceholderGroup) Reset()         { *m = PlaceholderGroup{} }
 func (m *PlaceholderGroup) String() string { return proto.CompactTextString(m) }
 func (*PlaceholderGroup) ProtoMessage()    {}
 func (*PlaceholderGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{39}
+	return fileDescriptor_02345ba45cc0e303, []int{62}
 }
 
 func (m *PlaceholderGroup) XXX_Unmarshal(b []byte) error {
@@ -2624,22 +4011,24 @@ type SearchRequest struct {
 	PartitionNames []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
 	Dsl            string            `protobuf:"bytes,5,opt,name=dsl,proto3" json:"dsl,omitempty"`
 	// serialized `PlaceholderGroup`
-	PlaceholderGroup     []byte                   `protobuf:"bytes,6,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
-	DslType              commonpb.DslType         `protobuf:"varint,7,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
-	OutputFields         []string                 `protobuf:"bytes,8,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
-	SearchParams         []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
-	TravelTimestamp      uint64                   `protobuf:"varint,10,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64                   `protobuf:"varint,11,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	PlaceholderGroup      []byte                    `protobuf:"bytes,6,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
+	DslType               commonpb.DslType          `protobuf:"varint,7,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
+	OutputFields          []string                  `protobuf:"bytes,8,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	SearchParams          []*commonpb.KeyValuePair  `protobuf:"bytes,9,rep,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
+	TravelTimestamp       uint64                    `protobuf:"varint,10,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp    uint64                    `protobuf:"varint,11,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	ConsistencyLevel      commonpb.ConsistencyLevel `protobuf:"varint,12,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	UseDefaultConsistency bool                      `protobuf:"varint,13,opt,name=use_default_consistency,json=useDefaultConsistency,proto3" json:"use_default_consistency,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                  `json:"-"`
+	XXX_unrecognized      []byte                    `json:"-"`
+	XXX_sizecache         int32                     `json:"-"`
 }
 
 func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
 func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
 func (*SearchRequest) ProtoMessage()    {}
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{40}
+	return fileDescriptor_02345ba45cc0e303, []int{63}
 }
 
 func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
@@ -2737,6 +4126,20 @@ func (m *SearchRequest) GetGuaranteeTimestamp() uint64 {
 	return 0
 }
 
+func (m *SearchRequest) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
+}
+
+func (m *SearchRequest) GetUseDefaultConsistency() bool {
+	if m != nil {
+		return m.UseDefaultConsistency
+	}
+	return false
+}
+
 type Hits struct {
 	IDs                  []int64   `protobuf:"varint,1,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
 	RowData              [][]byte  `protobuf:"bytes,2,rep,name=row_data,json=rowData,proto3" json:"row_data,omitempty"`
@@ -2750,7 +4153,7 @@ func (m *Hits) Reset()         { *m = Hits{} }
 func (m *Hits) String() string { return proto.CompactTextString(m) }
 func (*Hits) ProtoMessage()    {}
 func (*Hits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{41}
+	return fileDescriptor_02345ba45cc0e303, []int{64}
 }
 
 func (m *Hits) XXX_Unmarshal(b []byte) error {
@@ -2804,7 +4207,7 @@ func (m *SearchResults) Reset()         { *m = SearchResults{} }
 func (m *SearchResults) String() string { return proto.CompactTextString(m) }
 func (*SearchResults) ProtoMessage()    {}
 func (*SearchResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{42}
+	return fileDescriptor_02345ba45cc0e303, []int{65}
 }
 
 func (m *SearchResults) XXX_Unmarshal(b []byte) error {
@@ -2843,6 +4246,8 @@ type FlushRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	CollectionNames      []string          `protobuf:"bytes,3,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
+	Sync                 bool              `protobuf:"varint,4,opt,name=sync,proto3" json:"sync,omitempty"`
+	SyncTimeout          int64             `protobuf:"varint,5,opt,name=sync_timeout,json=syncTimeout,proto3" json:"sync_timeout,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -2852,7 +4257,7 @@ func (m *FlushRequest) Reset()         { *m = FlushRequest{} }
 func (m *FlushRequest) String() string { return proto.CompactTextString(m) }
 func (*FlushRequest) ProtoMessage()    {}
 func (*FlushRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{43}
+	return fileDescriptor_02345ba45cc0e303, []int{66}
 }
 
 func (m *FlushRequest) XXX_Unmarshal(b []byte) error {
@@ -2894,6 +4299,20 @@ func (m *FlushRequest) GetCollectionNames() []string {
 	return nil
 }
 
+func (m *FlushRequest) GetSync() bool {
+	if m != nil {
+		return m.Sync
+	}
+	return false
+}
+
+func (m *FlushRequest) GetSyncTimeout() int64 {
+	if m != nil {
+		return m.SyncTimeout
+	}
+	return 0
+}
+
 type FlushResponse struct {
 	Status               *commonpb.Status               `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	DbName               string                         `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
@@ -2907,7 +4326,7 @@ func (m *FlushResponse) Reset()         { *m = FlushResponse{} }
 func (m *FlushResponse) String() string { return proto.CompactTextString(m) }
 func (*FlushResponse) ProtoMessage()    {}
 func (*FlushResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{44}
+	return fileDescriptor_02345ba45cc0e303, []int{67}
 }
 
 func (m *FlushResponse) XXX_Unmarshal(b []byte) error {
@@ -2950,24 +4369,28 @@ func (m *FlushResponse) GetCollSegIDs() map[string]*schemapb.LongArray {
 }
 
 type QueryRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	Expr                 string            `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
-	OutputFields         []string          `protobuf:"bytes,5,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
-	PartitionNames       []string          `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	TravelTimestamp      uint64            `protobuf:"varint,7,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64            `protobuf:"varint,8,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base                  *commonpb.MsgBase         `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName                string                    `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName        string                    `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Expr                  string                    `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
+	OutputFields          []string                  `protobuf:"bytes,5,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	PartitionNames        []string                  `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	TravelTimestamp       uint64                    `protobuf:"varint,7,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp    uint64                    `protobuf:"varint,8,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	OrderBy               []*OrderByField           `protobuf:"bytes,9,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Limit                 int64                     `protobuf:"varint,10,opt,name=limit,proto3" json:"limit,omitempty"`
+	ConsistencyLevel      commonpb.ConsistencyLevel `protobuf:"varint,11,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	UseDefaultConsistency bool                      `protobuf:"varint,12,opt,name=use_default_consistency,json=useDefaultConsistency,proto3" json:"use_default_consistency,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                  `json:"-"`
+	XXX_unrecognized      []byte                    `json:"-"`
+	XXX_sizecache         int32                     `json:"-"`
 }
 
 func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
 func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRequest) ProtoMessage()    {}
 func (*QueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{45}
+	return fileDescriptor_02345ba45cc0e303, []int{68}
 }
 
 func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
@@ -3044,6 +4467,81 @@ func (m *QueryRequest) GetGuaranteeTimestamp() uint64 {
 	return 0
 }
 
+func (m *QueryRequest) GetOrderBy() []*OrderByField {
+	if m != nil {
+		return m.OrderBy
+	}
+	return nil
+}
+
+func (m *QueryRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *QueryRequest) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
+}
+
+func (m *QueryRequest) GetUseDefaultConsistency() bool {
+	if m != nil {
+		return m.UseDefaultConsistency
+	}
+	return false
+}
+
+type OrderByField struct {
+	FieldName            string   `protobuf:"bytes,1,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	Descending           bool     `protobuf:"varint,2,opt,name=descending,proto3" json:"descending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrderByField) Reset()         { *m = OrderByField{} }
+func (m *OrderByField) String() string { return proto.CompactTextString(m) }
+func (*OrderByField) ProtoMessage()    {}
+func (*OrderByField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{69}
+}
+
+func (m *OrderByField) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderByField.Unmarshal(m, b)
+}
+func (m *OrderByField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderByField.Marshal(b, m, deterministic)
+}
+func (m *OrderByField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderByField.Merge(m, src)
+}
+func (m *OrderByField) XXX_Size() int {
+	return xxx_messageInfo_OrderByField.Size(m)
+}
+func (m *OrderByField) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderByField.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderByField proto.InternalMessageInfo
+
+func (m *OrderByField) GetFieldName() string {
+	if m != nil {
+		return m.FieldName
+	}
+	return ""
+}
+
+func (m *OrderByField) GetDescending() bool {
+	if m != nil {
+		return m.Descending
+	}
+	return false
+}
+
 type QueryResults struct {
 	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	FieldsData           []*schemapb.FieldData `protobuf:"bytes,2,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
@@ -3056,7 +4554,7 @@ func (m *QueryResults) Reset()         { *m = QueryResults{} }
 func (m *QueryResults) String() string { return proto.CompactTextString(m) }
 func (*QueryResults) ProtoMessage()    {}
 func (*QueryResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{46}
+	return fileDescriptor_02345ba45cc0e303, []int{70}
 }
 
 func (m *QueryResults) XXX_Unmarshal(b []byte) error {
@@ -3105,7 +4603,7 @@ func (m *VectorIDs) Reset()         { *m = VectorIDs{} }
 func (m *VectorIDs) String() string { return proto.CompactTextString(m) }
 func (*VectorIDs) ProtoMessage()    {}
 func (*VectorIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{47}
+	return fileDescriptor_02345ba45cc0e303, []int{71}
 }
 
 func (m *VectorIDs) XXX_Unmarshal(b []byte) error {
@@ -3168,7 +4666,7 @@ func (m *VectorsArray) Reset()         { *m = VectorsArray{} }
 func (m *VectorsArray) String() string { return proto.CompactTextString(m) }
 func (*VectorsArray) ProtoMessage()    {}
 func (*VectorsArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{48}
+	return fileDescriptor_02345ba45cc0e303, []int{72}
 }
 
 func (m *VectorsArray) XXX_Unmarshal(b []byte) error {
@@ -3248,7 +4746,7 @@ func (m *CalcDistanceRequest) Reset()         { *m = CalcDistanceRequest{} }
 func (m *CalcDistanceRequest) String() string { return proto.CompactTextString(m) }
 func (*CalcDistanceRequest) ProtoMessage()    {}
 func (*CalcDistanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{49}
+	return fileDescriptor_02345ba45cc0e303, []int{73}
 }
 
 func (m *CalcDistanceRequest) XXX_Unmarshal(b []byte) error {
@@ -3314,7 +4812,7 @@ func (m *CalcDistanceResults) Reset()         { *m = CalcDistanceResults{} }
 func (m *CalcDistanceResults) String() string { return proto.CompactTextString(m) }
 func (*CalcDistanceResults) ProtoMessage()    {}
 func (*CalcDistanceResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{50}
+	return fileDescriptor_02345ba45cc0e303, []int{74}
 }
 
 func (m *CalcDistanceResults) XXX_Unmarshal(b []byte) error {
@@ -3387,175 +4885,499 @@ func (*CalcDistanceResults) XXX_OneofWrappers() []interface{} {
 	}
 }
 
-type PersistentSegmentInfo struct {
-	SegmentID            int64                 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	CollectionID         int64                 `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionID          int64                 `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	NumRows              int64                 `protobuf:"varint,4,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	State                commonpb.SegmentState `protobuf:"varint,5,opt,name=state,proto3,enum=milvus.proto.common.SegmentState" json:"state,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type PersistentSegmentInfo struct {
+	SegmentID            int64                 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	CollectionID         int64                 `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64                 `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	NumRows              int64                 `protobuf:"varint,4,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	State                commonpb.SegmentState `protobuf:"varint,5,opt,name=state,proto3,enum=milvus.proto.common.SegmentState" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PersistentSegmentInfo) Reset()         { *m = PersistentSegmentInfo{} }
+func (m *PersistentSegmentInfo) String() string { return proto.CompactTextString(m) }
+func (*PersistentSegmentInfo) ProtoMessage()    {}
+func (*PersistentSegmentInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{75}
+}
+
+func (m *PersistentSegmentInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PersistentSegmentInfo.Unmarshal(m, b)
+}
+func (m *PersistentSegmentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PersistentSegmentInfo.Marshal(b, m, deterministic)
+}
+func (m *PersistentSegmentInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PersistentSegmentInfo.Merge(m, src)
+}
+func (m *PersistentSegmentInfo) XXX_Size() int {
+	return xxx_messageInfo_PersistentSegmentInfo.Size(m)
+}
+func (m *PersistentSegmentInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PersistentSegmentInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PersistentSegmentInfo proto.InternalMessageInfo
+
+func (m *PersistentSegmentInfo) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *PersistentSegmentInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *PersistentSegmentInfo) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *PersistentSegmentInfo) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *PersistentSegmentInfo) GetState() commonpb.SegmentState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.SegmentState_SegmentStateNone
+}
+
+type GetPersistentSegmentInfoRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collectionName,proto3" json:"collectionName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetPersistentSegmentInfoRequest) Reset()         { *m = GetPersistentSegmentInfoRequest{} }
+func (m *GetPersistentSegmentInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPersistentSegmentInfoRequest) ProtoMessage()    {}
+func (*GetPersistentSegmentInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{76}
+}
+
+func (m *GetPersistentSegmentInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Unmarshal(m, b)
+}
+func (m *GetPersistentSegmentInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPersistentSegmentInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPersistentSegmentInfoRequest.Merge(m, src)
+}
+func (m *GetPersistentSegmentInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Size(m)
+}
+func (m *GetPersistentSegmentInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPersistentSegmentInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPersistentSegmentInfoRequest proto.InternalMessageInfo
+
+func (m *GetPersistentSegmentInfoRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetPersistentSegmentInfoRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *GetPersistentSegmentInfoRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+type GetPersistentSegmentInfoResponse struct {
+	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Infos                []*PersistentSegmentInfo `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetPersistentSegmentInfoResponse) Reset()         { *m = GetPersistentSegmentInfoResponse{} }
+func (m *GetPersistentSegmentInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPersistentSegmentInfoResponse) ProtoMessage()    {}
+func (*GetPersistentSegmentInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{77}
+}
+
+func (m *GetPersistentSegmentInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Unmarshal(m, b)
+}
+func (m *GetPersistentSegmentInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPersistentSegmentInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPersistentSegmentInfoResponse.Merge(m, src)
+}
+func (m *GetPersistentSegmentInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Size(m)
+}
+func (m *GetPersistentSegmentInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPersistentSegmentInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPersistentSegmentInfoResponse proto.InternalMessageInfo
+
+func (m *GetPersistentSegmentInfoResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetPersistentSegmentInfoResponse) GetInfos() []*PersistentSegmentInfo {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+type GetFlushStateRequest struct {
+	SegmentIDs []int64 `protobuf:"varint,1,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	DbName     string  `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// the collection of the segments, whose flush privilege is checked
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// the id of the collection set by the proxy, the segments of other collections are rejected
+	CollectionID         int64    `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetFlushStateRequest) Reset()         { *m = GetFlushStateRequest{} }
+func (m *GetFlushStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFlushStateRequest) ProtoMessage()    {}
+func (*GetFlushStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{78}
+}
+
+func (m *GetFlushStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFlushStateRequest.Unmarshal(m, b)
+}
+func (m *GetFlushStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFlushStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetFlushStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFlushStateRequest.Merge(m, src)
+}
+func (m *GetFlushStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetFlushStateRequest.Size(m)
+}
+func (m *GetFlushStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFlushStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFlushStateRequest proto.InternalMessageInfo
+
+func (m *GetFlushStateRequest) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+func (m *GetFlushStateRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *GetFlushStateRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *GetFlushStateRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type GetFlushStateResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Flushed              bool             `protobuf:"varint,2,opt,name=flushed,proto3" json:"flushed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetFlushStateResponse) Reset()         { *m = GetFlushStateResponse{} }
+func (m *GetFlushStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFlushStateResponse) ProtoMessage()    {}
+func (*GetFlushStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{79}
+}
+
+func (m *GetFlushStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFlushStateResponse.Unmarshal(m, b)
+}
+func (m *GetFlushStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFlushStateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetFlushStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFlushStateResponse.Merge(m, src)
+}
+func (m *GetFlushStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetFlushStateResponse.Size(m)
+}
+func (m *GetFlushStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFlushStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFlushStateResponse proto.InternalMessageInfo
+
+func (m *GetFlushStateResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetFlushStateResponse) GetFlushed() bool {
+	if m != nil {
+		return m.Flushed
+	}
+	return false
+}
+
+// position of a dml channel to resume a change subscription from
+type ChangePosition struct {
+	ChannelName          string   `protobuf:"bytes,1,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	Position             []byte   `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChangePosition) Reset()         { *m = ChangePosition{} }
+func (m *ChangePosition) String() string { return proto.CompactTextString(m) }
+func (*ChangePosition) ProtoMessage()    {}
+func (*ChangePosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{80}
+}
+
+func (m *ChangePosition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangePosition.Unmarshal(m, b)
+}
+func (m *ChangePosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangePosition.Marshal(b, m, deterministic)
+}
+func (m *ChangePosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePosition.Merge(m, src)
+}
+func (m *ChangePosition) XXX_Size() int {
+	return xxx_messageInfo_ChangePosition.Size(m)
+}
+func (m *ChangePosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangePosition proto.InternalMessageInfo
+
+func (m *ChangePosition) GetChannelName() string {
+	if m != nil {
+		return m.ChannelName
+	}
+	return ""
+}
+
+func (m *ChangePosition) GetPosition() []byte {
+	if m != nil {
+		return m.Position
+	}
+	return nil
 }
 
-func (m *PersistentSegmentInfo) Reset()         { *m = PersistentSegmentInfo{} }
-func (m *PersistentSegmentInfo) String() string { return proto.CompactTextString(m) }
-func (*PersistentSegmentInfo) ProtoMessage()    {}
-func (*PersistentSegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{51}
+type SubscribeChangesRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// positions received last, the subscription resumes after them
+	Positions []*ChangePosition `protobuf:"bytes,4,rep,name=positions,proto3" json:"positions,omitempty"`
+	// changes after the timestamp are sent on the channels without position, 0 means the changes from now on
+	StartTimestamp       uint64   `protobuf:"varint,5,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PersistentSegmentInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PersistentSegmentInfo.Unmarshal(m, b)
+func (m *SubscribeChangesRequest) Reset()         { *m = SubscribeChangesRequest{} }
+func (m *SubscribeChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeChangesRequest) ProtoMessage()    {}
+func (*SubscribeChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{81}
 }
-func (m *PersistentSegmentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PersistentSegmentInfo.Marshal(b, m, deterministic)
+
+func (m *SubscribeChangesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeChangesRequest.Unmarshal(m, b)
 }
-func (m *PersistentSegmentInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PersistentSegmentInfo.Merge(m, src)
+func (m *SubscribeChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeChangesRequest.Marshal(b, m, deterministic)
 }
-func (m *PersistentSegmentInfo) XXX_Size() int {
-	return xxx_messageInfo_PersistentSegmentInfo.Size(m)
+func (m *SubscribeChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeChangesRequest.Merge(m, src)
 }
-func (m *PersistentSegmentInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_PersistentSegmentInfo.DiscardUnknown(m)
+func (m *SubscribeChangesRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeChangesRequest.Size(m)
+}
+func (m *SubscribeChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeChangesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PersistentSegmentInfo proto.InternalMessageInfo
+var xxx_messageInfo_SubscribeChangesRequest proto.InternalMessageInfo
 
-func (m *PersistentSegmentInfo) GetSegmentID() int64 {
+func (m *SubscribeChangesRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.SegmentID
+		return m.Base
 	}
-	return 0
+	return nil
 }
 
-func (m *PersistentSegmentInfo) GetCollectionID() int64 {
+func (m *SubscribeChangesRequest) GetDbName() string {
 	if m != nil {
-		return m.CollectionID
+		return m.DbName
 	}
-	return 0
+	return ""
 }
 
-func (m *PersistentSegmentInfo) GetPartitionID() int64 {
+func (m *SubscribeChangesRequest) GetCollectionName() string {
 	if m != nil {
-		return m.PartitionID
+		return m.CollectionName
 	}
-	return 0
+	return ""
 }
 
-func (m *PersistentSegmentInfo) GetNumRows() int64 {
+func (m *SubscribeChangesRequest) GetPositions() []*ChangePosition {
 	if m != nil {
-		return m.NumRows
+		return m.Positions
 	}
-	return 0
+	return nil
 }
 
-func (m *PersistentSegmentInfo) GetState() commonpb.SegmentState {
+func (m *SubscribeChangesRequest) GetStartTimestamp() uint64 {
 	if m != nil {
-		return m.State
+		return m.StartTimestamp
 	}
-	return commonpb.SegmentState_SegmentStateNone
+	return 0
 }
 
-type GetPersistentSegmentInfoRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collectionName,proto3" json:"collectionName,omitempty"`
+type ChangeEvent struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Insert or Delete
+	ChangeType    commonpb.MsgType `protobuf:"varint,2,opt,name=change_type,json=changeType,proto3,enum=milvus.proto.common.MsgType" json:"change_type,omitempty"`
+	PartitionName string           `protobuf:"bytes,3,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	// entities inserted
+	FieldsData []*schemapb.FieldData `protobuf:"bytes,4,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	// primary keys of the entities inserted or deleted
+	PrimaryKeys *schemapb.IDs `protobuf:"bytes,5,opt,name=primary_keys,json=primaryKeys,proto3" json:"primary_keys,omitempty"`
+	Timestamps  []uint64      `protobuf:"varint,6,rep,packed,name=timestamps,proto3" json:"timestamps,omitempty"`
+	// set on the last event of a batch, the subscription resumes after the batch from them
+	Positions            []*ChangePosition `protobuf:"bytes,7,rep,name=positions,proto3" json:"positions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *GetPersistentSegmentInfoRequest) Reset()         { *m = GetPersistentSegmentInfoRequest{} }
-func (m *GetPersistentSegmentInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPersistentSegmentInfoRequest) ProtoMessage()    {}
-func (*GetPersistentSegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{52}
+func (m *ChangeEvent) Reset()         { *m = ChangeEvent{} }
+func (m *ChangeEvent) String() string { return proto.CompactTextString(m) }
+func (*ChangeEvent) ProtoMessage()    {}
+func (*ChangeEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{82}
 }
 
-func (m *GetPersistentSegmentInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Unmarshal(m, b)
+func (m *ChangeEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangeEvent.Unmarshal(m, b)
 }
-func (m *GetPersistentSegmentInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Marshal(b, m, deterministic)
+func (m *ChangeEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangeEvent.Marshal(b, m, deterministic)
 }
-func (m *GetPersistentSegmentInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPersistentSegmentInfoRequest.Merge(m, src)
+func (m *ChangeEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeEvent.Merge(m, src)
 }
-func (m *GetPersistentSegmentInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Size(m)
+func (m *ChangeEvent) XXX_Size() int {
+	return xxx_messageInfo_ChangeEvent.Size(m)
 }
-func (m *GetPersistentSegmentInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPersistentSegmentInfoRequest.DiscardUnknown(m)
+func (m *ChangeEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPersistentSegmentInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_ChangeEvent proto.InternalMessageInfo
 
-func (m *GetPersistentSegmentInfoRequest) GetBase() *commonpb.MsgBase {
+func (m *ChangeEvent) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.Base
+		return m.Status
 	}
 	return nil
 }
 
-func (m *GetPersistentSegmentInfoRequest) GetDbName() string {
+func (m *ChangeEvent) GetChangeType() commonpb.MsgType {
 	if m != nil {
-		return m.DbName
+		return m.ChangeType
 	}
-	return ""
+	return commonpb.MsgType_Undefined
 }
 
-func (m *GetPersistentSegmentInfoRequest) GetCollectionName() string {
+func (m *ChangeEvent) GetPartitionName() string {
 	if m != nil {
-		return m.CollectionName
+		return m.PartitionName
 	}
 	return ""
 }
 
-type GetPersistentSegmentInfoResponse struct {
-	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Infos                []*PersistentSegmentInfo `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
-}
-
-func (m *GetPersistentSegmentInfoResponse) Reset()         { *m = GetPersistentSegmentInfoResponse{} }
-func (m *GetPersistentSegmentInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPersistentSegmentInfoResponse) ProtoMessage()    {}
-func (*GetPersistentSegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{53}
+func (m *ChangeEvent) GetFieldsData() []*schemapb.FieldData {
+	if m != nil {
+		return m.FieldsData
+	}
+	return nil
 }
 
-func (m *GetPersistentSegmentInfoResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Unmarshal(m, b)
-}
-func (m *GetPersistentSegmentInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Marshal(b, m, deterministic)
-}
-func (m *GetPersistentSegmentInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPersistentSegmentInfoResponse.Merge(m, src)
-}
-func (m *GetPersistentSegmentInfoResponse) XXX_Size() int {
-	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Size(m)
-}
-func (m *GetPersistentSegmentInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPersistentSegmentInfoResponse.DiscardUnknown(m)
+func (m *ChangeEvent) GetPrimaryKeys() *schemapb.IDs {
+	if m != nil {
+		return m.PrimaryKeys
+	}
+	return nil
 }
 
-var xxx_messageInfo_GetPersistentSegmentInfoResponse proto.InternalMessageInfo
-
-func (m *GetPersistentSegmentInfoResponse) GetStatus() *commonpb.Status {
+func (m *ChangeEvent) GetTimestamps() []uint64 {
 	if m != nil {
-		return m.Status
+		return m.Timestamps
 	}
 	return nil
 }
 
-func (m *GetPersistentSegmentInfoResponse) GetInfos() []*PersistentSegmentInfo {
+func (m *ChangeEvent) GetPositions() []*ChangePosition {
 	if m != nil {
-		return m.Infos
+		return m.Positions
 	}
 	return nil
 }
@@ -3577,7 +5399,7 @@ func (m *QuerySegmentInfo) Reset()         { *m = QuerySegmentInfo{} }
 func (m *QuerySegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*QuerySegmentInfo) ProtoMessage()    {}
 func (*QuerySegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{54}
+	return fileDescriptor_02345ba45cc0e303, []int{83}
 }
 
 func (m *QuerySegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -3660,7 +5482,7 @@ func (m *GetQuerySegmentInfoRequest) Reset()         { *m = GetQuerySegmentInfoR
 func (m *GetQuerySegmentInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetQuerySegmentInfoRequest) ProtoMessage()    {}
 func (*GetQuerySegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{55}
+	return fileDescriptor_02345ba45cc0e303, []int{84}
 }
 
 func (m *GetQuerySegmentInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -3714,7 +5536,7 @@ func (m *GetQuerySegmentInfoResponse) Reset()         { *m = GetQuerySegmentInfo
 func (m *GetQuerySegmentInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetQuerySegmentInfoResponse) ProtoMessage()    {}
 func (*GetQuerySegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{56}
+	return fileDescriptor_02345ba45cc0e303, []int{85}
 }
 
 func (m *GetQuerySegmentInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -3760,7 +5582,7 @@ func (m *DummyRequest) Reset()         { *m = DummyRequest{} }
 func (m *DummyRequest) String() string { return proto.CompactTextString(m) }
 func (*DummyRequest) ProtoMessage()    {}
 func (*DummyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{57}
+	return fileDescriptor_02345ba45cc0e303, []int{86}
 }
 
 func (m *DummyRequest) XXX_Unmarshal(b []byte) error {
@@ -3799,7 +5621,7 @@ func (m *DummyResponse) Reset()         { *m = DummyResponse{} }
 func (m *DummyResponse) String() string { return proto.CompactTextString(m) }
 func (*DummyResponse) ProtoMessage()    {}
 func (*DummyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{58}
+	return fileDescriptor_02345ba45cc0e303, []int{87}
 }
 
 func (m *DummyResponse) XXX_Unmarshal(b []byte) error {
@@ -3837,7 +5659,7 @@ func (m *RegisterLinkRequest) Reset()         { *m = RegisterLinkRequest{} }
 func (m *RegisterLinkRequest) String() string { return proto.CompactTextString(m) }
 func (*RegisterLinkRequest) ProtoMessage()    {}
 func (*RegisterLinkRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{59}
+	return fileDescriptor_02345ba45cc0e303, []int{88}
 }
 
 func (m *RegisterLinkRequest) XXX_Unmarshal(b []byte) error {
@@ -3870,7 +5692,7 @@ func (m *RegisterLinkResponse) Reset()         { *m = RegisterLinkResponse{} }
 func (m *RegisterLinkResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisterLinkResponse) ProtoMessage()    {}
 func (*RegisterLinkResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{60}
+	return fileDescriptor_02345ba45cc0e303, []int{89}
 }
 
 func (m *RegisterLinkResponse) XXX_Unmarshal(b []byte) error {
@@ -3917,7 +5739,7 @@ func (m *GetMetricsRequest) Reset()         { *m = GetMetricsRequest{} }
 func (m *GetMetricsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMetricsRequest) ProtoMessage()    {}
 func (*GetMetricsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{61}
+	return fileDescriptor_02345ba45cc0e303, []int{90}
 }
 
 func (m *GetMetricsRequest) XXX_Unmarshal(b []byte) error {
@@ -3965,7 +5787,7 @@ func (m *GetMetricsResponse) Reset()         { *m = GetMetricsResponse{} }
 func (m *GetMetricsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMetricsResponse) ProtoMessage()    {}
 func (*GetMetricsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{62}
+	return fileDescriptor_02345ba45cc0e303, []int{91}
 }
 
 func (m *GetMetricsResponse) XXX_Unmarshal(b []byte) error {
@@ -4009,6 +5831,8 @@ func (m *GetMetricsResponse) GetComponentName() string {
 
 func init() {
 	proto.RegisterEnum("milvus.proto.milvus.ShowType", ShowType_name, ShowType_value)
+	proto.RegisterEnum("milvus.proto.milvus.OperateUserRoleType", OperateUserRoleType_name, OperateUserRoleType_value)
+	proto.RegisterEnum("milvus.proto.milvus.OperatePrivilegeType", OperatePrivilegeType_name, OperatePrivilegeType_value)
 	proto.RegisterEnum("milvus.proto.milvus.PlaceholderType", PlaceholderType_name, PlaceholderType_value)
 	proto.RegisterType((*CreateCollectionRequest)(nil), "milvus.proto.milvus.CreateCollectionRequest")
 	proto.RegisterType((*DropCollectionRequest)(nil), "milvus.proto.milvus.DropCollectionRequest")
@@ -4023,6 +5847,22 @@ func init() {
 	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.milvus.GetCollectionStatisticsResponse")
 	proto.RegisterType((*ShowCollectionsRequest)(nil), "milvus.proto.milvus.ShowCollectionsRequest")
 	proto.RegisterType((*ShowCollectionsResponse)(nil), "milvus.proto.milvus.ShowCollectionsResponse")
+	proto.RegisterType((*LoadedFields)(nil), "milvus.proto.milvus.LoadedFields")
+	proto.RegisterType((*CreateDatabaseRequest)(nil), "milvus.proto.milvus.CreateDatabaseRequest")
+	proto.RegisterType((*DropDatabaseRequest)(nil), "milvus.proto.milvus.DropDatabaseRequest")
+	proto.RegisterType((*ListDatabasesRequest)(nil), "milvus.proto.milvus.ListDatabasesRequest")
+	proto.RegisterType((*ListDatabasesResponse)(nil), "milvus.proto.milvus.ListDatabasesResponse")
+	proto.RegisterType((*CreateCredentialRequest)(nil), "milvus.proto.milvus.CreateCredentialRequest")
+	proto.RegisterType((*DeleteCredentialRequest)(nil), "milvus.proto.milvus.DeleteCredentialRequest")
+	proto.RegisterType((*ListCredUsersRequest)(nil), "milvus.proto.milvus.ListCredUsersRequest")
+	proto.RegisterType((*ListCredUsersResponse)(nil), "milvus.proto.milvus.ListCredUsersResponse")
+	proto.RegisterType((*CreateRoleRequest)(nil), "milvus.proto.milvus.CreateRoleRequest")
+	proto.RegisterType((*DropRoleRequest)(nil), "milvus.proto.milvus.DropRoleRequest")
+	proto.RegisterType((*OperateUserRoleRequest)(nil), "milvus.proto.milvus.OperateUserRoleRequest")
+	proto.RegisterType((*GrantEntity)(nil), "milvus.proto.milvus.GrantEntity")
+	proto.RegisterType((*OperatePrivilegeRequest)(nil), "milvus.proto.milvus.OperatePrivilegeRequest")
+	proto.RegisterType((*SelectGrantRequest)(nil), "milvus.proto.milvus.SelectGrantRequest")
+	proto.RegisterType((*SelectGrantResponse)(nil), "milvus.proto.milvus.SelectGrantResponse")
 	proto.RegisterType((*CreatePartitionRequest)(nil), "milvus.proto.milvus.CreatePartitionRequest")
 	proto.RegisterType((*DropPartitionRequest)(nil), "milvus.proto.milvus.DropPartitionRequest")
 	proto.RegisterType((*HasPartitionRequest)(nil), "milvus.proto.milvus.HasPartitionRequest")
@@ -4032,6 +5872,11 @@ func init() {
 	proto.RegisterType((*GetPartitionStatisticsResponse)(nil), "milvus.proto.milvus.GetPartitionStatisticsResponse")
 	proto.RegisterType((*ShowPartitionsRequest)(nil), "milvus.proto.milvus.ShowPartitionsRequest")
 	proto.RegisterType((*ShowPartitionsResponse)(nil), "milvus.proto.milvus.ShowPartitionsResponse")
+	proto.RegisterType((*GetLoadingProgressRequest)(nil), "milvus.proto.milvus.GetLoadingProgressRequest")
+	proto.RegisterType((*LoadingProgress)(nil), "milvus.proto.milvus.LoadingProgress")
+	proto.RegisterType((*PartitionLoadingProgress)(nil), "milvus.proto.milvus.PartitionLoadingProgress")
+	proto.RegisterType((*NodeLoadingProgress)(nil), "milvus.proto.milvus.NodeLoadingProgress")
+	proto.RegisterType((*GetLoadingProgressResponse)(nil), "milvus.proto.milvus.GetLoadingProgressResponse")
 	proto.RegisterType((*DescribeSegmentRequest)(nil), "milvus.proto.milvus.DescribeSegmentRequest")
 	proto.RegisterType((*DescribeSegmentResponse)(nil), "milvus.proto.milvus.DescribeSegmentResponse")
 	proto.RegisterType((*ShowSegmentsRequest)(nil), "milvus.proto.milvus.ShowSegmentsRequest")
@@ -4046,8 +5891,10 @@ func init() {
 	proto.RegisterType((*GetIndexStateResponse)(nil), "milvus.proto.milvus.GetIndexStateResponse")
 	proto.RegisterType((*DropIndexRequest)(nil), "milvus.proto.milvus.DropIndexRequest")
 	proto.RegisterType((*InsertRequest)(nil), "milvus.proto.milvus.InsertRequest")
+	proto.RegisterType((*InsertRowsRequest)(nil), "milvus.proto.milvus.InsertRowsRequest")
 	proto.RegisterType((*MutationResult)(nil), "milvus.proto.milvus.MutationResult")
 	proto.RegisterType((*DeleteRequest)(nil), "milvus.proto.milvus.DeleteRequest")
+	proto.RegisterType((*UpsertRequest)(nil), "milvus.proto.milvus.UpsertRequest")
 	proto.RegisterType((*PlaceholderValue)(nil), "milvus.proto.milvus.PlaceholderValue")
 	proto.RegisterType((*PlaceholderGroup)(nil), "milvus.proto.milvus.PlaceholderGroup")
 	proto.RegisterType((*SearchRequest)(nil), "milvus.proto.milvus.SearchRequest")
@@ -4057,6 +5904,7 @@ func init() {
 	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.milvus.FlushResponse")
 	proto.RegisterMapType((map[string]*schemapb.LongArray)(nil), "milvus.proto.milvus.FlushResponse.CollSegIDsEntry")
 	proto.RegisterType((*QueryRequest)(nil), "milvus.proto.milvus.QueryRequest")
+	proto.RegisterType((*OrderByField)(nil), "milvus.proto.milvus.OrderByField")
 	proto.RegisterType((*QueryResults)(nil), "milvus.proto.milvus.QueryResults")
 	proto.RegisterType((*VectorIDs)(nil), "milvus.proto.milvus.VectorIDs")
 	proto.RegisterType((*VectorsArray)(nil), "milvus.proto.milvus.VectorsArray")
@@ -4065,6 +5913,11 @@ func init() {
 	proto.RegisterType((*PersistentSegmentInfo)(nil), "milvus.proto.milvus.PersistentSegmentInfo")
 	proto.RegisterType((*GetPersistentSegmentInfoRequest)(nil), "milvus.proto.milvus.GetPersistentSegmentInfoRequest")
 	proto.RegisterType((*GetPersistentSegmentInfoResponse)(nil), "milvus.proto.milvus.GetPersistentSegmentInfoResponse")
+	proto.RegisterType((*GetFlushStateRequest)(nil), "milvus.proto.milvus.GetFlushStateRequest")
+	proto.RegisterType((*GetFlushStateResponse)(nil), "milvus.proto.milvus.GetFlushStateResponse")
+	proto.RegisterType((*ChangePosition)(nil), "milvus.proto.milvus.ChangePosition")
+	proto.RegisterType((*SubscribeChangesRequest)(nil), "milvus.proto.milvus.SubscribeChangesRequest")
+	proto.RegisterType((*ChangeEvent)(nil), "milvus.proto.milvus.ChangeEvent")
 	proto.RegisterType((*QuerySegmentInfo)(nil), "milvus.proto.milvus.QuerySegmentInfo")
 	proto.RegisterType((*GetQuerySegmentInfoRequest)(nil), "milvus.proto.milvus.GetQuerySegmentInfoRequest")
 	proto.RegisterType((*GetQuerySegmentInfoResponse)(nil), "milvus.proto.milvus.GetQuerySegmentInfoResponse")
@@ -4079,196 +5932,279 @@ func init() {
 func init() { proto.RegisterFile("milvus.proto", fileDescriptor_02345ba45cc0e303) }
 
 var fileDescriptor_02345ba45cc0e303 = []byte{
-	// 3022 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0x4b, 0x6f, 0x1c, 0xc7,
-	0xd1, 0x9c, 0x5d, 0xee, 0xab, 0x76, 0x96, 0x5c, 0x35, 0x1f, 0x5a, 0xaf, 0x25, 0x8b, 0x1c, 0x7f,
-	0xb2, 0x29, 0xc9, 0x96, 0x2c, 0xca, 0xfe, 0xec, 0xcf, 0xfe, 0x12, 0x5b, 0x12, 0x63, 0x89, 0xb0,
-	0xe4, 0xd0, 0x43, 0xdb, 0x80, 0x63, 0x18, 0x83, 0xe1, 0x4e, 0x73, 0x39, 0xe0, 0xec, 0xcc, 0x66,
-	0xba, 0x57, 0xd4, 0xfa, 0x14, 0xc0, 0x49, 0x80, 0xc0, 0x8e, 0x8d, 0x20, 0x41, 0x82, 0x5c, 0x93,
-	0xf8, 0x90, 0x5b, 0x9c, 0x04, 0x48, 0x90, 0x43, 0x90, 0x83, 0x0f, 0x39, 0x04, 0xc8, 0xe3, 0x90,
-	0x73, 0x2e, 0x39, 0xfa, 0x1f, 0x04, 0x48, 0xd0, 0x8f, 0x99, 0x9d, 0x59, 0xf6, 0x2c, 0x97, 0x5a,
-	0x3b, 0x24, 0x6f, 0x33, 0xd5, 0x55, 0xdd, 0x55, 0xd5, 0xd5, 0xd5, 0x55, 0x5d, 0x05, 0x7a, 0xc7,
-	0xf5, 0xee, 0xf5, 0xc8, 0xe5, 0x6e, 0x18, 0xd0, 0x00, 0xcd, 0x25, 0xff, 0x2e, 0x8b, 0x9f, 0xa6,
-	0xde, 0x0a, 0x3a, 0x9d, 0xc0, 0x17, 0xc0, 0xa6, 0x4e, 0x5a, 0x3b, 0xb8, 0x63, 0x8b, 0x3f, 0xe3,
-	0x53, 0x0d, 0x4e, 0xdf, 0x0c, 0xb1, 0x4d, 0xf1, 0xcd, 0xc0, 0xf3, 0x70, 0x8b, 0xba, 0x81, 0x6f,
-	0xe2, 0xaf, 0xf7, 0x30, 0xa1, 0xe8, 0x29, 0x98, 0xde, 0xb2, 0x09, 0x6e, 0x68, 0x4b, 0xda, 0x4a,
-	0x75, 0xf5, 0xcc, 0xe5, 0xd4, 0xdc, 0x72, 0xce, 0xbb, 0xa4, 0x7d, 0xc3, 0x26, 0xd8, 0xe4, 0x98,
-	0xe8, 0x34, 0x94, 0x9c, 0x2d, 0xcb, 0xb7, 0x3b, 0xb8, 0x91, 0x5b, 0xd2, 0x56, 0x2a, 0x66, 0xd1,
-	0xd9, 0x7a, 0xd5, 0xee, 0x60, 0xf4, 0x38, 0xcc, 0xb6, 0xe2, 0xf9, 0x05, 0x42, 0x9e, 0x23, 0xcc,
-	0x0c, 0xc0, 0x1c, 0x71, 0x11, 0x8a, 0x82, 0xbf, 0xc6, 0xf4, 0x92, 0xb6, 0xa2, 0x9b, 0xf2, 0x0f,
-	0x9d, 0x05, 0x20, 0x3b, 0x76, 0xe8, 0x10, 0xcb, 0xef, 0x75, 0x1a, 0x85, 0x25, 0x6d, 0xa5, 0x60,
-	0x56, 0x04, 0xe4, 0xd5, 0x5e, 0xc7, 0x78, 0x5f, 0x83, 0x85, 0xb5, 0x30, 0xe8, 0x1e, 0x0b, 0x21,
-	0x8c, 0x9f, 0x6b, 0x30, 0x7f, 0xdb, 0x26, 0xc7, 0x43, 0xa3, 0x67, 0x01, 0xa8, 0xdb, 0xc1, 0x16,
-	0xa1, 0x76, 0xa7, 0xcb, 0xb5, 0x3a, 0x6d, 0x56, 0x18, 0x64, 0x93, 0x01, 0x8c, 0xb7, 0x40, 0xbf,
-	0x11, 0x04, 0x9e, 0x89, 0x49, 0x37, 0xf0, 0x09, 0x46, 0xd7, 0xa0, 0x48, 0xa8, 0x4d, 0x7b, 0x44,
-	0x32, 0xf9, 0xb0, 0x92, 0xc9, 0x4d, 0x8e, 0x62, 0x4a, 0x54, 0x34, 0x0f, 0x85, 0x7b, 0xb6, 0xd7,
-	0x13, 0x3c, 0x96, 0x4d, 0xf1, 0x63, 0xbc, 0x0d, 0x33, 0x9b, 0x34, 0x74, 0xfd, 0xf6, 0xe7, 0x38,
-	0x79, 0x25, 0x9a, 0xfc, 0x6f, 0x1a, 0x3c, 0xb4, 0x86, 0x49, 0x2b, 0x74, 0xb7, 0x8e, 0x89, 0xe9,
-	0x1a, 0xa0, 0x0f, 0x20, 0xeb, 0x6b, 0x5c, 0xd5, 0x79, 0x33, 0x05, 0x1b, 0xda, 0x8c, 0xc2, 0xf0,
-	0x66, 0xfc, 0x3b, 0x07, 0x4d, 0x95, 0x50, 0x93, 0xa8, 0xef, 0x4b, 0xf1, 0x89, 0xca, 0x71, 0xa2,
-	0xf3, 0x69, 0x22, 0xe9, 0x0d, 0x06, 0xab, 0x6d, 0x72, 0x40, 0x7c, 0xf0, 0x86, 0xa5, 0xca, 0x2b,
-	0xa4, 0x5a, 0x85, 0x85, 0x7b, 0x6e, 0x48, 0x7b, 0xb6, 0x67, 0xb5, 0x76, 0x6c, 0xdf, 0xc7, 0x1e,
-	0xd7, 0x13, 0x69, 0x4c, 0x2f, 0xe5, 0x57, 0x2a, 0xe6, 0x9c, 0x1c, 0xbc, 0x29, 0xc6, 0x98, 0xb2,
-	0x08, 0x7a, 0x1a, 0x16, 0xbb, 0x3b, 0x7d, 0xe2, 0xb6, 0xf6, 0x11, 0x15, 0x38, 0xd1, 0x7c, 0x34,
-	0x9a, 0xa2, 0xba, 0x04, 0xa7, 0x5a, 0xdc, 0x5b, 0x39, 0x16, 0xd3, 0x9a, 0x50, 0x63, 0x91, 0xab,
-	0xb1, 0x2e, 0x07, 0x5e, 0x8f, 0xe0, 0x8c, 0xad, 0x08, 0xb9, 0x47, 0x5b, 0x09, 0x82, 0x12, 0x27,
-	0x98, 0x93, 0x83, 0x6f, 0xd0, 0x56, 0x4c, 0xc3, 0x1d, 0xc9, 0x9d, 0xc0, 0x76, 0x8e, 0x87, 0x23,
-	0xf9, 0x50, 0x83, 0x86, 0x89, 0x3d, 0x6c, 0x93, 0xe3, 0x61, 0xe3, 0xc6, 0x0f, 0x34, 0x78, 0xe4,
-	0x16, 0xa6, 0x09, 0x6b, 0xa1, 0x36, 0x75, 0x09, 0x75, 0x5b, 0xe4, 0x28, 0xd9, 0xfa, 0x48, 0x83,
-	0x73, 0x99, 0x6c, 0x4d, 0x72, 0x78, 0x9e, 0x85, 0x02, 0xfb, 0x22, 0x8d, 0xdc, 0x52, 0x7e, 0xa5,
-	0xba, 0xba, 0xac, 0xa4, 0x79, 0x05, 0xf7, 0xdf, 0x64, 0x3e, 0x69, 0xc3, 0x76, 0x43, 0x53, 0xe0,
-	0x1b, 0xff, 0xd0, 0x60, 0x71, 0x73, 0x27, 0xd8, 0x1b, 0xb0, 0xf4, 0x45, 0x28, 0x28, 0xed, 0x4e,
-	0xf2, 0x43, 0xee, 0x04, 0x5d, 0x85, 0x69, 0xda, 0xef, 0x62, 0xee, 0x89, 0x66, 0x56, 0xcf, 0x5e,
-	0x56, 0x04, 0x07, 0x97, 0x19, 0x93, 0xaf, 0xf7, 0xbb, 0xd8, 0xe4, 0xa8, 0xe8, 0x02, 0xd4, 0x87,
-	0x54, 0x1e, 0x1d, 0xc8, 0xd9, 0xb4, 0xce, 0x89, 0xf1, 0xdb, 0x1c, 0x9c, 0xde, 0x27, 0xe2, 0x24,
-	0xca, 0x56, 0xad, 0x9d, 0x53, 0xae, 0x8d, 0xce, 0x43, 0xc2, 0x04, 0x2c, 0xd7, 0x21, 0x8d, 0xfc,
-	0x52, 0x7e, 0x25, 0x6f, 0xd6, 0x12, 0x7e, 0xc9, 0x21, 0xe8, 0x49, 0x40, 0xfb, 0xdc, 0x85, 0xf0,
-	0x4a, 0xd3, 0xe6, 0xa9, 0x61, 0x7f, 0xc1, 0x7d, 0x92, 0xd2, 0x61, 0x08, 0x15, 0x4c, 0x9b, 0xf3,
-	0x0a, 0x8f, 0x41, 0xd0, 0x55, 0x98, 0x77, 0xfd, 0xbb, 0xb8, 0x13, 0x84, 0x7d, 0xab, 0x8b, 0xc3,
-	0x16, 0xf6, 0xa9, 0xdd, 0xc6, 0xa4, 0x51, 0xe4, 0x1c, 0xcd, 0x45, 0x63, 0x1b, 0x83, 0x21, 0xe3,
-	0x57, 0x1a, 0x2c, 0x8a, 0xa8, 0x6b, 0xc3, 0x0e, 0xa9, 0x7b, 0xd4, 0x37, 0xd7, 0x79, 0x98, 0xe9,
-	0x46, 0x7c, 0x08, 0xbc, 0x69, 0x8e, 0x57, 0x8b, 0xa1, 0xfc, 0x94, 0x7d, 0xa2, 0xc1, 0x3c, 0x0b,
-	0xb2, 0x4e, 0x12, 0xcf, 0xbf, 0xd0, 0x60, 0xee, 0xb6, 0x4d, 0x4e, 0x12, 0xcb, 0xbf, 0x96, 0x57,
-	0x50, 0xcc, 0xf3, 0x51, 0xba, 0x56, 0x86, 0x98, 0x66, 0x3a, 0xba, 0xd5, 0x67, 0x52, 0x5c, 0x13,
-	0xe3, 0x37, 0x83, 0xbb, 0xea, 0x84, 0x71, 0xfe, 0x3b, 0x0d, 0xce, 0xde, 0xc2, 0x34, 0xe6, 0xfa,
-	0x58, 0xdc, 0x69, 0xe3, 0x5a, 0xcb, 0x87, 0xe2, 0x46, 0x56, 0x32, 0x7f, 0x24, 0x37, 0xdf, 0xfb,
-	0x39, 0x58, 0x60, 0xd7, 0xc2, 0xf1, 0x30, 0x82, 0x71, 0x82, 0x72, 0x85, 0xa1, 0x14, 0x54, 0x86,
-	0x12, 0xdf, 0xa7, 0xc5, 0xb1, 0xef, 0x53, 0xe3, 0x97, 0x39, 0x11, 0x07, 0x24, 0xb5, 0x31, 0xc9,
-	0xb6, 0x28, 0x78, 0xcd, 0x29, 0x79, 0x35, 0x40, 0x8f, 0x21, 0xeb, 0x6b, 0xd1, 0xfd, 0x98, 0x82,
-	0x1d, 0xdb, 0xeb, 0xf1, 0x03, 0x0d, 0x16, 0xa3, 0x34, 0x68, 0x13, 0xb7, 0x3b, 0xd8, 0xa7, 0x0f,
-	0x6e, 0x43, 0xc3, 0x16, 0x90, 0x53, 0x58, 0xc0, 0x19, 0xa8, 0x10, 0xb1, 0x4e, 0x9c, 0xe1, 0x0c,
-	0x00, 0xc6, 0xc7, 0x1a, 0x9c, 0xde, 0xc7, 0xce, 0x24, 0x9b, 0xd8, 0x80, 0x92, 0xeb, 0x3b, 0xf8,
-	0x7e, 0xcc, 0x4d, 0xf4, 0xcb, 0x46, 0xb6, 0x7a, 0xae, 0xe7, 0xc4, 0x6c, 0x44, 0xbf, 0x68, 0x19,
-	0x74, 0xec, 0xdb, 0x5b, 0x1e, 0xb6, 0x38, 0x2e, 0x37, 0xe4, 0xb2, 0x59, 0x15, 0xb0, 0x75, 0x06,
-	0x32, 0xbe, 0xab, 0xc1, 0x1c, 0xb3, 0x35, 0xc9, 0x23, 0xf9, 0x62, 0x75, 0xb6, 0x04, 0xd5, 0x84,
-	0x31, 0x49, 0x76, 0x93, 0x20, 0x63, 0x17, 0xe6, 0xd3, 0xec, 0x4c, 0xa2, 0xb3, 0x47, 0x00, 0xe2,
-	0x1d, 0x11, 0x36, 0x9f, 0x37, 0x13, 0x10, 0xe3, 0x33, 0x0d, 0x90, 0x08, 0xa9, 0xb8, 0x32, 0x8e,
-	0xf8, 0xc5, 0x65, 0xdb, 0xc5, 0x9e, 0x93, 0xf4, 0xda, 0x15, 0x0e, 0xe1, 0xc3, 0x6b, 0xa0, 0xe3,
-	0xfb, 0x34, 0xb4, 0xad, 0xae, 0x1d, 0xda, 0x1d, 0x71, 0x78, 0xc6, 0x72, 0xb0, 0x55, 0x4e, 0xb6,
-	0xc1, 0xa9, 0x8c, 0x3f, 0xb2, 0x60, 0x4c, 0x1a, 0xe5, 0x71, 0x97, 0xf8, 0x2c, 0x00, 0x37, 0x5a,
-	0x31, 0x5c, 0x10, 0xc3, 0x1c, 0xc2, 0xaf, 0xb0, 0x8f, 0x35, 0xa8, 0x73, 0x11, 0x84, 0x3c, 0x5d,
-	0x36, 0xed, 0x10, 0x8d, 0x36, 0x44, 0x33, 0xe2, 0x08, 0xfd, 0x1f, 0x14, 0xa5, 0x62, 0xf3, 0xe3,
-	0x2a, 0x56, 0x12, 0x1c, 0x20, 0x86, 0xf1, 0x13, 0x0d, 0x16, 0x86, 0x54, 0x3e, 0x89, 0x45, 0xbf,
-	0x0e, 0x48, 0x48, 0xe8, 0x0c, 0xc4, 0x8e, 0xae, 0xdb, 0xf3, 0xca, 0xbb, 0x65, 0x58, 0x49, 0xe6,
-	0x29, 0x77, 0x08, 0x42, 0x8c, 0xbf, 0x68, 0x70, 0xe6, 0x16, 0xa6, 0x1c, 0xf5, 0x06, 0xf3, 0x1d,
-	0x1b, 0x61, 0xd0, 0x0e, 0x31, 0x21, 0x27, 0xd7, 0x3e, 0x7e, 0x28, 0xe2, 0x33, 0x95, 0x48, 0x93,
-	0xe8, 0x7f, 0x19, 0x74, 0xbe, 0x06, 0x76, 0xac, 0x30, 0xd8, 0x23, 0xd2, 0x8e, 0xaa, 0x12, 0x66,
-	0x06, 0x7b, 0xdc, 0x20, 0x68, 0x40, 0x6d, 0x4f, 0x20, 0xc8, 0x8b, 0x81, 0x43, 0xd8, 0x30, 0x3f,
-	0x83, 0x11, 0x63, 0x6c, 0x72, 0x7c, 0x72, 0x75, 0xfc, 0x33, 0x0d, 0x16, 0x86, 0x44, 0x99, 0x44,
-	0xb7, 0xcf, 0x88, 0xe8, 0x51, 0x08, 0x33, 0xb3, 0x7a, 0x4e, 0x49, 0x93, 0x58, 0x4c, 0x60, 0xa3,
-	0x73, 0x50, 0xdd, 0xb6, 0x5d, 0xcf, 0x0a, 0xb1, 0x4d, 0x02, 0x5f, 0x0a, 0x0a, 0x0c, 0x64, 0x72,
-	0x88, 0xf1, 0xa9, 0x06, 0x75, 0x96, 0x82, 0x9e, 0x70, 0x8f, 0xf7, 0xd3, 0x1c, 0xd4, 0xd6, 0x7d,
-	0x82, 0x43, 0x7a, 0xfc, 0x33, 0x0c, 0xf4, 0x22, 0x54, 0xb9, 0x60, 0xc4, 0x72, 0x6c, 0x6a, 0xcb,
-	0xeb, 0xea, 0x11, 0xe5, 0x2b, 0xf2, 0xcb, 0x0c, 0x6f, 0xcd, 0xa6, 0xb6, 0x29, 0xb4, 0x43, 0xd8,
-	0x37, 0x7a, 0x18, 0x2a, 0x3b, 0x36, 0xd9, 0xb1, 0x76, 0x71, 0x5f, 0x84, 0x7d, 0x35, 0xb3, 0xcc,
-	0x00, 0xaf, 0xe0, 0x3e, 0x41, 0x0f, 0x41, 0xd9, 0xef, 0x75, 0xc4, 0x01, 0x2b, 0x2d, 0x69, 0x2b,
-	0x35, 0xb3, 0xe4, 0xf7, 0x3a, 0xfc, 0x78, 0xfd, 0x29, 0x07, 0x33, 0x77, 0x7b, 0x2c, 0x9f, 0xe1,
-	0x6f, 0xe0, 0x3d, 0x8f, 0x3e, 0x98, 0x31, 0x5e, 0x84, 0xbc, 0x88, 0x19, 0x18, 0x45, 0x43, 0xc9,
-	0xf8, 0xfa, 0x1a, 0x31, 0x19, 0x12, 0xaf, 0x33, 0xf5, 0x5a, 0x2d, 0x19, 0x64, 0xe5, 0x39, 0xb3,
-	0x15, 0x06, 0xe1, 0x16, 0xc7, 0x44, 0xc1, 0x61, 0x18, 0x87, 0x60, 0x5c, 0x14, 0x1c, 0x86, 0x62,
-	0xd0, 0x00, 0xdd, 0x6e, 0xed, 0xfa, 0xc1, 0x9e, 0x87, 0x9d, 0x36, 0x76, 0xf8, 0xb6, 0x97, 0xcd,
-	0x14, 0x4c, 0x18, 0x06, 0xdb, 0x78, 0xab, 0xe5, 0x53, 0x9e, 0x48, 0xe4, 0x99, 0x61, 0x30, 0xc8,
-	0x4d, 0x9f, 0xb2, 0x61, 0x07, 0x7b, 0x98, 0x62, 0x3e, 0x5c, 0x12, 0xc3, 0x02, 0x22, 0x87, 0x7b,
-	0xdd, 0x98, 0xba, 0x2c, 0x86, 0x05, 0x84, 0x0d, 0x9f, 0x81, 0xca, 0xe0, 0x91, 0xbb, 0x32, 0x78,
-	0x0d, 0x14, 0x4f, 0xdb, 0xbf, 0xd7, 0xa0, 0xb6, 0xc6, 0xa7, 0x3a, 0x01, 0x46, 0x87, 0x60, 0x1a,
-	0xdf, 0xef, 0x86, 0xf2, 0xe8, 0xf0, 0x6f, 0xe3, 0x1e, 0xd4, 0x37, 0x3c, 0xbb, 0x85, 0x77, 0x02,
-	0xcf, 0xc1, 0x21, 0xbf, 0xbe, 0x51, 0x1d, 0xf2, 0xd4, 0x6e, 0xcb, 0xf8, 0x80, 0x7d, 0xa2, 0xe7,
-	0x64, 0x92, 0x26, 0x3c, 0xcf, 0xff, 0x28, 0x2f, 0xd2, 0xc4, 0x34, 0x89, 0xb7, 0xcf, 0x45, 0x28,
-	0xf2, 0xda, 0x92, 0x88, 0x1c, 0x74, 0x53, 0xfe, 0x19, 0xef, 0xa4, 0xd6, 0xbd, 0x15, 0x06, 0xbd,
-	0x2e, 0x5a, 0x07, 0xbd, 0x3b, 0x80, 0x31, 0x73, 0xcc, 0xbe, 0xb6, 0x87, 0x99, 0x36, 0x53, 0xa4,
-	0xc6, 0x67, 0x79, 0xa8, 0x6d, 0x62, 0x3b, 0x6c, 0xed, 0x9c, 0x84, 0xd7, 0x12, 0xa6, 0x71, 0x87,
-	0x78, 0x72, 0x63, 0xd8, 0x27, 0xba, 0x04, 0xa7, 0x12, 0x02, 0x59, 0x6d, 0xa6, 0x20, 0x6e, 0xda,
-	0xba, 0x59, 0xef, 0x0e, 0x2b, 0xee, 0x59, 0x28, 0x3b, 0xc4, 0xb3, 0xf8, 0x16, 0x95, 0xf8, 0x16,
-	0xa9, 0xe5, 0x5b, 0x23, 0x1e, 0xdf, 0x9a, 0x92, 0x23, 0x3e, 0xd0, 0xa3, 0x50, 0x0b, 0x7a, 0xb4,
-	0xdb, 0xa3, 0x96, 0x70, 0x2d, 0x8d, 0x32, 0x67, 0x4f, 0x17, 0x40, 0xee, 0x79, 0x08, 0x7a, 0x19,
-	0x6a, 0x84, 0xab, 0x32, 0x0a, 0xae, 0x2b, 0xe3, 0xc6, 0x80, 0xba, 0xa0, 0x13, 0xd1, 0x35, 0xba,
-	0x00, 0x75, 0x1a, 0xda, 0xf7, 0xb0, 0x97, 0xa8, 0x1a, 0x01, 0x3f, 0x50, 0xb3, 0x02, 0x3e, 0xa8,
-	0x32, 0x5d, 0x81, 0xb9, 0x76, 0xcf, 0x0e, 0x6d, 0x9f, 0x62, 0x9c, 0xc0, 0xae, 0x72, 0x6c, 0x14,
-	0x0f, 0x0d, 0x4a, 0x4c, 0xaf, 0xc0, 0xf4, 0x6d, 0x97, 0x72, 0x45, 0x32, 0xb7, 0xa4, 0xf1, 0x54,
-	0x86, 0x3b, 0x9f, 0x87, 0xa0, 0x1c, 0x06, 0x7b, 0xc2, 0xcd, 0xe6, 0xb8, 0x09, 0x96, 0xc2, 0x60,
-	0x8f, 0xfb, 0x50, 0x5e, 0x17, 0x0f, 0x42, 0x69, 0x9b, 0x39, 0x53, 0xfe, 0x19, 0xdf, 0xd2, 0x06,
-	0xc6, 0xc3, 0x3c, 0x24, 0x79, 0x30, 0x17, 0xf9, 0x22, 0x94, 0x42, 0x41, 0x3f, 0xb2, 0x4a, 0x98,
-	0x5c, 0x89, 0xbb, 0xf9, 0x88, 0xca, 0xf8, 0xa6, 0x06, 0xfa, 0xcb, 0x5e, 0x8f, 0x7c, 0x11, 0x36,
-	0xac, 0xaa, 0x0b, 0xe4, 0xd5, 0x35, 0x89, 0xef, 0xe5, 0xa0, 0x26, 0xd9, 0x98, 0x24, 0x7c, 0xc9,
-	0x64, 0x65, 0x13, 0xaa, 0x6c, 0x49, 0x8b, 0xe0, 0x76, 0xf4, 0xa8, 0x52, 0x5d, 0x5d, 0x55, 0x9e,
-	0xfa, 0x14, 0x1b, 0xbc, 0xbe, 0xba, 0xc9, 0x89, 0xbe, 0xe2, 0xd3, 0xb0, 0x6f, 0x42, 0x2b, 0x06,
-	0x34, 0xdf, 0x81, 0xd9, 0xa1, 0x61, 0x66, 0x1b, 0xbb, 0xb8, 0x1f, 0xb9, 0xb5, 0x5d, 0xdc, 0x47,
-	0x4f, 0x27, 0xab, 0xe0, 0x59, 0xf7, 0xef, 0x9d, 0xc0, 0x6f, 0x5f, 0x0f, 0x43, 0xbb, 0x2f, 0xab,
-	0xe4, 0xcf, 0xe7, 0x9e, 0xd3, 0x8c, 0x3f, 0xe4, 0x40, 0x7f, 0xad, 0x87, 0xc3, 0xfe, 0x51, 0xba,
-	0x97, 0xc8, 0x9f, 0x4f, 0x0f, 0xfc, 0xf9, 0xfe, 0x13, 0x5d, 0x50, 0x9c, 0x68, 0x85, 0x5f, 0x2a,
-	0x2a, 0xfd, 0x92, 0xea, 0xc8, 0x96, 0x0e, 0x75, 0x64, 0xcb, 0x99, 0x47, 0x96, 0x59, 0xb7, 0x54,
-	0xe1, 0x44, 0x87, 0x2c, 0x15, 0x48, 0xe5, 0x0e, 0x1b, 0x48, 0x19, 0x9f, 0x68, 0x50, 0x79, 0x13,
-	0xb7, 0x68, 0x10, 0x32, 0x6f, 0xa1, 0xd0, 0xbd, 0x36, 0x46, 0xac, 0x9a, 0x1b, 0x8e, 0x55, 0xaf,
-	0x41, 0xd9, 0x75, 0x2c, 0x9b, 0x99, 0x0d, 0xdf, 0xbc, 0x51, 0x31, 0x52, 0xc9, 0x75, 0xb8, 0x7d,
-	0x8d, 0xff, 0xb8, 0xfe, 0x23, 0x0d, 0x74, 0xc1, 0x33, 0x11, 0x94, 0x2f, 0x24, 0x96, 0xd3, 0x54,
-	0xb6, 0x2c, 0x7f, 0x62, 0x41, 0x6f, 0x4f, 0x0d, 0x96, 0xbd, 0x0e, 0xc0, 0x74, 0x27, 0xc9, 0xc5,
-	0x51, 0x58, 0x52, 0x72, 0x2b, 0xc8, 0xb9, 0x1e, 0x6f, 0x4f, 0x99, 0x15, 0x46, 0xc5, 0xa7, 0xb8,
-	0x51, 0x82, 0x02, 0xa7, 0x36, 0xfe, 0xa5, 0xc1, 0xdc, 0x4d, 0xdb, 0x6b, 0xad, 0xb9, 0x84, 0xda,
-	0x7e, 0x6b, 0x82, 0xa8, 0xe8, 0x79, 0x28, 0x05, 0x5d, 0xcb, 0xc3, 0xdb, 0x54, 0xb2, 0xb4, 0x3c,
-	0x42, 0x22, 0xa1, 0x06, 0xb3, 0x18, 0x74, 0xef, 0xe0, 0x6d, 0x8a, 0xfe, 0x1f, 0xca, 0x41, 0xd7,
-	0x0a, 0xdd, 0xf6, 0x0e, 0x95, 0xda, 0x1f, 0x83, 0xb8, 0x14, 0x74, 0x4d, 0x46, 0x91, 0x78, 0xec,
-	0x98, 0x3e, 0xe4, 0x63, 0x87, 0xf1, 0xd7, 0x7d, 0xe2, 0x4f, 0x60, 0xda, 0xcf, 0x43, 0xd9, 0xf5,
-	0xa9, 0xe5, 0xb8, 0x24, 0x52, 0xc1, 0x59, 0xb5, 0x0d, 0xf9, 0x94, 0x4b, 0xc0, 0xf7, 0xd4, 0xa7,
-	0x6c, 0x6d, 0xf4, 0x12, 0xc0, 0xb6, 0x17, 0xd8, 0x92, 0x5a, 0xe8, 0xe0, 0x9c, 0xfa, 0x54, 0x30,
-	0xb4, 0x88, 0xbe, 0xc2, 0x89, 0xd8, 0x0c, 0x83, 0x2d, 0xfd, 0xb3, 0x06, 0x0b, 0x1b, 0x38, 0x24,
-	0x2e, 0xa1, 0xd8, 0xa7, 0xf2, 0xe1, 0x71, 0xdd, 0xdf, 0x0e, 0xd2, 0x2f, 0xbc, 0xda, 0xd0, 0x0b,
-	0xef, 0xe7, 0xf3, 0xde, 0x99, 0x4a, 0x65, 0x44, 0x9d, 0x21, 0x4a, 0x65, 0xa2, 0x6a, 0x8a, 0x48,
-	0x05, 0x67, 0x32, 0xb6, 0x49, 0xf2, 0x9b, 0xcc, 0x88, 0x8d, 0xef, 0x8b, 0xce, 0x06, 0xa5, 0x50,
-	0x0f, 0x6e, 0xb0, 0x8b, 0x20, 0x1d, 0xf8, 0x90, 0x3b, 0x7f, 0x0c, 0x86, 0x7c, 0x47, 0x46, 0xbf,
-	0xc5, 0x8f, 0x35, 0x58, 0xca, 0xe6, 0x6a, 0x92, 0x9b, 0xf7, 0x25, 0x28, 0xb8, 0xfe, 0x76, 0x10,
-	0xbd, 0x83, 0x5d, 0x54, 0x07, 0xd4, 0xca, 0x75, 0x05, 0xa1, 0xf1, 0x4f, 0x0d, 0xea, 0xdc, 0x57,
-	0x1f, 0xc1, 0xf6, 0x77, 0x70, 0xc7, 0x22, 0xee, 0xbb, 0x38, 0xda, 0xfe, 0x0e, 0xee, 0x6c, 0xba,
-	0xef, 0xe2, 0x94, 0x65, 0x14, 0xd2, 0x96, 0x91, 0x7e, 0x29, 0x28, 0x8e, 0x78, 0xe7, 0x2c, 0xa5,
-	0xde, 0x39, 0x8d, 0x0f, 0x35, 0x68, 0xde, 0xc2, 0x74, 0x58, 0xd4, 0xa3, 0x33, 0x8a, 0x8f, 0x34,
-	0x78, 0x58, 0xc9, 0xd0, 0x24, 0xf6, 0xf0, 0x42, 0xda, 0x1e, 0xd4, 0x09, 0xd6, 0xbe, 0x25, 0xa5,
-	0x29, 0x5c, 0x05, 0x7d, 0xad, 0xd7, 0xe9, 0xc4, 0x81, 0xcf, 0x32, 0xe8, 0xa1, 0xf8, 0x14, 0xf9,
-	0x87, 0xb8, 0x2e, 0xab, 0x12, 0xc6, 0xb2, 0x0c, 0xe3, 0x12, 0xd4, 0x24, 0x89, 0xe4, 0xba, 0x09,
-	0xe5, 0x50, 0x7e, 0x4b, 0xfc, 0xf8, 0xdf, 0x58, 0x80, 0x39, 0x13, 0xb7, 0x99, 0x25, 0x86, 0x77,
-	0x5c, 0x7f, 0x57, 0x2e, 0x63, 0xbc, 0xa7, 0xc1, 0x7c, 0x1a, 0x2e, 0xe7, 0xfa, 0x5f, 0x28, 0xd9,
-	0x8e, 0x13, 0x62, 0x42, 0x46, 0x6e, 0xcb, 0x75, 0x81, 0x63, 0x46, 0xc8, 0x09, 0xcd, 0xe5, 0xc6,
-	0xd6, 0x9c, 0x61, 0xc1, 0xa9, 0x5b, 0x98, 0xde, 0xc5, 0x34, 0x9c, 0xa8, 0x90, 0xdd, 0x60, 0x99,
-	0x01, 0x27, 0x96, 0x66, 0x11, 0xfd, 0x1a, 0x1f, 0x68, 0x80, 0x92, 0x2b, 0x4c, 0xb2, 0xcd, 0x49,
-	0x2d, 0xe7, 0xd2, 0x5a, 0x16, 0xbd, 0x3e, 0x9d, 0x6e, 0xe0, 0x63, 0x9f, 0x26, 0x43, 0xcc, 0x5a,
-	0x0c, 0x65, 0xe6, 0x77, 0x71, 0x19, 0xca, 0x51, 0xed, 0x15, 0x95, 0x20, 0x7f, 0xdd, 0xf3, 0xea,
-	0x53, 0x48, 0x87, 0xf2, 0xba, 0x2c, 0x30, 0xd6, 0xb5, 0x8b, 0x5f, 0x86, 0xd9, 0xa1, 0xcc, 0x1f,
-	0x95, 0x61, 0xfa, 0xd5, 0xc0, 0xc7, 0xf5, 0x29, 0x54, 0x07, 0xfd, 0x86, 0xeb, 0xdb, 0x61, 0x5f,
-	0xdc, 0xb4, 0x75, 0x07, 0xcd, 0x42, 0x95, 0xdf, 0x38, 0x12, 0x80, 0x57, 0xff, 0xde, 0x80, 0xda,
-	0x5d, 0x2e, 0xcc, 0x26, 0x0e, 0xef, 0xb9, 0x2d, 0x8c, 0x2c, 0xa8, 0x0f, 0x77, 0x4f, 0xa3, 0x27,
-	0x94, 0x36, 0x9a, 0xd1, 0x64, 0xdd, 0x1c, 0xa5, 0x1e, 0x63, 0x0a, 0xbd, 0x0d, 0x33, 0xe9, 0xbe,
-	0x66, 0xa4, 0x76, 0x89, 0xca, 0xe6, 0xe7, 0x83, 0x26, 0xb7, 0xa0, 0x96, 0x6a, 0x53, 0x46, 0x17,
-	0x94, 0x73, 0xab, 0x5a, 0x99, 0x9b, 0xea, 0x28, 0x25, 0xd9, 0x4a, 0x2c, 0xb8, 0x4f, 0x37, 0x53,
-	0x66, 0x70, 0xaf, 0xec, 0xb8, 0x3c, 0x88, 0x7b, 0x1b, 0x4e, 0xed, 0xeb, 0x8d, 0x44, 0x4f, 0x2a,
-	0xe7, 0xcf, 0xea, 0xa1, 0x3c, 0x68, 0x89, 0x3d, 0x40, 0xfb, 0xdb, 0x71, 0xd1, 0x65, 0xf5, 0x0e,
-	0x64, 0x35, 0x23, 0x37, 0xaf, 0x8c, 0x8d, 0x1f, 0x2b, 0xee, 0xdb, 0x1a, 0x9c, 0xce, 0x68, 0x68,
-	0x44, 0xd7, 0x94, 0xd3, 0x8d, 0xee, 0xca, 0x6c, 0x3e, 0x7d, 0x38, 0xa2, 0x98, 0x11, 0x1f, 0x66,
-	0x87, 0x7a, 0xfc, 0xd0, 0xa5, 0xcc, 0xbe, 0x87, 0xfd, 0xcd, 0x8e, 0xcd, 0x27, 0xc6, 0x43, 0x8e,
-	0xd7, 0x63, 0xb9, 0x70, 0xba, 0x31, 0x2e, 0x63, 0x3d, 0x75, 0xfb, 0xdc, 0x41, 0x1b, 0xfa, 0x16,
-	0xd4, 0x52, 0x1d, 0x6c, 0x19, 0x16, 0xaf, 0xea, 0x72, 0x3b, 0x68, 0xea, 0x77, 0x40, 0x4f, 0x36,
-	0x9a, 0xa1, 0x95, 0xac, 0xb3, 0xb4, 0x6f, 0xe2, 0xc3, 0x1c, 0xa5, 0x41, 0x1f, 0xc9, 0x88, 0xa3,
-	0xb4, 0xaf, 0xf5, 0x66, 0xfc, 0xa3, 0x94, 0x98, 0x7f, 0xe4, 0x51, 0x3a, 0xf4, 0x12, 0xef, 0x69,
-	0xb0, 0xa8, 0xee, 0x53, 0x42, 0xab, 0x59, 0xb6, 0x99, 0xdd, 0x91, 0xd5, 0xbc, 0x76, 0x28, 0x9a,
-	0x58, 0x8b, 0xbb, 0x30, 0x93, 0xee, 0xc6, 0xc9, 0xd0, 0xa2, 0xb2, 0x81, 0xa9, 0x79, 0x69, 0x2c,
-	0xdc, 0x78, 0xb1, 0x37, 0xa0, 0x9a, 0xe8, 0x48, 0x40, 0x8f, 0x8f, 0xb0, 0xe3, 0x64, 0x3d, 0xeb,
-	0x20, 0x4d, 0xee, 0x40, 0x2d, 0x55, 0x85, 0xce, 0xb2, 0x61, 0x45, 0x73, 0x40, 0xf3, 0xe2, 0x38,
-	0xa8, 0xb1, 0x00, 0x3b, 0x50, 0x4b, 0xd5, 0x04, 0x33, 0x56, 0x52, 0x95, 0x40, 0x33, 0x56, 0x52,
-	0x96, 0x18, 0x8d, 0x29, 0xf4, 0x8d, 0x44, 0xf9, 0x31, 0x55, 0xe2, 0x45, 0x57, 0x47, 0xce, 0xa3,
-	0xaa, 0x70, 0x37, 0x57, 0x0f, 0x43, 0x12, 0xb3, 0xf0, 0x1a, 0x54, 0xe2, 0xca, 0x22, 0x3a, 0x9f,
-	0xe9, 0x16, 0x0e, 0xb3, 0x53, 0x9b, 0x50, 0x14, 0x55, 0x3e, 0x64, 0x64, 0xd4, 0xf3, 0x13, 0x25,
-	0xc0, 0xe6, 0xa3, 0x4a, 0x9c, 0x74, 0x01, 0x4c, 0x4c, 0x2a, 0xaa, 0x38, 0x19, 0x93, 0xa6, 0x4a,
-	0x3c, 0xe3, 0x4e, 0x6a, 0x42, 0x51, 0xbc, 0xed, 0x66, 0x4c, 0x9a, 0xaa, 0x4f, 0x34, 0x47, 0xe3,
-	0x88, 0x07, 0xe1, 0x29, 0xb4, 0x01, 0x05, 0xfe, 0x06, 0x8a, 0x96, 0x47, 0xbd, 0x8f, 0x8e, 0x9a,
-	0x31, 0xf5, 0x84, 0x6a, 0x4c, 0xa1, 0xaf, 0x42, 0x81, 0x87, 0xfa, 0x19, 0x33, 0x26, 0x1f, 0x39,
-	0x9b, 0x23, 0x51, 0x22, 0x16, 0x1d, 0xd0, 0x93, 0x4f, 0x20, 0x19, 0x3e, 0x5b, 0xf1, 0x48, 0xd4,
-	0x1c, 0x07, 0x33, 0x5a, 0xe5, 0x3b, 0x1a, 0x34, 0xb2, 0xb2, 0x65, 0x94, 0x79, 0x31, 0x8f, 0x4a,
-	0xf9, 0x9b, 0xcf, 0x1c, 0x92, 0x2a, 0x56, 0xe1, 0xbb, 0x30, 0xa7, 0xc8, 0xd1, 0xd0, 0x95, 0xac,
-	0xf9, 0x32, 0xd2, 0xcb, 0xe6, 0x53, 0xe3, 0x13, 0xc4, 0x6b, 0x6f, 0x40, 0x81, 0xe7, 0x56, 0x19,
-	0xdb, 0x97, 0x4c, 0xd5, 0x32, 0x0c, 0x22, 0x95, 0x9a, 0x19, 0x53, 0x08, 0x83, 0x9e, 0x4c, 0xb4,
-	0x32, 0xf6, 0x4f, 0x91, 0xa3, 0x35, 0x2f, 0x8c, 0x81, 0x19, 0x2f, 0x63, 0x01, 0x0c, 0x12, 0x1d,
-	0xf4, 0x58, 0x96, 0xe8, 0xe9, 0x5c, 0xab, 0xf9, 0xf8, 0x81, 0x78, 0xd1, 0x02, 0xab, 0x3d, 0xd0,
-	0x37, 0xc2, 0xe0, 0x7e, 0x3f, 0x4a, 0x2b, 0xfe, 0x3b, 0x72, 0xdd, 0x78, 0xe6, 0x6b, 0xd7, 0xda,
-	0x2e, 0xdd, 0xe9, 0x6d, 0x31, 0xcf, 0x75, 0x45, 0xe0, 0x3e, 0xe9, 0x06, 0xf2, 0xeb, 0x8a, 0xeb,
-	0x53, 0x1c, 0xfa, 0xb6, 0x77, 0x85, 0xcf, 0x25, 0xa1, 0xdd, 0xad, 0xad, 0x22, 0xff, 0xbf, 0xf6,
-	0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x88, 0x7a, 0xa8, 0xfc, 0x7b, 0x3a, 0x00, 0x00,
+	// 4344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0x4b, 0x73, 0x24, 0xc7,
+	0x71, 0xf0, 0xf6, 0xbc, 0x27, 0xa7, 0x07, 0x18, 0x14, 0x5e, 0xc3, 0xe1, 0x3e, 0xb0, 0x2d, 0x52,
+	0x04, 0x41, 0x71, 0x97, 0xc4, 0x92, 0x12, 0x3f, 0x92, 0xfa, 0xc4, 0xdd, 0x05, 0x77, 0x17, 0xc1,
+	0xdd, 0x25, 0xd8, 0x58, 0xca, 0x21, 0x2b, 0x18, 0xa3, 0x9e, 0xe9, 0xc2, 0xa0, 0xb5, 0x3d, 0xdd,
+	0xe3, 0xae, 0x1a, 0x80, 0xc3, 0x93, 0xc2, 0xb2, 0x1d, 0x56, 0xc8, 0x96, 0xc2, 0x8f, 0x90, 0x1f,
+	0xe1, 0x83, 0xc3, 0x8f, 0x83, 0x6f, 0x7e, 0x1c, 0xec, 0xb0, 0x23, 0x1c, 0x21, 0x87, 0x0f, 0xba,
+	0xf9, 0xf1, 0x0b, 0x7c, 0xf1, 0xc1, 0x07, 0xdd, 0xad, 0x08, 0x1f, 0x1c, 0xf5, 0xe8, 0x9e, 0xee,
+	0x46, 0xf5, 0x60, 0x80, 0xe1, 0x0a, 0x40, 0x84, 0x4f, 0x98, 0xce, 0xca, 0xac, 0xca, 0xca, 0xca,
+	0xcc, 0xaa, 0xca, 0xca, 0x04, 0xe8, 0x7d, 0xc7, 0x3d, 0x18, 0x92, 0x1b, 0x83, 0xc0, 0xa7, 0x3e,
+	0x5a, 0x8c, 0x7f, 0xdd, 0x10, 0x1f, 0x2d, 0xbd, 0xeb, 0xf7, 0xfb, 0xbe, 0x27, 0x80, 0x2d, 0x9d,
+	0x74, 0xf7, 0x71, 0xdf, 0x12, 0x5f, 0xc6, 0xef, 0xe7, 0x60, 0xf5, 0x6e, 0x80, 0x2d, 0x8a, 0xef,
+	0xfa, 0xae, 0x8b, 0xbb, 0xd4, 0xf1, 0x3d, 0x13, 0xff, 0xd2, 0x10, 0x13, 0x8a, 0x5e, 0x83, 0x42,
+	0xc7, 0x22, 0xb8, 0xa9, 0xad, 0x69, 0xeb, 0xb5, 0xcd, 0xcb, 0x37, 0x12, 0x7d, 0xcb, 0x3e, 0x1f,
+	0x91, 0xde, 0x1d, 0x8b, 0x60, 0x93, 0x63, 0xa2, 0x55, 0x28, 0xdb, 0x9d, 0xb6, 0x67, 0xf5, 0x71,
+	0x33, 0xb7, 0xa6, 0xad, 0x57, 0xcd, 0x92, 0xdd, 0x79, 0x6c, 0xf5, 0x31, 0x7a, 0x09, 0xe6, 0xbb,
+	0x51, 0xff, 0x02, 0x21, 0xcf, 0x11, 0xe6, 0xc6, 0x60, 0x8e, 0xb8, 0x02, 0x25, 0xc1, 0x5f, 0xb3,
+	0xb0, 0xa6, 0xad, 0xeb, 0xa6, 0xfc, 0x42, 0x57, 0x00, 0xc8, 0xbe, 0x15, 0xd8, 0xa4, 0xed, 0x0d,
+	0xfb, 0xcd, 0xe2, 0x9a, 0xb6, 0x5e, 0x34, 0xab, 0x02, 0xf2, 0x78, 0xd8, 0x47, 0x26, 0x2c, 0x74,
+	0x7d, 0x8f, 0x38, 0x84, 0x62, 0xaf, 0x3b, 0x6a, 0xbb, 0xf8, 0x00, 0xbb, 0xcd, 0xd2, 0x9a, 0xb6,
+	0x3e, 0xb7, 0xf9, 0xa2, 0x92, 0xef, 0xbb, 0x63, 0xec, 0x87, 0x0c, 0xd9, 0x6c, 0x74, 0x53, 0x10,
+	0xe3, 0xfb, 0x1a, 0x2c, 0x6f, 0x05, 0xfe, 0xe0, 0x5c, 0x08, 0xc6, 0xf8, 0x0b, 0x0d, 0x96, 0x1e,
+	0x58, 0xe4, 0x7c, 0xac, 0xd2, 0x15, 0x00, 0xea, 0xf4, 0x71, 0x9b, 0x50, 0xab, 0x3f, 0xe0, 0x2b,
+	0x55, 0x30, 0xab, 0x0c, 0xb2, 0xcb, 0x00, 0xc6, 0x37, 0x40, 0xbf, 0xe3, 0xfb, 0xae, 0x89, 0xc9,
+	0xc0, 0xf7, 0x08, 0x46, 0xb7, 0xa0, 0x44, 0xa8, 0x45, 0x87, 0x44, 0x32, 0xf9, 0xbc, 0x92, 0xc9,
+	0x5d, 0x8e, 0x62, 0x4a, 0x54, 0xb4, 0x04, 0xc5, 0x03, 0xcb, 0x1d, 0x0a, 0x1e, 0x2b, 0xa6, 0xf8,
+	0x30, 0xbe, 0x09, 0x73, 0xbb, 0x34, 0x70, 0xbc, 0xde, 0xe7, 0xd8, 0x79, 0x35, 0xec, 0xfc, 0xdf,
+	0x35, 0x78, 0x6e, 0x0b, 0x93, 0x6e, 0xe0, 0x74, 0xce, 0x89, 0x39, 0x18, 0xa0, 0x8f, 0x21, 0xdb,
+	0x5b, 0x5c, 0xd4, 0x79, 0x33, 0x01, 0x4b, 0x2d, 0x46, 0x31, 0xbd, 0x18, 0xff, 0x9d, 0x87, 0x96,
+	0x6a, 0x52, 0xb3, 0x88, 0xef, 0xab, 0x91, 0x95, 0xe6, 0x38, 0x51, 0xca, 0xc6, 0x44, 0xdb, 0x8d,
+	0xf1, 0x68, 0xbb, 0x1c, 0x10, 0x19, 0x73, 0x7a, 0x56, 0x79, 0xc5, 0xac, 0x36, 0x61, 0xf9, 0xc0,
+	0x09, 0xe8, 0xd0, 0x72, 0xdb, 0xdd, 0x7d, 0xcb, 0xf3, 0xb0, 0xcb, 0xe5, 0x44, 0x9a, 0x85, 0xb5,
+	0xfc, 0x7a, 0xd5, 0x5c, 0x94, 0x8d, 0x77, 0x45, 0x1b, 0x13, 0x16, 0x41, 0x6f, 0xc0, 0xca, 0x60,
+	0x7f, 0x44, 0x9c, 0xee, 0x11, 0xa2, 0x22, 0x27, 0x5a, 0x0a, 0x5b, 0x13, 0x54, 0xaf, 0xc0, 0x42,
+	0x97, 0x7b, 0x40, 0xbb, 0xcd, 0xa4, 0x26, 0xc4, 0x58, 0xe2, 0x62, 0x6c, 0xc8, 0x86, 0x27, 0x21,
+	0x9c, 0xb1, 0x15, 0x22, 0x0f, 0x69, 0x37, 0x46, 0x50, 0xe6, 0x04, 0x8b, 0xb2, 0xf1, 0x63, 0xda,
+	0x1d, 0xd3, 0x28, 0x9d, 0x53, 0x65, 0x26, 0xe7, 0x84, 0xbe, 0x00, 0x75, 0xd7, 0xb7, 0x6c, 0x6c,
+	0xb7, 0xf7, 0x1c, 0xec, 0xda, 0xa4, 0x59, 0xe5, 0x33, 0xd4, 0x05, 0xf0, 0x1e, 0x87, 0x19, 0xbf,
+	0x9d, 0x83, 0xe5, 0x87, 0xbe, 0x65, 0x9f, 0x0f, 0x5d, 0x7e, 0x11, 0xe6, 0x02, 0x3c, 0x70, 0x9d,
+	0xae, 0xc5, 0x7c, 0x78, 0x07, 0x07, 0x5c, 0x9b, 0x8b, 0x66, 0x5d, 0x42, 0x1f, 0x73, 0x20, 0x33,
+	0x4d, 0x8b, 0x8c, 0xbc, 0x2e, 0xd7, 0xe4, 0x8a, 0x29, 0x3e, 0xd0, 0x35, 0xa8, 0xb1, 0xa9, 0x85,
+	0xb3, 0x2d, 0xf1, 0xd9, 0x02, 0x03, 0x89, 0xb9, 0x32, 0x36, 0x02, 0x4c, 0xfc, 0x61, 0xd0, 0xc5,
+	0xed, 0x5e, 0xe0, 0x0f, 0x07, 0xa4, 0x59, 0xe6, 0x48, 0x73, 0x21, 0xf8, 0x3e, 0x87, 0x1a, 0x3f,
+	0xd0, 0xa0, 0x69, 0x62, 0x17, 0x5b, 0xe4, 0x7c, 0xd8, 0xb8, 0xf1, 0xbb, 0x1a, 0x5c, 0xbd, 0x8f,
+	0x69, 0xcc, 0x5a, 0xa8, 0x45, 0x1d, 0x42, 0x9d, 0x2e, 0x39, 0x4b, 0xb6, 0x7e, 0xa8, 0xc1, 0xb5,
+	0x4c, 0xb6, 0x66, 0x71, 0x1e, 0x5f, 0x81, 0x22, 0xfb, 0x45, 0x9a, 0xb9, 0xb5, 0xfc, 0x7a, 0x6d,
+	0xf3, 0xba, 0x92, 0xe6, 0x03, 0x3c, 0xfa, 0x3a, 0xf3, 0xc9, 0x3b, 0x96, 0x13, 0x98, 0x02, 0xdf,
+	0xf8, 0x0f, 0x0d, 0x56, 0x76, 0xf7, 0xfd, 0xc3, 0x31, 0x4b, 0xcf, 0x42, 0x40, 0x49, 0x77, 0x9a,
+	0x4f, 0xb9, 0x53, 0xf4, 0x3a, 0x14, 0xe8, 0x68, 0x80, 0xb9, 0xee, 0xce, 0x6d, 0x5e, 0xb9, 0xa1,
+	0x38, 0x70, 0xdd, 0x60, 0x4c, 0x3e, 0x19, 0x0d, 0xb0, 0xc9, 0x51, 0xd1, 0xcb, 0xd0, 0x48, 0x89,
+	0x3c, 0x74, 0x48, 0xf3, 0x49, 0x99, 0x13, 0xe3, 0x3b, 0x79, 0x58, 0x3d, 0x32, 0xc5, 0x59, 0x84,
+	0xad, 0x1a, 0x3b, 0xa7, 0x1c, 0x9b, 0xd9, 0x67, 0x0c, 0xd5, 0xb1, 0x49, 0x33, 0xbf, 0x96, 0x5f,
+	0xcf, 0x9b, 0xf5, 0x98, 0x5f, 0xb6, 0x09, 0x7a, 0x15, 0xd0, 0x11, 0x77, 0x29, 0xbc, 0x72, 0xc1,
+	0x5c, 0x48, 0xfb, 0x4b, 0xee, 0x93, 0x95, 0x0e, 0x53, 0x88, 0xa0, 0x60, 0x2e, 0x29, 0x3c, 0x26,
+	0x41, 0xaf, 0xc3, 0x92, 0xe3, 0x3d, 0xc2, 0x7d, 0x3f, 0x18, 0xb5, 0x07, 0x38, 0xe8, 0x62, 0x8f,
+	0x5a, 0x3d, 0x2c, 0xec, 0x3e, 0x6f, 0x2e, 0x86, 0x6d, 0x3b, 0xe3, 0x26, 0x74, 0x2f, 0xed, 0x11,
+	0xcb, 0x2a, 0xf5, 0x92, 0x1f, 0x0f, 0x63, 0x6e, 0x32, 0xe5, 0x34, 0x6f, 0x82, 0x1e, 0x6f, 0x65,
+	0x9e, 0x87, 0x77, 0x28, 0x85, 0xa7, 0x09, 0xcf, 0xc3, 0x41, 0x62, 0xcd, 0x3a, 0xb0, 0x2c, 0x4e,
+	0xd0, 0x5b, 0x16, 0xb5, 0x98, 0x6e, 0x7d, 0xfe, 0x4a, 0x69, 0x7c, 0x0b, 0x16, 0xd9, 0x51, 0xf4,
+	0x19, 0x8e, 0xf0, 0x00, 0x96, 0x1e, 0x3a, 0x84, 0x86, 0x23, 0x9c, 0xde, 0xb2, 0x8c, 0x1f, 0x69,
+	0xb0, 0x9c, 0xea, 0x6a, 0x16, 0x0d, 0x7e, 0x0e, 0x2a, 0x76, 0x27, 0xa1, 0xb9, 0x65, 0xc1, 0x72,
+	0x96, 0x2a, 0xe6, 0x33, 0x54, 0xd1, 0xf8, 0xae, 0x16, 0xdd, 0x75, 0x02, 0x6c, 0x63, 0x8f, 0x3a,
+	0x96, 0x7b, 0x7a, 0x49, 0xb6, 0xa0, 0x32, 0x24, 0x38, 0x88, 0x89, 0x32, 0xfa, 0x66, 0x6d, 0x03,
+	0x8b, 0x90, 0x43, 0x3f, 0xb0, 0xa5, 0x77, 0x8d, 0xbe, 0x8d, 0x1e, 0xac, 0x6e, 0x61, 0x17, 0x3f,
+	0x73, 0x26, 0xc2, 0x15, 0x65, 0xc3, 0x7c, 0x4c, 0x70, 0x30, 0xc3, 0x8a, 0x7e, 0x1b, 0x96, 0x53,
+	0x3d, 0xcd, 0xb2, 0xa0, 0x97, 0xa1, 0x1a, 0xf2, 0x18, 0xae, 0xe8, 0x18, 0x60, 0x74, 0x60, 0x41,
+	0xac, 0x91, 0xe9, 0xbb, 0x33, 0xe8, 0xf9, 0xf3, 0x50, 0x0d, 0x7c, 0x17, 0xc7, 0x35, 0xbd, 0xc2,
+	0x00, 0xd2, 0x9a, 0xe6, 0x99, 0x35, 0x3d, 0xc3, 0x11, 0x7e, 0xac, 0xc1, 0xca, 0x87, 0x03, 0x1c,
+	0x58, 0x14, 0x33, 0x89, 0xcd, 0x36, 0xd2, 0x24, 0x4d, 0x4b, 0x70, 0x91, 0x4f, 0x72, 0x81, 0xde,
+	0x4d, 0xec, 0x55, 0xeb, 0x4a, 0x4f, 0x98, 0xe2, 0x72, 0xbc, 0x6d, 0x19, 0xbf, 0xac, 0x41, 0xed,
+	0x7e, 0x60, 0x79, 0xf4, 0x7d, 0x8f, 0x3a, 0x74, 0x94, 0x1c, 0x4a, 0x4b, 0x0d, 0x95, 0xb9, 0x9d,
+	0x5e, 0x83, 0x9a, 0xdf, 0xf9, 0x36, 0xee, 0xd2, 0x38, 0x8b, 0x20, 0x40, 0x1c, 0xe1, 0x32, 0x54,
+	0x07, 0x81, 0x73, 0xe0, 0xb8, 0xb8, 0x27, 0x38, 0xad, 0x9a, 0x63, 0x80, 0xf1, 0xcf, 0x1a, 0xac,
+	0x4a, 0x16, 0x77, 0x42, 0xe0, 0xe9, 0x25, 0xf9, 0x16, 0x94, 0x30, 0x9f, 0x8c, 0xbc, 0xb7, 0xac,
+	0x29, 0x45, 0x12, 0x9b, 0xb4, 0x29, 0xf1, 0xd1, 0x57, 0xa5, 0x28, 0xf3, 0x5c, 0x94, 0x2f, 0x4f,
+	0x12, 0x65, 0xc4, 0x67, 0x4c, 0x96, 0x5d, 0x40, 0xbb, 0x98, 0x6d, 0xa2, 0xbc, 0xef, 0x67, 0xa4,
+	0x74, 0xbf, 0xae, 0xc1, 0x62, 0x62, 0x94, 0x59, 0xac, 0xf4, 0x5d, 0xa8, 0xf0, 0xa9, 0x3b, 0x38,
+	0x3c, 0xa8, 0x1d, 0x2f, 0xac, 0x88, 0xc2, 0xf8, 0x1b, 0x0d, 0x56, 0x84, 0x19, 0xef, 0x58, 0x01,
+	0x75, 0xce, 0xf8, 0x88, 0xcd, 0x8e, 0x36, 0x83, 0x90, 0x0f, 0x81, 0x27, 0x14, 0xad, 0x1e, 0x41,
+	0xb9, 0x00, 0xff, 0x4a, 0x83, 0x25, 0xe6, 0x18, 0x2e, 0x12, 0xcf, 0x7f, 0xa9, 0xc1, 0xe2, 0x03,
+	0x8b, 0x5c, 0x24, 0x96, 0xff, 0x50, 0x5e, 0x4b, 0x23, 0x9e, 0xcf, 0xf2, 0x9e, 0xc3, 0x10, 0x93,
+	0x4c, 0x87, 0x21, 0x86, 0xb9, 0x04, 0xd7, 0x44, 0x71, 0x7f, 0x2d, 0x4e, 0xbc, 0xbf, 0x96, 0xe2,
+	0xf7, 0xd7, 0xa9, 0xaf, 0xa7, 0x7f, 0x3b, 0xbe, 0x9e, 0x5e, 0x2c, 0xf9, 0x18, 0x7f, 0xaf, 0xc1,
+	0x95, 0xfb, 0x98, 0x46, 0x5c, 0x9f, 0x8b, 0x6b, 0xec, 0xb4, 0x3a, 0xf9, 0x03, 0x71, 0x09, 0x57,
+	0x32, 0x7f, 0x26, 0x97, 0xdd, 0xef, 0xe7, 0x60, 0x99, 0xdd, 0x04, 0xcf, 0x87, 0x12, 0x4c, 0x13,
+	0x87, 0x54, 0x28, 0x4a, 0x51, 0x69, 0x48, 0xe1, 0x15, 0xba, 0x34, 0xf5, 0x15, 0xda, 0xf8, 0xeb,
+	0x1c, 0xac, 0xa4, 0xa5, 0x31, 0xcb, 0xb2, 0x28, 0x78, 0xcd, 0x29, 0x79, 0x35, 0x40, 0x8f, 0x20,
+	0xdb, 0x5b, 0xe1, 0x95, 0x38, 0x01, 0x3b, 0xaf, 0x37, 0x62, 0xe3, 0xef, 0x34, 0x78, 0xee, 0x3e,
+	0xa6, 0xcc, 0xd5, 0x3a, 0x5e, 0x6f, 0x27, 0xf0, 0x7b, 0x01, 0x26, 0x17, 0xc3, 0x97, 0xfc, 0x58,
+	0x83, 0xf9, 0x14, 0xdf, 0xcc, 0x92, 0xa9, 0x4f, 0x2d, 0xb7, 0x4d, 0x70, 0xaf, 0x8f, 0x3d, 0x2a,
+	0x16, 0x3c, 0x6f, 0xd6, 0x39, 0x74, 0x57, 0x02, 0xd9, 0x18, 0x32, 0x0e, 0x10, 0xe1, 0xe5, 0x38,
+	0xde, 0x9c, 0x00, 0x47, 0x88, 0x2c, 0xd0, 0xc3, 0xfb, 0x0b, 0xfc, 0x43, 0x22, 0x63, 0xd0, 0x55,
+	0x0e, 0x31, 0xfd, 0x43, 0x12, 0x46, 0x1c, 0xb1, 0x2d, 0xda, 0x85, 0xc6, 0x83, 0x00, 0x71, 0x84,
+	0xab, 0x00, 0xe3, 0x85, 0xe0, 0x7b, 0x41, 0xde, 0x8c, 0x41, 0xd8, 0x75, 0xb3, 0x19, 0xe9, 0xab,
+	0x62, 0x32, 0x29, 0xb7, 0xa4, 0x29, 0xdc, 0x12, 0x7a, 0x0f, 0x2a, 0x03, 0x49, 0x22, 0x8f, 0xac,
+	0x2f, 0x64, 0xc6, 0x33, 0xe2, 0x6b, 0x1c, 0x51, 0x19, 0x3e, 0x2c, 0x3e, 0xf6, 0x6d, 0x9c, 0x1e,
+	0x7f, 0x05, 0x4a, 0x9e, 0x6f, 0xe3, 0xed, 0x2d, 0x29, 0x44, 0xf9, 0xf5, 0x39, 0x0c, 0xf8, 0x93,
+	0x1c, 0xb4, 0x54, 0x5a, 0x37, 0x8b, 0xb9, 0xce, 0xcc, 0x15, 0xfa, 0x16, 0x2c, 0x8d, 0xe5, 0x1d,
+	0x42, 0xb1, 0xb0, 0xe7, 0xda, 0xe6, 0xab, 0xca, 0xde, 0xb2, 0x16, 0xcf, 0x5c, 0x8c, 0xba, 0xda,
+	0x89, 0x7a, 0x42, 0x1f, 0xc1, 0x3c, 0x93, 0x61, 0xbc, 0xf3, 0x02, 0xef, 0x5c, 0x7d, 0xef, 0x52,
+	0x2c, 0x8a, 0x39, 0xc7, 0x3a, 0x18, 0x77, 0x69, 0xfc, 0x86, 0x06, 0x2b, 0xe1, 0xd3, 0x8d, 0x54,
+	0xdb, 0xd3, 0x5b, 0x6f, 0xda, 0x85, 0xe7, 0x14, 0x2e, 0xfc, 0x32, 0x54, 0xa5, 0xd1, 0x44, 0xaf,
+	0x32, 0x63, 0x80, 0xf1, 0xe7, 0x1a, 0xac, 0x1e, 0x61, 0x67, 0x96, 0x65, 0x6d, 0x42, 0xd9, 0xf1,
+	0x6c, 0xfc, 0x69, 0xc4, 0x4d, 0xf8, 0xc9, 0x5a, 0x3a, 0x43, 0xc7, 0xb5, 0x23, 0x36, 0xc2, 0x4f,
+	0x74, 0x1d, 0x74, 0xec, 0x59, 0x1d, 0x17, 0xb7, 0x39, 0x2e, 0xb7, 0xcb, 0x8a, 0x59, 0x13, 0xb0,
+	0x6d, 0x06, 0x32, 0x7e, 0x93, 0xdd, 0x83, 0xf6, 0xfd, 0xc3, 0xd0, 0xd2, 0x9f, 0xad, 0xcc, 0xd6,
+	0xa0, 0x16, 0xdb, 0x0d, 0x24, 0xbb, 0x71, 0x90, 0xf1, 0x14, 0x96, 0x92, 0xec, 0xcc, 0x22, 0xb3,
+	0xab, 0x00, 0xd1, 0x8a, 0x88, 0x4d, 0x2b, 0x6f, 0xc6, 0x20, 0xc6, 0x4f, 0x35, 0x40, 0xe2, 0xe6,
+	0xc5, 0x85, 0x71, 0xc6, 0xaf, 0xc4, 0xe3, 0xc8, 0x69, 0x78, 0xb5, 0x8f, 0x02, 0xa7, 0x68, 0x0b,
+	0x74, 0xfc, 0x29, 0x0d, 0xac, 0xf6, 0xc0, 0x0a, 0xac, 0xbe, 0xd8, 0xfd, 0xa6, 0x3a, 0x21, 0xd5,
+	0x38, 0xd9, 0x0e, 0xa7, 0x32, 0x7e, 0xc2, 0xee, 0x6c, 0x52, 0x29, 0xcf, 0xfb, 0x8c, 0xaf, 0x00,
+	0x70, 0xa5, 0x15, 0xcd, 0x45, 0xd1, 0xcc, 0x21, 0xac, 0x99, 0xd9, 0x57, 0x83, 0x4f, 0x41, 0xcc,
+	0x67, 0xc0, 0xba, 0x4d, 0xd1, 0x68, 0x29, 0x9a, 0x09, 0x26, 0xf4, 0xff, 0xa0, 0x24, 0x05, 0x9b,
+	0x9f, 0x56, 0xb0, 0x92, 0xe0, 0x98, 0x69, 0x18, 0x7f, 0xc2, 0x12, 0x23, 0x92, 0x22, 0x9f, 0x45,
+	0xa3, 0x9f, 0x00, 0x12, 0x33, 0xb4, 0xc7, 0xd3, 0x0e, 0xcf, 0xcb, 0x2f, 0x2a, 0x7d, 0x67, 0x5a,
+	0x48, 0xe6, 0x82, 0x93, 0x82, 0x10, 0xe3, 0x5f, 0x35, 0xb8, 0x7c, 0x1f, 0x53, 0x8e, 0x7a, 0x87,
+	0xf9, 0x8e, 0xf3, 0x70, 0xfe, 0x99, 0x4d, 0x3f, 0x7e, 0x24, 0x2e, 0x58, 0xaa, 0x29, 0xcd, 0x22,
+	0xff, 0xeb, 0xa0, 0xf3, 0x31, 0xc2, 0x93, 0x8e, 0xd0, 0xa3, 0x9a, 0x84, 0xf1, 0xa3, 0xce, 0xe4,
+	0xa3, 0x12, 0xb7, 0xc1, 0x90, 0x31, 0xd6, 0x39, 0xbe, 0xb8, 0x32, 0xfe, 0x33, 0x0d, 0x96, 0x53,
+	0x53, 0x99, 0x45, 0xb6, 0x6f, 0x8a, 0xeb, 0x9f, 0x98, 0xcc, 0xdc, 0xe6, 0x35, 0x25, 0x4d, 0x6c,
+	0x30, 0x81, 0xcd, 0xdf, 0x9c, 0x2c, 0xc7, 0x6d, 0x07, 0xd8, 0x22, 0xbe, 0x27, 0x27, 0x0a, 0x0c,
+	0x64, 0x72, 0x08, 0x0b, 0x8b, 0x36, 0x58, 0xa4, 0xea, 0x82, 0x7b, 0xbc, 0x3f, 0xcd, 0x41, 0x7d,
+	0xdb, 0x23, 0x38, 0xa0, 0xe7, 0x3f, 0x44, 0x80, 0xbe, 0x26, 0x1f, 0x02, 0x49, 0xdb, 0xb6, 0xa8,
+	0x25, 0xb7, 0xab, 0xab, 0xca, 0xcc, 0x17, 0xfe, 0x74, 0xc8, 0xde, 0xbf, 0xe4, 0x43, 0x21, 0x61,
+	0xbf, 0x59, 0xf0, 0x76, 0xdf, 0x22, 0xfb, 0xed, 0xa7, 0x78, 0x24, 0xee, 0x6d, 0x75, 0xb3, 0xc2,
+	0x00, 0x1f, 0xe0, 0x11, 0x7f, 0xe6, 0xf2, 0x86, 0x7d, 0x61, 0x60, 0x2c, 0x97, 0xa4, 0x6e, 0x96,
+	0xbd, 0x61, 0x9f, 0x9b, 0xd7, 0x3f, 0x69, 0xb0, 0x20, 0xa5, 0xe4, 0x1f, 0x5e, 0x80, 0x60, 0x0a,
+	0x42, 0x50, 0xe0, 0xf3, 0x60, 0x22, 0xd2, 0x4d, 0xfe, 0xdb, 0xf8, 0x69, 0x0e, 0xe6, 0x1e, 0x0d,
+	0xa9, 0x25, 0x93, 0x8f, 0x86, 0x2e, 0x3d, 0x9d, 0x45, 0x6d, 0x40, 0x5e, 0x1c, 0x7c, 0x18, 0x45,
+	0x53, 0x29, 0xfd, 0xed, 0x2d, 0x62, 0x32, 0x24, 0xa6, 0x7d, 0x64, 0xd8, 0xed, 0xca, 0x93, 0x62,
+	0x9e, 0x4b, 0xbc, 0xca, 0x20, 0xdc, 0x6c, 0xd8, 0x7a, 0xe0, 0x20, 0x88, 0xce, 0x91, 0x7c, 0x3d,
+	0x70, 0x10, 0x88, 0x46, 0x03, 0x74, 0xab, 0xfb, 0xd4, 0xf3, 0x0f, 0x5d, 0x6c, 0xf7, 0xb0, 0x2d,
+	0xb3, 0x51, 0x12, 0x30, 0xa1, 0xdd, 0x6c, 0x5d, 0xda, 0x5d, 0x8f, 0xf2, 0x70, 0x46, 0xde, 0xac,
+	0x0a, 0xc8, 0x5d, 0x8f, 0xb2, 0x66, 0x9b, 0xbf, 0xf4, 0xf1, 0xe6, 0xb2, 0x68, 0x16, 0x10, 0xd9,
+	0x3c, 0x1c, 0x44, 0xd4, 0x15, 0xd1, 0x2c, 0x20, 0xac, 0xf9, 0x32, 0x54, 0xc7, 0xd9, 0x45, 0xd5,
+	0x71, 0x1a, 0x02, 0x07, 0x30, 0x0f, 0xc1, 0x78, 0x17, 0x0e, 0x82, 0x34, 0x41, 0xbc, 0x4a, 0xe3,
+	0x20, 0x10, 0x0e, 0x82, 0x18, 0xff, 0xa8, 0x41, 0x5d, 0xbc, 0x33, 0x5e, 0x0c, 0x85, 0xc1, 0x9f,
+	0x0e, 0x02, 0xe9, 0x20, 0xf8, 0x6f, 0xee, 0x1b, 0x3e, 0x1e, 0xfc, 0x9f, 0x6f, 0x98, 0xec, 0x1b,
+	0x0e, 0xa0, 0xb1, 0xe3, 0x5a, 0x5d, 0xbc, 0xef, 0xbb, 0x36, 0x0e, 0xf8, 0x51, 0x0e, 0x35, 0x20,
+	0x4f, 0xad, 0x9e, 0x3c, 0x2b, 0xb2, 0x9f, 0xe8, 0x2d, 0x19, 0x71, 0x13, 0xbb, 0x90, 0xfa, 0xee,
+	0x1c, 0xeb, 0x26, 0x96, 0xbb, 0xb2, 0x02, 0x25, 0x9e, 0x1b, 0x29, 0x4e, 0x91, 0xba, 0x29, 0xbf,
+	0x8c, 0x4f, 0x12, 0xe3, 0xf2, 0xd8, 0x35, 0xda, 0x06, 0x7d, 0x30, 0x86, 0x89, 0x54, 0x89, 0xac,
+	0x23, 0x5c, 0x9a, 0x69, 0x33, 0x41, 0x6a, 0xfc, 0xac, 0x00, 0xf5, 0x5d, 0x6c, 0x05, 0xdd, 0xfd,
+	0x0b, 0xf1, 0x34, 0xd0, 0x80, 0xbc, 0x4d, 0x5c, 0xa9, 0xbe, 0xec, 0x27, 0x4b, 0x2a, 0x8c, 0x4d,
+	0x48, 0x84, 0xfc, 0xb9, 0x87, 0xd0, 0xcd, 0xc6, 0x20, 0x2d, 0xb8, 0xaf, 0x40, 0xc5, 0x26, 0x6e,
+	0x9b, 0x2f, 0x51, 0x99, 0x2f, 0x91, 0x7a, 0x7e, 0x5b, 0xc4, 0xe5, 0x4b, 0x53, 0xb6, 0xc5, 0x0f,
+	0x96, 0x05, 0xe8, 0x0f, 0xe9, 0x60, 0x48, 0xc3, 0x9c, 0x97, 0x8a, 0xc8, 0x02, 0x14, 0x40, 0x99,
+	0xc0, 0x72, 0x0f, 0xea, 0x84, 0x8b, 0x32, 0xbc, 0x68, 0x55, 0xa7, 0xbd, 0x0f, 0xe8, 0x82, 0x4e,
+	0xdc, 0xb4, 0x58, 0x2a, 0x11, 0x0d, 0xac, 0x03, 0xec, 0xc6, 0xb2, 0x1e, 0x81, 0xfb, 0xa5, 0x79,
+	0x01, 0x1f, 0x67, 0x3c, 0xde, 0x84, 0xc5, 0xde, 0xd0, 0x0a, 0x2c, 0x8f, 0x62, 0x1c, 0xc3, 0xae,
+	0x71, 0x6c, 0x14, 0x35, 0x1d, 0x93, 0x22, 0xa9, 0xcf, 0x96, 0x22, 0xf9, 0x65, 0x58, 0x1d, 0x12,
+	0xdc, 0xb6, 0xf1, 0x9e, 0x35, 0x74, 0x69, 0x3b, 0xd6, 0xde, 0xac, 0x73, 0x67, 0xbe, 0x3c, 0x24,
+	0x78, 0x4b, 0xb4, 0xc6, 0xba, 0x33, 0x3e, 0x80, 0xc2, 0x03, 0x87, 0xf2, 0x45, 0xdd, 0xde, 0x12,
+	0x5a, 0x9c, 0x17, 0xfb, 0xc9, 0x73, 0x50, 0x09, 0xfc, 0x43, 0x61, 0xe2, 0x39, 0x6e, 0x0e, 0xe5,
+	0xc0, 0x3f, 0xe4, 0xf6, 0xcb, 0xf3, 0xd6, 0xfd, 0x40, 0xda, 0x49, 0xce, 0x94, 0x5f, 0xc6, 0xaf,
+	0x6a, 0x63, 0x45, 0x66, 0x9b, 0x1e, 0x39, 0xdd, 0xae, 0xf7, 0x35, 0x28, 0x07, 0x82, 0x7e, 0x62,
+	0xc6, 0x6d, 0x7c, 0x24, 0xee, 0x62, 0x42, 0x2a, 0xe3, 0x1f, 0x34, 0xd0, 0xef, 0xb9, 0x43, 0xf2,
+	0x2c, 0xec, 0x49, 0x95, 0x63, 0x96, 0x57, 0xe7, 0x98, 0x21, 0x28, 0xf0, 0xb7, 0x31, 0x11, 0xb5,
+	0xe1, 0xbf, 0xd9, 0xfd, 0x83, 0xfd, 0xe5, 0x7a, 0xe2, 0x0f, 0xa9, 0x8c, 0xa4, 0xd6, 0x18, 0xec,
+	0x89, 0x00, 0x19, 0xbf, 0x95, 0x83, 0xba, 0xe4, 0x7e, 0x96, 0xd3, 0x78, 0xe6, 0x0c, 0x76, 0xa1,
+	0xc6, 0x38, 0x65, 0x11, 0xe3, 0x30, 0xc8, 0x5f, 0xdb, 0xdc, 0x54, 0x3a, 0xae, 0x04, 0x1b, 0x3c,
+	0xc5, 0x79, 0x97, 0x13, 0xbd, 0xef, 0xd1, 0x60, 0x64, 0x42, 0x37, 0x02, 0xb4, 0x3e, 0x81, 0xf9,
+	0x54, 0x33, 0x53, 0xa9, 0xa7, 0x78, 0x14, 0x7a, 0xe6, 0xa7, 0x78, 0x84, 0xde, 0x88, 0x27, 0xa2,
+	0x67, 0x6d, 0x19, 0x0f, 0x7d, 0xaf, 0x77, 0x3b, 0x08, 0xac, 0x91, 0x4c, 0x54, 0x7f, 0x3b, 0xf7,
+	0x96, 0x66, 0xfc, 0x71, 0x01, 0xf4, 0x8f, 0x86, 0x38, 0x18, 0x9d, 0xa5, 0x87, 0x0c, 0x37, 0xee,
+	0xc2, 0x78, 0xe3, 0x3e, 0xea, 0x94, 0x8a, 0x0a, 0xa7, 0xa4, 0x70, 0xad, 0x25, 0xa5, 0x6b, 0x55,
+	0x79, 0x9d, 0xf2, 0x89, 0xbc, 0x4e, 0x25, 0xd3, 0xeb, 0xbc, 0x0b, 0x15, 0x3f, 0x60, 0xee, 0xb9,
+	0x33, 0x52, 0x3b, 0xc5, 0x30, 0xb1, 0x83, 0x21, 0xdd, 0x19, 0x71, 0xd6, 0xcd, 0xb2, 0x2f, 0xbe,
+	0xd8, 0x43, 0xaf, 0xeb, 0xf4, 0x1d, 0xca, 0x9d, 0x60, 0xde, 0x14, 0x1f, 0x6a, 0x4f, 0x56, 0x7b,
+	0x66, 0x9e, 0x4c, 0x9f, 0xe4, 0xc9, 0x1e, 0x81, 0x1e, 0x67, 0x3d, 0x75, 0x59, 0xd3, 0xd2, 0x97,
+	0xb5, 0xab, 0xec, 0xbc, 0x4a, 0xba, 0xd8, 0x63, 0x41, 0x69, 0x59, 0x76, 0x11, 0x83, 0x18, 0xbf,
+	0xa2, 0x45, 0x1a, 0x37, 0x93, 0x2b, 0x4b, 0x1c, 0x95, 0x72, 0x27, 0x3d, 0x2a, 0xb1, 0x2c, 0x8d,
+	0xea, 0xd7, 0x71, 0x97, 0xfa, 0x01, 0xf3, 0xc9, 0x0a, 0x55, 0xd5, 0xa6, 0xb8, 0xa9, 0xe6, 0xd2,
+	0x93, 0xbf, 0x05, 0x15, 0xc7, 0x6e, 0x5b, 0xcc, 0xca, 0x9a, 0xf9, 0x63, 0x2e, 0x17, 0x65, 0xc7,
+	0xe6, 0xe6, 0x38, 0xfd, 0x7b, 0xd6, 0xef, 0x69, 0xa0, 0x0b, 0x9e, 0x89, 0xa0, 0x7c, 0x27, 0x36,
+	0x9c, 0xa6, 0x32, 0x7d, 0xf9, 0x11, 0x4d, 0xf4, 0xc1, 0xa5, 0xf1, 0xb0, 0xb7, 0x01, 0x98, 0xec,
+	0x24, 0xb9, 0x32, 0x95, 0x49, 0x72, 0x2b, 0xc8, 0xb9, 0x1c, 0x1f, 0x5c, 0x32, 0xab, 0x8c, 0x8a,
+	0x77, 0x71, 0xa7, 0x0c, 0x45, 0x4e, 0x6d, 0xfc, 0x8f, 0x06, 0x8b, 0x77, 0x2d, 0xb7, 0xbb, 0xe5,
+	0x10, 0x6a, 0x79, 0xdd, 0x19, 0x6e, 0x0b, 0x6f, 0x43, 0xd9, 0x1f, 0xb4, 0x5d, 0xbc, 0x47, 0x25,
+	0x4b, 0xd7, 0x27, 0xcc, 0x48, 0x88, 0xc1, 0x2c, 0xf9, 0x83, 0x87, 0x78, 0x8f, 0x72, 0x4b, 0x1c,
+	0xb4, 0x03, 0xa7, 0xb7, 0x4f, 0x9b, 0xf9, 0x69, 0x89, 0xcb, 0xfe, 0xc0, 0x64, 0x14, 0xb1, 0x50,
+	0x67, 0xe1, 0x84, 0xa1, 0x4e, 0xe3, 0xdf, 0x8e, 0x4c, 0x7f, 0x06, 0xd5, 0x7e, 0x1b, 0x2a, 0x8e,
+	0x47, 0xdb, 0xb6, 0x43, 0x42, 0x11, 0x5c, 0x51, 0xeb, 0x90, 0x47, 0xf9, 0x0c, 0xf8, 0x9a, 0x7a,
+	0x94, 0x8d, 0x8d, 0xde, 0x03, 0xd8, 0x73, 0x7d, 0x4b, 0x52, 0x0b, 0x19, 0x5c, 0x53, 0x5b, 0x05,
+	0x43, 0x0b, 0xe9, 0xab, 0x9c, 0x88, 0xf5, 0x30, 0x5e, 0xd2, 0x7f, 0xd1, 0x60, 0x79, 0x07, 0x07,
+	0xc2, 0x0d, 0x50, 0xf9, 0xec, 0xb0, 0xed, 0xed, 0xf9, 0xc9, 0xf7, 0x1d, 0x2d, 0xf5, 0xbe, 0xf3,
+	0xf9, 0xbc, 0x76, 0x24, 0x2e, 0x2b, 0xe2, 0xd1, 0x34, 0xbc, 0xac, 0x84, 0xc9, 0x10, 0x22, 0x10,
+	0x34, 0x97, 0xb1, 0x4c, 0x92, 0xdf, 0x78, 0x3c, 0xcc, 0xf8, 0x1d, 0x51, 0x8b, 0xa0, 0x9c, 0xd4,
+	0xe9, 0x15, 0x76, 0x05, 0xe4, 0x7e, 0x97, 0xda, 0xfd, 0xbe, 0x08, 0x29, 0xdf, 0x91, 0x51, 0x21,
+	0xf1, 0x07, 0x1a, 0xac, 0x65, 0x73, 0x35, 0xdb, 0x7b, 0x67, 0xd1, 0xf1, 0xf6, 0xfc, 0x30, 0x0a,
+	0xbe, 0xa1, 0xbe, 0x42, 0x29, 0xc7, 0x15, 0x84, 0xc6, 0x1f, 0x89, 0x90, 0x2c, 0x3f, 0xad, 0x24,
+	0x42, 0xb2, 0xc9, 0xf7, 0x23, 0x2d, 0xfd, 0x7e, 0xf4, 0xf3, 0xc9, 0x15, 0x31, 0xf6, 0x60, 0x39,
+	0xc5, 0xdd, 0x8c, 0xef, 0x88, 0x7b, 0xac, 0x2b, 0x6c, 0xcb, 0x5d, 0x2b, 0xfc, 0x34, 0x3e, 0x84,
+	0x39, 0x56, 0xeb, 0xd5, 0xc3, 0x3b, 0x3e, 0xe1, 0x0a, 0xca, 0x4e, 0x9b, 0xf1, 0xd2, 0x30, 0xb9,
+	0x59, 0xd4, 0xba, 0xe3, 0x8a, 0x30, 0x9e, 0xbd, 0x2d, 0xd1, 0x79, 0x7f, 0xba, 0x19, 0x7d, 0x1b,
+	0x3f, 0xd3, 0x60, 0x75, 0x77, 0xd8, 0x91, 0xe5, 0x74, 0xbc, 0xeb, 0x33, 0x8d, 0xc8, 0xdd, 0x86,
+	0x6a, 0xc8, 0x5b, 0xe8, 0xfd, 0xbe, 0xa0, 0xd4, 0x96, 0xa4, 0x18, 0xcc, 0x31, 0x15, 0x1b, 0x8b,
+	0x50, 0x2b, 0xa0, 0xb1, 0x23, 0x93, 0x28, 0x22, 0x9c, 0xe3, 0xe0, 0xe8, 0xb8, 0x64, 0x7c, 0x2f,
+	0x0f, 0x35, 0xd1, 0xcd, 0xfb, 0x07, 0xd8, 0xa3, 0xa7, 0x2d, 0x1d, 0xe4, 0xb2, 0xee, 0xe1, 0x76,
+	0x2c, 0x22, 0x91, 0x29, 0x2b, 0x7e, 0xdd, 0x05, 0x41, 0xc0, 0x7e, 0x2b, 0xe2, 0x31, 0xf9, 0x29,
+	0xe2, 0x31, 0x85, 0x13, 0xc7, 0x63, 0xde, 0x01, 0x7d, 0x10, 0x38, 0x7d, 0x2b, 0x18, 0x89, 0x90,
+	0x4c, 0xf1, 0x98, 0x23, 0x41, 0x4d, 0x62, 0xf3, 0x78, 0xcd, 0x55, 0x51, 0x42, 0x24, 0xb3, 0x7a,
+	0x4a, 0x3c, 0xab, 0x27, 0x06, 0x49, 0x2e, 0x5a, 0xf9, 0x34, 0x8b, 0x66, 0xfc, 0xa7, 0x06, 0x0d,
+	0x7e, 0x16, 0x3b, 0x03, 0xf7, 0xde, 0xc7, 0xfd, 0x36, 0x71, 0x3e, 0xc3, 0xa1, 0x7b, 0xef, 0xe3,
+	0xfe, 0xae, 0xf3, 0x19, 0x4e, 0x78, 0xfe, 0x62, 0xd2, 0xf3, 0x27, 0xdf, 0x01, 0x4a, 0x13, 0x5e,
+	0x31, 0xcb, 0x89, 0x57, 0x4c, 0x96, 0x97, 0xc7, 0xb2, 0x49, 0xd2, 0x53, 0x3d, 0x3b, 0xa7, 0xff,
+	0x43, 0x0d, 0x9e, 0x57, 0x32, 0x34, 0x8b, 0x03, 0x7b, 0x27, 0xe9, 0xef, 0xd5, 0x21, 0xb3, 0x23,
+	0x43, 0x4a, 0x57, 0xff, 0x3a, 0xe8, 0x5b, 0xc3, 0x7e, 0x3f, 0xba, 0x07, 0x5e, 0x07, 0x3d, 0x10,
+	0x3f, 0x85, 0x89, 0x49, 0x0f, 0x27, 0x61, 0xcc, 0x8a, 0x8c, 0x57, 0xa0, 0x2e, 0x49, 0x24, 0xd7,
+	0x2d, 0xa8, 0x04, 0xf2, 0x77, 0x94, 0xda, 0x2f, 0xbf, 0x8d, 0x65, 0x58, 0x34, 0x71, 0x8f, 0xed,
+	0x34, 0xc1, 0x43, 0xc7, 0x7b, 0x2a, 0x87, 0x61, 0xe9, 0x4d, 0x4b, 0x49, 0xb8, 0xec, 0xeb, 0xcb,
+	0x50, 0xb6, 0x6c, 0x9b, 0xe7, 0xea, 0x4c, 0x5a, 0x96, 0xdb, 0x02, 0xc7, 0x0c, 0x91, 0x63, 0x92,
+	0xcb, 0x4d, 0x2d, 0x39, 0xa3, 0x0d, 0x0b, 0xf7, 0x31, 0x7d, 0x84, 0x69, 0x30, 0x53, 0x9e, 0x69,
+	0x93, 0xc5, 0x57, 0x38, 0xb1, 0x54, 0x8b, 0xf0, 0x93, 0xe5, 0xe0, 0xa0, 0xf8, 0x08, 0xb3, 0x2c,
+	0x73, 0x5c, 0xca, 0xb9, 0xa4, 0x94, 0x45, 0xf5, 0x5d, 0x7f, 0xe0, 0x7b, 0xd8, 0x4b, 0x94, 0x4a,
+	0xd4, 0x23, 0x28, 0x53, 0xbf, 0x8d, 0xeb, 0x50, 0x09, 0x53, 0x23, 0x51, 0x19, 0xf2, 0xb7, 0x5d,
+	0xb7, 0x71, 0x09, 0xe9, 0x50, 0xd9, 0x96, 0xf9, 0x7f, 0x0d, 0x6d, 0xe3, 0x3d, 0x58, 0x54, 0x14,
+	0x75, 0xa0, 0x05, 0xa8, 0xdf, 0xb6, 0x79, 0xfd, 0xce, 0x13, 0x9f, 0x01, 0x1b, 0x97, 0xd0, 0x0a,
+	0x20, 0x13, 0xf7, 0xfd, 0x03, 0x8e, 0x78, 0x2f, 0xf0, 0xfb, 0x1c, 0xae, 0x6d, 0xbc, 0x0a, 0x4b,
+	0xaa, 0x5a, 0x06, 0x54, 0x85, 0x22, 0x4f, 0xf7, 0x6f, 0x5c, 0x42, 0x00, 0x25, 0x13, 0x1f, 0xf8,
+	0x4f, 0x19, 0xfa, 0xff, 0x87, 0xf9, 0x54, 0xf0, 0x18, 0x55, 0xa0, 0xf0, 0xd8, 0xf7, 0xd8, 0x18,
+	0x0d, 0xd0, 0xef, 0x38, 0x9e, 0x15, 0x8c, 0xc4, 0xd1, 0xbd, 0x61, 0xa3, 0x79, 0xa8, 0xf1, 0x23,
+	0xac, 0x04, 0xe0, 0xcd, 0xff, 0x7a, 0x01, 0xea, 0x8f, 0xb8, 0xf4, 0x76, 0x71, 0x70, 0xe0, 0x74,
+	0x31, 0x6a, 0x43, 0x23, 0xfd, 0x4f, 0x29, 0xd0, 0x97, 0xd4, 0x1e, 0x52, 0xfd, 0xbf, 0x2b, 0x5a,
+	0x93, 0xd6, 0xc3, 0xb8, 0x84, 0xbe, 0x09, 0x73, 0xc9, 0x7f, 0xed, 0x80, 0xd4, 0x67, 0x2c, 0xe5,
+	0xff, 0x7f, 0x38, 0xae, 0xf3, 0x36, 0xd4, 0x13, 0xff, 0xa9, 0x01, 0xa9, 0xcb, 0x45, 0x54, 0xff,
+	0xcd, 0xa1, 0xa5, 0xbe, 0xf6, 0xc4, 0xff, 0x9b, 0x82, 0xe0, 0x3e, 0x59, 0xd6, 0x9d, 0xc1, 0xbd,
+	0xb2, 0xf6, 0xfb, 0x38, 0xee, 0x2d, 0x58, 0x38, 0x52, 0x1e, 0x8d, 0xd4, 0x09, 0x72, 0x59, 0x65,
+	0xd4, 0xc7, 0x0d, 0x71, 0x08, 0xe8, 0xe8, 0x7f, 0x24, 0x40, 0x37, 0xd4, 0x2b, 0x90, 0xf5, 0xff,
+	0x18, 0x5a, 0x37, 0xa7, 0xc6, 0x8f, 0x04, 0xf7, 0x6b, 0x1a, 0xac, 0x66, 0xd4, 0x34, 0xa3, 0x5b,
+	0xea, 0xf2, 0x96, 0x89, 0x85, 0xd9, 0xad, 0x37, 0x4e, 0x46, 0x14, 0x31, 0xe2, 0xc1, 0x7c, 0xaa,
+	0xcc, 0x17, 0xbd, 0x92, 0x99, 0x07, 0x7d, 0xb4, 0xde, 0xb9, 0xf5, 0xa5, 0xe9, 0x90, 0xe3, 0x1a,
+	0x93, 0xac, 0x51, 0xcd, 0xd0, 0x18, 0x65, 0x21, 0xeb, 0x71, 0xcb, 0xf9, 0x0b, 0xa0, 0xc7, 0x8b,
+	0x53, 0xd1, 0x7a, 0xa6, 0x29, 0x9d, 0xb0, 0xe3, 0x7d, 0xa8, 0x27, 0x0a, 0x49, 0x33, 0x0c, 0x49,
+	0x55, 0xb7, 0xda, 0xda, 0x98, 0x06, 0x35, 0x92, 0xcf, 0xd8, 0xe1, 0x44, 0x45, 0x99, 0x93, 0x1d,
+	0x4e, 0xba, 0x76, 0xf3, 0x78, 0x9f, 0xd0, 0x48, 0x57, 0x7d, 0x66, 0x0c, 0x90, 0x51, 0x1c, 0x3a,
+	0xa5, 0xac, 0xa2, 0x1a, 0xcd, 0x09, 0xb2, 0x4a, 0x57, 0x84, 0xb6, 0x36, 0xa6, 0x41, 0x8d, 0x64,
+	0xb5, 0x0b, 0x30, 0xae, 0xd0, 0x44, 0x5f, 0x9c, 0x20, 0xa5, 0x58, 0xd9, 0xe3, 0x71, 0xec, 0x7f,
+	0x08, 0x95, 0xb0, 0x24, 0x13, 0xbd, 0x90, 0xa9, 0x3f, 0x27, 0xe8, 0xf0, 0x13, 0x98, 0x4f, 0xed,
+	0x82, 0x19, 0x16, 0xa6, 0x2e, 0xd3, 0x9c, 0x62, 0x3d, 0xd3, 0x5b, 0x64, 0xc6, 0x7a, 0x66, 0x54,
+	0x2f, 0x1e, 0x37, 0x40, 0x07, 0x6a, 0xb1, 0x5a, 0x3e, 0xf4, 0x92, 0xda, 0xe0, 0x8f, 0xd4, 0x14,
+	0xb6, 0xd6, 0x8f, 0x47, 0x8c, 0x56, 0x92, 0xbd, 0x50, 0x24, 0x8b, 0xf4, 0x32, 0x64, 0xa4, 0x2e,
+	0xe5, 0x3b, 0x6e, 0x0a, 0xdf, 0x80, 0x7a, 0xa2, 0x9a, 0x2e, 0x43, 0x25, 0x55, 0x15, 0x77, 0xc7,
+	0xaf, 0xae, 0x1e, 0x2f, 0x7a, 0xcb, 0x70, 0x39, 0x8a, 0xba, 0xb8, 0x13, 0x6d, 0xb0, 0x11, 0x31,
+	0x99, 0xb0, 0xc1, 0x1e, 0x29, 0xd0, 0x99, 0x7e, 0x83, 0x8d, 0xf5, 0x3f, 0x71, 0x83, 0x3d, 0xf1,
+	0x10, 0xdf, 0xd5, 0x60, 0x45, 0x5d, 0xcd, 0x84, 0x36, 0xb3, 0x76, 0xac, 0xec, 0xba, 0xad, 0xd6,
+	0xad, 0x13, 0xd1, 0x44, 0x52, 0x7c, 0x0a, 0x73, 0xc9, 0x9a, 0x9d, 0x0c, 0x29, 0x2a, 0xcb, 0x9c,
+	0x5a, 0xaf, 0x4c, 0x85, 0x1b, 0x0d, 0x76, 0xc8, 0x8f, 0xe9, 0xe9, 0x32, 0x87, 0x1b, 0x59, 0x9c,
+	0xab, 0x8b, 0x62, 0x5a, 0x37, 0xa7, 0xc6, 0x8f, 0x06, 0xfe, 0x18, 0x6a, 0xb1, 0x7c, 0xeb, 0x0c,
+	0x43, 0x3d, 0x9a, 0x91, 0x3d, 0x85, 0x3f, 0x4f, 0xe4, 0xd8, 0x66, 0x19, 0x8f, 0x22, 0xf5, 0xb9,
+	0xb5, 0x31, 0x0d, 0x6a, 0x34, 0x81, 0x7d, 0xa8, 0x27, 0x32, 0x1e, 0x33, 0x46, 0x52, 0x25, 0x78,
+	0xb6, 0x36, 0xa6, 0x41, 0x8d, 0x46, 0xfa, 0x4e, 0x2c, 0xb9, 0x32, 0x91, 0xc0, 0x8a, 0x5e, 0x9f,
+	0xd8, 0x8f, 0x2a, 0x7f, 0xb7, 0xb5, 0x79, 0x12, 0x92, 0x88, 0x85, 0x8f, 0xa0, 0x1a, 0xe5, 0x4d,
+	0xa2, 0x17, 0x33, 0xfd, 0xd1, 0x49, 0x56, 0x6a, 0x17, 0x4a, 0x22, 0x3b, 0x0f, 0x19, 0x19, 0xd9,
+	0xca, 0xb1, 0x24, 0xa6, 0x96, 0x3a, 0xd0, 0x93, 0xcc, 0x8c, 0xe3, 0x1e, 0x08, 0xc6, 0x29, 0x7f,
+	0x19, 0x9b, 0xec, 0x91, 0x9c, 0xc0, 0x69, 0x3b, 0xdf, 0x85, 0x92, 0x38, 0x65, 0x64, 0x70, 0x9c,
+	0xc8, 0x1b, 0x3b, 0x41, 0xa7, 0x22, 0x5d, 0x2b, 0xa3, 0xd3, 0x44, 0x2e, 0xd7, 0xb4, 0x9d, 0x9a,
+	0x50, 0x12, 0x39, 0x0d, 0x19, 0x9d, 0x26, 0x72, 0x84, 0x5a, 0x93, 0x71, 0x44, 0x22, 0xc4, 0x25,
+	0xb4, 0x03, 0x45, 0x1e, 0x78, 0x46, 0xd7, 0x27, 0x3d, 0xf0, 0x4f, 0xea, 0x31, 0x91, 0x03, 0xc0,
+	0x0f, 0x2f, 0x45, 0x1e, 0x9c, 0xc9, 0xe8, 0x31, 0xfe, 0x4a, 0xdf, 0x9a, 0x88, 0x12, 0xb2, 0x68,
+	0x83, 0x1e, 0x7f, 0x94, 0xca, 0xd8, 0xde, 0x14, 0xcf, 0x76, 0xad, 0x69, 0x30, 0xc3, 0x51, 0xbe,
+	0xa7, 0x41, 0x33, 0xeb, 0xfd, 0x02, 0x65, 0xde, 0x6c, 0x26, 0x3d, 0xc2, 0xb4, 0xde, 0x3c, 0x21,
+	0x55, 0x24, 0xc2, 0xcf, 0x60, 0x51, 0x11, 0x55, 0x43, 0x99, 0xfe, 0x38, 0x23, 0x20, 0xd8, 0x7a,
+	0x6d, 0x7a, 0x82, 0x94, 0x03, 0x1c, 0x3f, 0x46, 0x64, 0x3b, 0xc0, 0x23, 0xcf, 0x29, 0xad, 0x8d,
+	0x69, 0x50, 0xa3, 0x91, 0xf6, 0xa0, 0x91, 0x7e, 0x3c, 0xc8, 0x38, 0x35, 0x66, 0xbc, 0x31, 0xb4,
+	0xd6, 0x26, 0xc4, 0x89, 0x79, 0x54, 0xde, 0xb8, 0xf4, 0x9a, 0xc6, 0x54, 0x9c, 0xc7, 0xf7, 0x32,
+	0x14, 0x32, 0x1e, 0x2e, 0x6c, 0x19, 0x93, 0x50, 0x22, 0xce, 0x31, 0xe8, 0xf1, 0x60, 0x5f, 0x86,
+	0x46, 0x2a, 0xe2, 0x84, 0xad, 0x97, 0xa7, 0xc0, 0x8c, 0xdd, 0xc3, 0x60, 0x1c, 0x6c, 0xcb, 0x70,
+	0x7b, 0x47, 0xe2, 0x7d, 0xad, 0x97, 0x8e, 0xc5, 0x0b, 0x07, 0xd8, 0x1c, 0x82, 0xbe, 0x13, 0xf8,
+	0x9f, 0x8e, 0xc2, 0x48, 0xd3, 0xcf, 0x67, 0x5e, 0x77, 0xde, 0xfc, 0xc5, 0x5b, 0x3d, 0x87, 0xee,
+	0x0f, 0x3b, 0x6c, 0xf7, 0xb8, 0x29, 0x70, 0x5f, 0x75, 0x7c, 0xf9, 0xeb, 0xa6, 0xe3, 0x51, 0x1c,
+	0x78, 0x96, 0x7b, 0x93, 0xf7, 0x25, 0xa1, 0x83, 0x4e, 0xa7, 0xc4, 0xbf, 0x6f, 0xfd, 0xef, 0x00,
+	0x98, 0xf0, 0xa3, 0x49, 0xe5, 0x55, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4291,6 +6227,17 @@ type MilvusServiceClient interface {
 	DescribeCollection(ctx context.Context, in *DescribeCollectionRequest, opts ...grpc.CallOption) (*DescribeCollectionResponse, error)
 	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
 	ShowCollections(ctx context.Context, in *ShowCollectionsRequest, opts ...grpc.CallOption) (*ShowCollectionsResponse, error)
+	CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropDatabase(ctx context.Context, in *DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error)
+	CreateCredential(ctx context.Context, in *CreateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DeleteCredential(ctx context.Context, in *DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListCredUsers(ctx context.Context, in *ListCredUsersRequest, opts ...grpc.CallOption) (*ListCredUsersResponse, error)
+	CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropRole(ctx context.Context, in *DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	OperateUserRole(ctx context.Context, in *OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	OperatePrivilege(ctx context.Context, in *OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	SelectGrant(ctx context.Context, in *SelectGrantRequest, opts ...grpc.CallOption) (*SelectGrantResponse, error)
 	CreatePartition(ctx context.Context, in *CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DropPartition(ctx context.Context, in *DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	HasPartition(ctx context.Context, in *HasPartitionRequest, opts ...grpc.CallOption) (*BoolResponse, error)
@@ -4298,19 +6245,26 @@ type MilvusServiceClient interface {
 	ReleasePartitions(ctx context.Context, in *ReleasePartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error)
 	ShowPartitions(ctx context.Context, in *ShowPartitionsRequest, opts ...grpc.CallOption) (*ShowPartitionsResponse, error)
+	GetLoadingProgress(ctx context.Context, in *GetLoadingProgressRequest, opts ...grpc.CallOption) (*GetLoadingProgressResponse, error)
 	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DescribeIndex(ctx context.Context, in *DescribeIndexRequest, opts ...grpc.CallOption) (*DescribeIndexResponse, error)
 	GetIndexState(ctx context.Context, in *GetIndexStateRequest, opts ...grpc.CallOption) (*GetIndexStateResponse, error)
 	GetIndexBuildProgress(ctx context.Context, in *GetIndexBuildProgressRequest, opts ...grpc.CallOption) (*GetIndexBuildProgressResponse, error)
 	DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*MutationResult, error)
+	// inserts rows given as JSON objects keyed by field name
+	InsertRows(ctx context.Context, in *InsertRowsRequest, opts ...grpc.CallOption) (*MutationResult, error)
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*MutationResult, error)
+	Upsert(ctx context.Context, in *UpsertRequest, opts ...grpc.CallOption) (*MutationResult, error)
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error)
 	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResults, error)
 	CalcDistance(ctx context.Context, in *CalcDistanceRequest, opts ...grpc.CallOption) (*CalcDistanceResults, error)
 	GetPersistentSegmentInfo(ctx context.Context, in *GetPersistentSegmentInfoRequest, opts ...grpc.CallOption) (*GetPersistentSegmentInfoResponse, error)
 	GetQuerySegmentInfo(ctx context.Context, in *GetQuerySegmentInfoRequest, opts ...grpc.CallOption) (*GetQuerySegmentInfoResponse, error)
+	GetFlushState(ctx context.Context, in *GetFlushStateRequest, opts ...grpc.CallOption) (*GetFlushStateResponse, error)
+	// streams the entities inserted into and deleted from a collection
+	SubscribeChanges(ctx context.Context, in *SubscribeChangesRequest, opts ...grpc.CallOption) (MilvusService_SubscribeChangesClient, error)
 	Dummy(ctx context.Context, in *DummyRequest, opts ...grpc.CallOption) (*DummyResponse, error)
 	// TODO: remove
 	RegisterLink(ctx context.Context, in *RegisterLinkRequest, opts ...grpc.CallOption) (*RegisterLinkResponse, error)
@@ -4398,6 +6352,105 @@ func (c *milvusServiceClient) ShowCollections(ctx context.Context, in *ShowColle
 	return out, nil
 }
 
+func (c *milvusServiceClient) CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DropDatabase(ctx context.Context, in *DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error) {
+	out := new(ListDatabasesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListDatabases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CreateCredential(ctx context.Context, in *CreateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DeleteCredential(ctx context.Context, in *DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DeleteCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ListCredUsers(ctx context.Context, in *ListCredUsersRequest, opts ...grpc.CallOption) (*ListCredUsersResponse, error) {
+	out := new(ListCredUsersResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListCredUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DropRole(ctx context.Context, in *DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) OperateUserRole(ctx context.Context, in *OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/OperateUserRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) OperatePrivilege(ctx context.Context, in *OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/OperatePrivilege", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) SelectGrant(ctx context.Context, in *SelectGrantRequest, opts ...grpc.CallOption) (*SelectGrantResponse, error) {
+	out := new(SelectGrantResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/SelectGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) CreatePartition(ctx context.Context, in *CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreatePartition", in, out, opts...)
@@ -4461,6 +6514,15 @@ func (c *milvusServiceClient) ShowPartitions(ctx context.Context, in *ShowPartit
 	return out, nil
 }
 
+func (c *milvusServiceClient) GetLoadingProgress(ctx context.Context, in *GetLoadingProgressRequest, opts ...grpc.CallOption) (*GetLoadingProgressResponse, error) {
+	out := new(GetLoadingProgressResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetLoadingProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateIndex", in, out, opts...)
@@ -4515,6 +6577,15 @@ func (c *milvusServiceClient) Insert(ctx context.Context, in *InsertRequest, opt
 	return out, nil
 }
 
+func (c *milvusServiceClient) InsertRows(ctx context.Context, in *InsertRowsRequest, opts ...grpc.CallOption) (*MutationResult, error) {
+	out := new(MutationResult)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/InsertRows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*MutationResult, error) {
 	out := new(MutationResult)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Delete", in, out, opts...)
@@ -4524,6 +6595,15 @@ func (c *milvusServiceClient) Delete(ctx context.Context, in *DeleteRequest, opt
 	return out, nil
 }
 
+func (c *milvusServiceClient) Upsert(ctx context.Context, in *UpsertRequest, opts ...grpc.CallOption) (*MutationResult, error) {
+	out := new(MutationResult)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Upsert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error) {
 	out := new(SearchResults)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Search", in, out, opts...)
@@ -4575,7 +6655,48 @@ func (c *milvusServiceClient) GetQuerySegmentInfo(ctx context.Context, in *GetQu
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetFlushState(ctx context.Context, in *GetFlushStateRequest, opts ...grpc.CallOption) (*GetFlushStateResponse, error) {
+	out := new(GetFlushStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetFlushState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) SubscribeChanges(ctx context.Context, in *SubscribeChangesRequest, opts ...grpc.CallOption) (MilvusService_SubscribeChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_MilvusService_serviceDesc.Streams[0], "/milvus.proto.milvus.MilvusService/SubscribeChanges", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &milvusServiceSubscribeChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MilvusService_SubscribeChangesClient interface {
+	Recv() (*ChangeEvent, error)
+	grpc.ClientStream
+}
+
+type milvusServiceSubscribeChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *milvusServiceSubscribeChangesClient) Recv() (*ChangeEvent, error) {
+	m := new(ChangeEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
 func (c *milvusServiceClient) Dummy(ctx context.Context, in *DummyRequest, opts ...grpc.CallOption) (*DummyResponse, error) {
@@ -4615,6 +6736,17 @@ type MilvusServiceServer interface {
 	DescribeCollection(context.Context, *DescribeCollectionRequest) (*DescribeCollectionResponse, error)
 	GetCollectionStatistics(context.Context, *GetCollectionStatisticsRequest) (*GetCollectionStatisticsResponse, error)
 	ShowCollections(context.Context, *ShowCollectionsRequest) (*ShowCollectionsResponse, error)
+	CreateDatabase(context.Context, *CreateDatabaseRequest) (*commonpb.Status, error)
+	DropDatabase(context.Context, *DropDatabaseRequest) (*commonpb.Status, error)
+	ListDatabases(context.Context, *ListDatabasesRequest) (*ListDatabasesResponse, error)
+	CreateCredential(context.Context, *CreateCredentialRequest) (*commonpb.Status, error)
+	DeleteCredential(context.Context, *DeleteCredentialRequest) (*commonpb.Status, error)
+	ListCredUsers(context.Context, *ListCredUsersRequest) (*ListCredUsersResponse, error)
+	CreateRole(context.Context, *CreateRoleRequest) (*commonpb.Status, error)
+	DropRole(context.Context, *DropRoleRequest) (*commonpb.Status, error)
+	OperateUserRole(context.Context, *OperateUserRoleRequest) (*commonpb.Status, error)
+	OperatePrivilege(context.Context, *OperatePrivilegeRequest) (*commonpb.Status, error)
+	SelectGrant(context.Context, *SelectGrantRequest) (*SelectGrantResponse, error)
 	CreatePartition(context.Context, *CreatePartitionRequest) (*commonpb.Status, error)
 	DropPartition(context.Context, *DropPartitionRequest) (*commonpb.Status, error)
 	HasPartition(context.Context, *HasPartitionRequest) (*BoolResponse, error)
@@ -4622,19 +6754,26 @@ type MilvusServiceServer interface {
 	ReleasePartitions(context.Context, *ReleasePartitionsRequest) (*commonpb.Status, error)
 	GetPartitionStatistics(context.Context, *GetPartitionStatisticsRequest) (*GetPartitionStatisticsResponse, error)
 	ShowPartitions(context.Context, *ShowPartitionsRequest) (*ShowPartitionsResponse, error)
+	GetLoadingProgress(context.Context, *GetLoadingProgressRequest) (*GetLoadingProgressResponse, error)
 	CreateIndex(context.Context, *CreateIndexRequest) (*commonpb.Status, error)
 	DescribeIndex(context.Context, *DescribeIndexRequest) (*DescribeIndexResponse, error)
 	GetIndexState(context.Context, *GetIndexStateRequest) (*GetIndexStateResponse, error)
 	GetIndexBuildProgress(context.Context, *GetIndexBuildProgressRequest) (*GetIndexBuildProgressResponse, error)
 	DropIndex(context.Context, *DropIndexRequest) (*commonpb.Status, error)
 	Insert(context.Context, *InsertRequest) (*MutationResult, error)
+	// inserts rows given as JSON objects keyed by field name
+	InsertRows(context.Context, *InsertRowsRequest) (*MutationResult, error)
 	Delete(context.Context, *DeleteRequest) (*MutationResult, error)
+	Upsert(context.Context, *UpsertRequest) (*MutationResult, error)
 	Search(context.Context, *SearchRequest) (*SearchResults, error)
 	Flush(context.Context, *FlushRequest) (*FlushResponse, error)
 	Query(context.Context, *QueryRequest) (*QueryResults, error)
 	CalcDistance(context.Context, *CalcDistanceRequest) (*CalcDistanceResults, error)
 	GetPersistentSegmentInfo(context.Context, *GetPersistentSegmentInfoRequest) (*GetPersistentSegmentInfoResponse, error)
 	GetQuerySegmentInfo(context.Context, *GetQuerySegmentInfoRequest) (*GetQuerySegmentInfoResponse, error)
+	GetFlushState(context.Context, *GetFlushStateRequest) (*GetFlushStateResponse, error)
+	// streams the entities inserted into and deleted from a collection
+	SubscribeChanges(*SubscribeChangesRequest, MilvusService_SubscribeChangesServer) error
 	Dummy(context.Context, *DummyRequest) (*DummyResponse, error)
 	// TODO: remove
 	RegisterLink(context.Context, *RegisterLinkRequest) (*RegisterLinkResponse, error)
@@ -4670,6 +6809,39 @@ func (*UnimplementedMilvusServiceServer) GetCollectionStatistics(ctx context.Con
 func (*UnimplementedMilvusServiceServer) ShowCollections(ctx context.Context, req *ShowCollectionsRequest) (*ShowCollectionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShowCollections not implemented")
 }
+func (*UnimplementedMilvusServiceServer) CreateDatabase(ctx context.Context, req *CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDatabase not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DropDatabase(ctx context.Context, req *DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropDatabase not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ListDatabases(ctx context.Context, req *ListDatabasesRequest) (*ListDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatabases not implemented")
+}
+func (*UnimplementedMilvusServiceServer) CreateCredential(ctx context.Context, req *CreateCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCredential not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DeleteCredential(ctx context.Context, req *DeleteCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredential not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ListCredUsers(ctx context.Context, req *ListCredUsersRequest) (*ListCredUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredUsers not implemented")
+}
+func (*UnimplementedMilvusServiceServer) CreateRole(ctx context.Context, req *CreateRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DropRole(ctx context.Context, req *DropRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropRole not implemented")
+}
+func (*UnimplementedMilvusServiceServer) OperateUserRole(ctx context.Context, req *OperateUserRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateUserRole not implemented")
+}
+func (*UnimplementedMilvusServiceServer) OperatePrivilege(ctx context.Context, req *OperatePrivilegeRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatePrivilege not implemented")
+}
+func (*UnimplementedMilvusServiceServer) SelectGrant(ctx context.Context, req *SelectGrantRequest) (*SelectGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectGrant not implemented")
+}
 func (*UnimplementedMilvusServiceServer) CreatePartition(ctx context.Context, req *CreatePartitionRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePartition not implemented")
 }
@@ -4691,6 +6863,9 @@ func (*UnimplementedMilvusServiceServer) GetPartitionStatistics(ctx context.Cont
 func (*UnimplementedMilvusServiceServer) ShowPartitions(ctx context.Context, req *ShowPartitionsRequest) (*ShowPartitionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShowPartitions not implemented")
 }
+func (*UnimplementedMilvusServiceServer) GetLoadingProgress(ctx context.Context, req *GetLoadingProgressRequest) (*GetLoadingProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoadingProgress not implemented")
+}
 func (*UnimplementedMilvusServiceServer) CreateIndex(ctx context.Context, req *CreateIndexRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateIndex not implemented")
 }
@@ -4709,9 +6884,15 @@ func (*UnimplementedMilvusServiceServer) DropIndex(ctx context.Context, req *Dro
 func (*UnimplementedMilvusServiceServer) Insert(ctx context.Context, req *InsertRequest) (*MutationResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Insert not implemented")
 }
+func (*UnimplementedMilvusServiceServer) InsertRows(ctx context.Context, req *InsertRowsRequest) (*MutationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertRows not implemented")
+}
 func (*UnimplementedMilvusServiceServer) Delete(ctx context.Context, req *DeleteRequest) (*MutationResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedMilvusServiceServer) Upsert(ctx context.Context, req *UpsertRequest) (*MutationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Upsert not implemented")
+}
 func (*UnimplementedMilvusServiceServer) Search(ctx context.Context, req *SearchRequest) (*SearchResults, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
 }
@@ -4730,6 +6911,12 @@ func (*UnimplementedMilvusServiceServer) GetPersistentSegmentInfo(ctx context.Co
 func (*UnimplementedMilvusServiceServer) GetQuerySegmentInfo(ctx context.Context, req *GetQuerySegmentInfoRequest) (*GetQuerySegmentInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetQuerySegmentInfo not implemented")
 }
+func (*UnimplementedMilvusServiceServer) GetFlushState(ctx context.Context, req *GetFlushStateRequest) (*GetFlushStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFlushState not implemented")
+}
+func (*UnimplementedMilvusServiceServer) SubscribeChanges(req *SubscribeChangesRequest, srv MilvusService_SubscribeChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeChanges not implemented")
+}
 func (*UnimplementedMilvusServiceServer) Dummy(ctx context.Context, req *DummyRequest) (*DummyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Dummy not implemented")
 }
@@ -4888,6 +7075,204 @@ func _MilvusService_ShowCollections_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_CreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreateDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreateDatabase(ctx, req.(*CreateDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DropDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DropDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DropDatabase(ctx, req.(*DropDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_ListDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDatabasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ListDatabases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ListDatabases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ListDatabases(ctx, req.(*ListDatabasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_CreateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreateCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreateCredential(ctx, req.(*CreateCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DeleteCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DeleteCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DeleteCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DeleteCredential(ctx, req.(*DeleteCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_ListCredUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCredUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ListCredUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ListCredUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ListCredUsers(ctx, req.(*ListCredUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreateRole(ctx, req.(*CreateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DropRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DropRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DropRole(ctx, req.(*DropRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_OperateUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateUserRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).OperateUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/OperateUserRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).OperateUserRole(ctx, req.(*OperateUserRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_OperatePrivilege_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperatePrivilegeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).OperatePrivilege(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/OperatePrivilege",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).OperatePrivilege(ctx, req.(*OperatePrivilegeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_SelectGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).SelectGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/SelectGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).SelectGrant(ctx, req.(*SelectGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_CreatePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreatePartitionRequest)
 	if err := dec(in); err != nil {
@@ -5014,6 +7399,24 @@ func _MilvusService_ShowPartitions_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_GetLoadingProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoadingProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GetLoadingProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetLoadingProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GetLoadingProgress(ctx, req.(*GetLoadingProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_CreateIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateIndexRequest)
 	if err := dec(in); err != nil {
@@ -5122,6 +7525,24 @@ func _MilvusService_Insert_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_InsertRows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertRowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).InsertRows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/InsertRows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).InsertRows(ctx, req.(*InsertRowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteRequest)
 	if err := dec(in); err != nil {
@@ -5140,6 +7561,24 @@ func _MilvusService_Delete_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_Upsert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).Upsert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/Upsert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).Upsert(ctx, req.(*UpsertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchRequest)
 	if err := dec(in); err != nil {
@@ -5248,6 +7687,45 @@ func _MilvusService_GetQuerySegmentInfo_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_GetFlushState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFlushStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GetFlushState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetFlushState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GetFlushState(ctx, req.(*GetFlushStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_SubscribeChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MilvusServiceServer).SubscribeChanges(m, &milvusServiceSubscribeChangesServer{stream})
+}
+
+type MilvusService_SubscribeChangesServer interface {
+	Send(*ChangeEvent) error
+	grpc.ServerStream
+}
+
+type milvusServiceSubscribeChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *milvusServiceSubscribeChangesServer) Send(m *ChangeEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _MilvusService_Dummy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DummyRequest)
 	if err := dec(in); err != nil {
@@ -5338,6 +7816,50 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ShowCollections",
 			Handler:    _MilvusService_ShowCollections_Handler,
 		},
+		{
+			MethodName: "CreateDatabase",
+			Handler:    _MilvusService_CreateDatabase_Handler,
+		},
+		{
+			MethodName: "DropDatabase",
+			Handler:    _MilvusService_DropDatabase_Handler,
+		},
+		{
+			MethodName: "ListDatabases",
+			Handler:    _MilvusService_ListDatabases_Handler,
+		},
+		{
+			MethodName: "CreateCredential",
+			Handler:    _MilvusService_CreateCredential_Handler,
+		},
+		{
+			MethodName: "DeleteCredential",
+			Handler:    _MilvusService_DeleteCredential_Handler,
+		},
+		{
+			MethodName: "ListCredUsers",
+			Handler:    _MilvusService_ListCredUsers_Handler,
+		},
+		{
+			MethodName: "CreateRole",
+			Handler:    _MilvusService_CreateRole_Handler,
+		},
+		{
+			MethodName: "DropRole",
+			Handler:    _MilvusService_DropRole_Handler,
+		},
+		{
+			MethodName: "OperateUserRole",
+			Handler:    _MilvusService_OperateUserRole_Handler,
+		},
+		{
+			MethodName: "OperatePrivilege",
+			Handler:    _MilvusService_OperatePrivilege_Handler,
+		},
+		{
+			MethodName: "SelectGrant",
+			Handler:    _MilvusService_SelectGrant_Handler,
+		},
 		{
 			MethodName: "CreatePartition",
 			Handler:    _MilvusService_CreatePartition_Handler,
@@ -5366,6 +7888,10 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ShowPartitions",
 			Handler:    _MilvusService_ShowPartitions_Handler,
 		},
+		{
+			MethodName: "GetLoadingProgress",
+			Handler:    _MilvusService_GetLoadingProgress_Handler,
+		},
 		{
 			MethodName: "CreateIndex",
 			Handler:    _MilvusService_CreateIndex_Handler,
@@ -5390,10 +7916,18 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Insert",
 			Handler:    _MilvusService_Insert_Handler,
 		},
+		{
+			MethodName: "InsertRows",
+			Handler:    _MilvusService_InsertRows_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _MilvusService_Delete_Handler,
 		},
+		{
+			MethodName: "Upsert",
+			Handler:    _MilvusService_Upsert_Handler,
+		},
 		{
 			MethodName: "Search",
 			Handler:    _MilvusService_Search_Handler,
@@ -5418,6 +7952,10 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetQuerySegmentInfo",
 			Handler:    _MilvusService_GetQuerySegmentInfo_Handler,
 		},
+		{
+			MethodName: "GetFlushState",
+			Handler:    _MilvusService_GetFlushState_Handler,
+		},
 		{
 			MethodName: "Dummy",
 			Handler:    _MilvusService_Dummy_Handler,
@@ -5431,7 +7969,13 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			Handler:    _MilvusService_GetMetrics_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeChanges",
+			Handler:       _MilvusService_SubscribeChanges_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "milvus.proto",
 }
 
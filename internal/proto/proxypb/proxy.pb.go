@@ -137,41 +137,83 @@ func (m *ReleaseDQLMessageStreamRequest) GetCollectionID() int64 {
 	return 0
 }
 
+type RefreshPolicyInfoCacheRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RefreshPolicyInfoCacheRequest) Reset()         { *m = RefreshPolicyInfoCacheRequest{} }
+func (m *RefreshPolicyInfoCacheRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshPolicyInfoCacheRequest) ProtoMessage()    {}
+func (*RefreshPolicyInfoCacheRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{2}
+}
+
+func (m *RefreshPolicyInfoCacheRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshPolicyInfoCacheRequest.Unmarshal(m, b)
+}
+func (m *RefreshPolicyInfoCacheRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshPolicyInfoCacheRequest.Marshal(b, m, deterministic)
+}
+func (m *RefreshPolicyInfoCacheRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshPolicyInfoCacheRequest.Merge(m, src)
+}
+func (m *RefreshPolicyInfoCacheRequest) XXX_Size() int {
+	return xxx_messageInfo_RefreshPolicyInfoCacheRequest.Size(m)
+}
+func (m *RefreshPolicyInfoCacheRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshPolicyInfoCacheRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshPolicyInfoCacheRequest proto.InternalMessageInfo
+
+func (m *RefreshPolicyInfoCacheRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*InvalidateCollMetaCacheRequest)(nil), "milvus.proto.proxy.InvalidateCollMetaCacheRequest")
 	proto.RegisterType((*ReleaseDQLMessageStreamRequest)(nil), "milvus.proto.proxy.ReleaseDQLMessageStreamRequest")
+	proto.RegisterType((*RefreshPolicyInfoCacheRequest)(nil), "milvus.proto.proxy.RefreshPolicyInfoCacheRequest")
 }
 
 func init() { proto.RegisterFile("proxy.proto", fileDescriptor_700b50b08ed8dbaf) }
 
 var fileDescriptor_700b50b08ed8dbaf = []byte{
-	// 413 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xd1, 0x0a, 0xd3, 0x30,
-	0x14, 0x5d, 0xdd, 0x9c, 0x98, 0x95, 0x09, 0x41, 0xd8, 0xa8, 0x3a, 0x46, 0x05, 0x1d, 0x82, 0xeb,
-	0xa8, 0x7e, 0xc1, 0x5a, 0x18, 0x03, 0x27, 0xda, 0xbd, 0xf9, 0x22, 0x69, 0x7b, 0xe9, 0x02, 0x69,
-	0xd2, 0x35, 0xe9, 0xd0, 0x5f, 0xf0, 0xd9, 0x1f, 0xf1, 0x0f, 0xa5, 0x69, 0xb7, 0xd9, 0x6d, 0x9d,
-	0xe8, 0x5b, 0xcf, 0xcd, 0xb9, 0x9c, 0x73, 0x6e, 0x0f, 0x1a, 0x64, 0xb9, 0xf8, 0xf6, 0x7d, 0x9e,
-	0xe5, 0x42, 0x09, 0x8c, 0x53, 0xca, 0x0e, 0x85, 0xac, 0xd0, 0x5c, 0xbf, 0x58, 0x66, 0x24, 0xd2,
-	0x54, 0xf0, 0x6a, 0x66, 0x0d, 0x29, 0x57, 0x90, 0x73, 0xc2, 0x6a, 0x6c, 0xfe, 0xb9, 0x61, 0xff,
-	0x34, 0xd0, 0x64, 0xcd, 0x0f, 0x84, 0xd1, 0x98, 0x28, 0xf0, 0x04, 0x63, 0x1b, 0x50, 0xc4, 0x23,
-	0xd1, 0x0e, 0x02, 0xd8, 0x17, 0x20, 0x15, 0x5e, 0xa0, 0x5e, 0x48, 0x24, 0x8c, 0x8d, 0xa9, 0x31,
-	0x1b, 0xb8, 0xcf, 0xe7, 0x0d, 0xc5, 0x5a, 0x6a, 0x23, 0x93, 0x25, 0x91, 0x10, 0x68, 0x26, 0x1e,
-	0xa1, 0x47, 0x71, 0xf8, 0x95, 0x93, 0x14, 0xc6, 0x0f, 0xa6, 0xc6, 0xec, 0x71, 0xd0, 0x8f, 0xc3,
-	0x8f, 0x24, 0x05, 0xfc, 0x1a, 0x3d, 0x89, 0x04, 0x63, 0x10, 0x29, 0x2a, 0x78, 0x45, 0xe8, 0x6a,
-	0xc2, 0xf0, 0x3c, 0x2e, 0x89, 0xf6, 0x0f, 0x03, 0x4d, 0x02, 0x60, 0x40, 0x24, 0xf8, 0x9f, 0x3f,
-	0x6c, 0x40, 0x4a, 0x92, 0xc0, 0x56, 0xe5, 0x40, 0xd2, 0xff, 0xb7, 0x85, 0x51, 0x2f, 0x0e, 0xd7,
-	0xbe, 0xf6, 0xd4, 0x0d, 0xf4, 0x37, 0xb6, 0x91, 0x79, 0x96, 0x5e, 0xfb, 0xda, 0x4e, 0x37, 0x68,
-	0xcc, 0xdc, 0x5f, 0x3d, 0xf4, 0xf0, 0x53, 0x79, 0x59, 0x9c, 0x21, 0xbc, 0x02, 0xe5, 0x89, 0x34,
-	0x13, 0x1c, 0xb8, 0xda, 0x2a, 0xa2, 0x40, 0xe2, 0x45, 0x53, 0xfb, 0x74, 0xef, 0x6b, 0x6a, 0xed,
-	0xdd, 0x7a, 0xd5, 0xb2, 0x71, 0x41, 0xb7, 0x3b, 0x78, 0x8f, 0x9e, 0xae, 0x40, 0x43, 0x2a, 0x15,
-	0x8d, 0xa4, 0xb7, 0x23, 0x9c, 0x03, 0xc3, 0x6e, 0xbb, 0xe6, 0x15, 0xf9, 0xa8, 0xfa, 0xb2, 0xb9,
-	0x53, 0x83, 0xad, 0xca, 0x29, 0x4f, 0x02, 0x90, 0x99, 0xe0, 0x12, 0xec, 0x0e, 0xce, 0xd1, 0x8b,
-	0x66, 0x23, 0xaa, 0x43, 0x9c, 0x7a, 0x71, 0xa9, 0x5d, 0xd5, 0xf1, 0x7e, 0x89, 0xac, 0x67, 0x37,
-	0xff, 0x4f, 0x69, 0xb5, 0x28, 0x63, 0x12, 0x64, 0xae, 0x40, 0xf9, 0xf1, 0x31, 0xde, 0x9b, 0xf6,
-	0x78, 0x27, 0xd2, 0x3f, 0xc6, 0x62, 0x68, 0xd4, 0xd2, 0xa8, 0xdb, 0x81, 0xee, 0xd7, 0xef, 0x2f,
-	0x81, 0x96, 0xef, 0xbf, 0xb8, 0x09, 0x55, 0xbb, 0x22, 0x2c, 0x5f, 0x9c, 0x8a, 0xfa, 0x96, 0x8a,
-	0xfa, 0xcb, 0x39, 0x06, 0x72, 0xf4, 0xb6, 0xa3, 0x15, 0xb3, 0x30, 0xec, 0x6b, 0xf8, 0xee, 0x77,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x46, 0xe1, 0x7c, 0xd9, 0xe3, 0x03, 0x00, 0x00,
+	// 448 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0xd1, 0x6e, 0xd3, 0x30,
+	0x14, 0x5d, 0xe8, 0x36, 0xc4, 0x5d, 0x35, 0x24, 0x0b, 0xb1, 0xa9, 0xb0, 0x69, 0x0a, 0x12, 0x4c,
+	0x48, 0xb4, 0xa3, 0xf0, 0x05, 0x4b, 0xa4, 0x2a, 0x12, 0x45, 0x9b, 0xfb, 0xc6, 0x0b, 0x72, 0x92,
+	0xbb, 0xc4, 0xc8, 0xb1, 0xb3, 0xd8, 0x99, 0xd8, 0x2f, 0xf0, 0xcc, 0x2b, 0xff, 0x8a, 0xe2, 0xa4,
+	0x1d, 0xd9, 0x92, 0x56, 0xc0, 0x9b, 0xef, 0xf5, 0xb9, 0x3e, 0xe7, 0x5c, 0x1f, 0xd8, 0xcb, 0x0b,
+	0xf5, 0xfd, 0x76, 0x9c, 0x17, 0xca, 0x28, 0x42, 0x32, 0x2e, 0x6e, 0x4a, 0x5d, 0x57, 0x63, 0x7b,
+	0x33, 0x1a, 0x46, 0x2a, 0xcb, 0x94, 0xac, 0x7b, 0xa3, 0x7d, 0x2e, 0x0d, 0x16, 0x92, 0x89, 0xa6,
+	0x1e, 0xfe, 0x39, 0xe1, 0xfe, 0x74, 0xe0, 0x38, 0x90, 0x37, 0x4c, 0xf0, 0x98, 0x19, 0xf4, 0x94,
+	0x10, 0x73, 0x34, 0xcc, 0x63, 0x51, 0x8a, 0x14, 0xaf, 0x4b, 0xd4, 0x86, 0x9c, 0xc1, 0x76, 0xc8,
+	0x34, 0x1e, 0x3a, 0x27, 0xce, 0xe9, 0xde, 0xf4, 0xe5, 0xb8, 0xc5, 0xd8, 0x50, 0xcd, 0x75, 0x72,
+	0xce, 0x34, 0x52, 0x8b, 0x24, 0x07, 0xf0, 0x38, 0x0e, 0xbf, 0x4a, 0x96, 0xe1, 0xe1, 0xa3, 0x13,
+	0xe7, 0xf4, 0x09, 0xdd, 0x8d, 0xc3, 0xcf, 0x2c, 0x43, 0xf2, 0x06, 0x9e, 0x46, 0x4a, 0x08, 0x8c,
+	0x0c, 0x57, 0xb2, 0x06, 0x0c, 0x2c, 0x60, 0xff, 0xae, 0x5d, 0x01, 0xdd, 0x1f, 0x0e, 0x1c, 0x53,
+	0x14, 0xc8, 0x34, 0xfa, 0x97, 0x9f, 0xe6, 0xa8, 0x35, 0x4b, 0x70, 0x61, 0x0a, 0x64, 0xd9, 0xbf,
+	0xcb, 0x22, 0xb0, 0x1d, 0x87, 0x81, 0x6f, 0x35, 0x0d, 0xa8, 0x3d, 0x13, 0x17, 0x86, 0x77, 0xd4,
+	0x81, 0x6f, 0xe5, 0x0c, 0x68, 0xab, 0xe7, 0x5e, 0xc2, 0x11, 0xc5, 0xab, 0x02, 0x75, 0x7a, 0xa1,
+	0x04, 0x8f, 0x6e, 0x03, 0x79, 0xa5, 0xfe, 0x6f, 0x43, 0xd3, 0x5f, 0x3b, 0xb0, 0x73, 0x51, 0x7d,
+	0x16, 0xc9, 0x81, 0xcc, 0xd0, 0x78, 0x2a, 0xcb, 0x95, 0x44, 0x69, 0x16, 0x86, 0x19, 0xd4, 0xe4,
+	0xac, 0xfd, 0xc6, 0xea, 0x0b, 0x1f, 0x42, 0x1b, 0x0d, 0xa3, 0xd7, 0x3d, 0x13, 0xf7, 0xe0, 0xee,
+	0x16, 0xb9, 0x86, 0x67, 0x33, 0xb4, 0x25, 0xd7, 0x86, 0x47, 0xda, 0x4b, 0x99, 0x94, 0x28, 0xc8,
+	0xb4, 0x9f, 0xf3, 0x01, 0x78, 0xc9, 0xfa, 0xaa, 0x3d, 0xd3, 0x14, 0x0b, 0x53, 0x70, 0x99, 0x50,
+	0xd4, 0xb9, 0x92, 0x1a, 0xdd, 0x2d, 0x52, 0xc0, 0x51, 0x3b, 0x64, 0xf5, 0x6e, 0x57, 0x51, 0xbb,
+	0xcf, 0x5d, 0x27, 0x7c, 0x7d, 0x2e, 0x47, 0x2f, 0x3a, 0xf7, 0x5c, 0x49, 0x2d, 0x2b, 0x9b, 0x0c,
+	0x86, 0x33, 0x34, 0x7e, 0xbc, 0xb4, 0xf7, 0xb6, 0xdf, 0xde, 0x0a, 0xf4, 0x97, 0xb6, 0x04, 0x1c,
+	0xf4, 0x84, 0xb4, 0xdb, 0xd0, 0xfa, 0x44, 0x6f, 0x32, 0xf4, 0x0d, 0x9e, 0x77, 0xc7, 0x90, 0xbc,
+	0xef, 0x26, 0x5b, 0x13, 0xd9, 0x0d, 0x5c, 0xe7, 0x1f, 0xbf, 0x4c, 0x13, 0x6e, 0xd2, 0x32, 0xac,
+	0x6e, 0x26, 0x35, 0xf4, 0x1d, 0x57, 0xcd, 0x69, 0xb2, 0x5c, 0xde, 0xc4, 0x4e, 0x4f, 0x2c, 0x61,
+	0x1e, 0x86, 0xbb, 0xb6, 0xfc, 0xf0, 0x7b, 0x00, 0xe4, 0x9f, 0xe3, 0xe4, 0xa2, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -191,6 +233,7 @@ type ProxyClient interface {
 	InvalidateCollectionMetaCache(ctx context.Context, in *InvalidateCollMetaCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	GetDdChannel(ctx context.Context, in *internalpb.GetDdChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
 	ReleaseDQLMessageStream(ctx context.Context, in *ReleaseDQLMessageStreamRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RefreshPolicyInfoCache(ctx context.Context, in *RefreshPolicyInfoCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type proxyClient struct {
@@ -246,6 +289,15 @@ func (c *proxyClient) ReleaseDQLMessageStream(ctx context.Context, in *ReleaseDQ
 	return out, nil
 }
 
+func (c *proxyClient) RefreshPolicyInfoCache(ctx context.Context, in *RefreshPolicyInfoCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/RefreshPolicyInfoCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProxyServer is the server API for Proxy service.
 type ProxyServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -253,6 +305,7 @@ type ProxyServer interface {
 	InvalidateCollectionMetaCache(context.Context, *InvalidateCollMetaCacheRequest) (*commonpb.Status, error)
 	GetDdChannel(context.Context, *internalpb.GetDdChannelRequest) (*milvuspb.StringResponse, error)
 	ReleaseDQLMessageStream(context.Context, *ReleaseDQLMessageStreamRequest) (*commonpb.Status, error)
+	RefreshPolicyInfoCache(context.Context, *RefreshPolicyInfoCacheRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedProxyServer can be embedded to have forward compatible implementations.
@@ -274,6 +327,9 @@ func (*UnimplementedProxyServer) GetDdChannel(ctx context.Context, req *internal
 func (*UnimplementedProxyServer) ReleaseDQLMessageStream(ctx context.Context, req *ReleaseDQLMessageStreamRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReleaseDQLMessageStream not implemented")
 }
+func (*UnimplementedProxyServer) RefreshPolicyInfoCache(ctx context.Context, req *RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshPolicyInfoCache not implemented")
+}
 
 func RegisterProxyServer(s *grpc.Server, srv ProxyServer) {
 	s.RegisterService(&_Proxy_serviceDesc, srv)
@@ -369,6 +425,24 @@ func _Proxy_ReleaseDQLMessageStream_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Proxy_RefreshPolicyInfoCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshPolicyInfoCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).RefreshPolicyInfoCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.Proxy/RefreshPolicyInfoCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).RefreshPolicyInfoCache(ctx, req.(*RefreshPolicyInfoCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Proxy_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.proxy.Proxy",
 	HandlerType: (*ProxyServer)(nil),
@@ -393,6 +467,10 @@ var _Proxy_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReleaseDQLMessageStream",
 			Handler:    _Proxy_ReleaseDQLMessageStream_Handler,
 		},
+		{
+			MethodName: "RefreshPolicyInfoCache",
+			Handler:    _Proxy_RefreshPolicyInfoCache_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proxy.proto",
@@ -72,10 +72,11 @@ func (PartitionState) EnumDescriptor() ([]byte, []int) {
 type TriggerCondition int32
 
 const (
-	TriggerCondition_handoff     TriggerCondition = 0
-	TriggerCondition_loadBalance TriggerCondition = 1
-	TriggerCondition_grpcRequest TriggerCondition = 2
-	TriggerCondition_nodeDown    TriggerCondition = 3
+	TriggerCondition_handoff      TriggerCondition = 0
+	TriggerCondition_loadBalance  TriggerCondition = 1
+	TriggerCondition_grpcRequest  TriggerCondition = 2
+	TriggerCondition_nodeDown     TriggerCondition = 3
+	TriggerCondition_nodeStopping TriggerCondition = 4
 )
 
 var TriggerCondition_name = map[int32]string{
@@ -83,13 +84,15 @@ var TriggerCondition_name = map[int32]string{
 	1: "loadBalance",
 	2: "grpcRequest",
 	3: "nodeDown",
+	4: "nodeStopping",
 }
 
 var TriggerCondition_value = map[string]int32{
-	"handoff":     0,
-	"loadBalance": 1,
-	"grpcRequest": 2,
-	"nodeDown":    3,
+	"handoff":      0,
+	"loadBalance":  1,
+	"grpcRequest":  2,
+	"nodeDown":     3,
+	"nodeStopping": 4,
 }
 
 func (x TriggerCondition) String() string {
@@ -100,7 +103,7 @@ func (TriggerCondition) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_aab7cc9a69ed26e8, []int{1}
 }
 
-//----------------etcd-----------------
+// ----------------etcd-----------------
 type SegmentState int32
 
 const (
@@ -160,7 +163,7 @@ func (LoadType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_aab7cc9a69ed26e8, []int{3}
 }
 
-//--------------------query coordinator proto------------------
+// --------------------query coordinator proto------------------
 type ShowCollectionsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
@@ -220,6 +223,7 @@ type ShowCollectionsResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	CollectionIDs        []int64          `protobuf:"varint,2,rep,packed,name=collectionIDs,proto3" json:"collectionIDs,omitempty"`
 	InMemoryPercentages  []int64          `protobuf:"varint,3,rep,packed,name=inMemory_percentages,json=inMemoryPercentages,proto3" json:"inMemory_percentages,omitempty"`
+	LoadedFields         []*LoadedFields  `protobuf:"bytes,4,rep,name=loaded_fields,json=loadedFields,proto3" json:"loaded_fields,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -271,6 +275,52 @@ func (m *ShowCollectionsResponse) GetInMemoryPercentages() []int64 {
 	return nil
 }
 
+func (m *ShowCollectionsResponse) GetLoadedFields() []*LoadedFields {
+	if m != nil {
+		return m.LoadedFields
+	}
+	return nil
+}
+
+type LoadedFields struct {
+	FieldIDs             []int64  `protobuf:"varint,1,rep,packed,name=fieldIDs,proto3" json:"fieldIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoadedFields) Reset()         { *m = LoadedFields{} }
+func (m *LoadedFields) String() string { return proto.CompactTextString(m) }
+func (*LoadedFields) ProtoMessage()    {}
+func (*LoadedFields) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{2}
+}
+
+func (m *LoadedFields) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadedFields.Unmarshal(m, b)
+}
+func (m *LoadedFields) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadedFields.Marshal(b, m, deterministic)
+}
+func (m *LoadedFields) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadedFields.Merge(m, src)
+}
+func (m *LoadedFields) XXX_Size() int {
+	return xxx_messageInfo_LoadedFields.Size(m)
+}
+func (m *LoadedFields) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadedFields.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadedFields proto.InternalMessageInfo
+
+func (m *LoadedFields) GetFieldIDs() []int64 {
+	if m != nil {
+		return m.FieldIDs
+	}
+	return nil
+}
+
 type ShowPartitionsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
@@ -285,7 +335,7 @@ func (m *ShowPartitionsRequest) Reset()         { *m = ShowPartitionsRequest{} }
 func (m *ShowPartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowPartitionsRequest) ProtoMessage()    {}
 func (*ShowPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{2}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{3}
 }
 
 func (m *ShowPartitionsRequest) XXX_Unmarshal(b []byte) error {
@@ -347,7 +397,7 @@ func (m *ShowPartitionsResponse) Reset()         { *m = ShowPartitionsResponse{}
 func (m *ShowPartitionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowPartitionsResponse) ProtoMessage()    {}
 func (*ShowPartitionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{3}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{4}
 }
 
 func (m *ShowPartitionsResponse) XXX_Unmarshal(b []byte) error {
@@ -394,6 +444,9 @@ type LoadCollectionRequest struct {
 	DbID                 int64                      `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
 	CollectionID         int64                      `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	Schema               *schemapb.CollectionSchema `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	ReplicaNumber        int32                      `protobuf:"varint,5,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
+	LoadFieldIDs         []int64                    `protobuf:"varint,6,rep,packed,name=load_fieldIDs,json=loadFieldIDs,proto3" json:"load_fieldIDs,omitempty"`
+	ResourceGroups       []string                   `protobuf:"bytes,7,rep,name=resource_groups,json=resourceGroups,proto3" json:"resource_groups,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -403,7 +456,7 @@ func (m *LoadCollectionRequest) Reset()         { *m = LoadCollectionRequest{} }
 func (m *LoadCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadCollectionRequest) ProtoMessage()    {}
 func (*LoadCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{4}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{5}
 }
 
 func (m *LoadCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -452,6 +505,27 @@ func (m *LoadCollectionRequest) GetSchema() *schemapb.CollectionSchema {
 	return nil
 }
 
+func (m *LoadCollectionRequest) GetReplicaNumber() int32 {
+	if m != nil {
+		return m.ReplicaNumber
+	}
+	return 0
+}
+
+func (m *LoadCollectionRequest) GetLoadFieldIDs() []int64 {
+	if m != nil {
+		return m.LoadFieldIDs
+	}
+	return nil
+}
+
+func (m *LoadCollectionRequest) GetResourceGroups() []string {
+	if m != nil {
+		return m.ResourceGroups
+	}
+	return nil
+}
+
 type ReleaseCollectionRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
@@ -466,7 +540,7 @@ func (m *ReleaseCollectionRequest) Reset()         { *m = ReleaseCollectionReque
 func (m *ReleaseCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*ReleaseCollectionRequest) ProtoMessage()    {}
 func (*ReleaseCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{5}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{6}
 }
 
 func (m *ReleaseCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -521,6 +595,8 @@ type LoadPartitionsRequest struct {
 	CollectionID         int64                      `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	PartitionIDs         []int64                    `protobuf:"varint,4,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
 	Schema               *schemapb.CollectionSchema `protobuf:"bytes,5,opt,name=schema,proto3" json:"schema,omitempty"`
+	ReplicaNumber        int32                      `protobuf:"varint,6,opt,name=replica_number,json=replicaNumber,proto3" json:"replica_number,omitempty"`
+	ResourceGroups       []string                   `protobuf:"bytes,7,rep,name=resource_groups,json=resourceGroups,proto3" json:"resource_groups,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -530,7 +606,7 @@ func (m *LoadPartitionsRequest) Reset()         { *m = LoadPartitionsRequest{} }
 func (m *LoadPartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadPartitionsRequest) ProtoMessage()    {}
 func (*LoadPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{6}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{7}
 }
 
 func (m *LoadPartitionsRequest) XXX_Unmarshal(b []byte) error {
@@ -586,6 +662,20 @@ func (m *LoadPartitionsRequest) GetSchema() *schemapb.CollectionSchema {
 	return nil
 }
 
+func (m *LoadPartitionsRequest) GetReplicaNumber() int32 {
+	if m != nil {
+		return m.ReplicaNumber
+	}
+	return 0
+}
+
+func (m *LoadPartitionsRequest) GetResourceGroups() []string {
+	if m != nil {
+		return m.ResourceGroups
+	}
+	return nil
+}
+
 type ReleasePartitionsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbID                 int64             `protobuf:"varint,2,opt,name=dbID,proto3" json:"dbID,omitempty"`
@@ -601,7 +691,7 @@ func (m *ReleasePartitionsRequest) Reset()         { *m = ReleasePartitionsReque
 func (m *ReleasePartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReleasePartitionsRequest) ProtoMessage()    {}
 func (*ReleasePartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{7}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{8}
 }
 
 func (m *ReleasePartitionsRequest) XXX_Unmarshal(b []byte) error {
@@ -669,7 +759,7 @@ func (m *CreateQueryChannelRequest) Reset()         { *m = CreateQueryChannelReq
 func (m *CreateQueryChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateQueryChannelRequest) ProtoMessage()    {}
 func (*CreateQueryChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{8}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{9}
 }
 
 func (m *CreateQueryChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -717,7 +807,7 @@ func (m *CreateQueryChannelResponse) Reset()         { *m = CreateQueryChannelRe
 func (m *CreateQueryChannelResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateQueryChannelResponse) ProtoMessage()    {}
 func (*CreateQueryChannelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{9}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{10}
 }
 
 func (m *CreateQueryChannelResponse) XXX_Unmarshal(b []byte) error {
@@ -773,7 +863,7 @@ func (m *GetPartitionStatesRequest) Reset()         { *m = GetPartitionStatesReq
 func (m *GetPartitionStatesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetPartitionStatesRequest) ProtoMessage()    {}
 func (*GetPartitionStatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{10}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{11}
 }
 
 func (m *GetPartitionStatesRequest) XXX_Unmarshal(b []byte) error {
@@ -835,7 +925,7 @@ func (m *PartitionStates) Reset()         { *m = PartitionStates{} }
 func (m *PartitionStates) String() string { return proto.CompactTextString(m) }
 func (*PartitionStates) ProtoMessage()    {}
 func (*PartitionStates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{11}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{12}
 }
 
 func (m *PartitionStates) XXX_Unmarshal(b []byte) error {
@@ -889,7 +979,7 @@ func (m *GetPartitionStatesResponse) Reset()         { *m = GetPartitionStatesRe
 func (m *GetPartitionStatesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetPartitionStatesResponse) ProtoMessage()    {}
 func (*GetPartitionStatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{12}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{13}
 }
 
 func (m *GetPartitionStatesResponse) XXX_Unmarshal(b []byte) error {
@@ -936,7 +1026,7 @@ func (m *GetSegmentInfoRequest) Reset()         { *m = GetSegmentInfoRequest{} }
 func (m *GetSegmentInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetSegmentInfoRequest) ProtoMessage()    {}
 func (*GetSegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{13}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{14}
 }
 
 func (m *GetSegmentInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -972,26 +1062,31 @@ func (m *GetSegmentInfoRequest) GetSegmentIDs() []int64 {
 }
 
 type SegmentInfo struct {
-	SegmentID            int64        `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	CollectionID         int64        `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionID          int64        `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	NodeID               int64        `protobuf:"varint,4,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
-	MemSize              int64        `protobuf:"varint,5,opt,name=mem_size,json=memSize,proto3" json:"mem_size,omitempty"`
-	NumRows              int64        `protobuf:"varint,6,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	IndexName            string       `protobuf:"bytes,7,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	IndexID              int64        `protobuf:"varint,8,opt,name=indexID,proto3" json:"indexID,omitempty"`
-	ChannelID            string       `protobuf:"bytes,9,opt,name=channelID,proto3" json:"channelID,omitempty"`
-	SegmentState         SegmentState `protobuf:"varint,10,opt,name=segment_state,json=segmentState,proto3,enum=milvus.proto.query.SegmentState" json:"segment_state,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	SegmentID    int64        `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	CollectionID int64        `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID  int64        `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	NodeID       int64        `protobuf:"varint,4,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	MemSize      int64        `protobuf:"varint,5,opt,name=mem_size,json=memSize,proto3" json:"mem_size,omitempty"`
+	NumRows      int64        `protobuf:"varint,6,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	IndexName    string       `protobuf:"bytes,7,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexID      int64        `protobuf:"varint,8,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	ChannelID    string       `protobuf:"bytes,9,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	SegmentState SegmentState `protobuf:"varint,10,opt,name=segment_state,json=segmentState,proto3,enum=milvus.proto.query.SegmentState" json:"segment_state,omitempty"`
+	// all the query nodes holding a copy of the segment, one per replica,
+	// nodeID is the first of them
+	NodeIds []int64 `protobuf:"varint,11,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	// the index builds of a flushed segment its handoff waits for
+	IndexBuildIDs        []int64  `protobuf:"varint,12,rep,packed,name=index_buildIDs,json=indexBuildIDs,proto3" json:"index_buildIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SegmentInfo) Reset()         { *m = SegmentInfo{} }
 func (m *SegmentInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentInfo) ProtoMessage()    {}
 func (*SegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{14}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{15}
 }
 
 func (m *SegmentInfo) XXX_Unmarshal(b []byte) error {
@@ -1082,6 +1177,20 @@ func (m *SegmentInfo) GetSegmentState() SegmentState {
 	return SegmentState_None
 }
 
+func (m *SegmentInfo) GetNodeIds() []int64 {
+	if m != nil {
+		return m.NodeIds
+	}
+	return nil
+}
+
+func (m *SegmentInfo) GetIndexBuildIDs() []int64 {
+	if m != nil {
+		return m.IndexBuildIDs
+	}
+	return nil
+}
+
 type GetSegmentInfoResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Infos                []*SegmentInfo   `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
@@ -1094,7 +1203,7 @@ func (m *GetSegmentInfoResponse) Reset()         { *m = GetSegmentInfoResponse{}
 func (m *GetSegmentInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSegmentInfoResponse) ProtoMessage()    {}
 func (*GetSegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{15}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{16}
 }
 
 func (m *GetSegmentInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -1129,7 +1238,7 @@ func (m *GetSegmentInfoResponse) GetInfos() []*SegmentInfo {
 	return nil
 }
 
-//-----------------query node proto----------------
+// -----------------query node proto----------------
 type AddQueryChannelRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	NodeID               int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
@@ -1145,7 +1254,7 @@ func (m *AddQueryChannelRequest) Reset()         { *m = AddQueryChannelRequest{}
 func (m *AddQueryChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*AddQueryChannelRequest) ProtoMessage()    {}
 func (*AddQueryChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{16}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{17}
 }
 
 func (m *AddQueryChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -1216,7 +1325,7 @@ func (m *RemoveQueryChannelRequest) Reset()         { *m = RemoveQueryChannelReq
 func (m *RemoveQueryChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveQueryChannelRequest) ProtoMessage()    {}
 func (*RemoveQueryChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{17}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{18}
 }
 
 func (m *RemoveQueryChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -1280,6 +1389,8 @@ type WatchDmChannelsRequest struct {
 	Infos                []*datapb.VchannelInfo     `protobuf:"bytes,5,rep,name=infos,proto3" json:"infos,omitempty"`
 	Schema               *schemapb.CollectionSchema `protobuf:"bytes,6,opt,name=schema,proto3" json:"schema,omitempty"`
 	ExcludeInfos         []*datapb.SegmentInfo      `protobuf:"bytes,7,rep,name=exclude_infos,json=excludeInfos,proto3" json:"exclude_infos,omitempty"`
+	ReplicaID            int64                      `protobuf:"varint,8,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	LoadFieldIDs         []int64                    `protobuf:"varint,9,rep,packed,name=load_fieldIDs,json=loadFieldIDs,proto3" json:"load_fieldIDs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -1289,7 +1400,7 @@ func (m *WatchDmChannelsRequest) Reset()         { *m = WatchDmChannelsRequest{}
 func (m *WatchDmChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchDmChannelsRequest) ProtoMessage()    {}
 func (*WatchDmChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{18}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{19}
 }
 
 func (m *WatchDmChannelsRequest) XXX_Unmarshal(b []byte) error {
@@ -1359,7 +1470,21 @@ func (m *WatchDmChannelsRequest) GetExcludeInfos() []*datapb.SegmentInfo {
 	return nil
 }
 
-//used for handoff task
+func (m *WatchDmChannelsRequest) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
+func (m *WatchDmChannelsRequest) GetLoadFieldIDs() []int64 {
+	if m != nil {
+		return m.LoadFieldIDs
+	}
+	return nil
+}
+
+// used for handoff task
 type SegmentLoadInfo struct {
 	SegmentID            int64                 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	PartitionID          int64                 `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
@@ -1367,6 +1492,11 @@ type SegmentLoadInfo struct {
 	DbID                 int64                 `protobuf:"varint,4,opt,name=dbID,proto3" json:"dbID,omitempty"`
 	FlushTime            int64                 `protobuf:"varint,5,opt,name=flush_time,json=flushTime,proto3" json:"flush_time,omitempty"`
 	BinlogPaths          []*datapb.FieldBinlog `protobuf:"bytes,6,rep,name=binlog_paths,json=binlogPaths,proto3" json:"binlog_paths,omitempty"`
+	NumOfRows            int64                 `protobuf:"varint,7,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	InsertChannel        string                `protobuf:"bytes,8,opt,name=insert_channel,json=insertChannel,proto3" json:"insert_channel,omitempty"`
+	Statslogs            []*datapb.FieldBinlog `protobuf:"bytes,9,rep,name=statslogs,proto3" json:"statslogs,omitempty"`
+	Deltalogs            []string              `protobuf:"bytes,10,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	IndexSize            int64                 `protobuf:"varint,11,opt,name=index_size,json=indexSize,proto3" json:"index_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -1376,7 +1506,7 @@ func (m *SegmentLoadInfo) Reset()         { *m = SegmentLoadInfo{} }
 func (m *SegmentLoadInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentLoadInfo) ProtoMessage()    {}
 func (*SegmentLoadInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{19}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{20}
 }
 
 func (m *SegmentLoadInfo) XXX_Unmarshal(b []byte) error {
@@ -1439,12 +1569,49 @@ func (m *SegmentLoadInfo) GetBinlogPaths() []*datapb.FieldBinlog {
 	return nil
 }
 
+func (m *SegmentLoadInfo) GetNumOfRows() int64 {
+	if m != nil {
+		return m.NumOfRows
+	}
+	return 0
+}
+
+func (m *SegmentLoadInfo) GetInsertChannel() string {
+	if m != nil {
+		return m.InsertChannel
+	}
+	return ""
+}
+
+func (m *SegmentLoadInfo) GetStatslogs() []*datapb.FieldBinlog {
+	if m != nil {
+		return m.Statslogs
+	}
+	return nil
+}
+
+func (m *SegmentLoadInfo) GetDeltalogs() []string {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
+}
+
+func (m *SegmentLoadInfo) GetIndexSize() int64 {
+	if m != nil {
+		return m.IndexSize
+	}
+	return 0
+}
+
 type LoadSegmentsRequest struct {
 	Base                 *commonpb.MsgBase          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	NodeID               int64                      `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
 	Infos                []*SegmentLoadInfo         `protobuf:"bytes,3,rep,name=infos,proto3" json:"infos,omitempty"`
 	Schema               *schemapb.CollectionSchema `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
 	LoadCondition        TriggerCondition           `protobuf:"varint,5,opt,name=load_condition,json=loadCondition,proto3,enum=milvus.proto.query.TriggerCondition" json:"load_condition,omitempty"`
+	ReplicaID            int64                      `protobuf:"varint,6,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	LoadFieldIDs         []int64                    `protobuf:"varint,7,rep,packed,name=load_fieldIDs,json=loadFieldIDs,proto3" json:"load_fieldIDs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -1454,7 +1621,7 @@ func (m *LoadSegmentsRequest) Reset()         { *m = LoadSegmentsRequest{} }
 func (m *LoadSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadSegmentsRequest) ProtoMessage()    {}
 func (*LoadSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{20}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{21}
 }
 
 func (m *LoadSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -1510,6 +1677,20 @@ func (m *LoadSegmentsRequest) GetLoadCondition() TriggerCondition {
 	return TriggerCondition_handoff
 }
 
+func (m *LoadSegmentsRequest) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
+func (m *LoadSegmentsRequest) GetLoadFieldIDs() []int64 {
+	if m != nil {
+		return m.LoadFieldIDs
+	}
+	return nil
+}
+
 type ReleaseSegmentsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	NodeID               int64             `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
@@ -1526,7 +1707,7 @@ func (m *ReleaseSegmentsRequest) Reset()         { *m = ReleaseSegmentsRequest{}
 func (m *ReleaseSegmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReleaseSegmentsRequest) ProtoMessage()    {}
 func (*ReleaseSegmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{21}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{22}
 }
 
 func (m *ReleaseSegmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -1601,7 +1782,7 @@ func (m *DmChannelInfo) Reset()         { *m = DmChannelInfo{} }
 func (m *DmChannelInfo) String() string { return proto.CompactTextString(m) }
 func (*DmChannelInfo) ProtoMessage()    {}
 func (*DmChannelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{22}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{23}
 }
 
 func (m *DmChannelInfo) XXX_Unmarshal(b []byte) error {
@@ -1649,7 +1830,7 @@ func (m *QueryChannelInfo) Reset()         { *m = QueryChannelInfo{} }
 func (m *QueryChannelInfo) String() string { return proto.CompactTextString(m) }
 func (*QueryChannelInfo) ProtoMessage()    {}
 func (*QueryChannelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{23}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{24}
 }
 
 func (m *QueryChannelInfo) XXX_Unmarshal(b []byte) error {
@@ -1700,6 +1881,7 @@ type CollectionInfo struct {
 	Schema               *schemapb.CollectionSchema `protobuf:"bytes,6,opt,name=schema,proto3" json:"schema,omitempty"`
 	ReleasedPartitionIDs []int64                    `protobuf:"varint,7,rep,packed,name=released_partitionIDs,json=releasedPartitionIDs,proto3" json:"released_partitionIDs,omitempty"`
 	InMemoryPercentage   int64                      `protobuf:"varint,8,opt,name=inMemory_percentage,json=inMemoryPercentage,proto3" json:"inMemory_percentage,omitempty"`
+	LoadFieldIDs         []int64                    `protobuf:"varint,9,rep,packed,name=load_fieldIDs,json=loadFieldIDs,proto3" json:"load_fieldIDs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -1709,7 +1891,7 @@ func (m *CollectionInfo) Reset()         { *m = CollectionInfo{} }
 func (m *CollectionInfo) String() string { return proto.CompactTextString(m) }
 func (*CollectionInfo) ProtoMessage()    {}
 func (*CollectionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{24}
+	return fileDescriptor_aab7cc9a69ed26e8, []int{25}
 }
 
 func (m *CollectionInfo) XXX_Unmarshal(b []byte) error {
@@ -1786,201 +1968,1162 @@ func (m *CollectionInfo) GetInMemoryPercentage() int64 {
 	return 0
 }
 
-type HandoffSegments struct {
-	Base                 *commonpb.MsgBase  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Infos                []*SegmentLoadInfo `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+func (m *CollectionInfo) GetLoadFieldIDs() []int64 {
+	if m != nil {
+		return m.LoadFieldIDs
+	}
+	return nil
 }
 
-func (m *HandoffSegments) Reset()         { *m = HandoffSegments{} }
-func (m *HandoffSegments) String() string { return proto.CompactTextString(m) }
-func (*HandoffSegments) ProtoMessage()    {}
-func (*HandoffSegments) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{25}
+// ReplicaInfo is a group of query nodes holding a full copy of a loaded collection,
+// the query nodes of different replicas of a collection are disjoint
+type ReplicaInfo struct {
+	ReplicaID            int64    `protobuf:"varint,1,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	NodeIds              []int64  `protobuf:"varint,3,rep,packed,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	ResourceGroup        string   `protobuf:"bytes,4,opt,name=resource_group,json=resourceGroup,proto3" json:"resource_group,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *HandoffSegments) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HandoffSegments.Unmarshal(m, b)
+func (m *ReplicaInfo) Reset()         { *m = ReplicaInfo{} }
+func (m *ReplicaInfo) String() string { return proto.CompactTextString(m) }
+func (*ReplicaInfo) ProtoMessage()    {}
+func (*ReplicaInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{26}
 }
-func (m *HandoffSegments) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HandoffSegments.Marshal(b, m, deterministic)
+
+func (m *ReplicaInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplicaInfo.Unmarshal(m, b)
 }
-func (m *HandoffSegments) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HandoffSegments.Merge(m, src)
+func (m *ReplicaInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplicaInfo.Marshal(b, m, deterministic)
 }
-func (m *HandoffSegments) XXX_Size() int {
-	return xxx_messageInfo_HandoffSegments.Size(m)
+func (m *ReplicaInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicaInfo.Merge(m, src)
 }
-func (m *HandoffSegments) XXX_DiscardUnknown() {
-	xxx_messageInfo_HandoffSegments.DiscardUnknown(m)
+func (m *ReplicaInfo) XXX_Size() int {
+	return xxx_messageInfo_ReplicaInfo.Size(m)
+}
+func (m *ReplicaInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicaInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HandoffSegments proto.InternalMessageInfo
+var xxx_messageInfo_ReplicaInfo proto.InternalMessageInfo
 
-func (m *HandoffSegments) GetBase() *commonpb.MsgBase {
+func (m *ReplicaInfo) GetReplicaID() int64 {
 	if m != nil {
-		return m.Base
+		return m.ReplicaID
 	}
-	return nil
+	return 0
 }
 
-func (m *HandoffSegments) GetInfos() []*SegmentLoadInfo {
+func (m *ReplicaInfo) GetCollectionID() int64 {
 	if m != nil {
-		return m.Infos
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ReplicaInfo) GetNodeIds() []int64 {
+	if m != nil {
+		return m.NodeIds
 	}
 	return nil
 }
 
-type LoadBalanceSegmentInfo struct {
-	SegmentID            int64    `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	PartitionID          int64    `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	CollectionID         int64    `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	SourceNodeID         int64    `protobuf:"varint,4,opt,name=source_nodeID,json=sourceNodeID,proto3" json:"source_nodeID,omitempty"`
-	DstNodeID            int64    `protobuf:"varint,5,opt,name=dst_nodeID,json=dstNodeID,proto3" json:"dst_nodeID,omitempty"`
-	SourceDone           bool     `protobuf:"varint,6,opt,name=source_done,json=sourceDone,proto3" json:"source_done,omitempty"`
-	DstDone              bool     `protobuf:"varint,7,opt,name=dst_done,json=dstDone,proto3" json:"dst_done,omitempty"`
-	ValidInfo            bool     `protobuf:"varint,8,opt,name=valid_info,json=validInfo,proto3" json:"valid_info,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ReplicaInfo) GetResourceGroup() string {
+	if m != nil {
+		return m.ResourceGroup
+	}
+	return ""
 }
 
-func (m *LoadBalanceSegmentInfo) Reset()         { *m = LoadBalanceSegmentInfo{} }
-func (m *LoadBalanceSegmentInfo) String() string { return proto.CompactTextString(m) }
-func (*LoadBalanceSegmentInfo) ProtoMessage()    {}
-func (*LoadBalanceSegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{26}
+type GetReplicasRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *LoadBalanceSegmentInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LoadBalanceSegmentInfo.Unmarshal(m, b)
+func (m *GetReplicasRequest) Reset()         { *m = GetReplicasRequest{} }
+func (m *GetReplicasRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReplicasRequest) ProtoMessage()    {}
+func (*GetReplicasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{27}
 }
-func (m *LoadBalanceSegmentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LoadBalanceSegmentInfo.Marshal(b, m, deterministic)
+
+func (m *GetReplicasRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReplicasRequest.Unmarshal(m, b)
 }
-func (m *LoadBalanceSegmentInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LoadBalanceSegmentInfo.Merge(m, src)
+func (m *GetReplicasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReplicasRequest.Marshal(b, m, deterministic)
 }
-func (m *LoadBalanceSegmentInfo) XXX_Size() int {
-	return xxx_messageInfo_LoadBalanceSegmentInfo.Size(m)
+func (m *GetReplicasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicasRequest.Merge(m, src)
 }
-func (m *LoadBalanceSegmentInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_LoadBalanceSegmentInfo.DiscardUnknown(m)
+func (m *GetReplicasRequest) XXX_Size() int {
+	return xxx_messageInfo_GetReplicasRequest.Size(m)
+}
+func (m *GetReplicasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicasRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LoadBalanceSegmentInfo proto.InternalMessageInfo
+var xxx_messageInfo_GetReplicasRequest proto.InternalMessageInfo
 
-func (m *LoadBalanceSegmentInfo) GetSegmentID() int64 {
+func (m *GetReplicasRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.SegmentID
+		return m.Base
 	}
-	return 0
+	return nil
 }
 
-func (m *LoadBalanceSegmentInfo) GetPartitionID() int64 {
+func (m *GetReplicasRequest) GetCollectionID() int64 {
 	if m != nil {
-		return m.PartitionID
+		return m.CollectionID
 	}
 	return 0
 }
 
-func (m *LoadBalanceSegmentInfo) GetCollectionID() int64 {
-	if m != nil {
-		return m.CollectionID
-	}
-	return 0
+type GetReplicasResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// only the replicas whose query nodes are all on service
+	Replicas             []*ReplicaInfo `protobuf:"bytes,2,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *LoadBalanceSegmentInfo) GetSourceNodeID() int64 {
-	if m != nil {
-		return m.SourceNodeID
-	}
-	return 0
+func (m *GetReplicasResponse) Reset()         { *m = GetReplicasResponse{} }
+func (m *GetReplicasResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReplicasResponse) ProtoMessage()    {}
+func (*GetReplicasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{28}
+}
+
+func (m *GetReplicasResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReplicasResponse.Unmarshal(m, b)
+}
+func (m *GetReplicasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReplicasResponse.Marshal(b, m, deterministic)
+}
+func (m *GetReplicasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicasResponse.Merge(m, src)
+}
+func (m *GetReplicasResponse) XXX_Size() int {
+	return xxx_messageInfo_GetReplicasResponse.Size(m)
+}
+func (m *GetReplicasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReplicasResponse proto.InternalMessageInfo
+
+func (m *GetReplicasResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetReplicasResponse) GetReplicas() []*ReplicaInfo {
+	if m != nil {
+		return m.Replicas
+	}
+	return nil
+}
+
+// LoadingProgress counts the segments planned by the load requests and the ones loaded,
+// the replicas of a segment are counted separately
+type LoadingProgress struct {
+	TotalSegments        int64    `protobuf:"varint,1,opt,name=total_segments,json=totalSegments,proto3" json:"total_segments,omitempty"`
+	LoadedSegments       int64    `protobuf:"varint,2,opt,name=loaded_segments,json=loadedSegments,proto3" json:"loaded_segments,omitempty"`
+	TotalRows            int64    `protobuf:"varint,3,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	LoadedRows           int64    `protobuf:"varint,4,opt,name=loaded_rows,json=loadedRows,proto3" json:"loaded_rows,omitempty"`
+	Percentage           int64    `protobuf:"varint,5,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoadingProgress) Reset()         { *m = LoadingProgress{} }
+func (m *LoadingProgress) String() string { return proto.CompactTextString(m) }
+func (*LoadingProgress) ProtoMessage()    {}
+func (*LoadingProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{29}
+}
+
+func (m *LoadingProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadingProgress.Unmarshal(m, b)
+}
+func (m *LoadingProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadingProgress.Marshal(b, m, deterministic)
+}
+func (m *LoadingProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadingProgress.Merge(m, src)
+}
+func (m *LoadingProgress) XXX_Size() int {
+	return xxx_messageInfo_LoadingProgress.Size(m)
+}
+func (m *LoadingProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadingProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadingProgress proto.InternalMessageInfo
+
+func (m *LoadingProgress) GetTotalSegments() int64 {
+	if m != nil {
+		return m.TotalSegments
+	}
+	return 0
+}
+
+func (m *LoadingProgress) GetLoadedSegments() int64 {
+	if m != nil {
+		return m.LoadedSegments
+	}
+	return 0
+}
+
+func (m *LoadingProgress) GetTotalRows() int64 {
+	if m != nil {
+		return m.TotalRows
+	}
+	return 0
+}
+
+func (m *LoadingProgress) GetLoadedRows() int64 {
+	if m != nil {
+		return m.LoadedRows
+	}
+	return 0
+}
+
+func (m *LoadingProgress) GetPercentage() int64 {
+	if m != nil {
+		return m.Percentage
+	}
+	return 0
+}
+
+type PartitionLoadingProgress struct {
+	PartitionID          int64            `protobuf:"varint,1,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	Progress             *LoadingProgress `protobuf:"bytes,2,opt,name=progress,proto3" json:"progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *PartitionLoadingProgress) Reset()         { *m = PartitionLoadingProgress{} }
+func (m *PartitionLoadingProgress) String() string { return proto.CompactTextString(m) }
+func (*PartitionLoadingProgress) ProtoMessage()    {}
+func (*PartitionLoadingProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{30}
+}
+
+func (m *PartitionLoadingProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartitionLoadingProgress.Unmarshal(m, b)
+}
+func (m *PartitionLoadingProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartitionLoadingProgress.Marshal(b, m, deterministic)
+}
+func (m *PartitionLoadingProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionLoadingProgress.Merge(m, src)
+}
+func (m *PartitionLoadingProgress) XXX_Size() int {
+	return xxx_messageInfo_PartitionLoadingProgress.Size(m)
+}
+func (m *PartitionLoadingProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionLoadingProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionLoadingProgress proto.InternalMessageInfo
+
+func (m *PartitionLoadingProgress) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *PartitionLoadingProgress) GetProgress() *LoadingProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+type NodeLoadingProgress struct {
+	NodeID               int64            `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Progress             *LoadingProgress `protobuf:"bytes,2,opt,name=progress,proto3" json:"progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *NodeLoadingProgress) Reset()         { *m = NodeLoadingProgress{} }
+func (m *NodeLoadingProgress) String() string { return proto.CompactTextString(m) }
+func (*NodeLoadingProgress) ProtoMessage()    {}
+func (*NodeLoadingProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{31}
+}
+
+func (m *NodeLoadingProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeLoadingProgress.Unmarshal(m, b)
+}
+func (m *NodeLoadingProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeLoadingProgress.Marshal(b, m, deterministic)
+}
+func (m *NodeLoadingProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeLoadingProgress.Merge(m, src)
+}
+func (m *NodeLoadingProgress) XXX_Size() int {
+	return xxx_messageInfo_NodeLoadingProgress.Size(m)
+}
+func (m *NodeLoadingProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeLoadingProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeLoadingProgress proto.InternalMessageInfo
+
+func (m *NodeLoadingProgress) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *NodeLoadingProgress) GetProgress() *LoadingProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+type GetLoadingProgressRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// all the loaded partitions if empty
+	PartitionIDs         []int64  `protobuf:"varint,3,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLoadingProgressRequest) Reset()         { *m = GetLoadingProgressRequest{} }
+func (m *GetLoadingProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLoadingProgressRequest) ProtoMessage()    {}
+func (*GetLoadingProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{32}
+}
+
+func (m *GetLoadingProgressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLoadingProgressRequest.Unmarshal(m, b)
+}
+func (m *GetLoadingProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLoadingProgressRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLoadingProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLoadingProgressRequest.Merge(m, src)
+}
+func (m *GetLoadingProgressRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLoadingProgressRequest.Size(m)
+}
+func (m *GetLoadingProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLoadingProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLoadingProgressRequest proto.InternalMessageInfo
+
+func (m *GetLoadingProgressRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetLoadingProgressRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *GetLoadingProgressRequest) GetPartitionIDs() []int64 {
+	if m != nil {
+		return m.PartitionIDs
+	}
+	return nil
+}
+
+type GetLoadingProgressResponse struct {
+	Status               *commonpb.Status            `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Progress             *LoadingProgress            `protobuf:"bytes,2,opt,name=progress,proto3" json:"progress,omitempty"`
+	PartitionProgresses  []*PartitionLoadingProgress `protobuf:"bytes,3,rep,name=partition_progresses,json=partitionProgresses,proto3" json:"partition_progresses,omitempty"`
+	NodeProgresses       []*NodeLoadingProgress      `protobuf:"bytes,4,rep,name=node_progresses,json=nodeProgresses,proto3" json:"node_progresses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *GetLoadingProgressResponse) Reset()         { *m = GetLoadingProgressResponse{} }
+func (m *GetLoadingProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLoadingProgressResponse) ProtoMessage()    {}
+func (*GetLoadingProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{33}
+}
+
+func (m *GetLoadingProgressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLoadingProgressResponse.Unmarshal(m, b)
+}
+func (m *GetLoadingProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLoadingProgressResponse.Marshal(b, m, deterministic)
+}
+func (m *GetLoadingProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLoadingProgressResponse.Merge(m, src)
+}
+func (m *GetLoadingProgressResponse) XXX_Size() int {
+	return xxx_messageInfo_GetLoadingProgressResponse.Size(m)
+}
+func (m *GetLoadingProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLoadingProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLoadingProgressResponse proto.InternalMessageInfo
+
+func (m *GetLoadingProgressResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetLoadingProgressResponse) GetProgress() *LoadingProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+func (m *GetLoadingProgressResponse) GetPartitionProgresses() []*PartitionLoadingProgress {
+	if m != nil {
+		return m.PartitionProgresses
+	}
+	return nil
+}
+
+func (m *GetLoadingProgressResponse) GetNodeProgresses() []*NodeLoadingProgress {
+	if m != nil {
+		return m.NodeProgresses
+	}
+	return nil
+}
+
+type GetShardLeadersRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetShardLeadersRequest) Reset()         { *m = GetShardLeadersRequest{} }
+func (m *GetShardLeadersRequest) String() string { return proto.CompactTextString(m) }
+func (*GetShardLeadersRequest) ProtoMessage()    {}
+func (*GetShardLeadersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{34}
+}
+
+func (m *GetShardLeadersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetShardLeadersRequest.Unmarshal(m, b)
+}
+func (m *GetShardLeadersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetShardLeadersRequest.Marshal(b, m, deterministic)
+}
+func (m *GetShardLeadersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShardLeadersRequest.Merge(m, src)
+}
+func (m *GetShardLeadersRequest) XXX_Size() int {
+	return xxx_messageInfo_GetShardLeadersRequest.Size(m)
+}
+func (m *GetShardLeadersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShardLeadersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShardLeadersRequest proto.InternalMessageInfo
+
+func (m *GetShardLeadersRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetShardLeadersRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+// ShardLeader is a query node of a replica, the leader of the DM channels it consumes
+type ShardLeader struct {
+	NodeID               int64    `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	DmChannels           []string `protobuf:"bytes,3,rep,name=dm_channels,json=dmChannels,proto3" json:"dm_channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShardLeader) Reset()         { *m = ShardLeader{} }
+func (m *ShardLeader) String() string { return proto.CompactTextString(m) }
+func (*ShardLeader) ProtoMessage()    {}
+func (*ShardLeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{35}
+}
+
+func (m *ShardLeader) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardLeader.Unmarshal(m, b)
+}
+func (m *ShardLeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardLeader.Marshal(b, m, deterministic)
+}
+func (m *ShardLeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardLeader.Merge(m, src)
+}
+func (m *ShardLeader) XXX_Size() int {
+	return xxx_messageInfo_ShardLeader.Size(m)
+}
+func (m *ShardLeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardLeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardLeader proto.InternalMessageInfo
+
+func (m *ShardLeader) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *ShardLeader) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ShardLeader) GetDmChannels() []string {
+	if m != nil {
+		return m.DmChannels
+	}
+	return nil
+}
+
+type ShardLeadersList struct {
+	ReplicaID            int64          `protobuf:"varint,1,opt,name=replicaID,proto3" json:"replicaID,omitempty"`
+	Leaders              []*ShardLeader `protobuf:"bytes,2,rep,name=leaders,proto3" json:"leaders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ShardLeadersList) Reset()         { *m = ShardLeadersList{} }
+func (m *ShardLeadersList) String() string { return proto.CompactTextString(m) }
+func (*ShardLeadersList) ProtoMessage()    {}
+func (*ShardLeadersList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{36}
+}
+
+func (m *ShardLeadersList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardLeadersList.Unmarshal(m, b)
+}
+func (m *ShardLeadersList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardLeadersList.Marshal(b, m, deterministic)
+}
+func (m *ShardLeadersList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardLeadersList.Merge(m, src)
+}
+func (m *ShardLeadersList) XXX_Size() int {
+	return xxx_messageInfo_ShardLeadersList.Size(m)
+}
+func (m *ShardLeadersList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardLeadersList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardLeadersList proto.InternalMessageInfo
+
+func (m *ShardLeadersList) GetReplicaID() int64 {
+	if m != nil {
+		return m.ReplicaID
+	}
+	return 0
+}
+
+func (m *ShardLeadersList) GetLeaders() []*ShardLeader {
+	if m != nil {
+		return m.Leaders
+	}
+	return nil
+}
+
+type GetShardLeadersResponse struct {
+	Status               *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Replicas             []*ShardLeadersList `protobuf:"bytes,2,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GetShardLeadersResponse) Reset()         { *m = GetShardLeadersResponse{} }
+func (m *GetShardLeadersResponse) String() string { return proto.CompactTextString(m) }
+func (*GetShardLeadersResponse) ProtoMessage()    {}
+func (*GetShardLeadersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{37}
+}
+
+func (m *GetShardLeadersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetShardLeadersResponse.Unmarshal(m, b)
+}
+func (m *GetShardLeadersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetShardLeadersResponse.Marshal(b, m, deterministic)
+}
+func (m *GetShardLeadersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShardLeadersResponse.Merge(m, src)
+}
+func (m *GetShardLeadersResponse) XXX_Size() int {
+	return xxx_messageInfo_GetShardLeadersResponse.Size(m)
+}
+func (m *GetShardLeadersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShardLeadersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShardLeadersResponse proto.InternalMessageInfo
+
+func (m *GetShardLeadersResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetShardLeadersResponse) GetReplicas() []*ShardLeadersList {
+	if m != nil {
+		return m.Replicas
+	}
+	return nil
+}
+
+type SearchRequest struct {
+	Req                  *internalpb.SearchRequest `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
+	DmChannels           []string                  `protobuf:"bytes,2,rep,name=dm_channels,json=dmChannels,proto3" json:"dm_channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
+func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchRequest) ProtoMessage()    {}
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{38}
+}
+
+func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchRequest.Unmarshal(m, b)
+}
+func (m *SearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchRequest.Marshal(b, m, deterministic)
+}
+func (m *SearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchRequest.Merge(m, src)
+}
+func (m *SearchRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchRequest.Size(m)
+}
+func (m *SearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchRequest proto.InternalMessageInfo
+
+func (m *SearchRequest) GetReq() *internalpb.SearchRequest {
+	if m != nil {
+		return m.Req
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetDmChannels() []string {
+	if m != nil {
+		return m.DmChannels
+	}
+	return nil
+}
+
+type QueryRequest struct {
+	Req                  *internalpb.RetrieveRequest `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
+	DmChannels           []string                    `protobuf:"bytes,2,rep,name=dm_channels,json=dmChannels,proto3" json:"dm_channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
+func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRequest) ProtoMessage()    {}
+func (*QueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{39}
+}
+
+func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRequest.Unmarshal(m, b)
+}
+func (m *QueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequest.Merge(m, src)
+}
+func (m *QueryRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryRequest.Size(m)
+}
+func (m *QueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRequest proto.InternalMessageInfo
+
+func (m *QueryRequest) GetReq() *internalpb.RetrieveRequest {
+	if m != nil {
+		return m.Req
+	}
+	return nil
+}
+
+func (m *QueryRequest) GetDmChannels() []string {
+	if m != nil {
+		return m.DmChannels
+	}
+	return nil
+}
+
+type HandoffSegments struct {
+	Base                 *commonpb.MsgBase  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Infos                []*SegmentLoadInfo `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *HandoffSegments) Reset()         { *m = HandoffSegments{} }
+func (m *HandoffSegments) String() string { return proto.CompactTextString(m) }
+func (*HandoffSegments) ProtoMessage()    {}
+func (*HandoffSegments) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{40}
+}
+
+func (m *HandoffSegments) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HandoffSegments.Unmarshal(m, b)
+}
+func (m *HandoffSegments) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HandoffSegments.Marshal(b, m, deterministic)
+}
+func (m *HandoffSegments) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandoffSegments.Merge(m, src)
+}
+func (m *HandoffSegments) XXX_Size() int {
+	return xxx_messageInfo_HandoffSegments.Size(m)
+}
+func (m *HandoffSegments) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandoffSegments.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandoffSegments proto.InternalMessageInfo
+
+func (m *HandoffSegments) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *HandoffSegments) GetInfos() []*SegmentLoadInfo {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+type LoadBalanceSegmentInfo struct {
+	SegmentID            int64    `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	PartitionID          int64    `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	CollectionID         int64    `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	SourceNodeID         int64    `protobuf:"varint,4,opt,name=source_nodeID,json=sourceNodeID,proto3" json:"source_nodeID,omitempty"`
+	DstNodeID            int64    `protobuf:"varint,5,opt,name=dst_nodeID,json=dstNodeID,proto3" json:"dst_nodeID,omitempty"`
+	SourceDone           bool     `protobuf:"varint,6,opt,name=source_done,json=sourceDone,proto3" json:"source_done,omitempty"`
+	DstDone              bool     `protobuf:"varint,7,opt,name=dst_done,json=dstDone,proto3" json:"dst_done,omitempty"`
+	ValidInfo            bool     `protobuf:"varint,8,opt,name=valid_info,json=validInfo,proto3" json:"valid_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoadBalanceSegmentInfo) Reset()         { *m = LoadBalanceSegmentInfo{} }
+func (m *LoadBalanceSegmentInfo) String() string { return proto.CompactTextString(m) }
+func (*LoadBalanceSegmentInfo) ProtoMessage()    {}
+func (*LoadBalanceSegmentInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{41}
+}
+
+func (m *LoadBalanceSegmentInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadBalanceSegmentInfo.Unmarshal(m, b)
+}
+func (m *LoadBalanceSegmentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadBalanceSegmentInfo.Marshal(b, m, deterministic)
+}
+func (m *LoadBalanceSegmentInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadBalanceSegmentInfo.Merge(m, src)
+}
+func (m *LoadBalanceSegmentInfo) XXX_Size() int {
+	return xxx_messageInfo_LoadBalanceSegmentInfo.Size(m)
+}
+func (m *LoadBalanceSegmentInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadBalanceSegmentInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadBalanceSegmentInfo proto.InternalMessageInfo
+
+func (m *LoadBalanceSegmentInfo) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *LoadBalanceSegmentInfo) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *LoadBalanceSegmentInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *LoadBalanceSegmentInfo) GetSourceNodeID() int64 {
+	if m != nil {
+		return m.SourceNodeID
+	}
+	return 0
 }
 
 func (m *LoadBalanceSegmentInfo) GetDstNodeID() int64 {
 	if m != nil {
-		return m.DstNodeID
+		return m.DstNodeID
+	}
+	return 0
+}
+
+func (m *LoadBalanceSegmentInfo) GetSourceDone() bool {
+	if m != nil {
+		return m.SourceDone
+	}
+	return false
+}
+
+func (m *LoadBalanceSegmentInfo) GetDstDone() bool {
+	if m != nil {
+		return m.DstDone
+	}
+	return false
+}
+
+func (m *LoadBalanceSegmentInfo) GetValidInfo() bool {
+	if m != nil {
+		return m.ValidInfo
+	}
+	return false
+}
+
+type LoadBalanceRequest struct {
+	Base          *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SourceNodeIDs []int64           `protobuf:"varint,2,rep,packed,name=source_nodeIDs,json=sourceNodeIDs,proto3" json:"source_nodeIDs,omitempty"`
+	BalanceReason TriggerCondition  `protobuf:"varint,3,opt,name=balance_reason,json=balanceReason,proto3,enum=milvus.proto.query.TriggerCondition" json:"balance_reason,omitempty"`
+	// the nodes to move the sealed segments to, used when balance_reason is loadBalance
+	DstNodeIDs           []int64  `protobuf:"varint,4,rep,packed,name=dst_nodeIDs,json=dstNodeIDs,proto3" json:"dst_nodeIDs,omitempty"`
+	SealedSegmentIDs     []int64  `protobuf:"varint,5,rep,packed,name=sealed_segmentIDs,json=sealedSegmentIDs,proto3" json:"sealed_segmentIDs,omitempty"`
+	CollectionID         int64    `protobuf:"varint,6,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoadBalanceRequest) Reset()         { *m = LoadBalanceRequest{} }
+func (m *LoadBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*LoadBalanceRequest) ProtoMessage()    {}
+func (*LoadBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{42}
+}
+
+func (m *LoadBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadBalanceRequest.Unmarshal(m, b)
+}
+func (m *LoadBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadBalanceRequest.Marshal(b, m, deterministic)
+}
+func (m *LoadBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadBalanceRequest.Merge(m, src)
+}
+func (m *LoadBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_LoadBalanceRequest.Size(m)
+}
+func (m *LoadBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadBalanceRequest proto.InternalMessageInfo
+
+func (m *LoadBalanceRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *LoadBalanceRequest) GetSourceNodeIDs() []int64 {
+	if m != nil {
+		return m.SourceNodeIDs
+	}
+	return nil
+}
+
+func (m *LoadBalanceRequest) GetBalanceReason() TriggerCondition {
+	if m != nil {
+		return m.BalanceReason
+	}
+	return TriggerCondition_handoff
+}
+
+func (m *LoadBalanceRequest) GetDstNodeIDs() []int64 {
+	if m != nil {
+		return m.DstNodeIDs
+	}
+	return nil
+}
+
+func (m *LoadBalanceRequest) GetSealedSegmentIDs() []int64 {
+	if m != nil {
+		return m.SealedSegmentIDs
+	}
+	return nil
+}
+
+func (m *LoadBalanceRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
 	}
 	return 0
 }
 
-func (m *LoadBalanceSegmentInfo) GetSourceDone() bool {
+// TransferNodeRequest moves the query nodes into the target resource group,
+// the nodes must serve no replica of the other resource groups
+type TransferNodeRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	NodeIDs              []int64           `protobuf:"varint,2,rep,packed,name=nodeIDs,proto3" json:"nodeIDs,omitempty"`
+	TargetResourceGroup  string            `protobuf:"bytes,3,opt,name=target_resource_group,json=targetResourceGroup,proto3" json:"target_resource_group,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *TransferNodeRequest) Reset()         { *m = TransferNodeRequest{} }
+func (m *TransferNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*TransferNodeRequest) ProtoMessage()    {}
+func (*TransferNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{43}
+}
+
+func (m *TransferNodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferNodeRequest.Unmarshal(m, b)
+}
+func (m *TransferNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferNodeRequest.Marshal(b, m, deterministic)
+}
+func (m *TransferNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferNodeRequest.Merge(m, src)
+}
+func (m *TransferNodeRequest) XXX_Size() int {
+	return xxx_messageInfo_TransferNodeRequest.Size(m)
+}
+func (m *TransferNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferNodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferNodeRequest proto.InternalMessageInfo
+
+func (m *TransferNodeRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.SourceDone
+		return m.Base
 	}
-	return false
+	return nil
 }
 
-func (m *LoadBalanceSegmentInfo) GetDstDone() bool {
+func (m *TransferNodeRequest) GetNodeIDs() []int64 {
 	if m != nil {
-		return m.DstDone
+		return m.NodeIDs
 	}
-	return false
+	return nil
 }
 
-func (m *LoadBalanceSegmentInfo) GetValidInfo() bool {
+func (m *TransferNodeRequest) GetTargetResourceGroup() string {
 	if m != nil {
-		return m.ValidInfo
+		return m.TargetResourceGroup
 	}
-	return false
+	return ""
 }
 
-type LoadBalanceRequest struct {
+type ListResourceGroupsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	SourceNodeIDs        []int64           `protobuf:"varint,2,rep,packed,name=source_nodeIDs,json=sourceNodeIDs,proto3" json:"source_nodeIDs,omitempty"`
-	BalanceReason        TriggerCondition  `protobuf:"varint,3,opt,name=balance_reason,json=balanceReason,proto3,enum=milvus.proto.query.TriggerCondition" json:"balance_reason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *LoadBalanceRequest) Reset()         { *m = LoadBalanceRequest{} }
-func (m *LoadBalanceRequest) String() string { return proto.CompactTextString(m) }
-func (*LoadBalanceRequest) ProtoMessage()    {}
-func (*LoadBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_aab7cc9a69ed26e8, []int{27}
+func (m *ListResourceGroupsRequest) Reset()         { *m = ListResourceGroupsRequest{} }
+func (m *ListResourceGroupsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListResourceGroupsRequest) ProtoMessage()    {}
+func (*ListResourceGroupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{44}
 }
 
-func (m *LoadBalanceRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LoadBalanceRequest.Unmarshal(m, b)
+func (m *ListResourceGroupsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListResourceGroupsRequest.Unmarshal(m, b)
 }
-func (m *LoadBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LoadBalanceRequest.Marshal(b, m, deterministic)
+func (m *ListResourceGroupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListResourceGroupsRequest.Marshal(b, m, deterministic)
 }
-func (m *LoadBalanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LoadBalanceRequest.Merge(m, src)
+func (m *ListResourceGroupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListResourceGroupsRequest.Merge(m, src)
 }
-func (m *LoadBalanceRequest) XXX_Size() int {
-	return xxx_messageInfo_LoadBalanceRequest.Size(m)
+func (m *ListResourceGroupsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListResourceGroupsRequest.Size(m)
 }
-func (m *LoadBalanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LoadBalanceRequest.DiscardUnknown(m)
+func (m *ListResourceGroupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListResourceGroupsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LoadBalanceRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListResourceGroupsRequest proto.InternalMessageInfo
 
-func (m *LoadBalanceRequest) GetBase() *commonpb.MsgBase {
+func (m *ListResourceGroupsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *LoadBalanceRequest) GetSourceNodeIDs() []int64 {
+// ResourceGroupInfo is a named group of query nodes, the collections loaded into the group
+// are only placed, balanced and recovered on its nodes
+type ResourceGroupInfo struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NodeIDs              []int64  `protobuf:"varint,2,rep,packed,name=nodeIDs,proto3" json:"nodeIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResourceGroupInfo) Reset()         { *m = ResourceGroupInfo{} }
+func (m *ResourceGroupInfo) String() string { return proto.CompactTextString(m) }
+func (*ResourceGroupInfo) ProtoMessage()    {}
+func (*ResourceGroupInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{45}
+}
+
+func (m *ResourceGroupInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourceGroupInfo.Unmarshal(m, b)
+}
+func (m *ResourceGroupInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourceGroupInfo.Marshal(b, m, deterministic)
+}
+func (m *ResourceGroupInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceGroupInfo.Merge(m, src)
+}
+func (m *ResourceGroupInfo) XXX_Size() int {
+	return xxx_messageInfo_ResourceGroupInfo.Size(m)
+}
+func (m *ResourceGroupInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceGroupInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceGroupInfo proto.InternalMessageInfo
+
+func (m *ResourceGroupInfo) GetName() string {
 	if m != nil {
-		return m.SourceNodeIDs
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ResourceGroupInfo) GetNodeIDs() []int64 {
+	if m != nil {
+		return m.NodeIDs
 	}
 	return nil
 }
 
-func (m *LoadBalanceRequest) GetBalanceReason() TriggerCondition {
+type ListResourceGroupsResponse struct {
+	Status               *commonpb.Status     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ResourceGroups       []*ResourceGroupInfo `protobuf:"bytes,2,rep,name=resource_groups,json=resourceGroups,proto3" json:"resource_groups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListResourceGroupsResponse) Reset()         { *m = ListResourceGroupsResponse{} }
+func (m *ListResourceGroupsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListResourceGroupsResponse) ProtoMessage()    {}
+func (*ListResourceGroupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{46}
+}
+
+func (m *ListResourceGroupsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListResourceGroupsResponse.Unmarshal(m, b)
+}
+func (m *ListResourceGroupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListResourceGroupsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListResourceGroupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListResourceGroupsResponse.Merge(m, src)
+}
+func (m *ListResourceGroupsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListResourceGroupsResponse.Size(m)
+}
+func (m *ListResourceGroupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListResourceGroupsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListResourceGroupsResponse proto.InternalMessageInfo
+
+func (m *ListResourceGroupsResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.BalanceReason
+		return m.Status
 	}
-	return TriggerCondition_handoff
+	return nil
+}
+
+func (m *ListResourceGroupsResponse) GetResourceGroups() []*ResourceGroupInfo {
+	if m != nil {
+		return m.ResourceGroups
+	}
+	return nil
 }
 
 func init() {
@@ -1990,6 +3133,7 @@ func init() {
 	proto.RegisterEnum("milvus.proto.query.LoadType", LoadType_name, LoadType_value)
 	proto.RegisterType((*ShowCollectionsRequest)(nil), "milvus.proto.query.ShowCollectionsRequest")
 	proto.RegisterType((*ShowCollectionsResponse)(nil), "milvus.proto.query.ShowCollectionsResponse")
+	proto.RegisterType((*LoadedFields)(nil), "milvus.proto.query.LoadedFields")
 	proto.RegisterType((*ShowPartitionsRequest)(nil), "milvus.proto.query.ShowPartitionsRequest")
 	proto.RegisterType((*ShowPartitionsResponse)(nil), "milvus.proto.query.ShowPartitionsResponse")
 	proto.RegisterType((*LoadCollectionRequest)(nil), "milvus.proto.query.LoadCollectionRequest")
@@ -2013,138 +3157,212 @@ func init() {
 	proto.RegisterType((*DmChannelInfo)(nil), "milvus.proto.query.DmChannelInfo")
 	proto.RegisterType((*QueryChannelInfo)(nil), "milvus.proto.query.QueryChannelInfo")
 	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.query.CollectionInfo")
+	proto.RegisterType((*ReplicaInfo)(nil), "milvus.proto.query.ReplicaInfo")
+	proto.RegisterType((*GetReplicasRequest)(nil), "milvus.proto.query.GetReplicasRequest")
+	proto.RegisterType((*GetReplicasResponse)(nil), "milvus.proto.query.GetReplicasResponse")
+	proto.RegisterType((*LoadingProgress)(nil), "milvus.proto.query.LoadingProgress")
+	proto.RegisterType((*PartitionLoadingProgress)(nil), "milvus.proto.query.PartitionLoadingProgress")
+	proto.RegisterType((*NodeLoadingProgress)(nil), "milvus.proto.query.NodeLoadingProgress")
+	proto.RegisterType((*GetLoadingProgressRequest)(nil), "milvus.proto.query.GetLoadingProgressRequest")
+	proto.RegisterType((*GetLoadingProgressResponse)(nil), "milvus.proto.query.GetLoadingProgressResponse")
+	proto.RegisterType((*GetShardLeadersRequest)(nil), "milvus.proto.query.GetShardLeadersRequest")
+	proto.RegisterType((*ShardLeader)(nil), "milvus.proto.query.ShardLeader")
+	proto.RegisterType((*ShardLeadersList)(nil), "milvus.proto.query.ShardLeadersList")
+	proto.RegisterType((*GetShardLeadersResponse)(nil), "milvus.proto.query.GetShardLeadersResponse")
+	proto.RegisterType((*SearchRequest)(nil), "milvus.proto.query.SearchRequest")
+	proto.RegisterType((*QueryRequest)(nil), "milvus.proto.query.QueryRequest")
 	proto.RegisterType((*HandoffSegments)(nil), "milvus.proto.query.HandoffSegments")
 	proto.RegisterType((*LoadBalanceSegmentInfo)(nil), "milvus.proto.query.LoadBalanceSegmentInfo")
 	proto.RegisterType((*LoadBalanceRequest)(nil), "milvus.proto.query.LoadBalanceRequest")
+	proto.RegisterType((*TransferNodeRequest)(nil), "milvus.proto.query.TransferNodeRequest")
+	proto.RegisterType((*ListResourceGroupsRequest)(nil), "milvus.proto.query.ListResourceGroupsRequest")
+	proto.RegisterType((*ResourceGroupInfo)(nil), "milvus.proto.query.ResourceGroupInfo")
+	proto.RegisterType((*ListResourceGroupsResponse)(nil), "milvus.proto.query.ListResourceGroupsResponse")
 }
 
 func init() { proto.RegisterFile("query_coord.proto", fileDescriptor_aab7cc9a69ed26e8) }
 
 var fileDescriptor_aab7cc9a69ed26e8 = []byte{
-	// 1958 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x73, 0x1b, 0x49,
-	0x15, 0xf7, 0x48, 0xb2, 0x2c, 0x3d, 0xeb, 0xcf, 0xa4, 0x13, 0x1b, 0x45, 0x24, 0xbb, 0x66, 0xb2,
-	0xd9, 0x64, 0xbd, 0xac, 0xbd, 0xab, 0x2c, 0x55, 0xe4, 0xc0, 0x61, 0x63, 0x6d, 0x8c, 0x60, 0xe3,
-	0x35, 0x63, 0xb3, 0x14, 0xa9, 0x54, 0x0d, 0xa3, 0x99, 0xb6, 0x34, 0xb5, 0x33, 0xd3, 0xca, 0xf4,
-	0x28, 0x8e, 0x73, 0xe0, 0xc4, 0x57, 0xe0, 0x04, 0x45, 0x15, 0x55, 0xfc, 0x29, 0x0e, 0x7c, 0x01,
-	0x4e, 0x7b, 0xe1, 0xce, 0x17, 0x80, 0x2a, 0x0a, 0x6e, 0x1c, 0xf8, 0x0a, 0x54, 0xf7, 0xf4, 0x8c,
-	0xe6, 0x4f, 0xcb, 0x56, 0x6c, 0x4c, 0x52, 0x29, 0x6e, 0xea, 0xd7, 0x6f, 0xde, 0xff, 0xfe, 0xf5,
-	0xeb, 0x27, 0xb8, 0xf2, 0x74, 0x8a, 0x83, 0x13, 0xc3, 0x22, 0x24, 0xb0, 0xb7, 0x26, 0x01, 0x09,
-	0x09, 0x42, 0x9e, 0xe3, 0x3e, 0x9b, 0xd2, 0x68, 0xb5, 0xc5, 0xf7, 0xbb, 0x0d, 0x8b, 0x78, 0x1e,
-	0xf1, 0x23, 0x5a, 0xb7, 0x91, 0xe6, 0xe8, 0xb6, 0x1c, 0x3f, 0xc4, 0x81, 0x6f, 0xba, 0xf1, 0x2e,
-	0xb5, 0xc6, 0xd8, 0x33, 0xc5, 0x4a, 0xb5, 0xcd, 0xd0, 0x4c, 0xcb, 0xd7, 0x7e, 0xa6, 0xc0, 0xfa,
-	0xc1, 0x98, 0x1c, 0xef, 0x10, 0xd7, 0xc5, 0x56, 0xe8, 0x10, 0x9f, 0xea, 0xf8, 0xe9, 0x14, 0xd3,
-	0x10, 0x7d, 0x08, 0x95, 0xa1, 0x49, 0x71, 0x47, 0xd9, 0x50, 0xee, 0xae, 0xf6, 0x6e, 0x6c, 0x65,
-	0x2c, 0x11, 0x26, 0x3c, 0xa2, 0xa3, 0x07, 0x26, 0xc5, 0x3a, 0xe7, 0x44, 0x08, 0x2a, 0xf6, 0x70,
-	0xd0, 0xef, 0x94, 0x36, 0x94, 0xbb, 0x65, 0x9d, 0xff, 0x46, 0xef, 0x40, 0xd3, 0x4a, 0x64, 0x0f,
-	0xfa, 0xb4, 0x53, 0xde, 0x28, 0xdf, 0x2d, 0xeb, 0x59, 0xa2, 0xf6, 0x7b, 0x05, 0xbe, 0x56, 0x30,
-	0x83, 0x4e, 0x88, 0x4f, 0x31, 0xba, 0x07, 0x55, 0x1a, 0x9a, 0xe1, 0x94, 0x0a, 0x4b, 0xbe, 0x2e,
-	0xb5, 0xe4, 0x80, 0xb3, 0xe8, 0x82, 0xb5, 0xa8, 0xb6, 0x24, 0x51, 0x8b, 0x3e, 0x82, 0x6b, 0x8e,
-	0xff, 0x08, 0x7b, 0x24, 0x38, 0x31, 0x26, 0x38, 0xb0, 0xb0, 0x1f, 0x9a, 0x23, 0x1c, 0xdb, 0x78,
-	0x35, 0xde, 0xdb, 0x9f, 0x6d, 0x69, 0xbf, 0x55, 0x60, 0x8d, 0x59, 0xba, 0x6f, 0x06, 0xa1, 0x73,
-	0x09, 0xf1, 0xd2, 0xa0, 0x91, 0xb6, 0xb1, 0x53, 0xe6, 0x7b, 0x19, 0x1a, 0xe3, 0x99, 0xc4, 0xea,
-	0x99, 0x6f, 0x15, 0x6e, 0x6e, 0x86, 0xa6, 0xfd, 0x46, 0x24, 0x36, 0x6d, 0xe7, 0x45, 0x02, 0x9a,
-	0xd7, 0x59, 0x2a, 0xea, 0x3c, 0x4f, 0x38, 0xbf, 0x52, 0x60, 0xed, 0x33, 0x62, 0xda, 0xb3, 0xc4,
-	0xff, 0xef, 0xc3, 0xf9, 0x1d, 0xa8, 0x46, 0xa7, 0xa4, 0x53, 0xe1, 0xba, 0x6e, 0x67, 0x75, 0x89,
-	0x13, 0x34, 0xb3, 0xf0, 0x80, 0x13, 0x74, 0xf1, 0x91, 0xf6, 0x4b, 0x05, 0x3a, 0x3a, 0x76, 0xb1,
-	0x49, 0xf1, 0xab, 0xf4, 0x62, 0x1d, 0xaa, 0x3e, 0xb1, 0xf1, 0xa0, 0xcf, 0xbd, 0x28, 0xeb, 0x62,
-	0xa5, 0xfd, 0x53, 0x44, 0xf8, 0x35, 0x2f, 0xd8, 0x54, 0x16, 0x96, 0xcf, 0x93, 0x85, 0xaf, 0x66,
-	0x59, 0x78, 0xdd, 0x3d, 0x9d, 0x65, 0x6a, 0x39, 0x93, 0xa9, 0x1f, 0xc3, 0xf5, 0x9d, 0x00, 0x9b,
-	0x21, 0xfe, 0x01, 0x83, 0xf9, 0x9d, 0xb1, 0xe9, 0xfb, 0xd8, 0x8d, 0x5d, 0xc8, 0x2b, 0x57, 0x24,
-	0xca, 0x3b, 0xb0, 0x32, 0x09, 0xc8, 0xf3, 0x93, 0xc4, 0xee, 0x78, 0xa9, 0xfd, 0x5a, 0x81, 0xae,
-	0x4c, 0xf6, 0x45, 0x10, 0xe1, 0x0e, 0xb4, 0x83, 0xc8, 0x38, 0xc3, 0x8a, 0xe4, 0x71, 0xad, 0x75,
-	0xbd, 0x25, 0xc8, 0x42, 0x0b, 0xba, 0x0d, 0xad, 0x00, 0xd3, 0xa9, 0x3b, 0xe3, 0x2b, 0x73, 0xbe,
-	0x66, 0x44, 0x15, 0x6c, 0xda, 0x1f, 0x14, 0xb8, 0xbe, 0x8b, 0xc3, 0x24, 0x7b, 0x4c, 0x1d, 0x7e,
-	0x4d, 0xd1, 0xf5, 0x57, 0x0a, 0xb4, 0x73, 0x86, 0xa2, 0x0d, 0x58, 0x4d, 0xf1, 0x88, 0x04, 0xa5,
-	0x49, 0xe8, 0xdb, 0xb0, 0xcc, 0x62, 0x87, 0xb9, 0x49, 0xad, 0x9e, 0xb6, 0x55, 0xbc, 0xdc, 0xb7,
-	0xb2, 0x52, 0xf5, 0xe8, 0x03, 0xb4, 0x0d, 0x57, 0x25, 0xc8, 0x2a, 0xcc, 0x47, 0x45, 0x60, 0xd5,
-	0xfe, 0xa8, 0x40, 0x57, 0x16, 0xcc, 0x8b, 0x24, 0xfc, 0x31, 0xac, 0x27, 0xde, 0x18, 0x36, 0xa6,
-	0x56, 0xe0, 0x4c, 0xf8, 0x31, 0xe3, 0x97, 0xc1, 0x6a, 0xef, 0xd6, 0xd9, 0xfe, 0x50, 0x7d, 0x2d,
-	0x11, 0xd1, 0x4f, 0x49, 0xd0, 0x1c, 0x58, 0xdb, 0xc5, 0xe1, 0x01, 0x1e, 0x79, 0xd8, 0x0f, 0x07,
-	0xfe, 0x11, 0x39, 0x7f, 0xde, 0xdf, 0x02, 0xa0, 0x42, 0x4e, 0x72, 0x4f, 0xa5, 0x28, 0xda, 0x5f,
-	0x4b, 0xb0, 0x9a, 0x52, 0x84, 0x6e, 0x40, 0x3d, 0xd9, 0x15, 0x59, 0x9b, 0x11, 0x0a, 0x15, 0x53,
-	0x92, 0x54, 0x4c, 0x2e, 0xf3, 0xe5, 0x62, 0xe6, 0xe7, 0x80, 0x33, 0xba, 0x0e, 0x35, 0x0f, 0x7b,
-	0x06, 0x75, 0x5e, 0x60, 0x01, 0x06, 0x2b, 0x1e, 0xf6, 0x0e, 0x9c, 0x17, 0x98, 0x6d, 0xf9, 0x53,
-	0xcf, 0x08, 0xc8, 0x31, 0xed, 0x54, 0xa3, 0x2d, 0x7f, 0xea, 0xe9, 0xe4, 0x98, 0xa2, 0x9b, 0x00,
-	0x8e, 0x6f, 0xe3, 0xe7, 0x86, 0x6f, 0x7a, 0xb8, 0xb3, 0xc2, 0x0f, 0x53, 0x9d, 0x53, 0xf6, 0x4c,
-	0x0f, 0x33, 0x18, 0xe0, 0x8b, 0x41, 0xbf, 0x53, 0x8b, 0x3e, 0x14, 0x4b, 0xe6, 0xaa, 0x38, 0x82,
-	0x83, 0x7e, 0xa7, 0x1e, 0x7d, 0x97, 0x10, 0xd0, 0xa7, 0xd0, 0x14, 0x7e, 0x1b, 0x51, 0x99, 0x02,
-	0x2f, 0xd3, 0x0d, 0x59, 0x5a, 0x45, 0x00, 0xa3, 0x22, 0x6d, 0xd0, 0xd4, 0x8a, 0xb7, 0x94, 0xf9,
-	0x5c, 0x5e, 0xa4, 0xec, 0xbe, 0x05, 0xcb, 0x8e, 0x7f, 0x44, 0xe2, 0x2a, 0x7b, 0xfb, 0x14, 0x73,
-	0xb8, 0xb2, 0x88, 0x5b, 0xfb, 0x9b, 0x02, 0xeb, 0x9f, 0xd8, 0xb6, 0x0c, 0x4b, 0x5f, 0xbe, 0xa6,
-	0x66, 0xf9, 0x2b, 0x65, 0xf2, 0xb7, 0x08, 0x9e, 0xbc, 0x0f, 0x57, 0x72, 0x38, 0x29, 0xca, 0xa0,
-	0xae, 0xab, 0x59, 0xa4, 0x1c, 0xf4, 0xd1, 0x7b, 0xa0, 0x66, 0xb1, 0x52, 0xdc, 0x12, 0x75, 0xbd,
-	0x9d, 0x41, 0xcb, 0x41, 0x5f, 0xfb, 0xbb, 0x02, 0xd7, 0x75, 0xec, 0x91, 0x67, 0xf8, 0xcd, 0xf5,
-	0xf1, 0x1f, 0x25, 0x58, 0xff, 0x91, 0x19, 0x5a, 0xe3, 0xbe, 0x27, 0x88, 0xf4, 0xd5, 0x38, 0x98,
-	0x3b, 0xe2, 0x95, 0xe2, 0x11, 0x4f, 0xca, 0x74, 0x59, 0x56, 0xa6, 0xec, 0xe1, 0xb5, 0xf5, 0x45,
-	0xec, 0xef, 0xac, 0x4c, 0x53, 0x6d, 0x4f, 0xf5, 0x1c, 0x6d, 0x0f, 0xda, 0x81, 0x26, 0x7e, 0x6e,
-	0xb9, 0x53, 0x1b, 0x1b, 0x91, 0xf6, 0x15, 0xae, 0xfd, 0x2d, 0x89, 0xf6, 0xf4, 0x19, 0x69, 0x88,
-	0x8f, 0x06, 0xfc, 0xa8, 0xfc, 0x4b, 0x81, 0xb6, 0xd8, 0x65, 0x9d, 0xe2, 0x02, 0xa8, 0x98, 0x0b,
-	0x47, 0xa9, 0x18, 0x8e, 0x45, 0x82, 0x1a, 0xdf, 0xd0, 0x95, 0xd4, 0x0d, 0x7d, 0x13, 0xe0, 0xc8,
-	0x9d, 0xd2, 0xb1, 0x11, 0x3a, 0x5e, 0x8c, 0x89, 0x75, 0x4e, 0x39, 0x74, 0x3c, 0x8c, 0x3e, 0x81,
-	0xc6, 0xd0, 0xf1, 0x5d, 0x32, 0x32, 0x26, 0x66, 0x38, 0x66, 0xc8, 0x38, 0xcf, 0xdd, 0x87, 0x0e,
-	0x76, 0xed, 0x07, 0x9c, 0x57, 0x5f, 0x8d, 0xbe, 0xd9, 0x67, 0x9f, 0x68, 0xbf, 0x2b, 0xc1, 0x55,
-	0xe6, 0xa6, 0xf0, 0xf8, 0x12, 0x0a, 0xea, 0x7e, 0x5c, 0x0a, 0xe5, 0xf9, 0xf7, 0x62, 0x2e, 0xde,
-	0xc5, 0x72, 0x38, 0xcf, 0x5b, 0x04, 0x7d, 0x1f, 0x5a, 0x2e, 0x31, 0x6d, 0xc3, 0x22, 0xbe, 0xcd,
-	0x33, 0xc1, 0x23, 0xd8, 0xea, 0xbd, 0x23, 0x33, 0xe1, 0x30, 0x70, 0x46, 0x23, 0x1c, 0xec, 0xc4,
-	0xbc, 0x7a, 0xd3, 0xe5, 0x2f, 0x31, 0xb1, 0xe4, 0x08, 0x2a, 0x5a, 0xea, 0xcb, 0x8b, 0x55, 0x5c,
-	0x03, 0xe5, 0x53, 0xba, 0xb4, 0xca, 0x02, 0x5d, 0xda, 0xb2, 0xa4, 0xd1, 0xce, 0x76, 0x02, 0xd5,
-	0x42, 0x27, 0x70, 0x08, 0xcd, 0x04, 0x57, 0x78, 0xd1, 0xdf, 0x82, 0x66, 0x64, 0x96, 0xc1, 0x22,
-	0x81, 0xed, 0xb8, 0xcb, 0x8e, 0x88, 0x9f, 0x71, 0x1a, 0x93, 0x9a, 0xe0, 0x56, 0x74, 0x29, 0xd5,
-	0xf5, 0x14, 0x45, 0xfb, 0xb9, 0x02, 0x6a, 0x1a, 0x91, 0xb9, 0xe4, 0x45, 0xda, 0xf7, 0x3b, 0xd0,
-	0x16, 0x03, 0xa0, 0x04, 0x16, 0x45, 0x43, 0xfd, 0x34, 0x2d, 0xae, 0x8f, 0x3e, 0x86, 0xf5, 0x88,
-	0xb1, 0x00, 0xa3, 0x51, 0x63, 0x7d, 0x8d, 0xef, 0xea, 0x39, 0x2c, 0xfd, 0x4b, 0x19, 0x5a, 0xb3,
-	0xc2, 0x59, 0xd8, 0xaa, 0x45, 0x1e, 0xfe, 0x7b, 0xa0, 0xce, 0x3a, 0x43, 0xde, 0x3b, 0x9c, 0x5a,
-	0xfb, 0xf9, 0x9e, 0xb0, 0x3d, 0xc9, 0xb5, 0xd2, 0x0f, 0xa1, 0x29, 0x7c, 0x12, 0xa8, 0x56, 0xe1,
-	0xc2, 0xbe, 0x21, 0x13, 0x96, 0xc9, 0xa0, 0xde, 0x48, 0x41, 0x2c, 0x45, 0xf7, 0xa1, 0xce, 0x8f,
-	0x43, 0x78, 0x32, 0xc1, 0xe2, 0x24, 0xdc, 0x90, 0xc9, 0x60, 0x99, 0x3d, 0x3c, 0x99, 0x60, 0xbd,
-	0xe6, 0x8a, 0x5f, 0x17, 0xc5, 0xe5, 0x7b, 0xb0, 0x16, 0x44, 0x47, 0xc7, 0x36, 0x32, 0xe1, 0x5b,
-	0xe1, 0xe1, 0xbb, 0x16, 0x6f, 0xee, 0xa7, 0xc3, 0x38, 0xa7, 0xcb, 0xaf, 0xcd, 0xed, 0xf2, 0x7f,
-	0x0a, 0xed, 0xef, 0x9a, 0xbe, 0x4d, 0x8e, 0x8e, 0xe2, 0x03, 0x7a, 0x8e, 0x93, 0x79, 0x3f, 0xdb,
-	0x5f, 0xbd, 0x04, 0x5a, 0x69, 0xbf, 0x28, 0xc1, 0x3a, 0xa3, 0x3d, 0x30, 0x5d, 0xd3, 0xb7, 0xf0,
-	0xe2, 0x5d, 0xf5, 0x7f, 0xe7, 0xfe, 0xb8, 0x05, 0x4d, 0x4a, 0xa6, 0x81, 0x85, 0x8d, 0x4c, 0x73,
-	0xdd, 0x88, 0x88, 0x7b, 0x11, 0xc0, 0xdc, 0x04, 0xb0, 0x69, 0x68, 0x64, 0x5e, 0xdc, 0x75, 0x9b,
-	0x86, 0x62, 0xfb, 0x6d, 0x58, 0x15, 0x32, 0x6c, 0xe2, 0x63, 0x9e, 0xec, 0x9a, 0x0e, 0x11, 0xa9,
-	0x4f, 0x7c, 0xde, 0x87, 0xb3, 0xef, 0xf9, 0xee, 0x0a, 0xdf, 0x5d, 0xb1, 0x69, 0xc8, 0xb7, 0x6e,
-	0x02, 0x3c, 0x33, 0x5d, 0xc7, 0xe6, 0x45, 0xca, 0xd3, 0x54, 0xd3, 0xeb, 0x9c, 0xc2, 0x42, 0xa0,
-	0xfd, 0x49, 0x01, 0x94, 0x8a, 0xce, 0xf9, 0xb1, 0xf3, 0x36, 0xb4, 0x32, 0x7e, 0x26, 0xd3, 0xcc,
-	0xb4, 0xa3, 0x94, 0x81, 0xff, 0x30, 0x52, 0x65, 0x04, 0xd8, 0xa4, 0xc4, 0xe7, 0x41, 0x5b, 0x18,
-	0xfc, 0x87, 0xb1, 0x99, 0xec, 0xd3, 0xcd, 0x17, 0xd0, 0xca, 0x1e, 0x53, 0xd4, 0x80, 0xda, 0x1e,
-	0x09, 0x3f, 0x7d, 0xee, 0xd0, 0x50, 0x5d, 0x42, 0x2d, 0x80, 0x3d, 0x12, 0xee, 0x07, 0x98, 0x62,
-	0x3f, 0x54, 0x15, 0x04, 0x50, 0xfd, 0xdc, 0xef, 0x3b, 0xf4, 0x4b, 0xb5, 0x84, 0xae, 0x8a, 0xc7,
-	0xb1, 0xe9, 0x0e, 0x44, 0xcd, 0xaa, 0x65, 0xf6, 0x79, 0xb2, 0xaa, 0x20, 0x15, 0x1a, 0x09, 0xcb,
-	0xee, 0xfe, 0x0f, 0xd5, 0x65, 0x54, 0x87, 0xe5, 0xe8, 0x67, 0x75, 0xf3, 0x73, 0x50, 0xf3, 0xe6,
-	0xa1, 0x55, 0x58, 0x19, 0x47, 0xa5, 0xae, 0x2e, 0xa1, 0x36, 0xac, 0xba, 0xb3, 0xc0, 0xaa, 0x0a,
-	0x23, 0x8c, 0x82, 0x89, 0x25, 0x42, 0xac, 0x96, 0x98, 0x36, 0x16, 0xab, 0x3e, 0x39, 0xf6, 0xd5,
-	0xf2, 0xe6, 0xf7, 0xa0, 0x91, 0x7e, 0xb0, 0xa0, 0x1a, 0x54, 0xf6, 0x88, 0x8f, 0xd5, 0x25, 0x26,
-	0x76, 0x37, 0x20, 0xc7, 0x8e, 0x3f, 0x8a, 0x7c, 0x78, 0x18, 0x90, 0x17, 0xd8, 0x57, 0x4b, 0x6c,
-	0x83, 0x62, 0xd3, 0x65, 0x1b, 0x65, 0xb6, 0xc1, 0x16, 0xd8, 0x56, 0x2b, 0x9b, 0x1f, 0x41, 0x2d,
-	0x86, 0x0b, 0x74, 0x05, 0x9a, 0x99, 0xd1, 0x9a, 0xba, 0x84, 0x50, 0x74, 0x03, 0xcf, 0x80, 0x41,
-	0x55, 0x7a, 0xff, 0x06, 0x80, 0xe8, 0x46, 0x20, 0x24, 0xb0, 0xd1, 0x04, 0xd0, 0x2e, 0x0e, 0x77,
-	0x88, 0x37, 0x21, 0x7e, 0x6c, 0x12, 0x45, 0x1f, 0x66, 0xb3, 0x94, 0xcc, 0xf1, 0x8b, 0xac, 0xc2,
-	0xcb, 0xee, 0xbb, 0x73, 0xbe, 0xc8, 0xb1, 0x6b, 0x4b, 0xc8, 0xe3, 0x1a, 0x59, 0x03, 0x75, 0xe8,
-	0x58, 0x5f, 0xc6, 0x73, 0x99, 0x53, 0x34, 0xe6, 0x58, 0x63, 0x8d, 0x39, 0x6c, 0x10, 0x8b, 0x83,
-	0x30, 0x70, 0xfc, 0x51, 0xfc, 0xc8, 0xd3, 0x96, 0xd0, 0x53, 0xb8, 0xc6, 0x1e, 0x80, 0xa1, 0x19,
-	0x3a, 0x34, 0x74, 0x2c, 0x1a, 0x2b, 0xec, 0xcd, 0x57, 0x58, 0x60, 0x7e, 0x49, 0x95, 0x2e, 0xb4,
-	0x73, 0xff, 0x1f, 0xa0, 0x4d, 0x29, 0x90, 0x49, 0xff, 0xeb, 0xe8, 0xbe, 0xbf, 0x10, 0x6f, 0xa2,
-	0xcd, 0x81, 0x56, 0x76, 0xb6, 0x8e, 0xde, 0x9b, 0x27, 0xa0, 0x30, 0x8c, 0xec, 0x6e, 0x2e, 0xc2,
-	0x9a, 0xa8, 0x7a, 0x0c, 0xad, 0xec, 0xf4, 0x56, 0xae, 0x4a, 0x3a, 0xe1, 0xed, 0x9e, 0xf6, 0xbe,
-	0xd6, 0x96, 0xd0, 0x4f, 0xe0, 0x4a, 0x61, 0x64, 0x8a, 0xbe, 0x29, 0x13, 0x3f, 0x6f, 0xb2, 0x7a,
-	0x96, 0x06, 0x61, 0xfd, 0x2c, 0x8a, 0xf3, 0xad, 0x2f, 0xcc, 0xce, 0x17, 0xb7, 0x3e, 0x25, 0xfe,
-	0x34, 0xeb, 0x5f, 0x5a, 0xc3, 0x14, 0x50, 0x71, 0x68, 0x8a, 0x3e, 0x90, 0xa9, 0x98, 0x3b, 0xb8,
-	0xed, 0x6e, 0x2d, 0xca, 0x9e, 0xa4, 0x7c, 0xca, 0x4f, 0x6b, 0x7e, 0xbc, 0x28, 0x55, 0x3b, 0x77,
-	0x5e, 0x2a, 0x57, 0x3b, 0x7f, 0x22, 0x18, 0x15, 0x75, 0x76, 0x6c, 0x23, 0xcf, 0x95, 0x74, 0x4c,
-	0x27, 0x2f, 0x6a, 0xf9, 0x14, 0x48, 0x5b, 0x42, 0x06, 0xc0, 0x2e, 0x0e, 0x1f, 0xe1, 0x30, 0x70,
-	0x2c, 0x8a, 0xde, 0x95, 0x1e, 0xf1, 0x19, 0x43, 0xac, 0xe3, 0xce, 0x99, 0x7c, 0xb1, 0x82, 0xde,
-	0x9f, 0xeb, 0x50, 0xe7, 0xd1, 0x65, 0x77, 0xe3, 0xff, 0x01, 0xf7, 0x12, 0x00, 0xf7, 0x09, 0xb4,
-	0x73, 0xd3, 0x35, 0x39, 0xe0, 0xca, 0x47, 0x70, 0x67, 0x9d, 0xbc, 0x21, 0xa0, 0xe2, 0x68, 0x4b,
-	0x7e, 0x04, 0xe6, 0x8e, 0xc0, 0xce, 0xd2, 0xf1, 0x04, 0xda, 0xb9, 0xd1, 0x92, 0xdc, 0x03, 0xf9,
-	0xfc, 0xe9, 0x2c, 0xe9, 0x5f, 0x40, 0x23, 0x3d, 0x64, 0x40, 0x77, 0xe6, 0xe1, 0x5e, 0xee, 0x69,
-	0xfd, 0xea, 0x51, 0xef, 0xf2, 0x6f, 0x85, 0x27, 0xd0, 0xce, 0xcd, 0x15, 0xe4, 0x91, 0x97, 0x0f,
-	0x1f, 0xce, 0x92, 0xfe, 0x06, 0xe1, 0xd8, 0x83, 0x8f, 0x1f, 0xf7, 0x46, 0x4e, 0x38, 0x9e, 0x0e,
-	0x99, 0x97, 0xdb, 0x11, 0xe7, 0x07, 0x0e, 0x11, 0xbf, 0xb6, 0xe3, 0x03, 0xbd, 0xcd, 0x25, 0x6d,
-	0x73, 0x6b, 0x27, 0xc3, 0x61, 0x95, 0x2f, 0xef, 0xfd, 0x27, 0x00, 0x00, 0xff, 0xff, 0x92, 0x29,
-	0x0a, 0x06, 0x50, 0x22, 0x00, 0x00,
+	// 2850 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1a, 0x4d, 0x6f, 0x24, 0x57,
+	0xd1, 0x3d, 0x3d, 0x9e, 0x8f, 0x9a, 0xaf, 0xde, 0xe7, 0xb5, 0x33, 0x3b, 0xec, 0x6e, 0x9c, 0xde,
+	0x6c, 0xec, 0x38, 0x89, 0x37, 0x71, 0x02, 0xca, 0x0a, 0x10, 0x64, 0x3d, 0x59, 0x63, 0xc8, 0x3a,
+	0x4e, 0xdb, 0x04, 0x11, 0x45, 0x9a, 0xb4, 0xa7, 0x9f, 0xc7, 0x4d, 0x7a, 0xba, 0x67, 0xfb, 0xf5,
+	0xec, 0x97, 0x04, 0x27, 0x10, 0x57, 0x38, 0xe4, 0x04, 0x02, 0x21, 0x01, 0x12, 0x12, 0xb9, 0xc1,
+	0x1d, 0x0e, 0xfc, 0x0d, 0x24, 0x8e, 0x5c, 0xe0, 0xc4, 0x1d, 0xbd, 0x8f, 0xfe, 0x7e, 0xe3, 0x19,
+	0x7b, 0xe2, 0x64, 0x85, 0xb8, 0x75, 0xd7, 0xab, 0x57, 0x55, 0xaf, 0xaa, 0x5e, 0x55, 0xbd, 0x7a,
+	0x0f, 0x2e, 0xdd, 0x1f, 0x63, 0xff, 0x71, 0xaf, 0xef, 0x79, 0xbe, 0xb5, 0x39, 0xf2, 0xbd, 0xc0,
+	0x43, 0x68, 0x68, 0x3b, 0x0f, 0xc6, 0x84, 0xff, 0x6d, 0xb2, 0xf1, 0x4e, 0xbd, 0xef, 0x0d, 0x87,
+	0x9e, 0xcb, 0x61, 0x9d, 0x7a, 0x12, 0xa3, 0xd3, 0xb4, 0xdd, 0x00, 0xfb, 0xae, 0xe9, 0x84, 0xa3,
+	0xa4, 0x7f, 0x82, 0x87, 0xa6, 0xf8, 0xd3, 0x2c, 0x33, 0x30, 0x93, 0xf4, 0xf5, 0x1f, 0x2b, 0xb0,
+	0x72, 0x70, 0xe2, 0x3d, 0xdc, 0xf6, 0x1c, 0x07, 0xf7, 0x03, 0xdb, 0x73, 0x89, 0x81, 0xef, 0x8f,
+	0x31, 0x09, 0xd0, 0xab, 0x50, 0x3c, 0x32, 0x09, 0x6e, 0x2b, 0xab, 0xca, 0x7a, 0x6d, 0xeb, 0xea,
+	0x66, 0x4a, 0x12, 0x21, 0xc2, 0x3d, 0x32, 0xb8, 0x63, 0x12, 0x6c, 0x30, 0x4c, 0x84, 0xa0, 0x68,
+	0x1d, 0xed, 0x76, 0xdb, 0x85, 0x55, 0x65, 0x5d, 0x35, 0xd8, 0x37, 0x7a, 0x1e, 0x1a, 0xfd, 0x88,
+	0xf6, 0x6e, 0x97, 0xb4, 0xd5, 0x55, 0x75, 0x5d, 0x35, 0xd2, 0x40, 0xfd, 0x5f, 0x0a, 0x3c, 0x93,
+	0x13, 0x83, 0x8c, 0x3c, 0x97, 0x60, 0xf4, 0x3a, 0x94, 0x48, 0x60, 0x06, 0x63, 0x22, 0x24, 0xf9,
+	0x92, 0x54, 0x92, 0x03, 0x86, 0x62, 0x08, 0xd4, 0x3c, 0xdb, 0x82, 0x84, 0x2d, 0x7a, 0x0d, 0x2e,
+	0xdb, 0xee, 0x3d, 0x3c, 0xf4, 0xfc, 0xc7, 0xbd, 0x11, 0xf6, 0xfb, 0xd8, 0x0d, 0xcc, 0x01, 0x0e,
+	0x65, 0x5c, 0x0a, 0xc7, 0xf6, 0xe3, 0x21, 0xf4, 0x36, 0x34, 0x1c, 0xcf, 0xb4, 0xb0, 0xd5, 0x3b,
+	0xb6, 0xb1, 0x63, 0x91, 0x76, 0x71, 0x55, 0x5d, 0xaf, 0x6d, 0xad, 0x6e, 0xe6, 0x0d, 0xb5, 0xf9,
+	0x0e, 0x43, 0xbc, 0xcb, 0xf0, 0x8c, 0xba, 0x93, 0xf8, 0xd3, 0x37, 0xa0, 0x9e, 0x1c, 0x45, 0x1d,
+	0xa8, 0x30, 0x7a, 0x54, 0x54, 0x85, 0x71, 0x8f, 0xfe, 0xf5, 0xdf, 0x29, 0xb0, 0x4c, 0x95, 0xb3,
+	0x6f, 0xfa, 0x81, 0x7d, 0x01, 0x26, 0xd2, 0xa1, 0x9e, 0x54, 0x4b, 0x5b, 0x65, 0x63, 0x29, 0x18,
+	0xc5, 0x19, 0x85, 0xec, 0x77, 0xbb, 0x7c, 0xd5, 0xaa, 0x91, 0x82, 0xe9, 0xbf, 0x15, 0xbe, 0x94,
+	0x94, 0x73, 0x1e, 0x1b, 0x66, 0x79, 0x16, 0xf2, 0x3c, 0xcf, 0x61, 0x41, 0xfd, 0xcf, 0x05, 0x58,
+	0xa6, 0xba, 0x8f, 0x7d, 0xed, 0xf3, 0x57, 0xe7, 0xd7, 0xa1, 0xc4, 0x37, 0x66, 0xbb, 0xc8, 0x78,
+	0xdd, 0x4c, 0xf3, 0xe2, 0x63, 0x9b, 0xb1, 0x84, 0x07, 0x0c, 0x60, 0x88, 0x49, 0xe8, 0x26, 0x34,
+	0x7d, 0x3c, 0x72, 0xec, 0xbe, 0xd9, 0x73, 0xc7, 0xc3, 0x23, 0xec, 0xb7, 0x17, 0x57, 0x95, 0xf5,
+	0x45, 0xa3, 0x21, 0xa0, 0x7b, 0x0c, 0x88, 0x6e, 0x70, 0x5f, 0xed, 0x45, 0x9e, 0x55, 0xe2, 0x1a,
+	0xa4, 0xc0, 0xbb, 0x02, 0x86, 0xd6, 0xa0, 0xe5, 0x63, 0xe2, 0x8d, 0xfd, 0x3e, 0xee, 0x0d, 0x7c,
+	0x6f, 0x3c, 0x22, 0xed, 0xf2, 0xaa, 0xba, 0x5e, 0x35, 0x9a, 0x21, 0x78, 0x87, 0x41, 0xf5, 0x5f,
+	0x2a, 0xd0, 0x36, 0xb0, 0x83, 0x4d, 0x82, 0xbf, 0x48, 0xd5, 0xad, 0x40, 0xc9, 0xf5, 0x2c, 0xbc,
+	0xdb, 0x65, 0xaa, 0x53, 0x0d, 0xf1, 0xa7, 0xff, 0x49, 0x98, 0xf5, 0x29, 0xdf, 0x25, 0x09, 0xd3,
+	0x2f, 0x7e, 0x36, 0xa6, 0x2f, 0xc9, 0x4c, 0x3f, 0xb3, 0x55, 0xff, 0x12, 0x5b, 0xf5, 0x69, 0xd7,
+	0x5c, 0x6c, 0xf9, 0xc5, 0x94, 0xe5, 0xbf, 0x0f, 0x57, 0xb6, 0x7d, 0x6c, 0x06, 0xf8, 0x3d, 0x1a,
+	0x75, 0xb7, 0x4f, 0x4c, 0xd7, 0xc5, 0x4e, 0xb8, 0x84, 0x2c, 0x73, 0x45, 0xc2, 0xbc, 0x0d, 0xe5,
+	0x91, 0xef, 0x3d, 0x7a, 0x1c, 0xc9, 0x1d, 0xfe, 0xea, 0xbf, 0x51, 0xa0, 0x23, 0xa3, 0x3d, 0x4f,
+	0x58, 0x63, 0xa6, 0x61, 0xc2, 0xf5, 0xfa, 0x9c, 0x1e, 0xe3, 0xca, 0x4c, 0xc3, 0xc0, 0x82, 0x0b,
+	0x37, 0x35, 0x19, 0x3b, 0x31, 0x9e, 0xca, 0xf0, 0x1a, 0x1c, 0x2a, 0xd0, 0xf4, 0x3f, 0x28, 0x70,
+	0x65, 0x07, 0x07, 0x91, 0xf5, 0x28, 0x3b, 0xfc, 0x94, 0xa6, 0x88, 0x5f, 0x29, 0xd0, 0xca, 0x08,
+	0x8a, 0x56, 0xa1, 0x96, 0xc0, 0x11, 0x06, 0x4a, 0x82, 0xd0, 0x9b, 0xb0, 0x48, 0x75, 0x87, 0x99,
+	0x48, 0xcd, 0x2d, 0x5d, 0x96, 0x6b, 0xd3, 0x54, 0x0d, 0x3e, 0x01, 0xdd, 0x82, 0x25, 0x49, 0x7a,
+	0x10, 0xe2, 0xa3, 0x7c, 0x76, 0xd0, 0x3f, 0x55, 0xa0, 0x23, 0x53, 0xe6, 0x3c, 0x06, 0xff, 0x00,
+	0x56, 0xa2, 0xd5, 0xf4, 0x2c, 0x4c, 0xfa, 0xbe, 0x3d, 0xa2, 0xdf, 0x3c, 0xa3, 0xd5, 0xb6, 0x6e,
+	0x4c, 0x5f, 0x0f, 0x31, 0x96, 0x23, 0x12, 0xdd, 0x04, 0x05, 0xdd, 0x86, 0xe5, 0x1d, 0x1c, 0x1c,
+	0xe0, 0xc1, 0x10, 0xbb, 0xc1, 0xae, 0x7b, 0xec, 0x9d, 0xdf, 0xee, 0xd7, 0x01, 0x88, 0xa0, 0x13,
+	0x25, 0xdb, 0x04, 0x44, 0xff, 0xb5, 0x0a, 0xb5, 0x04, 0x23, 0x74, 0x15, 0xaa, 0xd1, 0xa8, 0xb0,
+	0x5a, 0x0c, 0xc8, 0x79, 0x4c, 0x41, 0xe2, 0x31, 0x19, 0xcb, 0xab, 0x79, 0xcb, 0x4f, 0x08, 0xf6,
+	0xe8, 0x0a, 0x54, 0x86, 0x78, 0xd8, 0x23, 0xf6, 0x13, 0x2c, 0x82, 0x41, 0x79, 0x88, 0x87, 0x07,
+	0xf6, 0x13, 0x4c, 0x87, 0xdc, 0xf1, 0xb0, 0xe7, 0x7b, 0x0f, 0x09, 0x0b, 0x8d, 0xaa, 0x51, 0x76,
+	0xc7, 0x43, 0xc3, 0x7b, 0x48, 0xd0, 0x35, 0x00, 0xdb, 0xb5, 0xf0, 0xa3, 0x9e, 0x6b, 0x0e, 0x71,
+	0xbb, 0xcc, 0x36, 0x53, 0x95, 0x41, 0xf6, 0xcc, 0x21, 0xa6, 0x61, 0x80, 0xfd, 0xec, 0x76, 0xdb,
+	0x15, 0x3e, 0x51, 0xfc, 0xd2, 0xa5, 0x8a, 0x2d, 0xb8, 0xdb, 0x6d, 0x57, 0xf9, 0xbc, 0x08, 0x40,
+	0x4b, 0x42, 0xb1, 0xee, 0x1e, 0x77, 0x53, 0x60, 0x6e, 0x2a, 0x2d, 0x09, 0x85, 0x02, 0xb9, 0x93,
+	0xd6, 0x49, 0xe2, 0x8f, 0x09, 0xee, 0x59, 0xb8, 0x67, 0x5b, 0xa4, 0x5d, 0x63, 0xda, 0x2f, 0xb3,
+	0xd5, 0x5a, 0x84, 0x46, 0x02, 0x2e, 0xf8, 0xd1, 0xd8, 0xe6, 0x99, 0xbc, 0xce, 0xcb, 0x59, 0x06,
+	0xbd, 0x23, 0x80, 0xac, 0x98, 0xcf, 0x7a, 0xc3, 0x3c, 0x8e, 0xfb, 0x65, 0x58, 0xb4, 0xdd, 0x63,
+	0x2f, 0xf4, 0xd3, 0x67, 0x4f, 0x59, 0x10, 0x63, 0xc6, 0xb1, 0xf5, 0xbf, 0x2b, 0xb0, 0xf2, 0x96,
+	0x65, 0xc9, 0xa2, 0xf1, 0xd9, 0xbd, 0x32, 0xf6, 0x80, 0x42, 0xca, 0x03, 0x66, 0x89, 0x48, 0x2f,
+	0xc1, 0xa5, 0x4c, 0xa4, 0x15, 0x8e, 0x54, 0x35, 0xb4, 0x74, 0xac, 0xdd, 0xed, 0xa2, 0x17, 0x41,
+	0x4b, 0x47, 0x5b, 0x91, 0x67, 0xaa, 0x46, 0x2b, 0x15, 0x6f, 0x77, 0xbb, 0xfa, 0x3f, 0x14, 0xb8,
+	0x62, 0xe0, 0xa1, 0xf7, 0x00, 0xff, 0xef, 0xae, 0xf1, 0xf7, 0x2a, 0xac, 0x7c, 0xcf, 0x0c, 0xfa,
+	0x27, 0xdd, 0xa1, 0x00, 0x92, 0x2f, 0x66, 0x81, 0x99, 0x20, 0x51, 0xcc, 0x07, 0x89, 0xc8, 0x4d,
+	0x17, 0x65, 0x6e, 0x4a, 0x8f, 0xbc, 0x9b, 0xef, 0x87, 0xeb, 0x8d, 0xdd, 0x34, 0x51, 0x88, 0x95,
+	0xce, 0x53, 0x88, 0x6d, 0x43, 0x03, 0x3f, 0xea, 0x3b, 0x63, 0xba, 0x63, 0x19, 0xf7, 0x32, 0xe3,
+	0x7e, 0x5d, 0xc2, 0x3d, 0xb9, 0x47, 0xea, 0x62, 0xd2, 0x2e, 0x93, 0xe1, 0x2a, 0x54, 0x45, 0xdd,
+	0x16, 0x05, 0x9d, 0x18, 0x90, 0xaf, 0xdf, 0xab, 0xf9, 0xfa, 0x5d, 0xff, 0x54, 0x85, 0x96, 0x60,
+	0x40, 0xcb, 0xdf, 0x19, 0x42, 0x73, 0x46, 0xa3, 0x85, 0xbc, 0x46, 0x67, 0xb1, 0x4b, 0x58, 0x26,
+	0x14, 0x13, 0x65, 0xc2, 0x35, 0x80, 0x63, 0x67, 0x4c, 0x4e, 0x7a, 0x81, 0x3d, 0x0c, 0x03, 0x73,
+	0x95, 0x41, 0x0e, 0xed, 0x21, 0x46, 0x6f, 0x41, 0xfd, 0xc8, 0x76, 0x1d, 0x6f, 0xd0, 0x1b, 0x99,
+	0xc1, 0x09, 0x3f, 0x8e, 0xc8, 0x35, 0xc6, 0x56, 0x77, 0x87, 0xe1, 0x1a, 0x35, 0x3e, 0x67, 0x9f,
+	0x4e, 0x41, 0xd7, 0xa1, 0x46, 0xa3, 0xbb, 0x77, 0xcc, 0x03, 0x7c, 0x99, 0xb3, 0x70, 0xc7, 0xc3,
+	0x77, 0x8f, 0x59, 0x88, 0x67, 0x91, 0x92, 0x60, 0x3f, 0xae, 0x99, 0x2a, 0xbc, 0x66, 0xe2, 0xd0,
+	0xb0, 0xb4, 0xfa, 0x1a, 0x54, 0x69, 0x8c, 0x23, 0x8e, 0x37, 0xe0, 0x5a, 0x9d, 0x2e, 0x46, 0x3c,
+	0x81, 0xaa, 0xd7, 0xc2, 0x4e, 0x60, 0xb2, 0xd9, 0xc0, 0xca, 0xea, 0x18, 0x10, 0x67, 0x19, 0x96,
+	0x9d, 0x6a, 0x5c, 0x42, 0x06, 0xa1, 0xf9, 0x49, 0xff, 0x67, 0x01, 0x96, 0xa8, 0xa1, 0x84, 0xcd,
+	0x2e, 0x60, 0x57, 0xdd, 0x0e, 0xf7, 0x83, 0x3a, 0xb9, 0xbc, 0xc8, 0x78, 0x4c, 0x7e, 0x4f, 0x9c,
+	0xeb, 0x5c, 0xfa, 0x1d, 0x68, 0x32, 0x87, 0xed, 0x7b, 0xae, 0xc5, 0x7c, 0x89, 0xf9, 0x40, 0x73,
+	0xeb, 0x79, 0x99, 0x08, 0x87, 0xbe, 0x3d, 0x18, 0x60, 0x7f, 0x3b, 0xc4, 0x35, 0x98, 0xb3, 0x47,
+	0xbf, 0xe9, 0xbd, 0x51, 0x9a, 0xba, 0x37, 0xca, 0x92, 0xbd, 0x41, 0x33, 0x91, 0x38, 0xdc, 0x5c,
+	0x9c, 0xba, 0xc3, 0x8d, 0xa0, 0x9e, 0x52, 0x2f, 0x17, 0x67, 0xa8, 0x97, 0x17, 0x25, 0x47, 0x9e,
+	0x74, 0x4d, 0x56, 0xca, 0xd5, 0x64, 0x87, 0xd0, 0x88, 0xe2, 0x33, 0xdb, 0xf9, 0x37, 0xa0, 0xc1,
+	0xc5, 0xea, 0xf1, 0x76, 0x53, 0x78, 0xde, 0xe1, 0x40, 0xde, 0x72, 0xa2, 0x54, 0xa3, 0xf8, 0xcf,
+	0x93, 0x7b, 0xd5, 0x48, 0x40, 0xf4, 0x4f, 0x14, 0xd0, 0x92, 0x99, 0x8d, 0x51, 0x9e, 0xe5, 0x20,
+	0xb5, 0x06, 0x2d, 0xd1, 0xc2, 0x8c, 0xd2, 0x8b, 0x38, 0xda, 0xdc, 0x4f, 0x92, 0xeb, 0xa2, 0x37,
+	0x60, 0x85, 0x23, 0xe6, 0xd2, 0x11, 0x3f, 0xe2, 0x5c, 0x66, 0xa3, 0x46, 0x26, 0x27, 0xfd, 0x47,
+	0x85, 0x66, 0xec, 0x7b, 0x33, 0x4b, 0x35, 0x4b, 0x1f, 0x69, 0x0f, 0xb4, 0xb8, 0x46, 0x67, 0x55,
+	0xdc, 0xa9, 0xdb, 0x27, 0x5b, 0x9d, 0xb7, 0x46, 0x69, 0x00, 0xba, 0x0b, 0x0d, 0xb1, 0x26, 0x91,
+	0x1d, 0x78, 0x9b, 0xf0, 0x39, 0x19, 0xb1, 0x94, 0x05, 0x8d, 0x7a, 0x22, 0x55, 0x11, 0x74, 0x1b,
+	0xaa, 0xcc, 0xcd, 0x83, 0xc7, 0x23, 0x2c, 0x36, 0xd3, 0xd5, 0x49, 0xad, 0xc6, 0xc3, 0xc7, 0x23,
+	0x6c, 0x54, 0x1c, 0xf1, 0x35, 0x6f, 0x7e, 0x7b, 0x1d, 0x96, 0x7d, 0xbe, 0x75, 0xac, 0x5e, 0x4a,
+	0x7d, 0x7c, 0xa3, 0x5d, 0x0e, 0x07, 0xf7, 0x93, 0x6a, 0x9c, 0x70, 0xde, 0xaa, 0x4c, 0x3a, 0x6f,
+	0xcd, 0x96, 0xe2, 0x7e, 0xae, 0x40, 0xcd, 0x10, 0x3b, 0x5f, 0xa4, 0xb7, 0x38, 0x32, 0x28, 0xd9,
+	0xc8, 0x30, 0xcb, 0xc9, 0x23, 0x59, 0x6b, 0xab, 0xb9, 0x5a, 0x3b, 0xdd, 0x39, 0x69, 0x17, 0xa3,
+	0x53, 0x77, 0xdc, 0x38, 0xd1, 0x7f, 0x00, 0x68, 0x07, 0x07, 0x42, 0xaa, 0x39, 0xa2, 0xca, 0x0c,
+	0xd2, 0xea, 0x3f, 0x55, 0x60, 0x29, 0xc5, 0x6c, 0x9e, 0xa2, 0xfe, 0xab, 0x50, 0x11, 0xba, 0x3a,
+	0xb5, 0xae, 0x4f, 0xe8, 0xdb, 0x88, 0x26, 0xe8, 0x7f, 0x55, 0xa0, 0x45, 0x5d, 0xcd, 0x76, 0x07,
+	0xfb, 0xbe, 0x37, 0xf0, 0x31, 0x61, 0x0a, 0x0b, 0xbc, 0xc0, 0x74, 0x7a, 0x22, 0x2e, 0x11, 0x61,
+	0x92, 0x06, 0x83, 0x86, 0x71, 0x97, 0xc6, 0x06, 0xd1, 0x38, 0x8f, 0xf0, 0xf8, 0x5a, 0x9b, 0x1c,
+	0x1c, 0x21, 0x5e, 0x03, 0xe0, 0xf4, 0x58, 0x86, 0xe7, 0x51, 0xb5, 0xca, 0x20, 0x2c, 0xc3, 0x3f,
+	0x0b, 0x35, 0x41, 0x87, 0x8d, 0xf3, 0xc8, 0x0a, 0x1c, 0xc4, 0x10, 0xae, 0x03, 0x24, 0x5c, 0x8f,
+	0x17, 0x21, 0x09, 0x88, 0xfe, 0x43, 0x68, 0x47, 0x3e, 0x9b, 0x5d, 0xcb, 0xf4, 0x5e, 0xc4, 0x37,
+	0xa0, 0x32, 0x12, 0xd8, 0x4c, 0xfe, 0x09, 0x01, 0x22, 0x43, 0xd8, 0x88, 0x26, 0xe9, 0x2e, 0x2c,
+	0xed, 0x79, 0x16, 0xce, 0x72, 0x8e, 0xb3, 0x8b, 0x92, 0xca, 0x2e, 0x73, 0xf3, 0xfb, 0x84, 0xb7,
+	0x87, 0xb2, 0x08, 0x17, 0xe9, 0xb0, 0xb9, 0x88, 0xab, 0x4a, 0x5a, 0x41, 0x7f, 0x2b, 0x40, 0x47,
+	0x26, 0xd7, 0x3c, 0xbe, 0x3d, 0xaf, 0xb2, 0x50, 0x0f, 0x2e, 0xc7, 0x69, 0x20, 0x84, 0x46, 0xa9,
+	0xe0, 0xe5, 0x53, 0x53, 0x41, 0x96, 0xea, 0x52, 0x44, 0x69, 0x3f, 0x22, 0x84, 0xf6, 0xa1, 0xc5,
+	0x02, 0x4f, 0x82, 0x36, 0xcf, 0x0c, 0x6b, 0x32, 0xda, 0x12, 0x47, 0x31, 0x9a, 0x74, 0x7e, 0x4c,
+	0x51, 0x77, 0xf9, 0x99, 0xff, 0xc4, 0xf4, 0xad, 0x77, 0xb0, 0x69, 0x61, 0xff, 0x82, 0x83, 0xd1,
+	0x47, 0x50, 0x4b, 0x30, 0x9b, 0xe8, 0xb7, 0x6d, 0x28, 0x9b, 0x96, 0x15, 0x59, 0xa2, 0x6a, 0x84,
+	0xbf, 0x74, 0x03, 0x5b, 0xc3, 0x30, 0xe3, 0x73, 0xd5, 0x56, 0x0d, 0xb0, 0xa2, 0x63, 0xa6, 0xfe,
+	0x31, 0x68, 0xc9, 0xe5, 0xbc, 0x63, 0x93, 0x60, 0x4a, 0xc8, 0xbf, 0x0d, 0x65, 0x87, 0x23, 0x9f,
+	0xda, 0xaa, 0x88, 0x89, 0x1a, 0x21, 0xbe, 0xfe, 0x33, 0x05, 0x9e, 0xc9, 0xe9, 0x6f, 0x1e, 0x1f,
+	0xfc, 0x66, 0x2e, 0xbe, 0x3e, 0x3f, 0x45, 0x18, 0xb6, 0xc2, 0x44, 0x90, 0x3d, 0x81, 0xc6, 0x01,
+	0x36, 0xfd, 0xfe, 0x49, 0x68, 0xc8, 0xaf, 0x80, 0xea, 0xe3, 0xfb, 0x42, 0x88, 0x0c, 0xb5, 0xe8,
+	0xbe, 0x37, 0x35, 0xc5, 0xa0, 0x13, 0xb2, 0x9a, 0x2e, 0xe4, 0x34, 0x6d, 0x43, 0xfd, 0x3d, 0x5e,
+	0x68, 0x71, 0x46, 0x6f, 0x26, 0x19, 0xbd, 0x30, 0x81, 0x91, 0x81, 0x03, 0xdf, 0xc6, 0x0f, 0xf0,
+	0xd9, 0x58, 0xfd, 0x08, 0x5a, 0xdf, 0x32, 0x5d, 0xcb, 0x3b, 0x3e, 0x8e, 0x02, 0xfd, 0xd9, 0xfd,
+	0xf3, 0x76, 0xba, 0x21, 0x75, 0x86, 0x93, 0x8d, 0xfe, 0x8b, 0x02, 0xac, 0x50, 0xd8, 0x1d, 0xd3,
+	0x31, 0xdd, 0x3e, 0x9e, 0xbd, 0x91, 0xf9, 0xd9, 0x9c, 0x96, 0x6f, 0x40, 0x43, 0xd4, 0x14, 0xa9,
+	0x7e, 0x66, 0x9d, 0x03, 0xf7, 0x18, 0x8c, 0x66, 0x3e, 0x8b, 0x04, 0xbd, 0xd4, 0x25, 0x47, 0xd5,
+	0x22, 0x81, 0x18, 0x7e, 0x16, 0x6a, 0x82, 0x86, 0xe5, 0xb9, 0x98, 0x55, 0x75, 0x15, 0x03, 0x38,
+	0xa8, 0xeb, 0xb9, 0xac, 0x83, 0x48, 0xe7, 0xb3, 0xd1, 0x32, 0x1b, 0x2d, 0x5b, 0x24, 0x60, 0x43,
+	0xd7, 0x00, 0x1e, 0x98, 0x8e, 0x6d, 0xb1, 0x6a, 0x94, 0xd5, 0x63, 0x15, 0xa3, 0xca, 0x20, 0x54,
+	0x05, 0xfa, 0x1f, 0x0b, 0x80, 0x12, 0xda, 0x39, 0x7f, 0x04, 0xb9, 0x09, 0xcd, 0xd4, 0x3a, 0xa3,
+	0x8b, 0xf7, 0xe4, 0x42, 0x09, 0x3d, 0x28, 0x1e, 0x71, 0x56, 0x3d, 0x1f, 0x9b, 0xc4, 0x73, 0xdb,
+	0xea, 0x59, 0x0e, 0x8a, 0x47, 0xa1, 0x98, 0x74, 0x2a, 0xf3, 0xbd, 0x48, 0x6d, 0xe1, 0xbd, 0x03,
+	0x44, 0x7a, 0x23, 0xb4, 0x47, 0x46, 0xb0, 0xe9, 0xc4, 0xa5, 0x47, 0x7c, 0xdc, 0xd2, 0xf8, 0xc0,
+	0x41, 0x04, 0xcf, 0x59, 0xb3, 0x24, 0x89, 0x81, 0x9f, 0x28, 0xb0, 0x74, 0xe8, 0x9b, 0x2e, 0x39,
+	0xc6, 0x3e, 0x65, 0x72, 0x7e, 0x7d, 0xb5, 0xa1, 0x9c, 0x56, 0x54, 0xf8, 0x8b, 0xb6, 0x60, 0x39,
+	0x30, 0xfd, 0x01, 0x0e, 0x7a, 0x99, 0x72, 0x94, 0x9f, 0x90, 0x96, 0xf8, 0xa0, 0x91, 0x2a, 0x4a,
+	0xef, 0xc1, 0x15, 0x16, 0x4b, 0x92, 0xc0, 0xf3, 0xa7, 0x03, 0xfd, 0x2d, 0xb8, 0x94, 0x22, 0xc5,
+	0x76, 0x0b, 0x82, 0x22, 0x6b, 0x9f, 0x2b, 0x4c, 0x0c, 0xf6, 0x3d, 0x79, 0x15, 0xec, 0x02, 0x4d,
+	0x26, 0xd2, 0x3c, 0x11, 0x76, 0x2f, 0x7f, 0xb7, 0xc9, 0xe3, 0xc1, 0x4d, 0x79, 0x21, 0x9b, 0x59,
+	0x41, 0xf6, 0x0a, 0x74, 0xe3, 0x09, 0x34, 0xd3, 0xe7, 0x39, 0x54, 0x87, 0xca, 0x9e, 0x17, 0xbc,
+	0xfd, 0xc8, 0x26, 0x81, 0xb6, 0x80, 0x9a, 0x00, 0x7b, 0x5e, 0xb0, 0xef, 0x63, 0x82, 0xdd, 0x40,
+	0x53, 0x10, 0x40, 0xe9, 0x5d, 0xb7, 0x6b, 0x93, 0x8f, 0xb5, 0x02, 0x5a, 0x12, 0xf7, 0x59, 0xa6,
+	0xb3, 0x2b, 0x0e, 0x37, 0x9a, 0x4a, 0xa7, 0x47, 0x7f, 0x45, 0xa4, 0x41, 0x3d, 0x42, 0xd9, 0xd9,
+	0xff, 0xae, 0xb6, 0x88, 0xaa, 0xb0, 0xc8, 0x3f, 0x4b, 0x1b, 0x26, 0x68, 0x59, 0xf7, 0x46, 0x35,
+	0x28, 0x9f, 0xf0, 0x50, 0xa9, 0x2d, 0xa0, 0x16, 0x2f, 0x77, 0xc5, 0xc6, 0xd4, 0x14, 0x0a, 0x18,
+	0xf8, 0xa3, 0xbe, 0xb0, 0xaa, 0x56, 0xa0, 0xdc, 0xa8, 0xb6, 0xbb, 0xde, 0x43, 0x57, 0x53, 0x29,
+	0x37, 0xfa, 0x77, 0x10, 0x78, 0xa3, 0x91, 0xed, 0x0e, 0xb4, 0xe2, 0xc6, 0xb7, 0xa1, 0x9e, 0xbc,
+	0x75, 0x40, 0x15, 0x28, 0xee, 0x79, 0x2e, 0xd6, 0x16, 0x28, 0xa3, 0x1d, 0xdf, 0x7b, 0x48, 0xd1,
+	0xd8, 0xaa, 0xee, 0xfa, 0xde, 0x13, 0xec, 0x6a, 0x05, 0x3a, 0x40, 0xf7, 0x05, 0x1d, 0x50, 0xe9,
+	0x00, 0xdf, 0x24, 0x5a, 0x71, 0xe3, 0x35, 0xa8, 0x84, 0x27, 0x4d, 0x74, 0x09, 0x1a, 0xa9, 0xfb,
+	0x76, 0x6d, 0x01, 0x21, 0xde, 0xff, 0x89, 0xcf, 0x94, 0x9a, 0xb2, 0xf5, 0x93, 0x16, 0x00, 0x6f,
+	0x26, 0x78, 0x9e, 0x6f, 0xa1, 0x11, 0x3b, 0x37, 0x6d, 0x7b, 0xc3, 0x91, 0xe7, 0x86, 0x22, 0x11,
+	0xf4, 0xea, 0x84, 0x5c, 0x93, 0x47, 0x15, 0xeb, 0xee, 0x4c, 0xca, 0x4e, 0x19, 0x74, 0x7d, 0x01,
+	0x0d, 0x19, 0x47, 0xda, 0x80, 0x3c, 0xb4, 0xfb, 0x1f, 0x87, 0x1d, 0xc0, 0x53, 0x38, 0x66, 0x50,
+	0x43, 0x8e, 0x99, 0x6c, 0x23, 0x7e, 0x0e, 0x02, 0xdf, 0x76, 0x07, 0xa1, 0x43, 0xeb, 0x0b, 0xe8,
+	0x3e, 0x5c, 0xa6, 0xf5, 0x44, 0x60, 0x06, 0x36, 0x09, 0xec, 0x3e, 0x09, 0x19, 0x6e, 0x4d, 0x66,
+	0x98, 0x43, 0x3e, 0x23, 0x4b, 0x07, 0x5a, 0x99, 0xc7, 0x53, 0x68, 0x43, 0x5e, 0x73, 0xc8, 0x1e,
+	0x7a, 0x75, 0x5e, 0x9a, 0x09, 0x37, 0xe2, 0x66, 0x43, 0x33, 0xfd, 0xca, 0x07, 0xbd, 0x38, 0x89,
+	0x40, 0xee, 0x45, 0x41, 0x67, 0x63, 0x16, 0xd4, 0x88, 0xd5, 0x07, 0xd0, 0x4c, 0xb9, 0xd8, 0x04,
+	0x56, 0xd2, 0x67, 0x1f, 0x9d, 0xd3, 0x62, 0x89, 0xbe, 0x80, 0x3e, 0xa2, 0xc1, 0x2d, 0xf3, 0xee,
+	0x01, 0xbd, 0x2c, 0x8f, 0x20, 0xf2, 0xe7, 0x11, 0xd3, 0x38, 0x08, 0xe9, 0x63, 0x2d, 0x4e, 0x96,
+	0x3e, 0xf7, 0xa0, 0x66, 0x76, 0xe9, 0x13, 0xe4, 0x4f, 0x93, 0xfe, 0xcc, 0x1c, 0xc6, 0x80, 0xf2,
+	0x2f, 0x1f, 0xd0, 0x2b, 0x32, 0x16, 0x13, 0x5f, 0x5f, 0x74, 0x36, 0x67, 0x45, 0x8f, 0x4c, 0x3e,
+	0x66, 0xbb, 0x35, 0xfb, 0x46, 0x40, 0xca, 0x76, 0xe2, 0xa3, 0x87, 0xce, 0xe6, 0xac, 0xe8, 0x49,
+	0xa7, 0x4e, 0xdf, 0x9c, 0xca, 0x6d, 0x25, 0xbd, 0x6b, 0xef, 0x6c, 0xcc, 0x82, 0x1a, 0xb1, 0xea,
+	0x01, 0xec, 0xe0, 0xe0, 0x1e, 0xad, 0xa2, 0xfb, 0x04, 0xbd, 0x20, 0xdd, 0xe2, 0x31, 0x42, 0xc8,
+	0x63, 0x6d, 0x2a, 0x5e, 0xc4, 0xe0, 0x23, 0xa8, 0x25, 0xba, 0x45, 0xe8, 0x85, 0x09, 0xd2, 0x65,
+	0x7a, 0x57, 0x9d, 0xb5, 0xa9, 0x78, 0x19, 0x23, 0x65, 0x5b, 0x18, 0x93, 0x8c, 0x24, 0x6f, 0x3d,
+	0x74, 0x36, 0x67, 0x45, 0x4f, 0xc6, 0xb9, 0xcc, 0x51, 0x0d, 0x4d, 0x54, 0x7d, 0xfe, 0x3c, 0xdc,
+	0x79, 0x69, 0x26, 0xdc, 0x88, 0xdb, 0xfb, 0x50, 0x4f, 0xd6, 0x78, 0x68, 0x4d, 0x5e, 0x9b, 0xe6,
+	0xaa, 0xc0, 0x19, 0x36, 0x56, 0xbe, 0x22, 0x92, 0x2b, 0x6f, 0x62, 0x31, 0xd7, 0xd9, 0x9c, 0x15,
+	0x3d, 0x5c, 0xce, 0xd6, 0xbf, 0x01, 0xaa, 0x6c, 0xcf, 0xb1, 0xc5, 0xfc, 0x3f, 0x0d, 0x7f, 0xf6,
+	0x69, 0xf8, 0x43, 0x68, 0x65, 0x9e, 0x3d, 0xc8, 0xdd, 0x53, 0xfe, 0x36, 0x62, 0x9a, 0xdb, 0x1c,
+	0x01, 0xca, 0xbf, 0x39, 0x90, 0xbb, 0xcd, 0xc4, 0xb7, 0x09, 0xd3, 0x78, 0x7c, 0x08, 0xad, 0xcc,
+	0x9d, 0xbf, 0x7c, 0x05, 0xf2, 0x87, 0x01, 0xd3, 0xa8, 0xbf, 0xcf, 0xdf, 0x3c, 0xc7, 0x1d, 0xe1,
+	0x49, 0xd9, 0x30, 0x73, 0x57, 0xf7, 0xc5, 0xe7, 0xc2, 0x8b, 0xaf, 0x15, 0x3e, 0x84, 0x56, 0xe6,
+	0xa2, 0x52, 0xae, 0x79, 0xf9, 0x6d, 0xe6, 0x34, 0xea, 0x9f, 0x63, 0x76, 0x3b, 0x80, 0x12, 0xef,
+	0x44, 0xa1, 0xe7, 0xe4, 0xdd, 0x99, 0x44, 0x97, 0xaa, 0x33, 0xad, 0x97, 0x45, 0xc6, 0x4e, 0x40,
+	0x18, 0xd1, 0x45, 0xe6, 0xcd, 0x48, 0xfa, 0xa6, 0x2a, 0xd9, 0xc2, 0xea, 0x4c, 0xef, 0x5a, 0x85,
+	0x44, 0x2f, 0x3a, 0x0f, 0xdf, 0x79, 0xe3, 0x83, 0xad, 0x81, 0x1d, 0x9c, 0x8c, 0x8f, 0xa8, 0x3d,
+	0x6e, 0x71, 0xcc, 0x57, 0x6c, 0x4f, 0x7c, 0xdd, 0x0a, 0x45, 0xbb, 0xc5, 0x28, 0xdd, 0x62, 0x6b,
+	0x19, 0x1d, 0x1d, 0x95, 0xd8, 0xef, 0xeb, 0xff, 0x1d, 0x00, 0xc7, 0x28, 0xa5, 0x75, 0x0d, 0x32,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2173,6 +3391,11 @@ type QueryCoordClient interface {
 	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
+	GetReplicas(ctx context.Context, in *GetReplicasRequest, opts ...grpc.CallOption) (*GetReplicasResponse, error)
+	GetLoadingProgress(ctx context.Context, in *GetLoadingProgressRequest, opts ...grpc.CallOption) (*GetLoadingProgressResponse, error)
+	GetShardLeaders(ctx context.Context, in *GetShardLeadersRequest, opts ...grpc.CallOption) (*GetShardLeadersResponse, error)
+	TransferNode(ctx context.Context, in *TransferNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListResourceGroups(ctx context.Context, in *ListResourceGroupsRequest, opts ...grpc.CallOption) (*ListResourceGroupsResponse, error)
 }
 
 type queryCoordClient struct {
@@ -2300,6 +3523,51 @@ func (c *queryCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetri
 	return out, nil
 }
 
+func (c *queryCoordClient) GetReplicas(ctx context.Context, in *GetReplicasRequest, opts ...grpc.CallOption) (*GetReplicasResponse, error) {
+	out := new(GetReplicasResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetReplicas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetLoadingProgress(ctx context.Context, in *GetLoadingProgressRequest, opts ...grpc.CallOption) (*GetLoadingProgressResponse, error) {
+	out := new(GetLoadingProgressResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetLoadingProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) GetShardLeaders(ctx context.Context, in *GetShardLeadersRequest, opts ...grpc.CallOption) (*GetShardLeadersResponse, error) {
+	out := new(GetShardLeadersResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/GetShardLeaders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) TransferNode(ctx context.Context, in *TransferNodeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/TransferNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryCoordClient) ListResourceGroups(ctx context.Context, in *ListResourceGroupsRequest, opts ...grpc.CallOption) (*ListResourceGroupsResponse, error) {
+	out := new(ListResourceGroupsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/ListResourceGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryCoordServer is the server API for QueryCoord service.
 type QueryCoordServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -2316,6 +3584,11 @@ type QueryCoordServer interface {
 	GetSegmentInfo(context.Context, *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+	GetReplicas(context.Context, *GetReplicasRequest) (*GetReplicasResponse, error)
+	GetLoadingProgress(context.Context, *GetLoadingProgressRequest) (*GetLoadingProgressResponse, error)
+	GetShardLeaders(context.Context, *GetShardLeadersRequest) (*GetShardLeadersResponse, error)
+	TransferNode(context.Context, *TransferNodeRequest) (*commonpb.Status, error)
+	ListResourceGroups(context.Context, *ListResourceGroupsRequest) (*ListResourceGroupsResponse, error)
 }
 
 // UnimplementedQueryCoordServer can be embedded to have forward compatible implementations.
@@ -2361,6 +3634,21 @@ func (*UnimplementedQueryCoordServer) GetSegmentInfo(ctx context.Context, req *G
 func (*UnimplementedQueryCoordServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
+func (*UnimplementedQueryCoordServer) GetReplicas(ctx context.Context, req *GetReplicasRequest) (*GetReplicasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReplicas not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetLoadingProgress(ctx context.Context, req *GetLoadingProgressRequest) (*GetLoadingProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoadingProgress not implemented")
+}
+func (*UnimplementedQueryCoordServer) GetShardLeaders(ctx context.Context, req *GetShardLeadersRequest) (*GetShardLeadersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShardLeaders not implemented")
+}
+func (*UnimplementedQueryCoordServer) TransferNode(ctx context.Context, req *TransferNodeRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferNode not implemented")
+}
+func (*UnimplementedQueryCoordServer) ListResourceGroups(ctx context.Context, req *ListResourceGroupsRequest) (*ListResourceGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResourceGroups not implemented")
+}
 
 func RegisterQueryCoordServer(s *grpc.Server, srv QueryCoordServer) {
 	s.RegisterService(&_QueryCoord_serviceDesc, srv)
@@ -2600,6 +3888,96 @@ func _QueryCoord_GetMetrics_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryCoord_GetReplicas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReplicasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetReplicas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetReplicas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetReplicas(ctx, req.(*GetReplicasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetLoadingProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoadingProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetLoadingProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetLoadingProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetLoadingProgress(ctx, req.(*GetLoadingProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_GetShardLeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShardLeadersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).GetShardLeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/GetShardLeaders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).GetShardLeaders(ctx, req.(*GetShardLeadersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_TransferNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).TransferNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/TransferNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).TransferNode(ctx, req.(*TransferNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryCoord_ListResourceGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResourceGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).ListResourceGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/ListResourceGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).ListResourceGroups(ctx, req.(*ListResourceGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _QueryCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.query.QueryCoord",
 	HandlerType: (*QueryCoordServer)(nil),
@@ -2656,6 +4034,26 @@ var _QueryCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMetrics",
 			Handler:    _QueryCoord_GetMetrics_Handler,
 		},
+		{
+			MethodName: "GetReplicas",
+			Handler:    _QueryCoord_GetReplicas_Handler,
+		},
+		{
+			MethodName: "GetLoadingProgress",
+			Handler:    _QueryCoord_GetLoadingProgress_Handler,
+		},
+		{
+			MethodName: "GetShardLeaders",
+			Handler:    _QueryCoord_GetShardLeaders_Handler,
+		},
+		{
+			MethodName: "TransferNode",
+			Handler:    _QueryCoord_TransferNode_Handler,
+		},
+		{
+			MethodName: "ListResourceGroups",
+			Handler:    _QueryCoord_ListResourceGroups_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "query_coord.proto",
@@ -2676,6 +4074,8 @@ type QueryNodeClient interface {
 	ReleasePartitions(ctx context.Context, in *ReleasePartitionsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ReleaseSegments(ctx context.Context, in *ReleaseSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*internalpb.SearchResults, error)
+	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*internalpb.RetrieveResults, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
 }
@@ -2787,6 +4187,24 @@ func (c *queryNodeClient) GetSegmentInfo(ctx context.Context, in *GetSegmentInfo
 	return out, nil
 }
 
+func (c *queryNodeClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*internalpb.SearchResults, error) {
+	out := new(internalpb.SearchResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryNodeClient) Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*internalpb.RetrieveResults, error) {
+	out := new(internalpb.RetrieveResults)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/Query", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryNodeClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
 	out := new(milvuspb.GetMetricsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/GetMetrics", in, out, opts...)
@@ -2809,6 +4227,8 @@ type QueryNodeServer interface {
 	ReleasePartitions(context.Context, *ReleasePartitionsRequest) (*commonpb.Status, error)
 	ReleaseSegments(context.Context, *ReleaseSegmentsRequest) (*commonpb.Status, error)
 	GetSegmentInfo(context.Context, *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error)
+	Search(context.Context, *SearchRequest) (*internalpb.SearchResults, error)
+	Query(context.Context, *QueryRequest) (*internalpb.RetrieveResults, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 }
@@ -2850,6 +4270,12 @@ func (*UnimplementedQueryNodeServer) ReleaseSegments(ctx context.Context, req *R
 func (*UnimplementedQueryNodeServer) GetSegmentInfo(ctx context.Context, req *GetSegmentInfoRequest) (*GetSegmentInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentInfo not implemented")
 }
+func (*UnimplementedQueryNodeServer) Search(ctx context.Context, req *SearchRequest) (*internalpb.SearchResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (*UnimplementedQueryNodeServer) Query(ctx context.Context, req *QueryRequest) (*internalpb.RetrieveResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
+}
 func (*UnimplementedQueryNodeServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
@@ -3056,6 +4482,42 @@ func _QueryNode_GetSegmentInfo_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryNode_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryNode_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).Query(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/Query",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).Query(ctx, req.(*QueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QueryNode_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.GetMetricsRequest)
 	if err := dec(in); err != nil {
@@ -3122,6 +4584,14 @@ var _QueryNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSegmentInfo",
 			Handler:    _QueryNode_GetSegmentInfo_Handler,
 		},
+		{
+			MethodName: "Search",
+			Handler:    _QueryNode_Search_Handler,
+		},
+		{
+			MethodName: "Query",
+			Handler:    _QueryNode_Query_Handler,
+		},
 		{
 			MethodName: "GetMetrics",
 			Handler:    _QueryNode_GetMetrics_Handler,
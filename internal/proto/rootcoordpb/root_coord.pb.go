@@ -29,6 +29,155 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type ListPolicyRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListPolicyRequest) Reset()         { *m = ListPolicyRequest{} }
+func (m *ListPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPolicyRequest) ProtoMessage()    {}
+func (*ListPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{0}
+}
+
+func (m *ListPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPolicyRequest.Unmarshal(m, b)
+}
+func (m *ListPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPolicyRequest.Marshal(b, m, deterministic)
+}
+func (m *ListPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPolicyRequest.Merge(m, src)
+}
+func (m *ListPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPolicyRequest.Size(m)
+}
+func (m *ListPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPolicyRequest proto.InternalMessageInfo
+
+func (m *ListPolicyRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type UserPolicy struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	EncryptedPassword    string   `protobuf:"bytes,2,opt,name=encrypted_password,json=encryptedPassword,proto3" json:"encrypted_password,omitempty"`
+	Roles                []string `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserPolicy) Reset()         { *m = UserPolicy{} }
+func (m *UserPolicy) String() string { return proto.CompactTextString(m) }
+func (*UserPolicy) ProtoMessage()    {}
+func (*UserPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{1}
+}
+
+func (m *UserPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserPolicy.Unmarshal(m, b)
+}
+func (m *UserPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserPolicy.Marshal(b, m, deterministic)
+}
+func (m *UserPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserPolicy.Merge(m, src)
+}
+func (m *UserPolicy) XXX_Size() int {
+	return xxx_messageInfo_UserPolicy.Size(m)
+}
+func (m *UserPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserPolicy proto.InternalMessageInfo
+
+func (m *UserPolicy) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *UserPolicy) GetEncryptedPassword() string {
+	if m != nil {
+		return m.EncryptedPassword
+	}
+	return ""
+}
+
+func (m *UserPolicy) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+type ListPolicyResponse struct {
+	Status               *commonpb.Status        `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Users                []*UserPolicy           `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	Grants               []*milvuspb.GrantEntity `protobuf:"bytes,3,rep,name=grants,proto3" json:"grants,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ListPolicyResponse) Reset()         { *m = ListPolicyResponse{} }
+func (m *ListPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPolicyResponse) ProtoMessage()    {}
+func (*ListPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{2}
+}
+
+func (m *ListPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPolicyResponse.Unmarshal(m, b)
+}
+func (m *ListPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPolicyResponse.Marshal(b, m, deterministic)
+}
+func (m *ListPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPolicyResponse.Merge(m, src)
+}
+func (m *ListPolicyResponse) XXX_Size() int {
+	return xxx_messageInfo_ListPolicyResponse.Size(m)
+}
+func (m *ListPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPolicyResponse proto.InternalMessageInfo
+
+func (m *ListPolicyResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListPolicyResponse) GetUsers() []*UserPolicy {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
+func (m *ListPolicyResponse) GetGrants() []*milvuspb.GrantEntity {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
 type AllocTimestampRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Count                uint32            `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
@@ -41,7 +190,7 @@ func (m *AllocTimestampRequest) Reset()         { *m = AllocTimestampRequest{} }
 func (m *AllocTimestampRequest) String() string { return proto.CompactTextString(m) }
 func (*AllocTimestampRequest) ProtoMessage()    {}
 func (*AllocTimestampRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{0}
+	return fileDescriptor_4513485a144f6b06, []int{3}
 }
 
 func (m *AllocTimestampRequest) XXX_Unmarshal(b []byte) error {
@@ -89,7 +238,7 @@ func (m *AllocTimestampResponse) Reset()         { *m = AllocTimestampResponse{}
 func (m *AllocTimestampResponse) String() string { return proto.CompactTextString(m) }
 func (*AllocTimestampResponse) ProtoMessage()    {}
 func (*AllocTimestampResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{1}
+	return fileDescriptor_4513485a144f6b06, []int{4}
 }
 
 func (m *AllocTimestampResponse) XXX_Unmarshal(b []byte) error {
@@ -143,7 +292,7 @@ func (m *AllocIDRequest) Reset()         { *m = AllocIDRequest{} }
 func (m *AllocIDRequest) String() string { return proto.CompactTextString(m) }
 func (*AllocIDRequest) ProtoMessage()    {}
 func (*AllocIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{2}
+	return fileDescriptor_4513485a144f6b06, []int{5}
 }
 
 func (m *AllocIDRequest) XXX_Unmarshal(b []byte) error {
@@ -191,7 +340,7 @@ func (m *AllocIDResponse) Reset()         { *m = AllocIDResponse{} }
 func (m *AllocIDResponse) String() string { return proto.CompactTextString(m) }
 func (*AllocIDResponse) ProtoMessage()    {}
 func (*AllocIDResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4513485a144f6b06, []int{3}
+	return fileDescriptor_4513485a144f6b06, []int{6}
 }
 
 func (m *AllocIDResponse) XXX_Unmarshal(b []byte) error {
@@ -234,6 +383,9 @@ func (m *AllocIDResponse) GetCount() uint32 {
 }
 
 func init() {
+	proto.RegisterType((*ListPolicyRequest)(nil), "milvus.proto.rootcoord.ListPolicyRequest")
+	proto.RegisterType((*UserPolicy)(nil), "milvus.proto.rootcoord.UserPolicy")
+	proto.RegisterType((*ListPolicyResponse)(nil), "milvus.proto.rootcoord.ListPolicyResponse")
 	proto.RegisterType((*AllocTimestampRequest)(nil), "milvus.proto.rootcoord.AllocTimestampRequest")
 	proto.RegisterType((*AllocTimestampResponse)(nil), "milvus.proto.rootcoord.AllocTimestampResponse")
 	proto.RegisterType((*AllocIDRequest)(nil), "milvus.proto.rootcoord.AllocIDRequest")
@@ -243,57 +395,76 @@ func init() {
 func init() { proto.RegisterFile("root_coord.proto", fileDescriptor_4513485a144f6b06) }
 
 var fileDescriptor_4513485a144f6b06 = []byte{
-	// 787 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x5d, 0x4f, 0xdb, 0x3e,
-	0x14, 0xc6, 0x69, 0xe1, 0xcf, 0x5f, 0x1c, 0xda, 0x82, 0x2c, 0x60, 0xa8, 0xe3, 0x82, 0x75, 0x1a,
-	0xb4, 0xbc, 0xa4, 0x08, 0xa4, 0x69, 0xb7, 0xa3, 0xd5, 0xa0, 0xd2, 0x2a, 0x8d, 0x14, 0xa4, 0xbd,
-	0xa1, 0xca, 0x4d, 0x8f, 0xda, 0x88, 0x24, 0x0e, 0xb1, 0x3b, 0xd8, 0xe5, 0xbe, 0xd9, 0x3e, 0xda,
-	0x94, 0x17, 0xa7, 0x49, 0x9a, 0x94, 0xa0, 0xed, 0xae, 0x8e, 0x7f, 0x7e, 0x1e, 0x1f, 0x3f, 0xa7,
-	0xb2, 0x61, 0xdd, 0x61, 0x4c, 0xf4, 0x35, 0xc6, 0x9c, 0xa1, 0x62, 0x3b, 0x4c, 0x30, 0xb2, 0x65,
-	0xea, 0xc6, 0x8f, 0x09, 0xf7, 0x47, 0x8a, 0x3b, 0xed, 0xcd, 0x56, 0x4b, 0x1a, 0x33, 0x4d, 0x66,
-	0xf9, 0xdf, 0xab, 0xa5, 0x28, 0x55, 0xad, 0xe8, 0x96, 0x40, 0xc7, 0xa2, 0x46, 0x30, 0x5e, 0xb5,
-	0x1d, 0xf6, 0xf8, 0x33, 0x18, 0xac, 0x0f, 0xa9, 0xa0, 0x51, 0x8b, 0x5a, 0x1f, 0x36, 0xdf, 0x1b,
-	0x06, 0xd3, 0xae, 0x75, 0x13, 0xb9, 0xa0, 0xa6, 0xad, 0xe2, 0xfd, 0x04, 0xb9, 0x20, 0x27, 0xb0,
-	0x34, 0xa0, 0x1c, 0xb7, 0x0b, 0xbb, 0x85, 0xfa, 0xea, 0xe9, 0x8e, 0x12, 0xdb, 0x4a, 0xe0, 0xdf,
-	0xe5, 0xa3, 0x73, 0xca, 0x51, 0xf5, 0x48, 0xb2, 0x01, 0xff, 0x69, 0x6c, 0x62, 0x89, 0xed, 0xc5,
-	0xdd, 0x42, 0xbd, 0xac, 0xfa, 0x83, 0xda, 0xaf, 0x02, 0x6c, 0x25, 0x1d, 0xb8, 0xcd, 0x2c, 0x8e,
-	0xe4, 0x0c, 0x96, 0xb9, 0xa0, 0x62, 0xc2, 0x03, 0x93, 0x97, 0xa9, 0x26, 0x3d, 0x0f, 0x51, 0x03,
-	0x94, 0xec, 0xc0, 0x8a, 0x90, 0x4a, 0xdb, 0xc5, 0xdd, 0x42, 0x7d, 0x49, 0x9d, 0x7e, 0xc8, 0xd8,
-	0xc3, 0x67, 0xa8, 0x78, 0x5b, 0xe8, 0xb4, 0xff, 0x41, 0x75, 0xc5, 0xa8, 0xb2, 0x01, 0x6b, 0xa1,
-	0xf2, 0xdf, 0x54, 0x55, 0x81, 0x62, 0xa7, 0xed, 0x49, 0x2f, 0xaa, 0xc5, 0x4e, 0x3b, 0xbd, 0x8e,
-	0xd3, 0xdf, 0x04, 0x56, 0x54, 0xc6, 0x44, 0xcb, 0x0d, 0x90, 0xd8, 0x40, 0x2e, 0x50, 0xb4, 0x98,
-	0x69, 0x33, 0x0b, 0x2d, 0xe1, 0x2a, 0x22, 0x27, 0x27, 0x71, 0xbb, 0xb0, 0x1b, 0x66, 0xd1, 0xe0,
-	0x2c, 0xaa, 0x7b, 0x19, 0x2b, 0x12, 0x78, 0x6d, 0x81, 0x98, 0x9e, 0xa3, 0x1b, 0xe4, 0xb5, 0xae,
-	0xdd, 0xb5, 0xc6, 0xd4, 0xb2, 0xd0, 0x98, 0xe7, 0x98, 0x40, 0xa5, 0xe3, 0xeb, 0xf8, 0x8a, 0x60,
-	0xd0, 0x13, 0x8e, 0x6e, 0x8d, 0xe4, 0x39, 0xd6, 0x16, 0xc8, 0x3d, 0x6c, 0x5c, 0xa0, 0xe7, 0xae,
-	0x73, 0xa1, 0x6b, 0x5c, 0x1a, 0x9e, 0x66, 0x1b, 0xce, 0xc0, 0xcf, 0xb4, 0xec, 0xc3, 0x7a, 0xcb,
-	0x41, 0x2a, 0xb0, 0xc5, 0x0c, 0x03, 0x35, 0xa1, 0x33, 0x8b, 0x1c, 0xa5, 0x2e, 0x4d, 0x62, 0xd2,
-	0x68, 0x5e, 0xdc, 0xb5, 0x05, 0xf2, 0x0d, 0x2a, 0x6d, 0x87, 0xd9, 0x11, 0xf9, 0x83, 0x54, 0xf9,
-	0x38, 0x94, 0x53, 0xbc, 0x0f, 0xe5, 0x4b, 0xca, 0x23, 0xda, 0x8d, 0x54, 0xed, 0x18, 0x23, 0xa5,
-	0x5f, 0xa5, 0xa2, 0xe7, 0x8c, 0x19, 0x91, 0xe3, 0x79, 0x00, 0xd2, 0x46, 0xae, 0x39, 0xfa, 0x20,
-	0x7a, 0x40, 0x4a, 0x7a, 0x05, 0x33, 0xa0, 0xb4, 0x6a, 0xe6, 0xe6, 0x43, 0x63, 0x0b, 0xd6, 0x7a,
-	0x63, 0xf6, 0x30, 0x9d, 0xe3, 0xe4, 0x30, 0x3d, 0xd1, 0x38, 0x25, 0x2d, 0x8f, 0xf2, 0xc1, 0xa1,
-	0xdf, 0x2d, 0xac, 0xf9, 0x01, 0x7f, 0xa2, 0x8e, 0xd0, 0xbd, 0x2a, 0x0f, 0xe7, 0xb4, 0x41, 0x48,
-	0xe5, 0x0c, 0xea, 0x0b, 0x94, 0xdd, 0x80, 0xa7, 0xe2, 0x8d, 0xcc, 0x26, 0x78, 0xae, 0xf4, 0x2d,
-	0x94, 0x2e, 0x29, 0x9f, 0x2a, 0xd7, 0xb3, 0x5a, 0x60, 0x46, 0x38, 0x57, 0x07, 0xdc, 0x41, 0xc5,
-	0x3d, 0xb5, 0x70, 0x31, 0xcf, 0xe8, 0xdf, 0x38, 0x24, 0x2d, 0x0e, 0x73, 0xb1, 0xd1, 0xd4, 0x65,
-	0x57, 0xf4, 0x70, 0x64, 0xa2, 0x25, 0x32, 0x52, 0x48, 0x50, 0xf3, 0x53, 0x9f, 0x81, 0x43, 0x3f,
-	0x84, 0x92, 0xbb, 0x97, 0x60, 0x82, 0x67, 0x9c, 0x5d, 0x14, 0x91, 0x4e, 0x8d, 0x1c, 0x64, 0x68,
-	0x73, 0x03, 0xab, 0x7e, 0xdb, 0x74, 0xac, 0x21, 0x3e, 0x92, 0xfd, 0x39, 0x8d, 0xe5, 0x11, 0x39,
-	0x93, 0x1f, 0x43, 0x59, 0x96, 0xe6, 0x0b, 0x37, 0xe6, 0x96, 0x1f, 0x93, 0x3e, 0xc8, 0x83, 0x86,
-	0x05, 0x5c, 0xc1, 0x8a, 0xdb, 0x9a, 0xbe, 0xcb, 0x9b, 0xcc, 0xd6, 0x7d, 0xce, 0xe6, 0xef, 0x83,
-	0x2b, 0x3a, 0x7c, 0x25, 0x90, 0x63, 0x25, 0xfd, 0xf5, 0xa3, 0xa4, 0xbe, 0x57, 0xaa, 0x4a, 0x5e,
-	0x3c, 0xac, 0xe2, 0x3b, 0xfc, 0x1f, 0xdc, 0xdd, 0x64, 0x6f, 0xee, 0xe2, 0xf0, 0xd9, 0x50, 0xdd,
-	0x7f, 0x92, 0x0b, 0xd5, 0x29, 0x6c, 0xde, 0xd8, 0x43, 0xf7, 0x8a, 0xf0, 0x2f, 0x22, 0x79, 0x15,
-	0x26, 0x53, 0x99, 0x5e, 0xb7, 0x71, 0xae, 0xcb, 0x47, 0x4f, 0x9d, 0x99, 0x01, 0x2f, 0x54, 0x34,
-	0x90, 0x72, 0x6c, 0x5f, 0x7d, 0xec, 0x22, 0xe7, 0x74, 0x84, 0x3d, 0xe1, 0x20, 0x35, 0x93, 0x57,
-	0xa4, 0xff, 0x06, 0xcc, 0x80, 0x73, 0x26, 0xa4, 0xc1, 0x66, 0xd0, 0xcb, 0x1f, 0x8c, 0x09, 0x1f,
-	0xbb, 0xaf, 0x03, 0x03, 0x05, 0x0e, 0x93, 0x7f, 0x49, 0xf7, 0x89, 0xa9, 0xa4, 0x92, 0x39, 0x4a,
-	0xea, 0x03, 0x5c, 0xa0, 0xe8, 0xa2, 0x70, 0x74, 0x8d, 0x27, 0x63, 0x09, 0x06, 0x53, 0x20, 0x23,
-	0x96, 0x14, 0x4e, 0xc6, 0x72, 0xfe, 0xee, 0xeb, 0xdb, 0x91, 0x2e, 0xc6, 0x93, 0x81, 0x6b, 0xdd,
-	0xf4, 0xc9, 0x63, 0x9d, 0x05, 0xbf, 0x9a, 0x32, 0x8d, 0xa6, 0xa7, 0xd4, 0x0c, 0x03, 0xb6, 0x07,
-	0x83, 0x65, 0xef, 0xd3, 0xd9, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa8, 0x2e, 0x6c, 0xa3, 0xa7,
-	0x0b, 0x00, 0x00,
+	// 1099 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0x5d, 0x53, 0x1b, 0x37,
+	0x14, 0x86, 0x03, 0x24, 0x34, 0x1c, 0x83, 0x21, 0x9a, 0x90, 0x32, 0x4e, 0x2e, 0xa8, 0xdb, 0x12,
+	0x1b, 0x82, 0xc9, 0x90, 0x99, 0x0e, 0xb7, 0x05, 0xa7, 0x84, 0x99, 0x30, 0x21, 0xeb, 0x30, 0xfd,
+	0x48, 0x19, 0x8f, 0xbc, 0x3e, 0x63, 0xef, 0x64, 0x77, 0xb5, 0x48, 0x72, 0x08, 0x97, 0xfd, 0x61,
+	0xf9, 0x6f, 0x1d, 0xed, 0x87, 0xbc, 0x5e, 0xaf, 0x8c, 0xdc, 0xf4, 0x8e, 0xf5, 0x3e, 0x7a, 0xdf,
+	0xa3, 0x73, 0x8e, 0x56, 0x12, 0xb0, 0xc1, 0x19, 0x93, 0x5d, 0x97, 0x31, 0xde, 0x6f, 0x45, 0x9c,
+	0x49, 0x46, 0x9e, 0x04, 0x9e, 0xff, 0x79, 0x24, 0x92, 0xa7, 0x96, 0x7a, 0x1d, 0xbf, 0xad, 0xad,
+	0xba, 0x2c, 0x08, 0x58, 0x98, 0xfc, 0x5e, 0x5b, 0xcd, 0x53, 0xb5, 0xaa, 0x17, 0x4a, 0xe4, 0x21,
+	0xf5, 0xd3, 0xe7, 0x4a, 0xc4, 0xd9, 0x97, 0xdb, 0xf4, 0x61, 0xa3, 0x4f, 0x25, 0xcd, 0x5b, 0xd4,
+	0x5f, 0xc3, 0xa3, 0xb7, 0x9e, 0x90, 0x17, 0xcc, 0xf7, 0xdc, 0x5b, 0x07, 0xaf, 0x47, 0x28, 0x24,
+	0x79, 0x09, 0xf7, 0x7b, 0x54, 0xe0, 0xd6, 0xc2, 0xf6, 0x42, 0xa3, 0x72, 0xf8, 0xac, 0x35, 0x11,
+	0x46, 0xea, 0x7d, 0x2e, 0x06, 0xc7, 0x54, 0xa0, 0x13, 0x93, 0xf5, 0x00, 0xe0, 0x52, 0x20, 0x4f,
+	0x64, 0x48, 0x0d, 0x1e, 0x8e, 0x84, 0x0a, 0x22, 0x48, 0x34, 0x56, 0x1c, 0xfd, 0x4c, 0xf6, 0x81,
+	0x60, 0xe8, 0xf2, 0xdb, 0x48, 0x62, 0xbf, 0x1b, 0x51, 0x21, 0x6e, 0x18, 0xef, 0x6f, 0x2d, 0xc6,
+	0xd4, 0x23, 0xfd, 0xe6, 0x22, 0x7d, 0x41, 0x1e, 0xc3, 0x03, 0xce, 0x7c, 0x14, 0x5b, 0x4b, 0xdb,
+	0x4b, 0x8d, 0x15, 0x27, 0x79, 0xa8, 0x7f, 0x5d, 0x00, 0x92, 0x0f, 0x5b, 0x44, 0x2c, 0x14, 0x48,
+	0x5e, 0xc1, 0xb2, 0x90, 0x54, 0x8e, 0x44, 0x1a, 0xf9, 0xd3, 0xd2, 0xc8, 0x3b, 0x31, 0xe2, 0xa4,
+	0x28, 0x39, 0x82, 0x07, 0x2a, 0x38, 0xb1, 0xb5, 0xb8, 0xbd, 0xd4, 0xa8, 0x1c, 0xd6, 0x5b, 0xe5,
+	0x49, 0x6f, 0x8d, 0xe7, 0xe7, 0x24, 0x03, 0xc8, 0x11, 0x2c, 0x0f, 0x38, 0x0d, 0x65, 0x12, 0x5c,
+	0xe5, 0x70, 0x7b, 0x72, 0x68, 0xfa, 0x70, 0xaa, 0x90, 0xd7, 0xa1, 0xf4, 0xe4, 0xad, 0x93, 0xf2,
+	0xf5, 0x2e, 0x6c, 0xfe, 0xea, 0xfb, 0xcc, 0xfd, 0xe0, 0x05, 0x28, 0x24, 0x0d, 0xa2, 0xff, 0x9c,
+	0x79, 0x95, 0x20, 0x97, 0x8d, 0x42, 0xb9, 0xb5, 0xb4, 0xbd, 0xd0, 0x58, 0x73, 0x92, 0x87, 0xfa,
+	0x3f, 0x0b, 0xf0, 0xa4, 0xe8, 0xf0, 0x2d, 0x49, 0x7a, 0x06, 0x2b, 0x32, 0x53, 0x8a, 0x8b, 0x75,
+	0xdf, 0x19, 0xff, 0x60, 0x88, 0xe1, 0x0f, 0xa8, 0xc6, 0x21, 0x9c, 0xb5, 0xff, 0x87, 0xd9, 0x2d,
+	0xe6, 0x95, 0x7d, 0x58, 0xd7, 0xca, 0xdf, 0x32, 0xab, 0x2a, 0x2c, 0x9e, 0xb5, 0x63, 0xe9, 0x25,
+	0x67, 0xf1, 0xac, 0x5d, 0x3e, 0x8f, 0xc3, 0xaf, 0x4f, 0x61, 0xc5, 0x61, 0x4c, 0x9e, 0xa8, 0x36,
+	0x20, 0x11, 0x90, 0x53, 0x94, 0x27, 0x2c, 0x88, 0x58, 0x88, 0xa1, 0x54, 0x8a, 0x28, 0xc8, 0xcb,
+	0x49, 0x3b, 0xbd, 0x06, 0xa7, 0xd1, 0x34, 0x17, 0xb5, 0x1d, 0xc3, 0x88, 0x02, 0x5e, 0xbf, 0x47,
+	0x82, 0xd8, 0x51, 0x15, 0xf2, 0x83, 0xe7, 0x7e, 0x3a, 0x19, 0xd2, 0x30, 0x44, 0x7f, 0x96, 0x63,
+	0x01, 0xcd, 0x1c, 0x7f, 0x2c, 0x6d, 0xcf, 0x8e, 0xe4, 0x5e, 0x38, 0xc8, 0xf2, 0x58, 0xbf, 0x47,
+	0xae, 0xe1, 0xf1, 0x29, 0xc6, 0xee, 0x9e, 0x90, 0x9e, 0x2b, 0x32, 0xc3, 0x43, 0xb3, 0xe1, 0x14,
+	0x3c, 0xa7, 0x65, 0x17, 0x36, 0x4e, 0x38, 0x52, 0x89, 0x27, 0xcc, 0xf7, 0xd1, 0x95, 0x1e, 0x0b,
+	0xc9, 0x8b, 0xd2, 0xa1, 0x45, 0x2c, 0x33, 0x9a, 0x55, 0xee, 0xfa, 0x3d, 0xf2, 0x11, 0xaa, 0x6d,
+	0xce, 0xa2, 0x9c, 0xfc, 0x6e, 0xa9, 0xfc, 0x24, 0x64, 0x29, 0xde, 0x85, 0xb5, 0x37, 0x54, 0xe4,
+	0xb4, 0x9b, 0xa5, 0xda, 0x13, 0x4c, 0x26, 0xfd, 0x43, 0x29, 0x7a, 0xcc, 0x98, 0x9f, 0x4b, 0xcf,
+	0x0d, 0x90, 0x36, 0x0a, 0x97, 0x7b, 0xbd, 0x7c, 0x82, 0x5a, 0xe5, 0x33, 0x98, 0x02, 0x33, 0xab,
+	0x03, 0x6b, 0x5e, 0x1b, 0x87, 0xb0, 0xde, 0x19, 0xb2, 0x9b, 0xf1, 0x3b, 0x41, 0xf6, 0xca, 0x2b,
+	0x3a, 0x49, 0x65, 0x96, 0x2f, 0xec, 0x60, 0xed, 0xf7, 0x11, 0xaa, 0x49, 0x81, 0xdb, 0x54, 0xd2,
+	0x78, 0xfd, 0xef, 0xce, 0xe8, 0x82, 0x0c, 0xb2, 0x2c, 0xd3, 0xef, 0xb0, 0xaa, 0xca, 0xab, 0xa5,
+	0x1b, 0xc6, 0x0e, 0x98, 0x53, 0x78, 0x08, 0x6b, 0x6a, 0x2f, 0xca, 0x46, 0x09, 0x43, 0xfd, 0x27,
+	0x98, 0x4c, 0x7a, 0xd7, 0x06, 0x2d, 0x59, 0x27, 0x1c, 0xfb, 0x18, 0x4a, 0x8f, 0xfa, 0xb3, 0xd7,
+	0x89, 0xc6, 0xac, 0x5b, 0x79, 0xa3, 0x8d, 0x3e, 0x5a, 0x18, 0x14, 0xb1, 0xf9, 0x72, 0xa5, 0xc6,
+	0x5d, 0xc6, 0x7b, 0xa8, 0x39, 0x57, 0x9a, 0xb9, 0x3b, 0x57, 0x39, 0x54, 0xe7, 0xaa, 0x03, 0x90,
+	0x24, 0xc1, 0x61, 0x3e, 0x92, 0x9d, 0x19, 0x59, 0x52, 0x80, 0x65, 0xf8, 0xef, 0xe0, 0xa1, 0x6a,
+	0x90, 0x58, 0xf2, 0x27, 0x63, 0xff, 0xcc, 0x21, 0x78, 0x05, 0xeb, 0xef, 0x22, 0xe4, 0x54, 0xa2,
+	0x8a, 0x3f, 0xd6, 0x2d, 0x5f, 0x61, 0x05, 0xca, 0xbe, 0x9e, 0xe9, 0xc0, 0x0b, 0xee, 0x7d, 0xf6,
+	0x7c, 0x1c, 0xa0, 0xa1, 0x9e, 0x45, 0xcc, 0xd2, 0xa0, 0x07, 0x95, 0x0e, 0xaa, 0x95, 0x1c, 0x9f,
+	0x72, 0xc8, 0xf3, 0xf2, 0x05, 0x3f, 0x26, 0x32, 0xd9, 0xc6, 0xdd, 0xa0, 0xae, 0x24, 0x02, 0x8c,
+	0xcf, 0x7a, 0xa4, 0x69, 0x3a, 0x9f, 0x4d, 0x1d, 0x63, 0x6b, 0xbb, 0x36, 0xa8, 0xb6, 0xb9, 0x82,
+	0xf5, 0xa4, 0x1f, 0x2e, 0x28, 0x97, 0x5e, 0xfc, 0x89, 0xdd, 0x9b, 0xd1, 0x35, 0x9a, 0xb2, 0xcc,
+	0xd4, 0x9f, 0xb0, 0xa6, 0x7a, 0x63, 0x2c, 0xde, 0x34, 0xf6, 0xcf, 0xbc, 0xd2, 0x57, 0xb0, 0xfa,
+	0x86, 0x8a, 0xb1, 0x72, 0xc3, 0xb4, 0xff, 0x4c, 0x09, 0x5b, 0x6d, 0x3f, 0x9f, 0xa0, 0xaa, 0x3e,
+	0xd9, 0x7a, 0xb0, 0x30, 0x7c, 0x95, 0x27, 0xa1, 0xcc, 0x62, 0xcf, 0x8a, 0xcd, 0x6f, 0x39, 0xd9,
+	0x96, 0xd4, 0xc1, 0x41, 0x80, 0xa1, 0x34, 0x54, 0xa1, 0x40, 0xcd, 0xde, 0x72, 0xa6, 0xe0, 0x5c,
+	0x73, 0xad, 0xaa, 0x58, 0xd2, 0x17, 0xc2, 0x90, 0xbb, 0x3c, 0x92, 0x39, 0x35, 0x2d, 0x48, 0x6d,
+	0x73, 0x09, 0x95, 0xa4, 0x6d, 0xce, 0xc2, 0x3e, 0x7e, 0x31, 0xac, 0x93, 0x1c, 0x61, 0xff, 0x39,
+	0xcd, 0xa6, 0x96, 0x08, 0x37, 0x67, 0x4e, 0x7f, 0x42, 0x7a, 0xd7, 0x06, 0xd5, 0x13, 0x78, 0x0f,
+	0x2b, 0xaa, 0x35, 0x13, 0x97, 0x9f, 0x8d, 0xad, 0x3b, 0x4f, 0xf0, 0xd7, 0xe9, 0xfd, 0x40, 0x5f,
+	0x51, 0xc8, 0xbe, 0x69, 0xc1, 0x96, 0x5e, 0x96, 0x6a, 0x2d, 0x5b, 0x5c, 0xcf, 0xe2, 0x6f, 0xf8,
+	0x2e, 0xbd, 0x38, 0x90, 0x9d, 0x99, 0x83, 0xf5, 0x9d, 0xa5, 0xf6, 0xfc, 0x4e, 0x4e, 0xab, 0x53,
+	0xd8, 0xbc, 0x8c, 0xfa, 0x6a, 0xdf, 0x4d, 0x4e, 0xc1, 0xd9, 0x39, 0x9c, 0x34, 0x0d, 0x47, 0xe7,
+	0x02, 0x77, 0x2e, 0x06, 0x77, 0xe5, 0xcc, 0x87, 0xef, 0x1d, 0xf4, 0x91, 0x0a, 0x6c, 0xbf, 0x7f,
+	0x7b, 0x8e, 0x42, 0xd0, 0x01, 0x76, 0x24, 0x47, 0x1a, 0x14, 0xcf, 0xe7, 0xc9, 0xb5, 0xdf, 0x00,
+	0x5b, 0x56, 0xc8, 0x85, 0xcd, 0xb4, 0x97, 0x7f, 0xf3, 0x47, 0x62, 0xa8, 0xae, 0x26, 0x3e, 0x4a,
+	0xec, 0x17, 0x97, 0xa4, 0xfa, 0xaf, 0x42, 0xab, 0x94, 0xb4, 0x98, 0x52, 0x17, 0xe0, 0x14, 0xe5,
+	0x39, 0x4a, 0xee, 0xb9, 0xc2, 0xb0, 0x51, 0x8f, 0x01, 0x43, 0x59, 0x4a, 0xb8, 0xac, 0x2c, 0xc7,
+	0x47, 0x7f, 0xfd, 0x32, 0xf0, 0xe4, 0x70, 0xd4, 0x53, 0xd6, 0x07, 0x09, 0xb9, 0xef, 0xb1, 0xf4,
+	0xaf, 0x83, 0xac, 0x1a, 0x07, 0xb1, 0xd2, 0x81, 0x2e, 0x70, 0xd4, 0xeb, 0x2d, 0xc7, 0x3f, 0xbd,
+	0xfa, 0x77, 0x00, 0xd9, 0x9f, 0x76, 0x00, 0x9a, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -345,6 +516,40 @@ type RootCoordClient interface {
 	// @return StringListResponse, collection name list
 	ShowCollections(ctx context.Context, in *milvuspb.ShowCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowCollectionsResponse, error)
 	//*
+	// @brief This method is used to create a database, collections are isolated by database
+	//
+	// @return Status
+	CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//*
+	// @brief This method is used to drop an empty database
+	//
+	// @return Status
+	DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//*
+	// @brief This method is used to list all databases, including the default one
+	//
+	// @return ListDatabasesResponse
+	ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest, opts ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error)
+	//*
+	// @brief This method is used to create a user, only the hashed password is persisted
+	//
+	// @return Status
+	CreateCredential(ctx context.Context, in *milvuspb.CreateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListCredUsers(ctx context.Context, in *milvuspb.ListCredUsersRequest, opts ...grpc.CallOption) (*milvuspb.ListCredUsersResponse, error)
+	//*
+	// @brief These methods are used to manage roles, role membership and the privileges granted to roles
+	CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropRole(ctx context.Context, in *milvuspb.DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	OperateUserRole(ctx context.Context, in *milvuspb.OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	SelectGrant(ctx context.Context, in *milvuspb.SelectGrantRequest, opts ...grpc.CallOption) (*milvuspb.SelectGrantResponse, error)
+	//*
+	// @brief This method is used by proxy to load credentials and grants into its cache
+	//
+	// @return ListPolicyResponse
+	ListPolicy(ctx context.Context, in *ListPolicyRequest, opts ...grpc.CallOption) (*ListPolicyResponse, error)
+	//*
 	// @brief This method is used to create partition
 	//
 	// @return Status
@@ -460,6 +665,114 @@ func (c *rootCoordClient) ShowCollections(ctx context.Context, in *milvuspb.Show
 	return out, nil
 }
 
+func (c *rootCoordClient) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest, opts ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error) {
+	out := new(milvuspb.ListDatabasesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListDatabases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) CreateCredential(ctx context.Context, in *milvuspb.CreateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DeleteCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListCredUsers(ctx context.Context, in *milvuspb.ListCredUsersRequest, opts ...grpc.CallOption) (*milvuspb.ListCredUsersResponse, error) {
+	out := new(milvuspb.ListCredUsersResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListCredUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) OperateUserRole(ctx context.Context, in *milvuspb.OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/OperateUserRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/OperatePrivilege", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) SelectGrant(ctx context.Context, in *milvuspb.SelectGrantRequest, opts ...grpc.CallOption) (*milvuspb.SelectGrantResponse, error) {
+	out := new(milvuspb.SelectGrantResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/SelectGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListPolicy(ctx context.Context, in *ListPolicyRequest, opts ...grpc.CallOption) (*ListPolicyResponse, error) {
+	out := new(ListPolicyResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rootCoordClient) CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreatePartition", in, out, opts...)
@@ -634,6 +947,40 @@ type RootCoordServer interface {
 	// @return StringListResponse, collection name list
 	ShowCollections(context.Context, *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error)
 	//*
+	// @brief This method is used to create a database, collections are isolated by database
+	//
+	// @return Status
+	CreateDatabase(context.Context, *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error)
+	//*
+	// @brief This method is used to drop an empty database
+	//
+	// @return Status
+	DropDatabase(context.Context, *milvuspb.DropDatabaseRequest) (*commonpb.Status, error)
+	//*
+	// @brief This method is used to list all databases, including the default one
+	//
+	// @return ListDatabasesResponse
+	ListDatabases(context.Context, *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error)
+	//*
+	// @brief This method is used to create a user, only the hashed password is persisted
+	//
+	// @return Status
+	CreateCredential(context.Context, *milvuspb.CreateCredentialRequest) (*commonpb.Status, error)
+	DeleteCredential(context.Context, *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error)
+	ListCredUsers(context.Context, *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error)
+	//*
+	// @brief These methods are used to manage roles, role membership and the privileges granted to roles
+	CreateRole(context.Context, *milvuspb.CreateRoleRequest) (*commonpb.Status, error)
+	DropRole(context.Context, *milvuspb.DropRoleRequest) (*commonpb.Status, error)
+	OperateUserRole(context.Context, *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error)
+	OperatePrivilege(context.Context, *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error)
+	SelectGrant(context.Context, *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error)
+	//*
+	// @brief This method is used by proxy to load credentials and grants into its cache
+	//
+	// @return ListPolicyResponse
+	ListPolicy(context.Context, *ListPolicyRequest) (*ListPolicyResponse, error)
+	//*
 	// @brief This method is used to create partition
 	//
 	// @return Status
@@ -697,6 +1044,42 @@ func (*UnimplementedRootCoordServer) DescribeCollection(ctx context.Context, req
 func (*UnimplementedRootCoordServer) ShowCollections(ctx context.Context, req *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShowCollections not implemented")
 }
+func (*UnimplementedRootCoordServer) CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDatabase not implemented")
+}
+func (*UnimplementedRootCoordServer) DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropDatabase not implemented")
+}
+func (*UnimplementedRootCoordServer) ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatabases not implemented")
+}
+func (*UnimplementedRootCoordServer) CreateCredential(ctx context.Context, req *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCredential not implemented")
+}
+func (*UnimplementedRootCoordServer) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredential not implemented")
+}
+func (*UnimplementedRootCoordServer) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredUsers not implemented")
+}
+func (*UnimplementedRootCoordServer) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (*UnimplementedRootCoordServer) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropRole not implemented")
+}
+func (*UnimplementedRootCoordServer) OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateUserRole not implemented")
+}
+func (*UnimplementedRootCoordServer) OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatePrivilege not implemented")
+}
+func (*UnimplementedRootCoordServer) SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectGrant not implemented")
+}
+func (*UnimplementedRootCoordServer) ListPolicy(ctx context.Context, req *ListPolicyRequest) (*ListPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPolicy not implemented")
+}
 func (*UnimplementedRootCoordServer) CreatePartition(ctx context.Context, req *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePartition not implemented")
 }
@@ -891,6 +1274,222 @@ func _RootCoord_ShowCollections_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_CreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CreateDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateDatabase(ctx, req.(*milvuspb.CreateDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DropDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DropDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DropDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DropDatabase(ctx, req.(*milvuspb.DropDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ListDatabasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListDatabases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListDatabases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListDatabases(ctx, req.(*milvuspb.ListDatabasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_CreateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CreateCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateCredential(ctx, req.(*milvuspb.CreateCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DeleteCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DeleteCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DeleteCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DeleteCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DeleteCredential(ctx, req.(*milvuspb.DeleteCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListCredUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ListCredUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListCredUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListCredUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListCredUsers(ctx, req.(*milvuspb.ListCredUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CreateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateRole(ctx, req.(*milvuspb.CreateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DropRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DropRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DropRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DropRole(ctx, req.(*milvuspb.DropRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_OperateUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.OperateUserRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).OperateUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/OperateUserRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).OperateUserRole(ctx, req.(*milvuspb.OperateUserRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_OperatePrivilege_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.OperatePrivilegeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).OperatePrivilege(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/OperatePrivilege",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).OperatePrivilege(ctx, req.(*milvuspb.OperatePrivilegeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_SelectGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.SelectGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).SelectGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/SelectGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).SelectGrant(ctx, req.(*milvuspb.SelectGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListPolicy(ctx, req.(*ListPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RootCoord_CreatePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.CreatePartitionRequest)
 	if err := dec(in); err != nil {
@@ -1197,6 +1796,54 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ShowCollections",
 			Handler:    _RootCoord_ShowCollections_Handler,
 		},
+		{
+			MethodName: "CreateDatabase",
+			Handler:    _RootCoord_CreateDatabase_Handler,
+		},
+		{
+			MethodName: "DropDatabase",
+			Handler:    _RootCoord_DropDatabase_Handler,
+		},
+		{
+			MethodName: "ListDatabases",
+			Handler:    _RootCoord_ListDatabases_Handler,
+		},
+		{
+			MethodName: "CreateCredential",
+			Handler:    _RootCoord_CreateCredential_Handler,
+		},
+		{
+			MethodName: "DeleteCredential",
+			Handler:    _RootCoord_DeleteCredential_Handler,
+		},
+		{
+			MethodName: "ListCredUsers",
+			Handler:    _RootCoord_ListCredUsers_Handler,
+		},
+		{
+			MethodName: "CreateRole",
+			Handler:    _RootCoord_CreateRole_Handler,
+		},
+		{
+			MethodName: "DropRole",
+			Handler:    _RootCoord_DropRole_Handler,
+		},
+		{
+			MethodName: "OperateUserRole",
+			Handler:    _RootCoord_OperateUserRole_Handler,
+		},
+		{
+			MethodName: "OperatePrivilege",
+			Handler:    _RootCoord_OperatePrivilege_Handler,
+		},
+		{
+			MethodName: "SelectGrant",
+			Handler:    _RootCoord_SelectGrant_Handler,
+		},
+		{
+			MethodName: "ListPolicy",
+			Handler:    _RootCoord_ListPolicy_Handler,
+		},
 		{
 			MethodName: "CreatePartition",
 			Handler:    _RootCoord_CreatePartition_Handler,
@@ -0,0 +1,55 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/util/credential"
+)
+
+type ctxUserKey struct{}
+
+// AuthenticationInterceptor verifies the base64 encoded "username:password" in the authorization metadata of
+// a request, the authenticated username is kept in the returned context for the privilege checks.
+// Nothing is checked if authorization isn't enabled.
+func AuthenticationInterceptor(ctx context.Context) (context.Context, error) {
+	if !Params.AuthorizationEnabled {
+		return ctx, nil
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	values := md.Get(credential.HeaderAuthorize)
+	if len(values) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing authorization in metadata")
+	}
+	username, password, err := credential.DecodeBasicAuth(values[0])
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if globalPolicyCache == nil || !globalPolicyCache.authenticate(username, password) {
+		return nil, status.Error(codes.Unauthenticated, "auth check failure, please check username and password are correct")
+	}
+	return context.WithValue(ctx, ctxUserKey{}, username), nil
+}
+
+// getCurUser returns the username authenticated by AuthenticationInterceptor
+func getCurUser(ctx context.Context) (string, bool) {
+	username, ok := ctx.Value(ctxUserKey{}).(string)
+	return username, ok
+}
@@ -0,0 +1,450 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// SubscribeChanges streams the entities inserted into and deleted from a collection, read from its dml channels.
+// The positions on the last event of each batch resume the subscription right after the batch, the changes are
+// delivered at least once, a resumed subscription may see the changes after the positions again.
+func (node *Proxy) SubscribeChanges(request *milvuspb.SubscribeChangesRequest, stream milvuspb.MilvusService_SubscribeChangesServer) error {
+	ctx := stream.Context()
+	log.Debug("SubscribeChanges",
+		zap.String("role", Params.RoleName),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Int("positions", len(request.Positions)),
+		zap.Uint64("startTimestamp", request.StartTimestamp))
+
+	failed := func(err error) error {
+		return stream.Send(&milvuspb.ChangeEvent{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		})
+	}
+
+	if !node.checkHealthy() {
+		return stream.Send(&milvuspb.ChangeEvent{
+			Status: unhealthyStatus(),
+		})
+	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_Retrieve, request.DbName, request.CollectionName); st != nil {
+		return stream.Send(&milvuspb.ChangeEvent{
+			Status: st,
+		})
+	}
+
+	collInfo, err := globalMetaCache.GetCollectionInfo(ctx, request.DbName, request.CollectionName)
+	if err != nil {
+		return failed(err)
+	}
+	pchans, err := node.chMgr.getChannels(collInfo.collID)
+	if err != nil {
+		if err := node.chMgr.createDMLMsgStream(collInfo.collID); err != nil {
+			return failed(err)
+		}
+		if pchans, err = node.chMgr.getChannels(collInfo.collID); err != nil {
+			return failed(err)
+		}
+	}
+
+	positions, err := decodeChangePositions(request.Positions)
+	if err != nil {
+		return failed(err)
+	}
+	startTs := request.StartTimestamp
+	if startTs == 0 {
+		if startTs, err = node.tsoAllocator.AllocOne(); err != nil {
+			return failed(err)
+		}
+	}
+
+	subID, err := node.idAllocator.AllocOne()
+	if err != nil {
+		return failed(err)
+	}
+	msgStream, err := node.msFactory.NewTtMsgStream(ctx)
+	if err != nil {
+		return failed(err)
+	}
+	// the subscription is used by this call only, it's removed to not retain the messages for it
+	defer func() {
+		msgStream.Unsubscribe()
+		msgStream.Close()
+	}()
+	msgStream.AsConsumer(pchans, fmt.Sprintf("%s-changes-%d", Params.ProxySubName, subID))
+
+	// the channels without a position are seeked to the checkpoints before startTs instead of the earliest messages
+	startPositions, err := node.getChangeStartPositions(ctx, collInfo.collID, startTs)
+	if err != nil {
+		return failed(err)
+	}
+	seekPositions := make([]*internalpb.MsgPosition, 0, len(pchans))
+	for _, pchan := range pchans {
+		if pos, ok := positions[pchan]; ok {
+			seekPositions = append(seekPositions, pos)
+		} else if pos, ok := startPositions[pchan]; ok {
+			seekPositions = append(seekPositions, pos)
+		}
+	}
+	if len(seekPositions) > 0 {
+		if err := msgStream.Seek(seekPositions); err != nil {
+			return failed(err)
+		}
+	}
+	msgStream.Start()
+
+	for {
+		msgPack := msgStream.Consume()
+		if msgPack == nil {
+			// the subscription is cancelled by the client
+			return nil
+		}
+
+		events := make([]*milvuspb.ChangeEvent, 0, len(msgPack.Msgs))
+		for _, msg := range msgPack.Msgs {
+			// the channels resumed from a position are seeked right after it, the others start from startTs
+			if pos := msg.Position(); pos != nil {
+				if _, ok := positions[pos.ChannelName]; !ok && msg.EndTs() <= startTs {
+					continue
+				}
+			}
+			switch m := msg.(type) {
+			case *msgstream.InsertMsg:
+				if m.CollectionID != collInfo.collID {
+					continue
+				}
+				event, err := insertChangeEvent(collInfo.schema, m)
+				if err != nil {
+					return failed(err)
+				}
+				events = append(events, event)
+			case *msgstream.DeleteMsg:
+				if m.CollectionID != collInfo.collID {
+					continue
+				}
+				events = append(events, deleteChangeEvent(m))
+			}
+		}
+		if len(events) == 0 {
+			continue
+		}
+
+		events[len(events)-1].Positions, err = encodeChangePositions(msgPack.EndPositions)
+		if err != nil {
+			return failed(err)
+		}
+		for _, event := range events {
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// getChangeStartPositions returns the latest position of each dml channel of the collection before ts, taken from
+// the start and dml positions of the flushed segments, the channels without such a position are read from the start
+func (node *Proxy) getChangeStartPositions(ctx context.Context, collID UniqueID, ts Timestamp) (map[pChan]*internalpb.MsgPosition, error) {
+	vchans, err := node.chMgr.getVChannels(collID)
+	if err != nil {
+		return nil, err
+	}
+	pchans, err := node.chMgr.getChannels(collID)
+	if err != nil {
+		return nil, err
+	}
+	if len(vchans) != len(pchans) {
+		return nil, fmt.Errorf("%d virtual channels mismatch %d physical channels of collection %d", len(vchans), len(pchans), collID)
+	}
+	vchan2pchan := make(map[vChan]pChan, len(vchans))
+	for i, vchan := range vchans {
+		vchan2pchan[vchan] = pchans[i]
+	}
+
+	flushedResp, err := node.dataCoord.GetFlushedSegments(ctx, &datapb.GetFlushedSegmentsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_SegmentInfo,
+			SourceID: Params.ProxyID,
+		},
+		CollectionID: collID,
+		PartitionID:  -1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if flushedResp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(flushedResp.Status.Reason)
+	}
+	ret := make(map[pChan]*internalpb.MsgPosition)
+	if len(flushedResp.Segments) == 0 {
+		return ret, nil
+	}
+	infoResp, err := node.dataCoord.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_SegmentInfo,
+			SourceID: Params.ProxyID,
+		},
+		SegmentIDs: flushedResp.Segments,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if infoResp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(infoResp.Status.Reason)
+	}
+
+	for _, info := range infoResp.Infos {
+		for _, pos := range []*internalpb.MsgPosition{info.GetStartPosition(), info.GetDmlPosition()} {
+			if pos == nil || len(pos.MsgID) == 0 || pos.Timestamp > ts {
+				continue
+			}
+			pchan, ok := vchan2pchan[pos.ChannelName]
+			if !ok {
+				continue
+			}
+			if cur, ok := ret[pchan]; !ok || cur.Timestamp < pos.Timestamp {
+				ret[pchan] = &internalpb.MsgPosition{
+					ChannelName: pchan,
+					MsgID:       pos.MsgID,
+					MsgGroup:    pos.MsgGroup,
+					Timestamp:   pos.Timestamp,
+				}
+			}
+		}
+	}
+	return ret, nil
+}
+
+func encodeChangePositions(positions []*internalpb.MsgPosition) ([]*milvuspb.ChangePosition, error) {
+	ret := make([]*milvuspb.ChangePosition, 0, len(positions))
+	for _, pos := range positions {
+		bs, err := proto.Marshal(pos)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, &milvuspb.ChangePosition{
+			ChannelName: pos.ChannelName,
+			Position:    bs,
+		})
+	}
+	return ret, nil
+}
+
+func decodeChangePositions(positions []*milvuspb.ChangePosition) (map[pChan]*internalpb.MsgPosition, error) {
+	ret := make(map[pChan]*internalpb.MsgPosition, len(positions))
+	for _, p := range positions {
+		pos := &internalpb.MsgPosition{}
+		if err := proto.Unmarshal(p.Position, pos); err != nil {
+			return nil, fmt.Errorf("invalid position of channel %s: %w", p.ChannelName, err)
+		}
+		if pos.ChannelName != p.ChannelName {
+			return nil, fmt.Errorf("position of channel %s is taken from channel %s", p.ChannelName, pos.ChannelName)
+		}
+		ret[pos.ChannelName] = pos
+	}
+	return ret, nil
+}
+
+func insertChangeEvent(schema *schemapb.CollectionSchema, msg *msgstream.InsertMsg) (*milvuspb.ChangeEvent, error) {
+	fieldsData, err := rowDataToFieldsData(schema, msg.RowData)
+	if err != nil {
+		return nil, err
+	}
+
+	// the generated primary keys are filled into the rows as well
+	var primaryKeys []int64
+	for i, field := range schema.Fields {
+		if field.IsPrimaryKey {
+			primaryKeys = fieldsData[i].GetScalars().GetLongData().GetData()
+		}
+	}
+
+	return &milvuspb.ChangeEvent{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		ChangeType:    commonpb.MsgType_Insert,
+		PartitionName: msg.PartitionName,
+		FieldsData:    fieldsData,
+		PrimaryKeys: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{
+					Data: primaryKeys,
+				},
+			},
+		},
+		Timestamps: msg.Timestamps,
+	}, nil
+}
+
+func deleteChangeEvent(msg *msgstream.DeleteMsg) *milvuspb.ChangeEvent {
+	return &milvuspb.ChangeEvent{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		ChangeType: commonpb.MsgType_Delete,
+		PrimaryKeys: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{
+					Data: msg.PrimaryKeys,
+				},
+			},
+		},
+		Timestamps: msg.Timestamps,
+	}
+}
+
+// rowDataToFieldsData decodes the row based insert data to columns, a row holds the fields of schema in order,
+// encoded in little endian, which is how the insert task encodes it
+func rowDataToFieldsData(schema *schemapb.CollectionSchema, rows []*commonpb.Blob) ([]*schemapb.FieldData, error) {
+	fieldsData := make([]*schemapb.FieldData, 0, len(schema.Fields))
+	offset := 0
+	for _, field := range schema.Fields {
+		// readColumn passes the bytes of field in each row to read
+		readColumn := func(size int, read func(bs []byte)) error {
+			for i, row := range rows {
+				if len(row.Value) < offset+size {
+					return fmt.Errorf("row %d is too short for field %s", i, field.Name)
+				}
+				read(row.Value[offset : offset+size])
+			}
+			offset += size
+			return nil
+		}
+		fieldData := &schemapb.FieldData{
+			Type:      field.DataType,
+			FieldName: field.Name,
+			FieldId:   field.FieldID,
+		}
+
+		var err error
+		switch field.DataType {
+		case schemapb.DataType_Bool:
+			data := make([]bool, 0, len(rows))
+			err = readColumn(1, func(bs []byte) {
+				data = append(data, bs[0] != 0)
+			})
+			fieldData.Field = scalarField(&schemapb.ScalarField{Data: &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}}})
+		case schemapb.DataType_Int8:
+			data := make([]int32, 0, len(rows))
+			err = readColumn(1, func(bs []byte) {
+				data = append(data, int32(int8(bs[0])))
+			})
+			fieldData.Field = scalarField(&schemapb.ScalarField{Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}})
+		case schemapb.DataType_Int16:
+			data := make([]int32, 0, len(rows))
+			err = readColumn(2, func(bs []byte) {
+				data = append(data, int32(int16(binary.LittleEndian.Uint16(bs))))
+			})
+			fieldData.Field = scalarField(&schemapb.ScalarField{Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}})
+		case schemapb.DataType_Int32:
+			data := make([]int32, 0, len(rows))
+			err = readColumn(4, func(bs []byte) {
+				data = append(data, int32(binary.LittleEndian.Uint32(bs)))
+			})
+			fieldData.Field = scalarField(&schemapb.ScalarField{Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}})
+		case schemapb.DataType_Int64:
+			data := make([]int64, 0, len(rows))
+			err = readColumn(8, func(bs []byte) {
+				data = append(data, int64(binary.LittleEndian.Uint64(bs)))
+			})
+			fieldData.Field = scalarField(&schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}})
+		case schemapb.DataType_Float:
+			data := make([]float32, 0, len(rows))
+			err = readColumn(4, func(bs []byte) {
+				data = append(data, math.Float32frombits(binary.LittleEndian.Uint32(bs)))
+			})
+			fieldData.Field = scalarField(&schemapb.ScalarField{Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: data}}})
+		case schemapb.DataType_Double:
+			data := make([]float64, 0, len(rows))
+			err = readColumn(8, func(bs []byte) {
+				data = append(data, math.Float64frombits(binary.LittleEndian.Uint64(bs)))
+			})
+			fieldData.Field = scalarField(&schemapb.ScalarField{Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}}})
+		case schemapb.DataType_FloatVector:
+			dim, dimErr := fieldDim(field)
+			if dimErr != nil {
+				return nil, dimErr
+			}
+			data := make([]float32, 0, len(rows)*dim)
+			err = readColumn(dim*4, func(bs []byte) {
+				for i := 0; i < dim; i++ {
+					data = append(data, math.Float32frombits(binary.LittleEndian.Uint32(bs[i*4:])))
+				}
+			})
+			fieldData.Field = &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Dim:  int64(dim),
+					Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: data}},
+				},
+			}
+		case schemapb.DataType_BinaryVector:
+			dim, dimErr := fieldDim(field)
+			if dimErr != nil {
+				return nil, dimErr
+			}
+			data := make([]byte, 0, len(rows)*dim/8)
+			err = readColumn(dim/8, func(bs []byte) {
+				data = append(data, bs...)
+			})
+			fieldData.Field = &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Dim:  int64(dim),
+					Data: &schemapb.VectorField_BinaryVector{BinaryVector: data},
+				},
+			}
+		default:
+			return nil, fmt.Errorf("unsupported data type %s of field %s", field.DataType.String(), field.Name)
+		}
+		if err != nil {
+			return nil, err
+		}
+		fieldsData = append(fieldsData, fieldData)
+	}
+	return fieldsData, nil
+}
+
+func scalarField(scalars *schemapb.ScalarField) *schemapb.FieldData_Scalars {
+	return &schemapb.FieldData_Scalars{Scalars: scalars}
+}
+
+func fieldDim(field *schemapb.FieldSchema) (int, error) {
+	dimStr, err := GetAttrByKeyFromRepeatedKV("dim", field.TypeParams)
+	if err != nil {
+		return 0, fmt.Errorf("dim of field %s not found: %w", field.Name, err)
+	}
+	dim, err := strconv.Atoi(dimStr)
+	if err != nil {
+		return 0, fmt.Errorf("invalid dim of field %s: %w", field.Name, err)
+	}
+	return dim, nil
+}
@@ -0,0 +1,186 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+func TestRowDataToFieldsData(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "bool", DataType: schemapb.DataType_Bool},
+			{FieldID: 102, Name: "int8", DataType: schemapb.DataType_Int8},
+			{FieldID: 103, Name: "int16", DataType: schemapb.DataType_Int16},
+			{FieldID: 104, Name: "int32", DataType: schemapb.DataType_Int32},
+			{FieldID: 105, Name: "float", DataType: schemapb.DataType_Float},
+			{FieldID: 106, Name: "double", DataType: schemapb.DataType_Double},
+			{FieldID: 107, Name: "fvec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}}},
+			{FieldID: 108, Name: "bvec", DataType: schemapb.DataType_BinaryVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "16"}}},
+		},
+	}
+
+	rowNum := 3
+	rows := make([]*commonpb.Blob, 0, rowNum)
+	for i := 0; i < rowNum; i++ {
+		var buffer bytes.Buffer
+		for _, v := range []interface{}{
+			int64(i + 10), i%2 == 0, int8(-i), int16(i * 100), int32(-i * 1000),
+			float32(i) / 2, float64(i) * 1.5, []float32{float32(i), float32(-i)}, []byte{byte(i), 0xff},
+		} {
+			assert.Nil(t, binary.Write(&buffer, binary.LittleEndian, v))
+		}
+		rows = append(rows, &commonpb.Blob{Value: buffer.Bytes()})
+	}
+
+	fieldsData, err := rowDataToFieldsData(schema, rows)
+	assert.Nil(t, err)
+	assert.Equal(t, len(schema.Fields), len(fieldsData))
+	for i, field := range schema.Fields {
+		assert.Equal(t, field.FieldID, fieldsData[i].FieldId)
+		assert.Equal(t, field.DataType, fieldsData[i].Type)
+	}
+	assert.Equal(t, []int64{10, 11, 12}, fieldsData[0].GetScalars().GetLongData().Data)
+	assert.Equal(t, []bool{true, false, true}, fieldsData[1].GetScalars().GetBoolData().Data)
+	assert.Equal(t, []int32{0, -1, -2}, fieldsData[2].GetScalars().GetIntData().Data)
+	assert.Equal(t, []int32{0, 100, 200}, fieldsData[3].GetScalars().GetIntData().Data)
+	assert.Equal(t, []int32{0, -1000, -2000}, fieldsData[4].GetScalars().GetIntData().Data)
+	assert.Equal(t, []float32{0, 0.5, 1}, fieldsData[5].GetScalars().GetFloatData().Data)
+	assert.Equal(t, []float64{0, 1.5, 3}, fieldsData[6].GetScalars().GetDoubleData().Data)
+	assert.Equal(t, int64(2), fieldsData[7].GetVectors().Dim)
+	assert.Equal(t, []float32{0, 0, 1, -1, 2, -2}, fieldsData[7].GetVectors().GetFloatVector().Data)
+	assert.Equal(t, int64(16), fieldsData[8].GetVectors().Dim)
+	assert.Equal(t, []byte{0, 0xff, 1, 0xff, 2, 0xff}, fieldsData[8].GetVectors().GetBinaryVector())
+
+	// truncated rows
+	rows[1].Value = rows[1].Value[:10]
+	_, err = rowDataToFieldsData(schema, rows)
+	assert.NotNil(t, err)
+
+	// vector field without dim
+	schema.Fields[7].TypeParams = nil
+	_, err = rowDataToFieldsData(schema, rows)
+	assert.NotNil(t, err)
+}
+
+func TestChangePositions(t *testing.T) {
+	positions := []*internalpb.MsgPosition{
+		{ChannelName: "ch1", MsgID: []byte{1, 2}, Timestamp: 100},
+		{ChannelName: "ch2", MsgID: []byte{3}, Timestamp: 200},
+	}
+	encoded, err := encodeChangePositions(positions)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(encoded))
+	assert.Equal(t, "ch1", encoded[0].ChannelName)
+
+	decoded, err := decodeChangePositions(encoded)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(decoded))
+	assert.Equal(t, uint64(200), decoded["ch2"].Timestamp)
+	assert.Equal(t, []byte{1, 2}, decoded["ch1"].MsgID)
+
+	_, err = decodeChangePositions([]*milvuspb.ChangePosition{{ChannelName: "ch3", Position: encoded[0].Position}})
+	assert.NotNil(t, err)
+	_, err = decodeChangePositions([]*milvuspb.ChangePosition{{ChannelName: "ch1", Position: []byte{0xff}}})
+	assert.NotNil(t, err)
+}
+
+type mockChangeChannelsMgr struct {
+	channelsMgr
+	vchans []vChan
+	pchans []pChan
+}
+
+func (m *mockChangeChannelsMgr) getChannels(collectionID UniqueID) ([]pChan, error) {
+	return m.pchans, nil
+}
+
+func (m *mockChangeChannelsMgr) getVChannels(collectionID UniqueID) ([]vChan, error) {
+	return m.vchans, nil
+}
+
+type mockSegmentsDataCoord struct {
+	types.DataCoord
+	segments []*datapb.SegmentInfo
+}
+
+func (m *mockSegmentsDataCoord) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
+	ids := make([]int64, 0, len(m.segments))
+	for _, segment := range m.segments {
+		ids = append(ids, segment.ID)
+	}
+	return &datapb.GetFlushedSegmentsResponse{
+		Status:   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Segments: ids,
+	}, nil
+}
+
+func (m *mockSegmentsDataCoord) GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
+	return &datapb.GetSegmentInfoResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Infos:  m.segments,
+	}, nil
+}
+
+func TestGetChangeStartPositions(t *testing.T) {
+	node := &Proxy{
+		chMgr: &mockChangeChannelsMgr{
+			vchans: []vChan{"dml_0_1v0", "dml_1_1v1", "dml_2_1v2"},
+			pchans: []pChan{"dml_0", "dml_1", "dml_2"},
+		},
+		dataCoord: &mockSegmentsDataCoord{
+			segments: []*datapb.SegmentInfo{
+				{
+					ID:            1,
+					StartPosition: &internalpb.MsgPosition{ChannelName: "dml_0_1v0", MsgID: []byte{1}, Timestamp: 100},
+					DmlPosition:   &internalpb.MsgPosition{ChannelName: "dml_0_1v0", MsgID: []byte{2}, Timestamp: 200},
+				},
+				{
+					ID:            2,
+					StartPosition: &internalpb.MsgPosition{ChannelName: "dml_0_1v0", MsgID: []byte{3}, Timestamp: 250},
+					DmlPosition:   &internalpb.MsgPosition{ChannelName: "dml_0_1v0", MsgID: []byte{4}, Timestamp: 400},
+				},
+				{
+					ID:            3,
+					StartPosition: &internalpb.MsgPosition{ChannelName: "dml_1_1v1", MsgID: []byte{5}, Timestamp: 350},
+				},
+			},
+		},
+	}
+
+	positions, err := node.getChangeStartPositions(context.Background(), 1, 300)
+	assert.Nil(t, err)
+	// the latest position before the timestamp
+	assert.Equal(t, 1, len(positions))
+	assert.Equal(t, "dml_0", positions["dml_0"].ChannelName)
+	assert.Equal(t, []byte{3}, positions["dml_0"].MsgID)
+	assert.Equal(t, uint64(250), positions["dml_0"].Timestamp)
+
+	positions, err = node.getChangeStartPositions(context.Background(), 1, 50)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(positions))
+}
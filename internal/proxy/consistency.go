@@ -0,0 +1,143 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+// minGuaranteeTimestamp is the smallest valid guarantee timestamp, query nodes serve it without waiting
+const minGuaranteeTimestamp Timestamp = 1
+
+// getSessionKey identifies the client session of a request by its connection
+func getSessionKey(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	return p.Addr.String()
+}
+
+type sessionWriteTs struct {
+	collTs     map[UniqueID]Timestamp
+	lastUpdate time.Time
+}
+
+// sessionTsTracker records the timestamp of the last write of each client session on each collection,
+// sessions without any write during ttl are dropped
+type sessionTsTracker struct {
+	mu        sync.Mutex
+	sessions  map[string]*sessionWriteTs
+	ttl       time.Duration
+	lastPrune time.Time
+}
+
+func newSessionTsTracker(ttl time.Duration) *sessionTsTracker {
+	return &sessionTsTracker{
+		sessions:  make(map[string]*sessionWriteTs),
+		ttl:       ttl,
+		lastPrune: time.Now(),
+	}
+}
+
+func (t *sessionTsTracker) update(session string, collID UniqueID, ts Timestamp) {
+	if t == nil || session == "" {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(t.lastPrune) > t.ttl {
+		for key, s := range t.sessions {
+			if now.Sub(s.lastUpdate) > t.ttl {
+				delete(t.sessions, key)
+			}
+		}
+		t.lastPrune = now
+	}
+
+	s, ok := t.sessions[session]
+	if !ok {
+		s = &sessionWriteTs{collTs: make(map[UniqueID]Timestamp)}
+		t.sessions[session] = s
+	}
+	if ts > s.collTs[collID] {
+		s.collTs[collID] = ts
+	}
+	s.lastUpdate = now
+}
+
+func (t *sessionTsTracker) get(session string, collID UniqueID) Timestamp {
+	if t == nil || session == "" {
+		return 0
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	s, ok := t.sessions[session]
+	if !ok {
+		return 0
+	}
+	return s.collTs[collID]
+}
+
+// getGuaranteeTimestamp translates a consistency level into the guarantee timestamp of a search or query,
+// beginTs is the timestamp allocated from TSO for the request
+func getGuaranteeTimestamp(level commonpb.ConsistencyLevel, beginTs Timestamp, sessionTs Timestamp, staleness time.Duration) (Timestamp, error) {
+	switch level {
+	case commonpb.ConsistencyLevel_Strong:
+		return beginTs, nil
+	case commonpb.ConsistencyLevel_Session:
+		if sessionTs == 0 {
+			return minGuaranteeTimestamp, nil
+		}
+		return sessionTs, nil
+	case commonpb.ConsistencyLevel_Bounded:
+		physical, _ := tsoutil.ParseHybridTs(beginTs)
+		if int64(physical) <= staleness.Milliseconds() {
+			return minGuaranteeTimestamp, nil
+		}
+		return tsoutil.ComposeTS(int64(physical)-staleness.Milliseconds(), 0), nil
+	case commonpb.ConsistencyLevel_Eventually:
+		return minGuaranteeTimestamp, nil
+	default:
+		return 0, fmt.Errorf("invalid consistency level %d", level)
+	}
+}
+
+// parseGuaranteeTimestamp returns the guarantee timestamp of a search or query request on collection, an explicit
+// guarantee timestamp always wins, otherwise it is derived from the consistency level of request or collection
+func parseGuaranteeTimestamp(ctx context.Context, dbName string, collectionName string, guaranteeTs Timestamp,
+	level commonpb.ConsistencyLevel, useDefault bool, beginTs Timestamp, tracker *sessionTsTracker) (Timestamp, error) {
+	if guaranteeTs != 0 {
+		return guaranteeTs, nil
+	}
+
+	collInfo, err := globalMetaCache.GetCollectionInfo(ctx, dbName, collectionName)
+	if err != nil {
+		return 0, err
+	}
+	if useDefault {
+		level = collInfo.consistencyLevel
+	}
+	sessionTs := tracker.get(getSessionKey(ctx), collInfo.collID)
+	return getGuaranteeTimestamp(level, beginTs, sessionTs, Params.BoundedConsistencyStaleness)
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+func TestGetSessionKey(t *testing.T) {
+	assert.Equal(t, "", getSessionKey(context.Background()))
+
+	addr := &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 19530}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+	assert.Equal(t, "127.0.0.1:19530", getSessionKey(ctx))
+}
+
+func TestSessionTsTracker(t *testing.T) {
+	var nilTracker *sessionTsTracker
+	nilTracker.update("s1", 1, 100)
+	assert.Equal(t, Timestamp(0), nilTracker.get("s1", 1))
+
+	tracker := newSessionTsTracker(time.Hour)
+	tracker.update("", 1, 100)
+	assert.Equal(t, Timestamp(0), tracker.get("", 1))
+
+	tracker.update("s1", 1, 100)
+	tracker.update("s1", 1, 50)
+	tracker.update("s1", 2, 200)
+	assert.Equal(t, Timestamp(100), tracker.get("s1", 1))
+	assert.Equal(t, Timestamp(200), tracker.get("s1", 2))
+	assert.Equal(t, Timestamp(0), tracker.get("s2", 1))
+
+	expired := newSessionTsTracker(time.Millisecond)
+	expired.update("s1", 1, 100)
+	time.Sleep(5 * time.Millisecond)
+	expired.update("s2", 1, 200)
+	assert.Equal(t, Timestamp(0), expired.get("s1", 1))
+	assert.Equal(t, Timestamp(200), expired.get("s2", 1))
+}
+
+func TestGetGuaranteeTimestamp(t *testing.T) {
+	beginTs := tsoutil.ComposeTS(10000, 5)
+	staleness := 3 * time.Second
+
+	ts, err := getGuaranteeTimestamp(commonpb.ConsistencyLevel_Strong, beginTs, 0, staleness)
+	assert.NoError(t, err)
+	assert.Equal(t, beginTs, ts)
+
+	ts, err = getGuaranteeTimestamp(commonpb.ConsistencyLevel_Session, beginTs, 0, staleness)
+	assert.NoError(t, err)
+	assert.Equal(t, minGuaranteeTimestamp, ts)
+
+	ts, err = getGuaranteeTimestamp(commonpb.ConsistencyLevel_Session, beginTs, 1000, staleness)
+	assert.NoError(t, err)
+	assert.Equal(t, Timestamp(1000), ts)
+
+	ts, err = getGuaranteeTimestamp(commonpb.ConsistencyLevel_Bounded, beginTs, 0, staleness)
+	assert.NoError(t, err)
+	assert.Equal(t, tsoutil.ComposeTS(7000, 0), ts)
+
+	ts, err = getGuaranteeTimestamp(commonpb.ConsistencyLevel_Bounded, beginTs, 0, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, minGuaranteeTimestamp, ts)
+
+	ts, err = getGuaranteeTimestamp(commonpb.ConsistencyLevel_Eventually, beginTs, 0, staleness)
+	assert.NoError(t, err)
+	assert.Equal(t, minGuaranteeTimestamp, ts)
+
+	_, err = getGuaranteeTimestamp(commonpb.ConsistencyLevel(100), beginTs, 0, staleness)
+	assert.Error(t, err)
+}
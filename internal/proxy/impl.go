@@ -17,9 +17,11 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 
+	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
@@ -31,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/credential"
 	"github.com/milvus-io/milvus/internal/util/distance"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -81,7 +84,7 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 
 	collectionName := request.CollectionName
 	if globalMetaCache != nil {
-		globalMetaCache.RemoveCollection(ctx, collectionName) // no need to return error, though collection may be not cached
+		globalMetaCache.RemoveCollection(ctx, request.DbName, collectionName) // no need to return error, though collection may be not cached
 	}
 	log.Debug("InvalidateCollectionMetaCache Done",
 		zap.String("role", Params.RoleName),
@@ -117,10 +120,36 @@ func (node *Proxy) ReleaseDQLMessageStream(ctx context.Context, request *proxypb
 	}, nil
 }
 
+func (node *Proxy) RefreshPolicyInfoCache(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+	log.Debug("RefreshPolicyInfoCache",
+		zap.String("role", Params.RoleName),
+		zap.String("msgType", request.GetBase().GetMsgType().String()))
+
+	if globalPolicyCache != nil {
+		if err := globalPolicyCache.refresh(ctx); err != nil {
+			log.Warn("RefreshPolicyInfoCache failed", zap.Error(err))
+			return &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			}, nil
+		}
+	}
+	log.Debug("RefreshPolicyInfoCache Done",
+		zap.String("role", Params.RoleName))
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
 func (node *Proxy) CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_CreateCollection, request.DbName, request.CollectionName); st != nil {
+		return st, nil
+	}
 	cct := &CreateCollectionTask{
 		ctx:                     ctx,
 		Condition:               NewTaskCondition(ctx),
@@ -175,6 +204,9 @@ func (node *Proxy) DropCollection(ctx context.Context, request *milvuspb.DropCol
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_DropCollection, request.DbName, request.CollectionName); st != nil {
+		return st, nil
+	}
 	dct := &DropCollectionTask{
 		ctx:                   ctx,
 		Condition:             NewTaskCondition(ctx),
@@ -229,6 +261,11 @@ func (node *Proxy) HasCollection(ctx context.Context, request *milvuspb.HasColle
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_HasCollection, request.DbName, request.CollectionName); st != nil {
+		return &milvuspb.BoolResponse{
+			Status: st,
+		}, nil
+	}
 	hct := &HasCollectionTask{
 		ctx:                  ctx,
 		Condition:            NewTaskCondition(ctx),
@@ -283,6 +320,9 @@ func (node *Proxy) LoadCollection(ctx context.Context, request *milvuspb.LoadCol
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_LoadCollection, request.DbName, request.CollectionName); st != nil {
+		return st, nil
+	}
 	lct := &LoadCollectionTask{
 		ctx:                   ctx,
 		Condition:             NewTaskCondition(ctx),
@@ -325,7 +365,17 @@ func (node *Proxy) LoadCollection(ctx context.Context, request *milvuspb.LoadCol
 			Reason:    err.Error(),
 		}, nil
 	}
+	if lct.result.ErrorCode != commonpb.ErrorCode_Success || request.Async {
+		return lct.result, nil
+	}
 
+	err = node.waitForLoadingDone(ctx, request.DbName, request.CollectionName, nil)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
 	return lct.result, nil
 }
 
@@ -333,6 +383,9 @@ func (node *Proxy) ReleaseCollection(ctx context.Context, request *milvuspb.Rele
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_ReleaseCollection, request.DbName, request.CollectionName); st != nil {
+		return st, nil
+	}
 	rct := &ReleaseCollectionTask{
 		ctx:                      ctx,
 		Condition:                NewTaskCondition(ctx),
@@ -386,11 +439,17 @@ func (node *Proxy) DescribeCollection(ctx context.Context, request *milvuspb.Des
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_DescribeCollection, request.DbName, request.CollectionName); st != nil {
+		return &milvuspb.DescribeCollectionResponse{
+			Status: st,
+		}, nil
+	}
 	dct := &DescribeCollectionTask{
 		ctx:                       ctx,
 		Condition:                 NewTaskCondition(ctx),
 		DescribeCollectionRequest: request,
 		rootCoord:                 node.rootCoord,
+		queryCoord:                node.queryCoord,
 	}
 
 	log.Debug("DescribeCollection enqueue",
@@ -442,6 +501,11 @@ func (node *Proxy) GetCollectionStatistics(ctx context.Context, request *milvusp
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_GetCollectionStatistics, request.DbName, request.CollectionName); st != nil {
+		return &milvuspb.GetCollectionStatisticsResponse{
+			Status: st,
+		}, nil
+	}
 	g := &GetCollectionStatisticsTask{
 		ctx:                            ctx,
 		Condition:                      NewTaskCondition(ctx),
@@ -498,6 +562,11 @@ func (node *Proxy) ShowCollections(ctx context.Context, request *milvuspb.ShowCo
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_ShowCollections, request.DbName, credential.AnyWord); st != nil {
+		return &milvuspb.ShowCollectionsResponse{
+			Status: st,
+		}, nil
+	}
 	sct := &ShowCollectionsTask{
 		ctx:                    ctx,
 		Condition:              NewTaskCondition(ctx),
@@ -543,10 +612,415 @@ func (node *Proxy) ShowCollections(ctx context.Context, request *milvuspb.ShowCo
 	return sct.result, nil
 }
 
+func (node *Proxy) CreateDatabase(ctx context.Context, request *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_CreateDatabase, request.DbName, credential.AnyWord); st != nil {
+		return st, nil
+	}
+	cdt := &CreateDatabaseTask{
+		ctx:                   ctx,
+		Condition:             NewTaskCondition(ctx),
+		CreateDatabaseRequest: request,
+		rootCoord:             node.rootCoord,
+		result:                nil,
+	}
+
+	log.Debug("CreateDatabase enqueue",
+		zap.String("role", Params.RoleName),
+		zap.String("db", request.DbName))
+	err := node.sched.DdQueue.Enqueue(cdt)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug("CreateDatabase",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("db", request.DbName))
+	defer func() {
+		log.Debug("CreateDatabase Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("db", request.DbName))
+	}()
+
+	err = cdt.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return cdt.result, nil
+}
+
+func (node *Proxy) DropDatabase(ctx context.Context, request *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_DropDatabase, request.DbName, credential.AnyWord); st != nil {
+		return st, nil
+	}
+	ddt := &DropDatabaseTask{
+		ctx:                 ctx,
+		Condition:           NewTaskCondition(ctx),
+		DropDatabaseRequest: request,
+		rootCoord:           node.rootCoord,
+		result:              nil,
+	}
+
+	log.Debug("DropDatabase enqueue",
+		zap.String("role", Params.RoleName),
+		zap.String("db", request.DbName))
+	err := node.sched.DdQueue.Enqueue(ddt)
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug("DropDatabase",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp),
+		zap.String("db", request.DbName))
+	defer func() {
+		log.Debug("DropDatabase Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp),
+			zap.String("db", request.DbName))
+	}()
+
+	err = ddt.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return ddt.result, nil
+}
+
+func (node *Proxy) ListDatabases(ctx context.Context, request *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.ListDatabasesResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_ListDatabases, credential.AnyWord, credential.AnyWord); st != nil {
+		return &milvuspb.ListDatabasesResponse{
+			Status: st,
+		}, nil
+	}
+	ldt := &ListDatabasesTask{
+		ctx:                  ctx,
+		Condition:            NewTaskCondition(ctx),
+		ListDatabasesRequest: request,
+		rootCoord:            node.rootCoord,
+		result:               nil,
+	}
+
+	log.Debug("ListDatabases enqueue",
+		zap.String("role", Params.RoleName))
+	err := node.sched.DdQueue.Enqueue(ldt)
+	if err != nil {
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug("ListDatabases",
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", request.Base.MsgID),
+		zap.Uint64("timestamp", request.Base.Timestamp))
+	defer func() {
+		log.Debug("ListDatabases Done",
+			zap.Error(err),
+			zap.String("role", Params.RoleName),
+			zap.Int64("msgID", request.Base.MsgID),
+			zap.Uint64("timestamp", request.Base.Timestamp))
+	}()
+
+	err = ldt.WaitToFinish()
+	if err != nil {
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return ldt.result, nil
+}
+
+func (node *Proxy) CreateCredential(ctx context.Context, request *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+	log.Debug("CreateCredential",
+		zap.String("role", Params.RoleName),
+		zap.String("username", request.Username))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_CreateCredential, credential.AnyWord, credential.AnyWord); st != nil {
+		return st, nil
+	}
+	if err := ValidateUsername(request.Username); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if err := ValidatePassword(request.Password); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	request.Base = &commonpb.MsgBase{
+		MsgType:  commonpb.MsgType_CreateCredential,
+		SourceID: Params.ProxyID,
+	}
+	result, err := node.rootCoord.CreateCredential(ctx, request)
+	if err != nil {
+		log.Debug("CreateCredential failed",
+			zap.Error(err),
+			zap.String("username", request.Username))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return result, nil
+}
+
+func (node *Proxy) DeleteCredential(ctx context.Context, request *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	log.Debug("DeleteCredential",
+		zap.String("role", Params.RoleName),
+		zap.String("username", request.Username))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_DeleteCredential, credential.AnyWord, credential.AnyWord); st != nil {
+		return st, nil
+	}
+	request.Base = &commonpb.MsgBase{
+		MsgType:  commonpb.MsgType_DeleteCredential,
+		SourceID: Params.ProxyID,
+	}
+	result, err := node.rootCoord.DeleteCredential(ctx, request)
+	if err != nil {
+		log.Debug("DeleteCredential failed",
+			zap.Error(err),
+			zap.String("username", request.Username))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return result, nil
+}
+
+func (node *Proxy) ListCredUsers(ctx context.Context, request *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	log.Debug("ListCredUsers",
+		zap.String("role", Params.RoleName))
+	if !node.checkHealthy() {
+		return &milvuspb.ListCredUsersResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_ListCredUsernames, credential.AnyWord, credential.AnyWord); st != nil {
+		return &milvuspb.ListCredUsersResponse{
+			Status: st,
+		}, nil
+	}
+	request.Base = &commonpb.MsgBase{
+		MsgType:  commonpb.MsgType_ListCredUsernames,
+		SourceID: Params.ProxyID,
+	}
+	result, err := node.rootCoord.ListCredUsers(ctx, request)
+	if err != nil {
+		log.Debug("ListCredUsers failed", zap.Error(err))
+		return &milvuspb.ListCredUsersResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return result, nil
+}
+
+func (node *Proxy) CreateRole(ctx context.Context, request *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	log.Debug("CreateRole",
+		zap.String("role", Params.RoleName),
+		zap.String("rolename", request.RoleName))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_CreateRole, credential.AnyWord, credential.AnyWord); st != nil {
+		return st, nil
+	}
+	if err := ValidateRoleName(request.RoleName); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	request.Base = &commonpb.MsgBase{
+		MsgType:  commonpb.MsgType_CreateRole,
+		SourceID: Params.ProxyID,
+	}
+	result, err := node.rootCoord.CreateRole(ctx, request)
+	if err != nil {
+		log.Debug("CreateRole failed",
+			zap.Error(err),
+			zap.String("rolename", request.RoleName))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return result, nil
+}
+
+func (node *Proxy) DropRole(ctx context.Context, request *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	log.Debug("DropRole",
+		zap.String("role", Params.RoleName),
+		zap.String("rolename", request.RoleName))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_DropRole, credential.AnyWord, credential.AnyWord); st != nil {
+		return st, nil
+	}
+	request.Base = &commonpb.MsgBase{
+		MsgType:  commonpb.MsgType_DropRole,
+		SourceID: Params.ProxyID,
+	}
+	result, err := node.rootCoord.DropRole(ctx, request)
+	if err != nil {
+		log.Debug("DropRole failed",
+			zap.Error(err),
+			zap.String("rolename", request.RoleName))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return result, nil
+}
+
+func (node *Proxy) OperateUserRole(ctx context.Context, request *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	log.Debug("OperateUserRole",
+		zap.String("role", Params.RoleName),
+		zap.String("username", request.Username),
+		zap.String("rolename", request.RoleName),
+		zap.String("type", request.Type.String()))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_OperateUserRole, credential.AnyWord, credential.AnyWord); st != nil {
+		return st, nil
+	}
+	request.Base = &commonpb.MsgBase{
+		MsgType:  commonpb.MsgType_OperateUserRole,
+		SourceID: Params.ProxyID,
+	}
+	result, err := node.rootCoord.OperateUserRole(ctx, request)
+	if err != nil {
+		log.Debug("OperateUserRole failed",
+			zap.Error(err),
+			zap.String("username", request.Username),
+			zap.String("rolename", request.RoleName),
+			zap.String("type", request.Type.String()))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return result, nil
+}
+
+func (node *Proxy) OperatePrivilege(ctx context.Context, request *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	log.Debug("OperatePrivilege",
+		zap.String("role", Params.RoleName),
+		zap.Any("entity", request.Entity),
+		zap.String("type", request.Type.String()))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_OperatePrivilege, credential.AnyWord, credential.AnyWord); st != nil {
+		return st, nil
+	}
+	request.Base = &commonpb.MsgBase{
+		MsgType:  commonpb.MsgType_OperatePrivilege,
+		SourceID: Params.ProxyID,
+	}
+	result, err := node.rootCoord.OperatePrivilege(ctx, request)
+	if err != nil {
+		log.Debug("OperatePrivilege failed",
+			zap.Error(err),
+			zap.Any("entity", request.Entity),
+			zap.String("type", request.Type.String()))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return result, nil
+}
+
+func (node *Proxy) SelectGrant(ctx context.Context, request *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	log.Debug("SelectGrant",
+		zap.String("role", Params.RoleName),
+		zap.String("rolename", request.RoleName))
+	if !node.checkHealthy() {
+		return &milvuspb.SelectGrantResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_SelectGrant, credential.AnyWord, credential.AnyWord); st != nil {
+		return &milvuspb.SelectGrantResponse{
+			Status: st,
+		}, nil
+	}
+	request.Base = &commonpb.MsgBase{
+		MsgType:  commonpb.MsgType_SelectGrant,
+		SourceID: Params.ProxyID,
+	}
+	result, err := node.rootCoord.SelectGrant(ctx, request)
+	if err != nil {
+		log.Debug("SelectGrant failed", zap.Error(err))
+		return &milvuspb.SelectGrantResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return result, nil
+}
+
 func (node *Proxy) CreatePartition(ctx context.Context, request *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_CreatePartition, request.DbName, request.CollectionName); st != nil {
+		return st, nil
+	}
 	cpt := &CreatePartitionTask{
 		ctx:                    ctx,
 		Condition:              NewTaskCondition(ctx),
@@ -600,6 +1074,9 @@ func (node *Proxy) DropPartition(ctx context.Context, request *milvuspb.DropPart
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_DropPartition, request.DbName, request.CollectionName); st != nil {
+		return st, nil
+	}
 	dpt := &DropPartitionTask{
 		ctx:                  ctx,
 		Condition:            NewTaskCondition(ctx),
@@ -655,6 +1132,11 @@ func (node *Proxy) HasPartition(ctx context.Context, request *milvuspb.HasPartit
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_HasPartition, request.DbName, request.CollectionName); st != nil {
+		return &milvuspb.BoolResponse{
+			Status: st,
+		}, nil
+	}
 	hpt := &HasPartitionTask{
 		ctx:                 ctx,
 		Condition:           NewTaskCondition(ctx),
@@ -714,6 +1196,9 @@ func (node *Proxy) LoadPartitions(ctx context.Context, request *milvuspb.LoadPar
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_LoadPartitions, request.DbName, request.CollectionName); st != nil {
+		return st, nil
+	}
 	lpt := &LoadPartitionTask{
 		ctx:                   ctx,
 		Condition:             NewTaskCondition(ctx),
@@ -752,14 +1237,24 @@ func (node *Proxy) LoadPartitions(ctx context.Context, request *milvuspb.LoadPar
 			zap.Any("partitions", request.PartitionNames))
 	}()
 
-	err = lpt.WaitToFinish()
+	err = lpt.WaitToFinish()
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if lpt.result.ErrorCode != commonpb.ErrorCode_Success || request.Async {
+		return lpt.result, nil
+	}
+
+	err = node.waitForLoadingDone(ctx, request.DbName, request.CollectionName, request.PartitionNames)
 	if err != nil {
 		return &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_UnexpectedError,
 			Reason:    err.Error(),
 		}, nil
 	}
-
 	return lpt.result, nil
 }
 
@@ -767,6 +1262,9 @@ func (node *Proxy) ReleasePartitions(ctx context.Context, request *milvuspb.Rele
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_ReleasePartitions, request.DbName, request.CollectionName); st != nil {
+		return st, nil
+	}
 	rpt := &ReleasePartitionTask{
 		ctx:                      ctx,
 		Condition:                NewTaskCondition(ctx),
@@ -822,6 +1320,11 @@ func (node *Proxy) GetPartitionStatistics(ctx context.Context, request *milvuspb
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_GetPartitionStatistics, request.DbName, request.CollectionName); st != nil {
+		return &milvuspb.GetPartitionStatisticsResponse{
+			Status: st,
+		}, nil
+	}
 	g := &GetPartitionStatisticsTask{
 		ctx:                           ctx,
 		Condition:                     NewTaskCondition(ctx),
@@ -881,6 +1384,11 @@ func (node *Proxy) ShowPartitions(ctx context.Context, request *milvuspb.ShowPar
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_ShowPartitions, request.DbName, request.CollectionName); st != nil {
+		return &milvuspb.ShowPartitionsResponse{
+			Status: st,
+		}, nil
+	}
 	spt := &ShowPartitionsTask{
 		ctx:                   ctx,
 		Condition:             NewTaskCondition(ctx),
@@ -926,10 +1434,135 @@ func (node *Proxy) ShowPartitions(ctx context.Context, request *milvuspb.ShowPar
 	return spt.result, nil
 }
 
+// GetLoadingProgress returns the percentage of the segments and rows loaded of a collection,
+// in total, per partition and per query node
+func (node *Proxy) GetLoadingProgress(ctx context.Context, request *milvuspb.GetLoadingProgressRequest) (*milvuspb.GetLoadingProgressResponse, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.GetLoadingProgressResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_GetLoadingProgress, request.DbName, request.CollectionName); st != nil {
+		return &milvuspb.GetLoadingProgressResponse{
+			Status: st,
+		}, nil
+	}
+	log.Debug("GetLoadingProgress",
+		zap.String("role", Params.RoleName),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Any("partitions", request.PartitionNames))
+
+	resp, err := node.getLoadingProgress(ctx, request.DbName, request.CollectionName, request.PartitionNames)
+	if err != nil {
+		return &milvuspb.GetLoadingProgressResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return resp, nil
+}
+
+func (node *Proxy) getLoadingProgress(ctx context.Context, dbName string, collectionName string, partitionNames []string) (*milvuspb.GetLoadingProgressResponse, error) {
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	partitions, err := globalMetaCache.GetPartitions(ctx, dbName, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	partitionIDs := make([]UniqueID, 0, len(partitionNames))
+	for _, partitionName := range partitionNames {
+		partitionID, ok := partitions[partitionName]
+		if !ok {
+			return nil, fmt.Errorf("partition %s of collection %s does not exist", partitionName, collectionName)
+		}
+		partitionIDs = append(partitionIDs, partitionID)
+	}
+
+	resp, err := node.queryCoord.GetLoadingProgress(ctx, &querypb.GetLoadingProgressRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_GetLoadingProgress,
+			SourceID: Params.ProxyID,
+		},
+		CollectionID: collectionID,
+		PartitionIDs: partitionIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.Status.Reason)
+	}
+
+	partitionNamesByID := make(map[UniqueID]string, len(partitions))
+	for name, id := range partitions {
+		partitionNamesByID[id] = name
+	}
+	result := &milvuspb.GetLoadingProgressResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Progress: toMilvusLoadingProgress(resp.Progress),
+	}
+	for _, partitionProgress := range resp.PartitionProgresses {
+		result.PartitionProgresses = append(result.PartitionProgresses, &milvuspb.PartitionLoadingProgress{
+			PartitionName: partitionNamesByID[partitionProgress.PartitionID],
+			Progress:      toMilvusLoadingProgress(partitionProgress.Progress),
+		})
+	}
+	for _, nodeProgress := range resp.NodeProgresses {
+		result.NodeProgresses = append(result.NodeProgresses, &milvuspb.NodeLoadingProgress{
+			NodeID:   nodeProgress.NodeID,
+			Progress: toMilvusLoadingProgress(nodeProgress.Progress),
+		})
+	}
+	return result, nil
+}
+
+// waitForLoadingDone blocks a load request until all its partitions are loaded, or the request is canceled
+func (node *Proxy) waitForLoadingDone(ctx context.Context, dbName string, collectionName string, partitionNames []string) error {
+	ticker := time.NewTicker(loadingProgressCheckInterval)
+	defer ticker.Stop()
+	for {
+		resp, err := node.getLoadingProgress(ctx, dbName, collectionName, partitionNames)
+		if err != nil {
+			return err
+		}
+		if resp.Progress.Percentage >= 100 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("wait for loading collection %s: %w, %d percent loaded", collectionName, ctx.Err(), resp.Progress.Percentage)
+		case <-ticker.C:
+		}
+	}
+}
+
+func toMilvusLoadingProgress(progress *querypb.LoadingProgress) *milvuspb.LoadingProgress {
+	if progress == nil {
+		return &milvuspb.LoadingProgress{}
+	}
+	return &milvuspb.LoadingProgress{
+		TotalSegments:  progress.TotalSegments,
+		LoadedSegments: progress.LoadedSegments,
+		TotalRows:      progress.TotalRows,
+		LoadedRows:     progress.LoadedRows,
+		Percentage:     progress.Percentage,
+	}
+}
+
 func (node *Proxy) CreateIndex(ctx context.Context, request *milvuspb.CreateIndexRequest) (*commonpb.Status, error) {
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_CreateIndex, request.DbName, request.CollectionName); st != nil {
+		return st, nil
+	}
 	cit := &CreateIndexTask{
 		ctx:                ctx,
 		Condition:          NewTaskCondition(ctx),
@@ -988,6 +1621,11 @@ func (node *Proxy) DescribeIndex(ctx context.Context, request *milvuspb.Describe
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_DescribeIndex, request.DbName, request.CollectionName); st != nil {
+		return &milvuspb.DescribeIndexResponse{
+			Status: st,
+		}, nil
+	}
 	dit := &DescribeIndexTask{
 		ctx:                  ctx,
 		Condition:            NewTaskCondition(ctx),
@@ -1052,6 +1690,9 @@ func (node *Proxy) DropIndex(ctx context.Context, request *milvuspb.DropIndexReq
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_DropIndex, request.DbName, request.CollectionName); st != nil {
+		return st, nil
+	}
 	dit := &DropIndexTask{
 		ctx:              ctx,
 		Condition:        NewTaskCondition(ctx),
@@ -1112,6 +1753,11 @@ func (node *Proxy) GetIndexBuildProgress(ctx context.Context, request *milvuspb.
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_GetIndexBuildProgress, request.DbName, request.CollectionName); st != nil {
+		return &milvuspb.GetIndexBuildProgressResponse{
+			Status: st,
+		}, nil
+	}
 	gibpt := &GetIndexBuildProgressTask{
 		ctx:                          ctx,
 		Condition:                    NewTaskCondition(ctx),
@@ -1178,6 +1824,11 @@ func (node *Proxy) GetIndexState(ctx context.Context, request *milvuspb.GetIndex
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_GetIndexState, request.DbName, request.CollectionName); st != nil {
+		return &milvuspb.GetIndexStateResponse{
+			Status: st,
+		}, nil
+	}
 	dipt := &GetIndexStateTask{
 		ctx:                  ctx,
 		Condition:            NewTaskCondition(ctx),
@@ -1241,6 +1892,16 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_Insert, request.DbName, request.CollectionName); st != nil {
+		return &milvuspb.MutationResult{
+			Status: st,
+		}, nil
+	}
+	if st := node.checkRateLimit(ctx, request.DbName, request.CollectionName, int64(request.NumRows), int64(proto.Size(request))); st != nil {
+		return &milvuspb.MutationResult{
+			Status: st,
+		}, nil
+	}
 	it := &InsertTask{
 		ctx:       ctx,
 		Condition: NewTaskCondition(ctx),
@@ -1255,6 +1916,7 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 					MsgType: commonpb.MsgType_Insert,
 					MsgID:   0,
 				},
+				DbName:         request.DbName,
 				CollectionName: request.CollectionName,
 				PartitionName:  request.PartitionName,
 				// RowData: transfer column based request to this
@@ -1342,17 +2004,94 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 			errIndex[i] = i
 		}
 		it.result.ErrIndex = errIndex
+	} else {
+		node.sessionTs.update(getSessionKey(ctx), it.CollectionID, it.EndTs())
 	}
 	it.result.InsertCnt = int64(it.req.NumRows)
 	return it.result, nil
 }
 
+// InsertRows inserts the rows given as JSON objects, the rows are validated against the collection schema and
+// converted to columns, then inserted as Insert does
+func (node *Proxy) InsertRows(ctx context.Context, request *milvuspb.InsertRowsRequest) (*milvuspb.MutationResult, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.MutationResult{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_Insert, request.DbName, request.CollectionName); st != nil {
+		return &milvuspb.MutationResult{
+			Status: st,
+		}, nil
+	}
+
+	log.Debug("InsertRows",
+		zap.String("role", Params.RoleName),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("partition", request.PartitionName),
+		zap.Int("rows", len(request.Rows)))
+
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, request.DbName, request.CollectionName)
+	if err != nil {
+		return &milvuspb.MutationResult{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	fieldsData, errIndex, errReasons, err := rowsToFieldsData(schema, request.Rows)
+	if err != nil {
+		return &milvuspb.MutationResult{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	if len(errIndex) > 0 {
+		log.Debug("InsertRows rows mismatch the schema",
+			zap.String("collection", request.CollectionName),
+			zap.Int("errRows", len(errIndex)))
+		return &milvuspb.MutationResult{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason: fmt.Sprintf("%d of %d rows mismatch the schema of collection %s, first error: %s",
+					len(errIndex), len(request.Rows), request.CollectionName, errReasons[0]),
+			},
+			ErrIndex:   errIndex,
+			ErrReasons: errReasons,
+		}, nil
+	}
+
+	return node.Insert(ctx, &milvuspb.InsertRequest{
+		Base:           request.Base,
+		DbName:         request.DbName,
+		CollectionName: request.CollectionName,
+		PartitionName:  request.PartitionName,
+		FieldsData:     fieldsData,
+		NumRows:        uint32(len(request.Rows)),
+	})
+}
+
 func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error) {
 	if !node.checkHealthy() {
 		return &milvuspb.MutationResult{
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_Delete, request.DbName, request.CollectionName); st != nil {
+		return &milvuspb.MutationResult{
+			Status: st,
+		}, nil
+	}
+	// the deleted rows are known after the expr is parsed, only the request and its bytes are limited
+	if st := node.checkRateLimit(ctx, request.DbName, request.CollectionName, 0, int64(proto.Size(request))); st != nil {
+		return &milvuspb.MutationResult{
+			Status: st,
+		}, nil
+	}
 
 	dt := &DeleteTask{
 		ctx:           ctx,
@@ -1409,12 +2148,123 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 	return dt.result, nil
 }
 
+// Upsert inserts the entities, the existing entities with the same primary keys are deleted in the same timestamp
+func (node *Proxy) Upsert(ctx context.Context, request *milvuspb.UpsertRequest) (*milvuspb.MutationResult, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.MutationResult{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	for _, msgType := range []commonpb.MsgType{commonpb.MsgType_Insert, commonpb.MsgType_Delete} {
+		if st := checkPrivilege(ctx, msgType, request.DbName, request.CollectionName); st != nil {
+			return &milvuspb.MutationResult{
+				Status: st,
+			}, nil
+		}
+	}
+	if st := node.checkRateLimit(ctx, request.DbName, request.CollectionName, int64(request.NumRows), int64(proto.Size(request))); st != nil {
+		return &milvuspb.MutationResult{
+			Status: st,
+		}, nil
+	}
+	ut := &UpsertTask{
+		InsertTask: InsertTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(ctx),
+			dataCoord: node.dataCoord,
+			req: &milvuspb.InsertRequest{
+				Base:           request.Base,
+				DbName:         request.DbName,
+				CollectionName: request.CollectionName,
+				PartitionName:  request.PartitionName,
+				FieldsData:     request.FieldsData,
+				HashKeys:       request.HashKeys,
+				NumRows:        request.NumRows,
+			},
+			BaseInsertTask: BaseInsertTask{
+				BaseMsg: msgstream.BaseMsg{
+					HashValues: request.HashKeys,
+				},
+				InsertRequest: internalpb.InsertRequest{
+					Base: &commonpb.MsgBase{
+						MsgType: commonpb.MsgType_Insert,
+						MsgID:   0,
+					},
+					DbName:         request.DbName,
+					CollectionName: request.CollectionName,
+					PartitionName:  request.PartitionName,
+				},
+			},
+			rowIDAllocator: node.idAllocator,
+			segIDAssigner:  node.segAssigner,
+			chMgr:          node.chMgr,
+			chTicker:       node.chTicker,
+		},
+	}
+	if len(ut.PartitionName) <= 0 {
+		ut.PartitionName = Params.DefaultPartitionName
+	}
+
+	log.Debug("Upsert enqueue",
+		zap.String("role", Params.RoleName),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("partition", request.PartitionName),
+		zap.Uint32("numRows", request.NumRows))
+
+	failed := func(err error) *milvuspb.MutationResult {
+		errIndex := make([]uint32, request.NumRows)
+		for i := uint32(0); i < request.NumRows; i++ {
+			errIndex[i] = i
+		}
+		return &milvuspb.MutationResult{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ErrIndex: errIndex,
+		}
+	}
+
+	err := node.sched.DmQueue.Enqueue(ut)
+	if err != nil {
+		return failed(err), nil
+	}
+	err = ut.WaitToFinish()
+
+	log.Debug("Upsert Done",
+		zap.Error(err),
+		zap.String("role", Params.RoleName),
+		zap.Int64("msgID", ut.Base.MsgID),
+		zap.Uint64("timestamp", ut.BeginTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("partition", request.PartitionName),
+		zap.Uint32("numRows", request.NumRows))
+
+	if err != nil {
+		return failed(err), nil
+	}
+	if ut.result.Status.ErrorCode != commonpb.ErrorCode_Success {
+		ut.result.ErrIndex = failed(errors.New(ut.result.Status.Reason)).ErrIndex
+	} else {
+		node.sessionTs.update(getSessionKey(ctx), ut.CollectionID, ut.EndTs())
+	}
+	ut.result.UpsertCnt = int64(request.NumRows)
+	return ut.result, nil
+}
+
 func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
 	if !node.checkHealthy() {
 		return &milvuspb.SearchResults{
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_Search, request.DbName, request.CollectionName); st != nil {
+		return &milvuspb.SearchResults{
+			Status: st,
+		}, nil
+	}
 	qt := &SearchTask{
 		ctx:       ctx,
 		Condition: NewTaskCondition(ctx),
@@ -1429,6 +2279,8 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 		query:     request,
 		chMgr:     node.chMgr,
 		qc:        node.queryCoord,
+		shardMgr:  node.shardMgr,
+		sessionTs: node.sessionTs,
 	}
 
 	log.Debug("Search enqueue",
@@ -1508,6 +2360,12 @@ func (node *Proxy) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*
 		resp.Status.Reason = "proxy is not healthy"
 		return resp, nil
 	}
+	for _, collectionName := range request.CollectionNames {
+		if st := checkPrivilege(ctx, commonpb.MsgType_Flush, request.DbName, collectionName); st != nil {
+			resp.Status = st
+			return resp, nil
+		}
+	}
 	ft := &FlushTask{
 		ctx:          ctx,
 		Condition:    NewTaskCondition(ctx),
@@ -1546,8 +2404,59 @@ func (node *Proxy) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*
 		resp.Status.Reason = err.Error()
 		return resp, nil
 	}
+	return node.waitForFlushResult(ctx, request, ft.result), nil
+}
+
+// waitForFlushResult blocks the result of a sync flush request until its segments are flushed,
+// the segment ids are kept if the wait fails, so the caller could go on polling GetFlushState
+func (node *Proxy) waitForFlushResult(ctx context.Context, request *milvuspb.FlushRequest, result *milvuspb.FlushResponse) *milvuspb.FlushResponse {
+	if result.Status.ErrorCode != commonpb.ErrorCode_Success || !request.Sync {
+		return result
+	}
+
+	var segmentIDs []UniqueID
+	for _, ids := range result.CollSegIDs {
+		segmentIDs = append(segmentIDs, ids.Data...)
+	}
+	err := node.waitForFlushDone(ctx, segmentIDs, request.SyncTimeout)
+	if err != nil {
+		result.Status = &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}
+	}
+	return result
+}
 
-	return ft.result, nil
+// waitForFlushDone blocks a flush request until all its segments are flushed, the timeout is in milliseconds
+// and the request waits until it is canceled if the timeout is 0
+func (node *Proxy) waitForFlushDone(ctx context.Context, segmentIDs []UniqueID, timeout int64) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(timeout)*time.Millisecond)
+		defer cancel()
+	}
+	ticker := time.NewTicker(flushStateCheckInterval)
+	defer ticker.Stop()
+	for {
+		resp, err := node.dataCoord.GetFlushState(ctx, &milvuspb.GetFlushStateRequest{
+			SegmentIDs: segmentIDs,
+		})
+		if err != nil {
+			return err
+		}
+		if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return errors.New(resp.Status.Reason)
+		}
+		if resp.Flushed {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("wait for flushing segments %v: %w", segmentIDs, ctx.Err())
+		case <-ticker.C:
+		}
+	}
 }
 
 func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
@@ -1556,6 +2465,11 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 			Status: unhealthyStatus(),
 		}, nil
 	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_Retrieve, request.DbName, request.CollectionName); st != nil {
+		return &milvuspb.QueryResults{
+			Status: st,
+		}, nil
+	}
 
 	queryRequest := &milvuspb.QueryRequest{
 		DbName:         request.DbName,
@@ -1579,6 +2493,8 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 		query:     queryRequest,
 		chMgr:     node.chMgr,
 		qc:        node.queryCoord,
+		shardMgr:  node.shardMgr,
+		sessionTs: node.sessionTs,
 	}
 
 	log.Debug("Query enqueue",
@@ -1641,6 +2557,17 @@ func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDista
 			},
 		}, nil
 	}
+	// the vectors given by ids are retrieved from their collections
+	for _, ids := range []*milvuspb.VectorIDs{request.GetOpLeft().GetIdArray(), request.GetOpRight().GetIdArray()} {
+		if ids == nil {
+			continue
+		}
+		if st := checkPrivilege(ctx, commonpb.MsgType_Retrieve, "", ids.CollectionName); st != nil {
+			return &milvuspb.CalcDistanceResults{
+				Status: st,
+			}, nil
+		}
+	}
 
 	query := func(ids *milvuspb.VectorIDs) (*milvuspb.QueryResults, error) {
 		outputFields := []string{ids.FieldName}
@@ -1667,6 +2594,8 @@ func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDista
 			query:     queryRequest,
 			chMgr:     node.chMgr,
 			qc:        node.queryCoord,
+			shardMgr:  node.shardMgr,
+			sessionTs: node.sessionTs,
 		}
 
 		err := node.sched.DqQueue.Enqueue(qt)
@@ -1941,6 +2870,11 @@ func (node *Proxy) GetPersistentSegmentInfo(ctx context.Context, req *milvuspb.G
 		resp.Status = unhealthyStatus()
 		return resp, nil
 	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_ShowSegments, req.DbName, req.CollectionName); st != nil {
+		return &milvuspb.GetPersistentSegmentInfoResponse{
+			Status: st,
+		}, nil
+	}
 	segments, err := node.getSegmentsOfCollection(ctx, req.DbName, req.CollectionName)
 	if err != nil {
 		resp.Status.Reason = fmt.Errorf("getSegmentsOfCollection, err:%w", err).Error()
@@ -1979,6 +2913,56 @@ func (node *Proxy) GetPersistentSegmentInfo(ctx context.Context, req *milvuspb.G
 	return resp, nil
 }
 
+// GetFlushState checks whether all the given segments are flushed, it requires the flush privilege
+// on the collection of the request, or on all the collections if no collection is given. The segments
+// of other collections are rejected if a collection is given.
+func (node *Proxy) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
+	log.Debug("GetFlushState",
+		zap.String("role", Params.RoleName),
+		zap.String("db", req.DbName),
+		zap.String("collection", req.CollectionName),
+		zap.Int64s("segmentIDs", req.SegmentIDs))
+
+	if !node.checkHealthy() {
+		return &milvuspb.GetFlushStateResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	collectionName := req.CollectionName
+	if collectionName == "" {
+		collectionName = credential.AnyWord
+	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_Flush, req.DbName, collectionName); st != nil {
+		return &milvuspb.GetFlushStateResponse{
+			Status: st,
+		}, nil
+	}
+	// the data coord rejects the segments out of the collection whose privilege is checked
+	req.CollectionID = 0
+	if req.CollectionName != "" {
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, req.DbName, req.CollectionName)
+		if err != nil {
+			return &milvuspb.GetFlushStateResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Reason:    err.Error(),
+				},
+			}, nil
+		}
+		req.CollectionID = collectionID
+	}
+	resp, err := node.dataCoord.GetFlushState(ctx, req)
+	if err != nil {
+		return &milvuspb.GetFlushStateResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Errorf("dataCoord:GetFlushState, err:%w", err).Error(),
+			},
+		}, nil
+	}
+	return resp, nil
+}
+
 func (node *Proxy) GetQuerySegmentInfo(ctx context.Context, req *milvuspb.GetQuerySegmentInfoRequest) (*milvuspb.GetQuerySegmentInfoResponse, error) {
 	log.Debug("GetQuerySegmentInfo",
 		zap.String("role", Params.RoleName),
@@ -1994,6 +2978,11 @@ func (node *Proxy) GetQuerySegmentInfo(ctx context.Context, req *milvuspb.GetQue
 		resp.Status = unhealthyStatus()
 		return resp, nil
 	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_ShowSegments, req.DbName, req.CollectionName); st != nil {
+		return &milvuspb.GetQuerySegmentInfoResponse{
+			Status: st,
+		}, nil
+	}
 	segments, err := node.getSegmentsOfCollection(ctx, req.DbName, req.CollectionName)
 	if err != nil {
 		resp.Status.Reason = err.Error()
@@ -2181,6 +3170,11 @@ func (node *Proxy) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsReque
 			Response: "",
 		}, nil
 	}
+	if st := checkPrivilege(ctx, commonpb.MsgType_GetSystemConfigs, credential.AnyWord, credential.AnyWord); st != nil {
+		return &milvuspb.GetMetricsResponse{
+			Status: st,
+		}, nil
+	}
 
 	metricType, err := metricsinfo.ParseMetricType(req.Request)
 	if err != nil {
@@ -0,0 +1,209 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/credential"
+)
+
+// mockFlushStateDataCoord reports the segments flushed after the given number of GetFlushState calls
+type mockFlushStateDataCoord struct {
+	types.DataCoord
+	flushedAfter int
+	status       *commonpb.Status
+	err          error
+	calls        int
+	lastReq      *milvuspb.GetFlushStateRequest
+}
+
+func (m *mockFlushStateDataCoord) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
+	m.calls++
+	m.lastReq = req
+	if m.err != nil {
+		return nil, m.err
+	}
+	status := m.status
+	if status == nil {
+		status = &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+	}
+	return &milvuspb.GetFlushStateResponse{
+		Status:  status,
+		Flushed: m.calls > m.flushedAfter,
+	}, nil
+}
+
+func TestProxy_waitForFlushDone(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("flushed", func(t *testing.T) {
+		dataCoord := &mockFlushStateDataCoord{flushedAfter: 1}
+		node := &Proxy{dataCoord: dataCoord}
+		err := node.waitForFlushDone(ctx, []UniqueID{1, 2}, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, dataCoord.calls)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		node := &Proxy{dataCoord: &mockFlushStateDataCoord{flushedAfter: 100}}
+		err := node.waitForFlushDone(ctx, []UniqueID{1, 2}, 100)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		cancelCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		node := &Proxy{dataCoord: &mockFlushStateDataCoord{flushedAfter: 100}}
+		err := node.waitForFlushDone(cancelCtx, []UniqueID{1}, 0)
+		assert.True(t, errors.Is(err, context.Canceled))
+	})
+
+	t.Run("data coord error", func(t *testing.T) {
+		node := &Proxy{dataCoord: &mockFlushStateDataCoord{
+			status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "segment not found"},
+		}}
+		err := node.waitForFlushDone(ctx, []UniqueID{1}, 0)
+		assert.EqualError(t, err, "segment not found")
+
+		node = &Proxy{dataCoord: &mockFlushStateDataCoord{err: errors.New("mock")}}
+		err = node.waitForFlushDone(ctx, []UniqueID{1}, 0)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestProxy_waitForFlushResult(t *testing.T) {
+	ctx := context.Background()
+	newResult := func() *milvuspb.FlushResponse {
+		return &milvuspb.FlushResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			CollSegIDs: map[string]*schemapb.LongArray{
+				"coll1": {Data: []int64{1, 2}},
+				"coll2": {Data: []int64{3}},
+			},
+		}
+	}
+
+	t.Run("async", func(t *testing.T) {
+		dataCoord := &mockFlushStateDataCoord{flushedAfter: 100}
+		node := &Proxy{dataCoord: dataCoord}
+		result := node.waitForFlushResult(ctx, &milvuspb.FlushRequest{}, newResult())
+		assert.Equal(t, commonpb.ErrorCode_Success, result.Status.ErrorCode)
+		assert.Equal(t, 0, dataCoord.calls)
+	})
+
+	t.Run("sync", func(t *testing.T) {
+		dataCoord := &mockFlushStateDataCoord{flushedAfter: 1}
+		node := &Proxy{dataCoord: dataCoord}
+		result := node.waitForFlushResult(ctx, &milvuspb.FlushRequest{Sync: true}, newResult())
+		assert.Equal(t, commonpb.ErrorCode_Success, result.Status.ErrorCode)
+		assert.Equal(t, 2, dataCoord.calls)
+	})
+
+	t.Run("sync timeout", func(t *testing.T) {
+		node := &Proxy{dataCoord: &mockFlushStateDataCoord{flushedAfter: 100}}
+		result := node.waitForFlushResult(ctx, &milvuspb.FlushRequest{Sync: true, SyncTimeout: 100}, newResult())
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, result.Status.ErrorCode)
+		// the segment ids are kept for polling
+		assert.Equal(t, []int64{1, 2}, result.CollSegIDs["coll1"].Data)
+		assert.Equal(t, []int64{3}, result.CollSegIDs["coll2"].Data)
+	})
+
+	t.Run("data coord error", func(t *testing.T) {
+		node := &Proxy{dataCoord: &mockFlushStateDataCoord{
+			status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "segment not found"},
+		}}
+		result := node.waitForFlushResult(ctx, &milvuspb.FlushRequest{Sync: true}, newResult())
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, result.Status.ErrorCode)
+		assert.Equal(t, "segment not found", result.Status.Reason)
+	})
+
+	t.Run("flush failed", func(t *testing.T) {
+		dataCoord := &mockFlushStateDataCoord{}
+		node := &Proxy{dataCoord: dataCoord}
+		failed := &milvuspb.FlushResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError},
+		}
+		result := node.waitForFlushResult(ctx, &milvuspb.FlushRequest{Sync: true}, failed)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, result.Status.ErrorCode)
+		assert.Equal(t, 0, dataCoord.calls)
+	})
+}
+
+func TestProxy_GetFlushStatePrivilege(t *testing.T) {
+	enabled := Params.AuthorizationEnabled
+	defer func() {
+		Params.AuthorizationEnabled = enabled
+	}()
+	Params.AuthorizationEnabled = true
+	globalPolicyCache = newPolicyCache(newMockPolicyRootCoord(t))
+	assert.Nil(t, globalPolicyCache.refresh(context.Background()))
+
+	md := metadata.Pairs(credential.HeaderAuthorize, credential.EncodeBasicAuth("user1", "password1"))
+	ctx, err := AuthenticationInterceptor(metadata.NewIncomingContext(context.Background(), md))
+	assert.Nil(t, err)
+
+	cache := globalMetaCache
+	defer func() {
+		globalMetaCache = cache
+	}()
+	globalMetaCache = &mockCollectionIDCache{collectionIDs: map[string]UniqueID{"db1.coll1": 100}}
+
+	dataCoord := &mockFlushStateDataCoord{}
+	node := &Proxy{dataCoord: dataCoord}
+	node.UpdateStateCode(internalpb.StateCode_Healthy)
+
+	// user1 can only search coll1 of the default database, but can do anything in db1
+	resp, err := node.GetFlushState(ctx, &milvuspb.GetFlushStateRequest{SegmentIDs: []int64{1}, CollectionName: "coll1"})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_PermissionDenied, resp.Status.ErrorCode)
+
+	resp, err = node.GetFlushState(ctx, &milvuspb.GetFlushStateRequest{SegmentIDs: []int64{1}})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_PermissionDenied, resp.Status.ErrorCode)
+
+	// the segments are checked against the collection of the request, whatever collection id is given
+	resp, err = node.GetFlushState(ctx, &milvuspb.GetFlushStateRequest{SegmentIDs: []int64{1}, DbName: "db1", CollectionName: "coll1", CollectionID: 200})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	assert.True(t, resp.Flushed)
+	assert.Equal(t, int64(100), dataCoord.lastReq.CollectionID)
+
+	resp, err = node.GetFlushState(ctx, &milvuspb.GetFlushStateRequest{SegmentIDs: []int64{1}, DbName: "db1", CollectionName: "coll2"})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+}
+
+// mockCollectionIDCache resolves the collection ids by "db.collection"
+type mockCollectionIDCache struct {
+	Cache
+	collectionIDs map[string]UniqueID
+}
+
+func (m *mockCollectionIDCache) GetCollectionID(ctx context.Context, dbName string, collectionName string) (UniqueID, error) {
+	collectionID, ok := m.collectionIDs[dbName+"."+collectionName]
+	if !ok {
+		return 0, fmt.Errorf("collection %s not found", collectionName)
+	}
+	return collectionID, nil
+}
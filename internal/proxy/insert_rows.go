@@ -0,0 +1,281 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// rowsToFieldsData converts the rows given as JSON objects keyed by field name to the columns of schema, the auto
+// generated primary key must not be given. The rows not matching the schema are reported by their indexes and
+// reasons, no columns are returned then.
+func rowsToFieldsData(schema *schemapb.CollectionSchema, rows [][]byte) ([]*schemapb.FieldData, []uint32, []string, error) {
+	fields := make([]*schemapb.FieldSchema, 0, len(schema.Fields))
+	autoIDFields := make(map[string]struct{})
+	for _, field := range schema.Fields {
+		if field.AutoID {
+			autoIDFields[field.Name] = struct{}{}
+			continue
+		}
+		fields = append(fields, field)
+	}
+
+	fieldsData := make([]*schemapb.FieldData, 0, len(fields))
+	appenders := make(map[string]func(value interface{}) error, len(fields))
+	for _, field := range fields {
+		fieldData, appender, err := newColumnAppender(field, len(rows))
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		fieldsData = append(fieldsData, fieldData)
+		appenders[field.Name] = appender
+	}
+
+	var errIndex []uint32
+	var errReasons []string
+	for i, row := range rows {
+		var reasons []string
+		values := make(map[string]interface{})
+		decoder := json.NewDecoder(bytes.NewReader(row))
+		decoder.UseNumber()
+		if err := decoder.Decode(&values); err != nil {
+			reasons = append(reasons, fmt.Sprintf("invalid JSON object: %v", err))
+		} else {
+			unknown := make([]string, 0)
+			for name := range values {
+				if _, ok := appenders[name]; !ok {
+					unknown = append(unknown, name)
+				}
+			}
+			sort.Strings(unknown)
+			for _, name := range unknown {
+				if _, ok := autoIDFields[name]; ok {
+					reasons = append(reasons, fmt.Sprintf("autoID field %s does not require data", name))
+				} else {
+					reasons = append(reasons, fmt.Sprintf("field %s does not exist", name))
+				}
+			}
+			for _, field := range fields {
+				value, ok := values[field.Name]
+				if !ok || value == nil {
+					reasons = append(reasons, fmt.Sprintf("field %s is missing", field.Name))
+					continue
+				}
+				if err := appenders[field.Name](value); err != nil {
+					reasons = append(reasons, fmt.Sprintf("field %s: %v", field.Name, err))
+				}
+			}
+		}
+		if len(reasons) > 0 {
+			errIndex = append(errIndex, uint32(i))
+			errReasons = append(errReasons, strings.Join(reasons, "; "))
+		}
+	}
+	if len(errIndex) > 0 {
+		return nil, errIndex, errReasons, nil
+	}
+	return fieldsData, nil, nil, nil
+}
+
+// newColumnAppender returns the empty column of field, and the function appending a JSON value to it
+func newColumnAppender(field *schemapb.FieldSchema, rowNum int) (*schemapb.FieldData, func(value interface{}) error, error) {
+	fieldData := &schemapb.FieldData{
+		Type:      field.DataType,
+		FieldName: field.Name,
+		FieldId:   field.FieldID,
+	}
+
+	switch field.DataType {
+	case schemapb.DataType_Bool:
+		data := &schemapb.BoolArray{Data: make([]bool, 0, rowNum)}
+		fieldData.Field = scalarField(&schemapb.ScalarField{Data: &schemapb.ScalarField_BoolData{BoolData: data}})
+		return fieldData, func(value interface{}) error {
+			b, ok := value.(bool)
+			if !ok {
+				return fmt.Errorf("expects a bool, got %s", jsonTypeName(value))
+			}
+			data.Data = append(data.Data, b)
+			return nil
+		}, nil
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		bits := 32
+		if field.DataType == schemapb.DataType_Int8 {
+			bits = 8
+		} else if field.DataType == schemapb.DataType_Int16 {
+			bits = 16
+		}
+		data := &schemapb.IntArray{Data: make([]int32, 0, rowNum)}
+		fieldData.Field = scalarField(&schemapb.ScalarField{Data: &schemapb.ScalarField_IntData{IntData: data}})
+		return fieldData, func(value interface{}) error {
+			n, err := jsonInt(value, bits)
+			if err != nil {
+				return err
+			}
+			data.Data = append(data.Data, int32(n))
+			return nil
+		}, nil
+	case schemapb.DataType_Int64:
+		data := &schemapb.LongArray{Data: make([]int64, 0, rowNum)}
+		fieldData.Field = scalarField(&schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: data}})
+		return fieldData, func(value interface{}) error {
+			n, err := jsonInt(value, 64)
+			if err != nil {
+				return err
+			}
+			data.Data = append(data.Data, n)
+			return nil
+		}, nil
+	case schemapb.DataType_Float:
+		data := &schemapb.FloatArray{Data: make([]float32, 0, rowNum)}
+		fieldData.Field = scalarField(&schemapb.ScalarField{Data: &schemapb.ScalarField_FloatData{FloatData: data}})
+		return fieldData, func(value interface{}) error {
+			f, err := jsonFloat(value, 32)
+			if err != nil {
+				return err
+			}
+			data.Data = append(data.Data, float32(f))
+			return nil
+		}, nil
+	case schemapb.DataType_Double:
+		data := &schemapb.DoubleArray{Data: make([]float64, 0, rowNum)}
+		fieldData.Field = scalarField(&schemapb.ScalarField{Data: &schemapb.ScalarField_DoubleData{DoubleData: data}})
+		return fieldData, func(value interface{}) error {
+			f, err := jsonFloat(value, 64)
+			if err != nil {
+				return err
+			}
+			data.Data = append(data.Data, f)
+			return nil
+		}, nil
+	case schemapb.DataType_FloatVector:
+		dim, err := fieldDim(field)
+		if err != nil {
+			return nil, nil, err
+		}
+		data := &schemapb.FloatArray{Data: make([]float32, 0, rowNum*dim)}
+		fieldData.Field = &schemapb.FieldData_Vectors{
+			Vectors: &schemapb.VectorField{
+				Dim:  int64(dim),
+				Data: &schemapb.VectorField_FloatVector{FloatVector: data},
+			},
+		}
+		return fieldData, func(value interface{}) error {
+			elems, err := jsonArray(value, dim)
+			if err != nil {
+				return err
+			}
+			vector := make([]float32, 0, dim)
+			for i, elem := range elems {
+				f, err := jsonFloat(elem, 32)
+				if err != nil {
+					return fmt.Errorf("element %d %v", i, err)
+				}
+				vector = append(vector, float32(f))
+			}
+			data.Data = append(data.Data, vector...)
+			return nil
+		}, nil
+	case schemapb.DataType_BinaryVector:
+		dim, err := fieldDim(field)
+		if err != nil {
+			return nil, nil, err
+		}
+		data := &schemapb.VectorField_BinaryVector{BinaryVector: make([]byte, 0, rowNum*dim/8)}
+		fieldData.Field = &schemapb.FieldData_Vectors{
+			Vectors: &schemapb.VectorField{
+				Dim:  int64(dim),
+				Data: data,
+			},
+		}
+		return fieldData, func(value interface{}) error {
+			elems, err := jsonArray(value, dim/8)
+			if err != nil {
+				return err
+			}
+			vector := make([]byte, 0, dim/8)
+			for i, elem := range elems {
+				n, err := jsonInt(elem, 16)
+				if err != nil || n < 0 || n > math.MaxUint8 {
+					return fmt.Errorf("element %d expects a byte", i)
+				}
+				vector = append(vector, byte(n))
+			}
+			data.BinaryVector = append(data.BinaryVector, vector...)
+			return nil
+		}, nil
+	default:
+		return nil, nil, fmt.Errorf("data type %s of field %s is not supported", field.DataType.String(), field.Name)
+	}
+}
+
+func jsonInt(value interface{}, bits int) (int64, error) {
+	number, ok := value.(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("expects an integer, got %s", jsonTypeName(value))
+	}
+	n, err := strconv.ParseInt(number.String(), 10, bits)
+	if err != nil {
+		return 0, fmt.Errorf("expects a %d-bit integer, got %s", bits, number)
+	}
+	return n, nil
+}
+
+func jsonFloat(value interface{}, bits int) (float64, error) {
+	number, ok := value.(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("expects a number, got %s", jsonTypeName(value))
+	}
+	f, err := strconv.ParseFloat(number.String(), bits)
+	if err != nil {
+		return 0, fmt.Errorf("expects a %d-bit float, got %s", bits, number)
+	}
+	return f, nil
+}
+
+// jsonArray returns the elements of a JSON array of length n
+func jsonArray(value interface{}, n int) ([]interface{}, error) {
+	elems, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expects an array, got %s", jsonTypeName(value))
+	}
+	if len(elems) != n {
+		return nil, fmt.Errorf("expects %d elements, got %d", n, len(elems))
+	}
+	return elems, nil
+}
+
+func jsonTypeName(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "bool"
+	case json.Number:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
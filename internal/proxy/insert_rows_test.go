@@ -0,0 +1,109 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func TestRowsToFieldsData(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true, AutoID: true},
+			{FieldID: 101, Name: "bool", DataType: schemapb.DataType_Bool},
+			{FieldID: 102, Name: "int8", DataType: schemapb.DataType_Int8},
+			{FieldID: 103, Name: "int64", DataType: schemapb.DataType_Int64},
+			{FieldID: 104, Name: "float", DataType: schemapb.DataType_Float},
+			{FieldID: 105, Name: "double", DataType: schemapb.DataType_Double},
+			{FieldID: 106, Name: "fvec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}}},
+			{FieldID: 107, Name: "bvec", DataType: schemapb.DataType_BinaryVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "16"}}},
+		},
+	}
+
+	t.Run("convert", func(t *testing.T) {
+		rows := [][]byte{
+			[]byte(`{"bool": true, "int8": -1, "int64": 9007199254740993, "float": 0.5, "double": 1.5, "fvec": [1, 2], "bvec": [0, 255]}`),
+			[]byte(`{"bool": false, "int8": 127, "int64": -2, "float": 1, "double": 3, "fvec": [-1, 0.25], "bvec": [1, 2]}`),
+		}
+		fieldsData, errIndex, errReasons, err := rowsToFieldsData(schema, rows)
+		assert.Nil(t, err)
+		assert.Nil(t, errIndex)
+		assert.Nil(t, errReasons)
+
+		// the autoID field is not converted
+		assert.Equal(t, 7, len(fieldsData))
+		for i, fieldData := range fieldsData {
+			assert.Equal(t, schema.Fields[i+1].FieldID, fieldData.FieldId)
+			assert.Equal(t, schema.Fields[i+1].Name, fieldData.FieldName)
+		}
+		assert.Equal(t, []bool{true, false}, fieldsData[0].GetScalars().GetBoolData().Data)
+		assert.Equal(t, []int32{-1, 127}, fieldsData[1].GetScalars().GetIntData().Data)
+		assert.Equal(t, []int64{9007199254740993, -2}, fieldsData[2].GetScalars().GetLongData().Data)
+		assert.Equal(t, []float32{0.5, 1}, fieldsData[3].GetScalars().GetFloatData().Data)
+		assert.Equal(t, []float64{1.5, 3}, fieldsData[4].GetScalars().GetDoubleData().Data)
+		assert.Equal(t, int64(2), fieldsData[5].GetVectors().Dim)
+		assert.Equal(t, []float32{1, 2, -1, 0.25}, fieldsData[5].GetVectors().GetFloatVector().Data)
+		assert.Equal(t, int64(16), fieldsData[6].GetVectors().Dim)
+		assert.Equal(t, []byte{0, 255, 1, 2}, fieldsData[6].GetVectors().GetBinaryVector())
+	})
+
+	t.Run("row errors", func(t *testing.T) {
+		valid := `{"bool": true, "int8": 1, "int64": 1, "float": 1, "double": 1, "fvec": [1, 2], "bvec": [0, 1]}`
+		rows := [][]byte{
+			[]byte(valid),
+			[]byte(`{"bool": 1, "int8": 128, "int64": 1.5, "float": "1", "double": 1, "fvec": [1], "bvec": [0, 256]}`),
+			[]byte(valid),
+			[]byte(`{"pk": 1, "unknown": 1, "bool": true, "int8": 1, "int64": 1, "float": 1, "double": null, "fvec": [1, "2"], "bvec": [0, 1]}`),
+			[]byte(`[1, 2]`),
+		}
+		fieldsData, errIndex, errReasons, err := rowsToFieldsData(schema, rows)
+		assert.Nil(t, err)
+		assert.Nil(t, fieldsData)
+		assert.Equal(t, []uint32{1, 3, 4}, errIndex)
+		assert.Equal(t, 3, len(errReasons))
+
+		assert.Equal(t, "field bool: expects a bool, got number; "+
+			"field int8: expects a 8-bit integer, got 128; "+
+			"field int64: expects a 64-bit integer, got 1.5; "+
+			"field float: expects a number, got string; "+
+			"field fvec: expects 2 elements, got 1; "+
+			"field bvec: element 1 expects a byte", errReasons[0])
+		assert.Equal(t, "autoID field pk does not require data; "+
+			"field unknown does not exist; "+
+			"field double is missing; "+
+			"field fvec: element 1 expects a number, got string", errReasons[1])
+		assert.Contains(t, errReasons[2], "invalid JSON object")
+	})
+
+	t.Run("unsupported schema", func(t *testing.T) {
+		_, _, _, err := rowsToFieldsData(&schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "str", DataType: schemapb.DataType_String},
+			},
+		}, [][]byte{[]byte(`{"str": "a"}`)})
+		assert.NotNil(t, err)
+
+		_, _, _, err = rowsToFieldsData(&schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "fvec", DataType: schemapb.DataType_FloatVector},
+			},
+		}, [][]byte{[]byte(`{"fvec": [1]}`)})
+		assert.NotNil(t, err)
+	})
+}
@@ -28,14 +28,14 @@ import (
 )
 
 type Cache interface {
-	GetCollectionID(ctx context.Context, collectionName string) (typeutil.UniqueID, error)
-	GetCollectionInfo(ctx context.Context, collectionName string) (*collectionInfo, error)
-	GetPartitionID(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error)
-	GetPartitions(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error)
-	GetPartitionInfo(ctx context.Context, collectionName string, partitionName string) (*partitionInfo, error)
-	GetCollectionSchema(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error)
-	RemoveCollection(ctx context.Context, collectionName string)
-	RemovePartition(ctx context.Context, collectionName string, partitionName string)
+	GetCollectionID(ctx context.Context, dbName string, collectionName string) (typeutil.UniqueID, error)
+	GetCollectionInfo(ctx context.Context, dbName string, collectionName string) (*collectionInfo, error)
+	GetPartitionID(ctx context.Context, dbName string, collectionName string, partitionName string) (typeutil.UniqueID, error)
+	GetPartitions(ctx context.Context, dbName string, collectionName string) (map[string]typeutil.UniqueID, error)
+	GetPartitionInfo(ctx context.Context, dbName string, collectionName string, partitionName string) (*partitionInfo, error)
+	GetCollectionSchema(ctx context.Context, dbName string, collectionName string) (*schemapb.CollectionSchema, error)
+	RemoveCollection(ctx context.Context, dbName string, collectionName string)
+	RemovePartition(ctx context.Context, dbName string, collectionName string, partitionName string)
 }
 
 type collectionInfo struct {
@@ -44,6 +44,7 @@ type collectionInfo struct {
 	partInfo            map[string]*partitionInfo
 	createdTimestamp    uint64
 	createdUtcTimestamp uint64
+	consistencyLevel    commonpb.ConsistencyLevel
 }
 
 type partitionInfo struct {
@@ -55,7 +56,7 @@ type partitionInfo struct {
 type MetaCache struct {
 	client types.RootCoord
 
-	collInfo map[string]*collectionInfo
+	collInfo map[string]map[string]*collectionInfo // database name -> collection name -> collection info
 	mu       sync.RWMutex
 }
 
@@ -73,24 +74,36 @@ func InitMetaCache(client types.RootCoord) error {
 func NewMetaCache(client types.RootCoord) (*MetaCache, error) {
 	return &MetaCache{
 		client:   client,
-		collInfo: map[string]*collectionInfo{},
+		collInfo: map[string]map[string]*collectionInfo{},
 	}, nil
 }
 
-func (m *MetaCache) GetCollectionID(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+// getDBName maps the empty database name of legacy requests to the default database
+func getDBName(dbName string) string {
+	if dbName == "" {
+		return Params.DefaultDatabaseName
+	}
+	return dbName
+}
+
+func (m *MetaCache) getCollInfo(dbName string, collectionName string) (*collectionInfo, bool) {
+	collInfo, ok := m.collInfo[getDBName(dbName)][collectionName]
+	return collInfo, ok
+}
+
+func (m *MetaCache) GetCollectionID(ctx context.Context, dbName string, collectionName string) (typeutil.UniqueID, error) {
 	m.mu.RLock()
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollInfo(dbName, collectionName)
 
 	if !ok {
 		m.mu.RUnlock()
-		coll, err := m.describeCollection(ctx, collectionName)
+		coll, err := m.describeCollection(ctx, dbName, collectionName)
 		if err != nil {
 			return 0, err
 		}
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		m.updateCollection(coll, collectionName)
-		collInfo = m.collInfo[collectionName]
+		collInfo = m.updateCollection(coll, dbName, collectionName)
 		return collInfo.collID, nil
 	}
 	defer m.mu.RUnlock()
@@ -98,21 +111,20 @@ func (m *MetaCache) GetCollectionID(ctx context.Context, collectionName string)
 	return collInfo.collID, nil
 }
 
-func (m *MetaCache) GetCollectionInfo(ctx context.Context, collectionName string) (*collectionInfo, error) {
+func (m *MetaCache) GetCollectionInfo(ctx context.Context, dbName string, collectionName string) (*collectionInfo, error) {
 	m.mu.RLock()
 	var collInfo *collectionInfo
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollInfo(dbName, collectionName)
 	m.mu.RUnlock()
 
 	if !ok {
-		coll, err := m.describeCollection(ctx, collectionName)
+		coll, err := m.describeCollection(ctx, dbName, collectionName)
 		if err != nil {
 			return nil, err
 		}
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		m.updateCollection(coll, collectionName)
-		collInfo = m.collInfo[collectionName]
+		collInfo = m.updateCollection(coll, dbName, collectionName)
 	}
 
 	return &collectionInfo{
@@ -121,23 +133,23 @@ func (m *MetaCache) GetCollectionInfo(ctx context.Context, collectionName string
 		partInfo:            collInfo.partInfo,
 		createdTimestamp:    collInfo.createdTimestamp,
 		createdUtcTimestamp: collInfo.createdUtcTimestamp,
+		consistencyLevel:    collInfo.consistencyLevel,
 	}, nil
 }
 
-func (m *MetaCache) GetCollectionSchema(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+func (m *MetaCache) GetCollectionSchema(ctx context.Context, dbName string, collectionName string) (*schemapb.CollectionSchema, error) {
 	m.mu.RLock()
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollInfo(dbName, collectionName)
 
 	if !ok {
 		m.mu.RUnlock()
-		coll, err := m.describeCollection(ctx, collectionName)
+		coll, err := m.describeCollection(ctx, dbName, collectionName)
 		if err != nil {
 			return nil, err
 		}
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		m.updateCollection(coll, collectionName)
-		collInfo = m.collInfo[collectionName]
+		collInfo = m.updateCollection(coll, dbName, collectionName)
 		return collInfo.schema, nil
 	}
 	defer m.mu.RUnlock()
@@ -145,34 +157,41 @@ func (m *MetaCache) GetCollectionSchema(ctx context.Context, collectionName stri
 	return collInfo.schema, nil
 }
 
-func (m *MetaCache) updateCollection(coll *milvuspb.DescribeCollectionResponse, collectionName string) {
-	_, ok := m.collInfo[collectionName]
+func (m *MetaCache) updateCollection(coll *milvuspb.DescribeCollectionResponse, dbName string, collectionName string) *collectionInfo {
+	dbName = getDBName(dbName)
+	if _, ok := m.collInfo[dbName]; !ok {
+		m.collInfo[dbName] = map[string]*collectionInfo{}
+	}
+	collInfo, ok := m.collInfo[dbName][collectionName]
 	if !ok {
-		m.collInfo[collectionName] = &collectionInfo{}
+		collInfo = &collectionInfo{}
+		m.collInfo[dbName][collectionName] = collInfo
 	}
-	m.collInfo[collectionName].schema = coll.Schema
-	m.collInfo[collectionName].collID = coll.CollectionID
-	m.collInfo[collectionName].createdTimestamp = coll.CreatedTimestamp
-	m.collInfo[collectionName].createdUtcTimestamp = coll.CreatedUtcTimestamp
+	collInfo.schema = coll.Schema
+	collInfo.collID = coll.CollectionID
+	collInfo.createdTimestamp = coll.CreatedTimestamp
+	collInfo.createdUtcTimestamp = coll.CreatedUtcTimestamp
+	collInfo.consistencyLevel = coll.ConsistencyLevel
+	return collInfo
 }
 
-func (m *MetaCache) GetPartitionID(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error) {
-	partInfo, err := m.GetPartitionInfo(ctx, collectionName, partitionName)
+func (m *MetaCache) GetPartitionID(ctx context.Context, dbName string, collectionName string, partitionName string) (typeutil.UniqueID, error) {
+	partInfo, err := m.GetPartitionInfo(ctx, dbName, collectionName, partitionName)
 	if err != nil {
 		return 0, err
 	}
 	return partInfo.partitionID, nil
 }
 
-func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error) {
-	_, err := m.GetCollectionID(ctx, collectionName)
+func (m *MetaCache) GetPartitions(ctx context.Context, dbName string, collectionName string) (map[string]typeutil.UniqueID, error) {
+	_, err := m.GetCollectionID(ctx, dbName, collectionName)
 	if err != nil {
 		return nil, err
 	}
 
 	m.mu.RLock()
 
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollInfo(dbName, collectionName)
 	if !ok {
 		m.mu.RUnlock()
 		return nil, fmt.Errorf("can't find collection name:%s", collectionName)
@@ -181,7 +200,7 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 	if collInfo.partInfo == nil || len(collInfo.partInfo) == 0 {
 		m.mu.RUnlock()
 
-		partitions, err := m.showPartitions(ctx, collectionName)
+		partitions, err := m.showPartitions(ctx, dbName, collectionName)
 		if err != nil {
 			return nil, err
 		}
@@ -189,10 +208,9 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 		m.mu.Lock()
 		defer m.mu.Unlock()
 
-		m.updatePartitions(partitions, collectionName)
+		partInfo := m.updatePartitions(partitions, dbName, collectionName)
 
 		ret := make(map[string]typeutil.UniqueID)
-		partInfo := m.collInfo[collectionName].partInfo
 		for k, v := range partInfo {
 			ret[k] = v.partitionID
 		}
@@ -202,7 +220,7 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 	defer m.mu.RUnlock()
 
 	ret := make(map[string]typeutil.UniqueID)
-	partInfo := m.collInfo[collectionName].partInfo
+	partInfo := collInfo.partInfo
 	for k, v := range partInfo {
 		ret[k] = v.partitionID
 	}
@@ -210,15 +228,15 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 	return ret, nil
 }
 
-func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string, partitionName string) (*partitionInfo, error) {
-	_, err := m.GetCollectionID(ctx, collectionName)
+func (m *MetaCache) GetPartitionInfo(ctx context.Context, dbName string, collectionName string, partitionName string) (*partitionInfo, error) {
+	_, err := m.GetCollectionID(ctx, dbName, collectionName)
 	if err != nil {
 		return nil, err
 	}
 
 	m.mu.RLock()
 
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollInfo(dbName, collectionName)
 	if !ok {
 		m.mu.RUnlock()
 		return nil, fmt.Errorf("can't find collection name:%s", collectionName)
@@ -229,7 +247,7 @@ func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string,
 	m.mu.RUnlock()
 
 	if !ok {
-		partitions, err := m.showPartitions(ctx, collectionName)
+		partitions, err := m.showPartitions(ctx, dbName, collectionName)
 		if err != nil {
 			return nil, err
 		}
@@ -237,10 +255,10 @@ func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string,
 		m.mu.Lock()
 		defer m.mu.Unlock()
 		log.Debug("proxy", zap.Any("GetPartitionID:partitions before update", partitions), zap.Any("collectionName", collectionName))
-		m.updatePartitions(partitions, collectionName)
+		partInfos := m.updatePartitions(partitions, dbName, collectionName)
 		log.Debug("proxy", zap.Any("GetPartitionID:partitions after update", partitions), zap.Any("collectionName", collectionName))
 
-		partInfo, ok = m.collInfo[collectionName].partInfo[partitionName]
+		partInfo, ok = partInfos[partitionName]
 		if !ok {
 			return nil, fmt.Errorf("partitionID of partitionName:%s can not be find", partitionName)
 		}
@@ -252,11 +270,12 @@ func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string,
 	}, nil
 }
 
-func (m *MetaCache) describeCollection(ctx context.Context, collectionName string) (*milvuspb.DescribeCollectionResponse, error) {
+func (m *MetaCache) describeCollection(ctx context.Context, dbName string, collectionName string) (*milvuspb.DescribeCollectionResponse, error) {
 	req := &milvuspb.DescribeCollectionRequest{
 		Base: &commonpb.MsgBase{
 			MsgType: commonpb.MsgType_DescribeCollection,
 		},
+		DbName:         dbName,
 		CollectionName: collectionName,
 	}
 	coll, err := m.client.DescribeCollection(ctx, req)
@@ -279,6 +298,7 @@ func (m *MetaCache) describeCollection(ctx context.Context, collectionName strin
 		PhysicalChannelNames: coll.PhysicalChannelNames,
 		CreatedTimestamp:     coll.CreatedTimestamp,
 		CreatedUtcTimestamp:  coll.CreatedUtcTimestamp,
+		ConsistencyLevel:     coll.ConsistencyLevel,
 	}
 	for _, field := range coll.Schema.Fields {
 		if field.FieldID >= 100 { // TODO(dragondriver): use StartOfUserField to replace 100
@@ -288,11 +308,12 @@ func (m *MetaCache) describeCollection(ctx context.Context, collectionName strin
 	return resp, nil
 }
 
-func (m *MetaCache) showPartitions(ctx context.Context, collectionName string) (*milvuspb.ShowPartitionsResponse, error) {
+func (m *MetaCache) showPartitions(ctx context.Context, dbName string, collectionName string) (*milvuspb.ShowPartitionsResponse, error) {
 	req := &milvuspb.ShowPartitionsRequest{
 		Base: &commonpb.MsgBase{
 			MsgType: commonpb.MsgType_ShowPartitions,
 		},
+		DbName:         dbName,
 		CollectionName: collectionName,
 	}
 
@@ -312,14 +333,19 @@ func (m *MetaCache) showPartitions(ctx context.Context, collectionName string) (
 	return partitions, nil
 }
 
-func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse, collectionName string) {
-	_, ok := m.collInfo[collectionName]
+func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse, dbName string, collectionName string) map[string]*partitionInfo {
+	dbName = getDBName(dbName)
+	if _, ok := m.collInfo[dbName]; !ok {
+		m.collInfo[dbName] = map[string]*collectionInfo{}
+	}
+	collInfo, ok := m.collInfo[dbName][collectionName]
 	if !ok {
-		m.collInfo[collectionName] = &collectionInfo{
+		collInfo = &collectionInfo{
 			partInfo: map[string]*partitionInfo{},
 		}
+		m.collInfo[dbName][collectionName] = collInfo
 	}
-	partInfo := m.collInfo[collectionName].partInfo
+	partInfo := collInfo.partInfo
 	if partInfo == nil {
 		partInfo = map[string]*partitionInfo{}
 	}
@@ -333,23 +359,24 @@ func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse
 			}
 		}
 	}
-	m.collInfo[collectionName].partInfo = partInfo
+	collInfo.partInfo = partInfo
+	return partInfo
 }
 
-func (m *MetaCache) RemoveCollection(ctx context.Context, collectionName string) {
+func (m *MetaCache) RemoveCollection(ctx context.Context, dbName string, collectionName string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	delete(m.collInfo, collectionName)
+	delete(m.collInfo[getDBName(dbName)], collectionName)
 }
 
-func (m *MetaCache) RemovePartition(ctx context.Context, collectionName, partitionName string) {
+func (m *MetaCache) RemovePartition(ctx context.Context, dbName string, collectionName, partitionName string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	_, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollInfo(dbName, collectionName)
 	if !ok {
 		return
 	}
-	partInfo := m.collInfo[collectionName].partInfo
+	partInfo := collInfo.partInfo
 	if partInfo == nil {
 		return
 	}
@@ -75,18 +75,18 @@ func TestMetaCache_GetCollection(t *testing.T) {
 	err := InitMetaCache(client)
 	assert.Nil(t, err)
 
-	id, err := globalMetaCache.GetCollectionID(ctx, "collection1")
+	id, err := globalMetaCache.GetCollectionID(ctx, "", "collection1")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(1))
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, "", "collection1")
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
 		AutoID: true,
 	})
-	id, err = globalMetaCache.GetCollectionID(ctx, "collection2")
+	id, err = globalMetaCache.GetCollectionID(ctx, "", "collection2")
 	assert.NotNil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(0))
-	schema, err = globalMetaCache.GetCollectionSchema(ctx, "collection2")
+	schema, err = globalMetaCache.GetCollectionSchema(ctx, "", "collection2")
 	assert.NotNil(t, err)
 	assert.Nil(t, schema)
 }
@@ -97,19 +97,19 @@ func TestMetaCache_GetPartitionID(t *testing.T) {
 	err := InitMetaCache(client)
 	assert.Nil(t, err)
 
-	id, err := globalMetaCache.GetPartitionID(ctx, "collection1", "par1")
+	id, err := globalMetaCache.GetPartitionID(ctx, "", "collection1", "par1")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(1))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection1", "par2")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "collection1", "par2")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(2))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection1", "par3")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "collection1", "par3")
 	assert.NotNil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(0))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection2", "par3")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "collection2", "par3")
 	assert.NotNil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(0))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection2", "par4")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "collection2", "par4")
 	assert.NotNil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(0))
 }
@@ -58,8 +58,26 @@ type ParamTable struct {
 	MaxFieldNum                int64
 	MaxDimension               int64
 	DefaultPartitionName       string
+	DefaultDatabaseName        string
 	DefaultIndexName           string
 
+	BoundedConsistencyStaleness time.Duration
+	SessionTsTTL                time.Duration
+
+	ReplicaTimeout     time.Duration
+	ShardLeaderTimeout time.Duration
+
+	// rate limits of dml requests, 0 means unlimited
+	MaxRowsPerSecond               float64
+	MaxBytesPerSecond              float64
+	MaxRequestsPerSecond           float64
+	MaxCollectionRowsPerSecond     float64
+	MaxCollectionBytesPerSecond    float64
+	MaxCollectionRequestsPerSecond float64
+	MaxTimeTickLag                 time.Duration
+
+	AuthorizationEnabled bool
+
 	PulsarMaxMessageSize int
 	Log                  log.Config
 	RoleName             string
@@ -93,7 +111,14 @@ func (pt *ParamTable) initParams() {
 	pt.initMaxFieldNum()
 	pt.initMaxDimension()
 	pt.initDefaultPartitionName()
+	pt.initDefaultDatabaseName()
 	pt.initDefaultIndexName()
+	pt.initBoundedConsistencyStaleness()
+	pt.initSessionTsTTL()
+	pt.initReplicaTimeout()
+	pt.initShardLeaderTimeout()
+	pt.initRateLimits()
+	pt.initAuthorizationEnabled()
 
 	pt.initPulsarMaxMessageSize()
 	pt.initRoleName()
@@ -196,6 +221,14 @@ func (pt *ParamTable) initDefaultPartitionName() {
 	pt.DefaultPartitionName = name
 }
 
+func (pt *ParamTable) initDefaultDatabaseName() {
+	name, err := pt.Load("common.defaultDatabaseName")
+	if err != nil {
+		panic(err)
+	}
+	pt.DefaultDatabaseName = name
+}
+
 func (pt *ParamTable) initDefaultIndexName() {
 	name, err := pt.Load("common.defaultIndexName")
 	if err != nil {
@@ -204,6 +237,41 @@ func (pt *ParamTable) initDefaultIndexName() {
 	pt.DefaultIndexName = name
 }
 
+func (pt *ParamTable) initBoundedConsistencyStaleness() {
+	staleness := pt.ParseInt64("proxy.consistency.boundedStaleness")
+	pt.BoundedConsistencyStaleness = time.Duration(staleness) * time.Millisecond
+}
+
+func (pt *ParamTable) initSessionTsTTL() {
+	ttl := pt.ParseInt64("proxy.consistency.sessionTTL")
+	pt.SessionTsTTL = time.Duration(ttl) * time.Second
+}
+
+func (pt *ParamTable) initReplicaTimeout() {
+	timeout := pt.ParseInt64("proxy.replicaTimeout")
+	pt.ReplicaTimeout = time.Duration(timeout) * time.Millisecond
+}
+
+func (pt *ParamTable) initShardLeaderTimeout() {
+	timeout := pt.ParseInt64("proxy.shardLeaderTimeout")
+	pt.ShardLeaderTimeout = time.Duration(timeout) * time.Millisecond
+}
+
+func (pt *ParamTable) initRateLimits() {
+	pt.MaxRowsPerSecond = pt.ParseFloat("proxy.rateLimit.maxRowsPerSecond")
+	pt.MaxBytesPerSecond = pt.ParseFloat("proxy.rateLimit.maxBytesPerSecond")
+	pt.MaxRequestsPerSecond = pt.ParseFloat("proxy.rateLimit.maxRequestsPerSecond")
+	pt.MaxCollectionRowsPerSecond = pt.ParseFloat("proxy.rateLimit.collection.maxRowsPerSecond")
+	pt.MaxCollectionBytesPerSecond = pt.ParseFloat("proxy.rateLimit.collection.maxBytesPerSecond")
+	pt.MaxCollectionRequestsPerSecond = pt.ParseFloat("proxy.rateLimit.collection.maxRequestsPerSecond")
+	lag := pt.ParseInt64("proxy.rateLimit.maxTimeTickLag")
+	pt.MaxTimeTickLag = time.Duration(lag) * time.Millisecond
+}
+
+func (pt *ParamTable) initAuthorizationEnabled() {
+	pt.AuthorizationEnabled = pt.ParseBool("common.security.authorizationEnabled", false)
+}
+
 func (pt *ParamTable) initPulsarMaxMessageSize() {
 	// pulsarHost, err := pt.Load("pulsar.address")
 	// if err != nil {
@@ -56,6 +56,36 @@ func TestParamTable(t *testing.T) {
 		t.Logf("MaxFieldNum: %d", Params.MaxFieldNum)
 	})
 
+	t.Run("BoundedConsistencyStaleness", func(t *testing.T) {
+		t.Logf("BoundedConsistencyStaleness: %v", Params.BoundedConsistencyStaleness)
+	})
+
+	t.Run("SessionTsTTL", func(t *testing.T) {
+		t.Logf("SessionTsTTL: %v", Params.SessionTsTTL)
+	})
+
+	t.Run("ReplicaTimeout", func(t *testing.T) {
+		t.Logf("ReplicaTimeout: %v", Params.ReplicaTimeout)
+	})
+
+	t.Run("ShardLeaderTimeout", func(t *testing.T) {
+		t.Logf("ShardLeaderTimeout: %v", Params.ShardLeaderTimeout)
+	})
+
+	t.Run("RateLimits", func(t *testing.T) {
+		t.Logf("MaxRowsPerSecond: %v", Params.MaxRowsPerSecond)
+		t.Logf("MaxBytesPerSecond: %v", Params.MaxBytesPerSecond)
+		t.Logf("MaxRequestsPerSecond: %v", Params.MaxRequestsPerSecond)
+		t.Logf("MaxCollectionRowsPerSecond: %v", Params.MaxCollectionRowsPerSecond)
+		t.Logf("MaxCollectionBytesPerSecond: %v", Params.MaxCollectionBytesPerSecond)
+		t.Logf("MaxCollectionRequestsPerSecond: %v", Params.MaxCollectionRequestsPerSecond)
+		t.Logf("MaxTimeTickLag: %v", Params.MaxTimeTickLag)
+	})
+
+	t.Run("AuthorizationEnabled", func(t *testing.T) {
+		t.Logf("AuthorizationEnabled: %v", Params.AuthorizationEnabled)
+	})
+
 	t.Run("MaxDimension", func(t *testing.T) {
 		t.Logf("MaxDimension: %d", Params.MaxDimension)
 	})
@@ -64,6 +94,10 @@ func TestParamTable(t *testing.T) {
 		t.Logf("DefaultPartitionName: %s", Params.DefaultPartitionName)
 	})
 
+	t.Run("DefaultDatabaseName", func(t *testing.T) {
+		t.Logf("DefaultDatabaseName: %s", Params.DefaultDatabaseName)
+	})
+
 	t.Run("DefaultIndexName", func(t *testing.T) {
 		t.Logf("DefaultIndexName: %s", Params.DefaultIndexName)
 	})
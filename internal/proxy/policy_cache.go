@@ -0,0 +1,163 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/credential"
+)
+
+// policyCache keeps the credentials and grants of RootCoord, it's reloaded as a whole
+// whenever RootCoord tells proxy that any of them changed
+type policyCache struct {
+	client types.RootCoord
+
+	mu       sync.RWMutex
+	users    map[string]*rootcoordpb.UserPolicy // username -> user
+	grants   map[string][]*milvuspb.GrantEntity // role name -> grants
+	verified map[string][sha256.Size]byte       // username -> sha256 of the last verified password, bcrypt is too slow for every request
+}
+
+var globalPolicyCache *policyCache
+
+func InitPolicyCache(client types.RootCoord) error {
+	globalPolicyCache = newPolicyCache(client)
+	return globalPolicyCache.refresh(context.Background())
+}
+
+func newPolicyCache(client types.RootCoord) *policyCache {
+	return &policyCache{
+		client:   client,
+		users:    make(map[string]*rootcoordpb.UserPolicy),
+		grants:   make(map[string][]*milvuspb.GrantEntity),
+		verified: make(map[string][sha256.Size]byte),
+	}
+}
+
+func (pc *policyCache) refresh(ctx context.Context) error {
+	resp, err := pc.client.ListPolicy(ctx, &rootcoordpb.ListPolicyRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_ListPolicy,
+			SourceID: Params.ProxyID,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(resp.Status.Reason)
+	}
+
+	users := make(map[string]*rootcoordpb.UserPolicy, len(resp.Users))
+	for _, user := range resp.Users {
+		users[user.Username] = user
+	}
+	grants := make(map[string][]*milvuspb.GrantEntity)
+	for _, grant := range resp.Grants {
+		grants[grant.RoleName] = append(grants[grant.RoleName], grant)
+	}
+
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	pc.users = users
+	pc.grants = grants
+	pc.verified = make(map[string][sha256.Size]byte)
+	log.Debug("refresh policy cache", zap.Int("users", len(users)), zap.Int("grants", len(resp.Grants)))
+	return nil
+}
+
+// authenticate checks whether password is the password of user username
+func (pc *policyCache) authenticate(username, password string) bool {
+	sum := sha256.Sum256([]byte(password))
+	pc.mu.RLock()
+	user, ok := pc.users[username]
+	verified, hit := pc.verified[username]
+	pc.mu.RUnlock()
+	if !ok {
+		return false
+	}
+	if hit && verified == sum {
+		return true
+	}
+	if !credential.VerifyPassword(password, user.EncryptedPassword) {
+		return false
+	}
+
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	// the user may be deleted or recreated during verifying
+	if pc.users[username] == user {
+		pc.verified[username] = sum
+	}
+	return true
+}
+
+// checkPrivilege checks whether any role of user username is granted privilege on object objectName of database dbName,
+// the root user is allowed to do anything
+func (pc *policyCache) checkPrivilege(username, dbName, objectName, privilege string) bool {
+	if username == credential.RootUser {
+		return true
+	}
+	pc.mu.RLock()
+	defer pc.mu.RUnlock()
+
+	user, ok := pc.users[username]
+	if !ok {
+		return false
+	}
+	match := func(granted, requested string) bool {
+		return granted == credential.AnyWord || granted == requested
+	}
+	for _, role := range user.Roles {
+		for _, grant := range pc.grants[role] {
+			if match(grant.DbName, dbName) && match(grant.ObjectName, objectName) && match(grant.Privilege, privilege) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// checkPrivilege returns a PermissionDenied status if the authenticated user of ctx isn't allowed to do msgType
+// on object objectName of database dbName, nil otherwise. Pass credential.AnyWord as objectName for requests
+// not targeting a single object, only grants on all objects allow them.
+func checkPrivilege(ctx context.Context, msgType commonpb.MsgType, dbName string, objectName string) *commonpb.Status {
+	if !Params.AuthorizationEnabled {
+		return nil
+	}
+	if dbName != credential.AnyWord {
+		dbName = getDBName(dbName)
+	}
+	privilege := commonpb.MsgType_name[int32(msgType)]
+	username, ok := getCurUser(ctx)
+	if !ok || globalPolicyCache == nil || !globalPolicyCache.checkPrivilege(username, dbName, objectName, privilege) {
+		log.Debug("permission denied", zap.String("username", username), zap.String("privilege", privilege),
+			zap.String("db", dbName), zap.String("object", objectName))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_PermissionDenied,
+			Reason:    fmt.Sprintf("user %s has no privilege %s on %s.%s", username, privilege, dbName, objectName),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/credential"
+)
+
+type mockPolicyRootCoord struct {
+	types.RootCoord
+	users  []*rootcoordpb.UserPolicy
+	grants []*milvuspb.GrantEntity
+}
+
+func (m *mockPolicyRootCoord) ListPolicy(ctx context.Context, req *rootcoordpb.ListPolicyRequest) (*rootcoordpb.ListPolicyResponse, error) {
+	return &rootcoordpb.ListPolicyResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Users:  m.users,
+		Grants: m.grants,
+	}, nil
+}
+
+func newMockPolicyRootCoord(t *testing.T) *mockPolicyRootCoord {
+	encrypted, err := credential.EncryptPassword("password1")
+	assert.Nil(t, err)
+	return &mockPolicyRootCoord{
+		users: []*rootcoordpb.UserPolicy{
+			{Username: "user1", EncryptedPassword: encrypted, Roles: []string{"role1"}},
+			{Username: "user2", EncryptedPassword: encrypted},
+		},
+		grants: []*milvuspb.GrantEntity{
+			{RoleName: "role1", DbName: Params.DefaultDatabaseName, ObjectName: "coll1", Privilege: "Search"},
+			{RoleName: "role1", DbName: "db1", ObjectName: credential.AnyWord, Privilege: credential.AnyWord},
+		},
+	}
+}
+
+func TestPolicyCache(t *testing.T) {
+	rc := newMockPolicyRootCoord(t)
+	pc := newPolicyCache(rc)
+	assert.Nil(t, pc.refresh(context.Background()))
+
+	assert.True(t, pc.authenticate("user1", "password1"))
+	assert.True(t, pc.authenticate("user1", "password1"))
+	assert.False(t, pc.authenticate("user1", "password2"))
+	assert.False(t, pc.authenticate("user3", "password1"))
+
+	assert.True(t, pc.checkPrivilege("user1", Params.DefaultDatabaseName, "coll1", "Search"))
+	assert.False(t, pc.checkPrivilege("user1", Params.DefaultDatabaseName, "coll1", "Insert"))
+	assert.False(t, pc.checkPrivilege("user1", Params.DefaultDatabaseName, "coll2", "Search"))
+	assert.True(t, pc.checkPrivilege("user1", "db1", "coll2", "Insert"))
+	assert.False(t, pc.checkPrivilege("user2", Params.DefaultDatabaseName, "coll1", "Search"))
+	assert.False(t, pc.checkPrivilege("user3", Params.DefaultDatabaseName, "coll1", "Search"))
+	assert.True(t, pc.checkPrivilege(credential.RootUser, Params.DefaultDatabaseName, "coll1", "Insert"))
+
+	// deleted user can't login anymore
+	rc.users = rc.users[1:]
+	assert.Nil(t, pc.refresh(context.Background()))
+	assert.False(t, pc.authenticate("user1", "password1"))
+	assert.True(t, pc.authenticate("user2", "password1"))
+}
+
+func TestAuthenticationInterceptor(t *testing.T) {
+	enabled := Params.AuthorizationEnabled
+	defer func() {
+		Params.AuthorizationEnabled = enabled
+	}()
+
+	Params.AuthorizationEnabled = false
+	ctx, err := AuthenticationInterceptor(context.Background())
+	assert.Nil(t, err)
+	assert.Nil(t, checkPrivilege(ctx, commonpb.MsgType_Search, "", "coll1"))
+
+	Params.AuthorizationEnabled = true
+	globalPolicyCache = newPolicyCache(newMockPolicyRootCoord(t))
+	assert.Nil(t, globalPolicyCache.refresh(context.Background()))
+
+	_, err = AuthenticationInterceptor(context.Background())
+	assert.NotNil(t, err)
+	md := metadata.Pairs(credential.HeaderAuthorize, credential.EncodeBasicAuth("user1", "password2"))
+	_, err = AuthenticationInterceptor(metadata.NewIncomingContext(context.Background(), md))
+	assert.NotNil(t, err)
+
+	md = metadata.Pairs(credential.HeaderAuthorize, credential.EncodeBasicAuth("user1", "password1"))
+	ctx, err = AuthenticationInterceptor(metadata.NewIncomingContext(context.Background(), md))
+	assert.Nil(t, err)
+	username, ok := getCurUser(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, "user1", username)
+
+	assert.Nil(t, checkPrivilege(ctx, commonpb.MsgType_Search, "", "coll1"))
+	st := checkPrivilege(ctx, commonpb.MsgType_Insert, "", "coll1")
+	assert.NotNil(t, st)
+	assert.Equal(t, commonpb.ErrorCode_PermissionDenied, st.ErrorCode)
+	assert.NotNil(t, checkPrivilege(ctx, commonpb.MsgType_CreateCredential, credential.AnyWord, credential.AnyWord))
+	assert.NotNil(t, checkPrivilege(context.Background(), commonpb.MsgType_Search, "", "coll1"))
+}
+
+func TestProxy_RetrievePrivilege(t *testing.T) {
+	enabled := Params.AuthorizationEnabled
+	defer func() {
+		Params.AuthorizationEnabled = enabled
+	}()
+	Params.AuthorizationEnabled = true
+	globalPolicyCache = newPolicyCache(newMockPolicyRootCoord(t))
+	assert.Nil(t, globalPolicyCache.refresh(context.Background()))
+
+	md := metadata.Pairs(credential.HeaderAuthorize, credential.EncodeBasicAuth("user1", "password1"))
+	ctx, err := AuthenticationInterceptor(metadata.NewIncomingContext(context.Background(), md))
+	assert.Nil(t, err)
+
+	node := &Proxy{}
+	node.UpdateStateCode(internalpb.StateCode_Healthy)
+
+	// user1 can only search coll1, vectors can't be retrieved by ids
+	resp, err := node.CalcDistance(ctx, &milvuspb.CalcDistanceRequest{
+		OpLeft: &milvuspb.VectorsArray{
+			Array: &milvuspb.VectorsArray_IdArray{
+				IdArray: &milvuspb.VectorIDs{CollectionName: "coll1", FieldName: "vec"},
+			},
+		},
+		Params: []*commonpb.KeyValuePair{{Key: "metric", Value: "L2"}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_PermissionDenied, resp.Status.ErrorCode)
+
+	metrics, err := node.GetMetrics(ctx, &milvuspb.GetMetricsRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, commonpb.ErrorCode_PermissionDenied, metrics.Status.ErrorCode)
+}
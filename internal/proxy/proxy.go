@@ -42,6 +42,8 @@ type Timestamp = typeutil.Timestamp
 
 const sendTimeTickMsgInterval = 200 * time.Millisecond
 const channelMgrTickerInterval = 100 * time.Millisecond
+const loadingProgressCheckInterval = 500 * time.Millisecond
+const flushStateCheckInterval = 500 * time.Millisecond
 
 type Proxy struct {
 	ctx    context.Context
@@ -61,6 +63,16 @@ type Proxy struct {
 
 	chMgr channelsMgr
 
+	// clients of the query nodes leading the shards, search and query requests are sent to them directly
+	shardMgr *shardClientMgr
+
+	// last write timestamps of client sessions, for Session consistency
+	sessionTs *sessionTsTracker
+
+	// limits of the dml requests, and the lag of the data nodes and query nodes to throttle them
+	rateLimiter *rateLimiter
+	timeTickLag *timeTickLagChecker
+
 	sched *TaskScheduler
 	tick  *timeTick
 
@@ -87,6 +99,7 @@ func NewProxy(ctx context.Context, factory msgstream.Factory) (*Proxy, error) {
 		cancel:    cancel,
 		msFactory: factory,
 	}
+	node.shardMgr = newShardClientMgr(ctx1, defaultQueryNodeCreator)
 	node.UpdateStateCode(internalpb.StateCode_Abnormal)
 	log.Debug("Proxy", zap.Any("State", node.stateCode.Load()))
 	return node, nil
@@ -184,6 +197,13 @@ func (node *Proxy) Init() error {
 	node.segAssigner = segAssigner
 	node.segAssigner.PeerID = Params.ProxyID
 
+	node.sessionTs = newSessionTsTracker(Params.SessionTsTTL)
+
+	node.rateLimiter = newRateLimiter(
+		rateLimits{Params.MaxRowsPerSecond, Params.MaxBytesPerSecond, Params.MaxRequestsPerSecond},
+		rateLimits{Params.MaxCollectionRowsPerSecond, Params.MaxCollectionBytesPerSecond, Params.MaxCollectionRequestsPerSecond})
+	node.timeTickLag = newTimeTickLagChecker(time.Now())
+
 	getDmlChannelsFunc := func(collectionID UniqueID) (map[vChan]pChan, error) {
 		req := &milvuspb.DescribeCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -343,6 +363,13 @@ func (node *Proxy) Start() error {
 	}
 	log.Debug("init global meta cache ...")
 
+	if Params.AuthorizationEnabled {
+		if err = InitPolicyCache(node.rootCoord); err != nil {
+			return err
+		}
+		log.Debug("init global policy cache ...")
+	}
+
 	node.sched.Start()
 	log.Debug("start scheduler ...")
 
@@ -363,6 +390,13 @@ func (node *Proxy) Start() error {
 
 	node.sendChannelsTimeTickLoop()
 
+	if Params.MaxTimeTickLag > 0 {
+		if err = node.watchTimeTickLag(); err != nil {
+			return err
+		}
+		log.Debug("start watching time tick lag")
+	}
+
 	// Start callbacks
 	for _, cb := range node.startCallbacks {
 		cb()
@@ -395,6 +429,9 @@ func (node *Proxy) Stop() error {
 			return err
 		}
 	}
+	if node.shardMgr != nil {
+		node.shardMgr.close()
+	}
 
 	node.wg.Wait()
 
@@ -0,0 +1,354 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+// timeTickExpiration is how long the time tick reported by a data node or the tSafe reported by a query node is
+// trusted, data nodes publish the time ticks of their channels as they consume them and query nodes publish their
+// statistics every second, the ones not reporting for a while are considered offline or released
+const timeTickExpiration = time.Minute
+
+// tokenBucket allows limit tokens per second on average, with bursts of up to one second worth of tokens
+type tokenBucket struct {
+	limit  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(limit float64, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		limit:  limit,
+		tokens: limit,
+		last:   now,
+	}
+}
+
+func (b *tokenBucket) advance(now time.Time) {
+	elapsed := now.Sub(b.last).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+	b.tokens = math.Min(b.limit, b.tokens+elapsed*b.limit)
+	b.last = now
+}
+
+// allow reports whether n tokens can be taken, a request larger than the burst is allowed once the bucket is full
+// and leaves the bucket in debt
+func (b *tokenBucket) allow(n float64) bool {
+	return b.tokens >= math.Min(n, b.limit)
+}
+
+func (b *tokenBucket) take(n float64) {
+	b.tokens -= n
+}
+
+type rateType int
+
+const (
+	dmlRows rateType = iota
+	dmlBytes
+	dmlRequests
+	rateTypeNum
+)
+
+func (t rateType) String() string {
+	switch t {
+	case dmlRows:
+		return "rows"
+	case dmlBytes:
+		return "bytes"
+	case dmlRequests:
+		return "requests"
+	default:
+		return fmt.Sprintf("rateType(%d)", int(t))
+	}
+}
+
+// rateLimits are the limits per second of each rate type, 0 means unlimited
+type rateLimits [rateTypeNum]float64
+
+// rateBuckets are the token buckets of each rate type, nil for the unlimited ones
+type rateBuckets [rateTypeNum]*tokenBucket
+
+func newRateBuckets(limits rateLimits, now time.Time) *rateBuckets {
+	buckets := &rateBuckets{}
+	for rt, limit := range limits {
+		if limit > 0 {
+			buckets[rt] = newTokenBucket(limit, now)
+		}
+	}
+	return buckets
+}
+
+// rateLimiter limits the dml requests through the proxy, both globally and per collection
+type rateLimiter struct {
+	mu          sync.Mutex
+	collLimits  rateLimits
+	global      *rateBuckets
+	collections map[UniqueID]*rateBuckets
+}
+
+func newRateLimiter(globalLimits rateLimits, collLimits rateLimits) *rateLimiter {
+	return &rateLimiter{
+		collLimits:  collLimits,
+		global:      newRateBuckets(globalLimits, time.Now()),
+		collections: make(map[UniqueID]*rateBuckets),
+	}
+}
+
+// check takes the tokens of a dml request of rows and bytes on collection, nothing is taken if any limit is exceeded
+func (rl *rateLimiter) check(collID UniqueID, rows int64, bytes int64) error {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := time.Now()
+	coll, ok := rl.collections[collID]
+	if !ok {
+		coll = newRateBuckets(rl.collLimits, now)
+		rl.collections[collID] = coll
+	}
+	amounts := [rateTypeNum]float64{float64(rows), float64(bytes), 1}
+
+	for rt, b := range rl.global {
+		if b == nil {
+			continue
+		}
+		b.advance(now)
+		if !b.allow(amounts[rt]) {
+			return fmt.Errorf("dml %s rate exceeds the limit %v per second", rateType(rt), b.limit)
+		}
+	}
+	for rt, b := range coll {
+		if b == nil {
+			continue
+		}
+		b.advance(now)
+		if !b.allow(amounts[rt]) {
+			return fmt.Errorf("dml %s rate of collection %d exceeds the limit %v per second", rateType(rt), collID, b.limit)
+		}
+	}
+
+	for _, buckets := range []*rateBuckets{rl.global, coll} {
+		for rt, b := range buckets {
+			if b != nil {
+				b.take(amounts[rt])
+			}
+		}
+	}
+	return nil
+}
+
+type dataNodeTick struct {
+	ts         Timestamp
+	updateTime time.Time
+}
+
+type queryNodeTicks struct {
+	tSafes     map[vChan]Timestamp
+	updateTime time.Time
+}
+
+// timeTickLagChecker tracks the time ticks consumed by the data nodes and the query nodes on the dm channels
+type timeTickLagChecker struct {
+	mu             sync.RWMutex
+	startTs        Timestamp // the time ticks before it are replayed history, which are ignored
+	dataNodeTicks  map[vChan]*dataNodeTick
+	queryNodeTicks map[UniqueID]*queryNodeTicks
+}
+
+// newTimeTickLagChecker returns a checker ignoring the time ticks before start, the time tick channels are
+// consumed from the earliest position, whose history would take the lag as high as the age of the channels
+func newTimeTickLagChecker(start time.Time) *timeTickLagChecker {
+	return &timeTickLagChecker{
+		startTs:        tsoutil.ComposeTS(start.UnixNano()/int64(time.Millisecond), 0),
+		dataNodeTicks:  make(map[vChan]*dataNodeTick),
+		queryNodeTicks: make(map[UniqueID]*queryNodeTicks),
+	}
+}
+
+// updateDataNode records the time tick of channel reported by the data node watching it
+func (c *timeTickLagChecker) updateDataNode(channel vChan, ts Timestamp, now time.Time) {
+	if ts < c.startTs {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tick, ok := c.dataNodeTicks[channel]
+	if !ok {
+		tick = &dataNodeTick{}
+		c.dataNodeTicks[channel] = tick
+	}
+	if ts > tick.ts {
+		tick.ts = ts
+	}
+	tick.updateTime = now
+}
+
+// updateQueryNode replaces the tSafe of the channels watched by query node nodeID
+func (c *timeTickLagChecker) updateQueryNode(nodeID UniqueID, channels []vChan, tSafes []Timestamp, now time.Time) {
+	ticks := &queryNodeTicks{
+		tSafes:     make(map[vChan]Timestamp, len(channels)),
+		updateTime: now,
+	}
+	for i, channel := range channels {
+		if i < len(tSafes) && tSafes[i] >= c.startTs {
+			ticks.tSafes[channel] = tSafes[i]
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.queryNodeTicks[nodeID] = ticks
+}
+
+// getLag returns the largest lag of the data nodes and query nodes consuming channels at now,
+// the channels not consumed yet or not reported for a while are ignored
+func (c *timeTickLagChecker) getLag(channels []vChan, now time.Time) time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var maxLag time.Duration
+	lagOf := func(ts Timestamp) {
+		if ts == 0 {
+			return
+		}
+		physical, _ := tsoutil.ParseTS(ts)
+		if lag := now.Sub(physical); lag > maxLag {
+			maxLag = lag
+		}
+	}
+	for _, channel := range channels {
+		if tick, ok := c.dataNodeTicks[channel]; ok && now.Sub(tick.updateTime) <= timeTickExpiration {
+			lagOf(tick.ts)
+		}
+		for _, ticks := range c.queryNodeTicks {
+			if now.Sub(ticks.updateTime) > timeTickExpiration {
+				continue
+			}
+			if ts, ok := ticks.tSafes[channel]; ok {
+				lagOf(ts)
+			}
+		}
+	}
+	return maxLag
+}
+
+func rateLimitStatus(err error) *commonpb.Status {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_RateLimit,
+		Reason:    err.Error(),
+	}
+}
+
+// checkRateLimit returns a RateLimit status if a dml request of rows and bytes on collection exceeds the rate limits,
+// or the data nodes and query nodes consuming the collection fall behind too much
+func (node *Proxy) checkRateLimit(ctx context.Context, dbName string, collectionName string, rows int64, bytes int64) *commonpb.Status {
+	if node.rateLimiter == nil {
+		return nil
+	}
+	collID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
+	if err != nil {
+		// the request fails on the missing collection later
+		return nil
+	}
+
+	if Params.MaxTimeTickLag > 0 && node.timeTickLag != nil {
+		// the dm channels are known after the first write of collection
+		channels, err := node.chMgr.getVChannels(collID)
+		if err == nil {
+			lag := node.timeTickLag.getLag(channels, time.Now())
+			if lag > Params.MaxTimeTickLag {
+				log.Warn("dml request throttled", zap.String("collection", collectionName),
+					zap.Duration("timeTickLag", lag))
+				return rateLimitStatus(fmt.Errorf("consumers of collection %s fall behind %v, exceeds the limit %v",
+					collectionName, lag, Params.MaxTimeTickLag))
+			}
+		}
+	}
+
+	if err := node.rateLimiter.check(collID, rows, bytes); err != nil {
+		return rateLimitStatus(err)
+	}
+	return nil
+}
+
+// watchTimeTickLag follows the time ticks of the data nodes and the tSafe of the query nodes on the dm channels
+func (node *Proxy) watchTimeTickLag() error {
+	channels := make([]string, 0, 2)
+	if node.dataCoord != nil {
+		resp, err := node.dataCoord.GetTimeTickChannel(node.ctx)
+		if err != nil {
+			return err
+		}
+		if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return errors.New(resp.Status.Reason)
+		}
+		channels = append(channels, resp.Value)
+	}
+	if node.queryCoord != nil {
+		resp, err := node.queryCoord.GetStatisticsChannel(node.ctx)
+		if err != nil {
+			return err
+		}
+		if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return errors.New(resp.Status.Reason)
+		}
+		channels = append(channels, resp.Value)
+	}
+	if len(channels) == 0 {
+		return nil
+	}
+
+	stream, err := node.msFactory.NewMsgStream(node.ctx)
+	if err != nil {
+		return err
+	}
+	stream.AsConsumer(channels, Params.ProxySubName)
+	log.Debug("Proxy watch time tick lag", zap.Strings("channels", channels))
+	stream.Start()
+
+	node.wg.Add(1)
+	go func() {
+		defer node.wg.Done()
+		defer stream.Close()
+		for {
+			msgPack := stream.Consume()
+			if msgPack == nil {
+				return
+			}
+			for _, msg := range msgPack.Msgs {
+				switch m := msg.(type) {
+				case *msgstream.DataNodeTtMsg:
+					node.timeTickLag.updateDataNode(m.ChannelName, m.Timestamp, time.Now())
+				case *msgstream.QueryNodeStatsMsg:
+					node.timeTickLag.updateQueryNode(m.GetBase().GetSourceID(), m.ChannelNames, m.Tsafes, time.Now())
+				}
+			}
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,129 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	b := newTokenBucket(10, now)
+
+	assert.True(t, b.allow(10))
+	b.take(10)
+	assert.False(t, b.allow(1))
+
+	// refilled by the elapsed time, up to the limit
+	b.advance(now.Add(500 * time.Millisecond))
+	assert.True(t, b.allow(5))
+	assert.False(t, b.allow(6))
+	b.advance(now.Add(10 * time.Second))
+	assert.Equal(t, float64(10), b.tokens)
+
+	// a request larger than the burst leaves the bucket in debt
+	assert.True(t, b.allow(30))
+	b.take(30)
+	b.advance(now.Add(11 * time.Second))
+	assert.False(t, b.allow(1))
+	b.advance(now.Add(12*time.Second + 100*time.Millisecond))
+	assert.True(t, b.allow(1))
+}
+
+func TestRateLimiter(t *testing.T) {
+	t.Run("unlimited", func(t *testing.T) {
+		rl := newRateLimiter(rateLimits{}, rateLimits{})
+		for i := 0; i < 100; i++ {
+			assert.Nil(t, rl.check(1, 1000000, 1000000))
+		}
+	})
+
+	t.Run("global limits", func(t *testing.T) {
+		rl := newRateLimiter(rateLimits{100, 0, 3}, rateLimits{})
+		assert.Nil(t, rl.check(1, 50, 1000))
+		assert.Nil(t, rl.check(2, 50, 1000))
+		// rows exceed
+		assert.NotNil(t, rl.check(3, 50, 1000))
+
+		rl = newRateLimiter(rateLimits{0, 0, 3}, rateLimits{})
+		assert.Nil(t, rl.check(1, 1, 1))
+		assert.Nil(t, rl.check(2, 1, 1))
+		assert.Nil(t, rl.check(3, 1, 1))
+		// requests exceed
+		assert.NotNil(t, rl.check(4, 1, 1))
+	})
+
+	t.Run("collection limits", func(t *testing.T) {
+		rl := newRateLimiter(rateLimits{}, rateLimits{0, 1000, 0})
+		assert.Nil(t, rl.check(1, 1, 600))
+		assert.NotNil(t, rl.check(1, 1, 600))
+		// other collections are not affected
+		assert.Nil(t, rl.check(2, 1, 600))
+	})
+
+	t.Run("nothing taken if rejected", func(t *testing.T) {
+		rl := newRateLimiter(rateLimits{0, 0, 2}, rateLimits{10, 0, 0})
+		assert.Nil(t, rl.check(1, 10, 1))
+		// rejected by the collection limit, no global request is taken
+		assert.NotNil(t, rl.check(1, 10, 1))
+		assert.Nil(t, rl.check(2, 10, 1))
+	})
+}
+
+func TestTimeTickLagChecker(t *testing.T) {
+	now := time.Now().Truncate(time.Millisecond)
+	tsAgo := func(d time.Duration) Timestamp {
+		return tsoutil.ComposeTS(now.Add(-d).UnixNano()/int64(time.Millisecond), 0)
+	}
+
+	c := newTimeTickLagChecker(now.Add(-2 * time.Hour))
+	// nothing consumed yet
+	assert.Equal(t, time.Duration(0), c.getLag([]vChan{"ch1", "ch2"}, now))
+
+	c.updateDataNode("ch1", tsAgo(time.Second), now)
+	c.updateDataNode("ch2", tsAgo(3*time.Second), now)
+	// time ticks never go back
+	c.updateDataNode("ch2", tsAgo(5*time.Second), now)
+	assert.Equal(t, time.Second, c.getLag([]vChan{"ch1"}, now))
+	assert.Equal(t, 3*time.Second, c.getLag([]vChan{"ch1", "ch2"}, now))
+
+	c.updateQueryNode(1, []vChan{"ch1", "ch3"}, []Timestamp{tsAgo(10 * time.Second), 0}, now)
+	assert.Equal(t, 10*time.Second, c.getLag([]vChan{"ch1", "ch2"}, now))
+	// not served yet
+	assert.Equal(t, time.Duration(0), c.getLag([]vChan{"ch3"}, now))
+
+	// the channels released by the query node are dropped
+	c.updateQueryNode(1, []vChan{"ch3"}, []Timestamp{tsAgo(10 * time.Second)}, now)
+	assert.Equal(t, 3*time.Second, c.getLag([]vChan{"ch1", "ch2"}, now))
+
+	// the query nodes not reporting are ignored
+	c.updateQueryNode(2, []vChan{"ch1"}, []Timestamp{tsAgo(time.Hour)}, now.Add(-2*timeTickExpiration))
+	assert.Equal(t, 3*time.Second, c.getLag([]vChan{"ch1", "ch2"}, now))
+
+	// the data nodes not reporting are ignored
+	c.updateDataNode("ch4", tsAgo(time.Hour), now.Add(-2*timeTickExpiration))
+	assert.Equal(t, time.Duration(0), c.getLag([]vChan{"ch4"}, now))
+	c.updateDataNode("ch4", tsAgo(time.Hour), now)
+	assert.Equal(t, time.Hour, c.getLag([]vChan{"ch4"}, now))
+
+	// the history before the checker starts is ignored
+	c = newTimeTickLagChecker(now.Add(-time.Minute))
+	c.updateDataNode("ch1", tsAgo(time.Hour), now)
+	c.updateQueryNode(1, []vChan{"ch1"}, []Timestamp{tsAgo(time.Hour)}, now)
+	assert.Equal(t, time.Duration(0), c.getLag([]vChan{"ch1"}, now))
+	c.updateDataNode("ch1", tsAgo(time.Second), now)
+	assert.Equal(t, time.Second, c.getLag([]vChan{"ch1"}, now))
+}
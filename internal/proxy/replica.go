@@ -0,0 +1,62 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+// replicaCounter rotates the first replica tried by the requests, so the load is spread over the replicas
+var replicaCounter uint64
+
+// getReplicaIDs returns the serviceable replicas of the collection in the order to try them, an error is returned
+// if the collection has no serviceable replica
+func getReplicaIDs(ctx context.Context, qc types.QueryCoord, collectionID UniqueID, msgID UniqueID) ([]UniqueID, error) {
+	resp, err := qc.GetReplicas(ctx, &querypb.GetReplicasRequest{
+		Base: &commonpb.MsgBase{
+			MsgID:    msgID,
+			SourceID: Params.ProxyID,
+		},
+		CollectionID: collectionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.Status.Reason)
+	}
+	if len(resp.Replicas) == 0 {
+		return nil, fmt.Errorf("no serviceable replica of collection %d", collectionID)
+	}
+
+	start := int(atomic.AddUint64(&replicaCounter, 1) % uint64(len(resp.Replicas)))
+	replicaIDs := make([]UniqueID, 0, len(resp.Replicas))
+	for i := range resp.Replicas {
+		replicaIDs = append(replicaIDs, resp.Replicas[(start+i)%len(resp.Replicas)].ReplicaID)
+	}
+	return replicaIDs, nil
+}
+
+// nextReplica drops the failed replica and returns the remaining ones, false if no replica is left to try
+func nextReplica(replicaIDs []UniqueID) ([]UniqueID, bool) {
+	if len(replicaIDs) <= 1 {
+		return replicaIDs, false
+	}
+	return replicaIDs[1:], true
+}
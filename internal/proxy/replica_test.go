@@ -0,0 +1,65 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+type replicaQueryCoordMock struct {
+	types.QueryCoord
+	replicas []*querypb.ReplicaInfo
+}
+
+func (m *replicaQueryCoordMock) GetReplicas(ctx context.Context, req *querypb.GetReplicasRequest) (*querypb.GetReplicasResponse, error) {
+	return &querypb.GetReplicasResponse{
+		Status:   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Replicas: m.replicas,
+	}, nil
+}
+
+func TestGetReplicaIDs(t *testing.T) {
+	ctx := context.Background()
+
+	qc := &replicaQueryCoordMock{}
+	_, err := getReplicaIDs(ctx, qc, 1, 1)
+	assert.NotNil(t, err)
+
+	qc.replicas = []*querypb.ReplicaInfo{{ReplicaID: 10}, {ReplicaID: 11}, {ReplicaID: 12}}
+	firsts := make(map[UniqueID]struct{})
+	for i := 0; i < 3; i++ {
+		replicaIDs, err := getReplicaIDs(ctx, qc, 1, 1)
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []UniqueID{10, 11, 12}, replicaIDs)
+		firsts[replicaIDs[0]] = struct{}{}
+	}
+	// the requests start from different replicas
+	assert.Equal(t, 3, len(firsts))
+}
+
+func TestNextReplica(t *testing.T) {
+	replicaIDs, ok := nextReplica([]UniqueID{10, 11})
+	assert.True(t, ok)
+	assert.Equal(t, []UniqueID{11}, replicaIDs)
+
+	_, ok = nextReplica(replicaIDs)
+	assert.False(t, ok)
+	_, ok = nextReplica(nil)
+	assert.False(t, ok)
+}
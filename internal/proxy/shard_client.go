@@ -0,0 +1,245 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	nodeclient "github.com/milvus-io/milvus/internal/distributed/querynode/client"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+type queryNodeCreatorFunc func(ctx context.Context, addr string) (types.QueryNode, error)
+
+func defaultQueryNodeCreator(ctx context.Context, addr string) (types.QueryNode, error) {
+	client, err := nodeclient.NewClient(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	if err = client.Init(); err != nil {
+		return nil, err
+	}
+	if err = client.Start(); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+type shardClient struct {
+	address string
+	client  types.QueryNode
+}
+
+// shardClientMgr caches the clients of the shard leaders, search and query requests are sent to them directly
+type shardClientMgr struct {
+	ctx     context.Context
+	mu      sync.Mutex
+	clients map[UniqueID]*shardClient
+	creator queryNodeCreatorFunc
+}
+
+func newShardClientMgr(ctx context.Context, creator queryNodeCreatorFunc) *shardClientMgr {
+	return &shardClientMgr{
+		ctx:     ctx,
+		clients: make(map[UniqueID]*shardClient),
+		creator: creator,
+	}
+}
+
+// getClient returns the client of the shard leader, the client is recreated if the leader moved to another address.
+// The client is created without holding the lock, so a slow leader doesn't block the requests to the others.
+func (mgr *shardClientMgr) getClient(leader *querypb.ShardLeader) (types.QueryNode, error) {
+	mgr.mu.Lock()
+	if sc, ok := mgr.clients[leader.NodeID]; ok && sc.address == leader.Address {
+		mgr.mu.Unlock()
+		return sc.client, nil
+	}
+	mgr.mu.Unlock()
+
+	client, err := mgr.creator(mgr.ctx, leader.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	mgr.mu.Lock()
+	sc, ok := mgr.clients[leader.NodeID]
+	if ok && sc.address == leader.Address {
+		// created by another request meanwhile
+		mgr.mu.Unlock()
+		stopShardClient(leader.NodeID, client)
+		return sc.client, nil
+	}
+	mgr.clients[leader.NodeID] = &shardClient{
+		address: leader.Address,
+		client:  client,
+	}
+	mgr.mu.Unlock()
+
+	if ok {
+		// the leader moved to another address
+		stopShardClient(leader.NodeID, sc.client)
+	}
+	return client, nil
+}
+
+// evictClient removes the client of the node failed to reach, the client is recreated by the next request
+func (mgr *shardClientMgr) evictClient(nodeID UniqueID, client types.QueryNode) {
+	mgr.mu.Lock()
+	sc, ok := mgr.clients[nodeID]
+	if !ok || sc.client != client {
+		mgr.mu.Unlock()
+		return
+	}
+	delete(mgr.clients, nodeID)
+	mgr.mu.Unlock()
+
+	log.Debug("evict shard client", zap.Int64("nodeID", nodeID), zap.String("address", sc.address))
+	stopShardClient(nodeID, client)
+}
+
+func stopShardClient(nodeID UniqueID, client types.QueryNode) {
+	if err := client.Stop(); err != nil {
+		log.Warn("stop shard client failed", zap.Int64("nodeID", nodeID), zap.Error(err))
+	}
+}
+
+// isConnectionError tells whether the call failed to reach the query node, the query node reports
+// its own failures in the status of the results instead
+func isConnectionError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	switch status.Code(err) {
+	case codes.Canceled, codes.DeadlineExceeded:
+		return false
+	}
+	return true
+}
+
+func (mgr *shardClientMgr) close() {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	for nodeID, sc := range mgr.clients {
+		stopShardClient(nodeID, sc.client)
+	}
+	mgr.clients = make(map[UniqueID]*shardClient)
+}
+
+// search sends the request to all the shard leaders concurrently, each call is bounded by the shard leader timeout.
+// A failed leader gets a result with error status.
+func (mgr *shardClientMgr) search(ctx context.Context, leaders []*querypb.ShardLeader, req *internalpb.SearchRequest) []*internalpb.SearchResults {
+	results := make([]*internalpb.SearchResults, len(leaders))
+	var wg sync.WaitGroup
+	for i, leader := range leaders {
+		wg.Add(1)
+		go func(i int, leader *querypb.ShardLeader) {
+			defer wg.Done()
+			client, err := mgr.getClient(leader)
+			if err == nil {
+				callCtx, cancel := context.WithTimeout(ctx, Params.ShardLeaderTimeout)
+				results[i], err = client.Search(callCtx, &querypb.SearchRequest{
+					Req:        req,
+					DmChannels: leader.DmChannels,
+				})
+				cancel()
+				if err != nil && isConnectionError(err) {
+					mgr.evictClient(leader.NodeID, client)
+				}
+			}
+			if err != nil {
+				log.Warn("search shard leader failed", zap.Int64("nodeID", leader.NodeID), zap.Error(err))
+				results[i] = &internalpb.SearchResults{
+					Status: &commonpb.Status{
+						ErrorCode: commonpb.ErrorCode_UnexpectedError,
+						Reason:    err.Error(),
+					},
+				}
+			}
+		}(i, leader)
+	}
+	wg.Wait()
+	return results
+}
+
+// query sends the request to all the shard leaders concurrently, each call is bounded by the shard leader timeout.
+// A failed leader gets a result with error status.
+func (mgr *shardClientMgr) query(ctx context.Context, leaders []*querypb.ShardLeader, req *internalpb.RetrieveRequest) []*internalpb.RetrieveResults {
+	results := make([]*internalpb.RetrieveResults, len(leaders))
+	var wg sync.WaitGroup
+	for i, leader := range leaders {
+		wg.Add(1)
+		go func(i int, leader *querypb.ShardLeader) {
+			defer wg.Done()
+			client, err := mgr.getClient(leader)
+			if err == nil {
+				callCtx, cancel := context.WithTimeout(ctx, Params.ShardLeaderTimeout)
+				results[i], err = client.Query(callCtx, &querypb.QueryRequest{
+					Req:        req,
+					DmChannels: leader.DmChannels,
+				})
+				cancel()
+				if err != nil && isConnectionError(err) {
+					mgr.evictClient(leader.NodeID, client)
+				}
+			}
+			if err != nil {
+				log.Warn("query shard leader failed", zap.Int64("nodeID", leader.NodeID), zap.Error(err))
+				results[i] = &internalpb.RetrieveResults{
+					Status: &commonpb.Status{
+						ErrorCode: commonpb.ErrorCode_UnexpectedError,
+						Reason:    err.Error(),
+					},
+				}
+			}
+		}(i, leader)
+	}
+	wg.Wait()
+	return results
+}
+
+// getShardLeaders returns the shard leaders of the replica, the first replica is used if replicaID is 0
+func getShardLeaders(ctx context.Context, qc types.QueryCoord, collectionID UniqueID, replicaID UniqueID, msgID UniqueID) ([]*querypb.ShardLeader, error) {
+	resp, err := qc.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+		Base: &commonpb.MsgBase{
+			MsgID:    msgID,
+			SourceID: Params.ProxyID,
+		},
+		CollectionID: collectionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.Status.Reason)
+	}
+	for _, replica := range resp.Replicas {
+		if replicaID == 0 || replica.ReplicaID == replicaID {
+			if len(replica.Leaders) == 0 {
+				break
+			}
+			return replica.Leaders, nil
+		}
+	}
+	return nil, fmt.Errorf("no shard leader of replica %d in collection %d", replicaID, collectionID)
+}
@@ -0,0 +1,250 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+type shardQueryCoordMock struct {
+	types.QueryCoord
+	replicas []*querypb.ShardLeadersList
+}
+
+func (m *shardQueryCoordMock) GetShardLeaders(ctx context.Context, req *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error) {
+	return &querypb.GetShardLeadersResponse{
+		Status:   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Replicas: m.replicas,
+	}, nil
+}
+
+type shardQueryNodeMock struct {
+	types.QueryNode
+	fail    bool
+	stopped bool
+}
+
+func (m *shardQueryNodeMock) Stop() error {
+	m.stopped = true
+	return nil
+}
+
+func (m *shardQueryNodeMock) Search(ctx context.Context, req *querypb.SearchRequest) (*internalpb.SearchResults, error) {
+	if m.fail {
+		return nil, errors.New("mock search failed")
+	}
+	return &internalpb.SearchResults{
+		Status:             &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		ReplicaID:          req.Req.ReplicaID,
+		ChannelIDsSearched: req.DmChannels,
+	}, nil
+}
+
+func (m *shardQueryNodeMock) Query(ctx context.Context, req *querypb.QueryRequest) (*internalpb.RetrieveResults, error) {
+	if m.fail {
+		return nil, errors.New("mock query failed")
+	}
+	return &internalpb.RetrieveResults{
+		Status:              &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		ReplicaID:           req.Req.ReplicaID,
+		ChannelIDsRetrieved: req.DmChannels,
+	}, nil
+}
+
+func TestGetShardLeaders(t *testing.T) {
+	ctx := context.Background()
+
+	qc := &shardQueryCoordMock{}
+	_, err := getShardLeaders(ctx, qc, 1, 0, 1)
+	assert.NotNil(t, err)
+
+	qc.replicas = []*querypb.ShardLeadersList{
+		{ReplicaID: 10, Leaders: []*querypb.ShardLeader{{NodeID: 1, DmChannels: []string{"dml-0"}}}},
+		{ReplicaID: 11, Leaders: []*querypb.ShardLeader{{NodeID: 2, DmChannels: []string{"dml-0"}}, {NodeID: 3}}},
+		{ReplicaID: 12},
+	}
+	leaders, err := getShardLeaders(ctx, qc, 1, 0, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(leaders))
+	assert.Equal(t, int64(1), leaders[0].NodeID)
+
+	leaders, err = getShardLeaders(ctx, qc, 1, 11, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(leaders))
+
+	_, err = getShardLeaders(ctx, qc, 1, 12, 1)
+	assert.NotNil(t, err)
+	_, err = getShardLeaders(ctx, qc, 1, 13, 1)
+	assert.NotNil(t, err)
+}
+
+func TestShardClientMgr(t *testing.T) {
+	ctx := context.Background()
+
+	nodes := make(map[string]*shardQueryNodeMock)
+	mgr := newShardClientMgr(ctx, func(ctx context.Context, addr string) (types.QueryNode, error) {
+		if addr == "" {
+			return nil, errors.New("empty address")
+		}
+		node := &shardQueryNodeMock{}
+		nodes[addr] = node
+		return node, nil
+	})
+
+	client, err := mgr.getClient(&querypb.ShardLeader{NodeID: 1, Address: "addr1"})
+	assert.Nil(t, err)
+	cached, err := mgr.getClient(&querypb.ShardLeader{NodeID: 1, Address: "addr1"})
+	assert.Nil(t, err)
+	assert.Equal(t, client, cached)
+
+	// the node restarted on another address
+	moved, err := mgr.getClient(&querypb.ShardLeader{NodeID: 1, Address: "addr2"})
+	assert.Nil(t, err)
+	assert.NotEqual(t, client, moved)
+	assert.True(t, nodes["addr1"].stopped)
+
+	_, err = mgr.getClient(&querypb.ShardLeader{NodeID: 2})
+	assert.NotNil(t, err)
+
+	mgr.close()
+	assert.True(t, nodes["addr2"].stopped)
+	assert.Equal(t, 0, len(mgr.clients))
+}
+
+func TestShardClientMgr_SearchQuery(t *testing.T) {
+	ctx := context.Background()
+
+	mgr := newShardClientMgr(ctx, func(ctx context.Context, addr string) (types.QueryNode, error) {
+		return &shardQueryNodeMock{fail: addr == "failed"}, nil
+	})
+	leaders := []*querypb.ShardLeader{
+		{NodeID: 1, Address: "addr1", DmChannels: []string{"dml-0"}},
+		{NodeID: 2, Address: "addr2", DmChannels: []string{"dml-1"}},
+		{NodeID: 3, Address: "addr3"},
+	}
+
+	searchResults := mgr.search(ctx, leaders, &internalpb.SearchRequest{ReplicaID: 100})
+	assert.Equal(t, len(leaders), len(searchResults))
+	for i, result := range searchResults {
+		assert.Equal(t, commonpb.ErrorCode_Success, result.Status.ErrorCode)
+		assert.Equal(t, int64(100), result.ReplicaID)
+		assert.Equal(t, leaders[i].DmChannels, result.ChannelIDsSearched)
+	}
+
+	retrieveResults := mgr.query(ctx, leaders, &internalpb.RetrieveRequest{ReplicaID: 101})
+	assert.Equal(t, len(leaders), len(retrieveResults))
+	for i, result := range retrieveResults {
+		assert.Equal(t, commonpb.ErrorCode_Success, result.Status.ErrorCode)
+		assert.Equal(t, int64(101), result.ReplicaID)
+		assert.Equal(t, leaders[i].DmChannels, result.ChannelIDsRetrieved)
+	}
+
+	leaders = append(leaders, &querypb.ShardLeader{NodeID: 4, Address: "failed"})
+	searchResults = mgr.search(ctx, leaders, &internalpb.SearchRequest{ReplicaID: 102})
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, searchResults[3].Status.ErrorCode)
+	retrieveResults = mgr.query(ctx, leaders, &internalpb.RetrieveRequest{ReplicaID: 103})
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, retrieveResults[3].Status.ErrorCode)
+}
+
+func TestShardClientMgr_evictClient(t *testing.T) {
+	ctx := context.Background()
+
+	created := make([]*shardQueryNodeMock, 0)
+	mgr := newShardClientMgr(ctx, func(ctx context.Context, addr string) (types.QueryNode, error) {
+		node := &shardQueryNodeMock{fail: len(created) == 0}
+		created = append(created, node)
+		return node, nil
+	})
+	leaders := []*querypb.ShardLeader{{NodeID: 1, Address: "addr1"}}
+
+	// the client failed to reach the node is evicted
+	searchResults := mgr.search(ctx, leaders, &internalpb.SearchRequest{})
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, searchResults[0].Status.ErrorCode)
+	assert.Equal(t, 0, len(mgr.clients))
+	assert.True(t, created[0].stopped)
+
+	searchResults = mgr.search(ctx, leaders, &internalpb.SearchRequest{})
+	assert.Equal(t, commonpb.ErrorCode_Success, searchResults[0].Status.ErrorCode)
+	assert.Equal(t, 2, len(created))
+	assert.Equal(t, 1, len(mgr.clients))
+
+	// a stale client doesn't evict the new one
+	mgr.evictClient(1, created[0])
+	assert.Equal(t, 1, len(mgr.clients))
+	assert.False(t, created[1].stopped)
+
+	assert.False(t, isConnectionError(context.DeadlineExceeded))
+	assert.False(t, isConnectionError(context.Canceled))
+	assert.True(t, isConnectionError(errors.New("connection refused")))
+}
+
+func TestShardClientMgr_createConcurrently(t *testing.T) {
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	created := make([]*shardQueryNodeMock, 0)
+	entered := make(chan struct{}, 2)
+	release := make(chan struct{})
+	mgr := newShardClientMgr(ctx, func(ctx context.Context, addr string) (types.QueryNode, error) {
+		if addr == "slow" {
+			entered <- struct{}{}
+			<-release
+		}
+		node := &shardQueryNodeMock{}
+		mu.Lock()
+		created = append(created, node)
+		mu.Unlock()
+		return node, nil
+	})
+
+	clients := make([]types.QueryNode, 2)
+	var wg sync.WaitGroup
+	for i := range clients {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			client, err := mgr.getClient(&querypb.ShardLeader{NodeID: 1, Address: "slow"})
+			assert.Nil(t, err)
+			clients[i] = client
+		}(i)
+	}
+	<-entered
+	<-entered
+
+	// the slow leader doesn't block the others
+	_, err := mgr.getClient(&querypb.ShardLeader{NodeID: 2, Address: "addr2"})
+	assert.Nil(t, err)
+
+	close(release)
+	wg.Wait()
+	// only one client of the leader is kept, the other is stopped
+	assert.Equal(t, clients[0], clients[1])
+	assert.Equal(t, 3, len(created))
+	stopped := 0
+	for _, node := range created {
+		if node.stopped {
+			stopped++
+		}
+	}
+	assert.Equal(t, 1, stopped)
+	assert.Equal(t, 2, len(mgr.clients))
+}
@@ -49,6 +49,7 @@ import (
 
 const (
 	InsertTaskName                  = "InsertTask"
+	UpsertTaskName                  = "UpsertTask"
 	CreateCollectionTaskName        = "CreateCollectionTask"
 	DropCollectionTaskName          = "DropCollectionTask"
 	SearchTaskName                  = "SearchTask"
@@ -63,6 +64,9 @@ const (
 	GetCollectionStatisticsTaskName = "GetCollectionStatisticsTask"
 	GetPartitionStatisticsTaskName  = "GetPartitionStatisticsTask"
 	ShowCollectionTaskName          = "ShowCollectionTask"
+	CreateDatabaseTaskName          = "CreateDatabaseTask"
+	DropDatabaseTaskName            = "DropDatabaseTask"
+	ListDatabasesTaskName           = "ListDatabasesTask"
 	CreatePartitionTaskName         = "CreatePartitionTask"
 	DropPartitionTaskName           = "DropPartitionTask"
 	HasPartitionTaskName            = "HasPartitionTask"
@@ -180,7 +184,7 @@ func (it *InsertTask) getPChanStats() (map[pChan]pChanStatistics, error) {
 }
 
 func (it *InsertTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(it.ctx, it.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(it.ctx, it.DbName, it.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -741,7 +745,7 @@ func (it *InsertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, it.DbName, collectionName)
 	log.Debug("Proxy Insert PreExecute", zap.Any("collSchema", collSchema))
 	if err != nil {
 		return err
@@ -985,20 +989,26 @@ func (it *InsertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 }
 
 func (it *InsertTask) Execute(ctx context.Context) error {
+	return it.execute(ctx, nil)
+}
+
+// execute produces the entities into the dml channels, the messages returned by genPreMsgs are produced
+// ahead of the inserts of their channels in the same msg pack
+func (it *InsertTask) execute(ctx context.Context, genPreMsgs func(stream msgstream.MsgStream) ([]msgstream.TsMsg, error)) error {
 	collectionName := it.BaseInsertTask.CollectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, it.DbName, collectionName)
 	if err != nil {
 		return err
 	}
 	it.CollectionID = collID
 	var partitionID UniqueID
 	if len(it.PartitionName) > 0 {
-		partitionID, err = globalMetaCache.GetPartitionID(ctx, collectionName, it.PartitionName)
+		partitionID, err = globalMetaCache.GetPartitionID(ctx, it.DbName, collectionName, it.PartitionName)
 		if err != nil {
 			return err
 		}
 	} else {
-		partitionID, err = globalMetaCache.GetPartitionID(ctx, collectionName, Params.DefaultPartitionName)
+		partitionID, err = globalMetaCache.GetPartitionID(ctx, it.DbName, collectionName, Params.DefaultPartitionName)
 		if err != nil {
 			return err
 		}
@@ -1037,6 +1047,13 @@ func (it *InsertTask) Execute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if genPreMsgs != nil {
+		preMsgs, err := genPreMsgs(stream)
+		if err != nil {
+			return err
+		}
+		pack.Msgs = append(preMsgs, pack.Msgs...)
+	}
 
 	err = stream.Produce(pack)
 	if err != nil {
@@ -1052,6 +1069,109 @@ func (it *InsertTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
+// UpsertTask inserts the entities and deletes the existing entities with the same primary keys,
+// the deletes share the timestamp of the inserts and are produced in the same msg pack,
+// so the data nodes and query nodes always consume them together
+type UpsertTask struct {
+	InsertTask
+}
+
+func (ut *UpsertTask) Name() string {
+	return UpsertTaskName
+}
+
+func (ut *UpsertTask) PreExecute(ctx context.Context) error {
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, ut.DbName, ut.CollectionName)
+	if err != nil {
+		return err
+	}
+	for _, field := range collSchema.Fields {
+		if field.IsPrimaryKey && field.AutoID {
+			return fmt.Errorf("can't upsert into collection %s, its primary keys are generated automatically", ut.CollectionName)
+		}
+	}
+	err = ut.InsertTask.PreExecute(ctx)
+	if err != nil {
+		return err
+	}
+	return checkDuplicatePrimaryKeys(ut.result.IDs.GetIntId().GetData())
+}
+
+// checkDuplicatePrimaryKeys rejects the upserts of a primary key more than once in a request,
+// the entities would share the timestamp so that none of them replaces the others
+func checkDuplicatePrimaryKeys(primaryKeys []int64) error {
+	seen := make(map[int64]struct{}, len(primaryKeys))
+	for _, pk := range primaryKeys {
+		if _, ok := seen[pk]; ok {
+			return fmt.Errorf("duplicate primary key %d in the upsert request", pk)
+		}
+		seen[pk] = struct{}{}
+	}
+	return nil
+}
+
+func (ut *UpsertTask) Execute(ctx context.Context) error {
+	return ut.execute(ctx, ut.genDeleteMsgs)
+}
+
+// genDeleteMsgs splits the deletes of the primary keys by the dml channels, the hash values of the
+// inserts are reused so that the delete of a primary key goes into the channel of its insert
+func (ut *UpsertTask) genDeleteMsgs(stream msgstream.MsgStream) ([]msgstream.TsMsg, error) {
+	primaryKeys := ut.result.IDs.GetIntId().GetData()
+	if len(primaryKeys) != len(ut.HashValues) {
+		return nil, fmt.Errorf("the number of primary keys %d doesn't match the number of hash values %d", len(primaryKeys), len(ut.HashValues))
+	}
+	channelNames, err := ut.chMgr.getVChannels(ut.CollectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var deleteMsg msgstream.TsMsg = &msgstream.DeleteMsg{
+		BaseMsg: msgstream.BaseMsg{
+			HashValues: ut.HashValues,
+		},
+	}
+	channelIndexes := stream.ComputeProduceChannelIndexes([]msgstream.TsMsg{deleteMsg})[0]
+
+	msgs := make(map[int32]*msgstream.DeleteMsg)
+	channelOrder := make([]int32, 0)
+	for i, pk := range primaryKeys {
+		index := channelIndexes[i]
+		msg, ok := msgs[index]
+		if !ok {
+			msg = &msgstream.DeleteMsg{
+				BaseMsg: msgstream.BaseMsg{
+					Ctx:            ut.TraceCtx(),
+					BeginTimestamp: ut.BeginTs(),
+					EndTimestamp:   ut.EndTs(),
+				},
+				DeleteRequest: internalpb.DeleteRequest{
+					Base: &commonpb.MsgBase{
+						MsgType:   commonpb.MsgType_Delete,
+						MsgID:     ut.Base.MsgID,
+						Timestamp: ut.BeginTs(),
+						SourceID:  ut.Base.SourceID,
+					},
+					CollectionName: ut.CollectionName,
+					CollectionID:   ut.CollectionID,
+					ChannelID:      channelNames[index],
+				},
+			}
+			msgs[index] = msg
+			channelOrder = append(channelOrder, index)
+		}
+		msg.HashValues = append(msg.HashValues, ut.HashValues[i])
+		msg.Timestamps = append(msg.Timestamps, ut.BeginTs())
+		msg.PrimaryKeys = append(msg.PrimaryKeys, pk)
+	}
+
+	deleteMsgs := make([]msgstream.TsMsg, 0, len(channelOrder))
+	for _, index := range channelOrder {
+		deleteMsgs = append(deleteMsgs, msgs[index])
+	}
+	return deleteMsgs, nil
+}
+
 type CreateCollectionTask struct {
 	Condition
 	*milvuspb.CreateCollectionRequest
@@ -1111,6 +1231,10 @@ func (cct *CreateCollectionTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	if _, ok := commonpb.ConsistencyLevel_name[int32(cct.ConsistencyLevel)]; !ok {
+		return fmt.Errorf("invalid consistency level %d", cct.ConsistencyLevel)
+	}
+
 	if int64(len(cct.schema.Fields)) > Params.MaxFieldNum {
 		return fmt.Errorf("maximum field's number should be limited to %d", Params.MaxFieldNum)
 	}
@@ -1237,7 +1361,7 @@ func (dct *DropCollectionTask) PreExecute(ctx context.Context) error {
 }
 
 func (dct *DropCollectionTask) Execute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, dct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, dct.DbName, dct.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -1259,7 +1383,7 @@ func (dct *DropCollectionTask) Execute(ctx context.Context) error {
 }
 
 func (dct *DropCollectionTask) PostExecute(ctx context.Context) error {
-	globalMetaCache.RemoveCollection(ctx, dct.CollectionName)
+	globalMetaCache.RemoveCollection(ctx, dct.DbName, dct.CollectionName)
 	return nil
 }
 
@@ -1315,6 +1439,30 @@ func translateOutputFields(outputFields []string, schema *schemapb.CollectionSch
 	return resultFieldNames, nil
 }
 
+// translateOrderByFields resolves the field names of order by to field ids, only scalar fields can be ordered by
+func translateOrderByFields(orderByFields []*milvuspb.OrderByField, schema *typeutil.SchemaHelper) ([]*internalpb.OrderByField, error) {
+	ret := make([]*internalpb.OrderByField, 0, len(orderByFields))
+	fieldIDs := make(map[int64]bool)
+	for _, orderByField := range orderByFields {
+		field, err := schema.GetFieldFromName(strings.TrimSpace(orderByField.FieldName))
+		if err != nil {
+			return nil, err
+		}
+		if typeutil.IsVectorType(field.DataType) {
+			return nil, fmt.Errorf("can not order by vector field %s", field.Name)
+		}
+		if fieldIDs[field.FieldID] {
+			return nil, fmt.Errorf("duplicated order by field %s", field.Name)
+		}
+		fieldIDs[field.FieldID] = true
+		ret = append(ret, &internalpb.OrderByField{
+			FieldID:    field.FieldID,
+			Descending: orderByField.Descending,
+		})
+	}
+	return ret, nil
+}
+
 type SearchTask struct {
 	Condition
 	*internalpb.SearchRequest
@@ -1324,6 +1472,10 @@ type SearchTask struct {
 	query     *milvuspb.SearchRequest
 	chMgr     channelsMgr
 	qc        types.QueryCoord
+	shardMgr  *shardClientMgr
+	sessionTs *sessionTsTracker
+	// replicaIDs are the replicas left to serve the request, the first one is serving it
+	replicaIDs []UniqueID
 }
 
 func (st *SearchTask) TraceCtx() context.Context {
@@ -1364,7 +1516,7 @@ func (st *SearchTask) OnEnqueue() error {
 }
 
 func (st *SearchTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(st.ctx, st.query.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(st.ctx, st.query.DbName, st.query.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -1373,7 +1525,7 @@ func (st *SearchTask) getChannels() ([]pChan, error) {
 }
 
 func (st *SearchTask) getVChannels() ([]vChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(st.ctx, st.query.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(st.ctx, st.query.DbName, st.query.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -1394,7 +1546,7 @@ func (st *SearchTask) PreExecute(ctx context.Context) error {
 	st.Base.SourceID = Params.ProxyID
 
 	collectionName := st.query.CollectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, st.query.DbName, collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -1440,12 +1592,17 @@ func (st *SearchTask) PreExecute(ctx context.Context) error {
 	if !collectionLoaded {
 		return fmt.Errorf("collection %v was not loaded into memory", collectionName)
 	}
+	st.replicaIDs, err = getReplicaIDs(st.ctx, st.qc, collID, st.Base.MsgID)
+	if err != nil {
+		return err
+	}
+	st.SearchRequest.ReplicaID = st.replicaIDs[0]
 
 	// TODO(dragondriver): necessary to check if partition was loaded into query node?
 
 	st.Base.MsgType = commonpb.MsgType_Search
 
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, st.query.DbName, collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -1525,23 +1682,24 @@ func (st *SearchTask) PreExecute(ctx context.Context) error {
 	if travelTimestamp == 0 {
 		travelTimestamp = st.BeginTs()
 	}
-	guaranteeTimestamp := st.query.GuaranteeTimestamp
-	if guaranteeTimestamp == 0 {
-		guaranteeTimestamp = st.BeginTs()
+	guaranteeTimestamp, err := parseGuaranteeTimestamp(ctx, st.query.DbName, collectionName, st.query.GuaranteeTimestamp,
+		st.query.ConsistencyLevel, st.query.UseDefaultConsistency, st.BeginTs(), st.sessionTs)
+	if err != nil {
+		return err
 	}
 	st.SearchRequest.TravelTimestamp = travelTimestamp
 	st.SearchRequest.GuaranteeTimestamp = guaranteeTimestamp
 
 	st.SearchRequest.ResultChannelID = Params.SearchResultChannelNames[0]
 	st.SearchRequest.DbID = 0 // todo
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, st.query.DbName, collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
 	st.SearchRequest.CollectionID = collectionID
 	st.SearchRequest.PartitionIDs = make([]UniqueID, 0)
 
-	partitionsMap, err := globalMetaCache.GetPartitions(ctx, collectionName)
+	partitionsMap, err := globalMetaCache.GetPartitions(ctx, st.query.DbName, collectionName)
 	if err != nil {
 		return err
 	}
@@ -1576,6 +1734,25 @@ func (st *SearchTask) PreExecute(ctx context.Context) error {
 }
 
 func (st *SearchTask) Execute(ctx context.Context) error {
+	if st.shardMgr != nil {
+		leaders, err := getShardLeaders(ctx, st.qc, st.CollectionID, st.SearchRequest.ReplicaID, st.Base.MsgID)
+		if err == nil {
+			go func() {
+				// a replica not responding in time fails, the next replica is tried then
+				replicaCtx, cancel := context.WithTimeout(ctx, Params.ReplicaTimeout)
+				defer cancel()
+				results := st.shardMgr.search(replicaCtx, leaders, st.SearchRequest)
+				select {
+				case st.resultBuf <- results:
+				case <-st.TraceCtx().Done():
+				}
+			}()
+			return nil
+		}
+		log.Debug("Proxy get shard leaders failed, search through the query channel",
+			zap.Int64("collectionID", st.CollectionID), zap.Error(err))
+	}
+
 	var tsMsg msgstream.TsMsg = &msgstream.SearchMsg{
 		SearchRequest: *st.SearchRequest,
 		BaseMsg: msgstream.BaseMsg{
@@ -1593,7 +1770,7 @@ func (st *SearchTask) Execute(ctx context.Context) error {
 	msgPack.Msgs[0] = tsMsg
 
 	collectionName := st.query.CollectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, st.query.DbName, collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -1930,6 +2107,19 @@ func (st *SearchTask) PostExecute(ctx context.Context) error {
 					filterReason += partialSearchResult.Status.Reason + "\n"
 				}
 			}
+			if len(filterSearchResult) < len(searchResults) {
+				if replicaIDs, ok := nextReplica(st.replicaIDs); ok {
+					log.Debug("Proxy Search failed on a replica, retry on the next one",
+						zap.Int64("failedReplicaID", st.SearchRequest.ReplicaID), zap.Int64("replicaID", replicaIDs[0]),
+						zap.Any("filterReason", filterReason))
+					st.replicaIDs = replicaIDs
+					st.SearchRequest.ReplicaID = replicaIDs[0]
+					if err := st.Execute(ctx); err != nil {
+						return err
+					}
+					continue
+				}
+			}
 
 			availableQueryNodeNum := len(filterSearchResult)
 			log.Debug("Proxy Search PostExecute stage1", zap.Any("availableQueryNodeNum", availableQueryNodeNum))
@@ -1982,7 +2172,7 @@ func (st *SearchTask) PostExecute(ctx context.Context) error {
 				return err
 			}
 
-			schema, err := globalMetaCache.GetCollectionSchema(ctx, st.query.CollectionName)
+			schema, err := globalMetaCache.GetCollectionSchema(ctx, st.query.DbName, st.query.CollectionName)
 			if err != nil {
 				return err
 			}
@@ -2012,6 +2202,12 @@ type QueryTask struct {
 	query     *milvuspb.QueryRequest
 	chMgr     channelsMgr
 	qc        types.QueryCoord
+	shardMgr  *shardClientMgr
+	sessionTs *sessionTsTracker
+	// replicaIDs are the replicas left to serve the request, the first one is serving it
+	replicaIDs []UniqueID
+	// orderByOnlyFieldIDs are the order by fields not in the output fields, stripped from the results
+	orderByOnlyFieldIDs []UniqueID
 }
 
 func (qt *QueryTask) TraceCtx() context.Context {
@@ -2052,7 +2248,7 @@ func (qt *QueryTask) OnEnqueue() error {
 }
 
 func (qt *QueryTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(qt.ctx, qt.query.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(qt.ctx, qt.query.DbName, qt.query.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -2061,7 +2257,7 @@ func (qt *QueryTask) getChannels() ([]pChan, error) {
 }
 
 func (qt *QueryTask) getVChannels() ([]vChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(qt.ctx, qt.query.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(qt.ctx, qt.query.DbName, qt.query.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -2110,7 +2306,7 @@ func (qt *QueryTask) PreExecute(ctx context.Context) error {
 	qt.Base.SourceID = Params.ProxyID
 
 	collectionName := qt.query.CollectionName
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, qt.query.DbName, collectionName)
 	if err != nil {
 		log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
 			zap.Any("requestID", qt.Base.MsgID), zap.Any("requestType", "query"))
@@ -2167,8 +2363,13 @@ func (qt *QueryTask) PreExecute(ctx context.Context) error {
 	if !collectionLoaded {
 		return fmt.Errorf("collection %v was not loaded into memory", collectionName)
 	}
+	qt.replicaIDs, err = getReplicaIDs(qt.ctx, qt.qc, collectionID, qt.Base.MsgID)
+	if err != nil {
+		return err
+	}
+	qt.RetrieveRequest.ReplicaID = qt.replicaIDs[0]
 
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, qt.query.CollectionName)
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, qt.query.DbName, qt.query.CollectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -2235,13 +2436,31 @@ func (qt *QueryTask) PreExecute(ctx context.Context) error {
 	}
 	log.Debug("translate output fields to field ids", zap.Any("OutputFieldsID", qt.OutputFieldsId))
 
+	if qt.query.Limit < 0 {
+		return fmt.Errorf("query limit should not be negative, limit = %d", qt.query.Limit)
+	}
+	qt.Limit = qt.query.Limit
+	qt.OrderBy, err = translateOrderByFields(qt.query.OrderBy, schemaHelper)
+	if err != nil {
+		return err
+	}
+	for _, orderBy := range qt.OrderBy {
+		// order by fields must be retrieved, results of query nodes are merged by them
+		if !funcutil.SliceContain(qt.OutputFieldsId, orderBy.FieldID) {
+			qt.OutputFieldsId = append(qt.OutputFieldsId, orderBy.FieldID)
+			qt.orderByOnlyFieldIDs = append(qt.orderByOnlyFieldIDs, orderBy.FieldID)
+		}
+	}
+	log.Debug("translate order by fields", zap.Any("OrderBy", qt.OrderBy), zap.Int64("Limit", qt.Limit))
+
 	travelTimestamp := qt.query.TravelTimestamp
 	if travelTimestamp == 0 {
 		travelTimestamp = qt.BeginTs()
 	}
-	guaranteeTimestamp := qt.query.GuaranteeTimestamp
-	if guaranteeTimestamp == 0 {
-		guaranteeTimestamp = qt.BeginTs()
+	guaranteeTimestamp, err := parseGuaranteeTimestamp(ctx, qt.query.DbName, collectionName, qt.query.GuaranteeTimestamp,
+		qt.query.ConsistencyLevel, qt.query.UseDefaultConsistency, qt.BeginTs(), qt.sessionTs)
+	if err != nil {
+		return err
 	}
 	qt.TravelTimestamp = travelTimestamp
 	qt.GuaranteeTimestamp = guaranteeTimestamp
@@ -2252,7 +2471,7 @@ func (qt *QueryTask) PreExecute(ctx context.Context) error {
 	qt.CollectionID = collectionID
 	qt.PartitionIDs = make([]UniqueID, 0)
 
-	partitionsMap, err := globalMetaCache.GetPartitions(ctx, collectionName)
+	partitionsMap, err := globalMetaCache.GetPartitions(ctx, qt.query.DbName, collectionName)
 	if err != nil {
 		log.Debug("Failed to get partitions in collection.", zap.Any("collectionName", collectionName),
 			zap.Any("requestID", qt.Base.MsgID), zap.Any("requestType", "query"))
@@ -2293,6 +2512,25 @@ func (qt *QueryTask) PreExecute(ctx context.Context) error {
 }
 
 func (qt *QueryTask) Execute(ctx context.Context) error {
+	if qt.shardMgr != nil {
+		leaders, err := getShardLeaders(ctx, qt.qc, qt.CollectionID, qt.RetrieveRequest.ReplicaID, qt.Base.MsgID)
+		if err == nil {
+			go func() {
+				// a replica not responding in time fails, the next replica is tried then
+				replicaCtx, cancel := context.WithTimeout(ctx, Params.ReplicaTimeout)
+				defer cancel()
+				results := qt.shardMgr.query(replicaCtx, leaders, qt.RetrieveRequest)
+				select {
+				case qt.resultBuf <- results:
+				case <-qt.TraceCtx().Done():
+				}
+			}()
+			return nil
+		}
+		log.Debug("Proxy get shard leaders failed, query through the query channel",
+			zap.Int64("collectionID", qt.CollectionID), zap.Error(err))
+	}
+
 	var tsMsg msgstream.TsMsg = &msgstream.RetrieveMsg{
 		RetrieveRequest: *qt.RetrieveRequest,
 		BaseMsg: msgstream.BaseMsg{
@@ -2310,7 +2548,7 @@ func (qt *QueryTask) Execute(ctx context.Context) error {
 	msgPack.Msgs[0] = tsMsg
 
 	collectionName := qt.query.CollectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, qt.query.DbName, collectionName)
 	if err != nil {
 		return err
 	}
@@ -2342,6 +2580,50 @@ func (qt *QueryTask) Execute(ctx context.Context) error {
 	return err
 }
 
+// removeFieldsData removes the fields of fieldIDs from fieldsData
+func removeFieldsData(fieldsData []*schemapb.FieldData, fieldIDs []UniqueID) []*schemapb.FieldData {
+	if len(fieldIDs) == 0 {
+		return fieldsData
+	}
+	ret := make([]*schemapb.FieldData, 0, len(fieldsData))
+	for _, fieldData := range fieldsData {
+		if !funcutil.SliceContain(fieldIDs, fieldData.FieldId) {
+			ret = append(ret, fieldData)
+		}
+	}
+	return ret
+}
+
+// mergeSortedQueryResults merges the results of query nodes, each of them is already sorted by orderBy,
+// and returns the fields data of the top limit rows
+func mergeSortedQueryResults(retrieveResults []*internalpb.RetrieveResults, orderBy []*internalpb.OrderByField, limit int64) ([]*schemapb.FieldData, error) {
+	validResults := make([]*internalpb.RetrieveResults, 0, len(retrieveResults))
+	fieldsDataArr := make([][]*schemapb.FieldData, 0, len(retrieveResults))
+	for _, partialRetrieveResult := range retrieveResults {
+		if partialRetrieveResult.Ids == nil || len(partialRetrieveResult.FieldsData) == 0 {
+			continue
+		}
+		validResults = append(validResults, partialRetrieveResult)
+		fieldsDataArr = append(fieldsDataArr, partialRetrieveResult.FieldsData)
+	}
+	if len(validResults) == 0 {
+		return make([]*schemapb.FieldData, 0), nil
+	}
+
+	locations, err := typeutil.MergeSortedFieldsData(fieldsDataArr, orderBy, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(locations) == 0 {
+		return make([]*schemapb.FieldData, 0), nil
+	}
+	fieldsData := make([]*schemapb.FieldData, len(validResults[0].FieldsData))
+	for _, loc := range locations {
+		typeutil.AppendFieldData(fieldsData, fieldsDataArr[loc.ResultIdx], loc.RowOffset)
+	}
+	return fieldsData, nil
+}
+
 func (qt *QueryTask) PostExecute(ctx context.Context) error {
 	t0 := time.Now()
 	defer func() {
@@ -2361,6 +2643,19 @@ func (qt *QueryTask) PostExecute(ctx context.Context) error {
 				reason += partialRetrieveResult.Status.Reason + "\n"
 			}
 		}
+		if len(retrieveResult) < len(retrieveResults) {
+			if replicaIDs, ok := nextReplica(qt.replicaIDs); ok {
+				log.Debug("Query failed on a replica, retry on the next one",
+					zap.Int64("failedReplicaID", qt.RetrieveRequest.ReplicaID), zap.Int64("replicaID", replicaIDs[0]),
+					zap.Any("requestID", qt.Base.MsgID), zap.String("reason", reason))
+				qt.replicaIDs = replicaIDs
+				qt.RetrieveRequest.ReplicaID = replicaIDs[0]
+				if err := qt.Execute(ctx); err != nil {
+					return err
+				}
+				return qt.PostExecute(ctx)
+			}
+		}
 
 		if len(retrieveResult) == 0 {
 			qt.result = &milvuspb.QueryResults{
@@ -2374,6 +2669,19 @@ func (qt *QueryTask) PostExecute(ctx context.Context) error {
 			return errors.New(reason)
 		}
 
+		// the sorted results are merged into one beforehand, which keeps the top limit rows. The ids
+		// aren't returned by query, an empty one marks the merged result valid.
+		if len(qt.OrderBy) > 0 || qt.Limit > 0 {
+			fieldsData, err := mergeSortedQueryResults(retrieveResult, qt.OrderBy, qt.Limit)
+			if err != nil {
+				return err
+			}
+			retrieveResult = []*internalpb.RetrieveResults{{
+				Ids:        &schemapb.IDs{},
+				FieldsData: fieldsData,
+			}}
+		}
+
 		availableQueryNodeNum := 0
 		qt.result = &milvuspb.QueryResults{
 			Status: &commonpb.Status{
@@ -2446,7 +2754,7 @@ func (qt *QueryTask) PostExecute(ctx context.Context) error {
 			return nil
 		}
 
-		schema, err := globalMetaCache.GetCollectionSchema(ctx, qt.query.CollectionName)
+		schema, err := globalMetaCache.GetCollectionSchema(ctx, qt.query.DbName, qt.query.CollectionName)
 		if err != nil {
 			return err
 		}
@@ -2459,6 +2767,7 @@ func (qt *QueryTask) PostExecute(ctx context.Context) error {
 				}
 			}
 		}
+		qt.result.FieldsData = removeFieldsData(qt.result.FieldsData, qt.orderByOnlyFieldIDs)
 	}
 
 	log.Info("Query PostExecute done.",
@@ -2540,9 +2849,10 @@ func (hct *HasCollectionTask) PostExecute(ctx context.Context) error {
 type DescribeCollectionTask struct {
 	Condition
 	*milvuspb.DescribeCollectionRequest
-	ctx       context.Context
-	rootCoord types.RootCoord
-	result    *milvuspb.DescribeCollectionResponse
+	ctx        context.Context
+	rootCoord  types.RootCoord
+	queryCoord types.QueryCoord
+	result     *milvuspb.DescribeCollectionResponse
 }
 
 func (dct *DescribeCollectionTask) TraceCtx() context.Context {
@@ -2641,10 +2951,36 @@ func (dct *DescribeCollectionTask) Execute(ctx context.Context) error {
 				})
 			}
 		}
+		dct.result.LoadedFields = dct.getLoadedFields(ctx, result.CollectionID, result.Schema)
 	}
 	return nil
 }
 
+// getLoadedFields returns the names of the fields loaded, empty if the collection is not loaded
+func (dct *DescribeCollectionTask) getLoadedFields(ctx context.Context, collectionID UniqueID, schema *schemapb.CollectionSchema) []string {
+	if dct.queryCoord == nil {
+		return nil
+	}
+	resp, err := dct.queryCoord.ShowCollections(ctx, &querypb.ShowCollectionsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_ShowCollections,
+			MsgID:     dct.Base.MsgID,
+			Timestamp: dct.Base.Timestamp,
+			SourceID:  dct.Base.SourceID,
+		},
+		CollectionIDs: []int64{collectionID},
+	})
+	if err != nil {
+		log.Debug("failed to get the loaded fields", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return nil
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success || len(resp.LoadedFields) == 0 {
+		// the collection is not loaded
+		return nil
+	}
+	return getFieldNames(schema, resp.LoadedFields[0].FieldIDs)
+}
+
 func (dct *DescribeCollectionTask) PostExecute(ctx context.Context) error {
 	return nil
 }
@@ -2701,7 +3037,7 @@ func (g *GetCollectionStatisticsTask) PreExecute(ctx context.Context) error {
 }
 
 func (g *GetCollectionStatisticsTask) Execute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, g.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, g.DbName, g.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -2788,11 +3124,11 @@ func (g *GetPartitionStatisticsTask) PreExecute(ctx context.Context) error {
 }
 
 func (g *GetPartitionStatisticsTask) Execute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, g.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, g.DbName, g.CollectionName)
 	if err != nil {
 		return err
 	}
-	partitionID, err := globalMetaCache.GetPartitionID(ctx, g.CollectionName, g.PartitionName)
+	partitionID, err := globalMetaCache.GetPartitionID(ctx, g.DbName, g.CollectionName, g.PartitionName)
 	if err != nil {
 		return err
 	}
@@ -2911,7 +3247,7 @@ func (sct *ShowCollectionsTask) Execute(ctx context.Context) error {
 		}
 		collectionIDs := make([]UniqueID, 0)
 		for _, collectionName := range sct.CollectionNames {
-			collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+			collectionID, err := globalMetaCache.GetCollectionID(ctx, sct.DbName, collectionName)
 			if err != nil {
 				log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
@@ -2951,6 +3287,7 @@ func (sct *ShowCollectionsTask) Execute(ctx context.Context) error {
 			CreatedTimestamps:    make([]uint64, 0, len(resp.CollectionIDs)),
 			CreatedUtcTimestamps: make([]uint64, 0, len(resp.CollectionIDs)),
 			InMemoryPercentages:  make([]int64, 0, len(resp.CollectionIDs)),
+			LoadedFields:         make([]*milvuspb.LoadedFields, 0, len(resp.CollectionIDs)),
 		}
 
 		for offset, id := range resp.CollectionIDs {
@@ -2960,7 +3297,7 @@ func (sct *ShowCollectionsTask) Execute(ctx context.Context) error {
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
 				return errors.New("failed to show collections")
 			}
-			collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, collectionName)
+			collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, sct.DbName, collectionName)
 			if err != nil {
 				log.Debug("Failed to get collection info.", zap.Any("collectionName", collectionName),
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
@@ -2971,6 +3308,11 @@ func (sct *ShowCollectionsTask) Execute(ctx context.Context) error {
 			sct.result.CreatedTimestamps = append(sct.result.CreatedTimestamps, collectionInfo.createdTimestamp)
 			sct.result.CreatedUtcTimestamps = append(sct.result.CreatedUtcTimestamps, collectionInfo.createdUtcTimestamp)
 			sct.result.InMemoryPercentages = append(sct.result.InMemoryPercentages, resp.InMemoryPercentages[offset])
+			loadedFields := &milvuspb.LoadedFields{}
+			if offset < len(resp.LoadedFields) {
+				loadedFields.FieldNames = getFieldNames(collectionInfo.schema, resp.LoadedFields[offset].FieldIDs)
+			}
+			sct.result.LoadedFields = append(sct.result.LoadedFields, loadedFields)
 		}
 	} else {
 		sct.result = respFromRootCoord
@@ -2983,6 +3325,212 @@ func (sct *ShowCollectionsTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
+type CreateDatabaseTask struct {
+	Condition
+	*milvuspb.CreateDatabaseRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (cdt *CreateDatabaseTask) TraceCtx() context.Context {
+	return cdt.ctx
+}
+
+func (cdt *CreateDatabaseTask) ID() UniqueID {
+	return cdt.Base.MsgID
+}
+
+func (cdt *CreateDatabaseTask) SetID(uid UniqueID) {
+	cdt.Base.MsgID = uid
+}
+
+func (cdt *CreateDatabaseTask) Name() string {
+	return CreateDatabaseTaskName
+}
+
+func (cdt *CreateDatabaseTask) Type() commonpb.MsgType {
+	return cdt.Base.MsgType
+}
+
+func (cdt *CreateDatabaseTask) BeginTs() Timestamp {
+	return cdt.Base.Timestamp
+}
+
+func (cdt *CreateDatabaseTask) EndTs() Timestamp {
+	return cdt.Base.Timestamp
+}
+
+func (cdt *CreateDatabaseTask) SetTs(ts Timestamp) {
+	cdt.Base.Timestamp = ts
+}
+
+func (cdt *CreateDatabaseTask) OnEnqueue() error {
+	cdt.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (cdt *CreateDatabaseTask) PreExecute(ctx context.Context) error {
+	cdt.Base.MsgType = commonpb.MsgType_CreateDatabase
+	cdt.Base.SourceID = Params.ProxyID
+
+	return ValidateDatabaseName(cdt.DbName)
+}
+
+func (cdt *CreateDatabaseTask) Execute(ctx context.Context) error {
+	var err error
+	cdt.result, err = cdt.rootCoord.CreateDatabase(ctx, cdt.CreateDatabaseRequest)
+	if cdt.result == nil {
+		return errors.New("create database resp is nil")
+	}
+	if cdt.result.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(cdt.result.Reason)
+	}
+	return err
+}
+
+func (cdt *CreateDatabaseTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type DropDatabaseTask struct {
+	Condition
+	*milvuspb.DropDatabaseRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (ddt *DropDatabaseTask) TraceCtx() context.Context {
+	return ddt.ctx
+}
+
+func (ddt *DropDatabaseTask) ID() UniqueID {
+	return ddt.Base.MsgID
+}
+
+func (ddt *DropDatabaseTask) SetID(uid UniqueID) {
+	ddt.Base.MsgID = uid
+}
+
+func (ddt *DropDatabaseTask) Name() string {
+	return DropDatabaseTaskName
+}
+
+func (ddt *DropDatabaseTask) Type() commonpb.MsgType {
+	return ddt.Base.MsgType
+}
+
+func (ddt *DropDatabaseTask) BeginTs() Timestamp {
+	return ddt.Base.Timestamp
+}
+
+func (ddt *DropDatabaseTask) EndTs() Timestamp {
+	return ddt.Base.Timestamp
+}
+
+func (ddt *DropDatabaseTask) SetTs(ts Timestamp) {
+	ddt.Base.Timestamp = ts
+}
+
+func (ddt *DropDatabaseTask) OnEnqueue() error {
+	ddt.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (ddt *DropDatabaseTask) PreExecute(ctx context.Context) error {
+	ddt.Base.MsgType = commonpb.MsgType_DropDatabase
+	ddt.Base.SourceID = Params.ProxyID
+
+	if ddt.DbName == Params.DefaultDatabaseName {
+		return errors.New("the default database can't be dropped")
+	}
+	return ValidateDatabaseName(ddt.DbName)
+}
+
+func (ddt *DropDatabaseTask) Execute(ctx context.Context) error {
+	var err error
+	ddt.result, err = ddt.rootCoord.DropDatabase(ctx, ddt.DropDatabaseRequest)
+	if ddt.result == nil {
+		return errors.New("drop database resp is nil")
+	}
+	if ddt.result.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(ddt.result.Reason)
+	}
+	return err
+}
+
+func (ddt *DropDatabaseTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type ListDatabasesTask struct {
+	Condition
+	*milvuspb.ListDatabasesRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *milvuspb.ListDatabasesResponse
+}
+
+func (ldt *ListDatabasesTask) TraceCtx() context.Context {
+	return ldt.ctx
+}
+
+func (ldt *ListDatabasesTask) ID() UniqueID {
+	return ldt.Base.MsgID
+}
+
+func (ldt *ListDatabasesTask) SetID(uid UniqueID) {
+	ldt.Base.MsgID = uid
+}
+
+func (ldt *ListDatabasesTask) Name() string {
+	return ListDatabasesTaskName
+}
+
+func (ldt *ListDatabasesTask) Type() commonpb.MsgType {
+	return ldt.Base.MsgType
+}
+
+func (ldt *ListDatabasesTask) BeginTs() Timestamp {
+	return ldt.Base.Timestamp
+}
+
+func (ldt *ListDatabasesTask) EndTs() Timestamp {
+	return ldt.Base.Timestamp
+}
+
+func (ldt *ListDatabasesTask) SetTs(ts Timestamp) {
+	ldt.Base.Timestamp = ts
+}
+
+func (ldt *ListDatabasesTask) OnEnqueue() error {
+	ldt.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (ldt *ListDatabasesTask) PreExecute(ctx context.Context) error {
+	ldt.Base.MsgType = commonpb.MsgType_ListDatabases
+	ldt.Base.SourceID = Params.ProxyID
+	return nil
+}
+
+func (ldt *ListDatabasesTask) Execute(ctx context.Context) error {
+	var err error
+	ldt.result, err = ldt.rootCoord.ListDatabases(ctx, ldt.ListDatabasesRequest)
+	if ldt.result == nil {
+		return errors.New("list databases resp is nil")
+	}
+	if ldt.result.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(ldt.result.Status.Reason)
+	}
+	return err
+}
+
+func (ldt *ListDatabasesTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
 type CreatePartitionTask struct {
 	Condition
 	*milvuspb.CreatePartitionRequest
@@ -3294,7 +3842,7 @@ func (spt *ShowPartitionsTask) Execute(ctx context.Context) error {
 
 	if spt.GetType() == milvuspb.ShowType_InMemory {
 		collectionName := spt.CollectionName
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, spt.DbName, collectionName)
 		if err != nil {
 			log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
 				zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -3307,7 +3855,7 @@ func (spt *ShowPartitionsTask) Execute(ctx context.Context) error {
 		}
 		partitionIDs := make([]UniqueID, 0)
 		for _, partitionName := range spt.PartitionNames {
-			partitionID, err := globalMetaCache.GetPartitionID(ctx, collectionName, partitionName)
+			partitionID, err := globalMetaCache.GetPartitionID(ctx, spt.DbName, collectionName, partitionName)
 			if err != nil {
 				log.Debug("Failed to get partition id.", zap.Any("partitionName", partitionName),
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -3355,7 +3903,7 @@ func (spt *ShowPartitionsTask) Execute(ctx context.Context) error {
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
 				return errors.New("failed to show partitions")
 			}
-			partitionInfo, err := globalMetaCache.GetPartitionInfo(ctx, collectionName, partitionName)
+			partitionInfo, err := globalMetaCache.GetPartitionInfo(ctx, spt.DbName, collectionName, partitionName)
 			if err != nil {
 				log.Debug("Failed to get partition id.", zap.Any("partitionName", partitionName),
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -3711,7 +4259,7 @@ func (gibpt *GetIndexBuildProgressTask) PreExecute(ctx context.Context) error {
 
 func (gibpt *GetIndexBuildProgressTask) Execute(ctx context.Context) error {
 	collectionName := gibpt.CollectionName
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, gibpt.DbName, collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -3932,7 +4480,7 @@ func (gist *GetIndexStateTask) PreExecute(ctx context.Context) error {
 
 func (gist *GetIndexStateTask) Execute(ctx context.Context) error {
 	collectionName := gist.CollectionName
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, gist.DbName, collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -4134,7 +4682,7 @@ func (ft *FlushTask) PreExecute(ctx context.Context) error {
 func (ft *FlushTask) Execute(ctx context.Context) error {
 	coll2Segments := make(map[string]*schemapb.LongArray)
 	for _, collName := range ft.CollectionNames {
-		collID, err := globalMetaCache.GetCollectionID(ctx, collName)
+		collID, err := globalMetaCache.GetCollectionID(ctx, ft.DbName, collName)
 		if err != nil {
 			return err
 		}
@@ -4233,11 +4781,15 @@ func (lct *LoadCollectionTask) PreExecute(ctx context.Context) error {
 
 func (lct *LoadCollectionTask) Execute(ctx context.Context) (err error) {
 	log.Debug("LoadCollectionTask Execute", zap.String("role", Params.RoleName), zap.Int64("msgID", lct.Base.MsgID))
-	collID, err := globalMetaCache.GetCollectionID(ctx, lct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, lct.DbName, lct.CollectionName)
 	if err != nil {
 		return err
 	}
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lct.CollectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lct.DbName, lct.CollectionName)
+	if err != nil {
+		return err
+	}
+	loadFieldIDs, err := getLoadFieldIDs(collSchema, lct.LoadFields)
 	if err != nil {
 		return err
 	}
@@ -4249,9 +4801,12 @@ func (lct *LoadCollectionTask) Execute(ctx context.Context) (err error) {
 			Timestamp: lct.Base.Timestamp,
 			SourceID:  lct.Base.SourceID,
 		},
-		DbID:         0,
-		CollectionID: collID,
-		Schema:       collSchema,
+		DbID:           0,
+		CollectionID:   collID,
+		Schema:         collSchema,
+		ReplicaNumber:  lct.ReplicaNumber,
+		LoadFieldIDs:   loadFieldIDs,
+		ResourceGroups: lct.ResourceGroups,
 	}
 	log.Debug("send LoadCollectionRequest to query coordinator", zap.String("role", Params.RoleName), zap.Int64("msgID", request.Base.MsgID), zap.Int64("collectionID", request.CollectionID),
 		zap.Any("schema", request.Schema))
@@ -4262,6 +4817,50 @@ func (lct *LoadCollectionTask) Execute(ctx context.Context) (err error) {
 	return nil
 }
 
+// getLoadFieldIDs translates the names of the fields to load into their ids, the primary key is always loaded
+func getLoadFieldIDs(schema *schemapb.CollectionSchema, fieldNames []string) ([]int64, error) {
+	if len(fieldNames) == 0 {
+		return nil, nil
+	}
+	fieldIDs := make([]int64, 0, len(fieldNames)+1)
+	for _, field := range schema.Fields {
+		if field.IsPrimaryKey {
+			fieldIDs = append(fieldIDs, field.FieldID)
+		}
+	}
+	for _, name := range fieldNames {
+		found := false
+		for _, field := range schema.Fields {
+			if field.Name != name {
+				continue
+			}
+			found = true
+			if !field.IsPrimaryKey {
+				fieldIDs = append(fieldIDs, field.FieldID)
+			}
+			break
+		}
+		if !found {
+			return nil, fmt.Errorf("field %s not exist in collection %s", name, schema.Name)
+		}
+	}
+	return fieldIDs, nil
+}
+
+// getFieldNames translates the ids of the fields into their names
+func getFieldNames(schema *schemapb.CollectionSchema, fieldIDs []int64) []string {
+	fieldNames := make([]string, 0, len(fieldIDs))
+	for _, fieldID := range fieldIDs {
+		for _, field := range schema.Fields {
+			if field.FieldID == fieldID {
+				fieldNames = append(fieldNames, field.Name)
+				break
+			}
+		}
+	}
+	return fieldNames
+}
+
 func (lct *LoadCollectionTask) PostExecute(ctx context.Context) error {
 	log.Debug("LoadCollectionTask PostExecute", zap.String("role", Params.RoleName), zap.Int64("msgID", lct.Base.MsgID))
 	return nil
@@ -4327,7 +4926,7 @@ func (rct *ReleaseCollectionTask) PreExecute(ctx context.Context) error {
 }
 
 func (rct *ReleaseCollectionTask) Execute(ctx context.Context) (err error) {
-	collID, err := globalMetaCache.GetCollectionID(ctx, rct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, rct.DbName, rct.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -4413,16 +5012,16 @@ func (lpt *LoadPartitionTask) PreExecute(ctx context.Context) error {
 
 func (lpt *LoadPartitionTask) Execute(ctx context.Context) error {
 	var partitionIDs []int64
-	collID, err := globalMetaCache.GetCollectionID(ctx, lpt.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, lpt.DbName, lpt.CollectionName)
 	if err != nil {
 		return err
 	}
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lpt.CollectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lpt.DbName, lpt.CollectionName)
 	if err != nil {
 		return err
 	}
 	for _, partitionName := range lpt.PartitionNames {
-		partitionID, err := globalMetaCache.GetPartitionID(ctx, lpt.CollectionName, partitionName)
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, lpt.DbName, lpt.CollectionName, partitionName)
 		if err != nil {
 			return err
 		}
@@ -4435,10 +5034,12 @@ func (lpt *LoadPartitionTask) Execute(ctx context.Context) error {
 			Timestamp: lpt.Base.Timestamp,
 			SourceID:  lpt.Base.SourceID,
 		},
-		DbID:         0,
-		CollectionID: collID,
-		PartitionIDs: partitionIDs,
-		Schema:       collSchema,
+		DbID:           0,
+		CollectionID:   collID,
+		PartitionIDs:   partitionIDs,
+		Schema:         collSchema,
+		ReplicaNumber:  lpt.ReplicaNumber,
+		ResourceGroups: lpt.ResourceGroups,
 	}
 	lpt.result, err = lpt.queryCoord.LoadPartitions(ctx, request)
 	return err
@@ -4508,12 +5109,12 @@ func (rpt *ReleasePartitionTask) PreExecute(ctx context.Context) error {
 
 func (rpt *ReleasePartitionTask) Execute(ctx context.Context) (err error) {
 	var partitionIDs []int64
-	collID, err := globalMetaCache.GetCollectionID(ctx, rpt.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, rpt.DbName, rpt.CollectionName)
 	if err != nil {
 		return err
 	}
 	for _, partitionName := range rpt.PartitionNames {
-		partitionID, err := globalMetaCache.GetPartitionID(ctx, rpt.CollectionName, partitionName)
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, rpt.DbName, rpt.CollectionName, partitionName)
 		if err != nil {
 			return err
 		}
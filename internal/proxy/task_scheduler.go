@@ -658,6 +658,13 @@ func (qr *queryResultBuf) addPartialResult(result *internalpb.RetrieveResults) {
 		result.GlobalSealedSegmentIDs)
 }
 
+// resultBufKey identifies the results of a request answered by a replica, a failed request
+// is published again to the next replica and its results are collected separately
+type resultBufKey struct {
+	reqID     UniqueID
+	replicaID UniqueID
+}
+
 func (sched *TaskScheduler) collectResultLoop() {
 	defer sched.wg.Done()
 
@@ -669,10 +676,10 @@ func (sched *TaskScheduler) collectResultLoop() {
 	queryResultMsgStream.Start()
 	defer queryResultMsgStream.Close()
 
-	searchResultBufs := make(map[UniqueID]*searchResultBuf)
-	searchResultBufFlags := make(map[UniqueID]bool) // if value is true, we can ignore queryResult
-	queryResultBufs := make(map[UniqueID]*queryResultBuf)
-	queryResultBufFlags := make(map[UniqueID]bool) // if value is true, we can ignore queryResult
+	searchResultBufs := make(map[resultBufKey]*searchResultBuf)
+	searchResultBufFlags := make(map[resultBufKey]bool) // if value is true, we can ignore queryResult
+	queryResultBufs := make(map[resultBufKey]*queryResultBuf)
+	queryResultBufFlags := make(map[resultBufKey]bool) // if value is true, we can ignore queryResult
 
 	for {
 		select {
@@ -691,9 +698,10 @@ func (sched *TaskScheduler) collectResultLoop() {
 				if searchResultMsg, srOk := tsMsg.(*msgstream.SearchResultMsg); srOk {
 					reqID := searchResultMsg.Base.MsgID
 					reqIDStr := strconv.FormatInt(reqID, 10)
-					ignoreThisResult, ok := searchResultBufFlags[reqID]
+					bufKey := resultBufKey{reqID: reqID, replicaID: searchResultMsg.ReplicaID}
+					ignoreThisResult, ok := searchResultBufFlags[bufKey]
 					if !ok {
-						searchResultBufFlags[reqID] = false
+						searchResultBufFlags[bufKey] = false
 						ignoreThisResult = false
 					}
 					if ignoreThisResult {
@@ -704,27 +712,27 @@ func (sched *TaskScheduler) collectResultLoop() {
 					log.Debug("Proxy collectResultLoop Got a SearchResultMsg", zap.Any("ReqID", reqID))
 					if t == nil {
 						log.Debug("Proxy collectResultLoop GetTaskByReqID failed", zap.String("reqID", reqIDStr))
-						delete(searchResultBufs, reqID)
-						searchResultBufFlags[reqID] = true
+						delete(searchResultBufs, bufKey)
+						searchResultBufFlags[bufKey] = true
 						continue
 					}
 
 					st, ok := t.(*SearchTask)
 					if !ok {
 						log.Debug("Proxy collectResultLoop type assert t as SearchTask failed", zap.Any("ReqID", reqID))
-						delete(searchResultBufs, reqID)
-						searchResultBufFlags[reqID] = true
+						delete(searchResultBufs, bufKey)
+						searchResultBufFlags[bufKey] = true
 						continue
 					}
 
-					resultBuf, ok := searchResultBufs[reqID]
+					resultBuf, ok := searchResultBufs[bufKey]
 					if !ok {
 						resultBuf = newSearchResultBuf()
 						vchans, err := st.getVChannels()
 						log.Debug("Proxy collectResultLoop, first receive", zap.Any("reqID", reqID), zap.Any("vchans", vchans),
 							zap.Error(err))
 						if err != nil {
-							delete(searchResultBufs, reqID)
+							delete(searchResultBufs, bufKey)
 							continue
 						}
 						for _, vchan := range vchans {
@@ -734,24 +742,24 @@ func (sched *TaskScheduler) collectResultLoop() {
 						log.Debug("Proxy collectResultLoop, first receive", zap.Any("reqID", reqID), zap.Any("pchans", pchans),
 							zap.Error(err))
 						if err != nil {
-							delete(searchResultBufs, reqID)
+							delete(searchResultBufs, bufKey)
 							continue
 						}
-						searchResultBufs[reqID] = resultBuf
+						searchResultBufs[bufKey] = resultBuf
 					}
 					resultBuf.addPartialResult(&searchResultMsg.SearchResults)
 
 					//t := sched.getTaskByReqID(reqID)
 					{
 						colName := t.(*SearchTask).query.CollectionName
-						log.Debug("Proxy collectResultLoop", zap.String("collection name", colName), zap.String("reqID", reqIDStr), zap.Int("answer cnt", len(searchResultBufs[reqID].resultBuf)))
+						log.Debug("Proxy collectResultLoop", zap.String("collection name", colName), zap.String("reqID", reqIDStr), zap.Int("answer cnt", len(searchResultBufs[bufKey].resultBuf)))
 					}
 
 					if resultBuf.readyToReduce() {
 						log.Debug("Proxy collectResultLoop readyToReduce and assign to reduce")
-						searchResultBufFlags[reqID] = true
+						searchResultBufFlags[bufKey] = true
 						st.resultBuf <- resultBuf.resultBuf
-						delete(searchResultBufs, reqID)
+						delete(searchResultBufs, bufKey)
 					}
 
 					sp.Finish()
@@ -790,9 +798,10 @@ func (sched *TaskScheduler) collectResultLoop() {
 
 					reqID := queryResultMsg.Base.MsgID
 					reqIDStr := strconv.FormatInt(reqID, 10)
-					ignoreThisResult, ok := queryResultBufFlags[reqID]
+					bufKey := resultBufKey{reqID: reqID, replicaID: queryResultMsg.ReplicaID}
+					ignoreThisResult, ok := queryResultBufFlags[bufKey]
 					if !ok {
-						queryResultBufFlags[reqID] = false
+						queryResultBufFlags[bufKey] = false
 						ignoreThisResult = false
 					}
 					if ignoreThisResult {
@@ -803,27 +812,27 @@ func (sched *TaskScheduler) collectResultLoop() {
 					log.Debug("Proxy collectResultLoop Got a queryResultMsg", zap.Any("ReqID", reqID))
 					if t == nil {
 						log.Debug("Proxy collectResultLoop GetTaskByReqID failed", zap.String("reqID", reqIDStr))
-						delete(queryResultBufs, reqID)
-						queryResultBufFlags[reqID] = true
+						delete(queryResultBufs, bufKey)
+						queryResultBufFlags[bufKey] = true
 						continue
 					}
 
 					st, ok := t.(*QueryTask)
 					if !ok {
 						log.Debug("Proxy collectResultLoop type assert t as QueryTask failed")
-						delete(queryResultBufs, reqID)
-						queryResultBufFlags[reqID] = true
+						delete(queryResultBufs, bufKey)
+						queryResultBufFlags[bufKey] = true
 						continue
 					}
 
-					resultBuf, ok := queryResultBufs[reqID]
+					resultBuf, ok := queryResultBufs[bufKey]
 					if !ok {
 						resultBuf = newQueryResultBuf()
 						vchans, err := st.getVChannels()
 						log.Debug("Proxy collectResultLoop, first receive", zap.Any("reqID", reqID), zap.Any("vchans", vchans),
 							zap.Error(err))
 						if err != nil {
-							delete(queryResultBufs, reqID)
+							delete(queryResultBufs, bufKey)
 							continue
 						}
 						for _, vchan := range vchans {
@@ -833,24 +842,24 @@ func (sched *TaskScheduler) collectResultLoop() {
 						log.Debug("Proxy collectResultLoop, first receive", zap.Any("reqID", reqID), zap.Any("pchans", pchans),
 							zap.Error(err))
 						if err != nil {
-							delete(queryResultBufs, reqID)
+							delete(queryResultBufs, bufKey)
 							continue
 						}
-						queryResultBufs[reqID] = resultBuf
+						queryResultBufs[bufKey] = resultBuf
 					}
 					resultBuf.addPartialResult(&queryResultMsg.RetrieveResults)
 
 					//t := sched.getTaskByReqID(reqID)
 					{
 						colName := t.(*QueryTask).query.CollectionName
-						log.Debug("Proxy collectResultLoop", zap.String("collection name", colName), zap.String("reqID", reqIDStr), zap.Int("answer cnt", len(queryResultBufs[reqID].resultBuf)))
+						log.Debug("Proxy collectResultLoop", zap.String("collection name", colName), zap.String("reqID", reqIDStr), zap.Int("answer cnt", len(queryResultBufs[bufKey].resultBuf)))
 					}
 
 					if resultBuf.readyToReduce() {
 						log.Debug("Proxy collectResultLoop readyToReduce and assign to reduce")
-						queryResultBufFlags[reqID] = true
+						queryResultBufFlags[bufKey] = true
 						st.resultBuf <- resultBuf.resultBuf
-						delete(queryResultBufs, reqID)
+						delete(queryResultBufs, bufKey)
 					}
 					sp.Finish()
 				}
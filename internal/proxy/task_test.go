@@ -3,7 +3,11 @@ package proxy
 import (
 	"testing"
 
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 
@@ -508,3 +512,202 @@ func TestTranslateOutputFields(t *testing.T) {
 	assert.Equal(t, nil, err)
 	assert.ElementsMatch(t, []string{idFieldName, floatVectorFieldName, binaryVectorFieldName}, outputFields)
 }
+
+func TestTranslateOrderByFields(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "TestTranslateOrderByFields",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "timestamp", DataType: schemapb.DataType_Int64},
+			{FieldID: 102, Name: "float_vector", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	schemaHelper, err := typeutil.CreateSchemaHelper(schema)
+	assert.Nil(t, err)
+
+	orderBy, err := translateOrderByFields(nil, schemaHelper)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(orderBy))
+
+	orderBy, err = translateOrderByFields([]*milvuspb.OrderByField{
+		{FieldName: "timestamp", Descending: true},
+		{FieldName: "id"},
+	}, schemaHelper)
+	assert.Nil(t, err)
+	assert.Equal(t, []*internalpb.OrderByField{
+		{FieldID: 101, Descending: true},
+		{FieldID: 100},
+	}, orderBy)
+
+	_, err = translateOrderByFields([]*milvuspb.OrderByField{{FieldName: "float_vector"}}, schemaHelper)
+	assert.NotNil(t, err)
+
+	_, err = translateOrderByFields([]*milvuspb.OrderByField{{FieldName: "not_exist"}}, schemaHelper)
+	assert.NotNil(t, err)
+
+	_, err = translateOrderByFields([]*milvuspb.OrderByField{{FieldName: "id"}, {FieldName: "id", Descending: true}}, schemaHelper)
+	assert.NotNil(t, err)
+}
+
+func TestGetLoadFieldIDs(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "TestGetLoadFieldIDs",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "timestamp", DataType: schemapb.DataType_Int64},
+			{FieldID: 102, Name: "float_vector", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+
+	fieldIDs, err := getLoadFieldIDs(schema, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(fieldIDs))
+
+	fieldIDs, err = getLoadFieldIDs(schema, []string{"float_vector"})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{100, 102}, fieldIDs)
+
+	fieldIDs, err = getLoadFieldIDs(schema, []string{"id", "float_vector"})
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{100, 102}, fieldIDs)
+
+	_, err = getLoadFieldIDs(schema, []string{"not_exist"})
+	assert.NotNil(t, err)
+
+	assert.Equal(t, []string{"id", "float_vector"}, getFieldNames(schema, []int64{100, 102, 103}))
+}
+
+func TestMergeSortedQueryResults(t *testing.T) {
+	genResult := func(ids []int64, timestamps []int64) *internalpb.RetrieveResults {
+		genField := func(fieldID int64, data []int64) *schemapb.FieldData {
+			return &schemapb.FieldData{
+				Type:    schemapb.DataType_Int64,
+				FieldId: fieldID,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{
+							LongData: &schemapb.LongArray{Data: data},
+						},
+					},
+				},
+			}
+		}
+		return &internalpb.RetrieveResults{
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{Data: ids},
+				},
+			},
+			FieldsData: []*schemapb.FieldData{genField(100, ids), genField(101, timestamps)},
+		}
+	}
+
+	results := []*internalpb.RetrieveResults{
+		genResult([]int64{1, 2, 3}, []int64{30, 20, 10}),
+		{Ids: nil},
+		genResult([]int64{4, 5}, []int64{25, 5}),
+	}
+	orderBy := []*internalpb.OrderByField{{FieldID: 101, Descending: true}}
+
+	fieldsData, err := mergeSortedQueryResults(results, orderBy, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(fieldsData))
+	assert.Equal(t, []int64{1, 4, 2}, fieldsData[0].GetScalars().GetLongData().Data)
+	assert.Equal(t, []int64{30, 25, 20}, fieldsData[1].GetScalars().GetLongData().Data)
+
+	fieldsData, err = mergeSortedQueryResults(results, nil, 4)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1, 2, 3, 4}, fieldsData[0].GetScalars().GetLongData().Data)
+
+	fieldsData, err = mergeSortedQueryResults([]*internalpb.RetrieveResults{{Ids: nil}}, orderBy, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(fieldsData))
+}
+
+func TestRemoveFieldsData(t *testing.T) {
+	fieldsData := []*schemapb.FieldData{{FieldId: 100}, {FieldId: 101}, {FieldId: 102}}
+
+	assert.Equal(t, fieldsData, removeFieldsData(fieldsData, nil))
+
+	ret := removeFieldsData(fieldsData, []UniqueID{101, 102})
+	assert.Equal(t, 1, len(ret))
+	assert.Equal(t, int64(100), ret[0].FieldId)
+}
+
+type mockVChannelsMgr struct {
+	channelsMgr
+	vChannels []vChan
+}
+
+func (m *mockVChannelsMgr) getVChannels(collectionID UniqueID) ([]vChan, error) {
+	return m.vChannels, nil
+}
+
+type mockModMsgStream struct {
+	msgstream.MsgStream
+	channelNum uint32
+}
+
+func (m *mockModMsgStream) ComputeProduceChannelIndexes(tsMsgs []msgstream.TsMsg) [][]int32 {
+	indexes := make([][]int32, len(tsMsgs))
+	for i, msg := range tsMsgs {
+		for _, hashValue := range msg.HashKeys() {
+			indexes[i] = append(indexes[i], int32(hashValue%m.channelNum))
+		}
+	}
+	return indexes
+}
+
+func TestUpsertTask_genDeleteMsgs(t *testing.T) {
+	ut := &UpsertTask{
+		InsertTask: InsertTask{
+			BaseInsertTask: BaseInsertTask{
+				BaseMsg: msgstream.BaseMsg{
+					HashValues:     []uint32{0, 1, 2, 3},
+					BeginTimestamp: 100,
+					EndTimestamp:   100,
+				},
+				InsertRequest: internalpb.InsertRequest{
+					Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_Insert},
+					CollectionName: "coll",
+					CollectionID:   1,
+				},
+			},
+			result: &milvuspb.MutationResult{
+				IDs: &schemapb.IDs{
+					IdField: &schemapb.IDs_IntId{
+						IntId: &schemapb.LongArray{Data: []int64{10, 11, 12, 13}},
+					},
+				},
+			},
+			chMgr: &mockVChannelsMgr{vChannels: []vChan{"dml_0", "dml_1"}},
+		},
+	}
+
+	msgs, err := ut.genDeleteMsgs(&mockModMsgStream{channelNum: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(msgs))
+
+	del0 := msgs[0].(*msgstream.DeleteMsg)
+	assert.Equal(t, commonpb.MsgType_Delete, del0.Type())
+	assert.Equal(t, "dml_0", del0.ChannelID)
+	assert.Equal(t, int64(1), del0.CollectionID)
+	assert.Equal(t, []int64{10, 12}, del0.PrimaryKeys)
+	assert.Equal(t, []uint64{100, 100}, del0.Timestamps)
+	assert.Equal(t, []uint32{0, 2}, del0.HashValues)
+
+	del1 := msgs[1].(*msgstream.DeleteMsg)
+	assert.Equal(t, "dml_1", del1.ChannelID)
+	assert.Equal(t, []int64{11, 13}, del1.PrimaryKeys)
+
+	// misaligned primary keys
+	ut.HashValues = []uint32{0}
+	_, err = ut.genDeleteMsgs(&mockModMsgStream{channelNum: 2})
+	assert.NotNil(t, err)
+}
+
+func TestCheckDuplicatePrimaryKeys(t *testing.T) {
+	assert.Nil(t, checkDuplicatePrimaryKeys(nil))
+	assert.Nil(t, checkDuplicatePrimaryKeys([]int64{1, 2, 3}))
+	assert.NotNil(t, checkDuplicatePrimaryKeys([]int64{1, 2, 1}))
+}
@@ -21,6 +21,11 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
 
+const (
+	minPasswordLength = 6
+	maxPasswordLength = 256
+)
+
 func isAlpha(c uint8) bool {
 	if (c < 'A' || c > 'Z') && (c < 'a' || c > 'z') {
 		return false
@@ -65,6 +70,78 @@ func ValidateCollectionName(collName string) error {
 	return nil
 }
 
+func ValidateDatabaseName(dbName string) error {
+	if dbName == "" {
+		return errors.New("Database name should not be empty")
+	}
+
+	invalidMsg := "Invalid database name: " + dbName + ". "
+	if int64(len(dbName)) > Params.MaxNameLength {
+		msg := invalidMsg + "The length of a database name must be less than " +
+			strconv.FormatInt(Params.MaxNameLength, 10) + " characters."
+		return errors.New(msg)
+	}
+
+	firstChar := dbName[0]
+	if firstChar != '_' && !isAlpha(firstChar) {
+		msg := invalidMsg + "The first character of a database name must be an underscore or letter."
+		return errors.New(msg)
+	}
+
+	for i := 1; i < len(dbName); i++ {
+		c := dbName[i]
+		if c != '_' && !isAlpha(c) && !isNumber(c) {
+			msg := invalidMsg + "Database name can only contain numbers, letters and underscores."
+			return errors.New(msg)
+		}
+	}
+	return nil
+}
+
+// validateIdentifier checks a user or role name of kind, the rule is the same as database names
+func validateIdentifier(kind string, name string) error {
+	if name == "" {
+		return errors.New(strings.Title(kind) + " name should not be empty")
+	}
+
+	invalidMsg := "Invalid " + kind + " name: " + name + ". "
+	if int64(len(name)) > Params.MaxNameLength {
+		msg := invalidMsg + "The length of a " + kind + " name must be less than " +
+			strconv.FormatInt(Params.MaxNameLength, 10) + " characters."
+		return errors.New(msg)
+	}
+
+	firstChar := name[0]
+	if firstChar != '_' && !isAlpha(firstChar) {
+		msg := invalidMsg + "The first character of a " + kind + " name must be an underscore or letter."
+		return errors.New(msg)
+	}
+
+	for i := 1; i < len(name); i++ {
+		c := name[i]
+		if c != '_' && !isAlpha(c) && !isNumber(c) {
+			msg := invalidMsg + strings.Title(kind) + " name can only contain numbers, letters and underscores."
+			return errors.New(msg)
+		}
+	}
+	return nil
+}
+
+func ValidateUsername(username string) error {
+	return validateIdentifier("user", username)
+}
+
+func ValidateRoleName(roleName string) error {
+	return validateIdentifier("role", roleName)
+}
+
+func ValidatePassword(password string) error {
+	if len(password) < minPasswordLength || len(password) > maxPasswordLength {
+		return fmt.Errorf("The length of password must be between %d and %d characters", minPasswordLength, maxPasswordLength)
+	}
+	return nil
+}
+
 func ValidatePartitionTag(partitionTag string, strictCheck bool) error {
 	partitionTag = strings.TrimSpace(partitionTag)
 
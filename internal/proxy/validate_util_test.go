@@ -51,6 +51,48 @@ func TestValidateCollectionName(t *testing.T) {
 	}
 }
 
+func TestValidateDatabaseName(t *testing.T) {
+	assert.Nil(t, ValidateDatabaseName("db"))
+	assert.Nil(t, ValidateDatabaseName("_team_1"))
+
+	longName := make([]byte, 256)
+	for i := 0; i < len(longName); i++ {
+		longName[i] = 'a'
+	}
+	invalidNames := []string{
+		"1db",
+		"db$",
+		"db 1",
+		" ",
+		"",
+		string(longName),
+		"中文",
+	}
+
+	for _, name := range invalidNames {
+		assert.NotNil(t, ValidateDatabaseName(name))
+	}
+}
+
+func TestValidateUsername(t *testing.T) {
+	assert.Nil(t, ValidateUsername("root"))
+	assert.Nil(t, ValidateUsername("_user_1"))
+	assert.NotNil(t, ValidateUsername(""))
+	assert.NotNil(t, ValidateUsername("1user"))
+	assert.NotNil(t, ValidateUsername("user:1"))
+
+	assert.Nil(t, ValidateRoleName("admin"))
+	assert.NotNil(t, ValidateRoleName("role*"))
+}
+
+func TestValidatePassword(t *testing.T) {
+	assert.Nil(t, ValidatePassword("Milvus"))
+	assert.Nil(t, ValidatePassword("pass:word with spaces"))
+	assert.NotNil(t, ValidatePassword(""))
+	assert.NotNil(t, ValidatePassword("12345"))
+	assert.NotNil(t, ValidatePassword(string(make([]byte, maxPasswordLength+1))))
+}
+
 func TestValidatePartitionTag(t *testing.T) {
 	assert.Nil(t, ValidatePartitionTag("abc", true))
 	assert.Nil(t, ValidatePartitionTag("123abc", true))
@@ -17,6 +17,7 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"sync"
 
@@ -34,6 +35,9 @@ import (
 const (
 	queryNodeMetaPrefix = "queryCoord-queryNodeMeta"
 	queryNodeInfoPrefix = "queryCoord-queryNodeInfo"
+
+	// the query nodes without a resource group label join the default resource group
+	defaultResourceGroup = "__default_resource_group"
 )
 
 type Cluster interface {
@@ -59,9 +63,15 @@ type Cluster interface {
 	getNodeByID(nodeID int64) (Node, error)
 	removeNodeInfo(nodeID int64) error
 	stopNode(nodeID int64)
+	drainNode(nodeID int64) error
 	onServiceNodes() (map[int64]Node, error)
+	servingNodes() map[int64]Node
 	isOnService(nodeID int64) (bool, error)
 
+	transferNode(nodeID int64, rg string) error
+	resourceGroups() map[string][]int64
+	resourceGroupNodes(rg string) []int64
+
 	printMeta()
 }
 
@@ -160,6 +170,10 @@ func (c *queryNodeCluster) loadSegments(ctx context.Context, nodeID int64, in *q
 	defer c.Unlock()
 
 	if node, ok := c.nodes[nodeID]; ok {
+		// the segments of the collections loaded with a part of the fields only load these fields
+		if len(in.LoadFieldIDs) == 0 && len(in.Infos) > 0 {
+			in.LoadFieldIDs, _ = c.clusterMeta.getLoadFieldIDs(in.Infos[0].CollectionID)
+		}
 		segmentInfos := make(map[UniqueID]*querypb.SegmentInfo)
 		for _, info := range in.Infos {
 			segmentID := info.SegmentID
@@ -168,7 +182,13 @@ func (c *queryNodeCluster) loadSegments(ctx context.Context, nodeID int64, in *q
 				segmentInfos[segmentID] = proto.Clone(segmentInfo).(*querypb.SegmentInfo)
 				if in.LoadCondition != querypb.TriggerCondition_loadBalance {
 					segmentInfo.SegmentState = querypb.SegmentState_sealing
-					segmentInfo.NodeID = nodeID
+					// the segment may have been loaded by the other replicas
+					nodeIDs := segmentNodeIDs(segmentInfo)
+					if !segmentOnNode(segmentInfo, nodeID) {
+						nodeIDs = append(nodeIDs, nodeID)
+					}
+					segmentInfo.NodeID = nodeIDs[0]
+					segmentInfo.NodeIds = nodeIDs
 				}
 			} else {
 				segmentInfo = &querypb.SegmentInfo{
@@ -176,7 +196,11 @@ func (c *queryNodeCluster) loadSegments(ctx context.Context, nodeID int64, in *q
 					CollectionID: info.CollectionID,
 					PartitionID:  info.PartitionID,
 					NodeID:       nodeID,
+					NodeIds:      []int64{nodeID},
 					SegmentState: querypb.SegmentState_sealing,
+					// replaced by the actual memory usage once the query node loads the segment
+					MemSize: estimateSegmentSize(in.Schema, info),
+					NumRows: info.NumOfRows,
 				}
 			}
 			c.clusterMeta.setSegmentInfo(segmentID, segmentInfo)
@@ -215,7 +239,7 @@ func (c *queryNodeCluster) releaseSegments(ctx context.Context, nodeID int64, in
 		}
 
 		for _, segmentID := range in.SegmentIDs {
-			c.clusterMeta.deleteSegmentInfoByID(segmentID)
+			c.clusterMeta.removeSegmentNode(segmentID, nodeID)
 		}
 		return nil
 	}
@@ -228,6 +252,9 @@ func (c *queryNodeCluster) watchDmChannels(ctx context.Context, nodeID int64, in
 	defer c.Unlock()
 
 	if node, ok := c.nodes[nodeID]; ok {
+		if len(in.LoadFieldIDs) == 0 {
+			in.LoadFieldIDs, _ = c.clusterMeta.getLoadFieldIDs(in.CollectionID)
+		}
 		err := node.watchDmChannels(ctx, in)
 		if err != nil {
 			log.Debug("WatchDmChannels: queryNode watch dm channel error", zap.String("error", err.Error()))
@@ -352,6 +379,25 @@ func (c *queryNodeCluster) getSegmentInfo(ctx context.Context, in *querypb.GetSe
 	return segmentInfos, nil
 }
 
+// getSegmentInfoByNode returns the infos of the segments loaded on the query node
+func (c *queryNodeCluster) getSegmentInfoByNode(ctx context.Context, nodeID int64, in *querypb.GetSegmentInfoRequest) ([]*querypb.SegmentInfo, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	if node, ok := c.nodes[nodeID]; ok {
+		res, err := node.getSegmentInfo(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			return nil, fmt.Errorf("getSegmentInfoByNode: query node %d failed to get segment info", nodeID)
+		}
+		return res.Infos, nil
+	}
+
+	return nil, errors.New("getSegmentInfoByNode: can't find query node by nodeID")
+}
+
 type queryNodeGetMetricsResponse struct {
 	resp *milvuspb.GetMetricsResponse
 	err  error
@@ -409,13 +455,45 @@ func (c *queryNodeCluster) getNumSegments(nodeID int64) (int, error) {
 		segmentInfos = append(segmentInfos, res...)
 	}
 	for _, info := range segmentInfos {
-		if info.NodeID == nodeID {
+		if segmentOnNode(info, nodeID) {
 			numSegment++
 		}
 	}
 	return numSegment, nil
 }
 
+// getMemoryUsage returns the estimated memory used by the segments on the query node and its memory capacity
+func (c *queryNodeCluster) getMemoryUsage(nodeID int64) (uint64, uint64, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	node, ok := c.nodes[nodeID]
+	if !ok {
+		return 0, 0, errors.New("getMemoryUsage: Can't find query node by nodeID ")
+	}
+
+	memUsage := uint64(0)
+	collectionInfos := c.clusterMeta.showCollections()
+	for _, info := range collectionInfos {
+		for _, segmentInfo := range c.clusterMeta.showSegmentInfos(info.CollectionID, nil) {
+			if segmentOnNode(segmentInfo, nodeID) {
+				memUsage += uint64(segmentInfo.MemSize)
+			}
+		}
+	}
+	return memUsage, node.getMemoryCapacity(), nil
+}
+
+// updateMemoryCapacity refreshes the memory capacities reported by the on service query nodes
+func (c *queryNodeCluster) updateMemoryCapacity(ctx context.Context) {
+	nodes, _ := c.onServiceNodes()
+	for nodeID, node := range nodes {
+		if err := node.updateMemoryCapacity(ctx); err != nil {
+			log.Warn("updateMemoryCapacity: get queryNode memory capacity failed", zap.Int64("nodeID", nodeID), zap.Error(err))
+		}
+	}
+}
+
 func (c *queryNodeCluster) registerNode(ctx context.Context, session *sessionutil.Session, id UniqueID) error {
 	c.Lock()
 	defer c.Unlock()
@@ -436,7 +514,9 @@ func (c *queryNodeCluster) registerNode(ctx context.Context, session *sessionuti
 			log.Debug("RegisterNode: create a new query node failed", zap.Int64("nodeID", id), zap.Error(err))
 			return err
 		}
-		log.Debug("RegisterNode: create a new query node", zap.Int64("nodeID", id), zap.String("address", session.Address))
+		c.nodes[id].setResourceGroup(session.ResourceGroup)
+		log.Debug("RegisterNode: create a new query node", zap.Int64("nodeID", id), zap.String("address", session.Address),
+			zap.String("resourceGroup", c.nodes[id].getResourceGroup()))
 
 		go func() {
 			err = c.nodes[id].start()
@@ -496,6 +576,19 @@ func (c *queryNodeCluster) stopNode(nodeID int64) {
 	}
 }
 
+// drainNode marks the node as stopping, it is no longer assigned new segments or channels
+func (c *queryNodeCluster) drainNode(nodeID int64) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if node, ok := c.nodes[nodeID]; ok {
+		node.setStopping()
+		log.Debug("DrainNode: queryNode is stopping", zap.Int64("nodeID", nodeID))
+		return nil
+	}
+	return fmt.Errorf("DrainNode: query node %d not exist", nodeID)
+}
+
 func (c *queryNodeCluster) onServiceNodes() (map[int64]Node, error) {
 	c.RLock()
 	defer c.RUnlock()
@@ -503,13 +596,95 @@ func (c *queryNodeCluster) onServiceNodes() (map[int64]Node, error) {
 	return c.getOnServiceNodes()
 }
 
-func (c *queryNodeCluster) getOnServiceNodes() (map[int64]Node, error) {
+// servingNodes returns the nodes on service including the stopping ones, which still serve their segments
+func (c *queryNodeCluster) servingNodes() map[int64]Node {
+	c.RLock()
+	defer c.RUnlock()
+
 	nodes := make(map[int64]Node)
 	for nodeID, node := range c.nodes {
 		if node.isOnService() {
 			nodes[nodeID] = node
 		}
 	}
+	return nodes
+}
+
+// transferNode moves the node into the resource group, the group is saved with the node info
+// so that it survives the restart of query coord
+func (c *queryNodeCluster) transferNode(nodeID int64, rg string) error {
+	c.Lock()
+	defer c.Unlock()
+
+	node, ok := c.nodes[nodeID]
+	if !ok {
+		return fmt.Errorf("TransferNode: query node %d not exist", nodeID)
+	}
+	key := fmt.Sprintf("%s/%d", queryNodeInfoPrefix, nodeID)
+	value, err := c.client.Load(key)
+	if err != nil {
+		return err
+	}
+	session := &sessionutil.Session{}
+	if err = json.Unmarshal([]byte(value), session); err != nil {
+		return err
+	}
+	session.ResourceGroup = rg
+	sessionJSON, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	if err = c.client.Save(key, string(sessionJSON)); err != nil {
+		return err
+	}
+	node.setResourceGroup(rg)
+	log.Debug("TransferNode: query node joins resource group", zap.Int64("nodeID", nodeID), zap.String("resourceGroup", rg))
+	return nil
+}
+
+// resourceGroups returns the nodes of every resource group, the default resource group is always listed
+func (c *queryNodeCluster) resourceGroups() map[string][]int64 {
+	c.RLock()
+	defer c.RUnlock()
+
+	groups := map[string][]int64{defaultResourceGroup: {}}
+	for nodeID, node := range c.nodes {
+		rg := node.getResourceGroup()
+		groups[rg] = append(groups[rg], nodeID)
+	}
+	for _, nodeIDs := range groups {
+		sort.Slice(nodeIDs, func(i, j int) bool {
+			return nodeIDs[i] < nodeIDs[j]
+		})
+	}
+	return groups
+}
+
+// resourceGroupNodes returns the on service nodes of the resource group
+func (c *queryNodeCluster) resourceGroupNodes(rg string) []int64 {
+	c.RLock()
+	defer c.RUnlock()
+
+	nodes, _ := c.getOnServiceNodes()
+	nodeIDs := make([]int64, 0)
+	for nodeID, node := range nodes {
+		if node.getResourceGroup() == rg {
+			nodeIDs = append(nodeIDs, nodeID)
+		}
+	}
+	sort.Slice(nodeIDs, func(i, j int) bool {
+		return nodeIDs[i] < nodeIDs[j]
+	})
+	return nodeIDs
+}
+
+func (c *queryNodeCluster) getOnServiceNodes() (map[int64]Node, error) {
+	nodes := make(map[int64]Node)
+	for nodeID, node := range c.nodes {
+		if node.isOnService() && !node.isStopping() {
+			nodes[nodeID] = node
+		}
+	}
 	if len(nodes) == 0 {
 		return nil, errors.New("GetOnServiceNodes: no queryNode is alive")
 	}
@@ -66,3 +66,67 @@ func TestReloadClusterFromKV(t *testing.T) {
 	collection := cluster.getCollectionInfosByID(context.Background(), 100)
 	assert.Equal(t, defaultCollectionID, collection[0].CollectionID)
 }
+
+func TestQueryNodeCluster_drainNode(t *testing.T) {
+	cluster := &queryNodeCluster{
+		nodes: map[int64]Node{
+			1: &queryNode{id: 1, onService: true},
+			2: &queryNode{id: 2, onService: true},
+		},
+	}
+
+	err := cluster.drainNode(1)
+	assert.Nil(t, err)
+	err = cluster.drainNode(3)
+	assert.NotNil(t, err)
+
+	// the stopping node gets no new segment or channel, but still serves the loaded ones
+	nodes, err := cluster.onServiceNodes()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(nodes))
+	_, ok := nodes[2]
+	assert.True(t, ok)
+	assert.Equal(t, 2, len(cluster.servingNodes()))
+}
+
+func TestQueryNodeCluster_resourceGroup(t *testing.T) {
+	refreshParams()
+	kv, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
+	assert.Nil(t, err)
+	cluster := &queryNodeCluster{
+		client: kv,
+		nodes: map[int64]Node{
+			1: &queryNode{id: 1, onService: true},
+			2: &queryNode{id: 2, onService: true, resourceGroup: "rg1"},
+			3: &queryNode{id: 3, onService: true},
+		},
+	}
+
+	groups := cluster.resourceGroups()
+	assert.Equal(t, 2, len(groups))
+	assert.Equal(t, []int64{1, 3}, groups[defaultResourceGroup])
+	assert.Equal(t, []int64{2}, groups["rg1"])
+	assert.Equal(t, []int64{2}, cluster.resourceGroupNodes("rg1"))
+	assert.Equal(t, 0, len(cluster.resourceGroupNodes("rg2")))
+
+	sessionBlob, err := json.Marshal(&sessionutil.Session{ServerID: 3, Address: "localhost"})
+	assert.Nil(t, err)
+	err = kv.Save(fmt.Sprintf("%s/%d", queryNodeInfoPrefix, 3), string(sessionBlob))
+	assert.Nil(t, err)
+
+	err = cluster.transferNode(3, "rg1")
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{2, 3}, cluster.resourceGroupNodes("rg1"))
+	assert.Equal(t, []int64{1}, cluster.resourceGroupNodes(defaultResourceGroup))
+
+	// the resource group survives a query coord restart
+	value, err := kv.Load(fmt.Sprintf("%s/%d", queryNodeInfoPrefix, 3))
+	assert.Nil(t, err)
+	session := &sessionutil.Session{}
+	err = json.Unmarshal([]byte(value), session)
+	assert.Nil(t, err)
+	assert.Equal(t, "rg1", session.ResourceGroup)
+
+	err = cluster.transferNode(4, "rg1")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,305 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querycoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/retry"
+)
+
+const (
+	// handoffSegmentPrefix is the prefix of the flushed segments to hand off, written by the root coord
+	handoffSegmentPrefix = "querycoord-handoff"
+
+	// indexMetaPrefix is the prefix of the index build metas, written by the index coord and the index nodes
+	indexMetaPrefix = "indexes"
+)
+
+// handoffEvent is a flushed segment to hand off, it waits for the pending index builds
+type handoffEvent struct {
+	info          *querypb.SegmentInfo
+	checked       bool // whether the states of the index builds are checked
+	pendingBuilds map[UniqueID]bool
+}
+
+// handoffHandler tracks the handoff events and the index builds they wait for
+type handoffHandler struct {
+	qc            *QueryCoord
+	events        map[UniqueID]*handoffEvent // by segment id
+	buildSegments map[UniqueID]UniqueID      // index build id to the segment waiting for it
+	retryEvents   map[UniqueID]*handoffEvent // the events failed to check or hand off
+}
+
+func newHandoffHandler(qc *QueryCoord) *handoffHandler {
+	return &handoffHandler{
+		qc:            qc,
+		events:        make(map[UniqueID]*handoffEvent),
+		buildSegments: make(map[UniqueID]UniqueID),
+		retryEvents:   make(map[UniqueID]*handoffEvent),
+	}
+}
+
+// handoffSegmentLoop hands off the flushed segments of the loaded collections as sealed segments. The root coord
+// records a handoff event of each flushed segment, which is handled once the index builds of the segment are done,
+// then the event is removed. The events failed to hand off are retried every handoff interval.
+func (qc *QueryCoord) handoffSegmentLoop() {
+	ctx, cancel := context.WithCancel(qc.loopCtx)
+	defer cancel()
+	defer qc.loopWg.Done()
+	log.Debug("query coordinator start handoff segment loop")
+
+	// the index metas are watched before the events are loaded, so that no finished build is missed
+	indexWatchChan := qc.kvClient.WatchWithPrefix(indexMetaPrefix)
+	var values []string
+	var revision int64
+	err := retry.Do(ctx, func() error {
+		var err error
+		_, values, revision, err = qc.kvClient.LoadWithRevision(handoffSegmentPrefix)
+		return err
+	})
+	if err != nil {
+		log.Error("handoffSegmentLoop: load handoff events failed", zap.Error(err))
+		return
+	}
+	handoffWatchChan := qc.kvClient.WatchWithRevision(handoffSegmentPrefix, revision+1)
+
+	h := newHandoffHandler(qc)
+	for _, value := range values {
+		h.addEvent(ctx, value)
+	}
+
+	ticker := time.NewTicker(time.Duration(Params.HandoffIntervalSeconds) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case resp := <-handoffWatchChan:
+			for _, event := range resp.Events {
+				if event.Type == mvccpb.PUT {
+					h.addEvent(ctx, string(event.Kv.Value))
+				}
+			}
+		case resp := <-indexWatchChan:
+			for _, event := range resp.Events {
+				switch event.Type {
+				case mvccpb.PUT:
+					indexMeta := &indexpb.IndexMeta{}
+					err := proto.UnmarshalText(string(event.Kv.Value), indexMeta)
+					if err != nil {
+						log.Warn("handoffSegmentLoop: unmarshal index meta failed", zap.Error(err))
+						continue
+					}
+					if isIndexStateDone(indexMeta.State) {
+						h.buildDone(ctx, indexMeta.IndexBuildID)
+					}
+				case mvccpb.DELETE:
+					// the index of a dropped build never shows up
+					buildID, err := strconv.ParseInt(filepath.Base(string(event.Kv.Key)), 10, 64)
+					if err == nil {
+						h.buildDone(ctx, buildID)
+					}
+				}
+			}
+		case <-ticker.C:
+			h.retry(ctx)
+		}
+	}
+}
+
+// isIndexStateDone tells whether the index build won't change, the segment of a failed build
+// is handed off without the index
+func isIndexStateDone(state commonpb.IndexState) bool {
+	return state == commonpb.IndexState_Finished || state == commonpb.IndexState_Failed
+}
+
+func (h *handoffHandler) addEvent(ctx context.Context, value string) {
+	info := &querypb.SegmentInfo{}
+	err := proto.UnmarshalText(value, info)
+	if err != nil {
+		log.Warn("handoffSegmentLoop: unmarshal handoff event failed", zap.Error(err))
+		return
+	}
+	if _, ok := h.events[info.SegmentID]; ok {
+		return
+	}
+	event := &handoffEvent{
+		info:          info,
+		pendingBuilds: make(map[UniqueID]bool),
+	}
+	h.events[info.SegmentID] = event
+	h.process(ctx, event)
+}
+
+// process checks the index builds of the event once, and hands off the segment if none is pending
+func (h *handoffHandler) process(ctx context.Context, event *handoffEvent) {
+	if !event.checked {
+		if err := h.checkIndexBuilds(ctx, event); err != nil {
+			log.Warn("handoffSegmentLoop: check index builds failed", zap.Int64("segmentID", event.info.SegmentID), zap.Error(err))
+			h.retryEvents[event.info.SegmentID] = event
+			return
+		}
+		event.checked = true
+	}
+	if len(event.pendingBuilds) > 0 {
+		return
+	}
+	if err := h.handoff(ctx, event); err != nil {
+		log.Warn("handoffSegmentLoop: handoff segment failed", zap.Int64("segmentID", event.info.SegmentID), zap.Error(err))
+		h.retryEvents[event.info.SegmentID] = event
+		return
+	}
+	delete(h.events, event.info.SegmentID)
+}
+
+func (h *handoffHandler) checkIndexBuilds(ctx context.Context, event *handoffEvent) error {
+	if len(event.info.IndexBuildIDs) == 0 || !h.isLoaded(event.info) {
+		return nil
+	}
+	if h.qc.indexCoordClient == nil {
+		return errors.New("index coord isn't set")
+	}
+	statesResp, err := h.qc.indexCoordClient.GetIndexStates(ctx, &indexpb.GetIndexStatesRequest{
+		IndexBuildIDs: event.info.IndexBuildIDs,
+	})
+	if err == nil && statesResp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		err = errors.New(statesResp.Status.Reason)
+	}
+	if err != nil {
+		return err
+	}
+	for _, state := range statesResp.States {
+		if !isIndexStateDone(state.State) {
+			event.pendingBuilds[state.IndexBuildID] = true
+			h.buildSegments[state.IndexBuildID] = event.info.SegmentID
+		}
+	}
+	return nil
+}
+
+func (h *handoffHandler) buildDone(ctx context.Context, buildID UniqueID) {
+	segmentID, ok := h.buildSegments[buildID]
+	if !ok {
+		return
+	}
+	delete(h.buildSegments, buildID)
+	event, ok := h.events[segmentID]
+	if !ok {
+		return
+	}
+	delete(event.pendingBuilds, buildID)
+	if len(event.pendingBuilds) == 0 {
+		h.process(ctx, event)
+	}
+}
+
+func (h *handoffHandler) retry(ctx context.Context) {
+	retryEvents := h.retryEvents
+	h.retryEvents = make(map[UniqueID]*handoffEvent)
+	for _, event := range retryEvents {
+		h.process(ctx, event)
+	}
+}
+
+// isLoaded tells whether the segment belongs to a loaded partition and isn't loaded yet
+func (h *handoffHandler) isLoaded(info *querypb.SegmentInfo) bool {
+	meta := h.qc.meta
+	return Params.AutoHandoff && meta.hasCollection(info.CollectionID) && meta.hasPartition(info.CollectionID, info.PartitionID) &&
+		!meta.hasReleasePartition(info.CollectionID, info.PartitionID) && !meta.hasSegmentInfo(info.SegmentID)
+}
+
+// handoff loads the segment as sealed segment if its partition is loaded, and removes the event
+func (h *handoffHandler) handoff(ctx context.Context, event *handoffEvent) error {
+	info := event.info
+	if h.isLoaded(info) {
+		loadInfo, err := h.getSegmentLoadInfo(ctx, info)
+		if err != nil {
+			return err
+		}
+		handoffTask := &HandoffTask{
+			BaseTask: BaseTask{
+				ctx:              h.qc.loopCtx,
+				Condition:        NewTaskCondition(h.qc.loopCtx),
+				triggerCondition: querypb.TriggerCondition_handoff,
+			},
+			HandoffSegments: &querypb.HandoffSegments{
+				Base: &commonpb.MsgBase{
+					MsgType:  commonpb.MsgType_HandoffSegments,
+					SourceID: h.qc.session.ServerID,
+				},
+				Infos: []*querypb.SegmentLoadInfo{loadInfo},
+			},
+			dataCoord: h.qc.dataCoordClient,
+			cluster:   h.qc.cluster,
+			meta:      h.qc.meta,
+		}
+		h.qc.scheduler.Enqueue([]task{handoffTask})
+		if err = handoffTask.WaitToFinish(); err != nil {
+			return err
+		}
+	}
+	key := path.Join(handoffSegmentPrefix, strconv.FormatInt(info.CollectionID, 10),
+		strconv.FormatInt(info.PartitionID, 10), strconv.FormatInt(info.SegmentID, 10))
+	return h.qc.kvClient.Remove(key)
+}
+
+// getSegmentLoadInfo returns the binlogs and the index size of the flushed segment
+func (h *handoffHandler) getSegmentLoadInfo(ctx context.Context, info *querypb.SegmentInfo) (*querypb.SegmentLoadInfo, error) {
+	recoveryInfo, err := h.qc.dataCoordClient.GetRecoveryInfo(ctx, &datapb.GetRecoveryInfoRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_HandoffSegments,
+			SourceID: h.qc.session.ServerID,
+		},
+		CollectionID: info.CollectionID,
+		PartitionID:  info.PartitionID,
+	})
+	if err == nil && recoveryInfo.Status.ErrorCode != commonpb.ErrorCode_Success {
+		err = errors.New(recoveryInfo.Status.Reason)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, segmentBinlog := range recoveryInfo.Binlogs {
+		if segmentBinlog.SegmentID != info.SegmentID {
+			continue
+		}
+		loadInfo := &querypb.SegmentLoadInfo{
+			SegmentID:     info.SegmentID,
+			PartitionID:   info.PartitionID,
+			CollectionID:  info.CollectionID,
+			BinlogPaths:   segmentBinlog.FieldBinlogs,
+			NumOfRows:     segmentBinlog.NumOfRows,
+			Statslogs:     segmentBinlog.Statslogs,
+			Deltalogs:     segmentBinlog.Deltalogs,
+			InsertChannel: info.ChannelID,
+		}
+		fillSegmentIndexSizes(ctx, h.qc.rootCoordClient, h.qc.indexCoordClient, []*querypb.SegmentLoadInfo{loadInfo})
+		return loadInfo, nil
+	}
+	return nil, fmt.Errorf("binlogs of segment %d not found", info.SegmentID)
+}
@@ -0,0 +1,149 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querycoord
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strconv"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+// handoffIndexCoordMock reports the index states of the builds
+type handoffIndexCoordMock struct {
+	indexCoordMock
+	states map[UniqueID]commonpb.IndexState
+	err    error
+	calls  int
+}
+
+func (c *handoffIndexCoordMock) GetIndexStates(ctx context.Context, req *indexpb.GetIndexStatesRequest) (*indexpb.GetIndexStatesResponse, error) {
+	c.calls++
+	if c.err != nil {
+		return nil, c.err
+	}
+	states := make([]*indexpb.IndexInfo, 0, len(req.IndexBuildIDs))
+	for _, buildID := range req.IndexBuildIDs {
+		states = append(states, &indexpb.IndexInfo{IndexBuildID: buildID, State: c.states[buildID]})
+	}
+	return &indexpb.GetIndexStatesResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		States: states,
+	}, nil
+}
+
+func TestHandoffHandler(t *testing.T) {
+	refreshParams()
+	ctx := context.Background()
+	kv, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
+	assert.Nil(t, err)
+	meta, err := newMeta(kv)
+	assert.Nil(t, err)
+	indexCoord := &handoffIndexCoordMock{
+		states: map[UniqueID]commonpb.IndexState{
+			1: commonpb.IndexState_InProgress,
+			2: commonpb.IndexState_Finished,
+		},
+	}
+	qc := &QueryCoord{kvClient: kv, meta: meta, indexCoordClient: indexCoord}
+	h := newHandoffHandler(qc)
+
+	saveEvent := func(info *querypb.SegmentInfo) (string, string) {
+		key := path.Join(handoffSegmentPrefix, strconv.FormatInt(info.CollectionID, 10),
+			strconv.FormatInt(info.PartitionID, 10), strconv.FormatInt(info.SegmentID, 10))
+		value := proto.MarshalTextString(info)
+		assert.Nil(t, kv.Save(key, value))
+		return key, value
+	}
+
+	t.Run("collection not loaded", func(t *testing.T) {
+		key, value := saveEvent(&querypb.SegmentInfo{
+			SegmentID:     defaultSegmentID,
+			CollectionID:  defaultCollectionID,
+			PartitionID:   defaultPartitionID,
+			IndexBuildIDs: []UniqueID{1},
+		})
+		h.addEvent(ctx, value)
+		assert.Equal(t, 0, indexCoord.calls)
+		assert.Equal(t, 0, len(h.events))
+		_, err := kv.Load(key)
+		assert.NotNil(t, err)
+	})
+
+	assert.Nil(t, meta.addCollection(defaultCollectionID, genCollectionSchema(defaultCollectionID, false)))
+	assert.Nil(t, meta.addPartition(defaultCollectionID, defaultPartitionID))
+
+	t.Run("wait for index builds", func(t *testing.T) {
+		key, value := saveEvent(&querypb.SegmentInfo{
+			SegmentID:     defaultSegmentID,
+			CollectionID:  defaultCollectionID,
+			PartitionID:   defaultPartitionID,
+			IndexBuildIDs: []UniqueID{1, 2},
+		})
+		h.addEvent(ctx, value)
+		assert.Equal(t, 1, indexCoord.calls)
+		assert.Equal(t, map[UniqueID]bool{1: true}, h.events[defaultSegmentID].pendingBuilds)
+
+		// a repeated event is ignored
+		h.addEvent(ctx, value)
+		assert.Equal(t, 1, indexCoord.calls)
+
+		h.buildDone(ctx, 2)
+		assert.Equal(t, 1, len(h.events))
+
+		// the released partition isn't handed off
+		assert.Nil(t, meta.releasePartition(defaultCollectionID, defaultPartitionID))
+		h.buildDone(ctx, 1)
+		assert.Equal(t, 0, len(h.events))
+		assert.Equal(t, 0, len(h.buildSegments))
+		_, err := kv.Load(key)
+		assert.NotNil(t, err)
+	})
+
+	assert.Nil(t, meta.releaseCollection(defaultCollectionID))
+	assert.Nil(t, meta.addCollection(defaultCollectionID, genCollectionSchema(defaultCollectionID, false)))
+	assert.Nil(t, meta.addPartition(defaultCollectionID, defaultPartitionID))
+
+	t.Run("retry", func(t *testing.T) {
+		indexCoord.err = errors.New("mock")
+		_, value := saveEvent(&querypb.SegmentInfo{
+			SegmentID:     defaultSegmentID + 1,
+			CollectionID:  defaultCollectionID,
+			PartitionID:   defaultPartitionID,
+			IndexBuildIDs: []UniqueID{1},
+		})
+		h.addEvent(ctx, value)
+		assert.Equal(t, 1, len(h.retryEvents))
+
+		indexCoord.err = nil
+		h.retry(ctx)
+		assert.Equal(t, 0, len(h.retryEvents))
+		assert.True(t, h.events[defaultSegmentID+1].checked)
+		assert.Equal(t, map[UniqueID]bool{1: true}, h.events[defaultSegmentID+1].pendingBuilds)
+	})
+}
+
+func TestIsIndexStateDone(t *testing.T) {
+	assert.True(t, isIndexStateDone(commonpb.IndexState_Finished))
+	assert.True(t, isIndexStateDone(commonpb.IndexState_Failed))
+	assert.False(t, isIndexStateDone(commonpb.IndexState_InProgress))
+	assert.False(t, isIndexStateDone(commonpb.IndexState_Unissued))
+}
@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 
@@ -94,15 +95,18 @@ func (qc *QueryCoord) ShowCollections(ctx context.Context, req *querypb.ShowColl
 		inMemoryCollectionIDs = append(inMemoryCollectionIDs, info.CollectionID)
 	}
 	inMemoryPercentages := make([]int64, 0)
+	loadedFields := make([]*querypb.LoadedFields, 0)
 	if len(req.CollectionIDs) == 0 {
 		for _, id := range inMemoryCollectionIDs {
 			inMemoryPercentages = append(inMemoryPercentages, ID2collectionInfo[id].InMemoryPercentage)
+			loadedFields = append(loadedFields, &querypb.LoadedFields{FieldIDs: loadedFieldIDs(ID2collectionInfo[id])})
 		}
 		log.Debug("show collection end", zap.Int64s("collections", inMemoryCollectionIDs), zap.Int64s("inMemoryPercentage", inMemoryPercentages))
 		return &querypb.ShowCollectionsResponse{
 			Status:              status,
 			CollectionIDs:       inMemoryCollectionIDs,
 			InMemoryPercentages: inMemoryPercentages,
+			LoadedFields:        loadedFields,
 		}, nil
 	}
 	for _, id := range req.CollectionIDs {
@@ -115,12 +119,14 @@ func (qc *QueryCoord) ShowCollections(ctx context.Context, req *querypb.ShowColl
 			}, err
 		}
 		inMemoryPercentages = append(inMemoryPercentages, ID2collectionInfo[id].InMemoryPercentage)
+		loadedFields = append(loadedFields, &querypb.LoadedFields{FieldIDs: loadedFieldIDs(ID2collectionInfo[id])})
 	}
 	log.Debug("show collection end", zap.Int64s("collections", req.CollectionIDs), zap.Int64s("inMemoryPercentage", inMemoryPercentages))
 	return &querypb.ShowCollectionsResponse{
 		Status:              status,
 		CollectionIDs:       req.CollectionIDs,
 		InMemoryPercentages: inMemoryPercentages,
+		LoadedFields:        loadedFields,
 	}, nil
 }
 
@@ -149,9 +155,13 @@ func (qc *QueryCoord) LoadCollection(ctx context.Context, req *querypb.LoadColle
 		LoadCollectionRequest: req,
 		rootCoord:             qc.rootCoordClient,
 		dataCoord:             qc.dataCoordClient,
+		indexCoord:            qc.indexCoordClient,
 		cluster:               qc.cluster,
 		meta:                  qc.meta,
+		idAllocator:           qc.scheduler.taskIDAllocator,
 	}
+	// the failure of the last load request is forgotten
+	qc.scheduler.loadingProgress.clearFailure(collectionID)
 	qc.scheduler.Enqueue([]task{loadCollectionTask})
 
 	err := loadCollectionTask.WaitToFinish()
@@ -206,6 +216,8 @@ func (qc *QueryCoord) ReleaseCollection(ctx context.Context, req *querypb.Releas
 		return status, err
 	}
 
+	qc.scheduler.loadingProgress.releaseCollection(collectionID)
+
 	log.Debug("ReleaseCollectionRequest completed", zap.String("role", Params.RoleName), zap.Int64("msgID", req.Base.MsgID), zap.Int64("collectionID", collectionID))
 	//qc.MetaReplica.printMeta()
 	//qc.cluster.printMeta()
@@ -332,10 +344,15 @@ func (qc *QueryCoord) LoadPartitions(ctx context.Context, req *querypb.LoadParti
 			triggerCondition: querypb.TriggerCondition_grpcRequest,
 		},
 		LoadPartitionsRequest: req,
+		rootCoord:             qc.rootCoordClient,
 		dataCoord:             qc.dataCoordClient,
+		indexCoord:            qc.indexCoordClient,
 		cluster:               qc.cluster,
 		meta:                  qc.meta,
+		idAllocator:           qc.scheduler.taskIDAllocator,
 	}
+	// the failure of the last load request is forgotten
+	qc.scheduler.loadingProgress.clearFailure(collectionID)
 	qc.scheduler.Enqueue([]task{loadPartitionTask})
 
 	err := loadPartitionTask.WaitToFinish()
@@ -397,6 +414,7 @@ func (qc *QueryCoord) ReleasePartitions(ctx context.Context, req *querypb.Releas
 		status.Reason = err.Error()
 		return status, err
 	}
+	qc.scheduler.loadingProgress.releasePartitions(collectionID, partitionIDs)
 	log.Debug("ReleasePartitionRequest completed", zap.String("role", Params.RoleName), zap.Int64("msgID", req.Base.MsgID), zap.Int64("collectionID", collectionID), zap.Int64s("partitionIDs", partitionIDs))
 	//qc.MetaReplica.printMeta()
 	//qc.cluster.printMeta()
@@ -503,6 +521,211 @@ func (qc *QueryCoord) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmen
 	}, nil
 }
 
+// GetReplicas returns the replicas of the collection whose query nodes are all on service
+func (qc *QueryCoord) GetReplicas(ctx context.Context, req *querypb.GetReplicasRequest) (*querypb.GetReplicasResponse, error) {
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	if qc.stateCode.Load() != internalpb.StateCode_Healthy {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := errors.New("query coordinator is not healthy")
+		status.Reason = err.Error()
+		log.Debug("getReplicas end with query coordinator not healthy")
+		return &querypb.GetReplicasResponse{
+			Status: status,
+		}, err
+	}
+
+	if !qc.meta.hasCollection(req.CollectionID) {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := fmt.Errorf("collection %d has not been loaded", req.CollectionID)
+		status.Reason = err.Error()
+		return &querypb.GetReplicasResponse{
+			Status: status,
+		}, err
+	}
+
+	replicas := make([]*querypb.ReplicaInfo, 0)
+	for _, replica := range qc.meta.getReplicasByCollectionID(req.CollectionID) {
+		if len(replica.NodeIds) == 0 {
+			continue
+		}
+		serviceable := true
+		for _, nodeID := range replica.NodeIds {
+			if onService, err := qc.cluster.isOnService(nodeID); err != nil || !onService {
+				serviceable = false
+				break
+			}
+		}
+		if serviceable {
+			replicas = append(replicas, replica)
+		}
+	}
+	log.Debug("getReplicas", zap.Int64("collectionID", req.CollectionID), zap.Any("replicas", replicas))
+	return &querypb.GetReplicasResponse{
+		Status:   status,
+		Replicas: replicas,
+	}, nil
+}
+
+// GetShardLeaders returns the query nodes of the serviceable replicas of the collection,
+// each query node leads the DM channels it watches and serves the sealed segments it loads
+func (qc *QueryCoord) GetShardLeaders(ctx context.Context, req *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error) {
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	if qc.stateCode.Load() != internalpb.StateCode_Healthy {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := errors.New("query coordinator is not healthy")
+		status.Reason = err.Error()
+		log.Debug("getShardLeaders end with query coordinator not healthy")
+		return &querypb.GetShardLeadersResponse{
+			Status: status,
+		}, err
+	}
+
+	if !qc.meta.hasCollection(req.CollectionID) {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := fmt.Errorf("collection %d has not been loaded", req.CollectionID)
+		status.Reason = err.Error()
+		return &querypb.GetShardLeadersResponse{
+			Status: status,
+		}, err
+	}
+
+	replicas := make([]*querypb.ShardLeadersList, 0)
+	for _, replica := range qc.meta.getReplicasByCollectionID(req.CollectionID) {
+		leaders := make([]*querypb.ShardLeader, 0, len(replica.NodeIds))
+		for _, nodeID := range replica.NodeIds {
+			node, err := qc.cluster.getNodeByID(nodeID)
+			if err != nil || !node.isOnService() {
+				// the replica misses the data served by the node
+				leaders = nil
+				break
+			}
+			channels, err := qc.meta.getDmChannelsByNodeID(req.CollectionID, nodeID)
+			if err != nil {
+				leaders = nil
+				break
+			}
+			leaders = append(leaders, &querypb.ShardLeader{
+				NodeID:     nodeID,
+				Address:    node.getAddress(),
+				DmChannels: channels,
+			})
+		}
+		if len(leaders) > 0 {
+			replicas = append(replicas, &querypb.ShardLeadersList{
+				ReplicaID: replica.ReplicaID,
+				Leaders:   leaders,
+			})
+		}
+	}
+	if len(replicas) == 0 {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := fmt.Errorf("no serviceable replica of collection %d", req.CollectionID)
+		status.Reason = err.Error()
+		return &querypb.GetShardLeadersResponse{
+			Status: status,
+		}, err
+	}
+	log.Debug("getShardLeaders", zap.Int64("collectionID", req.CollectionID), zap.Any("replicas", replicas))
+	return &querypb.GetShardLeadersResponse{
+		Status:   status,
+		Replicas: replicas,
+	}, nil
+}
+
+// GetLoadingProgress returns the segments and rows loaded by the load requests of the collection,
+// a partition is 100 percent loaded only after all the tasks of its load request are done
+func (qc *QueryCoord) GetLoadingProgress(ctx context.Context, req *querypb.GetLoadingProgressRequest) (*querypb.GetLoadingProgressResponse, error) {
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	if qc.stateCode.Load() != internalpb.StateCode_Healthy {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := errors.New("query coordinator is not healthy")
+		status.Reason = err.Error()
+		log.Debug("getLoadingProgress end with query coordinator not healthy")
+		return &querypb.GetLoadingProgressResponse{
+			Status: status,
+		}, err
+	}
+
+	collectionID := req.CollectionID
+	if reason, ok := qc.scheduler.loadingProgress.getFailure(collectionID); ok {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := fmt.Errorf("load collection %d failed: %s", collectionID, reason)
+		status.Reason = err.Error()
+		return &querypb.GetLoadingProgressResponse{
+			Status: status,
+		}, err
+	}
+	collectionInfo, err := qc.meta.getCollectionInfoByID(collectionID)
+	if err != nil {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err = fmt.Errorf("collection %d has not been loaded", collectionID)
+		status.Reason = err.Error()
+		return &querypb.GetLoadingProgressResponse{
+			Status: status,
+		}, err
+	}
+	partitionIDs := req.PartitionIDs
+	if len(partitionIDs) == 0 {
+		for _, partitionID := range collectionInfo.PartitionIDs {
+			if !qc.meta.hasReleasePartition(collectionID, partitionID) {
+				partitionIDs = append(partitionIDs, partitionID)
+			}
+		}
+	}
+
+	total, partitions, nodes := qc.scheduler.loadingProgress.getProgress(collectionID, partitionIDs)
+	allLoaded := true
+	partitionProgresses := make([]*querypb.PartitionLoadingProgress, 0, len(partitionIDs))
+	for _, partitionID := range partitionIDs {
+		partitionState, err := qc.meta.getPartitionStatesByID(collectionID, partitionID)
+		if err != nil {
+			status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+			err = fmt.Errorf("partition %d of collection %d has not been loaded", partitionID, collectionID)
+			status.Reason = err.Error()
+			return &querypb.GetLoadingProgressResponse{
+				Status: status,
+			}, err
+		}
+		progress, ok := partitions[partitionID]
+		if !ok {
+			progress = &querypb.LoadingProgress{}
+		}
+		progress.Percentage = finishedPercentage(loadingPercentage(progress), partitionState.InMemoryPercentage >= 100)
+		allLoaded = allLoaded && partitionState.InMemoryPercentage >= 100
+		partitionProgresses = append(partitionProgresses, &querypb.PartitionLoadingProgress{
+			PartitionID: partitionID,
+			Progress:    progress,
+		})
+	}
+	total.Percentage = finishedPercentage(loadingPercentage(total), allLoaded)
+
+	nodeProgresses := make([]*querypb.NodeLoadingProgress, 0, len(nodes))
+	for nodeID, progress := range nodes {
+		progress.Percentage = loadingPercentage(progress)
+		nodeProgresses = append(nodeProgresses, &querypb.NodeLoadingProgress{
+			NodeID:   nodeID,
+			Progress: progress,
+		})
+	}
+	sort.Slice(nodeProgresses, func(i, j int) bool {
+		return nodeProgresses[i].NodeID < nodeProgresses[j].NodeID
+	})
+
+	log.Debug("getLoadingProgress", zap.Int64("collectionID", collectionID), zap.Int64s("partitionIDs", partitionIDs), zap.Any("progress", total))
+	return &querypb.GetLoadingProgressResponse{
+		Status:              status,
+		Progress:            total,
+		PartitionProgresses: partitionProgresses,
+		NodeProgresses:      nodeProgresses,
+	}, nil
+}
+
 func (qc *QueryCoord) isHealthy() bool {
 	code := qc.stateCode.Load().(internalpb.StateCode)
 	return code == internalpb.StateCode_Healthy
@@ -572,3 +795,82 @@ func (qc *QueryCoord) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRe
 		Response: "",
 	}, nil
 }
+
+// TransferNode moves the query nodes into the target resource group, a node serving a replica of
+// another resource group can't be transferred until the collection is released
+func (qc *QueryCoord) TransferNode(ctx context.Context, req *querypb.TransferNodeRequest) (*commonpb.Status, error) {
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	if qc.stateCode.Load() != internalpb.StateCode_Healthy {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := errors.New("query coordinator is not healthy")
+		status.Reason = err.Error()
+		log.Debug("transferNode end with query coordinator not healthy")
+		return status, err
+	}
+
+	rg := req.TargetResourceGroup
+	if rg == "" {
+		rg = defaultResourceGroup
+	}
+	// check all the nodes before moving any of them
+	for _, nodeID := range req.NodeIDs {
+		if _, err := qc.cluster.getNodeByID(nodeID); err != nil {
+			status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+			status.Reason = err.Error()
+			return status, err
+		}
+		for _, collection := range qc.meta.showCollections() {
+			replica, err := qc.meta.getReplicaByNodeID(collection.CollectionID, nodeID)
+			if err == nil && replicaResourceGroup(replica) != rg {
+				err = fmt.Errorf("query node %d serves replica %d of collection %d in resource group %s, release the collection before transferring the node",
+					nodeID, replica.ReplicaID, collection.CollectionID, replicaResourceGroup(replica))
+				status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+				status.Reason = err.Error()
+				return status, err
+			}
+		}
+	}
+	for _, nodeID := range req.NodeIDs {
+		if err := qc.cluster.transferNode(nodeID, rg); err != nil {
+			status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+			status.Reason = err.Error()
+			return status, err
+		}
+	}
+	log.Debug("transferNode end", zap.Int64s("nodeIDs", req.NodeIDs), zap.String("resourceGroup", rg))
+	return status, nil
+}
+
+// ListResourceGroups returns all the resource groups and their query nodes
+func (qc *QueryCoord) ListResourceGroups(ctx context.Context, req *querypb.ListResourceGroupsRequest) (*querypb.ListResourceGroupsResponse, error) {
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	if qc.stateCode.Load() != internalpb.StateCode_Healthy {
+		status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		err := errors.New("query coordinator is not healthy")
+		status.Reason = err.Error()
+		log.Debug("listResourceGroups end with query coordinator not healthy")
+		return &querypb.ListResourceGroupsResponse{
+			Status: status,
+		}, err
+	}
+
+	groups := qc.cluster.resourceGroups()
+	resourceGroups := make([]*querypb.ResourceGroupInfo, 0, len(groups))
+	for name, nodeIDs := range groups {
+		resourceGroups = append(resourceGroups, &querypb.ResourceGroupInfo{
+			Name:    name,
+			NodeIDs: nodeIDs,
+		})
+	}
+	sort.Slice(resourceGroups, func(i, j int) bool {
+		return resourceGroups[i].Name < resourceGroups[j].Name
+	})
+	return &querypb.ListResourceGroupsResponse{
+		Status:         status,
+		ResourceGroups: resourceGroups,
+	}, nil
+}
@@ -105,6 +105,86 @@ func TestGrpcTask(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("Test GetLoadingProgress", func(t *testing.T) {
+		res, err := queryCoord.GetLoadingProgress(ctx, &querypb.GetLoadingProgressRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_GetLoadingProgress,
+			},
+			CollectionID: defaultCollectionID,
+		})
+		assert.Equal(t, commonpb.ErrorCode_Success, res.Status.ErrorCode)
+		assert.Nil(t, err)
+		assert.LessOrEqual(t, res.Progress.Percentage, int64(100))
+		assert.Equal(t, 1, len(res.PartitionProgresses))
+
+		res, err = queryCoord.GetLoadingProgress(ctx, &querypb.GetLoadingProgressRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_GetLoadingProgress,
+			},
+			CollectionID: defaultCollectionID + 1,
+		})
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, res.Status.ErrorCode)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Test GetShardLeaders", func(t *testing.T) {
+		res, err := queryCoord.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+			Base:         &commonpb.MsgBase{},
+			CollectionID: defaultCollectionID,
+		})
+		assert.Equal(t, commonpb.ErrorCode_Success, res.Status.ErrorCode)
+		assert.Nil(t, err)
+		assert.NotEqual(t, 0, len(res.Replicas))
+		for _, replica := range res.Replicas {
+			assert.NotEqual(t, 0, len(replica.Leaders))
+		}
+
+		res, err = queryCoord.GetShardLeaders(ctx, &querypb.GetShardLeadersRequest{
+			Base:         &commonpb.MsgBase{},
+			CollectionID: defaultCollectionID + 1,
+		})
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, res.Status.ErrorCode)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Test ListResourceGroups", func(t *testing.T) {
+		res, err := queryCoord.ListResourceGroups(ctx, &querypb.ListResourceGroupsRequest{
+			Base: &commonpb.MsgBase{},
+		})
+		assert.Equal(t, commonpb.ErrorCode_Success, res.Status.ErrorCode)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(res.ResourceGroups))
+		assert.Equal(t, defaultResourceGroup, res.ResourceGroups[0].Name)
+		assert.Equal(t, []int64{node.queryNodeID}, res.ResourceGroups[0].NodeIDs)
+	})
+
+	t.Run("Test TransferNode", func(t *testing.T) {
+		// the node serves a replica of the default resource group
+		status, err := queryCoord.TransferNode(ctx, &querypb.TransferNodeRequest{
+			Base:                &commonpb.MsgBase{},
+			NodeIDs:             []int64{node.queryNodeID},
+			TargetResourceGroup: "rg1",
+		})
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+		assert.NotNil(t, err)
+
+		status, err = queryCoord.TransferNode(ctx, &querypb.TransferNodeRequest{
+			Base:                &commonpb.MsgBase{},
+			NodeIDs:             []int64{node.queryNodeID},
+			TargetResourceGroup: defaultResourceGroup,
+		})
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		assert.Nil(t, err)
+
+		status, err = queryCoord.TransferNode(ctx, &querypb.TransferNodeRequest{
+			Base:                &commonpb.MsgBase{},
+			NodeIDs:             []int64{node.queryNodeID + 1},
+			TargetResourceGroup: "rg1",
+		})
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+		assert.NotNil(t, err)
+	})
+
 	t.Run("Test GetSegmentInfo", func(t *testing.T) {
 		res, err := queryCoord.GetSegmentInfo(ctx, &querypb.GetSegmentInfoRequest{
 			Base: &commonpb.MsgBase{
@@ -0,0 +1,199 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querycoord
+
+import (
+	"sync"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+type segmentNodeKey struct {
+	segmentID UniqueID
+	nodeID    int64
+}
+
+type segmentLoadingState struct {
+	partitionID UniqueID
+	numRows     int64
+	loaded      bool
+}
+
+// loadingProgress keeps the segments the load requests of the collections place on the query nodes,
+// the scheduler adds them once their LoadSegmentTasks start and marks them loaded once the tasks are done
+type loadingProgress struct {
+	sync.RWMutex
+	collections map[UniqueID]map[segmentNodeKey]*segmentLoadingState
+	// the reasons of the load requests rolled back, until the collections are requested to load again
+	failures map[UniqueID]string
+}
+
+func newLoadingProgress() *loadingProgress {
+	return &loadingProgress{
+		collections: make(map[UniqueID]map[segmentNodeKey]*segmentLoadingState),
+		failures:    make(map[UniqueID]string),
+	}
+}
+
+// addSegments records the segments of the load request as loading on its node
+func (lp *loadingProgress) addSegments(req *querypb.LoadSegmentsRequest) {
+	lp.Lock()
+	defer lp.Unlock()
+
+	for _, info := range req.Infos {
+		segments, ok := lp.collections[info.CollectionID]
+		if !ok {
+			segments = make(map[segmentNodeKey]*segmentLoadingState)
+			lp.collections[info.CollectionID] = segments
+		}
+		key := segmentNodeKey{segmentID: info.SegmentID, nodeID: req.NodeID}
+		if _, ok := segments[key]; !ok {
+			segments[key] = &segmentLoadingState{
+				partitionID: info.PartitionID,
+				numRows:     info.NumOfRows,
+			}
+		}
+	}
+}
+
+// removeSegments forgets the segments of the failed load request, the rescheduled requests add them again
+func (lp *loadingProgress) removeSegments(req *querypb.LoadSegmentsRequest) {
+	lp.Lock()
+	defer lp.Unlock()
+
+	for _, info := range req.Infos {
+		if segments, ok := lp.collections[info.CollectionID]; ok {
+			delete(segments, segmentNodeKey{segmentID: info.SegmentID, nodeID: req.NodeID})
+		}
+	}
+}
+
+func (lp *loadingProgress) setSegmentsLoaded(req *querypb.LoadSegmentsRequest) {
+	lp.Lock()
+	defer lp.Unlock()
+
+	for _, info := range req.Infos {
+		if segments, ok := lp.collections[info.CollectionID]; ok {
+			if state, ok := segments[segmentNodeKey{segmentID: info.SegmentID, nodeID: req.NodeID}]; ok {
+				state.loaded = true
+			}
+		}
+	}
+}
+
+// setFailed records why the load request of the collection is rolled back
+func (lp *loadingProgress) setFailed(collectionID UniqueID, reason string) {
+	lp.Lock()
+	defer lp.Unlock()
+
+	lp.failures[collectionID] = reason
+}
+
+func (lp *loadingProgress) clearFailure(collectionID UniqueID) {
+	lp.Lock()
+	defer lp.Unlock()
+
+	delete(lp.failures, collectionID)
+}
+
+func (lp *loadingProgress) getFailure(collectionID UniqueID) (string, bool) {
+	lp.RLock()
+	defer lp.RUnlock()
+
+	reason, ok := lp.failures[collectionID]
+	return reason, ok
+}
+
+func (lp *loadingProgress) releaseCollection(collectionID UniqueID) {
+	lp.Lock()
+	defer lp.Unlock()
+
+	delete(lp.collections, collectionID)
+}
+
+func (lp *loadingProgress) releasePartitions(collectionID UniqueID, partitionIDs []UniqueID) {
+	lp.Lock()
+	defer lp.Unlock()
+
+	segments, ok := lp.collections[collectionID]
+	if !ok {
+		return
+	}
+	released := make(map[UniqueID]bool)
+	for _, partitionID := range partitionIDs {
+		released[partitionID] = true
+	}
+	for key, state := range segments {
+		if released[state.partitionID] {
+			delete(segments, key)
+		}
+	}
+}
+
+// getProgress sums up the segments of the partitions, all the partitions if partitionIDs is empty,
+// the percentages are left to the caller
+func (lp *loadingProgress) getProgress(collectionID UniqueID, partitionIDs []UniqueID) (*querypb.LoadingProgress,
+	map[UniqueID]*querypb.LoadingProgress, map[int64]*querypb.LoadingProgress) {
+	lp.RLock()
+	defer lp.RUnlock()
+
+	wanted := make(map[UniqueID]bool)
+	for _, partitionID := range partitionIDs {
+		wanted[partitionID] = true
+	}
+	total := &querypb.LoadingProgress{}
+	partitions := make(map[UniqueID]*querypb.LoadingProgress)
+	nodes := make(map[int64]*querypb.LoadingProgress)
+	for key, state := range lp.collections[collectionID] {
+		if len(wanted) > 0 && !wanted[state.partitionID] {
+			continue
+		}
+		if _, ok := partitions[state.partitionID]; !ok {
+			partitions[state.partitionID] = &querypb.LoadingProgress{}
+		}
+		if _, ok := nodes[key.nodeID]; !ok {
+			nodes[key.nodeID] = &querypb.LoadingProgress{}
+		}
+		for _, progress := range []*querypb.LoadingProgress{total, partitions[state.partitionID], nodes[key.nodeID]} {
+			progress.TotalSegments++
+			progress.TotalRows += state.numRows
+			if state.loaded {
+				progress.LoadedSegments++
+				progress.LoadedRows += state.numRows
+			}
+		}
+	}
+	return total, partitions, nodes
+}
+
+// loadingPercentage prefers the rows loaded to the segments loaded, the segments may report no rows
+func loadingPercentage(progress *querypb.LoadingProgress) int64 {
+	if progress.TotalRows > 0 {
+		return progress.LoadedRows * 100 / progress.TotalRows
+	}
+	if progress.TotalSegments > 0 {
+		return progress.LoadedSegments * 100 / progress.TotalSegments
+	}
+	return 0
+}
+
+// finishedPercentage caps the percentage below 100 until the load request is done,
+// the segments planned later are not counted yet
+func finishedPercentage(percentage int64, finished bool) int64 {
+	if finished {
+		return 100
+	}
+	if percentage >= 100 {
+		return 99
+	}
+	return percentage
+}
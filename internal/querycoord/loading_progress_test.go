@@ -0,0 +1,99 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querycoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+func TestLoadingProgress(t *testing.T) {
+	genLoadRequest := func(nodeID int64, partitionID UniqueID, segmentIDs ...UniqueID) *querypb.LoadSegmentsRequest {
+		req := &querypb.LoadSegmentsRequest{
+			NodeID: nodeID,
+		}
+		for _, segmentID := range segmentIDs {
+			req.Infos = append(req.Infos, &querypb.SegmentLoadInfo{
+				SegmentID:    segmentID,
+				PartitionID:  partitionID,
+				CollectionID: defaultCollectionID,
+				NumOfRows:    100,
+			})
+		}
+		return req
+	}
+
+	lp := newLoadingProgress()
+	req1 := genLoadRequest(1, 10, 1, 2)
+	req2 := genLoadRequest(2, 11, 3)
+	lp.addSegments(req1)
+	lp.addSegments(req2)
+	lp.setSegmentsLoaded(req1)
+
+	total, partitions, nodes := lp.getProgress(defaultCollectionID, nil)
+	assert.Equal(t, int64(3), total.TotalSegments)
+	assert.Equal(t, int64(2), total.LoadedSegments)
+	assert.Equal(t, int64(300), total.TotalRows)
+	assert.Equal(t, int64(200), total.LoadedRows)
+	assert.Equal(t, int64(66), loadingPercentage(total))
+	assert.Equal(t, int64(100), loadingPercentage(partitions[10]))
+	assert.Equal(t, int64(0), loadingPercentage(partitions[11]))
+	assert.Equal(t, int64(100), loadingPercentage(nodes[1]))
+	assert.Equal(t, int64(0), loadingPercentage(nodes[2]))
+
+	total, partitions, _ = lp.getProgress(defaultCollectionID, []UniqueID{11})
+	assert.Equal(t, int64(1), total.TotalSegments)
+	assert.Equal(t, 1, len(partitions))
+
+	t.Run("Test failed request", func(t *testing.T) {
+		lp.removeSegments(req2)
+		total, _, _ := lp.getProgress(defaultCollectionID, nil)
+		assert.Equal(t, int64(2), total.TotalSegments)
+		assert.Equal(t, int64(100), loadingPercentage(total))
+	})
+
+	t.Run("Test release", func(t *testing.T) {
+		lp.releasePartitions(defaultCollectionID, []UniqueID{10})
+		total, _, _ := lp.getProgress(defaultCollectionID, nil)
+		assert.Equal(t, int64(0), total.TotalSegments)
+
+		lp.addSegments(req1)
+		lp.releaseCollection(defaultCollectionID)
+		total, _, _ = lp.getProgress(defaultCollectionID, nil)
+		assert.Equal(t, int64(0), total.TotalSegments)
+		assert.Equal(t, int64(0), loadingPercentage(total))
+	})
+}
+
+func TestLoadingProgressFailure(t *testing.T) {
+	lp := newLoadingProgress()
+	_, ok := lp.getFailure(defaultCollectionID)
+	assert.False(t, ok)
+
+	lp.setFailed(defaultCollectionID, "out of memory")
+	reason, ok := lp.getFailure(defaultCollectionID)
+	assert.True(t, ok)
+	assert.Equal(t, "out of memory", reason)
+
+	lp.clearFailure(defaultCollectionID)
+	_, ok = lp.getFailure(defaultCollectionID)
+	assert.False(t, ok)
+}
+
+func TestFinishedPercentage(t *testing.T) {
+	assert.Equal(t, int64(100), finishedPercentage(30, true))
+	assert.Equal(t, int64(99), finishedPercentage(100, false))
+	assert.Equal(t, int64(30), finishedPercentage(30, false))
+}
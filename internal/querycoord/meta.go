@@ -15,6 +15,7 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"sync"
 
@@ -31,6 +32,7 @@ const (
 	collectionMetaPrefix   = "queryCoord-collectionMeta"
 	segmentMetaPrefix      = "queryCoord-segmentMeta"
 	queryChannelMetaPrefix = "queryCoord-queryChannel"
+	replicaMetaPrefix      = "queryCoord-replicaMeta"
 )
 
 type Meta interface {
@@ -50,6 +52,8 @@ type Meta interface {
 
 	deleteSegmentInfoByID(segmentID UniqueID) error
 	deleteSegmentInfoByNodeID(nodeID UniqueID) error
+	removeSegmentNode(segmentID UniqueID, nodeID int64) error
+	replaceSegmentNode(segmentID UniqueID, oldNodeID int64, newNodeID int64) error
 	setSegmentInfo(segmentID UniqueID, info *querypb.SegmentInfo) error
 	hasSegmentInfo(segmentID UniqueID) bool
 	showSegmentInfos(collectionID UniqueID, partitionIDs []UniqueID) []*querypb.SegmentInfo
@@ -65,8 +69,17 @@ type Meta interface {
 	GetQueryChannel(collectionID UniqueID) (string, string)
 
 	setLoadType(collectionID UniqueID, loadType querypb.LoadType) error
+	setLoadFieldIDs(collectionID UniqueID, fieldIDs []int64) error
+	getLoadFieldIDs(collectionID UniqueID) ([]int64, error)
 	getLoadType(collectionID UniqueID) (querypb.LoadType, error)
 	setLoadPercentage(collectionID UniqueID, partitionID UniqueID, percentage int64, loadType querypb.LoadType) error
+
+	addReplica(replica *querypb.ReplicaInfo) error
+	getReplicaByID(replicaID UniqueID) (*querypb.ReplicaInfo, error)
+	getReplicasByCollectionID(collectionID UniqueID) []*querypb.ReplicaInfo
+	getReplicaByNodeID(collectionID UniqueID, nodeID int64) (*querypb.ReplicaInfo, error)
+	addNodeToReplica(replicaID UniqueID, nodeID int64) error
+	removeNodeFromReplica(replicaID UniqueID, nodeID int64) error
 	printMeta()
 }
 
@@ -77,6 +90,7 @@ type MetaReplica struct {
 	collectionInfos   map[UniqueID]*querypb.CollectionInfo
 	segmentInfos      map[UniqueID]*querypb.SegmentInfo
 	queryChannelInfos map[UniqueID]*querypb.QueryChannelInfo
+	replicaInfos      map[UniqueID]*querypb.ReplicaInfo
 
 	//partitionStates map[UniqueID]*querypb.PartitionStates
 }
@@ -85,12 +99,14 @@ func newMeta(kv *etcdkv.EtcdKV) (Meta, error) {
 	collectionInfos := make(map[UniqueID]*querypb.CollectionInfo)
 	segmentInfos := make(map[UniqueID]*querypb.SegmentInfo)
 	queryChannelInfos := make(map[UniqueID]*querypb.QueryChannelInfo)
+	replicaInfos := make(map[UniqueID]*querypb.ReplicaInfo)
 
 	m := &MetaReplica{
 		client:            kv,
 		collectionInfos:   collectionInfos,
 		segmentInfos:      segmentInfos,
 		queryChannelInfos: queryChannelInfos,
+		replicaInfos:      replicaInfos,
 	}
 
 	err := m.reloadFromKV()
@@ -152,6 +168,23 @@ func (m *MetaReplica) reloadFromKV() error {
 		}
 		m.queryChannelInfos[collectionID] = queryChannelInfo
 	}
+
+	replicaKeys, replicaValues, err := m.client.LoadWithPrefix(replicaMetaPrefix)
+	if err != nil {
+		return err
+	}
+	for index := range replicaKeys {
+		replicaID, err := strconv.ParseInt(filepath.Base(replicaKeys[index]), 10, 64)
+		if err != nil {
+			return err
+		}
+		replicaInfo := &querypb.ReplicaInfo{}
+		err = proto.UnmarshalText(replicaValues[index], replicaInfo)
+		if err != nil {
+			return err
+		}
+		m.replicaInfos[replicaID] = replicaInfo
+	}
 	//TODO::update partition states
 
 	return nil
@@ -306,19 +339,88 @@ func (m *MetaReplica) deleteSegmentInfoByNodeID(nodeID UniqueID) error {
 	defer m.Unlock()
 
 	for segmentID, info := range m.segmentInfos {
-		if info.NodeID == nodeID {
-			err := removeSegmentInfo(segmentID, m.client)
+		if segmentOnNode(info, nodeID) {
+			err := m.removeSegmentNodeInternal(segmentID, nodeID)
 			if err != nil {
-				log.Error("remove segmentInfo error", zap.Any("error", err.Error()), zap.Int64("segmentID", segmentID))
 				return err
 			}
-			delete(m.segmentInfos, segmentID)
 		}
 	}
 
 	return nil
 }
 
+// removeSegmentNode removes the copy of the segment on the node,
+// the segment info is deleted once no copy is left
+func (m *MetaReplica) removeSegmentNode(segmentID UniqueID, nodeID int64) error {
+	m.Lock()
+	defer m.Unlock()
+
+	return m.removeSegmentNodeInternal(segmentID, nodeID)
+}
+
+// replaceSegmentNode moves the copy of the segment on the old node to the new node
+func (m *MetaReplica) replaceSegmentNode(segmentID UniqueID, oldNodeID int64, newNodeID int64) error {
+	m.Lock()
+	defer m.Unlock()
+
+	info, ok := m.segmentInfos[segmentID]
+	if !ok {
+		return fmt.Errorf("replaceSegmentNode: can't find segmentID %d in segmentInfos", segmentID)
+	}
+	nodeIDs := make([]int64, 0)
+	for _, id := range segmentNodeIDs(info) {
+		if id != oldNodeID && id != newNodeID {
+			nodeIDs = append(nodeIDs, id)
+		}
+	}
+	nodeIDs = append(nodeIDs, newNodeID)
+
+	newInfo := proto.Clone(info).(*querypb.SegmentInfo)
+	newInfo.NodeID = nodeIDs[0]
+	newInfo.NodeIds = nodeIDs
+	err := saveSegmentInfo(segmentID, newInfo, m.client)
+	if err != nil {
+		log.Error("save segmentInfo error", zap.Any("error", err.Error()), zap.Int64("segmentID", segmentID))
+		return err
+	}
+	m.segmentInfos[segmentID] = newInfo
+	return nil
+}
+
+func (m *MetaReplica) removeSegmentNodeInternal(segmentID UniqueID, nodeID int64) error {
+	info, ok := m.segmentInfos[segmentID]
+	if !ok {
+		return nil
+	}
+	nodeIDs := make([]int64, 0)
+	for _, id := range segmentNodeIDs(info) {
+		if id != nodeID {
+			nodeIDs = append(nodeIDs, id)
+		}
+	}
+	if len(nodeIDs) == 0 {
+		err := removeSegmentInfo(segmentID, m.client)
+		if err != nil {
+			log.Error("remove segmentInfo error", zap.Any("error", err.Error()), zap.Int64("segmentID", segmentID))
+			return err
+		}
+		delete(m.segmentInfos, segmentID)
+		return nil
+	}
+
+	newInfo := proto.Clone(info).(*querypb.SegmentInfo)
+	newInfo.NodeID = nodeIDs[0]
+	newInfo.NodeIds = nodeIDs
+	err := saveSegmentInfo(segmentID, newInfo, m.client)
+	if err != nil {
+		log.Error("save segmentInfo error", zap.Any("error", err.Error()), zap.Int64("segmentID", segmentID))
+		return err
+	}
+	m.segmentInfos[segmentID] = newInfo
+	return nil
+}
+
 func (m *MetaReplica) setSegmentInfo(segmentID UniqueID, info *querypb.SegmentInfo) error {
 	m.Lock()
 	defer m.Unlock()
@@ -432,6 +534,17 @@ func (m *MetaReplica) releaseCollection(collectionID UniqueID) error {
 		}
 	}
 
+	for id, info := range m.replicaInfos {
+		if info.CollectionID == collectionID {
+			err := removeReplicaInfo(id, m.client)
+			if err != nil {
+				log.Error("remove replicaInfo error", zap.Any("error", err.Error()), zap.Int64("replicaID", id))
+				return err
+			}
+			delete(m.replicaInfos, id)
+		}
+	}
+
 	delete(m.queryChannelInfos, collectionID)
 	err := removeGlobalCollectionInfo(collectionID, m.client)
 	if err != nil {
@@ -621,6 +734,64 @@ func (m *MetaReplica) setLoadType(collectionID UniqueID, loadType querypb.LoadTy
 	return errors.New("setLoadType: can't find collection in collectionInfos")
 }
 
+func (m *MetaReplica) setLoadFieldIDs(collectionID UniqueID, fieldIDs []int64) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if info, ok := m.collectionInfos[collectionID]; ok {
+		info.LoadFieldIDs = fieldIDs
+		err := saveGlobalCollectionInfo(collectionID, info, m.client)
+		if err != nil {
+			log.Error("save collectionInfo error", zap.Any("error", err.Error()), zap.Int64("collectionID", collectionID))
+			return err
+		}
+		return nil
+	}
+
+	return errors.New("setLoadFieldIDs: can't find collection in collectionInfos")
+}
+
+// getLoadFieldIDs returns the fields loaded of the collection, empty if all the fields are loaded
+func (m *MetaReplica) getLoadFieldIDs(collectionID UniqueID) ([]int64, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	if info, ok := m.collectionInfos[collectionID]; ok {
+		return info.LoadFieldIDs, nil
+	}
+
+	return nil, errors.New("getLoadFieldIDs: can't find collection in collectionInfos")
+}
+
+// loadedFieldIDs returns the fields loaded of the collection, all the fields of the schema if no field is specified
+func loadedFieldIDs(info *querypb.CollectionInfo) []int64 {
+	if len(info.LoadFieldIDs) > 0 || info.Schema == nil {
+		return info.LoadFieldIDs
+	}
+	fieldIDs := make([]int64, 0, len(info.Schema.Fields))
+	for _, field := range info.Schema.Fields {
+		fieldIDs = append(fieldIDs, field.FieldID)
+	}
+	return fieldIDs
+}
+
+// sameFieldIDs checks if the two lists have the same fields regardless of the order
+func sameFieldIDs(fieldIDs1 []int64, fieldIDs2 []int64) bool {
+	if len(fieldIDs1) != len(fieldIDs2) {
+		return false
+	}
+	fields := make(map[int64]bool, len(fieldIDs1))
+	for _, fieldID := range fieldIDs1 {
+		fields[fieldID] = true
+	}
+	for _, fieldID := range fieldIDs2 {
+		if !fields[fieldID] {
+			return false
+		}
+	}
+	return true
+}
+
 func (m *MetaReplica) getLoadType(collectionID UniqueID) (querypb.LoadType, error) {
 	m.RLock()
 	defer m.RUnlock()
@@ -679,6 +850,113 @@ func (m *MetaReplica) setLoadPercentage(collectionID UniqueID, partitionID Uniqu
 	return nil
 }
 
+func (m *MetaReplica) addReplica(replica *querypb.ReplicaInfo) error {
+	m.Lock()
+	defer m.Unlock()
+
+	err := saveReplicaInfo(replica.ReplicaID, replica, m.client)
+	if err != nil {
+		log.Error("save replicaInfo error", zap.Any("error", err.Error()), zap.Int64("replicaID", replica.ReplicaID))
+		return err
+	}
+	m.replicaInfos[replica.ReplicaID] = proto.Clone(replica).(*querypb.ReplicaInfo)
+	return nil
+}
+
+func (m *MetaReplica) getReplicaByID(replicaID UniqueID) (*querypb.ReplicaInfo, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	if info, ok := m.replicaInfos[replicaID]; ok {
+		return proto.Clone(info).(*querypb.ReplicaInfo), nil
+	}
+
+	return nil, fmt.Errorf("getReplicaByID: can't find replica %d in replicaInfos", replicaID)
+}
+
+// getReplicasByCollectionID returns the replicas of the collection ordered by replicaID
+func (m *MetaReplica) getReplicasByCollectionID(collectionID UniqueID) []*querypb.ReplicaInfo {
+	m.RLock()
+	defer m.RUnlock()
+
+	replicas := make([]*querypb.ReplicaInfo, 0)
+	for _, info := range m.replicaInfos {
+		if info.CollectionID == collectionID {
+			replicas = append(replicas, proto.Clone(info).(*querypb.ReplicaInfo))
+		}
+	}
+	sort.Slice(replicas, func(i, j int) bool {
+		return replicas[i].ReplicaID < replicas[j].ReplicaID
+	})
+	return replicas
+}
+
+func (m *MetaReplica) getReplicaByNodeID(collectionID UniqueID, nodeID int64) (*querypb.ReplicaInfo, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	for _, info := range m.replicaInfos {
+		if info.CollectionID != collectionID {
+			continue
+		}
+		for _, id := range info.NodeIds {
+			if id == nodeID {
+				return proto.Clone(info).(*querypb.ReplicaInfo), nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("getReplicaByNodeID: node %d isn't in any replica of collection %d", nodeID, collectionID)
+}
+
+func (m *MetaReplica) addNodeToReplica(replicaID UniqueID, nodeID int64) error {
+	m.Lock()
+	defer m.Unlock()
+
+	info, ok := m.replicaInfos[replicaID]
+	if !ok {
+		return fmt.Errorf("addNodeToReplica: can't find replica %d in replicaInfos", replicaID)
+	}
+	for _, id := range info.NodeIds {
+		if id == nodeID {
+			return nil
+		}
+	}
+	newInfo := proto.Clone(info).(*querypb.ReplicaInfo)
+	newInfo.NodeIds = append(newInfo.NodeIds, nodeID)
+	err := saveReplicaInfo(replicaID, newInfo, m.client)
+	if err != nil {
+		log.Error("save replicaInfo error", zap.Any("error", err.Error()), zap.Int64("replicaID", replicaID))
+		return err
+	}
+	m.replicaInfos[replicaID] = newInfo
+	return nil
+}
+
+func (m *MetaReplica) removeNodeFromReplica(replicaID UniqueID, nodeID int64) error {
+	m.Lock()
+	defer m.Unlock()
+
+	info, ok := m.replicaInfos[replicaID]
+	if !ok {
+		return fmt.Errorf("removeNodeFromReplica: can't find replica %d in replicaInfos", replicaID)
+	}
+	newInfo := proto.Clone(info).(*querypb.ReplicaInfo)
+	newInfo.NodeIds = make([]int64, 0)
+	for _, id := range info.NodeIds {
+		if id != nodeID {
+			newInfo.NodeIds = append(newInfo.NodeIds, id)
+		}
+	}
+	err := saveReplicaInfo(replicaID, newInfo, m.client)
+	if err != nil {
+		log.Error("save replicaInfo error", zap.Any("error", err.Error()), zap.Int64("replicaID", replicaID))
+		return err
+	}
+	m.replicaInfos[replicaID] = newInfo
+	return nil
+}
+
 func (m *MetaReplica) printMeta() {
 	m.RLock()
 	defer m.RUnlock()
@@ -693,6 +971,31 @@ func (m *MetaReplica) printMeta() {
 	for id, info := range m.queryChannelInfos {
 		log.Debug("query coordinator MetaReplica: queryChannelInfo", zap.Int64("collectionID", id), zap.Any("info", info))
 	}
+
+	for id, info := range m.replicaInfos {
+		log.Debug("query coordinator MetaReplica: replicaInfo", zap.Int64("replicaID", id), zap.Any("info", info))
+	}
+}
+
+// segmentNodeIDs returns the query nodes holding a copy of the segment
+func segmentNodeIDs(info *querypb.SegmentInfo) []int64 {
+	if len(info.NodeIds) > 0 {
+		return info.NodeIds
+	}
+	if info.NodeID > 0 {
+		return []int64{info.NodeID}
+	}
+	return nil
+}
+
+// segmentOnNode tells whether a copy of the segment is loaded on the query node
+func segmentOnNode(info *querypb.SegmentInfo, nodeID int64) bool {
+	for _, id := range segmentNodeIDs(info) {
+		if id == nodeID {
+			return true
+		}
+	}
+	return false
 }
 
 func saveGlobalCollectionInfo(collectionID UniqueID, info *querypb.CollectionInfo, kv *etcdkv.EtcdKV) error {
@@ -730,3 +1033,15 @@ func removeQueryChannelInfo(collectionID UniqueID, kv *etcdkv.EtcdKV) error {
 	key := fmt.Sprintf("%s/%d", queryChannelMetaPrefix, collectionID)
 	return kv.Remove(key)
 }
+
+func saveReplicaInfo(replicaID UniqueID, info *querypb.ReplicaInfo, kv *etcdkv.EtcdKV) error {
+	infoBytes := proto.MarshalTextString(info)
+
+	key := fmt.Sprintf("%s/%d", replicaMetaPrefix, replicaID)
+	return kv.Save(key, infoBytes)
+}
+
+func removeReplicaInfo(replicaID UniqueID, kv *etcdkv.EtcdKV) error {
+	key := fmt.Sprintf("%s/%d", replicaMetaPrefix, replicaID)
+	return kv.Remove(key)
+}
@@ -62,6 +62,7 @@ func TestReloadMetaFromKV(t *testing.T) {
 		collectionInfos:   map[UniqueID]*querypb.CollectionInfo{},
 		segmentInfos:      map[UniqueID]*querypb.SegmentInfo{},
 		queryChannelInfos: map[UniqueID]*querypb.QueryChannelInfo{},
+		replicaInfos:      map[UniqueID]*querypb.ReplicaInfo{},
 	}
 
 	kvs := make(map[string]string)
@@ -86,6 +87,15 @@ func TestReloadMetaFromKV(t *testing.T) {
 	queryChannelKey := fmt.Sprintf("%s/%d", queryChannelMetaPrefix, defaultCollectionID)
 	kvs[queryChannelKey] = queryChannelBlobs
 
+	replicaInfo := &querypb.ReplicaInfo{
+		ReplicaID:    defaultReplicaID,
+		CollectionID: defaultCollectionID,
+		NodeIds:      []int64{1, 2},
+	}
+	replicaBlobs := proto.MarshalTextString(replicaInfo)
+	replicaKey := fmt.Sprintf("%s/%d", replicaMetaPrefix, defaultReplicaID)
+	kvs[replicaKey] = replicaBlobs
+
 	err = kv.MultiSave(kvs)
 	assert.Nil(t, err)
 
@@ -101,4 +111,116 @@ func TestReloadMetaFromKV(t *testing.T) {
 	assert.Equal(t, true, ok)
 	_, ok = meta.queryChannelInfos[defaultCollectionID]
 	assert.Equal(t, true, ok)
+	replica, ok := meta.replicaInfos[defaultReplicaID]
+	assert.Equal(t, true, ok)
+	assert.Equal(t, []int64{1, 2}, replica.NodeIds)
+	kv.Remove(replicaKey)
+}
+
+func TestMetaReplica_Replicas(t *testing.T) {
+	refreshParams()
+	etcdKV, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
+	assert.Nil(t, err)
+	meta, err := newMeta(etcdKV)
+	assert.Nil(t, err)
+	err = meta.addCollection(defaultCollectionID, nil)
+	require.NoError(t, err)
+
+	err = meta.addReplica(&querypb.ReplicaInfo{ReplicaID: 2, CollectionID: defaultCollectionID, NodeIds: []int64{2, 4}})
+	assert.Nil(t, err)
+	err = meta.addReplica(&querypb.ReplicaInfo{ReplicaID: 1, CollectionID: defaultCollectionID, NodeIds: []int64{1, 3}})
+	assert.Nil(t, err)
+
+	replicas := meta.getReplicasByCollectionID(defaultCollectionID)
+	assert.Equal(t, 2, len(replicas))
+	assert.Equal(t, int64(1), replicas[0].ReplicaID)
+	assert.Equal(t, int64(2), replicas[1].ReplicaID)
+
+	replica, err := meta.getReplicaByNodeID(defaultCollectionID, 4)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), replica.ReplicaID)
+	_, err = meta.getReplicaByNodeID(defaultCollectionID, 5)
+	assert.NotNil(t, err)
+
+	err = meta.removeNodeFromReplica(2, 4)
+	assert.Nil(t, err)
+	err = meta.addNodeToReplica(2, 5)
+	assert.Nil(t, err)
+	replica, err = meta.getReplicaByID(2)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{2, 5}, replica.NodeIds)
+
+	t.Run("Test segment copies", func(t *testing.T) {
+		err = meta.setSegmentInfo(defaultSegmentID, &querypb.SegmentInfo{
+			SegmentID:    defaultSegmentID,
+			CollectionID: defaultCollectionID,
+			NodeID:       1,
+			NodeIds:      []int64{1, 2},
+		})
+		assert.Nil(t, err)
+
+		err = meta.removeSegmentNode(defaultSegmentID, 1)
+		assert.Nil(t, err)
+		info, err := meta.getSegmentInfoByID(defaultSegmentID)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), info.NodeID)
+		assert.Equal(t, []int64{2}, info.NodeIds)
+
+		err = meta.replaceSegmentNode(defaultSegmentID, 2, 3)
+		assert.Nil(t, err)
+		info, err = meta.getSegmentInfoByID(defaultSegmentID)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(3), info.NodeID)
+		assert.Equal(t, []int64{3}, info.NodeIds)
+
+		err = meta.replaceSegmentNode(defaultSegmentID, 3, 2)
+		assert.Nil(t, err)
+
+		err = meta.deleteSegmentInfoByNodeID(2)
+		assert.Nil(t, err)
+		assert.False(t, meta.hasSegmentInfo(defaultSegmentID))
+	})
+
+	err = meta.releaseCollection(defaultCollectionID)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(meta.getReplicasByCollectionID(defaultCollectionID)))
+	_, err = meta.getReplicaByID(1)
+	assert.NotNil(t, err)
+}
+
+func TestMeta_LoadFieldIDs(t *testing.T) {
+	refreshParams()
+	etcdKV, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
+	assert.Nil(t, err)
+	meta, err := newMeta(etcdKV)
+	assert.Nil(t, err)
+
+	schema := genCollectionSchema(defaultCollectionID, false)
+	err = meta.addCollection(defaultCollectionID, schema)
+	assert.Nil(t, err)
+	defer meta.releaseCollection(defaultCollectionID)
+
+	allFieldIDs := make([]int64, 0, len(schema.Fields))
+	for _, field := range schema.Fields {
+		allFieldIDs = append(allFieldIDs, field.FieldID)
+	}
+	fieldIDs, err := meta.getLoadFieldIDs(defaultCollectionID)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(fieldIDs))
+	info, err := meta.getCollectionInfoByID(defaultCollectionID)
+	assert.Nil(t, err)
+	assert.True(t, sameFieldIDs(allFieldIDs, loadedFieldIDs(info)))
+
+	err = meta.setLoadFieldIDs(defaultCollectionID, allFieldIDs[:1])
+	assert.Nil(t, err)
+	fieldIDs, err = meta.getLoadFieldIDs(defaultCollectionID)
+	assert.Nil(t, err)
+	assert.Equal(t, allFieldIDs[:1], fieldIDs)
+
+	_, err = meta.getLoadFieldIDs(defaultCollectionID + 1)
+	assert.NotNil(t, err)
+
+	assert.True(t, sameFieldIDs([]int64{1, 2}, []int64{2, 1}))
+	assert.False(t, sameFieldIDs([]int64{1, 2}, []int64{1}))
+	assert.False(t, sameFieldIDs([]int64{1, 2}, []int64{1, 3}))
 }
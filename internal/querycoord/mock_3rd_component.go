@@ -40,6 +40,7 @@ const (
 	defaultCollectionID = UniqueID(2021)
 	defaultPartitionID  = UniqueID(2021)
 	defaultSegmentID    = UniqueID(2021)
+	defaultReplicaID    = UniqueID(2021)
 	defaultShardsNum    = 2
 )
 
@@ -355,6 +356,19 @@ func (data *dataCoordMock) GetRecoveryInfo(ctx context.Context, req *datapb.GetR
 	}, nil
 }
 
+func (data *dataCoordMock) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
+	segmentIDs := make([]UniqueID, 0)
+	if segmentID, ok := data.partitionID2Segment[req.PartitionID]; ok {
+		segmentIDs = append(segmentIDs, segmentID)
+	}
+	return &datapb.GetFlushedSegmentsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Segments: segmentIDs,
+	}, nil
+}
+
 type indexCoordMock struct {
 	types.IndexCoord
 }
@@ -131,6 +131,14 @@ func (client *queryNodeClientMock) GetSegmentInfo(ctx context.Context, req *quer
 	return client.grpcClient.GetSegmentInfo(ctx, req)
 }
 
+func (client *queryNodeClientMock) Search(ctx context.Context, req *querypb.SearchRequest) (*internalpb.SearchResults, error) {
+	return client.grpcClient.Search(ctx, req)
+}
+
+func (client *queryNodeClientMock) Query(ctx context.Context, req *querypb.QueryRequest) (*internalpb.RetrieveResults, error) {
+	return client.grpcClient.Query(ctx, req)
+}
+
 func (client *queryNodeClientMock) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return client.grpcClient.GetMetrics(ctx, req)
 }
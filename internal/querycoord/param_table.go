@@ -58,6 +58,18 @@ type ParamTable struct {
 	MinioSecretAccessKey string
 	MinioUseSSLStr       bool
 	MinioBucketName      string
+
+	// --- Balance ---
+	AutoBalance                         bool
+	BalanceIntervalSeconds              int64
+	OverloadedMemoryThresholdPercentage float64
+	MemoryUsageMaxDifferencePercentage  float64
+
+	// --- Handoff ---
+	AutoHandoff            bool
+	HandoffIntervalSeconds int64
+
+	EnableActiveStandby bool
 }
 
 var Params ParamTable
@@ -96,6 +108,18 @@ func (p *ParamTable) Init() {
 		p.initMinioSecretAccessKey()
 		p.initMinioUseSSLStr()
 		p.initMinioBucketName()
+
+		//--- Balance ---
+		p.initAutoBalance()
+		p.initBalanceIntervalSeconds()
+		p.initOverloadedMemoryThresholdPercentage()
+		p.initMemoryUsageMaxDifferencePercentage()
+
+		//--- Handoff ---
+		p.initAutoHandoff()
+		p.initHandoffIntervalSeconds()
+
+		p.initEnableActiveStandby()
 	})
 }
 
@@ -247,3 +271,31 @@ func (p *ParamTable) initMinioBucketName() {
 	}
 	p.MinioBucketName = bucketName
 }
+
+func (p *ParamTable) initAutoBalance() {
+	p.AutoBalance = p.ParseBool("queryCoord.autoBalance", true)
+}
+
+func (p *ParamTable) initBalanceIntervalSeconds() {
+	p.BalanceIntervalSeconds = p.ParseInt64("queryCoord.balanceIntervalSeconds")
+}
+
+func (p *ParamTable) initOverloadedMemoryThresholdPercentage() {
+	p.OverloadedMemoryThresholdPercentage = p.ParseFloat("queryCoord.overloadedMemoryThresholdPercentage") / 100
+}
+
+func (p *ParamTable) initMemoryUsageMaxDifferencePercentage() {
+	p.MemoryUsageMaxDifferencePercentage = p.ParseFloat("queryCoord.memoryUsageMaxDifferencePercentage") / 100
+}
+
+func (p *ParamTable) initAutoHandoff() {
+	p.AutoHandoff = p.ParseBool("queryCoord.autoHandoff", true)
+}
+
+func (p *ParamTable) initHandoffIntervalSeconds() {
+	p.HandoffIntervalSeconds = p.ParseInt64("queryCoord.handoffIntervalSeconds")
+}
+
+func (p *ParamTable) initEnableActiveStandby() {
+	p.EnableActiveStandby = p.ParseBool("queryCoord.enableActiveStandby", false)
+}
@@ -55,10 +55,12 @@ type QueryCoord struct {
 	newNodeFn    newQueryNodeFn
 	scheduler    *TaskScheduler
 
-	dataCoordClient types.DataCoord
-	rootCoordClient types.RootCoord
+	dataCoordClient  types.DataCoord
+	rootCoordClient  types.RootCoord
+	indexCoordClient types.IndexCoord
 
 	session   *sessionutil.Session
+	liveCh    <-chan bool
 	eventChan <-chan *sessionutil.SessionEvent
 
 	stateCode  atomic.Value
@@ -68,11 +70,12 @@ type QueryCoord struct {
 	msFactory msgstream.Factory
 }
 
-// Register register query service at etcd
+// Register register query service at etcd, a standby query service blocks here until the active one quits
 func (qc *QueryCoord) Register() error {
 	log.Debug("query coord session info", zap.String("metaPath", Params.MetaRootPath), zap.Strings("etcdEndPoints", Params.EtcdEndpoints), zap.String("address", Params.Address))
 	qc.session = sessionutil.NewSession(qc.loopCtx, Params.MetaRootPath, Params.EtcdEndpoints)
-	qc.session.Init(typeutil.QueryCoordRole, Params.Address, true)
+	qc.session.SetEnableActiveStandBy(Params.EnableActiveStandby)
+	qc.liveCh = qc.session.Init(typeutil.QueryCoordRole, Params.Address, true)
 	Params.NodeID = uint64(qc.session.ServerID)
 	return nil
 }
@@ -105,7 +108,7 @@ func (qc *QueryCoord) Init() error {
 		return err
 	}
 
-	qc.scheduler, err = NewTaskScheduler(qc.loopCtx, qc.meta, qc.cluster, qc.kvClient, qc.rootCoordClient, qc.dataCoordClient)
+	qc.scheduler, err = NewTaskScheduler(qc.loopCtx, qc.meta, qc.cluster, qc.kvClient, qc.rootCoordClient, qc.dataCoordClient, qc.indexCoordClient)
 	if err != nil {
 		log.Error("query coordinator init task scheduler failed", zap.Error(err))
 		return err
@@ -119,12 +122,24 @@ func (qc *QueryCoord) Start() error {
 	log.Debug("start scheduler ...")
 	qc.UpdateStateCode(internalpb.StateCode_Healthy)
 
+	qc.loopWg.Add(1)
+	go qc.watchLivenessLoop()
+
 	qc.loopWg.Add(1)
 	go qc.watchNodeLoop()
 
 	qc.loopWg.Add(1)
 	go qc.watchMetaLoop()
 
+	if Params.AutoBalance {
+		qc.loopWg.Add(1)
+		go qc.loadBalanceSegmentLoop()
+	}
+
+	// the handoff events are removed without loading the segments if auto handoff is disabled
+	qc.loopWg.Add(1)
+	go qc.handoffSegmentLoop()
+
 	return nil
 }
 
@@ -142,6 +157,28 @@ func (qc *QueryCoord) UpdateStateCode(code internalpb.StateCode) {
 	qc.stateCode.Store(code)
 }
 
+// watchLivenessLoop stops the query service once its session expires, so that a standby can take over
+func (qc *QueryCoord) watchLivenessLoop() {
+	defer qc.loopWg.Done()
+	for {
+		select {
+		case <-qc.loopCtx.Done():
+			return
+		case _, ok := <-qc.liveCh:
+			if ok {
+				continue
+			}
+			if qc.loopCtx.Err() != nil {
+				// the session is closed by stopping
+				return
+			}
+			log.Error("query coordinator disconnect with etcd, stop query coordinator")
+			go qc.Stop()
+			return
+		}
+	}
+}
+
 func NewQueryCoord(ctx context.Context, factory msgstream.Factory) (*QueryCoord, error) {
 	rand.Seed(time.Now().UnixNano())
 	queryChannels := make([]*queryChannelInfo, 0)
@@ -177,6 +214,10 @@ func (qc *QueryCoord) SetDataCoord(dataCoord types.DataCoord) {
 	qc.dataCoordClient = dataCoord
 }
 
+func (qc *QueryCoord) SetIndexCoord(indexCoord types.IndexCoord) {
+	qc.indexCoordClient = indexCoord
+}
+
 func (qc *QueryCoord) watchNodeLoop() {
 	ctx, cancel := context.WithCancel(qc.loopCtx)
 	defer cancel()
@@ -196,6 +237,11 @@ func (qc *QueryCoord) watchNodeLoop() {
 			err := qc.cluster.registerNode(ctx, session, serverID)
 			if err != nil {
 				log.Error("query node failed to register", zap.Int64("nodeID", serverID), zap.String("error info", err.Error()))
+				continue
+			}
+			if session.Stopping {
+				qc.loopWg.Add(1)
+				go qc.drainNode(serverID)
 			}
 		}
 	}
@@ -219,6 +265,7 @@ func (qc *QueryCoord) watchNodeLoop() {
 				LoadBalanceRequest: loadBalanceSegment,
 				rootCoord:          qc.rootCoordClient,
 				dataCoord:          qc.dataCoordClient,
+				indexCoord:         qc.indexCoordClient,
 				cluster:            qc.cluster,
 				meta:               qc.meta,
 			}
@@ -240,6 +287,14 @@ func (qc *QueryCoord) watchNodeLoop() {
 				if err != nil {
 					log.Error("query node failed to register", zap.Int64("nodeID", serverID), zap.String("error info", err.Error()))
 				}
+			case sessionutil.SessionUpdateEvent:
+				serverID := event.Session.ServerID
+				if !event.Session.Stopping {
+					continue
+				}
+				log.Debug("get an update event of a stopping queryNode", zap.Int64("nodeID", serverID))
+				qc.loopWg.Add(1)
+				go qc.drainNode(serverID)
 			case sessionutil.SessionDelEvent:
 				serverID := event.Session.ServerID
 				log.Debug("get a del event after queryNode down", zap.Int64("nodeID", serverID))
@@ -268,6 +323,7 @@ func (qc *QueryCoord) watchNodeLoop() {
 					LoadBalanceRequest: loadBalanceSegment,
 					rootCoord:          qc.rootCoordClient,
 					dataCoord:          qc.dataCoordClient,
+					indexCoord:         qc.indexCoordClient,
 					cluster:            qc.cluster,
 					meta:               qc.meta,
 				}
@@ -314,3 +370,256 @@ func (qc *QueryCoord) watchMetaLoop() {
 	}
 
 }
+
+// loadBalanceSegmentLoop periodically moves the sealed segments from the query nodes using the most memory
+// to the ones using the least, a round starts after the tasks of the previous round are done
+func (qc *QueryCoord) loadBalanceSegmentLoop() {
+	ctx, cancel := context.WithCancel(qc.loopCtx)
+	defer cancel()
+	defer qc.loopWg.Done()
+	log.Debug("query coordinator start load balance segment loop")
+
+	ticker := time.NewTicker(time.Duration(Params.BalanceIntervalSeconds) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			qc.cluster.updateMemoryCapacity(ctx)
+			for _, req := range qc.generateLoadBalanceRequests() {
+				loadBalanceTask := &LoadBalanceTask{
+					BaseTask: BaseTask{
+						ctx:              qc.loopCtx,
+						Condition:        NewTaskCondition(qc.loopCtx),
+						triggerCondition: querypb.TriggerCondition_loadBalance,
+					},
+					LoadBalanceRequest: req,
+					rootCoord:          qc.rootCoordClient,
+					dataCoord:          qc.dataCoordClient,
+					indexCoord:         qc.indexCoordClient,
+					cluster:            qc.cluster,
+					meta:               qc.meta,
+				}
+				qc.scheduler.Enqueue([]task{loadBalanceTask})
+				err := loadBalanceTask.WaitToFinish()
+				if err != nil {
+					log.Warn("loadBalanceSegmentLoop: move segments failed", zap.Int64s("sourceNodeIDs", req.SourceNodeIDs),
+						zap.Int64s("dstNodeIDs", req.DstNodeIDs), zap.Int64s("segmentIDs", req.SealedSegmentIDs), zap.Error(err))
+				}
+			}
+		}
+	}
+}
+
+// generateLoadBalanceRequests plans the segment moves balancing the memory usage of the on service query nodes,
+// one request moves the segments of a collection between two nodes
+func (qc *QueryCoord) generateLoadBalanceRequests() []*querypb.LoadBalanceRequest {
+	nodes, err := qc.cluster.onServiceNodes()
+	if err != nil {
+		return nil
+	}
+	nodeLoads := make([]*nodeMemoryLoad, 0, len(nodes))
+	segments := make(map[int64][]*querypb.SegmentInfo)
+	for nodeID := range nodes {
+		memUsage, memCapacity, err := qc.cluster.getMemoryUsage(nodeID)
+		if err != nil {
+			continue
+		}
+		nodeLoads = append(nodeLoads, &nodeMemoryLoad{
+			nodeID:      nodeID,
+			memUsage:    memUsage,
+			memCapacity: memCapacity,
+		})
+		segments[nodeID] = make([]*querypb.SegmentInfo, 0)
+	}
+	for _, collectionInfo := range qc.meta.showCollections() {
+		for _, segmentInfo := range qc.meta.showSegmentInfos(collectionInfo.CollectionID, nil) {
+			// the segments being loaded are left alone
+			if segmentInfo.SegmentState != querypb.SegmentState_sealed {
+				continue
+			}
+			for _, nodeID := range segmentNodeIDs(segmentInfo) {
+				if _, ok := segments[nodeID]; ok {
+					segments[nodeID] = append(segments[nodeID], segmentInfo)
+				}
+			}
+		}
+	}
+
+	moves := planSegmentMoves(nodeLoads, segments, Params.OverloadedMemoryThresholdPercentage,
+		Params.MemoryUsageMaxDifferencePercentage, qc.canMoveSegment)
+	reqs := make([]*querypb.LoadBalanceRequest, 0)
+	for _, move := range moves {
+		var req *querypb.LoadBalanceRequest
+		for _, r := range reqs {
+			if r.CollectionID == move.segment.CollectionID && r.SourceNodeIDs[0] == move.srcNodeID && r.DstNodeIDs[0] == move.dstNodeID {
+				req = r
+				break
+			}
+		}
+		if req == nil {
+			req = &querypb.LoadBalanceRequest{
+				Base: &commonpb.MsgBase{
+					MsgType:  commonpb.MsgType_LoadBalanceSegments,
+					SourceID: qc.session.ServerID,
+				},
+				SourceNodeIDs: []int64{move.srcNodeID},
+				BalanceReason: querypb.TriggerCondition_loadBalance,
+				DstNodeIDs:    []int64{move.dstNodeID},
+				CollectionID:  move.segment.CollectionID,
+			}
+			reqs = append(reqs, req)
+		}
+		req.SealedSegmentIDs = append(req.SealedSegmentIDs, move.segment.SegmentID)
+	}
+	return reqs
+}
+
+// drainNode moves the sealed segments off the stopping query node by load balance, so they are searchable
+// all the time, then reloads its DM channels on the other nodes and releases the node
+func (qc *QueryCoord) drainNode(nodeID int64) {
+	defer qc.loopWg.Done()
+
+	err := qc.cluster.drainNode(nodeID)
+	if err != nil {
+		log.Error("drainNode: mark queryNode stopping failed", zap.Int64("nodeID", nodeID), zap.Error(err))
+		return
+	}
+	for _, req := range qc.generateDrainRequests(nodeID) {
+		loadBalanceTask := &LoadBalanceTask{
+			BaseTask: BaseTask{
+				ctx:              qc.loopCtx,
+				Condition:        NewTaskCondition(qc.loopCtx),
+				triggerCondition: querypb.TriggerCondition_loadBalance,
+			},
+			LoadBalanceRequest: req,
+			rootCoord:          qc.rootCoordClient,
+			dataCoord:          qc.dataCoordClient,
+			indexCoord:         qc.indexCoordClient,
+			cluster:            qc.cluster,
+			meta:               qc.meta,
+		}
+		qc.scheduler.Enqueue([]task{loadBalanceTask})
+		err = loadBalanceTask.WaitToFinish()
+		if err != nil {
+			log.Warn("drainNode: move segments failed", zap.Int64("nodeID", nodeID), zap.Int64s("dstNodeIDs", req.DstNodeIDs),
+				zap.Int64s("segmentIDs", req.SealedSegmentIDs), zap.Error(err))
+		}
+	}
+
+	// the segments failed to move are reloaded together with the channels
+	drainTask := &LoadBalanceTask{
+		BaseTask: BaseTask{
+			ctx:              qc.loopCtx,
+			Condition:        NewTaskCondition(qc.loopCtx),
+			triggerCondition: querypb.TriggerCondition_nodeStopping,
+		},
+		LoadBalanceRequest: &querypb.LoadBalanceRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_LoadBalanceSegments,
+				SourceID: qc.session.ServerID,
+			},
+			SourceNodeIDs: []int64{nodeID},
+			BalanceReason: querypb.TriggerCondition_nodeStopping,
+		},
+		rootCoord:  qc.rootCoordClient,
+		dataCoord:  qc.dataCoordClient,
+		indexCoord: qc.indexCoordClient,
+		cluster:    qc.cluster,
+		meta:       qc.meta,
+	}
+	qc.scheduler.Enqueue([]task{drainTask})
+	err = drainTask.WaitToFinish()
+	if err != nil {
+		log.Error("drainNode: drain queryNode failed", zap.Int64("nodeID", nodeID), zap.Error(err))
+		return
+	}
+	log.Debug("drainNode: queryNode drained", zap.Int64("nodeID", nodeID))
+}
+
+// generateDrainRequests plans moving the sealed segments off the stopping query node, each segment moves to
+// the node using the least memory among the ones it can move to, one request moves the segments of a collection
+// between two nodes
+func (qc *QueryCoord) generateDrainRequests(nodeID int64) []*querypb.LoadBalanceRequest {
+	nodes, err := qc.cluster.onServiceNodes()
+	if err != nil {
+		return nil
+	}
+	memUsages := make(map[int64]uint64, len(nodes))
+	for id := range nodes {
+		memUsage, _, err := qc.cluster.getMemoryUsage(id)
+		if err != nil {
+			continue
+		}
+		memUsages[id] = memUsage
+	}
+
+	reqs := make([]*querypb.LoadBalanceRequest, 0)
+	for _, collectionInfo := range qc.meta.showCollections() {
+		for _, segmentInfo := range qc.meta.showSegmentInfos(collectionInfo.CollectionID, nil) {
+			if segmentInfo.SegmentState != querypb.SegmentState_sealed || !segmentOnNode(segmentInfo, nodeID) {
+				continue
+			}
+			dstNodeID := int64(-1)
+			for id, memUsage := range memUsages {
+				if qc.canMoveSegment(segmentInfo, nodeID, id) && (dstNodeID == -1 || memUsage < memUsages[dstNodeID]) {
+					dstNodeID = id
+				}
+			}
+			if dstNodeID == -1 {
+				log.Warn("generateDrainRequests: no query node to move the segment to", zap.Int64("nodeID", nodeID),
+					zap.Int64("segmentID", segmentInfo.SegmentID))
+				continue
+			}
+			memUsages[dstNodeID] += uint64(segmentInfo.MemSize)
+
+			var req *querypb.LoadBalanceRequest
+			for _, r := range reqs {
+				if r.CollectionID == segmentInfo.CollectionID && r.DstNodeIDs[0] == dstNodeID {
+					req = r
+					break
+				}
+			}
+			if req == nil {
+				req = &querypb.LoadBalanceRequest{
+					Base: &commonpb.MsgBase{
+						MsgType:  commonpb.MsgType_LoadBalanceSegments,
+						SourceID: qc.session.ServerID,
+					},
+					SourceNodeIDs: []int64{nodeID},
+					BalanceReason: querypb.TriggerCondition_loadBalance,
+					DstNodeIDs:    []int64{dstNodeID},
+					CollectionID:  segmentInfo.CollectionID,
+				}
+				reqs = append(reqs, req)
+			}
+			req.SealedSegmentIDs = append(req.SealedSegmentIDs, segmentInfo.SegmentID)
+		}
+	}
+	return reqs
+}
+
+// canMoveSegment tells whether the segment can move between the query nodes, which have to serve the same
+// replica of the collection unless the destination node serves none and is in the resource group of the replica
+func (qc *QueryCoord) canMoveSegment(segment *querypb.SegmentInfo, srcNodeID int64, dstNodeID int64) bool {
+	if segmentOnNode(segment, dstNodeID) {
+		return false
+	}
+	if len(qc.meta.getReplicasByCollectionID(segment.CollectionID)) == 0 {
+		return true
+	}
+	srcReplica, err := qc.meta.getReplicaByNodeID(segment.CollectionID, srcNodeID)
+	if err != nil {
+		return false
+	}
+	dstReplica, err := qc.meta.getReplicaByNodeID(segment.CollectionID, dstNodeID)
+	if err != nil {
+		dstNode, err := qc.cluster.getNodeByID(dstNodeID)
+		if err != nil {
+			return false
+		}
+		return dstNode.getResourceGroup() == replicaResourceGroup(srcReplica)
+	}
+	return srcReplica.ReplicaID == dstReplica.ReplicaID
+}
@@ -30,12 +30,17 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 )
 
+// errQueryNodeOutOfMemory is returned if the query node rejects the segments for lack of memory
+var errQueryNodeOutOfMemory = errors.New("query node out of memory")
+
 type Node interface {
 	start() error
 	stop()
 	clearNodeInfo() error
+	getAddress() string
 
 	addCollection(collectionID UniqueID, schema *schemapb.CollectionSchema) error
 	setCollectionInfo(info *querypb.CollectionInfo) error
@@ -55,6 +60,10 @@ type Node interface {
 
 	setNodeState(onService bool)
 	isOnService() bool
+	setStopping()
+	isStopping() bool
+	setResourceGroup(rg string)
+	getResourceGroup() string
 
 	getSegmentInfo(ctx context.Context, in *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)
 	loadSegments(ctx context.Context, in *querypb.LoadSegmentsRequest) error
@@ -62,6 +71,8 @@ type Node interface {
 	getComponentInfo(ctx context.Context) *internalpb.ComponentInfo
 
 	getMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+	updateMemoryCapacity(ctx context.Context) error
+	getMemoryCapacity() uint64
 }
 
 type queryNode struct {
@@ -75,8 +86,16 @@ type queryNode struct {
 	sync.RWMutex
 	collectionInfos      map[UniqueID]*querypb.CollectionInfo
 	watchedQueryChannels map[UniqueID]*querypb.QueryChannelInfo
-	onService            bool
-	serviceLock          sync.RWMutex
+	// memory capacity in bytes reported by the query node, 0 if unknown
+	memCapacity uint64
+	onService   bool
+	// a stopping node keeps serving until its segments and channels are moved elsewhere,
+	// but no new segment or channel is assigned to it
+	stopping    bool
+	serviceLock sync.RWMutex
+
+	// the resource group of the node, guarded by serviceLock
+	resourceGroup string
 }
 
 func newQueryNode(ctx context.Context, address string, id UniqueID, kv *etcdkv.EtcdKV) (Node, error) {
@@ -115,6 +134,10 @@ func (qn *queryNode) start() error {
 	qn.onService = true
 	qn.serviceLock.Unlock()
 	log.Debug("Start: queryNode client start success", zap.Int64("nodeID", qn.id), zap.String("address", qn.address))
+
+	if err := qn.updateMemoryCapacity(qn.ctx); err != nil {
+		log.Warn("Start: get queryNode memory capacity failed", zap.Int64("nodeID", qn.id), zap.Error(err))
+	}
 	return nil
 }
 
@@ -368,6 +391,37 @@ func (qn *queryNode) isOnService() bool {
 	return qn.onService
 }
 
+func (qn *queryNode) setStopping() {
+	qn.serviceLock.Lock()
+	defer qn.serviceLock.Unlock()
+
+	qn.stopping = true
+}
+
+func (qn *queryNode) isStopping() bool {
+	qn.serviceLock.RLock()
+	defer qn.serviceLock.RUnlock()
+
+	return qn.stopping
+}
+
+func (qn *queryNode) setResourceGroup(rg string) {
+	qn.serviceLock.Lock()
+	defer qn.serviceLock.Unlock()
+
+	qn.resourceGroup = rg
+}
+
+func (qn *queryNode) getResourceGroup() string {
+	qn.serviceLock.RLock()
+	defer qn.serviceLock.RUnlock()
+
+	if qn.resourceGroup == "" {
+		return defaultResourceGroup
+	}
+	return qn.resourceGroup
+}
+
 //***********************grpc req*************************//
 func (qn *queryNode) watchDmChannels(ctx context.Context, in *querypb.WatchDmChannelsRequest) error {
 	qn.serviceLock.RLock()
@@ -490,10 +544,11 @@ func (qn *queryNode) releasePartitions(ctx context.Context, in *querypb.ReleaseP
 
 func (qn *queryNode) getSegmentInfo(ctx context.Context, in *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
 	qn.serviceLock.RLock()
-	if !qn.onService {
+	onService := qn.onService
+	qn.serviceLock.RUnlock()
+	if !onService {
 		return nil, nil
 	}
-	qn.serviceLock.RUnlock()
 
 	res, err := qn.client.GetSegmentInfo(ctx, in)
 	if err == nil && res.Status.ErrorCode == commonpb.ErrorCode_Success {
@@ -526,14 +581,50 @@ func (qn *queryNode) getComponentInfo(ctx context.Context) *internalpb.Component
 
 func (qn *queryNode) getMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	qn.serviceLock.RLock()
-	if !qn.onService {
+	onService := qn.onService
+	qn.serviceLock.RUnlock()
+	if !onService {
 		return nil, errQueryNodeIsNotOnService(qn.id)
 	}
-	qn.serviceLock.RUnlock()
 
 	return qn.client.GetMetrics(ctx, in)
 }
 
+// updateMemoryCapacity refreshes the memory capacity with the one reported in the metrics of the query node
+func (qn *queryNode) updateMemoryCapacity(ctx context.Context) error {
+	req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SystemInfoMetrics)
+	if err != nil {
+		return err
+	}
+	resp, err := qn.getMetrics(ctx, req)
+	if err != nil {
+		return err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return errors.New(resp.Status.Reason)
+	}
+	infos := metricsinfo.QueryNodeInfos{}
+	err = metricsinfo.UnmarshalComponentInfos(resp.Response, &infos)
+	if err != nil {
+		return err
+	}
+
+	qn.Lock()
+	defer qn.Unlock()
+	qn.memCapacity = infos.HardwareInfos.Memory
+	return nil
+}
+
+func (qn *queryNode) getAddress() string {
+	return qn.address
+}
+
+func (qn *queryNode) getMemoryCapacity() uint64 {
+	qn.RLock()
+	defer qn.RUnlock()
+	return qn.memCapacity
+}
+
 func (qn *queryNode) loadSegments(ctx context.Context, in *querypb.LoadSegmentsRequest) error {
 	qn.serviceLock.RLock()
 	onService := qn.onService
@@ -546,6 +637,9 @@ func (qn *queryNode) loadSegments(ctx context.Context, in *querypb.LoadSegmentsR
 	if err != nil {
 		return err
 	}
+	if status.ErrorCode == commonpb.ErrorCode_OutOfMemory {
+		return fmt.Errorf("%w: %s", errQueryNodeOutOfMemory, status.Reason)
+	}
 	if status.ErrorCode != commonpb.ErrorCode_Success {
 		return errors.New(status.Reason)
 	}
@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/golang/protobuf/proto"
@@ -23,12 +24,17 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/opentracing/opentracing-go"
 )
 
@@ -66,6 +72,8 @@ type task interface {
 	AddChildTask(t task)
 	IsValid() bool
 	Reschedule() ([]task, error)
+	getResultInfo() *commonpb.Status
+	setResultInfo(err error)
 	Marshal() ([]byte, error)
 	State() taskState
 	SetState(state taskState)
@@ -120,6 +128,18 @@ func (bt *BaseTask) Reschedule() ([]task, error) {
 	return nil, nil
 }
 
+func (bt *BaseTask) getResultInfo() *commonpb.Status {
+	return bt.result
+}
+
+// setResultInfo fails the task, the child tasks fail their trigger task if they can't be rescheduled
+func (bt *BaseTask) setResultInfo(err error) {
+	bt.result = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		Reason:    err.Error(),
+	}
+}
+
 func (bt *BaseTask) State() taskState {
 	return bt.state
 }
@@ -132,10 +152,12 @@ func (bt *BaseTask) SetState(state taskState) {
 type LoadCollectionTask struct {
 	BaseTask
 	*querypb.LoadCollectionRequest
-	rootCoord types.RootCoord
-	dataCoord types.DataCoord
-	cluster   *queryNodeCluster
-	meta      Meta
+	rootCoord   types.RootCoord
+	dataCoord   types.DataCoord
+	indexCoord  types.IndexCoord
+	cluster     *queryNodeCluster
+	meta        Meta
+	idAllocator func() (UniqueID, error)
 }
 
 func (lct *LoadCollectionTask) MsgBase() *commonpb.MsgBase {
@@ -193,6 +215,13 @@ func (lct *LoadCollectionTask) Execute(ctx context.Context) error {
 	hasCollection := lct.meta.hasCollection(collectionID)
 	watchPartition := false
 	if hasCollection {
+		loadFieldIDs, _ := lct.meta.getLoadFieldIDs(collectionID)
+		if !sameFieldIDs(loadFieldIDs, lct.LoadFieldIDs) {
+			err = fmt.Errorf("collection %d has been loaded with fields %v, release it before loading fields %v", collectionID, loadFieldIDs, lct.LoadFieldIDs)
+			status.Reason = err.Error()
+			lct.result = status
+			return err
+		}
 		watchPartition = true
 		loadType, _ := lct.meta.getLoadType(collectionID)
 		if loadType == querypb.LoadType_loadCollection {
@@ -217,14 +246,24 @@ func (lct *LoadCollectionTask) Execute(ctx context.Context) error {
 	log.Debug("loadCollectionTask: toLoadPartitionIDs", zap.Int64s("partitionIDs", toLoadPartitionIDs))
 	lct.meta.addCollection(collectionID, lct.Schema)
 	lct.meta.setLoadType(collectionID, querypb.LoadType_loadCollection)
+	if !hasCollection {
+		lct.meta.setLoadFieldIDs(collectionID, lct.LoadFieldIDs)
+	}
 	for _, id := range toLoadPartitionIDs {
 		lct.meta.addPartition(collectionID, id)
 	}
+	replicas, err := prepareReplicas(lct.meta, lct.cluster, collectionID, lct.ReplicaNumber, lct.ResourceGroups, lct.idAllocator)
+	if err != nil {
+		status.Reason = err.Error()
+		lct.result = status
+		return err
+	}
 
 	loadSegmentReqs := make([]*querypb.LoadSegmentsRequest, 0)
 	watchDmChannelReqs := make([]*querypb.WatchDmChannelsRequest, 0)
 	channelsToWatch := make([]string, 0)
 	segmentsToLoad := make([]UniqueID, 0)
+	segmentLoadInfos := make([]*querypb.SegmentLoadInfo, 0)
 	for _, partitionID := range toLoadPartitionIDs {
 		getRecoveryInfoRequest := &datapb.GetRecoveryInfoRequest{
 			Base:         lct.Base,
@@ -245,6 +284,9 @@ func (lct *LoadCollectionTask) Execute(ctx context.Context) error {
 				PartitionID:  partitionID,
 				CollectionID: collectionID,
 				BinlogPaths:  segmentBingLog.FieldBinlogs,
+				NumOfRows:    segmentBingLog.NumOfRows,
+				Statslogs:    segmentBingLog.Statslogs,
+				Deltalogs:    segmentBingLog.Deltalogs,
 			}
 
 			msgBase := proto.Clone(lct.Base).(*commonpb.MsgBase)
@@ -257,6 +299,7 @@ func (lct *LoadCollectionTask) Execute(ctx context.Context) error {
 			}
 
 			segmentsToLoad = append(segmentsToLoad, segmentID)
+			segmentLoadInfos = append(segmentLoadInfos, segmentLoadInfo)
 			loadSegmentReqs = append(loadSegmentReqs, loadSegmentReq)
 		}
 
@@ -300,8 +343,14 @@ func (lct *LoadCollectionTask) Execute(ctx context.Context) error {
 			}
 		}
 	}
+	fillSegmentIndexSizes(ctx, lct.rootCoord, lct.indexCoord, segmentLoadInfos)
 
-	assignInternalTask(ctx, collectionID, lct, lct.meta, lct.cluster, loadSegmentReqs, watchDmChannelReqs)
+	err = assignReplicaInternalTask(ctx, collectionID, lct, lct.meta, lct.cluster, loadSegmentReqs, watchDmChannelReqs, replicas)
+	if err != nil {
+		status.Reason = err.Error()
+		lct.result = status
+		return err
+	}
 	log.Debug("loadCollectionTask: assign child task done", zap.Int64("collectionID", collectionID))
 
 	log.Debug("LoadCollection execute done",
@@ -312,7 +361,7 @@ func (lct *LoadCollectionTask) Execute(ctx context.Context) error {
 
 func (lct *LoadCollectionTask) PostExecute(ctx context.Context) error {
 	collectionID := lct.CollectionID
-	if lct.State() == taskDone {
+	if lct.State() == taskDone && lct.result.ErrorCode == commonpb.ErrorCode_Success {
 		err := lct.meta.setLoadPercentage(collectionID, 0, 100, querypb.LoadType_loadCollection)
 		if err != nil {
 			log.Debug("loadCollectionTask: set load percentage to meta's collectionInfo", zap.Int64("collectionID", collectionID))
@@ -351,6 +400,14 @@ func (lct *LoadCollectionTask) PostExecute(ctx context.Context) error {
 			log.Debug("loadCollectionTask: add a releaseCollectionTask to loadCollectionTask's childTask", zap.Any("task", releaseCollectionTask))
 		}
 	}
+	if lct.State() == taskDone {
+		// the child tasks failed, the collection is not loaded any more
+		err := lct.meta.releaseCollection(collectionID)
+		if err != nil {
+			log.Warn("loadCollectionTask: release collection from meta failed", zap.Int64("collectionID", collectionID), zap.Error(err))
+		}
+		return err
+	}
 	lct.meta.addCollection(collectionID, lct.Schema)
 	log.Debug("LoadCollectionTask postExecute done",
 		zap.Int64("msgID", lct.ID()),
@@ -426,11 +483,8 @@ func (rct *ReleaseCollectionTask) Execute(ctx context.Context) error {
 			return err
 		}
 
-		nodes, err := rct.cluster.onServiceNodes()
-		if err != nil {
-			log.Debug(err.Error())
-		}
-		for nodeID := range nodes {
+		// the stopping nodes are released too
+		for nodeID := range rct.cluster.servingNodes() {
 			req := proto.Clone(rct.ReleaseCollectionRequest).(*querypb.ReleaseCollectionRequest)
 			req.NodeID = nodeID
 			releaseCollectionTask := &ReleaseCollectionTask{
@@ -475,10 +529,13 @@ func (rct *ReleaseCollectionTask) PostExecute(context.Context) error {
 type LoadPartitionTask struct {
 	BaseTask
 	*querypb.LoadPartitionsRequest
-	dataCoord types.DataCoord
-	cluster   *queryNodeCluster
-	meta      Meta
-	addCol    bool
+	rootCoord   types.RootCoord
+	dataCoord   types.DataCoord
+	indexCoord  types.IndexCoord
+	cluster     *queryNodeCluster
+	meta        Meta
+	idAllocator func() (UniqueID, error)
+	addCol      bool
 }
 
 func (lpt *LoadPartitionTask) MsgBase() *commonpb.MsgBase {
@@ -523,8 +580,15 @@ func (lpt *LoadPartitionTask) Execute(ctx context.Context) error {
 	status := &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_UnexpectedError,
 	}
+	replicas, err := prepareReplicas(lpt.meta, lpt.cluster, collectionID, lpt.ReplicaNumber, lpt.ResourceGroups, lpt.idAllocator)
+	if err != nil {
+		status.Reason = err.Error()
+		lpt.result = status
+		return err
+	}
 
 	segmentsToLoad := make([]UniqueID, 0)
+	segmentLoadInfos := make([]*querypb.SegmentLoadInfo, 0)
 	loadSegmentReqs := make([]*querypb.LoadSegmentsRequest, 0)
 	channelsToWatch := make([]string, 0)
 	watchDmReqs := make([]*querypb.WatchDmChannelsRequest, 0)
@@ -548,6 +612,9 @@ func (lpt *LoadPartitionTask) Execute(ctx context.Context) error {
 				PartitionID:  partitionID,
 				CollectionID: collectionID,
 				BinlogPaths:  segmentBingLog.FieldBinlogs,
+				NumOfRows:    segmentBingLog.NumOfRows,
+				Statslogs:    segmentBingLog.Statslogs,
+				Deltalogs:    segmentBingLog.Deltalogs,
 			}
 
 			msgBase := proto.Clone(lpt.Base).(*commonpb.MsgBase)
@@ -559,6 +626,7 @@ func (lpt *LoadPartitionTask) Execute(ctx context.Context) error {
 				LoadCondition: querypb.TriggerCondition_grpcRequest,
 			}
 			segmentsToLoad = append(segmentsToLoad, segmentID)
+			segmentLoadInfos = append(segmentLoadInfos, segmentLoadInfo)
 			loadSegmentReqs = append(loadSegmentReqs, loadSegmentReq)
 		}
 
@@ -578,7 +646,13 @@ func (lpt *LoadPartitionTask) Execute(ctx context.Context) error {
 			log.Debug("LoadPartitionTask: set watchDmChannelsRequests", zap.Any("request", watchDmRequest), zap.Int64("collectionID", collectionID))
 		}
 	}
-	assignInternalTask(ctx, collectionID, lpt, lpt.meta, lpt.cluster, loadSegmentReqs, watchDmReqs)
+	fillSegmentIndexSizes(ctx, lpt.rootCoord, lpt.indexCoord, segmentLoadInfos)
+	err = assignReplicaInternalTask(ctx, collectionID, lpt, lpt.meta, lpt.cluster, loadSegmentReqs, watchDmReqs, replicas)
+	if err != nil {
+		status.Reason = err.Error()
+		lpt.result = status
+		return err
+	}
 	log.Debug("LoadPartitionTask: assign child task done", zap.Int64("collectionID", collectionID), zap.Int64s("partitionIDs", partitionIDs))
 
 	log.Debug("LoadPartitionTask Execute done",
@@ -591,7 +665,7 @@ func (lpt *LoadPartitionTask) Execute(ctx context.Context) error {
 func (lpt *LoadPartitionTask) PostExecute(ctx context.Context) error {
 	collectionID := lpt.CollectionID
 	partitionIDs := lpt.PartitionIDs
-	if lpt.State() == taskDone {
+	if lpt.State() == taskDone && lpt.result.ErrorCode == commonpb.ErrorCode_Success {
 		for _, id := range partitionIDs {
 			err := lpt.meta.setLoadPercentage(collectionID, id, 100, querypb.LoadType_LoadPartition)
 			if err != nil {
@@ -666,6 +740,19 @@ func (lpt *LoadPartitionTask) PostExecute(ctx context.Context) error {
 			}
 		}
 	}
+	if lpt.State() == taskDone {
+		// the child tasks failed, the partitions are not loaded any more
+		if lpt.addCol {
+			return lpt.meta.releaseCollection(collectionID)
+		}
+		for _, id := range partitionIDs {
+			err := lpt.meta.releasePartition(collectionID, id)
+			if err != nil {
+				log.Warn("loadPartitionTask: release partition from meta failed", zap.Int64("collectionID", collectionID), zap.Int64("partitionID", id), zap.Error(err))
+				return err
+			}
+		}
+	}
 	log.Debug("LoadPartitionTask postExecute done",
 		zap.Int64("msgID", lpt.ID()),
 		zap.Int64("collectionID", collectionID),
@@ -715,11 +802,8 @@ func (rpt *ReleasePartitionTask) Execute(ctx context.Context) error {
 	}
 
 	if rpt.NodeID <= 0 {
-		nodes, err := rpt.cluster.onServiceNodes()
-		if err != nil {
-			log.Debug(err.Error())
-		}
-		for nodeID := range nodes {
+		// the stopping nodes are released too
+		for nodeID := range rpt.cluster.servingNodes() {
 			req := proto.Clone(rpt.ReleasePartitionsRequest).(*querypb.ReleasePartitionsRequest)
 			req.NodeID = nodeID
 			releasePartitionTask := &ReleasePartitionTask{
@@ -771,6 +855,8 @@ type LoadSegmentTask struct {
 	*querypb.LoadSegmentsRequest
 	meta    Meta
 	cluster *queryNodeCluster
+	// the nodes rejecting the segments for lack of memory
+	excludeNodeIDs []int64
 }
 
 func (lst *LoadSegmentTask) MsgBase() *commonpb.MsgBase {
@@ -787,7 +873,7 @@ func (lst *LoadSegmentTask) IsValid() bool {
 		return false
 	}
 
-	return lst.ctx != nil && onService
+	return lst.ctx != nil && onService && !funcutil.SliceContain(lst.excludeNodeIDs, lst.NodeID)
 }
 
 func (lst *LoadSegmentTask) Type() commonpb.MsgType {
@@ -820,7 +906,11 @@ func (lst *LoadSegmentTask) Execute(ctx context.Context) error {
 	}
 	err := lst.cluster.loadSegments(ctx, lst.NodeID, lst.LoadSegmentsRequest)
 	if err != nil {
-		log.Error("LoadSegmentTask: loadSegment occur error", zap.Int64("taskID", lst.ID()))
+		log.Error("LoadSegmentTask: loadSegment occur error", zap.Int64("taskID", lst.ID()), zap.Error(err))
+		if errors.Is(err, errQueryNodeOutOfMemory) {
+			// the segments are rescheduled to the other nodes instead of retrying on this node
+			lst.excludeNodeIDs = append(lst.excludeNodeIDs, lst.NodeID)
+		}
 		status.Reason = err.Error()
 		lst.result = status
 		return err
@@ -837,13 +927,30 @@ func (lst *LoadSegmentTask) PostExecute(context.Context) error {
 }
 
 func (lst *LoadSegmentTask) Reschedule() ([]task, error) {
-	segmentIDs := make([]UniqueID, 0)
+	segmentSizes := make([]int64, 0)
 	collectionID := lst.Infos[0].CollectionID
 	reScheduledTask := make([]task, 0)
+	// the segments moved by load balance stay on the source node if the destination node fails
+	if lst.LoadCondition == querypb.TriggerCondition_loadBalance {
+		return reScheduledTask, nil
+	}
 	for _, info := range lst.Infos {
-		segmentIDs = append(segmentIDs, info.SegmentID)
+		segmentSizes = append(segmentSizes, estimateSegmentSize(lst.Schema, info))
+	}
+	nodeIDs, err := replicaNodeIDs(lst.meta, lst.ReplicaID)
+	if err != nil {
+		return nil, err
+	}
+	if len(lst.excludeNodeIDs) > 0 {
+		nodeIDs, err = excludeNodes(lst.cluster, nodeIDs, lst.excludeNodeIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	segment2Nodes, err := shuffleSegmentsToQueryNode(segmentSizes, lst.cluster, nodeIDs)
+	if err != nil {
+		return nil, fmt.Errorf("reschedule segments rejected by query nodes %v: %w", lst.excludeNodeIDs, err)
 	}
-	segment2Nodes := shuffleSegmentsToQueryNode(segmentIDs, lst.cluster)
 	node2segmentInfos := make(map[int64][]*querypb.SegmentLoadInfo)
 	for index, info := range lst.Infos {
 		nodeID := segment2Nodes[index]
@@ -859,6 +966,7 @@ func (lst *LoadSegmentTask) Reschedule() ([]task, error) {
 				ctx:              lst.ctx,
 				Condition:        NewTaskCondition(lst.ctx),
 				triggerCondition: lst.LoadCondition,
+				parentTask:       lst.parentTask,
 			},
 			LoadSegmentsRequest: &querypb.LoadSegmentsRequest{
 				Base:          lst.Base,
@@ -866,9 +974,11 @@ func (lst *LoadSegmentTask) Reschedule() ([]task, error) {
 				Infos:         infos,
 				Schema:        lst.Schema,
 				LoadCondition: lst.LoadCondition,
+				ReplicaID:     lst.ReplicaID,
 			},
-			meta:    lst.meta,
-			cluster: lst.cluster,
+			meta:           lst.meta,
+			cluster:        lst.cluster,
+			excludeNodeIDs: lst.excludeNodeIDs,
 		}
 		reScheduledTask = append(reScheduledTask, loadSegmentTask)
 		log.Debug("LoadSegmentTask: add a loadSegmentTask to RescheduleTasks", zap.Any("task", loadSegmentTask))
@@ -1052,7 +1162,14 @@ func (wdt *WatchDmChannelTask) Reschedule() ([]task, error) {
 		channelIDs = append(channelIDs, info.ChannelName)
 	}
 
-	channel2Nodes := shuffleChannelsToQueryNode(channelIDs, wdt.cluster)
+	nodeIDs, err := replicaNodeIDs(wdt.meta, wdt.ReplicaID)
+	if err != nil {
+		return nil, err
+	}
+	channel2Nodes, err := shuffleChannelsToQueryNode(channelIDs, wdt.cluster, nodeIDs)
+	if err != nil {
+		return nil, err
+	}
 	node2channelInfos := make(map[int64][]*datapb.VchannelInfo)
 	for index, info := range wdt.Infos {
 		nodeID := channel2Nodes[index]
@@ -1077,6 +1194,7 @@ func (wdt *WatchDmChannelTask) Reschedule() ([]task, error) {
 				Infos:        infos,
 				Schema:       wdt.Schema,
 				ExcludeInfos: wdt.ExcludeInfos,
+				ReplicaID:    wdt.ReplicaID,
 			},
 			meta:    wdt.meta,
 			cluster: wdt.cluster,
@@ -1190,17 +1308,133 @@ func (wqt *WatchQueryChannelTask) PostExecute(context.Context) error {
 }
 
 //****************************handoff task********************************//
+
+// HandoffTask loads the flushed segments on the query nodes watching their dm channels,
+// where the sealed segments replace the growing ones
 type HandoffTask struct {
+	BaseTask
+	*querypb.HandoffSegments
+	dataCoord types.DataCoord
+	cluster   *queryNodeCluster
+	meta      Meta
+}
+
+func (ht *HandoffTask) MsgBase() *commonpb.MsgBase {
+	return ht.Base
+}
+
+func (ht *HandoffTask) Marshal() ([]byte, error) {
+	return proto.Marshal(ht.HandoffSegments)
+}
+
+func (ht *HandoffTask) Type() commonpb.MsgType {
+	return ht.Base.MsgType
+}
+
+func (ht *HandoffTask) Timestamp() Timestamp {
+	return ht.Base.Timestamp
+}
+
+func (ht *HandoffTask) PreExecute(context.Context) error {
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}
+	ht.result = status
+	segmentIDs := make([]UniqueID, 0, len(ht.Infos))
+	for _, info := range ht.Infos {
+		segmentIDs = append(segmentIDs, info.SegmentID)
+	}
+	log.Debug("start do HandoffTask",
+		zap.Int64s("segmentIDs", segmentIDs),
+		zap.Int64("taskID", ht.ID()))
+	return nil
+}
+
+func (ht *HandoffTask) Execute(ctx context.Context) error {
+	for _, info := range ht.Infos {
+		collectionID := info.CollectionID
+		segmentID := info.SegmentID
+		collectionInfo, err := ht.meta.getCollectionInfoByID(collectionID)
+		if err != nil {
+			log.Debug("HandoffTask: collection has been released", zap.Int64("collectionID", collectionID), zap.Int64("segmentID", segmentID))
+			continue
+		}
+		if !ht.meta.hasPartition(collectionID, info.PartitionID) || ht.meta.hasReleasePartition(collectionID, info.PartitionID) {
+			log.Debug("HandoffTask: partition has been released", zap.Int64("partitionID", info.PartitionID), zap.Int64("segmentID", segmentID))
+			continue
+		}
+		if ht.meta.hasSegmentInfo(segmentID) {
+			log.Debug("HandoffTask: segment has been loaded", zap.Int64("segmentID", segmentID))
+			continue
+		}
+		nodeIDs := dmChannelNodeIDs(collectionInfo, info.InsertChannel)
+		if len(nodeIDs) == 0 {
+			log.Warn("HandoffTask: no query node watches the dm channel", zap.Int64("segmentID", segmentID), zap.String("channel", info.InsertChannel))
+			continue
+		}
+
+		// every replica holds the growing segment on the node watching the dm channel
+		for _, nodeID := range nodeIDs {
+			var replicaID UniqueID
+			if replica, err := ht.meta.getReplicaByNodeID(collectionID, nodeID); err == nil {
+				replicaID = replica.ReplicaID
+			}
+			msgBase := proto.Clone(ht.Base).(*commonpb.MsgBase)
+			msgBase.MsgType = commonpb.MsgType_LoadSegments
+			loadSegmentTask := &LoadSegmentTask{
+				BaseTask: BaseTask{
+					ctx:              ctx,
+					Condition:        NewTaskCondition(ctx),
+					triggerCondition: querypb.TriggerCondition_handoff,
+				},
+				LoadSegmentsRequest: &querypb.LoadSegmentsRequest{
+					Base:          msgBase,
+					NodeID:        nodeID,
+					Infos:         []*querypb.SegmentLoadInfo{info},
+					Schema:        collectionInfo.Schema,
+					LoadCondition: querypb.TriggerCondition_handoff,
+					ReplicaID:     replicaID,
+				},
+				meta:    ht.meta,
+				cluster: ht.cluster,
+			}
+			ht.AddChildTask(loadSegmentTask)
+			log.Debug("HandoffTask: add a loadSegmentTask childTask", zap.Int64("segmentID", segmentID), zap.Int64("nodeID", nodeID))
+		}
+	}
+
+	log.Debug("HandoffTask: assign child task done", zap.Int64("taskID", ht.ID()))
+	return nil
+}
+
+func (ht *HandoffTask) PostExecute(context.Context) error {
+	log.Debug("HandoffTask postExecute done", zap.Int64("taskID", ht.ID()))
+	return nil
+}
+
+// dmChannelNodeIDs returns the query nodes watching the dm channel of the collection, one per replica
+func dmChannelNodeIDs(collectionInfo *querypb.CollectionInfo, channel string) []int64 {
+	nodeIDs := make([]int64, 0)
+	for _, channelInfo := range collectionInfo.ChannelInfos {
+		for _, channelID := range channelInfo.ChannelIDs {
+			if channelID == channel {
+				nodeIDs = append(nodeIDs, channelInfo.NodeIDLoaded)
+				break
+			}
+		}
+	}
+	return nodeIDs
 }
 
 //*********************** ***load balance task*** ************************//
 type LoadBalanceTask struct {
 	BaseTask
 	*querypb.LoadBalanceRequest
-	rootCoord types.RootCoord
-	dataCoord types.DataCoord
-	cluster   *queryNodeCluster
-	meta      Meta
+	rootCoord  types.RootCoord
+	dataCoord  types.DataCoord
+	indexCoord types.IndexCoord
+	cluster    *queryNodeCluster
+	meta       Meta
 }
 
 func (lbt *LoadBalanceTask) MsgBase() *commonpb.MsgBase {
@@ -1236,10 +1470,21 @@ func (lbt *LoadBalanceTask) Execute(ctx context.Context) error {
 		ErrorCode: commonpb.ErrorCode_UnexpectedError,
 	}
 
-	if lbt.triggerCondition == querypb.TriggerCondition_nodeDown {
+	// the segments and channels of a stopping node are reloaded the same way as a down node's,
+	// but the node keeps serving them until PostExecute releases it
+	if lbt.triggerCondition == querypb.TriggerCondition_nodeDown || lbt.triggerCondition == querypb.TriggerCondition_nodeStopping {
 		for _, nodeID := range lbt.SourceNodeIDs {
-			lbt.meta.deleteSegmentInfoByNodeID(nodeID)
 			collectionInfos := lbt.cluster.getCollectionInfosByID(lbt.ctx, nodeID)
+			// only the segments on the down node are reloaded, the other replicas keep their copies
+			segmentsOnNode := make(map[UniqueID]bool)
+			for _, info := range collectionInfos {
+				for _, segmentInfo := range lbt.meta.showSegmentInfos(info.CollectionID, nil) {
+					if segmentOnNode(segmentInfo, nodeID) {
+						segmentsOnNode[segmentInfo.SegmentID] = true
+					}
+				}
+			}
+			lbt.meta.deleteSegmentInfoByNodeID(nodeID)
 			for _, info := range collectionInfos {
 				collectionID := info.CollectionID
 				metaInfo, err := lbt.meta.getCollectionInfoByID(collectionID)
@@ -1247,11 +1492,17 @@ func (lbt *LoadBalanceTask) Execute(ctx context.Context) error {
 					log.Error("LoadBalanceTask: getCollectionInfoByID occur error", zap.String("error", err.Error()))
 					continue
 				}
+				replica, err := lbt.recoverReplica(collectionID, nodeID)
+				if err != nil {
+					log.Error("LoadBalanceTask: recover replica occur error", zap.Int64("collectionID", collectionID), zap.Int64("nodeID", nodeID), zap.String("error", err.Error()))
+					continue
+				}
 				loadType := metaInfo.LoadType
 				schema := metaInfo.Schema
 				partitionIDs := info.PartitionIDs
 
 				segmentsToLoad := make([]UniqueID, 0)
+				segmentLoadInfos := make([]*querypb.SegmentLoadInfo, 0)
 				loadSegmentReqs := make([]*querypb.LoadSegmentsRequest, 0)
 				channelsToWatch := make([]string, 0)
 				watchDmChannelReqs := make([]*querypb.WatchDmChannelsRequest, 0)
@@ -1280,11 +1531,17 @@ func (lbt *LoadBalanceTask) Execute(ctx context.Context) error {
 
 					for _, segmentBingLog := range recoveryInfo.Binlogs {
 						segmentID := segmentBingLog.SegmentID
+						if !segmentsOnNode[segmentID] {
+							continue
+						}
 						segmentLoadInfo := &querypb.SegmentLoadInfo{
 							SegmentID:    segmentID,
 							PartitionID:  partitionID,
 							CollectionID: collectionID,
 							BinlogPaths:  segmentBingLog.FieldBinlogs,
+							NumOfRows:    segmentBingLog.NumOfRows,
+							Statslogs:    segmentBingLog.Statslogs,
+							Deltalogs:    segmentBingLog.Deltalogs,
 						}
 
 						msgBase := proto.Clone(lbt.Base).(*commonpb.MsgBase)
@@ -1297,6 +1554,7 @@ func (lbt *LoadBalanceTask) Execute(ctx context.Context) error {
 						}
 
 						segmentsToLoad = append(segmentsToLoad, segmentID)
+						segmentLoadInfos = append(segmentLoadInfos, segmentLoadInfo)
 						loadSegmentReqs = append(loadSegmentReqs, loadSegmentReq)
 					}
 
@@ -1344,16 +1602,25 @@ func (lbt *LoadBalanceTask) Execute(ctx context.Context) error {
 						}
 					}
 				}
-				assignInternalTask(ctx, collectionID, lbt, lbt.meta, lbt.cluster, loadSegmentReqs, watchDmChannelReqs)
+				fillSegmentIndexSizes(ctx, lbt.rootCoord, lbt.indexCoord, segmentLoadInfos)
+				err = assignInternalTask(ctx, collectionID, lbt, lbt.meta, lbt.cluster, loadSegmentReqs, watchDmChannelReqs, replica)
+				if err != nil {
+					log.Error("LoadBalanceTask: assign child task occur error", zap.Int64("collectionID", collectionID), zap.String("error", err.Error()))
+					continue
+				}
 				log.Debug("loadBalanceTask: assign child task done", zap.Int64("collectionID", collectionID), zap.Int64s("partitionIDs", partitionIDs))
 			}
 		}
 	}
 
-	//TODO::
-	//if lbt.triggerCondition == querypb.TriggerCondition_loadBalance {
-	//	return nil
-	//}
+	if lbt.triggerCondition == querypb.TriggerCondition_loadBalance {
+		err := lbt.moveSegments(ctx)
+		if err != nil {
+			status.Reason = err.Error()
+			lbt.result = status
+			return err
+		}
+	}
 
 	log.Debug("LoadBalanceTask Execute done",
 		zap.Int64s("sourceNodeIDs", lbt.SourceNodeIDs),
@@ -1362,11 +1629,316 @@ func (lbt *LoadBalanceTask) Execute(ctx context.Context) error {
 	return nil
 }
 
-func (lbt *LoadBalanceTask) PostExecute(context.Context) error {
-	for _, id := range lbt.SourceNodeIDs {
-		err := lbt.cluster.removeNodeInfo(id)
+// recoverReplica removes the down node from its replica of the collection and returns the replica,
+// a node of the same resource group serving no replica of the collection joins the replica if no node
+// of the replica is left.
+// A nil replica is returned if the collection was loaded without replicas.
+func (lbt *LoadBalanceTask) recoverReplica(collectionID UniqueID, nodeID int64) (*querypb.ReplicaInfo, error) {
+	replica, err := lbt.meta.getReplicaByNodeID(collectionID, nodeID)
+	if err != nil {
+		if len(lbt.meta.getReplicasByCollectionID(collectionID)) == 0 {
+			return nil, nil
+		}
+		return nil, err
+	}
+	err = lbt.meta.removeNodeFromReplica(replica.ReplicaID, nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	onServiceNodes, _ := lbt.cluster.onServiceNodes()
+	for _, id := range replica.NodeIds {
+		if _, ok := onServiceNodes[id]; ok && id != nodeID {
+			return lbt.meta.getReplicaByID(replica.ReplicaID)
+		}
+	}
+	freeNodeIDs := make([]int64, 0)
+	for id, node := range onServiceNodes {
+		if node.getResourceGroup() != replicaResourceGroup(replica) {
+			continue
+		}
+		if _, err := lbt.meta.getReplicaByNodeID(collectionID, id); err != nil && id != nodeID {
+			freeNodeIDs = append(freeNodeIDs, id)
+		}
+	}
+	if len(freeNodeIDs) == 0 {
+		return nil, fmt.Errorf("no query node is left in resource group %s for replica %d", replicaResourceGroup(replica), replica.ReplicaID)
+	}
+	sort.Slice(freeNodeIDs, func(i, j int) bool {
+		return freeNodeIDs[i] < freeNodeIDs[j]
+	})
+	err = lbt.meta.addNodeToReplica(replica.ReplicaID, freeNodeIDs[0])
+	if err != nil {
+		return nil, err
+	}
+	log.Debug("LoadBalanceTask: add a query node to replica", zap.Int64("replicaID", replica.ReplicaID), zap.Int64("nodeID", freeNodeIDs[0]))
+	return lbt.meta.getReplicaByID(replica.ReplicaID)
+}
+
+// moveSegments adds the child task loading the sealed segments of the source node on the destination node,
+// the destination node doesn't search them until PostExecute assigns them to it
+func (lbt *LoadBalanceTask) moveSegments(ctx context.Context) error {
+	if len(lbt.SourceNodeIDs) != 1 || len(lbt.DstNodeIDs) != 1 {
+		return errors.New("LoadBalanceTask: moving segments requires one source node and one destination node")
+	}
+	srcNodeID := lbt.SourceNodeIDs[0]
+	dstNodeID := lbt.DstNodeIDs[0]
+	collectionID := lbt.CollectionID
+	collectionInfo, err := lbt.meta.getCollectionInfoByID(collectionID)
+	if err != nil {
+		return err
+	}
+	replicaID, err := lbt.joinReplica(collectionID, srcNodeID, dstNodeID)
+	if err != nil {
+		return err
+	}
+
+	segmentsToMove := make(map[UniqueID]bool)
+	for _, segmentID := range lbt.SealedSegmentIDs {
+		segmentsToMove[segmentID] = true
+	}
+	segmentLoadInfos := make([]*querypb.SegmentLoadInfo, 0)
+	for _, partitionID := range collectionInfo.PartitionIDs {
+		getRecoveryInfo := &datapb.GetRecoveryInfoRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_LoadBalanceSegments,
+			},
+			CollectionID: collectionID,
+			PartitionID:  partitionID,
+		}
+		recoveryInfo, err := lbt.dataCoord.GetRecoveryInfo(ctx, getRecoveryInfo)
 		if err != nil {
-			log.Error("LoadBalanceTask: remove mode info error", zap.Int64("nodeID", id))
+			return err
+		}
+		for _, segmentBingLog := range recoveryInfo.Binlogs {
+			if !segmentsToMove[segmentBingLog.SegmentID] {
+				continue
+			}
+			segmentLoadInfos = append(segmentLoadInfos, &querypb.SegmentLoadInfo{
+				SegmentID:    segmentBingLog.SegmentID,
+				PartitionID:  partitionID,
+				CollectionID: collectionID,
+				BinlogPaths:  segmentBingLog.FieldBinlogs,
+				NumOfRows:    segmentBingLog.NumOfRows,
+				Statslogs:    segmentBingLog.Statslogs,
+				Deltalogs:    segmentBingLog.Deltalogs,
+			})
+		}
+	}
+	fillSegmentIndexSizes(ctx, lbt.rootCoord, lbt.indexCoord, segmentLoadInfos)
+	// the segments no longer sealed, e.g. compacted, are left out
+	lbt.SealedSegmentIDs = make([]UniqueID, 0, len(segmentLoadInfos))
+	for _, info := range segmentLoadInfos {
+		lbt.SealedSegmentIDs = append(lbt.SealedSegmentIDs, info.SegmentID)
+	}
+	if len(segmentLoadInfos) == 0 {
+		return nil
+	}
+
+	msgBase := proto.Clone(lbt.Base).(*commonpb.MsgBase)
+	msgBase.MsgType = commonpb.MsgType_LoadSegments
+	loadSegmentTask := &LoadSegmentTask{
+		BaseTask: BaseTask{
+			ctx:              ctx,
+			Condition:        NewTaskCondition(ctx),
+			triggerCondition: querypb.TriggerCondition_loadBalance,
+		},
+		LoadSegmentsRequest: &querypb.LoadSegmentsRequest{
+			Base:          msgBase,
+			NodeID:        dstNodeID,
+			Infos:         segmentLoadInfos,
+			Schema:        collectionInfo.Schema,
+			LoadCondition: querypb.TriggerCondition_loadBalance,
+			ReplicaID:     replicaID,
+		},
+		meta:    lbt.meta,
+		cluster: lbt.cluster,
+	}
+	lbt.AddChildTask(loadSegmentTask)
+	log.Debug("LoadBalanceTask: add a loadSegmentTask childTask", zap.Any("task", loadSegmentTask))
+
+	if !lbt.cluster.hasWatchedQueryChannel(ctx, dstNodeID, collectionID) {
+		queryChannel, queryResultChannel := lbt.meta.GetQueryChannel(collectionID)
+		msgBase := proto.Clone(lbt.Base).(*commonpb.MsgBase)
+		msgBase.MsgType = commonpb.MsgType_WatchQueryChannels
+		watchQueryChannelTask := &WatchQueryChannelTask{
+			BaseTask: BaseTask{
+				ctx:              ctx,
+				Condition:        NewTaskCondition(ctx),
+				triggerCondition: querypb.TriggerCondition_loadBalance,
+			},
+			AddQueryChannelRequest: &querypb.AddQueryChannelRequest{
+				Base:             msgBase,
+				NodeID:           dstNodeID,
+				CollectionID:     collectionID,
+				RequestChannelID: queryChannel,
+				ResultChannelID:  queryResultChannel,
+			},
+			cluster: lbt.cluster,
+		}
+		lbt.AddChildTask(watchQueryChannelTask)
+		log.Debug("LoadBalanceTask: add a watchQueryChannelTask childTask", zap.Any("task", watchQueryChannelTask))
+	}
+	return nil
+}
+
+// joinReplica returns the replica of the collection on the source node, which the destination node joins
+// if it serves no replica of the collection and is in the resource group of the replica. 0 is returned if
+// the collection was loaded without replicas.
+func (lbt *LoadBalanceTask) joinReplica(collectionID UniqueID, srcNodeID int64, dstNodeID int64) (UniqueID, error) {
+	if len(lbt.meta.getReplicasByCollectionID(collectionID)) == 0 {
+		return 0, nil
+	}
+	replica, err := lbt.meta.getReplicaByNodeID(collectionID, srcNodeID)
+	if err != nil {
+		return 0, err
+	}
+	dstReplica, err := lbt.meta.getReplicaByNodeID(collectionID, dstNodeID)
+	if err != nil {
+		dstNode, err := lbt.cluster.getNodeByID(dstNodeID)
+		if err != nil {
+			return 0, err
+		}
+		if dstNode.getResourceGroup() != replicaResourceGroup(replica) {
+			return 0, fmt.Errorf("query node %d isn't in resource group %s of replica %d", dstNodeID, replicaResourceGroup(replica), replica.ReplicaID)
+		}
+		err = lbt.meta.addNodeToReplica(replica.ReplicaID, dstNodeID)
+		if err != nil {
+			return 0, err
+		}
+		log.Debug("LoadBalanceTask: add a query node to replica", zap.Int64("replicaID", replica.ReplicaID), zap.Int64("nodeID", dstNodeID))
+		return replica.ReplicaID, nil
+	}
+	if dstReplica.ReplicaID != replica.ReplicaID {
+		return 0, fmt.Errorf("query node %d and %d serve different replicas of collection %d", srcNodeID, dstNodeID, collectionID)
+	}
+	return replica.ReplicaID, nil
+}
+
+// releaseMovedSegments assigns the moved segments to the destination node, and releases them from the
+// source node once the destination node searches them, so the segments are searchable all the time.
+// The copies on the destination node are released instead if it fails to load or to search them.
+func (lbt *LoadBalanceTask) releaseMovedSegments(ctx context.Context) {
+	if len(lbt.SealedSegmentIDs) == 0 {
+		return
+	}
+	srcNodeID := lbt.SourceNodeIDs[0]
+	dstNodeID := lbt.DstNodeIDs[0]
+	getSegmentInfoReq := &querypb.GetSegmentInfoRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_SegmentInfo,
+		},
+		SegmentIDs: lbt.SealedSegmentIDs,
+	}
+	// checkSegmentStates returns nil if all the segments are in the state on the destination node
+	checkSegmentStates := func(state querypb.SegmentState) error {
+		infos, err := lbt.cluster.getSegmentInfoByNode(ctx, dstNodeID, getSegmentInfoReq)
+		if err != nil {
+			return err
+		}
+		states := make(map[UniqueID]querypb.SegmentState)
+		for _, info := range infos {
+			states[info.SegmentID] = info.SegmentState
+		}
+		for _, segmentID := range lbt.SealedSegmentIDs {
+			if states[segmentID] != state {
+				return fmt.Errorf("segment %d isn't %s on query node %d", segmentID, state.String(), dstNodeID)
+			}
+		}
+		return nil
+	}
+	releaseSegments := func(nodeID int64) {
+		req := &querypb.ReleaseSegmentsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_ReleaseSegments,
+			},
+			NodeID:       nodeID,
+			CollectionID: lbt.CollectionID,
+			SegmentIDs:   lbt.SealedSegmentIDs,
+		}
+		err := lbt.cluster.releaseSegments(ctx, nodeID, req)
+		if err != nil {
+			log.Error("LoadBalanceTask: release segments error", zap.Int64("nodeID", nodeID), zap.Int64s("segmentIDs", lbt.SealedSegmentIDs), zap.Error(err))
+		}
+	}
+
+	err := checkSegmentStates(querypb.SegmentState_sealing)
+	if err != nil {
+		log.Error("LoadBalanceTask: destination node failed to load the segments", zap.Int64("nodeID", dstNodeID), zap.Error(err))
+		releaseSegments(dstNodeID)
+		return
+	}
+	for _, segmentID := range lbt.SealedSegmentIDs {
+		err = lbt.meta.replaceSegmentNode(segmentID, srcNodeID, dstNodeID)
+		if err != nil {
+			log.Error("LoadBalanceTask: replace segment node error", zap.Int64("segmentID", segmentID), zap.Error(err))
+		}
+	}
+	// the destination node starts searching the segments once it watches the new segment infos
+	err = retry.Do(ctx, func() error {
+		return checkSegmentStates(querypb.SegmentState_sealed)
+	}, retry.Attempts(10), retry.Sleep(100*time.Millisecond))
+	if err != nil {
+		log.Error("LoadBalanceTask: destination node failed to search the segments", zap.Int64("nodeID", dstNodeID), zap.Error(err))
+		for _, segmentID := range lbt.SealedSegmentIDs {
+			err = lbt.meta.replaceSegmentNode(segmentID, dstNodeID, srcNodeID)
+			if err != nil {
+				log.Error("LoadBalanceTask: replace segment node error", zap.Int64("segmentID", segmentID), zap.Error(err))
+			}
+		}
+		releaseSegments(dstNodeID)
+		return
+	}
+	releaseSegments(srcNodeID)
+}
+
+// releaseStoppingNodes releases the collections on the stopping nodes once their segments and channels
+// are served by the other nodes, and removes the nodes from the cluster
+func (lbt *LoadBalanceTask) releaseStoppingNodes(ctx context.Context) {
+	for _, nodeID := range lbt.SourceNodeIDs {
+		node, err := lbt.cluster.getNodeByID(nodeID)
+		if err != nil {
+			log.Warn("LoadBalanceTask: stopping node not exist", zap.Int64("nodeID", nodeID))
+			continue
+		}
+		for _, info := range node.showCollections() {
+			req := &querypb.ReleaseCollectionRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_ReleaseCollection,
+				},
+				CollectionID: info.CollectionID,
+				NodeID:       nodeID,
+			}
+			err = node.releaseCollection(ctx, req)
+			if err != nil {
+				log.Error("LoadBalanceTask: release collection on stopping node error", zap.Int64("nodeID", nodeID), zap.Int64("collectionID", info.CollectionID), zap.Error(err))
+			}
+		}
+		lbt.cluster.stopNode(nodeID)
+		err = lbt.cluster.removeNodeInfo(nodeID)
+		if err != nil {
+			log.Error("LoadBalanceTask: remove node info error", zap.Int64("nodeID", nodeID), zap.Error(err))
+		}
+		log.Debug("LoadBalanceTask: stopping node released", zap.Int64("nodeID", nodeID))
+	}
+}
+
+func (lbt *LoadBalanceTask) PostExecute(ctx context.Context) error {
+	if lbt.triggerCondition == querypb.TriggerCondition_loadBalance {
+		// called again after the child tasks are done
+		if lbt.State() == taskDone {
+			lbt.releaseMovedSegments(ctx)
+		}
+	} else if lbt.triggerCondition == querypb.TriggerCondition_nodeStopping {
+		if lbt.State() == taskDone {
+			lbt.releaseStoppingNodes(ctx)
+		}
+	} else if lbt.State() != taskDone {
+		for _, id := range lbt.SourceNodeIDs {
+			err := lbt.cluster.removeNodeInfo(id)
+			if err != nil {
+				log.Error("LoadBalanceTask: remove mode info error", zap.Int64("nodeID", id))
+			}
 		}
 	}
 	log.Debug("LoadBalanceTask postExecute done",
@@ -1376,18 +1948,11 @@ func (lbt *LoadBalanceTask) PostExecute(context.Context) error {
 	return nil
 }
 
-func shuffleChannelsToQueryNode(dmChannels []string, cluster *queryNodeCluster) []int64 {
+func shuffleChannelsToQueryNode(dmChannels []string, cluster *queryNodeCluster, nodeIDs []int64) ([]int64, error) {
 	maxNumChannels := 0
-	nodes := make(map[int64]Node)
-	var err error
-	for {
-		nodes, err = cluster.onServiceNodes()
-		if err != nil {
-			log.Debug(err.Error())
-			time.Sleep(1 * time.Second)
-			continue
-		}
-		break
+	nodes, err := getCandidateNodes(cluster, nodeIDs)
+	if err != nil {
+		return nil, err
 	}
 
 	for nodeID := range nodes {
@@ -1398,7 +1963,7 @@ func shuffleChannelsToQueryNode(dmChannels []string, cluster *queryNodeCluster)
 	}
 	res := make([]int64, 0)
 	if len(dmChannels) == 0 {
-		return res
+		return res, nil
 	}
 
 	offset := 0
@@ -1414,7 +1979,7 @@ func shuffleChannelsToQueryNode(dmChannels []string, cluster *queryNodeCluster)
 				res = append(res, nodeID)
 				offset++
 				if offset == len(dmChannels) {
-					return res
+					return res, nil
 				}
 			}
 		} else {
@@ -1422,7 +1987,7 @@ func shuffleChannelsToQueryNode(dmChannels []string, cluster *queryNodeCluster)
 				res = append(res, nodeID)
 				offset++
 				if offset == len(dmChannels) {
-					return res
+					return res, nil
 				}
 			}
 		}
@@ -1432,62 +1997,266 @@ func shuffleChannelsToQueryNode(dmChannels []string, cluster *queryNodeCluster)
 	}
 }
 
-func shuffleSegmentsToQueryNode(segmentIDs []UniqueID, cluster *queryNodeCluster) []int64 {
-	maxNumSegments := 0
-	nodes := make(map[int64]Node)
-	var err error
-	for {
-		nodes, err = cluster.onServiceNodes()
-		if err != nil {
-			log.Debug(err.Error())
-			time.Sleep(1 * time.Second)
-			continue
-		}
-		break
+// shuffleSegmentsToQueryNode assigns the segments to the candidate query nodes by their estimated memory sizes
+func shuffleSegmentsToQueryNode(segmentSizes []int64, cluster *queryNodeCluster, nodeIDs []int64) ([]int64, error) {
+	nodes, err := getCandidateNodes(cluster, nodeIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(segmentSizes) == 0 {
+		return make([]int64, 0), nil
 	}
+
+	nodeLoads := make([]*nodeMemoryLoad, 0, len(nodes))
 	for nodeID := range nodes {
+		memUsage, memCapacity, err := cluster.getMemoryUsage(nodeID)
+		if err != nil {
+			return nil, err
+		}
 		numSegments, _ := cluster.getNumSegments(nodeID)
-		if numSegments > maxNumSegments {
-			maxNumSegments = numSegments
+		nodeLoads = append(nodeLoads, &nodeMemoryLoad{
+			nodeID:      nodeID,
+			memUsage:    memUsage,
+			memCapacity: memCapacity,
+			numSegments: numSegments,
+		})
+	}
+	return placeSegmentsByMemory(segmentSizes, nodeLoads, Params.OverloadedMemoryThresholdPercentage)
+}
+
+// nodeMemoryLoad is the memory usage of a query node considered by the segment placement
+type nodeMemoryLoad struct {
+	nodeID      int64
+	memUsage    uint64
+	memCapacity uint64
+	numSegments int
+}
+
+// maxMemoryCapacity returns the largest memory capacity of the nodes, which is assumed for the nodes
+// not reporting their capacities. 0 means no node reports its capacity.
+func maxMemoryCapacity(nodeLoads []*nodeMemoryLoad) uint64 {
+	maxCapacity := uint64(0)
+	for _, load := range nodeLoads {
+		if load.memCapacity > maxCapacity {
+			maxCapacity = load.memCapacity
 		}
 	}
-	res := make([]int64, 0)
+	return maxCapacity
+}
 
-	if len(segmentIDs) == 0 {
-		return res
+// memCapacityOr returns the memory capacity of the node, defaultCapacity if it is unknown
+func (load *nodeMemoryLoad) memCapacityOr(defaultCapacity uint64) uint64 {
+	if load.memCapacity == 0 {
+		return defaultCapacity
 	}
+	return load.memCapacity
+}
 
-	offset := 0
-	loopAll := false
+// usageRatio returns the memory usage ratio of the node after adding size bytes,
+// the plain memory usage is returned if the capacity is unknown
+func (load *nodeMemoryLoad) usageRatio(size uint64, defaultCapacity uint64) float64 {
+	capacity := load.memCapacityOr(defaultCapacity)
+	if capacity == 0 {
+		return float64(load.memUsage + size)
+	}
+	return float64(load.memUsage+size) / float64(capacity)
+}
+
+// placeSegmentsByMemory places the largest segment first on the node with the lowest memory usage ratio
+// after the placement, the node with fewer segments breaks the tie. A node is skipped if the placement
+// exceeds the memory threshold, an error is returned if no node has enough memory for a segment.
+func placeSegmentsByMemory(segmentSizes []int64, nodeLoads []*nodeMemoryLoad, threshold float64) ([]int64, error) {
+	defaultCapacity := maxMemoryCapacity(nodeLoads)
+	order := make([]int, len(segmentSizes))
+	for index := range order {
+		order[index] = index
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return segmentSizes[order[i]] > segmentSizes[order[j]]
+	})
+
+	res := make([]int64, len(segmentSizes))
+	for _, index := range order {
+		size := uint64(segmentSizes[index])
+		var target *nodeMemoryLoad
+		for _, load := range nodeLoads {
+			ratio := load.usageRatio(size, defaultCapacity)
+			if defaultCapacity > 0 && ratio > threshold {
+				continue
+			}
+			if target == nil {
+				target = load
+				continue
+			}
+			targetRatio := target.usageRatio(size, defaultCapacity)
+			if ratio < targetRatio ||
+				(ratio == targetRatio && load.numSegments < target.numSegments) ||
+				(ratio == targetRatio && load.numSegments == target.numSegments && load.nodeID < target.nodeID) {
+				target = load
+			}
+		}
+		if target == nil {
+			return nil, fmt.Errorf("no query node has enough memory to load a segment of %d bytes", size)
+		}
+		target.memUsage += size
+		target.numSegments++
+		res[index] = target.nodeID
+	}
+	return res, nil
+}
+
+// estimateSegmentSize estimates the memory a query node takes to load the segment by the width of its rows
+// and the size of its index files
+func estimateSegmentSize(schema *schemapb.CollectionSchema, info *querypb.SegmentLoadInfo) int64 {
+	if schema == nil {
+		return info.IndexSize
+	}
+	sizePerRecord, err := typeutil.EstimateSizePerRecord(schema)
+	if err != nil {
+		log.Warn("estimateSegmentSize: estimate size per record failed", zap.Error(err))
+		return info.IndexSize
+	}
+	return int64(sizePerRecord)*info.NumOfRows + info.IndexSize
+}
+
+// fillSegmentIndexSizes sets the index sizes of the segments by their index builds, the size of a segment
+// whose index isn't built or can't be found is left zero
+func fillSegmentIndexSizes(ctx context.Context, rootCoord types.RootCoord, indexCoord types.IndexCoord, infos []*querypb.SegmentLoadInfo) {
+	if rootCoord == nil || indexCoord == nil || len(infos) == 0 {
+		return
+	}
+	buildInfos := make(map[UniqueID]*querypb.SegmentLoadInfo)
+	buildIDs := make([]UniqueID, 0)
+	for _, info := range infos {
+		describeResp, err := rootCoord.DescribeSegment(ctx, &milvuspb.DescribeSegmentRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_DescribeSegment,
+			},
+			CollectionID: info.CollectionID,
+			SegmentID:    info.SegmentID,
+		})
+		if err != nil || describeResp.Status.ErrorCode != commonpb.ErrorCode_Success || !describeResp.EnableIndex {
+			continue
+		}
+		buildInfos[describeResp.BuildID] = info
+		buildIDs = append(buildIDs, describeResp.BuildID)
+	}
+	if len(buildIDs) == 0 {
+		return
+	}
+	pathsResp, err := indexCoord.GetIndexFilePaths(ctx, &indexpb.GetIndexFilePathsRequest{
+		IndexBuildIDs: buildIDs,
+	})
+	if err == nil && pathsResp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		err = errors.New(pathsResp.Status.Reason)
+	}
+	if err != nil {
+		log.Warn("fillSegmentIndexSizes: get index file paths failed", zap.Int64s("buildIDs", buildIDs), zap.Error(err))
+		return
+	}
+	for _, pathInfo := range pathsResp.FilePaths {
+		if info, ok := buildInfos[pathInfo.IndexBuildID]; ok {
+			info.IndexSize = pathInfo.SerializedSize
+		}
+	}
+}
+
+// segmentMove is a sealed segment to move from the source node to the destination node
+type segmentMove struct {
+	segment   *querypb.SegmentInfo
+	srcNodeID int64
+	dstNodeID int64
+}
+
+// planSegmentMoves moves the sealed segments from the node with the highest memory usage ratio to the nodes
+// with lower ratios, as long as it is overloaded or its ratio exceeds the lowest one by more than maxDiff.
+// A segment is only moved if the destination node stays below the source node, canMove tells whether
+// the segment is allowed to move between the nodes. Nothing is moved if no node reports its capacity.
+func planSegmentMoves(nodeLoads []*nodeMemoryLoad,
+	segments map[int64][]*querypb.SegmentInfo,
+	threshold float64,
+	maxDiff float64,
+	canMove func(segment *querypb.SegmentInfo, srcNodeID int64, dstNodeID int64) bool) []*segmentMove {
+
+	moves := make([]*segmentMove, 0)
+	defaultCapacity := maxMemoryCapacity(nodeLoads)
+	if defaultCapacity == 0 || len(nodeLoads) < 2 {
+		return moves
+	}
+	moved := make(map[UniqueID]bool)
 	for {
-		lastOffset := offset
-		if !loopAll {
-			for nodeID := range nodes {
-				numSegments, _ := cluster.getNumSegments(nodeID)
-				if numSegments >= maxNumSegments {
+		sort.Slice(nodeLoads, func(i, j int) bool {
+			return nodeLoads[i].usageRatio(0, defaultCapacity) < nodeLoads[j].usageRatio(0, defaultCapacity)
+		})
+		src := nodeLoads[len(nodeLoads)-1]
+		srcRatio := src.usageRatio(0, defaultCapacity)
+		if srcRatio <= threshold && srcRatio-nodeLoads[0].usageRatio(0, defaultCapacity) <= maxDiff {
+			return moves
+		}
+
+		var move *segmentMove
+		for _, dst := range nodeLoads[:len(nodeLoads)-1] {
+			// the largest segment keeping the destination node below the source node
+			for _, segment := range segments[src.nodeID] {
+				size := uint64(segment.MemSize)
+				if moved[segment.SegmentID] || size > src.memUsage || !canMove(segment, src.nodeID, dst.nodeID) {
 					continue
 				}
-				res = append(res, nodeID)
-				offset++
-				if offset == len(segmentIDs) {
-					return res
+				srcAfter := float64(src.memUsage-size) / float64(src.memCapacityOr(defaultCapacity))
+				dstAfter := dst.usageRatio(size, defaultCapacity)
+				if dstAfter > srcAfter || dstAfter > threshold {
+					continue
 				}
-			}
-		} else {
-			for nodeID := range nodes {
-				res = append(res, nodeID)
-				offset++
-				if offset == len(segmentIDs) {
-					return res
+				if move == nil || size > uint64(move.segment.MemSize) {
+					move = &segmentMove{
+						segment:   segment,
+						srcNodeID: src.nodeID,
+						dstNodeID: dst.nodeID,
+					}
 				}
 			}
+			if move != nil {
+				src.memUsage -= uint64(move.segment.MemSize)
+				dst.memUsage += uint64(move.segment.MemSize)
+				break
+			}
 		}
-		if lastOffset == offset {
-			loopAll = true
+		if move == nil {
+			return moves
 		}
+		moved[move.segment.SegmentID] = true
+		moves = append(moves, move)
 	}
 }
 
+// getCandidateNodes returns the on service query nodes in nodeIDs, all the on service query nodes
+// are candidates if nodeIDs is nil
+func getCandidateNodes(cluster *queryNodeCluster, nodeIDs []int64) (map[int64]Node, error) {
+	if nodeIDs == nil {
+		for {
+			nodes, err := cluster.onServiceNodes()
+			if err != nil {
+				log.Debug(err.Error())
+				time.Sleep(1 * time.Second)
+				continue
+			}
+			return nodes, nil
+		}
+	}
+
+	nodes := make(map[int64]Node)
+	onServiceNodes, _ := cluster.onServiceNodes()
+	for _, nodeID := range nodeIDs {
+		if node, ok := onServiceNodes[nodeID]; ok {
+			nodes[nodeID] = node
+		}
+	}
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("no query node on service in %v", nodeIDs)
+	}
+	return nodes, nil
+}
+
 func mergeVChannelInfo(info1 *datapb.VchannelInfo, info2 *datapb.VchannelInfo) *datapb.VchannelInfo {
 	collectionID := info1.CollectionID
 	channelName := info1.ChannelName
@@ -1525,20 +2294,38 @@ func assignInternalTask(ctx context.Context,
 	meta Meta,
 	cluster *queryNodeCluster,
 	loadSegmentRequests []*querypb.LoadSegmentsRequest,
-	watchDmChannelRequests []*querypb.WatchDmChannelsRequest) {
+	watchDmChannelRequests []*querypb.WatchDmChannelsRequest,
+	replica *querypb.ReplicaInfo) error {
 
 	sp, _ := trace.StartSpanFromContext(ctx)
 	defer sp.Finish()
-	segmentsToLoad := make([]UniqueID, 0)
+	segmentSizes := make([]int64, 0)
 	for _, req := range loadSegmentRequests {
-		segmentsToLoad = append(segmentsToLoad, req.Infos[0].SegmentID)
+		segmentSizes = append(segmentSizes, estimateSegmentSize(req.Schema, req.Infos[0]))
 	}
 	channelsToWatch := make([]string, 0)
 	for _, req := range watchDmChannelRequests {
 		channelsToWatch = append(channelsToWatch, req.Infos[0].ChannelName)
 	}
-	segment2Nodes := shuffleSegmentsToQueryNode(segmentsToLoad, cluster)
-	watchRequest2Nodes := shuffleChannelsToQueryNode(channelsToWatch, cluster)
+	// the requests are only assigned to the nodes of the replica
+	var nodeIDs []int64
+	if replica != nil {
+		nodeIDs = replica.NodeIds
+		for _, req := range loadSegmentRequests {
+			req.ReplicaID = replica.ReplicaID
+		}
+		for _, req := range watchDmChannelRequests {
+			req.ReplicaID = replica.ReplicaID
+		}
+	}
+	segment2Nodes, err := shuffleSegmentsToQueryNode(segmentSizes, cluster, nodeIDs)
+	if err != nil {
+		return err
+	}
+	watchRequest2Nodes, err := shuffleChannelsToQueryNode(channelsToWatch, cluster, nodeIDs)
+	if err != nil {
+		return err
+	}
 	log.Debug("assignInternalTask: segment to node", zap.Any("segments map", segment2Nodes), zap.Int64("collectionID", collectionID))
 	log.Debug("assignInternalTask: watch request to node", zap.Any("request map", watchRequest2Nodes), zap.Int64("collectionID", collectionID))
 
@@ -1572,6 +2359,7 @@ func assignInternalTask(ctx context.Context,
 				ctx:              ctx,
 				Condition:        NewTaskCondition(ctx),
 				triggerCondition: querypb.TriggerCondition_grpcRequest,
+				parentTask:       parentTask,
 			},
 
 			LoadSegmentsRequest: loadSegmentsReq,
@@ -1628,4 +2416,162 @@ func assignInternalTask(ctx context.Context,
 			log.Debug("assignInternalTask: add a watchQueryChannelTask childTask", zap.Any("task", watchQueryChannelTask))
 		}
 	}
+	return nil
+}
+
+// replicaNodeIDs returns the nodes of the replica, nil means all the query nodes if the replica isn't set
+func replicaNodeIDs(meta Meta, replicaID UniqueID) ([]int64, error) {
+	if replicaID == 0 {
+		return nil, nil
+	}
+	replica, err := meta.getReplicaByID(replicaID)
+	if err != nil {
+		return nil, err
+	}
+	return replica.NodeIds, nil
+}
+
+// excludeNodes removes the excluded nodes from the candidate nodes, all the nodes on service if nodeIDs is nil,
+// an error is returned if no node is left
+func excludeNodes(cluster *queryNodeCluster, nodeIDs []int64, excludeNodeIDs []int64) ([]int64, error) {
+	if nodeIDs == nil {
+		nodes, err := cluster.onServiceNodes()
+		if err != nil {
+			return nil, err
+		}
+		for nodeID := range nodes {
+			nodeIDs = append(nodeIDs, nodeID)
+		}
+	}
+	res := make([]int64, 0, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		if !funcutil.SliceContain(excludeNodeIDs, nodeID) {
+			res = append(res, nodeID)
+		}
+	}
+	if len(res) == 0 {
+		return nil, fmt.Errorf("no query node to load the segments besides %v", excludeNodeIDs)
+	}
+	return res, nil
+}
+
+// assignReplicaInternalTask assigns a copy of the requests to every replica of the collection
+func assignReplicaInternalTask(ctx context.Context,
+	collectionID UniqueID,
+	parentTask task,
+	meta Meta,
+	cluster *queryNodeCluster,
+	loadSegmentRequests []*querypb.LoadSegmentsRequest,
+	watchDmChannelRequests []*querypb.WatchDmChannelsRequest,
+	replicas []*querypb.ReplicaInfo) error {
+
+	for _, replica := range replicas {
+		loadSegmentReqs := make([]*querypb.LoadSegmentsRequest, 0, len(loadSegmentRequests))
+		for _, req := range loadSegmentRequests {
+			loadSegmentReqs = append(loadSegmentReqs, proto.Clone(req).(*querypb.LoadSegmentsRequest))
+		}
+		watchDmChannelReqs := make([]*querypb.WatchDmChannelsRequest, 0, len(watchDmChannelRequests))
+		for _, req := range watchDmChannelRequests {
+			watchDmChannelReqs = append(watchDmChannelReqs, proto.Clone(req).(*querypb.WatchDmChannelsRequest))
+		}
+		err := assignInternalTask(ctx, collectionID, parentTask, meta, cluster, loadSegmentReqs, watchDmChannelReqs, replica)
+		if err != nil {
+			return err
+		}
+		log.Debug("assignReplicaInternalTask: assign child task to replica done", zap.Int64("collectionID", collectionID), zap.Int64("replicaID", replica.ReplicaID))
+	}
+	return nil
+}
+
+// prepareReplicas returns the replicas of the collection. If the collection has no replica yet,
+// the on service query nodes of the resource groups are split into replicaNumber replicas, one replica
+// is created by default. All the replicas are placed in one resource group, or each replica in its own one.
+func prepareReplicas(meta Meta, cluster *queryNodeCluster, collectionID UniqueID, replicaNumber int32, resourceGroups []string, idAllocator func() (UniqueID, error)) ([]*querypb.ReplicaInfo, error) {
+	replicas := meta.getReplicasByCollectionID(collectionID)
+	if len(replicas) > 0 {
+		if replicaNumber > 0 && int(replicaNumber) != len(replicas) {
+			return nil, fmt.Errorf("collection %d has been loaded with %d replicas, can't load it with %d replicas", collectionID, len(replicas), replicaNumber)
+		}
+		for _, replica := range replicas {
+			if len(resourceGroups) > 0 && !funcutil.SliceContain(resourceGroups, replicaResourceGroup(replica)) {
+				return nil, fmt.Errorf("collection %d has been loaded into resource group %s", collectionID, replicaResourceGroup(replica))
+			}
+		}
+		return replicas, nil
+	}
+
+	if replicaNumber <= 0 {
+		replicaNumber = 1
+	}
+	if len(resourceGroups) == 0 {
+		resourceGroups = []string{defaultResourceGroup}
+	}
+	if len(resourceGroups) > 1 && len(resourceGroups) != int(replicaNumber) {
+		return nil, fmt.Errorf("can't load %d replicas into %d resource groups, all the replicas are placed in one resource group or each replica in its own one", replicaNumber, len(resourceGroups))
+	}
+	replicaIDs := make([]UniqueID, 0, replicaNumber)
+	for i := int32(0); i < replicaNumber; i++ {
+		replicaID, err := idAllocator()
+		if err != nil {
+			return nil, err
+		}
+		replicaIDs = append(replicaIDs, replicaID)
+	}
+
+	replicas = make([]*querypb.ReplicaInfo, 0, replicaNumber)
+	for index, rg := range resourceGroups {
+		groupReplicaIDs := replicaIDs
+		if len(resourceGroups) > 1 {
+			groupReplicaIDs = replicaIDs[index : index+1]
+		}
+		nodeIDs := cluster.resourceGroupNodes(rg)
+		if len(nodeIDs) < len(groupReplicaIDs) {
+			return nil, fmt.Errorf("only %d query nodes are on service in resource group %s, can't load %d replicas", len(nodeIDs), rg, len(groupReplicaIDs))
+		}
+		for _, replica := range splitNodesToReplicas(collectionID, nodeIDs, groupReplicaIDs) {
+			replica.ResourceGroup = rg
+			replicas = append(replicas, replica)
+		}
+	}
+	for _, replica := range replicas {
+		err := meta.addReplica(replica)
+		if err != nil {
+			return nil, err
+		}
+		log.Debug("prepareReplicas: add a replica", zap.Int64("collectionID", collectionID), zap.Int64("replicaID", replica.ReplicaID),
+			zap.String("resourceGroup", replica.ResourceGroup), zap.Int64s("nodeIDs", replica.NodeIds))
+	}
+	return replicas, nil
+}
+
+// replicaResourceGroup returns the resource group of the replica, the replicas created before
+// resource groups were introduced belong to the default resource group
+func replicaResourceGroup(replica *querypb.ReplicaInfo) string {
+	if replica.ResourceGroup == "" {
+		return defaultResourceGroup
+	}
+	return replica.ResourceGroup
+}
+
+// splitNodesToReplicas assigns the nodes to the replicas in turn, so the replicas are disjoint and of the same size
+func splitNodesToReplicas(collectionID UniqueID, nodeIDs []int64, replicaIDs []UniqueID) []*querypb.ReplicaInfo {
+	sortedNodeIDs := make([]int64, len(nodeIDs))
+	copy(sortedNodeIDs, nodeIDs)
+	sort.Slice(sortedNodeIDs, func(i, j int) bool {
+		return sortedNodeIDs[i] < sortedNodeIDs[j]
+	})
+
+	replicas := make([]*querypb.ReplicaInfo, 0, len(replicaIDs))
+	for _, replicaID := range replicaIDs {
+		replicas = append(replicas, &querypb.ReplicaInfo{
+			ReplicaID:    replicaID,
+			CollectionID: collectionID,
+			NodeIds:      make([]int64, 0),
+		})
+	}
+	for index, nodeID := range sortedNodeIDs {
+		replica := replicas[index%len(replicas)]
+		replica.NodeIds = append(replica.NodeIds, nodeID)
+	}
+	return replicas
 }
@@ -126,15 +126,18 @@ type TaskScheduler struct {
 	taskIDAllocator  func() (UniqueID, error)
 	client           *etcdkv.EtcdKV
 
-	rootCoord types.RootCoord
-	dataCoord types.DataCoord
+	rootCoord  types.RootCoord
+	dataCoord  types.DataCoord
+	indexCoord types.IndexCoord
+
+	loadingProgress *loadingProgress
 
 	wg     sync.WaitGroup
 	ctx    context.Context
 	cancel context.CancelFunc
 }
 
-func NewTaskScheduler(ctx context.Context, meta Meta, cluster *queryNodeCluster, kv *etcdkv.EtcdKV, rootCoord types.RootCoord, dataCoord types.DataCoord) (*TaskScheduler, error) {
+func NewTaskScheduler(ctx context.Context, meta Meta, cluster *queryNodeCluster, kv *etcdkv.EtcdKV, rootCoord types.RootCoord, dataCoord types.DataCoord, indexCoord types.IndexCoord) (*TaskScheduler, error) {
 	ctx1, cancel := context.WithCancel(ctx)
 	taskChan := make(chan task, 1024)
 	s := &TaskScheduler{
@@ -146,6 +149,8 @@ func NewTaskScheduler(ctx context.Context, meta Meta, cluster *queryNodeCluster,
 		client:           kv,
 		rootCoord:        rootCoord,
 		dataCoord:        dataCoord,
+		indexCoord:       indexCoord,
+		loadingProgress:  newLoadingProgress(),
 	}
 	s.triggerTaskQueue = NewTaskQueue()
 	etcdKV, err := tsoutil.NewTSOKVBase(Params.EtcdEndpoints, Params.KvRootPath, "queryCoordTaskID")
@@ -269,8 +274,10 @@ func (scheduler *TaskScheduler) unmarshalTask(t string) (task, error) {
 			LoadCollectionRequest: &loadReq,
 			rootCoord:             scheduler.rootCoord,
 			dataCoord:             scheduler.dataCoord,
+			indexCoord:            scheduler.indexCoord,
 			cluster:               scheduler.cluster,
 			meta:                  scheduler.meta,
+			idAllocator:           scheduler.taskIDAllocator,
 		}
 		newTask = loadCollectionTask
 	case commonpb.MsgType_LoadPartitions:
@@ -286,9 +293,12 @@ func (scheduler *TaskScheduler) unmarshalTask(t string) (task, error) {
 				triggerCondition: querypb.TriggerCondition_grpcRequest,
 			},
 			LoadPartitionsRequest: &loadReq,
+			rootCoord:             scheduler.rootCoord,
 			dataCoord:             scheduler.dataCoord,
+			indexCoord:            scheduler.indexCoord,
 			cluster:               scheduler.cluster,
 			meta:                  scheduler.meta,
+			idAllocator:           scheduler.taskIDAllocator,
 		}
 		newTask = loadPartitionTask
 	case commonpb.MsgType_ReleaseCollection:
@@ -406,10 +416,29 @@ func (scheduler *TaskScheduler) unmarshalTask(t string) (task, error) {
 			LoadBalanceRequest: &loadReq,
 			rootCoord:          scheduler.rootCoord,
 			dataCoord:          scheduler.dataCoord,
+			indexCoord:         scheduler.indexCoord,
 			cluster:            scheduler.cluster,
 			meta:               scheduler.meta,
 		}
 		newTask = loadBalanceTask
+	case commonpb.MsgType_HandoffSegments:
+		handoffReq := querypb.HandoffSegments{}
+		err = proto.Unmarshal([]byte(t), &handoffReq)
+		if err != nil {
+			log.Error(err.Error())
+		}
+		handoffTask := &HandoffTask{
+			BaseTask: BaseTask{
+				ctx:              scheduler.ctx,
+				Condition:        NewTaskCondition(scheduler.ctx),
+				triggerCondition: querypb.TriggerCondition_handoff,
+			},
+			HandoffSegments: &handoffReq,
+			dataCoord:       scheduler.dataCoord,
+			cluster:         scheduler.cluster,
+			meta:            scheduler.meta,
+		}
+		newTask = handoffTask
 	default:
 		err = errors.New("inValid msg type when unMarshal task")
 		log.Error(err.Error())
@@ -551,9 +580,14 @@ func (scheduler *TaskScheduler) scheduleLoop() {
 				}
 			}
 			activeTaskWg.Wait()
-			if t.Type() == commonpb.MsgType_LoadCollection || t.Type() == commonpb.MsgType_LoadPartitions {
+			if t.Type() == commonpb.MsgType_LoadCollection || t.Type() == commonpb.MsgType_LoadPartitions ||
+				t.Type() == commonpb.MsgType_LoadBalanceSegments {
 				t.PostExecute(scheduler.ctx)
 			}
+			if err == nil && (t.Type() == commonpb.MsgType_LoadCollection || t.Type() == commonpb.MsgType_LoadPartitions) &&
+				t.getResultInfo().ErrorCode != commonpb.ErrorCode_Success {
+				scheduler.rollbackLoadTask(t, activeTaskWg)
+			}
 
 			keys := make([]string, 0)
 			taskKey := fmt.Sprintf("%s/%d", triggerTaskPrefix, t.ID())
@@ -572,6 +606,38 @@ func (scheduler *TaskScheduler) scheduleLoop() {
 	}
 }
 
+// rollbackLoadTask releases the segments loaded by the failed load task, PostExecute of the task
+// replaces its child tasks with the release tasks
+func (scheduler *TaskScheduler) rollbackLoadTask(t task, activeTaskWg *sync.WaitGroup) {
+	log.Warn("scheduleLoop: load task failed, release the loaded segments",
+		zap.Int64("taskID", t.ID()),
+		zap.String("reason", t.getResultInfo().Reason))
+	switch loadTask := t.(type) {
+	case *LoadCollectionTask:
+		scheduler.loadingProgress.releaseCollection(loadTask.CollectionID)
+		scheduler.loadingProgress.setFailed(loadTask.CollectionID, t.getResultInfo().Reason)
+	case *LoadPartitionTask:
+		if loadTask.addCol {
+			scheduler.loadingProgress.releaseCollection(loadTask.CollectionID)
+		} else {
+			scheduler.loadingProgress.releasePartitions(loadTask.CollectionID, loadTask.PartitionIDs)
+		}
+		scheduler.loadingProgress.setFailed(loadTask.CollectionID, t.getResultInfo().Reason)
+	}
+	for _, childTask := range t.GetChildTask() {
+		id, err := scheduler.taskIDAllocator()
+		if err != nil {
+			log.Error("scheduleLoop: allocate id of release task error", zap.Error(err))
+			continue
+		}
+		childTask.SetID(id)
+		scheduler.activateTaskChan <- childTask
+		activeTaskWg.Add(1)
+		go scheduler.waitActivateTaskDone(activeTaskWg, childTask)
+	}
+	activeTaskWg.Wait()
+}
+
 func (scheduler *TaskScheduler) waitActivateTaskDone(wg *sync.WaitGroup, t task) {
 	defer wg.Done()
 	err := t.WaitToFinish()
@@ -582,6 +648,16 @@ func (scheduler *TaskScheduler) waitActivateTaskDone(wg *sync.WaitGroup, t task)
 				reScheduledTasks, err := t.Reschedule()
 				if err != nil {
 					log.Error(err.Error())
+					// no node can take over the task, its trigger task fails and rolls back
+					if parentTask := t.GetParentTask(); parentTask != nil {
+						parentTask.setResultInfo(err)
+					}
+					taskKey := fmt.Sprintf("%s/%d", activeTaskPrefix, t.ID())
+					stateKey := fmt.Sprintf("%s/%d", taskInfoPrefix, t.ID())
+					err = scheduler.client.MultiRemove([]string{taskKey, stateKey})
+					if err != nil {
+						log.Error("waitActivateTaskDone: error when remove task from etcd", zap.Int64("taskID", t.ID()))
+					}
 					return
 				}
 				removes := make([]string, 0)
@@ -706,7 +782,20 @@ func (scheduler *TaskScheduler) processActivateTaskLoop() {
 			}
 			log.Debug("processActivateTaskLoop: pop a active task from activateChan", zap.Int64("taskID", t.ID()))
 			go func() {
+				// the progress of the load requests follows their LoadSegmentTasks
+				loadSegmentTask, isLoadRequest := t.(*LoadSegmentTask)
+				isLoadRequest = isLoadRequest && loadSegmentTask.LoadCondition == querypb.TriggerCondition_grpcRequest
+				if isLoadRequest {
+					scheduler.loadingProgress.addSegments(loadSegmentTask.LoadSegmentsRequest)
+				}
 				err := scheduler.processTask(t)
+				if isLoadRequest {
+					if err != nil {
+						scheduler.loadingProgress.removeSegments(loadSegmentTask.LoadSegmentsRequest)
+					} else {
+						scheduler.loadingProgress.setSegmentsLoaded(loadSegmentTask.LoadSegmentsRequest)
+					}
+				}
 				t.Notify(err)
 			}()
 		}
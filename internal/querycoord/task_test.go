@@ -2,12 +2,17 @@ package querycoord
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/types"
 )
 
 func TestTriggerTask(t *testing.T) {
@@ -37,12 +42,50 @@ func TestTriggerTask(t *testing.T) {
 			dataCoord:             queryCoord.dataCoordClient,
 			cluster:               queryCoord.cluster,
 			meta:                  queryCoord.meta,
+			idAllocator:           queryCoord.scheduler.taskIDAllocator,
 		}
 
 		err = queryCoord.scheduler.processTask(loadCollectionTask)
 		assert.Nil(t, err)
 	})
 
+	t.Run("Test Handoff", func(t *testing.T) {
+		collectionInfo, err := queryCoord.meta.getCollectionInfoByID(defaultCollectionID)
+		assert.Nil(t, err)
+		assert.NotEqual(t, 0, len(collectionInfo.ChannelInfos))
+		segmentID := defaultSegmentID + 1000
+		req := &querypb.HandoffSegments{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_HandoffSegments,
+			},
+			Infos: []*querypb.SegmentLoadInfo{
+				{
+					SegmentID:     segmentID,
+					PartitionID:   defaultPartitionID,
+					CollectionID:  defaultCollectionID,
+					InsertChannel: collectionInfo.ChannelInfos[0].ChannelIDs[0],
+				},
+			},
+		}
+		handoffTask := &HandoffTask{
+			BaseTask: BaseTask{
+				ctx:              ctx,
+				Condition:        NewTaskCondition(ctx),
+				triggerCondition: querypb.TriggerCondition_handoff,
+			},
+			HandoffSegments: req,
+			dataCoord:       queryCoord.dataCoordClient,
+			cluster:         queryCoord.cluster,
+			meta:            queryCoord.meta,
+		}
+
+		err = queryCoord.scheduler.processTask(handoffTask)
+		assert.Nil(t, err)
+		segmentInfo, err := queryCoord.meta.getSegmentInfoByID(segmentID)
+		assert.Nil(t, err)
+		assert.Equal(t, collectionInfo.ChannelInfos[0].NodeIDLoaded, segmentInfo.NodeID)
+	})
+
 	t.Run("Test ReleaseCollection", func(t *testing.T) {
 		req := &querypb.ReleaseCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -84,6 +127,7 @@ func TestTriggerTask(t *testing.T) {
 			dataCoord:             queryCoord.dataCoordClient,
 			cluster:               queryCoord.cluster,
 			meta:                  queryCoord.meta,
+			idAllocator:           queryCoord.scheduler.taskIDAllocator,
 		}
 
 		err = queryCoord.scheduler.processTask(loadCollectionTask)
@@ -122,3 +166,307 @@ func TestTriggerTask(t *testing.T) {
 	//assert.Equal(t, allNodeOffline, true)
 	queryCoord.Stop()
 }
+
+func TestSplitNodesToReplicas(t *testing.T) {
+	replicas := splitNodesToReplicas(defaultCollectionID, []int64{5, 1, 4, 2, 3}, []UniqueID{10, 11})
+	assert.Equal(t, 2, len(replicas))
+	assert.Equal(t, UniqueID(10), replicas[0].ReplicaID)
+	assert.Equal(t, defaultCollectionID, replicas[0].CollectionID)
+	assert.Equal(t, []int64{1, 3, 5}, replicas[0].NodeIds)
+	assert.Equal(t, UniqueID(11), replicas[1].ReplicaID)
+	assert.Equal(t, []int64{2, 4}, replicas[1].NodeIds)
+
+	replicas = splitNodesToReplicas(defaultCollectionID, []int64{1, 2}, []UniqueID{10})
+	assert.Equal(t, 1, len(replicas))
+	assert.Equal(t, []int64{1, 2}, replicas[0].NodeIds)
+}
+
+func TestPrepareReplicas_resourceGroup(t *testing.T) {
+	refreshParams()
+	kv, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
+	assert.Nil(t, err)
+	meta, err := newMeta(kv)
+	assert.Nil(t, err)
+	cluster := &queryNodeCluster{
+		nodes: map[int64]Node{
+			1: &queryNode{id: 1, onService: true},
+			2: &queryNode{id: 2, onService: true, resourceGroup: "rg1"},
+			3: &queryNode{id: 3, onService: true, resourceGroup: "rg2"},
+		},
+	}
+	replicaID := UniqueID(100)
+	idAllocator := func() (UniqueID, error) {
+		replicaID++
+		return replicaID, nil
+	}
+
+	// the group count must match the replica number
+	_, err = prepareReplicas(meta, cluster, defaultCollectionID, 3, []string{"rg1", "rg2"}, idAllocator)
+	assert.NotNil(t, err)
+	// rg1 has only one node
+	_, err = prepareReplicas(meta, cluster, defaultCollectionID, 2, []string{"rg1"}, idAllocator)
+	assert.NotNil(t, err)
+
+	replicas, err := prepareReplicas(meta, cluster, defaultCollectionID, 2, []string{"rg1", "rg2"}, idAllocator)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(replicas))
+	assert.Equal(t, "rg1", replicas[0].ResourceGroup)
+	assert.Equal(t, []int64{2}, replicas[0].NodeIds)
+	assert.Equal(t, "rg2", replicas[1].ResourceGroup)
+	assert.Equal(t, []int64{3}, replicas[1].NodeIds)
+
+	// the loaded replicas are reused only if they are in the requested groups
+	_, err = prepareReplicas(meta, cluster, defaultCollectionID, 2, []string{"rg1", "rg2"}, idAllocator)
+	assert.Nil(t, err)
+	_, err = prepareReplicas(meta, cluster, defaultCollectionID, 2, []string{defaultResourceGroup}, idAllocator)
+	assert.NotNil(t, err)
+
+	replicas, err = prepareReplicas(meta, cluster, defaultCollectionID+1, 1, nil, idAllocator)
+	assert.Nil(t, err)
+	assert.Equal(t, defaultResourceGroup, replicas[0].ResourceGroup)
+	assert.Equal(t, []int64{1}, replicas[0].NodeIds)
+
+	for _, collectionID := range []UniqueID{defaultCollectionID, defaultCollectionID + 1} {
+		for _, replica := range meta.getReplicasByCollectionID(collectionID) {
+			err = removeReplicaInfo(replica.ReplicaID, kv)
+			assert.Nil(t, err)
+		}
+	}
+}
+
+func TestDmChannelNodeIDs(t *testing.T) {
+	collectionInfo := &querypb.CollectionInfo{
+		CollectionID: defaultCollectionID,
+		ChannelInfos: []*querypb.DmChannelInfo{
+			{NodeIDLoaded: 1, ChannelIDs: []string{"dml_0", "dml_1"}},
+			{NodeIDLoaded: 2, ChannelIDs: []string{"dml_2"}},
+			{NodeIDLoaded: 3, ChannelIDs: []string{"dml_0"}},
+		},
+	}
+	assert.Equal(t, []int64{1, 3}, dmChannelNodeIDs(collectionInfo, "dml_0"))
+	assert.Equal(t, []int64{2}, dmChannelNodeIDs(collectionInfo, "dml_2"))
+	assert.Equal(t, 0, len(dmChannelNodeIDs(collectionInfo, "dml_3")))
+}
+
+func TestEstimateSegmentSize(t *testing.T) {
+	schema := genCollectionSchema(defaultCollectionID, false)
+	// row_id, Ts and field_age take 8 bytes each, the float vector of dim 16 takes 64 bytes
+	assert.Equal(t, int64(88*100), estimateSegmentSize(schema, &querypb.SegmentLoadInfo{NumOfRows: 100}))
+	assert.Equal(t, int64(88*100+500), estimateSegmentSize(schema, &querypb.SegmentLoadInfo{NumOfRows: 100, IndexSize: 500}))
+	assert.Equal(t, int64(500), estimateSegmentSize(nil, &querypb.SegmentLoadInfo{NumOfRows: 100, IndexSize: 500}))
+}
+
+// indexSizeRootCoordMock describes the index builds of the segments
+type indexSizeRootCoordMock struct {
+	types.RootCoord
+	buildIDs map[UniqueID]UniqueID
+}
+
+func (rc *indexSizeRootCoordMock) DescribeSegment(ctx context.Context, req *milvuspb.DescribeSegmentRequest) (*milvuspb.DescribeSegmentResponse, error) {
+	buildID, ok := rc.buildIDs[req.SegmentID]
+	if !ok {
+		return &milvuspb.DescribeSegmentResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "segment not indexed"},
+		}, nil
+	}
+	return &milvuspb.DescribeSegmentResponse{
+		Status:      &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		BuildID:     buildID,
+		EnableIndex: true,
+	}, nil
+}
+
+// indexSizeIndexCoordMock reports the serialized sizes of the index builds
+type indexSizeIndexCoordMock struct {
+	types.IndexCoord
+	sizes map[UniqueID]int64
+	err   error
+}
+
+func (c *indexSizeIndexCoordMock) GetIndexFilePaths(ctx context.Context, req *indexpb.GetIndexFilePathsRequest) (*indexpb.GetIndexFilePathsResponse, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	filePaths := make([]*indexpb.IndexFilePathInfo, 0, len(req.IndexBuildIDs))
+	for _, buildID := range req.IndexBuildIDs {
+		filePaths = append(filePaths, &indexpb.IndexFilePathInfo{IndexBuildID: buildID, SerializedSize: c.sizes[buildID]})
+	}
+	return &indexpb.GetIndexFilePathsResponse{
+		Status:    &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		FilePaths: filePaths,
+	}, nil
+}
+
+func TestFillSegmentIndexSizes(t *testing.T) {
+	ctx := context.Background()
+	schema := genCollectionSchema(defaultCollectionID, false)
+	rootCoord := &indexSizeRootCoordMock{buildIDs: map[UniqueID]UniqueID{1: 100}}
+	indexCoord := &indexSizeIndexCoordMock{sizes: map[UniqueID]int64{100: 5000}}
+	genInfos := func() []*querypb.SegmentLoadInfo {
+		return []*querypb.SegmentLoadInfo{
+			{SegmentID: 1, CollectionID: defaultCollectionID, NumOfRows: 10},
+			{SegmentID: 2, CollectionID: defaultCollectionID, NumOfRows: 20},
+		}
+	}
+	genNodeLoads := func() []*nodeMemoryLoad {
+		return []*nodeMemoryLoad{
+			{nodeID: 1, memUsage: 0, memCapacity: 100000},
+			{nodeID: 2, memUsage: 2000, memCapacity: 100000},
+		}
+	}
+
+	t.Run("Test indexed segment changes placement", func(t *testing.T) {
+		infos := genInfos()
+		// without the index, the larger raw segment goes first and both fit on node 1
+		res, err := placeSegmentsByMemory([]int64{estimateSegmentSize(schema, infos[0]), estimateSegmentSize(schema, infos[1])}, genNodeLoads(), 0.9)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{1, 1}, res)
+
+		fillSegmentIndexSizes(ctx, rootCoord, indexCoord, infos)
+		assert.Equal(t, int64(5000), infos[0].IndexSize)
+		assert.Equal(t, int64(0), infos[1].IndexSize)
+
+		// the indexed segment goes first to node 1, which leaves the other one to node 2
+		res, err = placeSegmentsByMemory([]int64{estimateSegmentSize(schema, infos[0]), estimateSegmentSize(schema, infos[1])}, genNodeLoads(), 0.9)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{1, 2}, res)
+	})
+
+	t.Run("Test get index file paths failed", func(t *testing.T) {
+		infos := genInfos()
+		indexCoord.err = errors.New("mock")
+		fillSegmentIndexSizes(ctx, rootCoord, indexCoord, infos)
+		assert.Equal(t, int64(0), infos[0].IndexSize)
+		indexCoord.err = nil
+
+		fillSegmentIndexSizes(ctx, nil, indexCoord, infos)
+		assert.Equal(t, int64(0), infos[0].IndexSize)
+	})
+}
+
+func TestPlaceSegmentsByMemory(t *testing.T) {
+	t.Run("Test place by usage ratio", func(t *testing.T) {
+		nodeLoads := []*nodeMemoryLoad{
+			{nodeID: 1, memUsage: 600, memCapacity: 1000},
+			{nodeID: 2, memUsage: 0, memCapacity: 1000},
+			{nodeID: 3, memUsage: 100, memCapacity: 2000},
+		}
+		// the largest segment goes first to node 3, then node 2 takes the others
+		res, err := placeSegmentsByMemory([]int64{100, 400, 100}, nodeLoads, 0.9)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{2, 3, 2}, res)
+	})
+
+	t.Run("Test place by number of segments", func(t *testing.T) {
+		nodeLoads := []*nodeMemoryLoad{
+			{nodeID: 1, numSegments: 2},
+			{nodeID: 2, numSegments: 0},
+		}
+		res, err := placeSegmentsByMemory([]int64{0, 0, 0}, nodeLoads, 0.9)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{2, 2, 1}, res)
+	})
+
+	t.Run("Test node over threshold", func(t *testing.T) {
+		nodeLoads := []*nodeMemoryLoad{
+			{nodeID: 1, memUsage: 800, memCapacity: 1000},
+			{nodeID: 2, memUsage: 0},
+		}
+		// node 2 is assumed as large as node 1
+		res, err := placeSegmentsByMemory([]int64{200}, nodeLoads, 0.9)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{2}, res)
+
+		_, err = placeSegmentsByMemory([]int64{950}, nodeLoads, 0.9)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestPlanSegmentMoves(t *testing.T) {
+	genSegment := func(segmentID UniqueID, memSize int64) *querypb.SegmentInfo {
+		return &querypb.SegmentInfo{
+			SegmentID:    segmentID,
+			CollectionID: defaultCollectionID,
+			MemSize:      memSize,
+		}
+	}
+	canMove := func(segment *querypb.SegmentInfo, srcNodeID int64, dstNodeID int64) bool {
+		return dstNodeID != 3
+	}
+
+	nodeLoads := []*nodeMemoryLoad{
+		{nodeID: 1, memUsage: 950, memCapacity: 1000},
+		{nodeID: 2, memUsage: 100, memCapacity: 1000},
+		{nodeID: 3, memUsage: 0, memCapacity: 1000},
+	}
+	segments := map[int64][]*querypb.SegmentInfo{
+		1: {genSegment(1, 500), genSegment(2, 300), genSegment(3, 100)},
+		2: {genSegment(4, 100)},
+	}
+	moves := planSegmentMoves(nodeLoads, segments, 0.9, 0.3, canMove)
+	// node 3 takes no segment, and segment 1 would leave node 2 above node 1
+	assert.Equal(t, 2, len(moves))
+	assert.Equal(t, UniqueID(2), moves[0].segment.SegmentID)
+	assert.Equal(t, int64(1), moves[0].srcNodeID)
+	assert.Equal(t, int64(2), moves[0].dstNodeID)
+	assert.Equal(t, UniqueID(3), moves[1].segment.SegmentID)
+
+	t.Run("Test unknown capacity", func(t *testing.T) {
+		nodeLoads := []*nodeMemoryLoad{
+			{nodeID: 1, memUsage: 950},
+			{nodeID: 2, memUsage: 0},
+		}
+		moves := planSegmentMoves(nodeLoads, segments, 0.9, 0.3, canMove)
+		assert.Equal(t, 0, len(moves))
+	})
+}
+
+func TestLoadBalance_resourceGroup(t *testing.T) {
+	refreshParams()
+	kv, err := etcdkv.NewEtcdKV(Params.EtcdEndpoints, Params.MetaRootPath)
+	assert.Nil(t, err)
+	meta, err := newMeta(kv)
+	assert.Nil(t, err)
+	err = meta.addCollection(defaultCollectionID, nil)
+	assert.Nil(t, err)
+	defer meta.releaseCollection(defaultCollectionID)
+
+	cluster := &queryNodeCluster{
+		nodes: map[int64]Node{
+			1: &queryNode{id: 1, onService: true, resourceGroup: "rg1"},
+			2: &queryNode{id: 2, onService: true, resourceGroup: "rg1"},
+			3: &queryNode{id: 3, onService: true, resourceGroup: "rg2"},
+			4: &queryNode{id: 4, onService: true, resourceGroup: "rg2"},
+		},
+	}
+	err = meta.addReplica(&querypb.ReplicaInfo{ReplicaID: 10, CollectionID: defaultCollectionID, NodeIds: []int64{1}, ResourceGroup: "rg1"})
+	assert.Nil(t, err)
+	err = meta.addReplica(&querypb.ReplicaInfo{ReplicaID: 11, CollectionID: defaultCollectionID, NodeIds: []int64{3}, ResourceGroup: "rg2"})
+	assert.Nil(t, err)
+
+	segment := &querypb.SegmentInfo{
+		SegmentID:    defaultSegmentID,
+		CollectionID: defaultCollectionID,
+		NodeID:       1,
+		NodeIds:      []int64{1},
+	}
+	qc := &QueryCoord{meta: meta, cluster: cluster}
+	// the segment stays in the resource group of its replica
+	assert.True(t, qc.canMoveSegment(segment, 1, 2))
+	assert.False(t, qc.canMoveSegment(segment, 1, 3))
+	assert.False(t, qc.canMoveSegment(segment, 1, 4))
+	assert.False(t, qc.canMoveSegment(segment, 1, 5))
+
+	lbt := &LoadBalanceTask{meta: meta, cluster: cluster}
+	_, err = lbt.joinReplica(defaultCollectionID, 1, 4)
+	assert.NotNil(t, err)
+	_, err = meta.getReplicaByNodeID(defaultCollectionID, 4)
+	assert.NotNil(t, err)
+
+	replicaID, err := lbt.joinReplica(defaultCollectionID, 1, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, UniqueID(10), replicaID)
+	replica, err := meta.getReplicaByID(10)
+	assert.Nil(t, err)
+	assert.Equal(t, []int64{1, 2}, replica.NodeIds)
+}
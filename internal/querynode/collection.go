@@ -26,7 +26,9 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strconv"
 	"sync"
+	"sync/atomic"
 	"unsafe"
 
 	"go.uber.org/zap"
@@ -34,6 +36,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/rootcoord"
 )
 
 type Collection struct {
@@ -49,6 +52,13 @@ type Collection struct {
 	releaseMu          sync.RWMutex // guards release
 	releasedPartitions map[UniqueID]struct{}
 	releaseTime        Timestamp
+
+	// replicaID is the replica of the collection served by this node, 0 if the collection has no replica
+	replicaID UniqueID
+
+	loadFieldsMu sync.RWMutex // guards loadFieldIDs
+	// the user fields loaded of the sealed segments, all the fields if empty
+	loadFieldIDs []int64
 }
 
 func (c *Collection) ID() UniqueID {
@@ -112,6 +122,53 @@ func (c *Collection) getReleaseTime() Timestamp {
 	return c.releaseTime
 }
 
+func (c *Collection) setReplicaID(replicaID UniqueID) {
+	atomic.StoreInt64(&c.replicaID, replicaID)
+}
+
+func (c *Collection) getReplicaID() UniqueID {
+	return atomic.LoadInt64(&c.replicaID)
+}
+
+func (c *Collection) setLoadFieldIDs(fieldIDs []int64) {
+	c.loadFieldsMu.Lock()
+	defer c.loadFieldsMu.Unlock()
+	c.loadFieldIDs = fieldIDs
+}
+
+// isFieldLoaded returns true for the system fields, which are always loaded
+func (c *Collection) isFieldLoaded(fieldID int64) bool {
+	c.loadFieldsMu.RLock()
+	defer c.loadFieldsMu.RUnlock()
+	if len(c.loadFieldIDs) == 0 || fieldID < rootcoord.StartOfUserFieldID {
+		return true
+	}
+	for _, id := range c.loadFieldIDs {
+		if id == fieldID {
+			return true
+		}
+	}
+	return false
+}
+
+// checkFieldsLoaded rejects the requests referencing the fields not loaded
+func (c *Collection) checkFieldsLoaded(fieldIDs []int64) error {
+	for _, fieldID := range fieldIDs {
+		if c.isFieldLoaded(fieldID) {
+			continue
+		}
+		fieldName := strconv.FormatInt(fieldID, 10)
+		for _, field := range c.schema.Fields {
+			if field.FieldID == fieldID {
+				fieldName = field.Name
+				break
+			}
+		}
+		return fmt.Errorf("field %s of collection %d is not loaded", fieldName, c.id)
+	}
+	return nil
+}
+
 func (c *Collection) addReleasedPartition(partitionID UniqueID) {
 	c.releaseMu.Lock()
 	defer c.releaseMu.Unlock()
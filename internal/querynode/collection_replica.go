@@ -510,6 +510,11 @@ func (colReplica *collectionReplica) replaceGrowingSegmentBySealedSegment(segmen
 			// target segment has been a sealed segment
 			return nil
 		}
+		err = targetSegment.handOff(segment)
+		if err != nil {
+			deleteSegment(segment)
+			return err
+		}
 		deleteSegment(targetSegment)
 	}
 
@@ -33,3 +33,21 @@ func TestCollection_deleteCollection(t *testing.T) {
 	assert.Equal(t, collection.ID(), collectionID)
 	deleteCollection(collection)
 }
+
+func TestCollection_checkFieldsLoaded(t *testing.T) {
+	collectionID := UniqueID(0)
+	collectionMeta := genTestCollectionMeta(collectionID, false)
+
+	collection := newCollection(collectionMeta.ID, collectionMeta.Schema)
+	defer deleteCollection(collection)
+	assert.NoError(t, collection.checkFieldsLoaded([]int64{100, 101}))
+
+	collection.setLoadFieldIDs([]int64{100})
+	assert.True(t, collection.isFieldLoaded(0))
+	assert.True(t, collection.isFieldLoaded(100))
+	assert.False(t, collection.isFieldLoaded(101))
+	assert.NoError(t, collection.checkFieldsLoaded([]int64{0, 100}))
+	err := collection.checkFieldsLoaded([]int64{100, 101})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "age")
+}
@@ -63,6 +63,7 @@ func (fdmNode *filterDmNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 
 	var iMsg = insertMsg{
 		insertMessages: make([]*msgstream.InsertMsg, 0),
+		deleteMessages: make([]*msgstream.DeleteMsg, 0),
 		timeRange: TimeRange{
 			timestampMin: msgStreamMsg.TimestampMin(),
 			timestampMax: msgStreamMsg.TimestampMax(),
@@ -75,6 +76,11 @@ func (fdmNode *filterDmNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 			if resMsg != nil {
 				iMsg.insertMessages = append(iMsg.insertMessages, resMsg)
 			}
+		case commonpb.MsgType_Delete:
+			resMsg := fdmNode.filterInvalidDeleteMessage(msg.(*msgstream.DeleteMsg))
+			if resMsg != nil {
+				iMsg.deleteMessages = append(iMsg.deleteMessages, resMsg)
+			}
 		default:
 			log.Warn("Non supporting", zap.Int32("message type", int32(msg.Type())))
 		}
@@ -170,6 +176,38 @@ func (fdmNode *filterDmNode) filterInvalidInsertMessage(msg *msgstream.InsertMsg
 	return msg
 }
 
+func (fdmNode *filterDmNode) filterInvalidDeleteMessage(msg *msgstream.DeleteMsg) *msgstream.DeleteMsg {
+	sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
+	msg.SetTraceCtx(ctx)
+	defer sp.Finish()
+
+	// delete messages carry no partition, they apply to the whole target collection
+	if msg.CollectionID != fdmNode.collectionID {
+		return nil
+	}
+
+	if !fdmNode.replica.hasCollection(msg.CollectionID) {
+		log.Debug("filter invalid delete message, collection dose not exist",
+			zap.Any("collectionID", msg.CollectionID))
+		return nil
+	}
+
+	if len(msg.PrimaryKeys) != len(msg.Timestamps) {
+		log.Warn("Error, misaligned delete messages detected",
+			zap.Int("numPKs", len(msg.PrimaryKeys)),
+			zap.Int("numTimestamps", len(msg.Timestamps)))
+		return nil
+	}
+
+	if len(msg.Timestamps) <= 0 {
+		log.Debug("filter invalid delete message, no message",
+			zap.Any("collectionID", msg.CollectionID))
+		return nil
+	}
+
+	return msg
+}
+
 func newFilteredDmNode(replica ReplicaInterface,
 	loadType loadType,
 	collectionID UniqueID,
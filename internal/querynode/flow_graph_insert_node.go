@@ -19,6 +19,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 	"github.com/milvus-io/milvus/internal/util/trace"
@@ -26,7 +27,14 @@ import (
 
 type insertNode struct {
 	baseNode
-	replica ReplicaInterface
+	replica           ReplicaInterface
+	historicalReplica ReplicaInterface
+}
+
+type deleteData struct {
+	deleteIDs        map[UniqueID][]UniqueID
+	deleteTimestamps map[UniqueID][]Timestamp
+	deleteSegments   map[UniqueID]*Segment
 }
 
 type InsertData struct {
@@ -72,6 +80,11 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		spans = append(spans, sp)
 		msg.SetTraceCtx(ctx)
 	}
+	for _, msg := range iMsg.deleteMessages {
+		sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
+		spans = append(spans, sp)
+		msg.SetTraceCtx(ctx)
+	}
 
 	// 1. hash insertMessages to insertData
 	for _, task := range iMsg.insertMessages {
@@ -124,6 +137,10 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 	}
 	wg.Wait()
 
+	// 4. do delete, deletes are applied after the inserts of the same pack,
+	// so that an upsert never exposes a window where the key is missing
+	iNode.delete(iMsg.deleteMessages)
+
 	var res Msg = &serviceTimeMsg{
 		gcRecord:  iMsg.gcRecord,
 		timeRange: iMsg.timeRange,
@@ -172,7 +189,75 @@ func (iNode *insertNode) insert(insertData *InsertData, segmentID int64, wg *syn
 	wg.Done()
 }
 
-func newInsertNode(replica ReplicaInterface) *insertNode {
+func (iNode *insertNode) delete(deleteMessages []*msgstream.DeleteMsg) {
+	if len(deleteMessages) == 0 {
+		return
+	}
+
+	delData := deleteData{
+		deleteIDs:        make(map[UniqueID][]UniqueID),
+		deleteTimestamps: make(map[UniqueID][]Timestamp),
+		deleteSegments:   make(map[UniqueID]*Segment),
+	}
+	for _, msg := range deleteMessages {
+		// growing segments of the channel, and all the sealed segments of the collection,
+		// sealed segments don't record the channel they come from
+		segments := getSegmentsByCollection(iNode.replica, msg.CollectionID, msg.ChannelID)
+		if iNode.historicalReplica != nil {
+			segments = append(segments, getSegmentsByCollection(iNode.historicalReplica, msg.CollectionID, "")...)
+		}
+		for _, segment := range segments {
+			delData.deleteSegments[segment.segmentID] = segment
+			delData.deleteIDs[segment.segmentID] = append(delData.deleteIDs[segment.segmentID], msg.PrimaryKeys...)
+			delData.deleteTimestamps[segment.segmentID] = append(delData.deleteTimestamps[segment.segmentID], msg.Timestamps...)
+		}
+	}
+
+	for segmentID, segment := range delData.deleteSegments {
+		ids := delData.deleteIDs[segmentID]
+		timestamps := delData.deleteTimestamps[segmentID]
+		err := segment.applyDelete(ids, timestamps)
+		if err != nil {
+			log.Warn("QueryNode: targetSegmentDelete failed", zap.Int64("segmentID", segmentID), zap.Error(err))
+			// TODO: add error handling
+			continue
+		}
+		log.Debug("Do delete done", zap.Int("len", len(ids)), zap.Int64("segmentID", segmentID))
+	}
+}
+
+// getSegmentsByCollection returns the segments of the collection in replica,
+// only the segments of vChannel are returned if vChannel is not empty
+func getSegmentsByCollection(replica ReplicaInterface, collectionID UniqueID, vChannel Channel) []*Segment {
+	partitionIDs, err := replica.getPartitionIDs(collectionID)
+	if err != nil {
+		return nil
+	}
+	segments := make([]*Segment, 0)
+	for _, partitionID := range partitionIDs {
+		var segmentIDs []UniqueID
+		if vChannel != "" {
+			segmentIDs, err = replica.getSegmentIDsByVChannel(partitionID, vChannel)
+		} else {
+			segmentIDs, err = replica.getSegmentIDs(partitionID)
+		}
+		if err != nil {
+			log.Warn(err.Error())
+			continue
+		}
+		for _, segmentID := range segmentIDs {
+			segment, err := replica.getSegmentByID(segmentID)
+			if err != nil {
+				log.Warn(err.Error())
+				continue
+			}
+			segments = append(segments, segment)
+		}
+	}
+	return segments
+}
+
+func newInsertNode(replica ReplicaInterface, historicalReplica ReplicaInterface) *insertNode {
 	maxQueueLength := Params.FlowGraphMaxQueueLength
 	maxParallelism := Params.FlowGraphMaxParallelism
 
@@ -181,7 +266,8 @@ func newInsertNode(replica ReplicaInterface) *insertNode {
 	baseNode.SetMaxParallelism(maxParallelism)
 
 	return &insertNode{
-		baseNode: baseNode,
-		replica:  replica,
+		baseNode:          baseNode,
+		replica:           replica,
+		historicalReplica: historicalReplica,
 	}
 }
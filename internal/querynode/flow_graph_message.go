@@ -38,6 +38,7 @@ type metaOperateRecord struct {
 
 type insertMsg struct {
 	insertMessages []*msgstream.InsertMsg
+	deleteMessages []*msgstream.DeleteMsg
 	gcRecord       *gcRecord
 	timeRange      TimeRange
 }
@@ -26,6 +26,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/storage"
@@ -108,6 +109,7 @@ func (h *historical) watchGlobalSegmentMeta() {
 						continue
 					}
 					h.addGlobalSegmentInfo(segmentID, segmentInfo)
+					h.setSegmentOnService(segmentInfo)
 				case mvccpb.DELETE:
 					log.Debug("globalSealedSegments delete segment",
 						zap.Any("segmentID", segmentID),
@@ -125,6 +127,26 @@ func (h *historical) addGlobalSegmentInfo(segmentID UniqueID, segmentInfo *query
 	h.globalSealedSegments[segmentID] = segmentInfo
 }
 
+// setSegmentOnService starts searching the segment loaded for load balance,
+// once the query coord assigns the segment to this query node
+func (h *historical) setSegmentOnService(segmentInfo *querypb.SegmentInfo) {
+	onNode := segmentInfo.NodeID == Params.QueryNodeID
+	for _, nodeID := range segmentInfo.NodeIds {
+		if nodeID == Params.QueryNodeID {
+			onNode = true
+		}
+	}
+	if !onNode {
+		return
+	}
+	segment, err := h.replica.getSegmentByID(segmentInfo.SegmentID)
+	if err != nil || segment.getOnService() {
+		return
+	}
+	segment.setOnService(true)
+	log.Debug("set load balanced segment on service", zap.Int64("segmentID", segmentInfo.SegmentID))
+}
+
 func (h *historical) removeGlobalSegmentInfo(segmentID UniqueID) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
@@ -180,7 +202,7 @@ func (h *historical) removeGlobalSegmentIDsByPartitionIds(partitionIDs []UniqueI
 }
 
 func (h *historical) retrieve(collID UniqueID, partIDs []UniqueID, vcm storage.ChunkManager,
-	plan *RetrievePlan) ([]*segcorepb.RetrieveResults, []UniqueID, error) {
+	plan *RetrievePlan, expr *planpb.Expr) ([]*segcorepb.RetrieveResults, []UniqueID, error) {
 
 	retrieveResults := make([]*segcorepb.RetrieveResults, 0)
 	retrieveSegmentIDs := make([]UniqueID, 0)
@@ -212,6 +234,11 @@ func (h *historical) retrieve(collID UniqueID, partIDs []UniqueID, vcm storage.C
 			if err != nil {
 				return retrieveResults, retrieveSegmentIDs, err
 			}
+			if canSkipSegment(seg, expr) {
+				// no entity of the segment matches, it is still reported as retrieved
+				retrieveSegmentIDs = append(retrieveSegmentIDs, segID)
+				continue
+			}
 			result, err := seg.getEntityByIds(plan)
 			if err != nil {
 				return retrieveResults, retrieveSegmentIDs, err
@@ -228,7 +255,7 @@ func (h *historical) retrieve(collID UniqueID, partIDs []UniqueID, vcm storage.C
 }
 
 func (h *historical) search(searchReqs []*searchRequest, collID UniqueID, partIDs []UniqueID, plan *SearchPlan,
-	searchTs Timestamp) ([]*SearchResult, []UniqueID, error) {
+	expr *planpb.Expr, searchTs Timestamp) ([]*SearchResult, []UniqueID, error) {
 
 	searchResults := make([]*SearchResult, 0)
 	searchSegmentIDs := make([]UniqueID, 0)
@@ -295,6 +322,11 @@ func (h *historical) search(searchReqs []*searchRequest, collID UniqueID, partID
 			if !seg.getOnService() {
 				continue
 			}
+			if canSkipSegment(seg, expr) {
+				// no entity of the segment matches the predicates, it is still reported as searched
+				searchSegmentIDs = append(searchSegmentIDs, seg.segmentID)
+				continue
+			}
 			searchResult, err := seg.search(plan, searchReqs, []Timestamp{searchTs})
 			if err != nil {
 				return searchResults, searchSegmentIDs, err
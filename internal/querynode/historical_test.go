@@ -87,3 +87,34 @@ func TestHistorical_GlobalSealedSegments(t *testing.T) {
 	time.Sleep(100 * time.Millisecond) // for etcd latency
 	emptySegmentCheck()
 }
+
+func TestHistorical_setSegmentOnService(t *testing.T) {
+	n := newQueryNodeMock()
+	collectionID := UniqueID(0)
+	segmentID := UniqueID(1)
+	initTestMeta(t, n, collectionID, 0)
+
+	collection, err := n.historical.replica.getCollectionByID(collectionID)
+	assert.NoError(t, err)
+	segment := newSegment(collection, segmentID, defaultPartitionID, collectionID, "", segmentTypeSealed, false)
+	err = n.historical.replica.setSegment(segment)
+	assert.NoError(t, err)
+
+	// assigned to the other node
+	n.historical.setSegmentOnService(&querypb.SegmentInfo{
+		SegmentID: segmentID,
+		NodeID:    Params.QueryNodeID + 1,
+		NodeIds:   []int64{Params.QueryNodeID + 1},
+	})
+	assert.False(t, segment.getOnService())
+
+	n.historical.setSegmentOnService(&querypb.SegmentInfo{
+		SegmentID: segmentID,
+		NodeID:    Params.QueryNodeID + 1,
+		NodeIds:   []int64{Params.QueryNodeID + 1, Params.QueryNodeID},
+	})
+	assert.True(t, segment.getOnService())
+
+	err = n.Stop()
+	assert.NoError(t, err)
+}
@@ -24,6 +24,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
@@ -111,7 +112,14 @@ func (node *QueryNode) AddQueryChannel(ctx context.Context, in *queryPb.AddQuery
 	}
 
 	// add request channel
-	sc := node.queryService.queryCollections[in.CollectionID]
+	sc, err := node.queryService.getQueryCollection(in.CollectionID)
+	if err != nil {
+		status := &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}
+		return status, err
+	}
 	consumeChannels := []string{in.RequestChannelID}
 	//consumeSubName := Params.MsgChannelSubName
 	consumeSubName := Params.MsgChannelSubName + "-" + strconv.FormatInt(collectionID, 10) + "-" + strconv.Itoa(rand.Int())
@@ -273,6 +281,15 @@ func (node *QueryNode) LoadSegments(ctx context.Context, in *queryPb.LoadSegment
 
 	waitFunc := func() (*commonpb.Status, error) {
 		err = dct.WaitToFinish()
+		if errors.Is(err, errOutOfMemory) {
+			// the status is returned without error, so that the query coord gets the error code
+			// and loads the segments on the other nodes
+			log.Warn(err.Error())
+			return &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_OutOfMemory,
+				Reason:    err.Error(),
+			}, nil
+		}
 		if err != nil {
 			status := &commonpb.Status{
 				ErrorCode: commonpb.ErrorCode_UnexpectedError,
@@ -435,6 +452,14 @@ func (node *QueryNode) GetSegmentInfo(ctx context.Context, in *queryPb.GetSegmen
 				break
 			}
 		}
+		segmentState := queryPb.SegmentState_Growing
+		if segment.getType() != segmentTypeGrowing {
+			segmentState = queryPb.SegmentState_sealed
+			if !segment.getOnService() {
+				// loaded for load balance, not searched until the query coord assigns it to this node
+				segmentState = queryPb.SegmentState_sealing
+			}
+		}
 		info := &queryPb.SegmentInfo{
 			SegmentID:    segment.ID(),
 			CollectionID: segment.collectionID,
@@ -443,6 +468,7 @@ func (node *QueryNode) GetSegmentInfo(ctx context.Context, in *queryPb.GetSegmen
 			NumRows:      segment.getRowCount(),
 			IndexName:    indexName,
 			IndexID:      indexID,
+			SegmentState: segmentState,
 		}
 		return info
 	}
@@ -482,6 +508,84 @@ func (node *QueryNode) GetSegmentInfo(ctx context.Context, in *queryPb.GetSegmen
 	}, nil
 }
 
+// Search searches the collection on the query node without the query channel, the proxy sends the request
+// to all the query nodes of a replica and reduces their results
+func (node *QueryNode) Search(ctx context.Context, req *queryPb.SearchRequest) (*internalpb.SearchResults, error) {
+	failResults := func(err error) (*internalpb.SearchResults, error) {
+		return &internalpb.SearchResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, err
+	}
+	if !node.isHealthy() {
+		return failResults(fmt.Errorf("query node %d is not ready", Params.QueryNodeID))
+	}
+	qc, err := node.queryService.getQueryCollection(req.Req.CollectionID)
+	if err != nil {
+		return failResults(err)
+	}
+
+	searchMsg := &msgstream.SearchMsg{
+		BaseMsg: msgstream.BaseMsg{
+			Ctx:            ctx,
+			BeginTimestamp: req.Req.Base.Timestamp,
+			EndTimestamp:   req.Req.Base.Timestamp,
+		},
+		SearchRequest: *req.Req,
+	}
+	err = qc.waitServiceable(ctx, searchMsg, req.Req.ReplicaID, req.DmChannels)
+	if err != nil {
+		return failResults(err)
+	}
+	results, err := qc.doSearch(searchMsg)
+	if err != nil {
+		log.Warn("search failed", zap.Int64("collectionID", req.Req.CollectionID), zap.Int64("msgID", req.Req.Base.MsgID), zap.Error(err))
+		return failResults(err)
+	}
+	return results, nil
+}
+
+// Query retrieves the entities on the query node without the query channel, the proxy sends the request
+// to all the query nodes of a replica and merges their results
+func (node *QueryNode) Query(ctx context.Context, req *queryPb.QueryRequest) (*internalpb.RetrieveResults, error) {
+	failResults := func(err error) (*internalpb.RetrieveResults, error) {
+		return &internalpb.RetrieveResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, err
+	}
+	if !node.isHealthy() {
+		return failResults(fmt.Errorf("query node %d is not ready", Params.QueryNodeID))
+	}
+	qc, err := node.queryService.getQueryCollection(req.Req.CollectionID)
+	if err != nil {
+		return failResults(err)
+	}
+
+	retrieveMsg := &msgstream.RetrieveMsg{
+		BaseMsg: msgstream.BaseMsg{
+			Ctx:            ctx,
+			BeginTimestamp: req.Req.Base.Timestamp,
+			EndTimestamp:   req.Req.Base.Timestamp,
+		},
+		RetrieveRequest: *req.Req,
+	}
+	err = qc.waitServiceable(ctx, retrieveMsg, req.Req.ReplicaID, req.DmChannels)
+	if err != nil {
+		return failResults(err)
+	}
+	results, err := qc.doRetrieve(retrieveMsg)
+	if err != nil {
+		log.Warn("query failed", zap.Int64("collectionID", req.Req.CollectionID), zap.Int64("msgID", req.Req.Base.MsgID), zap.Error(err))
+		return failResults(err)
+	}
+	return results, nil
+}
+
 func (node *QueryNode) isHealthy() bool {
 	code := node.stateCode.Load().(internalpb.StateCode)
 	return code == internalpb.StateCode_Healthy
@@ -287,55 +287,66 @@ func (loader *indexLoader) sendQueryNodeStats() error {
 }
 
 func (loader *indexLoader) setIndexInfo(collectionID UniqueID, segment *Segment, fieldID UniqueID) error {
+	info, err := loader.getIndexInfo(collectionID, segment.segmentID)
+	if err != nil {
+		return err
+	}
+	segment.setEnableIndex(true)
+	err = segment.setIndexInfo(fieldID, info)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// getIndexInfo returns the index built on the segment, with the paths of the index files
+func (loader *indexLoader) getIndexInfo(collectionID UniqueID, segmentID UniqueID) (*indexInfo, error) {
 	ctx := context.TODO()
 	req := &milvuspb.DescribeSegmentRequest{
 		Base: &commonpb.MsgBase{
 			MsgType: commonpb.MsgType_DescribeSegment,
 		},
 		CollectionID: collectionID,
-		SegmentID:    segment.segmentID,
+		SegmentID:    segmentID,
 	}
 	response, err := loader.rootCoord.DescribeSegment(ctx, req)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if response.Status.ErrorCode != commonpb.ErrorCode_Success {
-		return errors.New(response.Status.Reason)
+		return nil, errors.New(response.Status.Reason)
 	}
 
 	if !response.EnableIndex {
-		return errors.New("there are no indexes on this segment")
+		return nil, errors.New("there are no indexes on this segment")
 	}
 
 	if loader.indexCoord == nil {
-		return errors.New("null index coordinator client")
+		return nil, errors.New("null index coordinator client")
 	}
 
 	indexFilePathRequest := &indexpb.GetIndexFilePathsRequest{
 		IndexBuildIDs: []UniqueID{response.BuildID},
 	}
 	pathResponse, err := loader.indexCoord.GetIndexFilePaths(ctx, indexFilePathRequest)
-	if err != nil || pathResponse.Status.ErrorCode != commonpb.ErrorCode_Success {
-		return err
+	if err != nil {
+		return nil, err
+	}
+	if pathResponse.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(pathResponse.Status.Reason)
 	}
 
 	if len(pathResponse.FilePaths) <= 0 {
-		return errors.New("illegal index file paths")
+		return nil, errors.New("illegal index file paths")
 	}
 
-	info := &indexInfo{
+	return &indexInfo{
 		indexID:    response.IndexID,
 		buildID:    response.BuildID,
 		indexPaths: pathResponse.FilePaths[0].IndexFilePaths,
 		readyLoad:  true,
-	}
-	segment.setEnableIndex(response.EnableIndex)
-	err = segment.setIndexInfo(fieldID, info)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	}, nil
 }
 
 //func (loader *indexLoader) getIndexPaths(indexBuildID UniqueID) ([]string, error) {
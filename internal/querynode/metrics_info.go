@@ -26,6 +26,9 @@ func getSystemInfoMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest,
 		BaseComponentInfos: metricsinfo.BaseComponentInfos{
 			Name: metricsinfo.ConstructComponentName(typeutil.QueryNodeRole, Params.QueryNodeID),
 		},
+		HardwareInfos: metricsinfo.HardwareMetrics{
+			Memory: Params.MemoryCapacity,
+		},
 	}
 	resp, err := metricsinfo.MarshalComponentInfos(nodeInfos)
 	if err != nil {
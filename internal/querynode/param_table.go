@@ -17,8 +17,10 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 )
 
@@ -64,6 +66,15 @@ type ParamTable struct {
 	StatsPublishInterval int
 	StatsChannelName     string
 
+	// memory capacity in bytes reported to the query coord, detected from the host if not configured
+	MemoryCapacity uint64
+	// the segments are rejected if loading them takes the memory usage above this ratio of the capacity
+	LoadMemoryUsageThreshold float64
+	// the time to wait for the segments and channels to be moved elsewhere when the query node stops
+	GracefulStopTimeout time.Duration
+	// the resource group the query node joins when it registers, empty for the default group
+	ResourceGroup string
+
 	GracefulTime      int64
 	MsgChannelSubName string
 	SliceIndex        int
@@ -111,6 +122,11 @@ func (p *ParamTable) Init() {
 		p.initStatsPublishInterval()
 		p.initStatsChannelName()
 
+		p.initMemoryCapacity()
+		p.initLoadMemoryUsageThreshold()
+		p.initGracefulStopTimeout()
+		p.initResourceGroup()
+
 		p.initLogCfg()
 	})
 }
@@ -191,6 +207,29 @@ func (p *ParamTable) initStatsPublishInterval() {
 	p.StatsPublishInterval = p.ParseInt("queryNode.stats.publishInterval")
 }
 
+func (p *ParamTable) initMemoryCapacity() {
+	p.MemoryCapacity = uint64(p.ParseInt64("queryNode.memoryCapacity"))
+	if p.MemoryCapacity == 0 {
+		p.MemoryCapacity = metricsinfo.GetMemoryCapacity()
+	}
+}
+
+func (p *ParamTable) initLoadMemoryUsageThreshold() {
+	p.LoadMemoryUsageThreshold = p.ParseFloat("queryNode.loadMemoryUsageThresholdPercentage") / 100
+}
+
+func (p *ParamTable) initGracefulStopTimeout() {
+	p.GracefulStopTimeout = time.Duration(p.ParseInt64("queryNode.gracefulStopTimeout")) * time.Second
+}
+
+func (p *ParamTable) initResourceGroup() {
+	resourceGroup, err := p.LoadWithDefault("queryNode.resourceGroup", "")
+	if err != nil {
+		panic(err)
+	}
+	p.ResourceGroup = resourceGroup
+}
+
 // dataSync:
 func (p *ParamTable) initFlowGraphMaxQueueLength() {
 	p.FlowGraphMaxQueueLength = p.ParseInt32("queryNode.dataSync.flowGraph.maxQueueLength")
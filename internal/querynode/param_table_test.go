@@ -15,8 +15,11 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 )
 
 func TestParamTable_PulsarAddress(t *testing.T) {
@@ -61,6 +64,23 @@ func TestParamTable_statsServiceTimeInterval(t *testing.T) {
 	assert.Equal(t, 1000, interval)
 }
 
+func TestParamTable_memoryCapacity(t *testing.T) {
+	// detected from the host since it isn't configured
+	assert.Equal(t, metricsinfo.GetMemoryCapacity(), Params.MemoryCapacity)
+}
+
+func TestParamTable_loadMemoryUsageThreshold(t *testing.T) {
+	assert.Equal(t, 0.9, Params.LoadMemoryUsageThreshold)
+}
+
+func TestParamTable_gracefulStopTimeout(t *testing.T) {
+	assert.Equal(t, 30*time.Second, Params.GracefulStopTimeout)
+}
+
+func TestParamTable_resourceGroup(t *testing.T) {
+	assert.Equal(t, "", Params.ResourceGroup)
+}
+
 func TestParamTable_searchMsgStreamReceiveBufSize(t *testing.T) {
 	bufSize := Params.SearchReceiveBufSize
 	assert.Equal(t, int64(512), bufSize)
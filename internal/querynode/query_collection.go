@@ -18,6 +18,7 @@ import (
 	"math"
 	"reflect"
 	"sync"
+	"time"
 	"unsafe"
 
 	oplog "github.com/opentracing/opentracing-go/log"
@@ -30,15 +31,20 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
+// serviceableCheckInterval is the interval to check the serviceable time for the requests sent by grpc
+const serviceableCheckInterval = 10 * time.Millisecond
+
 type queryMsg interface {
 	msgstream.TsMsg
 	GuaranteeTs() Timestamp
@@ -66,10 +72,11 @@ type queryCollection struct {
 	queryMsgStream       msgstream.MsgStream
 	queryResultMsgStream msgstream.MsgStream
 
-	localChunkManager  storage.ChunkManager
-	remoteChunkManager storage.ChunkManager
-	vectorChunkManager storage.ChunkManager
-	localCacheEnabled  bool
+	localChunkManager    storage.ChunkManager
+	remoteChunkManager   storage.ChunkManager
+	vectorChunkManagerMu sync.Mutex // guards vectorChunkManager
+	vectorChunkManager   storage.ChunkManager
+	localCacheEnabled    bool
 }
 
 type ResultEntityIds []UniqueID
@@ -211,6 +218,44 @@ func (q *queryCollection) setServiceableTime(t Timestamp) {
 	}
 }
 
+// waitServiceable checks the query request sent to the query node directly, and blocks until
+// the serviceable time reaches the guarantee timestamp of the request or the context is done
+func (q *queryCollection) waitServiceable(ctx context.Context, msg queryMsg, replicaID UniqueID, dmChannels []string) error {
+	collection, err := q.historical.replica.getCollectionByID(q.collectionID)
+	if err != nil {
+		return err
+	}
+	if replicaID != 0 && collection.getReplicaID() != replicaID {
+		return fmt.Errorf("query node %d is not in replica %d of collection %d", Params.QueryNodeID, replicaID, q.collectionID)
+	}
+	vChannels := collection.getVChannels()
+	for _, channel := range dmChannels {
+		if !funcutil.SliceContain(vChannels, channel) {
+			return fmt.Errorf("query node %d doesn't lead DM channel %s of collection %d", Params.QueryNodeID, channel, q.collectionID)
+		}
+	}
+
+	guaranteeTs := msg.GuaranteeTs()
+	if guaranteeTs >= collection.getReleaseTime() {
+		return fmt.Errorf("collection %d has been released, msgID = %d", q.collectionID, msg.ID())
+	}
+	if len(vChannels) == 0 {
+		return nil
+	}
+	ticker := time.NewTicker(serviceableCheckInterval)
+	defer ticker.Stop()
+	for guaranteeTs > q.getServiceableTime() {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("wait for the serviceable time of collection %d: %w", q.collectionID, ctx.Err())
+		case <-q.releaseCtx.Done():
+			return fmt.Errorf("query collection %d has been closed", q.collectionID)
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
 func (q *queryCollection) consumeQuery() {
 	for {
 		select {
@@ -285,11 +330,13 @@ func (q *queryCollection) loadBalance(msg *msgstream.LoadBalanceSegmentsMsg) {
 func (q *queryCollection) receiveQueryMsg(msg queryMsg) error {
 	msgType := msg.Type()
 	var collectionID UniqueID
+	var replicaID UniqueID
 	var msgTypeStr string
 
 	switch msgType {
 	case commonpb.MsgType_Retrieve:
 		collectionID = msg.(*msgstream.RetrieveMsg).CollectionID
+		replicaID = msg.(*msgstream.RetrieveMsg).ReplicaID
 		msgTypeStr = "retrieve"
 		//log.Debug("consume retrieve message",
 		//	zap.Any("collectionID", collectionID),
@@ -297,6 +344,7 @@ func (q *queryCollection) receiveQueryMsg(msg queryMsg) error {
 		//)
 	case commonpb.MsgType_Search:
 		collectionID = msg.(*msgstream.SearchMsg).CollectionID
+		replicaID = msg.(*msgstream.SearchMsg).ReplicaID
 		msgTypeStr = "search"
 		//log.Debug("consume search message",
 		//	zap.Any("collectionID", collectionID),
@@ -315,6 +363,12 @@ func (q *queryCollection) receiveQueryMsg(msg queryMsg) error {
 		//err := fmt.Errorf("not target collection query request, collectionID = %d, targetCollectionID = %d, msgID = %d", q.collectionID, collectionID, msg.ID())
 		return nil
 	}
+	// the request is served by the nodes of another replica
+	if replicaID != 0 {
+		if collection, err := q.historical.replica.getCollectionByID(collectionID); err == nil && collection.getReplicaID() != replicaID {
+			return nil
+		}
+	}
 
 	sp, ctx := trace.StartSpanFromContext(msg.TraceCtx())
 	msg.SetTraceCtx(ctx)
@@ -784,10 +838,111 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 	return finalResult, nil
 }
 
+// getPlanFieldIDs returns the fields referenced by the vector field, the predicates and the output fields of the serialized plan
+func getPlanFieldIDs(serializedPlan []byte) ([]int64, error) {
+	planNode := &planpb.PlanNode{}
+	err := proto.Unmarshal(serializedPlan, planNode)
+	if err != nil {
+		return nil, err
+	}
+	fieldIDs := append([]int64{}, planNode.OutputFieldIds...)
+	vectorANNS := planNode.GetVectorAnns()
+	if vectorANNS == nil {
+		return fieldIDs, nil
+	}
+	fieldIDs = append(fieldIDs, vectorANNS.FieldId)
+
+	var collectExpr func(expr *planpb.Expr)
+	collectExpr = func(expr *planpb.Expr) {
+		if expr == nil {
+			return
+		}
+		switch e := expr.Expr.(type) {
+		case *planpb.Expr_TermExpr:
+			fieldIDs = append(fieldIDs, e.TermExpr.GetColumnInfo().GetFieldId())
+		case *planpb.Expr_UnaryExpr:
+			collectExpr(e.UnaryExpr.GetChild())
+		case *planpb.Expr_BinaryExpr:
+			collectExpr(e.BinaryExpr.GetLeft())
+			collectExpr(e.BinaryExpr.GetRight())
+		case *planpb.Expr_CompareExpr:
+			fieldIDs = append(fieldIDs, e.CompareExpr.GetLeftColumnInfo().GetFieldId(), e.CompareExpr.GetRightColumnInfo().GetFieldId())
+		case *planpb.Expr_UnaryRangeExpr:
+			fieldIDs = append(fieldIDs, e.UnaryRangeExpr.GetColumnInfo().GetFieldId())
+		case *planpb.Expr_BinaryRangeExpr:
+			fieldIDs = append(fieldIDs, e.BinaryRangeExpr.GetColumnInfo().GetFieldId())
+		}
+	}
+	collectExpr(vectorANNS.GetPredicates())
+	return fieldIDs, nil
+}
+
+// getPlanPredicates returns the predicates of the serialized plan, nil if the plan has no predicates
+func getPlanPredicates(serializedPlan []byte) (*planpb.Expr, error) {
+	planNode := &planpb.PlanNode{}
+	err := proto.Unmarshal(serializedPlan, planNode)
+	if err != nil {
+		return nil, err
+	}
+	return planNode.GetVectorAnns().GetPredicates(), nil
+}
+
+// genPrimaryKeyTermExpr generates the expression `pk in ids` of a retrieve request
+func genPrimaryKeyTermExpr(schema *schemapb.CollectionSchema, ids *schemapb.IDs) *planpb.Expr {
+	intIDs := ids.GetIntId().GetData()
+	if intIDs == nil {
+		return nil
+	}
+	for _, field := range schema.GetFields() {
+		if !field.IsPrimaryKey {
+			continue
+		}
+		values := make([]*planpb.GenericValue, 0, len(intIDs))
+		for _, id := range intIDs {
+			values = append(values, &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: id}})
+		}
+		return &planpb.Expr{
+			Expr: &planpb.Expr_TermExpr{
+				TermExpr: &planpb.TermExpr{
+					ColumnInfo: &planpb.ColumnInfo{
+						FieldId:      field.FieldID,
+						DataType:     field.DataType,
+						IsPrimaryKey: true,
+						IsAutoID:     field.AutoID,
+					},
+					Values: values,
+				},
+			},
+		}
+	}
+	return nil
+}
+
 // TODO:: cache map[dsl]plan
 // TODO: reBatched search requests
 func (q *queryCollection) search(msg queryMsg) error {
 	searchMsg := msg.(*msgstream.SearchMsg)
+	searchResults, err := q.doSearch(searchMsg)
+	if err != nil {
+		return err
+	}
+
+	resultChannelInt := 0
+	searchResultMsg := &msgstream.SearchResultMsg{
+		BaseMsg:       msgstream.BaseMsg{Ctx: searchMsg.Ctx, HashValues: []uint32{uint32(resultChannelInt)}},
+		SearchResults: *searchResults,
+	}
+	log.Debug("QueryNode SearchResultMsg",
+		zap.Any("collectionID", q.collection.id),
+		zap.Any("msgID", searchMsg.ID()),
+		zap.Any("vChannels", searchResults.ChannelIDsSearched),
+		zap.Any("sealedSegmentSearched", searchResults.SealedSegmentIDsSearched),
+	)
+	return q.publishQueryResult(searchResultMsg, searchMsg.CollectionID)
+}
+
+// doSearch searches the sealed segments loaded and the growing segments of the DM channels watched by the query node
+func (q *queryCollection) doSearch(searchMsg *msgstream.SearchMsg) (*internalpb.SearchResults, error) {
 	sp, ctx := trace.StartSpanFromContext(searchMsg.TraceCtx())
 	defer sp.Finish()
 	searchMsg.SetTraceCtx(ctx)
@@ -796,35 +951,50 @@ func (q *queryCollection) search(msg queryMsg) error {
 
 	schema, err := typeutil.CreateSchemaHelper(q.collection.schema)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var plan *SearchPlan
+	var predicates *planpb.Expr
 	if searchMsg.GetDslType() == commonpb.DslType_BoolExprV1 {
 		expr := searchMsg.SerializedExprPlan
+		fieldIDs, err := getPlanFieldIDs(expr)
+		if err != nil {
+			return nil, err
+		}
+		predicates, err = getPlanPredicates(expr)
+		if err != nil {
+			return nil, err
+		}
+		err = q.collection.checkFieldsLoaded(append(fieldIDs, searchMsg.OutputFieldsId...))
+		if err != nil {
+			return nil, err
+		}
 		plan, err = createSearchPlanByExpr(q.collection, expr)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	} else {
 		dsl := searchMsg.Dsl
 		plan, err = createSearchPlan(q.collection, dsl)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
+	defer plan.delete()
 	topK := plan.getTopK()
 	if topK == 0 {
-		return fmt.Errorf("limit must be greater than 0")
+		return nil, fmt.Errorf("limit must be greater than 0")
 	}
 	if topK >= 16385 {
-		return fmt.Errorf("limit %d is too large", topK)
+		return nil, fmt.Errorf("limit %d is too large", topK)
 	}
 	searchRequestBlob := searchMsg.PlaceholderGroup
 	searchReq, err := parseSearchRequest(plan, searchRequestBlob)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	defer searchReq.delete()
 	queryNum := searchReq.getNumOfQuery()
 	searchRequests := make([]*searchRequest, 0)
 	searchRequests = append(searchRequests, searchReq)
@@ -850,12 +1020,15 @@ func (q *queryCollection) search(msg queryMsg) error {
 	}
 
 	searchResults := make([]*SearchResult, 0)
+	defer func() {
+		deleteSearchResults(searchResults)
+	}()
 
 	// historical search
-	hisSearchResults, sealedSegmentSearched, err1 := q.historical.search(searchRequests, q.collection.id, searchMsg.PartitionIDs, plan, travelTimestamp)
+	hisSearchResults, sealedSegmentSearched, err1 := q.historical.search(searchRequests, q.collection.id, searchMsg.PartitionIDs, plan, predicates, travelTimestamp)
 	if err1 != nil {
 		log.Warn(err1.Error())
-		return err1
+		return nil, err1
 	}
 	searchResults = append(searchResults, hisSearchResults...)
 	tr.Record("historical search done")
@@ -867,52 +1040,42 @@ func (q *queryCollection) search(msg queryMsg) error {
 		strSearchResults, err2 = q.streaming.search(searchRequests, q.collection.id, searchMsg.PartitionIDs, channel, plan, travelTimestamp)
 		if err2 != nil {
 			log.Warn(err2.Error())
-			return err2
+			return nil, err2
 		}
 		searchResults = append(searchResults, strSearchResults...)
 	}
 	tr.Record("streaming search done")
 
 	sp.LogFields(oplog.String("statistical time", "segment search end"))
+	results := &internalpb.SearchResults{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_SearchResult,
+			MsgID:     searchMsg.Base.MsgID,
+			Timestamp: searchTimestamp,
+			SourceID:  searchMsg.Base.SourceID,
+		},
+		Status:                   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		ResultChannelID:          searchMsg.ResultChannelID,
+		MetricType:               plan.getMetricType(),
+		NumQueries:               queryNum,
+		TopK:                     topK,
+		SlicedBlob:               nil,
+		SlicedOffset:             1,
+		SlicedNumCount:           1,
+		SealedSegmentIDsSearched: sealedSegmentSearched,
+		ChannelIDsSearched:       q.collection.getVChannels(),
+		GlobalSealedSegmentIDs:   globalSealedSegments,
+		ReplicaID:                searchMsg.ReplicaID,
+	}
 	if len(searchResults) <= 0 {
-		for range searchRequests {
-			resultChannelInt := 0
-			searchResultMsg := &msgstream.SearchResultMsg{
-				BaseMsg: msgstream.BaseMsg{Ctx: searchMsg.Ctx, HashValues: []uint32{uint32(resultChannelInt)}},
-				SearchResults: internalpb.SearchResults{
-					Base: &commonpb.MsgBase{
-						MsgType:   commonpb.MsgType_SearchResult,
-						MsgID:     searchMsg.Base.MsgID,
-						Timestamp: searchTimestamp,
-						SourceID:  searchMsg.Base.SourceID,
-					},
-					Status:                   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
-					ResultChannelID:          searchMsg.ResultChannelID,
-					MetricType:               plan.getMetricType(),
-					NumQueries:               queryNum,
-					TopK:                     topK,
-					SlicedBlob:               nil,
-					SlicedOffset:             1,
-					SlicedNumCount:           1,
-					SealedSegmentIDsSearched: sealedSegmentSearched,
-					ChannelIDsSearched:       q.collection.getVChannels(),
-					GlobalSealedSegmentIDs:   globalSealedSegments,
-				},
-			}
-			log.Debug("QueryNode Empty SearchResultMsg",
-				zap.Any("collectionID", q.collection.id),
-				zap.Any("msgID", searchMsg.ID()),
-				zap.Any("vChannels", q.collection.getVChannels()),
-				zap.Any("sealedSegmentSearched", sealedSegmentSearched),
-			)
-			err = q.publishQueryResult(searchResultMsg, searchMsg.CollectionID)
-			if err != nil {
-				return err
-			}
-			tr.Record("publish empty search result done")
-			tr.Elapse("all done")
-			return nil
-		}
+		log.Debug("QueryNode Empty SearchResults",
+			zap.Any("collectionID", q.collection.id),
+			zap.Any("msgID", searchMsg.ID()),
+			zap.Any("vChannels", q.collection.getVChannels()),
+			zap.Any("sealedSegmentSearched", sealedSegmentSearched),
+		)
+		tr.Elapse("all done")
+		return results, nil
 	}
 
 	numSegment := int64(len(searchResults))
@@ -920,110 +1083,49 @@ func (q *queryCollection) search(msg queryMsg) error {
 	err = reduceSearchResultsAndFillData(plan, searchResults, numSegment)
 	sp.LogFields(oplog.String("statistical time", "reduceSearchResults end"))
 	if err != nil {
-		return err
+		return nil, err
 	}
 	marshaledHits, err = reorganizeSearchResults(searchResults, numSegment)
 	sp.LogFields(oplog.String("statistical time", "reorganizeSearchResults end"))
 	if err != nil {
-		return err
+		return nil, err
 	}
+	defer deleteMarshaledHits(marshaledHits)
 
 	hitsBlob, err := marshaledHits.getHitsBlob()
 	sp.LogFields(oplog.String("statistical time", "getHitsBlob end"))
 	if err != nil {
-		return err
+		return nil, err
 	}
 	tr.Record("reduce result done")
 
+	// only one search request in the message
+	hitBlobSizePeerQuery, err := marshaledHits.hitBlobSizeInGroup(0)
+	if err != nil {
+		return nil, err
+	}
 	var offset int64 = 0
-	for index := range searchRequests {
-		hitBlobSizePeerQuery, err := marshaledHits.hitBlobSizeInGroup(int64(index))
-		if err != nil {
-			return err
-		}
-		hits := make([][]byte, len(hitBlobSizePeerQuery))
-		for i, len := range hitBlobSizePeerQuery {
-			hits[i] = hitsBlob[offset : offset+len]
-			//test code to checkout marshaled hits
-			//marshaledHit := hitsBlob[offset:offset+len]
-			//unMarshaledHit := milvuspb.Hits{}
-			//err = proto.Unmarshal(marshaledHit, &unMarshaledHit)
-			//if err != nil {
-			//	return err
-			//}
-			//log.Debug("hits msg  = ", unMarshaledHit)
-			offset += len
-		}
-
-		// TODO: remove inefficient code in cgo and use SearchResultData directly
-		// TODO: Currently add a translate layer from hits to SearchResultData
-		// TODO: hits marshal and unmarshal is likely bottleneck
+	hits := make([][]byte, len(hitBlobSizePeerQuery))
+	for i, len := range hitBlobSizePeerQuery {
+		hits[i] = hitsBlob[offset : offset+len]
+		offset += len
+	}
 
-		transformed, err := translateHits(schema, searchMsg.OutputFieldsId, hits)
-		if err != nil {
-			return err
-		}
-		byteBlobs, err := proto.Marshal(transformed)
-		if err != nil {
-			return err
-		}
+	// TODO: remove inefficient code in cgo and use SearchResultData directly
+	// TODO: Currently add a translate layer from hits to SearchResultData
+	// TODO: hits marshal and unmarshal is likely bottleneck
 
-		resultChannelInt := 0
-		searchResultMsg := &msgstream.SearchResultMsg{
-			BaseMsg: msgstream.BaseMsg{Ctx: searchMsg.Ctx, HashValues: []uint32{uint32(resultChannelInt)}},
-			SearchResults: internalpb.SearchResults{
-				Base: &commonpb.MsgBase{
-					MsgType:   commonpb.MsgType_SearchResult,
-					MsgID:     searchMsg.Base.MsgID,
-					Timestamp: searchTimestamp,
-					SourceID:  searchMsg.Base.SourceID,
-				},
-				Status:                   &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
-				ResultChannelID:          searchMsg.ResultChannelID,
-				MetricType:               plan.getMetricType(),
-				NumQueries:               queryNum,
-				TopK:                     topK,
-				SlicedBlob:               byteBlobs,
-				SlicedOffset:             1,
-				SlicedNumCount:           1,
-				SealedSegmentIDsSearched: sealedSegmentSearched,
-				ChannelIDsSearched:       q.collection.getVChannels(),
-				GlobalSealedSegmentIDs:   globalSealedSegments,
-			},
-		}
-		log.Debug("QueryNode SearchResultMsg",
-			zap.Any("collectionID", q.collection.id),
-			zap.Any("msgID", searchMsg.ID()),
-			zap.Any("vChannels", q.collection.getVChannels()),
-			zap.Any("sealedSegmentSearched", sealedSegmentSearched),
-		)
-
-		// For debugging, please don't delete.
-		//fmt.Println("==================== search result ======================")
-		//for i := 0; i < len(hits); i++ {
-		//	testHits := milvuspb.Hits{}
-		//	err := proto.Unmarshal(hits[i], &testHits)
-		//	if err != nil {
-		//		panic(err)
-		//	}
-		//	fmt.Println(testHits.IDs)
-		//	fmt.Println(testHits.Scores)
-		//}
-		err = q.publishQueryResult(searchResultMsg, searchMsg.CollectionID)
-		if err != nil {
-			return err
-		}
-		tr.Record("publish search result")
+	transformed, err := translateHits(schema, searchMsg.OutputFieldsId, hits)
+	if err != nil {
+		return nil, err
+	}
+	results.SlicedBlob, err = proto.Marshal(transformed)
+	if err != nil {
+		return nil, err
 	}
-
-	sp.LogFields(oplog.String("statistical time", "before free c++ memory"))
-	deleteSearchResults(searchResults)
-	deleteMarshaledHits(marshaledHits)
 	sp.LogFields(oplog.String("statistical time", "stats done"))
-	plan.delete()
-	searchReq.delete()
 	tr.Elapse("all done")
-	return nil
+	return results, nil
 }
 
 func (q *queryCollection) retrieve(msg queryMsg) error {
@@ -1034,6 +1136,30 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 	// step 4: publish results
 	// retrieveProtoBlob, err := proto.Marshal(&retrieveMsg.RetrieveRequest)
 	retrieveMsg := msg.(*msgstream.RetrieveMsg)
+	retrieveResults, err := q.doRetrieve(retrieveMsg)
+	if err != nil {
+		return err
+	}
+
+	resultChannelInt := 0
+	retrieveResultMsg := &msgstream.RetrieveResultMsg{
+		BaseMsg:         msgstream.BaseMsg{Ctx: retrieveMsg.Ctx, HashValues: []uint32{uint32(resultChannelInt)}},
+		RetrieveResults: *retrieveResults,
+	}
+	err = q.publishQueryResult(retrieveResultMsg, retrieveMsg.CollectionID)
+	if err != nil {
+		return err
+	}
+	log.Debug("QueryNode publish RetrieveResultMsg",
+		zap.Any("vChannels", retrieveResults.ChannelIDsRetrieved),
+		zap.Any("collectionID", retrieveMsg.CollectionID),
+		zap.Any("sealedSegmentRetrieved", retrieveResults.SealedSegmentIDsRetrieved),
+	)
+	return nil
+}
+
+// doRetrieve retrieves the entities from the sealed segments loaded and the growing segments of the DM channels watched by the query node
+func (q *queryCollection) doRetrieve(retrieveMsg *msgstream.RetrieveMsg) (*internalpb.RetrieveResults, error) {
 	sp, ctx := trace.StartSpanFromContext(retrieveMsg.TraceCtx())
 	defer sp.Finish()
 	retrieveMsg.SetTraceCtx(ctx)
@@ -1042,7 +1168,11 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 	collectionID := retrieveMsg.CollectionID
 	collection, err := q.streaming.replica.getCollectionByID(collectionID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	err = collection.checkFieldsLoaded(retrieveMsg.OutputFieldsId)
+	if err != nil {
+		return nil, err
 	}
 
 	req := &segcorepb.RetrieveRequest{
@@ -1052,7 +1182,7 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 
 	plan, err := createRetrievePlan(collection, req, timestamp)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer plan.delete()
 
@@ -1067,24 +1197,16 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 
 	var mergeList []*segcorepb.RetrieveResults
 
-	if q.vectorChunkManager == nil {
-		if q.localChunkManager == nil {
-			return fmt.Errorf("can not create vector chunk manager for local chunk manager is nil")
-		}
-		if q.remoteChunkManager == nil {
-			return fmt.Errorf("can not create vector chunk manager for remote chunk manager is nil")
-		}
-		q.vectorChunkManager = storage.NewVectorChunkManager(q.localChunkManager, q.remoteChunkManager,
-			&etcdpb.CollectionMeta{
-				ID:     collection.id,
-				Schema: collection.schema,
-			}, q.localCacheEnabled)
+	vectorChunkManager, err := q.getVectorChunkManager(collection)
+	if err != nil {
+		return nil, err
 	}
 	// historical retrieve
-	hisRetrieveResults, sealedSegmentRetrieved, err1 := q.historical.retrieve(collectionID, retrieveMsg.PartitionIDs, q.vectorChunkManager, plan)
+	hisRetrieveResults, sealedSegmentRetrieved, err1 := q.historical.retrieve(collectionID, retrieveMsg.PartitionIDs, vectorChunkManager, plan,
+		genPrimaryKeyTermExpr(collection.schema, retrieveMsg.Ids))
 	if err1 != nil {
 		log.Warn(err1.Error())
-		return err1
+		return nil, err1
 	}
 	mergeList = append(mergeList, hisRetrieveResults...)
 	tr.Record("historical retrieve done")
@@ -1093,47 +1215,60 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 	strRetrieveResults, _, err2 := q.streaming.retrieve(collectionID, retrieveMsg.PartitionIDs, plan)
 	if err2 != nil {
 		log.Warn(err2.Error())
-		return err2
+		return nil, err2
 	}
 	mergeList = append(mergeList, strRetrieveResults...)
 	tr.Record("streaming retrieve done")
 
-	result, err := mergeRetrieveResults(mergeList)
+	var result *segcorepb.RetrieveResults
+	if len(retrieveMsg.OrderBy) > 0 || retrieveMsg.Limit > 0 {
+		result, err = mergeSortedRetrieveResults(mergeList, retrieveMsg.OrderBy, retrieveMsg.Limit)
+	} else {
+		result, err = mergeRetrieveResults(mergeList)
+	}
 	if err != nil {
-		return err
+		return nil, err
 	}
 	tr.Record("merge result done")
 
-	resultChannelInt := 0
-	retrieveResultMsg := &msgstream.RetrieveResultMsg{
-		BaseMsg: msgstream.BaseMsg{Ctx: retrieveMsg.Ctx, HashValues: []uint32{uint32(resultChannelInt)}},
-		RetrieveResults: internalpb.RetrieveResults{
-			Base: &commonpb.MsgBase{
-				MsgType:  commonpb.MsgType_RetrieveResult,
-				MsgID:    retrieveMsg.Base.MsgID,
-				SourceID: retrieveMsg.Base.SourceID,
-			},
-			Status:                    &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
-			Ids:                       result.Ids,
-			FieldsData:                result.FieldsData,
-			ResultChannelID:           retrieveMsg.ResultChannelID,
-			SealedSegmentIDsRetrieved: sealedSegmentRetrieved,
-			ChannelIDsRetrieved:       collection.getVChannels(),
-			GlobalSealedSegmentIDs:    globalSealedSegments,
+	results := &internalpb.RetrieveResults{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_RetrieveResult,
+			MsgID:    retrieveMsg.Base.MsgID,
+			SourceID: retrieveMsg.Base.SourceID,
 		},
+		Status:                    &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Ids:                       result.Ids,
+		FieldsData:                result.FieldsData,
+		ResultChannelID:           retrieveMsg.ResultChannelID,
+		SealedSegmentIDsRetrieved: sealedSegmentRetrieved,
+		ChannelIDsRetrieved:       collection.getVChannels(),
+		GlobalSealedSegmentIDs:    globalSealedSegments,
+		ReplicaID:                 retrieveMsg.ReplicaID,
 	}
+	tr.Elapse("all done")
+	return results, nil
+}
 
-	err = q.publishQueryResult(retrieveResultMsg, retrieveMsg.CollectionID)
-	if err != nil {
-		return err
+// getVectorChunkManager creates the vector chunk manager at the first retrieve, the requests sent by grpc
+// are served concurrently with the ones consumed from the query channel
+func (q *queryCollection) getVectorChunkManager(collection *Collection) (storage.ChunkManager, error) {
+	q.vectorChunkManagerMu.Lock()
+	defer q.vectorChunkManagerMu.Unlock()
+	if q.vectorChunkManager == nil {
+		if q.localChunkManager == nil {
+			return nil, fmt.Errorf("can not create vector chunk manager for local chunk manager is nil")
+		}
+		if q.remoteChunkManager == nil {
+			return nil, fmt.Errorf("can not create vector chunk manager for remote chunk manager is nil")
+		}
+		q.vectorChunkManager = storage.NewVectorChunkManager(q.localChunkManager, q.remoteChunkManager,
+			&etcdpb.CollectionMeta{
+				ID:     collection.id,
+				Schema: collection.schema,
+			}, q.localCacheEnabled)
 	}
-	log.Debug("QueryNode publish RetrieveResultMsg",
-		zap.Any("vChannels", collection.getVChannels()),
-		zap.Any("collectionID", collection.ID()),
-		zap.Any("sealedSegmentRetrieved", sealedSegmentRetrieved),
-	)
-	tr.Elapse("all done")
-	return nil
+	return q.vectorChunkManager, nil
 }
 
 func getSegmentsByPKs(pks []int64, segments []*Segment) (map[int64][]int64, error) {
@@ -1191,6 +1326,67 @@ func mergeRetrieveResults(dataArr []*segcorepb.RetrieveResults) (*segcorepb.Retr
 	return final, nil
 }
 
+// mergeSortedRetrieveResults sorts the result of each segment by orderBy and keeps its top limit rows,
+// then merges the sorted segment results into one result which is still sorted by orderBy
+func mergeSortedRetrieveResults(dataArr []*segcorepb.RetrieveResults, orderBy []*internalpb.OrderByField, limit int64) (*segcorepb.RetrieveResults, error) {
+	sortedArr := make([]*segcorepb.RetrieveResults, 0, len(dataArr))
+	for _, data := range dataArr {
+		// skip empty result, it has no fields data to sort
+		if data == nil || len(data.Offset) == 0 {
+			continue
+		}
+		offsets, err := typeutil.SortFieldsData(data.FieldsData, orderBy, limit)
+		if err != nil {
+			return nil, err
+		}
+		locations := make([]typeutil.RowLocation, 0, len(offsets))
+		for _, offset := range offsets {
+			locations = append(locations, typeutil.RowLocation{ResultIdx: 0, RowOffset: offset})
+		}
+		sortedArr = append(sortedArr, selectRetrieveResults([]*segcorepb.RetrieveResults{data}, locations))
+	}
+
+	fieldsDataArr := make([][]*schemapb.FieldData, 0, len(sortedArr))
+	for _, sorted := range sortedArr {
+		fieldsDataArr = append(fieldsDataArr, sorted.FieldsData)
+	}
+	locations, err := typeutil.MergeSortedFieldsData(fieldsDataArr, orderBy, limit)
+	if err != nil {
+		return nil, err
+	}
+	return selectRetrieveResults(sortedArr, locations), nil
+}
+
+// selectRetrieveResults builds a new result with the rows at locations, in the order of locations
+func selectRetrieveResults(dataArr []*segcorepb.RetrieveResults, locations []typeutil.RowLocation) *segcorepb.RetrieveResults {
+	// not found, return default values indicating not result found
+	if len(locations) == 0 {
+		return &segcorepb.RetrieveResults{
+			Ids:        nil,
+			FieldsData: []*schemapb.FieldData{},
+		}
+	}
+
+	ret := &segcorepb.RetrieveResults{
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{
+					Data: make([]int64, 0, len(locations)),
+				},
+			},
+		},
+		Offset:     make([]int64, 0, len(locations)),
+		FieldsData: make([]*schemapb.FieldData, len(dataArr[locations[0].ResultIdx].FieldsData)),
+	}
+	for _, loc := range locations {
+		data := dataArr[loc.ResultIdx]
+		ret.Ids.GetIntId().Data = append(ret.Ids.GetIntId().Data, data.Ids.GetIntId().GetData()[loc.RowOffset])
+		ret.Offset = append(ret.Offset, data.Offset[loc.RowOffset])
+		typeutil.AppendFieldData(ret.FieldsData, data.FieldsData, loc.RowOffset)
+	}
+	return ret
+}
+
 func (q *queryCollection) publishQueryResult(msg msgstream.TsMsg, collectionID UniqueID) error {
 	span, ctx := trace.StartSpanFromContext(msg.TraceCtx())
 	defer span.Finish()
@@ -1234,6 +1430,7 @@ func (q *queryCollection) publishFailedQueryResult(msg msgstream.TsMsg, errMsg s
 				ResultChannelID: retrieveMsg.ResultChannelID,
 				Ids:             nil,
 				FieldsData:      nil,
+				ReplicaID:       retrieveMsg.ReplicaID,
 			},
 		}
 		msgPack.Msgs = append(msgPack.Msgs, retrieveResultMsg)
@@ -1246,6 +1443,7 @@ func (q *queryCollection) publishFailedQueryResult(msg msgstream.TsMsg, errMsg s
 				Base:            baseResult,
 				Status:          &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: errMsg},
 				ResultChannelID: searchMsg.ResultChannelID,
+				ReplicaID:       searchMsg.ReplicaID,
 			},
 		}
 		msgPack.Msgs = append(msgPack.Msgs, searchResultMsg)
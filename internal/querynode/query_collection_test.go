@@ -16,6 +16,9 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 )
 
 func TestQueryCollection_withoutVChannel(t *testing.T) {
@@ -56,7 +59,7 @@ func TestQueryCollection_withoutVChannel(t *testing.T) {
 	assert.Nil(t, err)
 
 	//create a streaming
-	streaming := newStreaming(context.Background(), factory, etcdKV)
+	streaming := newStreaming(context.Background(), factory, etcdKV, historical.replica)
 	err = streaming.replica.addCollection(0, schema)
 	assert.Nil(t, err)
 	err = streaming.replica.addPartition(0, 1)
@@ -179,3 +182,99 @@ func TestGetSegmentsByPKs(t *testing.T) {
 	_, err = getSegmentsByPKs([]int64{0, 1, 2, 3, 4}, nil)
 	assert.NotNil(t, err)
 }
+
+func TestGetPlanFieldIDs(t *testing.T) {
+	planNode := &planpb.PlanNode{
+		Node: &planpb.PlanNode_VectorAnns{
+			VectorAnns: &planpb.VectorANNS{
+				FieldId: 100,
+				Predicates: &planpb.Expr{
+					Expr: &planpb.Expr_BinaryExpr{
+						BinaryExpr: &planpb.BinaryExpr{
+							Op: planpb.BinaryExpr_LogicalAnd,
+							Left: &planpb.Expr{
+								Expr: &planpb.Expr_UnaryRangeExpr{
+									UnaryRangeExpr: &planpb.UnaryRangeExpr{
+										ColumnInfo: &planpb.ColumnInfo{FieldId: 101},
+									},
+								},
+							},
+							Right: &planpb.Expr{
+								Expr: &planpb.Expr_UnaryExpr{
+									UnaryExpr: &planpb.UnaryExpr{
+										Op: planpb.UnaryExpr_Not,
+										Child: &planpb.Expr{
+											Expr: &planpb.Expr_TermExpr{
+												TermExpr: &planpb.TermExpr{
+													ColumnInfo: &planpb.ColumnInfo{FieldId: 102},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		OutputFieldIds: []int64{103},
+	}
+	serializedPlan, err := proto.Marshal(planNode)
+	assert.NoError(t, err)
+	fieldIDs, err := getPlanFieldIDs(serializedPlan)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int64{100, 101, 102, 103}, fieldIDs)
+
+	_, err = getPlanFieldIDs([]byte{0xff})
+	assert.Error(t, err)
+}
+
+func TestMergeSortedRetrieveResults(t *testing.T) {
+	genResult := func(ids []int64, values []float64) *segcorepb.RetrieveResults {
+		return &segcorepb.RetrieveResults{
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{Data: ids},
+				},
+			},
+			Offset: ids,
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:    schemapb.DataType_Double,
+					FieldId: 101,
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_DoubleData{
+								DoubleData: &schemapb.DoubleArray{Data: values},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	dataArr := []*segcorepb.RetrieveResults{
+		genResult([]int64{1, 2, 3}, []float64{0.3, 0.9, 0.1}),
+		nil,
+		genResult([]int64{4, 5}, []float64{0.5, 0.2}),
+	}
+	orderBy := []*internalpb.OrderByField{{FieldID: 101, Descending: true}}
+
+	result, err := mergeSortedRetrieveResults(dataArr, orderBy, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2, 4, 1}, result.Ids.GetIntId().Data)
+	assert.Equal(t, []float64{0.9, 0.5, 0.3}, result.FieldsData[0].GetScalars().GetDoubleData().Data)
+
+	result, err = mergeSortedRetrieveResults(dataArr, nil, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3, 4, 5}, result.Ids.GetIntId().Data)
+
+	result, err = mergeSortedRetrieveResults([]*segcorepb.RetrieveResults{nil}, orderBy, 3)
+	assert.NoError(t, err)
+	assert.Nil(t, result.Ids)
+
+	_, err = mergeSortedRetrieveResults(dataArr, []*internalpb.OrderByField{{FieldID: 102}}, 3)
+	assert.Error(t, err)
+}
@@ -29,6 +29,7 @@ import (
 	"errors"
 	"strconv"
 	"sync/atomic"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -88,6 +89,7 @@ func NewQueryNode(ctx context.Context, factory msgstream.Factory) *QueryNode {
 func (node *QueryNode) Register() error {
 	log.Debug("query node session info", zap.String("metaPath", Params.MetaRootPath), zap.Strings("etcdEndPoints", Params.EtcdEndpoints))
 	node.session = sessionutil.NewSession(node.queryNodeLoopCtx, Params.MetaRootPath, Params.EtcdEndpoints)
+	node.session.ResourceGroup = Params.ResourceGroup
 	node.session.Init(typeutil.QueryNodeRole, Params.QueryNodeIP+":"+strconv.FormatInt(Params.QueryNodePort, 10), false)
 	Params.QueryNodeID = node.session.ServerID
 	log.Debug("query nodeID", zap.Int64("nodeID", Params.QueryNodeID))
@@ -121,7 +123,8 @@ func (node *QueryNode) Init() error {
 		node.indexCoord,
 		node.msFactory,
 		node.etcdKV)
-	node.streaming = newStreaming(node.queryNodeLoopCtx, node.msFactory, node.etcdKV)
+	node.streaming = newStreaming(node.queryNodeLoopCtx, node.msFactory, node.etcdKV, node.historical.replica)
+	node.historical.statsService.tSafeReplica = node.streaming.tSafeReplica
 
 	C.SegcoreInit()
 
@@ -164,6 +167,19 @@ func (node *QueryNode) Start() error {
 }
 
 func (node *QueryNode) Stop() error {
+	// announce the stopping first, the query coord moves the segments and channels to other nodes
+	// and releases the collections of this node before it quits
+	code, _ := node.stateCode.Load().(internalpb.StateCode)
+	graceful := node.session != nil && code == internalpb.StateCode_Healthy
+	if graceful {
+		if err := node.session.GoingStop(); err != nil {
+			log.Warn("query node going stop failed", zap.Int64("nodeID", Params.QueryNodeID), zap.Error(err))
+			graceful = false
+		} else {
+			node.waitCollectionsReleased(Params.GracefulStopTimeout)
+		}
+	}
+
 	node.UpdateStateCode(internalpb.StateCode_Abnormal)
 	node.queryNodeLoopCancel()
 
@@ -180,9 +196,31 @@ func (node *QueryNode) Stop() error {
 	if node.queryService != nil {
 		node.queryService.close()
 	}
+	if graceful {
+		node.session.Revoke(time.Second)
+	}
 	return nil
 }
 
+// waitCollectionsReleased waits until all the collections are released from the node, or the timeout expires
+func (node *QueryNode) waitCollectionsReleased(timeout time.Duration) {
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	deadline := time.After(timeout)
+	for {
+		if node.historical.replica.getCollectionNum() == 0 && node.streaming.replica.getCollectionNum() == 0 {
+			log.Debug("all collections are released, query node is stopping", zap.Int64("nodeID", Params.QueryNodeID))
+			return
+		}
+		select {
+		case <-ticker.C:
+		case <-deadline:
+			log.Warn("wait collections released timeout, query node is stopping", zap.Int64("nodeID", Params.QueryNodeID))
+			return
+		}
+	}
+}
+
 func (node *QueryNode) UpdateStateCode(code internalpb.StateCode) {
 	node.stateCode.Store(code)
 }
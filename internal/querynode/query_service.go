@@ -14,7 +14,9 @@ package querynode
 import "C"
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"sync"
 
 	"go.uber.org/zap"
 
@@ -31,7 +33,8 @@ type queryService struct {
 	historical *historical
 	streaming  *streaming
 
-	queryCollections map[UniqueID]*queryCollection
+	queryCollectionMu sync.Mutex // guards queryCollections
+	queryCollections  map[UniqueID]*queryCollection
 
 	factory msgstream.Factory
 
@@ -91,14 +94,24 @@ func newQueryService(ctx context.Context,
 
 func (q *queryService) close() {
 	log.Debug("search service closed")
+	q.queryCollectionMu.Lock()
+	collectionIDs := make([]UniqueID, 0, len(q.queryCollections))
 	for collectionID := range q.queryCollections {
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+	q.queryCollectionMu.Unlock()
+	for _, collectionID := range collectionIDs {
 		q.stopQueryCollection(collectionID)
 	}
+	q.queryCollectionMu.Lock()
 	q.queryCollections = make(map[UniqueID]*queryCollection)
+	q.queryCollectionMu.Unlock()
 	q.cancel()
 }
 
 func (q *queryService) addQueryCollection(collectionID UniqueID) {
+	q.queryCollectionMu.Lock()
+	defer q.queryCollectionMu.Unlock()
 	if _, ok := q.queryCollections[collectionID]; ok {
 		log.Warn("query collection already exists", zap.Any("collectionID", collectionID))
 		return
@@ -118,11 +131,25 @@ func (q *queryService) addQueryCollection(collectionID UniqueID) {
 }
 
 func (q *queryService) hasQueryCollection(collectionID UniqueID) bool {
+	q.queryCollectionMu.Lock()
+	defer q.queryCollectionMu.Unlock()
 	_, ok := q.queryCollections[collectionID]
 	return ok
 }
 
+func (q *queryService) getQueryCollection(collectionID UniqueID) (*queryCollection, error) {
+	q.queryCollectionMu.Lock()
+	defer q.queryCollectionMu.Unlock()
+	qc, ok := q.queryCollections[collectionID]
+	if !ok {
+		return nil, fmt.Errorf("query collection %d not found", collectionID)
+	}
+	return qc, nil
+}
+
 func (q *queryService) stopQueryCollection(collectionID UniqueID) {
+	q.queryCollectionMu.Lock()
+	defer q.queryCollectionMu.Unlock()
 	sc, ok := q.queryCollections[collectionID]
 	if !ok {
 		log.Warn("stopQueryCollection failed, collection doesn't exist", zap.Int64("collectionID", collectionID))
@@ -93,6 +93,13 @@ type Segment struct {
 	vectorFieldInfos map[UniqueID]*VectorFieldInfo
 
 	pkFilter *bloom.BloomFilter //  bloom filter of pk inside a segment
+
+	fieldStats map[UniqueID]*storage.FieldStats // min/max of the scalar fields of a sealed segment, set at loading
+
+	deleteMu   sync.Mutex  // guards deletedIDs, deletedTss and replacedBy
+	deletedIDs []UniqueID  // deletes applied to a growing segment, carried over to the sealed segment at hand off
+	deletedTss []Timestamp // timestamps of deletedIDs
+	replacedBy *Segment    // the sealed segment replacing the growing segment, later deletes are applied to it
 }
 
 //-------------------------------------------------------------------------------------- common interfaces
@@ -120,6 +127,24 @@ func (s *Segment) getIDBinlogRowSizes() []int64 {
 	return s.idBinlogRowSizes
 }
 
+// getFieldStats returns nil if the segment has no complete statistics of the field
+func (s *Segment) getFieldStats(fieldID UniqueID) *storage.FieldStats {
+	return s.fieldStats[fieldID]
+}
+
+// mayContainPKs tests the pk bloom filter of the segment, a segment without the filter may contain any pk
+func (s *Segment) mayContainPKs(pks []int64) bool {
+	if s.pkFilter == nil || len(pks) == 0 {
+		return true
+	}
+	for _, pk := range pks {
+		if s.pkFilter.Test(storage.PrimaryKeyBytes(pk)) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Segment) setRecentlyModified(modify bool) {
 	s.rmMutex.Lock()
 	defer s.rmMutex.Unlock()
@@ -671,6 +696,46 @@ func (s *Segment) segmentDelete(offset int64, entityIDs *[]UniqueID, timestamps
 	return nil
 }
 
+// applyDelete deletes the entities from the segment, the deletes of a growing segment are recorded
+// so that they survive the hand off, and the deletes of a handed off segment go to its sealed segment
+func (s *Segment) applyDelete(entityIDs []UniqueID, timestamps []Timestamp) error {
+	s.deleteMu.Lock()
+	if s.replacedBy != nil {
+		sealedSegment := s.replacedBy
+		s.deleteMu.Unlock()
+		return sealedSegment.applyDelete(entityIDs, timestamps)
+	}
+	defer s.deleteMu.Unlock()
+
+	offset := s.segmentPreDelete(len(entityIDs))
+	err := s.segmentDelete(offset, &entityIDs, &timestamps)
+	if err != nil {
+		return err
+	}
+	if s.getType() == segmentTypeGrowing {
+		s.deletedIDs = append(s.deletedIDs, entityIDs...)
+		s.deletedTss = append(s.deletedTss, timestamps...)
+	}
+	return nil
+}
+
+// handOff carries the deletes applied to the growing segment over to the sealed segment replacing it,
+// the deletes applied afterwards are redirected to the sealed segment
+func (s *Segment) handOff(sealedSegment *Segment) error {
+	s.deleteMu.Lock()
+	defer s.deleteMu.Unlock()
+	if len(s.deletedIDs) > 0 {
+		err := sealedSegment.applyDelete(s.deletedIDs, s.deletedTss)
+		if err != nil {
+			return err
+		}
+	}
+	s.replacedBy = sealedSegment
+	s.deletedIDs = nil
+	s.deletedTss = nil
+	return nil
+}
+
 //-------------------------------------------------------------------------------------- interfaces for sealed segment
 func (s *Segment) segmentLoadFieldData(fieldID int64, rowCount int, data interface{}) error {
 	/*
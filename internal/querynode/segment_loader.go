@@ -15,20 +15,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
 
+	"github.com/bits-and-blooms/bloom/v3"
 	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 
-	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	minioKV "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/rootcoord"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 )
 
 const (
@@ -36,20 +39,70 @@ const (
 	queryNodeSegmentMetaPrefix  = "queryNode-segmentMeta"
 )
 
+// errOutOfMemory rejects the load requests which would take the memory usage above the threshold
+var errOutOfMemory = errors.New("out of memory")
+
 // segmentLoader is only responsible for loading the field data from binlog
 type segmentLoader struct {
+	reservedMemory uint64 // estimated bytes of the segments being loaded, accessed atomically
+
 	historicalReplica ReplicaInterface
 
 	dataCoord types.DataCoord
 
-	minioKV kv.BaseKV // minio minioKV
+	minioKV *minioKV.MinIOKV
 	etcdKV  *etcdkv.EtcdKV
 
 	indexLoader *indexLoader
 }
 
-func (loader *segmentLoader) loadSegmentOfConditionHandOff(req *querypb.LoadSegmentsRequest) error {
-	return errors.New("TODO: implement hand off")
+// loadSegmentOfConditionHandOff loads the flushed segments as sealed segments, which replace the growing
+// segments of the same ids in the streaming replica, the segments not growing on this node are loaded
+// into the historical replica instead
+func (loader *segmentLoader) loadSegmentOfConditionHandOff(req *querypb.LoadSegmentsRequest, streamingReplica ReplicaInterface) error {
+	historicalInfos := make([]*querypb.SegmentLoadInfo, 0)
+	for _, info := range req.Infos {
+		segmentID := info.SegmentID
+		growingSegment, err := streamingReplica.getSegmentByID(segmentID)
+		if err != nil {
+			historicalInfos = append(historicalInfos, info)
+			continue
+		}
+		if growingSegment.getType() != segmentTypeGrowing {
+			// the segment has been handed off
+			continue
+		}
+
+		collection, err := streamingReplica.getCollectionByID(info.CollectionID)
+		if err != nil {
+			return err
+		}
+		segment := newSegment(collection, segmentID, info.PartitionID, info.CollectionID, growingSegment.vChannelID, segmentTypeSealed, true)
+		err = loader.loadSegmentInternal(info.CollectionID, segment, info)
+		if err != nil {
+			deleteSegment(segment)
+			log.Warn(err.Error())
+			return err
+		}
+		err = loader.saveSegmentInfo(segment)
+		if err != nil {
+			deleteSegment(segment)
+			return err
+		}
+		err = streamingReplica.replaceGrowingSegmentBySealedSegment(segment)
+		if err != nil {
+			log.Warn(err.Error())
+			return err
+		}
+		log.Debug("hand off segment done", zap.Int64("segmentID", segmentID), zap.String("vChannel", growingSegment.vChannelID))
+	}
+
+	if len(historicalInfos) > 0 {
+		historicalReq := proto.Clone(req).(*querypb.LoadSegmentsRequest)
+		historicalReq.Infos = historicalInfos
+		return loader.loadSegment(historicalReq, true)
+	}
+	return loader.indexLoader.sendQueryNodeStats()
 }
 
 func (loader *segmentLoader) loadSegmentOfConditionLoadBalance(req *querypb.LoadSegmentsRequest) error {
@@ -69,6 +122,12 @@ func (loader *segmentLoader) loadSegment(req *querypb.LoadSegmentsRequest, onSer
 	if len(req.Infos) == 0 {
 		return nil
 	}
+	loadingSize, err := loader.checkSegmentSize(req.Infos)
+	if err != nil {
+		log.Warn(err.Error())
+		return err
+	}
+	defer loader.releaseMemory(loadingSize)
 
 	newSegments := make([]*Segment, 0)
 	segmentGC := func() {
@@ -107,28 +166,9 @@ func (loader *segmentLoader) loadSegment(req *querypb.LoadSegmentsRequest, onSer
 			return err
 		}
 		if onService {
-			key := fmt.Sprintf("%s/%d", queryCoordSegmentMetaPrefix, segmentID)
-			value, err := loader.etcdKV.Load(key)
-			if err != nil {
-				deleteSegment(segment)
-				log.Warn("error when load segment info from etcd", zap.Any("error", err.Error()))
-				segmentGC()
-				return err
-			}
-			segmentInfo := &querypb.SegmentInfo{}
-			err = proto.UnmarshalText(value, segmentInfo)
-			if err != nil {
-				deleteSegment(segment)
-				log.Warn("error when unmarshal segment info from etcd", zap.Any("error", err.Error()))
-				segmentGC()
-				return err
-			}
-			segmentInfo.SegmentState = querypb.SegmentState_sealed
-			newKey := fmt.Sprintf("%s/%d", queryNodeSegmentMetaPrefix, segmentID)
-			err = loader.etcdKV.Save(newKey, proto.MarshalTextString(segmentInfo))
+			err = loader.saveSegmentInfo(segment)
 			if err != nil {
 				deleteSegment(segment)
-				log.Warn("error when update segment info to etcd", zap.Any("error", err.Error()))
 				segmentGC()
 				return err
 			}
@@ -141,6 +181,130 @@ func (loader *segmentLoader) loadSegment(req *querypb.LoadSegmentsRequest, onSer
 	return loader.indexLoader.sendQueryNodeStats()
 }
 
+// checkSegmentSize rejects the segments before any of them is loaded, if their estimated memory together with
+// the memory reserved by the concurrent loads takes the memory usage of the node above the threshold of its
+// capacity. Otherwise the estimated memory is reserved, which the caller releases after loading the segments.
+func (loader *segmentLoader) checkSegmentSize(infos []*querypb.SegmentLoadInfo) (uint64, error) {
+	memCapacity := Params.MemoryCapacity
+	if memCapacity == 0 {
+		log.Warn("memory capacity of the query node is unknown, skip checking the segment size")
+		return 0, nil
+	}
+	var loadingSize uint64
+	for _, info := range infos {
+		segmentSize, err := loader.estimateSegmentSize(info)
+		if err != nil {
+			return 0, err
+		}
+		loadingSize += uint64(segmentSize)
+	}
+	reserved := atomic.AddUint64(&loader.reservedMemory, loadingSize)
+	usedMem := metricsinfo.GetUsedMemory()
+	if float64(usedMem+reserved) > float64(memCapacity)*Params.LoadMemoryUsageThreshold {
+		loader.releaseMemory(loadingSize)
+		return 0, fmt.Errorf("%w: query node %d uses %d bytes of memory and reserves %d bytes for loading, loading %d segments of %d bytes exceeds %.0f%% of the capacity %d bytes",
+			errOutOfMemory, Params.QueryNodeID, usedMem, reserved-loadingSize, len(infos), loadingSize, Params.LoadMemoryUsageThreshold*100, memCapacity)
+	}
+	return loadingSize, nil
+}
+
+// releaseMemory releases the memory reserved by checkSegmentSize
+func (loader *segmentLoader) releaseMemory(size uint64) {
+	atomic.AddUint64(&loader.reservedMemory, ^(size - 1))
+}
+
+// estimateSegmentSize approximates the memory of the segment once loaded by the sizes of its index files
+// and of the binlogs of the fields without index
+func (loader *segmentLoader) estimateSegmentSize(info *querypb.SegmentLoadInfo) (int64, error) {
+	vectorFieldIDs, unloadedFieldIDs, err := loader.getLoadFieldIDs(info.CollectionID)
+	if err != nil {
+		return 0, err
+	}
+
+	var segmentSize int64
+	indexedFieldIDs := make([]int64, 0)
+	indexInfo, err := loader.indexLoader.getIndexInfo(info.CollectionID, info.SegmentID)
+	if err == nil {
+		var indexSize int64
+		for _, indexPath := range indexInfo.indexPaths {
+			size, err := loader.minioKV.GetObjectSize(indexPath)
+			if err != nil {
+				return 0, err
+			}
+			indexSize += size
+		}
+		// the index is loaded for every vector field, the same as loadSegmentInternal
+		segmentSize += indexSize * int64(len(vectorFieldIDs))
+		indexedFieldIDs = vectorFieldIDs
+	}
+
+	skipFieldIDs := append(indexedFieldIDs, unloadedFieldIDs...)
+	for _, fieldBinlog := range loader.filterFieldBinlogs(info.BinlogPaths, skipFieldIDs) {
+		for _, binlogPath := range fieldBinlog.Binlogs {
+			size, err := loader.minioKV.GetObjectSize(binlogPath)
+			if err != nil {
+				return 0, err
+			}
+			segmentSize += size
+		}
+	}
+	return segmentSize, nil
+}
+
+// saveSegmentInfo marks the loaded segment sealed in the segment info watched by the query coord
+func (loader *segmentLoader) saveSegmentInfo(segment *Segment) error {
+	segmentID := segment.ID()
+	key := fmt.Sprintf("%s/%d", queryCoordSegmentMetaPrefix, segmentID)
+	value, err := loader.etcdKV.Load(key)
+	if err != nil {
+		log.Warn("error when load segment info from etcd", zap.Any("error", err.Error()))
+		return err
+	}
+	segmentInfo := &querypb.SegmentInfo{}
+	err = proto.UnmarshalText(value, segmentInfo)
+	if err != nil {
+		log.Warn("error when unmarshal segment info from etcd", zap.Any("error", err.Error()))
+		return err
+	}
+	segmentInfo.SegmentState = querypb.SegmentState_sealed
+	// the actual memory usage, including the loaded indexes, replaces the estimation of the query coord
+	segmentInfo.MemSize = segment.getMemSize()
+	segmentInfo.NumRows = segment.getRowCount()
+	newKey := fmt.Sprintf("%s/%d", queryNodeSegmentMetaPrefix, segmentID)
+	err = loader.etcdKV.Save(newKey, proto.MarshalTextString(segmentInfo))
+	if err != nil {
+		log.Warn("error when update segment info to etcd", zap.Any("error", err.Error()))
+		return err
+	}
+	return nil
+}
+
+// getLoadFieldIDs returns the vector fields to load and the fields not to load of the collection
+func (loader *segmentLoader) getLoadFieldIDs(collectionID UniqueID) ([]int64, []int64, error) {
+	collection, err := loader.historicalReplica.getCollectionByID(collectionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	vectorFieldIDs, err := loader.historicalReplica.getVecFieldIDsByCollectionID(collectionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	loadedVectorFieldIDs := make([]int64, 0)
+	for _, fieldID := range vectorFieldIDs {
+		if collection.isFieldLoaded(fieldID) {
+			loadedVectorFieldIDs = append(loadedVectorFieldIDs, fieldID)
+		}
+	}
+	unloadedFieldIDs := make([]int64, 0)
+	for _, field := range collection.schema.Fields {
+		if !collection.isFieldLoaded(field.FieldID) {
+			unloadedFieldIDs = append(unloadedFieldIDs, field.FieldID)
+		}
+	}
+	return loadedVectorFieldIDs, unloadedFieldIDs, nil
+}
+
 func (loader *segmentLoader) loadSegmentInternal(collectionID UniqueID, segment *Segment, segmentLoadInfo *querypb.SegmentLoadInfo) error {
 	vectorFieldIDs, err := loader.historicalReplica.getVecFieldIDsByCollectionID(collectionID)
 	if err != nil {
@@ -149,6 +313,10 @@ func (loader *segmentLoader) loadSegmentInternal(collectionID UniqueID, segment
 	if len(vectorFieldIDs) <= 0 {
 		return fmt.Errorf("no vector field in collection %d", collectionID)
 	}
+	vectorFieldIDs, unloadedFieldIDs, err := loader.getLoadFieldIDs(collectionID)
+	if err != nil {
+		return err
+	}
 
 	// add VectorFieldInfo for vector fields
 	for _, fieldBinlog := range segmentLoadInfo.BinlogPaths {
@@ -168,8 +336,9 @@ func (loader *segmentLoader) loadSegmentInternal(collectionID UniqueID, segment
 		indexedFieldIDs = append(indexedFieldIDs, vecFieldID)
 	}
 
-	// we don't need to load raw data for indexed vector field
-	fieldBinlogs := loader.filterFieldBinlogs(segmentLoadInfo.BinlogPaths, indexedFieldIDs)
+	// we don't need to load raw data for indexed vector field, nor for the fields not to load
+	skipFieldIDs := append(indexedFieldIDs, unloadedFieldIDs...)
+	fieldBinlogs := loader.filterFieldBinlogs(segmentLoadInfo.BinlogPaths, skipFieldIDs)
 
 	log.Debug("loading insert...")
 	err = loader.loadSegmentFieldsData(segment, fieldBinlogs)
@@ -184,6 +353,116 @@ func (loader *segmentLoader) loadSegmentInternal(collectionID UniqueID, segment
 		}
 	}
 
+	log.Debug("loading stats...")
+	err = loader.loadFieldStats(segment, segmentLoadInfo)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("loading deltalogs...")
+	return loader.loadDeltaLogs(segment, segmentLoadInfo.Deltalogs)
+}
+
+// loadDeltaLogs applies the deletes written into the delta logs by the data node to a sealed segment
+func (loader *segmentLoader) loadDeltaLogs(segment *Segment, deltaLogs []string) error {
+	if len(deltaLogs) == 0 {
+		return nil
+	}
+	dCodec := storage.NewDeleteCodec()
+	defer func() {
+		err := dCodec.Close()
+		if err != nil {
+			log.Warn(err.Error())
+		}
+	}()
+	for _, path := range deltaLogs {
+		value, err := loader.minioKV.Load(path)
+		if err != nil {
+			return err
+		}
+		_, _, deleteData, err := dCodec.Deserialize([]*storage.Blob{{Key: path, Value: []byte(value)}})
+		if err != nil {
+			return err
+		}
+		log.Debug("load segment delta log",
+			zap.Int64("segmentID", segment.segmentID),
+			zap.String("path", path),
+			zap.Int("numDeletes", len(deleteData.Pks)),
+		)
+		if len(deleteData.Pks) == 0 {
+			continue
+		}
+		offset := segment.segmentPreDelete(len(deleteData.Pks))
+		err = segment.segmentDelete(offset, &deleteData.Pks, &deleteData.Tss)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadFieldStats loads the field statistics and the pk bloom filter of a sealed segment from the stats binlogs
+// written by the data node. The statistics of a field are only used if every insert binlog of the field has
+// its stats binlog, the binlogs flushed by older data nodes carry no or partial statistics.
+func (loader *segmentLoader) loadFieldStats(segment *Segment, segmentLoadInfo *querypb.SegmentLoadInfo) error {
+	binlogNum := make(map[UniqueID]int)
+	for _, fieldBinlog := range segmentLoadInfo.BinlogPaths {
+		binlogNum[fieldBinlog.FieldID] += len(fieldBinlog.Binlogs)
+	}
+
+	fieldStats := make(map[UniqueID]*storage.FieldStats)
+	for _, fieldStatslog := range segmentLoadInfo.Statslogs {
+		fieldID := fieldStatslog.FieldID
+		if len(fieldStatslog.Binlogs) == 0 || len(fieldStatslog.Binlogs) != binlogNum[fieldID] {
+			continue
+		}
+		merged := &storage.FieldStats{}
+		complete := true
+		var pkFilter *bloom.BloomFilter
+		for i, path := range fieldStatslog.Binlogs {
+			value, err := loader.minioKV.Load(path)
+			if err != nil {
+				return err
+			}
+			statsReader := &storage.StatsReader{}
+			statsReader.SetBuffer([]byte(value))
+			stats, err := statsReader.GetFieldStats()
+			if err != nil {
+				return err
+			}
+
+			// the bloom filter is only used if every binlog of the primary key has one
+			if stats.BF != nil && (i == 0 || pkFilter != nil) {
+				if pkFilter == nil {
+					pkFilter = stats.BF
+				} else if err = pkFilter.Merge(stats.BF); err != nil {
+					return err
+				}
+			} else {
+				pkFilter = nil
+			}
+			stats.BF = nil
+
+			// the stats flushed by older data nodes have no data type nor row count
+			if !complete || stats.DataType == schemapb.DataType_None {
+				complete = false
+				continue
+			}
+			if i == 0 {
+				merged.DataType = stats.DataType
+			}
+			if err = merged.Merge(stats); err != nil {
+				return err
+			}
+		}
+		if pkFilter != nil {
+			segment.pkFilter = pkFilter
+		}
+		if complete {
+			fieldStats[fieldID] = merged
+		}
+	}
+	segment.fieldStats = fieldStats
 	return nil
 }
 
@@ -0,0 +1,51 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSegmentLoader_checkSegmentSize(t *testing.T) {
+	memCapacity := Params.MemoryCapacity
+	defer func() {
+		Params.MemoryCapacity = memCapacity
+	}()
+	loader := &segmentLoader{}
+
+	// the memory used by the test process exceeds the threshold of 1 byte
+	Params.MemoryCapacity = 1
+	_, err := loader.checkSegmentSize(nil)
+	assert.True(t, errors.Is(err, errOutOfMemory))
+	assert.Equal(t, uint64(0), loader.reservedMemory)
+
+	// the memory reserved by the concurrent loads counts
+	Params.MemoryCapacity = math.MaxInt64
+	loadingSize, err := loader.checkSegmentSize(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), loadingSize)
+	loader.reservedMemory = math.MaxInt64
+	_, err = loader.checkSegmentSize(nil)
+	assert.True(t, errors.Is(err, errOutOfMemory))
+	assert.Equal(t, uint64(math.MaxInt64), loader.reservedMemory)
+	loader.releaseMemory(math.MaxInt64)
+	assert.Equal(t, uint64(0), loader.reservedMemory)
+
+	// the check is skipped if the capacity is unknown
+	Params.MemoryCapacity = 0
+	_, err = loader.checkSegmentSize(nil)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,189 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"math"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// canSkipSegment returns true if the field statistics of the sealed segment prove that no row of the segment
+// satisfies the predicates, it returns false whenever the statistics can't tell
+func canSkipSegment(segment *Segment, expr *planpb.Expr) bool {
+	if expr == nil {
+		return false
+	}
+	switch e := expr.Expr.(type) {
+	case *planpb.Expr_BinaryExpr:
+		switch e.BinaryExpr.GetOp() {
+		case planpb.BinaryExpr_LogicalAnd:
+			return canSkipSegment(segment, e.BinaryExpr.GetLeft()) || canSkipSegment(segment, e.BinaryExpr.GetRight())
+		case planpb.BinaryExpr_LogicalOr:
+			return canSkipSegment(segment, e.BinaryExpr.GetLeft()) && canSkipSegment(segment, e.BinaryExpr.GetRight())
+		}
+	case *planpb.Expr_TermExpr:
+		return canSkipTerm(segment, e.TermExpr)
+	case *planpb.Expr_UnaryRangeExpr:
+		return canSkipUnaryRange(segment, e.UnaryRangeExpr)
+	case *planpb.Expr_BinaryRangeExpr:
+		return canSkipBinaryRange(segment, e.BinaryRangeExpr)
+	}
+	return false
+}
+
+func canSkipTerm(segment *Segment, expr *planpb.TermExpr) bool {
+	column := expr.GetColumnInfo()
+	stats := segment.getFieldStats(column.GetFieldId())
+	for _, value := range expr.GetValues() {
+		if stats != nil {
+			cmpMin, cmpMax, ok := compareWithStats(stats, value)
+			if !ok {
+				return false
+			}
+			if cmpMin < 0 || cmpMax > 0 {
+				continue
+			}
+		}
+		if pk, ok := value.GetVal().(*planpb.GenericValue_Int64Val); ok && column.GetIsPrimaryKey() {
+			if !segment.mayContainPKs([]int64{pk.Int64Val}) {
+				continue
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func canSkipUnaryRange(segment *Segment, expr *planpb.UnaryRangeExpr) bool {
+	stats := segment.getFieldStats(expr.GetColumnInfo().GetFieldId())
+	if stats == nil {
+		return false
+	}
+	cmpMin, cmpMax, ok := compareWithStats(stats, expr.GetValue())
+	if !ok {
+		return false
+	}
+	switch expr.GetOp() {
+	case planpb.OpType_GreaterThan:
+		return cmpMax >= 0
+	case planpb.OpType_GreaterEqual:
+		return cmpMax > 0
+	case planpb.OpType_LessThan:
+		return cmpMin <= 0
+	case planpb.OpType_LessEqual:
+		return cmpMin < 0
+	case planpb.OpType_Equal:
+		return cmpMin < 0 || cmpMax > 0
+	case planpb.OpType_NotEqual:
+		return cmpMin == 0 && cmpMax == 0
+	}
+	return false
+}
+
+func canSkipBinaryRange(segment *Segment, expr *planpb.BinaryRangeExpr) bool {
+	stats := segment.getFieldStats(expr.GetColumnInfo().GetFieldId())
+	if stats == nil {
+		return false
+	}
+	_, lowerCmpMax, ok := compareWithStats(stats, expr.GetLowerValue())
+	if !ok {
+		return false
+	}
+	upperCmpMin, _, ok := compareWithStats(stats, expr.GetUpperValue())
+	if !ok {
+		return false
+	}
+	if lowerCmpMax > 0 || (lowerCmpMax == 0 && !expr.GetLowerInclusive()) {
+		return true
+	}
+	if upperCmpMin < 0 || (upperCmpMin == 0 && !expr.GetUpperInclusive()) {
+		return true
+	}
+	return false
+}
+
+// compareWithStats compares the value with the min and the max of the field statistics,
+// ok is false if the value can't be compared with the field exactly
+func compareWithStats(stats *storage.FieldStats, value *planpb.GenericValue) (cmpMin int, cmpMax int, ok bool) {
+	switch stats.DataType {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16,
+		schemapb.DataType_Int32, schemapb.DataType_Int64:
+		var v int64
+		switch val := value.GetVal().(type) {
+		case *planpb.GenericValue_Int64Val:
+			v = val.Int64Val
+		case *planpb.GenericValue_BoolVal:
+			if val.BoolVal {
+				v = 1
+			}
+		default:
+			return 0, 0, false
+		}
+		// segcore casts the value to the type of the field, an out of range value wraps around
+		if !inIntRange(stats.DataType, v) {
+			return 0, 0, false
+		}
+		return compareInt64(v, stats.Min), compareInt64(v, stats.Max), true
+	case schemapb.DataType_Float, schemapb.DataType_Double:
+		var v float64
+		switch val := value.GetVal().(type) {
+		case *planpb.GenericValue_Int64Val:
+			v = float64(val.Int64Val)
+		case *planpb.GenericValue_FloatVal:
+			v = val.FloatVal
+		default:
+			return 0, 0, false
+		}
+		// segcore compares a float field with the value rounded to float32
+		if stats.DataType == schemapb.DataType_Float {
+			v = float64(float32(v))
+		}
+		return compareFloat64(v, stats.FloatMin), compareFloat64(v, stats.FloatMax), true
+	}
+	return 0, 0, false
+}
+
+// inIntRange returns true if v is representable by the integer type
+func inIntRange(dataType schemapb.DataType, v int64) bool {
+	switch dataType {
+	case schemapb.DataType_Int8:
+		return v >= math.MinInt8 && v <= math.MaxInt8
+	case schemapb.DataType_Int16:
+		return v >= math.MinInt16 && v <= math.MaxInt16
+	case schemapb.DataType_Int32:
+		return v >= math.MinInt32 && v <= math.MaxInt32
+	}
+	return true
+}
+
+func compareInt64(a, b int64) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+func compareFloat64(a, b float64) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
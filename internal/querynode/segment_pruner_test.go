@@ -0,0 +1,160 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package querynode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func TestCanSkipSegment(t *testing.T) {
+	const (
+		pkFieldID    = UniqueID(100)
+		tsFieldID    = UniqueID(101)
+		scoreFieldID = UniqueID(102)
+		noStatsID    = UniqueID(103)
+		int8FieldID  = UniqueID(104)
+		floatFieldID = UniqueID(105)
+	)
+	sw := &storage.StatsWriter{}
+	err := sw.StatsPrimaryKey([]int64{10, 20, 30})
+	assert.NoError(t, err)
+	sr := &storage.StatsReader{}
+	sr.SetBuffer(sw.GetBuffer())
+	pkStats, err := sr.GetFieldStats()
+	assert.NoError(t, err)
+
+	segment := &Segment{
+		pkFilter: pkStats.BF,
+		fieldStats: map[UniqueID]*storage.FieldStats{
+			pkFieldID:    pkStats,
+			tsFieldID:    {DataType: schemapb.DataType_Int64, RowCount: 3, Min: 1600000000, Max: 1650000000},
+			scoreFieldID: {DataType: schemapb.DataType_Double, RowCount: 3, FloatMin: 0.5, FloatMax: 2.5},
+			int8FieldID:  {DataType: schemapb.DataType_Int8, RowCount: 3, Min: -10, Max: 10},
+			floatFieldID: {DataType: schemapb.DataType_Float, RowCount: 3, FloatMin: float64(float32(0.1)), FloatMax: float64(float32(0.3))},
+		},
+	}
+
+	intValue := func(v int64) *planpb.GenericValue {
+		return &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: v}}
+	}
+	floatValue := func(v float64) *planpb.GenericValue {
+		return &planpb.GenericValue{Val: &planpb.GenericValue_FloatVal{FloatVal: v}}
+	}
+	unaryRange := func(fieldID UniqueID, op planpb.OpType, value *planpb.GenericValue) *planpb.Expr {
+		return &planpb.Expr{Expr: &planpb.Expr_UnaryRangeExpr{UnaryRangeExpr: &planpb.UnaryRangeExpr{
+			ColumnInfo: &planpb.ColumnInfo{FieldId: fieldID},
+			Op:         op,
+			Value:      value,
+		}}}
+	}
+	binaryRange := func(fieldID UniqueID, lower, upper *planpb.GenericValue, lowerInclusive, upperInclusive bool) *planpb.Expr {
+		return &planpb.Expr{Expr: &planpb.Expr_BinaryRangeExpr{BinaryRangeExpr: &planpb.BinaryRangeExpr{
+			ColumnInfo:     &planpb.ColumnInfo{FieldId: fieldID},
+			LowerInclusive: lowerInclusive,
+			UpperInclusive: upperInclusive,
+			LowerValue:     lower,
+			UpperValue:     upper,
+		}}}
+	}
+	term := func(fieldID UniqueID, isPrimaryKey bool, values ...*planpb.GenericValue) *planpb.Expr {
+		return &planpb.Expr{Expr: &planpb.Expr_TermExpr{TermExpr: &planpb.TermExpr{
+			ColumnInfo: &planpb.ColumnInfo{FieldId: fieldID, IsPrimaryKey: isPrimaryKey},
+			Values:     values,
+		}}}
+	}
+	binary := func(op planpb.BinaryExpr_BinaryOp, left, right *planpb.Expr) *planpb.Expr {
+		return &planpb.Expr{Expr: &planpb.Expr_BinaryExpr{BinaryExpr: &planpb.BinaryExpr{Op: op, Left: left, Right: right}}}
+	}
+
+	cases := []struct {
+		name string
+		expr *planpb.Expr
+		skip bool
+	}{
+		{"no predicates", nil, false},
+		{"ts > 1700000000", unaryRange(tsFieldID, planpb.OpType_GreaterThan, intValue(1700000000)), true},
+		{"ts > 1650000000", unaryRange(tsFieldID, planpb.OpType_GreaterThan, intValue(1650000000)), true},
+		{"ts >= 1650000000", unaryRange(tsFieldID, planpb.OpType_GreaterEqual, intValue(1650000000)), false},
+		{"ts < 1600000000", unaryRange(tsFieldID, planpb.OpType_LessThan, intValue(1600000000)), true},
+		{"ts <= 1600000000", unaryRange(tsFieldID, planpb.OpType_LessEqual, intValue(1600000000)), false},
+		{"ts == 1", unaryRange(tsFieldID, planpb.OpType_Equal, intValue(1)), true},
+		{"ts != 1", unaryRange(tsFieldID, planpb.OpType_NotEqual, intValue(1)), false},
+		{"ts > 1.5", unaryRange(tsFieldID, planpb.OpType_GreaterThan, floatValue(1.5)), false},
+		{"score > 3", unaryRange(scoreFieldID, planpb.OpType_GreaterThan, intValue(3)), true},
+		{"score < 0.5", unaryRange(scoreFieldID, planpb.OpType_LessThan, floatValue(0.5)), true},
+		{"score < 1.0", unaryRange(scoreFieldID, planpb.OpType_LessThan, floatValue(1.0)), false},
+		{"float < 0.1", unaryRange(floatFieldID, planpb.OpType_LessThan, floatValue(0.1)), true},
+		{"float <= 0.1", unaryRange(floatFieldID, planpb.OpType_LessEqual, floatValue(0.1)), false},
+		{"float == 0.3", unaryRange(floatFieldID, planpb.OpType_Equal, floatValue(0.3)), false},
+		{"float > 0.3", unaryRange(floatFieldID, planpb.OpType_GreaterThan, floatValue(0.3)), true},
+		{"int8 > 100", unaryRange(int8FieldID, planpb.OpType_GreaterThan, intValue(100)), true},
+		{"int8 > 256", unaryRange(int8FieldID, planpb.OpType_GreaterThan, intValue(256)), false},
+		{"int8 == 266", unaryRange(int8FieldID, planpb.OpType_Equal, intValue(266)), false},
+		{"int8 in [-129]", term(int8FieldID, false, intValue(-129)), false},
+		{"field without stats", unaryRange(noStatsID, planpb.OpType_GreaterThan, intValue(1)), false},
+		{"2.5 < score < 3", binaryRange(scoreFieldID, floatValue(2.5), floatValue(3), false, false), true},
+		{"2.5 <= score < 3", binaryRange(scoreFieldID, floatValue(2.5), floatValue(3), true, false), false},
+		{"0 < score < 0.5", binaryRange(scoreFieldID, floatValue(0), floatValue(0.5), false, false), true},
+		{"0 < score <= 0.5", binaryRange(scoreFieldID, floatValue(0), floatValue(0.5), false, true), false},
+		{"pk in [1, 100]", term(pkFieldID, true, intValue(1), intValue(100)), true},
+		{"pk in [15]", term(pkFieldID, true, intValue(15)), true},
+		{"pk in [15, 20]", term(pkFieldID, true, intValue(15), intValue(20)), false},
+		{"ts in [1, 1600000001]", term(tsFieldID, false, intValue(1), intValue(1600000001)), false},
+		{"ts in [1, 2]", term(tsFieldID, false, intValue(1), intValue(2)), true},
+		{"field without stats in [1]", term(noStatsID, false, intValue(1)), false},
+		{"ts > 1700000000 && score > 1", binary(planpb.BinaryExpr_LogicalAnd,
+			unaryRange(tsFieldID, planpb.OpType_GreaterThan, intValue(1700000000)),
+			unaryRange(scoreFieldID, planpb.OpType_GreaterThan, intValue(1))), true},
+		{"ts > 1700000000 || score > 1", binary(planpb.BinaryExpr_LogicalOr,
+			unaryRange(tsFieldID, planpb.OpType_GreaterThan, intValue(1700000000)),
+			unaryRange(scoreFieldID, planpb.OpType_GreaterThan, intValue(1))), false},
+		{"ts > 1700000000 || score > 3", binary(planpb.BinaryExpr_LogicalOr,
+			unaryRange(tsFieldID, planpb.OpType_GreaterThan, intValue(1700000000)),
+			unaryRange(scoreFieldID, planpb.OpType_GreaterThan, intValue(3))), true},
+		{"not ts > 1700000000", &planpb.Expr{Expr: &planpb.Expr_UnaryExpr{UnaryExpr: &planpb.UnaryExpr{
+			Op:    planpb.UnaryExpr_Not,
+			Child: unaryRange(tsFieldID, planpb.OpType_GreaterThan, intValue(1700000000)),
+		}}}, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.skip, canSkipSegment(segment, c.expr))
+		})
+	}
+
+	// a segment without statistics is never skipped
+	assert.False(t, canSkipSegment(&Segment{}, unaryRange(tsFieldID, planpb.OpType_GreaterThan, intValue(1700000000))))
+	assert.False(t, canSkipSegment(&Segment{}, term(pkFieldID, true, intValue(1))))
+}
+
+func TestGenPrimaryKeyTermExpr(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	ids := &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2}}}}
+	expr := genPrimaryKeyTermExpr(schema, ids)
+	assert.NotNil(t, expr)
+	assert.Equal(t, int64(100), expr.GetTermExpr().GetColumnInfo().GetFieldId())
+	assert.True(t, expr.GetTermExpr().GetColumnInfo().GetIsPrimaryKey())
+	assert.Equal(t, 2, len(expr.GetTermExpr().GetValues()))
+
+	assert.Nil(t, genPrimaryKeyTermExpr(schema, nil))
+}
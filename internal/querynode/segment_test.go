@@ -25,6 +25,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/internal/storage"
 )
 
 //-------------------------------------------------------------------------------------- constructor and destructor
@@ -362,6 +363,41 @@ func TestSegment_segmentDelete(t *testing.T) {
 	deleteCollection(collection)
 }
 
+func TestSegment_handOff(t *testing.T) {
+	collectionID := UniqueID(0)
+	collectionMeta := genTestCollectionMeta(collectionID, false)
+	collection := newCollection(collectionMeta.ID, collectionMeta.Schema)
+	defer deleteCollection(collection)
+
+	segmentID := UniqueID(0)
+	growingSegment := newSegment(collection, segmentID, defaultPartitionID, collectionID, "", segmentTypeGrowing, true)
+	sealedSegment := newSegment(collection, segmentID, defaultPartitionID, collectionID, "", segmentTypeSealed, true)
+	defer deleteSegment(sealedSegment)
+
+	// the deletes of the growing segment are recorded
+	err := growingSegment.applyDelete([]UniqueID{1, 2}, []Timestamp{10, 10})
+	assert.NoError(t, err)
+	assert.Equal(t, []UniqueID{1, 2}, growingSegment.deletedIDs)
+	assert.Equal(t, []Timestamp{10, 10}, growingSegment.deletedTss)
+
+	// and carried over to the sealed segment
+	err = growingSegment.handOff(sealedSegment)
+	assert.NoError(t, err)
+	assert.Nil(t, growingSegment.deletedIDs)
+	assert.Equal(t, sealedSegment, growingSegment.replacedBy)
+	deleteSegment(growingSegment)
+
+	// the deletes applied to the released growing segment after the hand off go to the sealed segment
+	err = growingSegment.applyDelete([]UniqueID{3}, []Timestamp{20})
+	assert.NoError(t, err)
+	assert.Nil(t, sealedSegment.deletedIDs)
+
+	// the deletes of a released segment which isn't handed off fail
+	deleteSegment(sealedSegment)
+	err = sealedSegment.applyDelete([]UniqueID{4}, []Timestamp{30})
+	assert.Error(t, err)
+}
+
 func TestSegment_segmentSearch(t *testing.T) {
 	collectionID := UniqueID(0)
 	collectionMeta := genTestCollectionMeta(collectionID, false)
@@ -610,3 +646,22 @@ func TestSegment_ConcurrentOperation(t *testing.T) {
 	wg.Wait()
 	deleteCollection(collection)
 }
+
+func TestSegment_mayContainPKs(t *testing.T) {
+	segment := &Segment{}
+	// a segment without pk filter may contain any pk
+	assert.True(t, segment.mayContainPKs([]int64{1}))
+
+	sw := &storage.StatsWriter{}
+	err := sw.StatsPrimaryKey([]int64{1, 2, 3})
+	assert.NoError(t, err)
+	sr := &storage.StatsReader{}
+	sr.SetBuffer(sw.GetBuffer())
+	stats, err := sr.GetPrimaryKeyStats()
+	assert.NoError(t, err)
+	segment.pkFilter = stats.BF
+
+	assert.True(t, segment.mayContainPKs(nil))
+	assert.True(t, segment.mayContainPKs([]int64{100, 2}))
+	assert.False(t, segment.mayContainPKs([]int64{100}))
+}
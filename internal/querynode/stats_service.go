@@ -26,6 +26,8 @@ type statsService struct {
 	ctx context.Context
 
 	replica ReplicaInterface
+	// tSafeReplica of the streaming data, the tSafe of dm channels are published along with the statistics if set
+	tSafeReplica TSafeReplicaInterface
 
 	fieldStatsChan chan []*internalpb.FieldStats
 	statsStream    msgstream.MsgStream
@@ -92,6 +94,12 @@ func (sService *statsService) publicStatistic(fieldStats []*internalpb.FieldStat
 		SegStats:   segStats,
 		FieldStats: fieldStats,
 	}
+	if sService.tSafeReplica != nil {
+		for channel, ts := range sService.tSafeReplica.getTSafes() {
+			queryNodeStats.ChannelNames = append(queryNodeStats.ChannelNames, channel)
+			queryNodeStats.Tsafes = append(queryNodeStats.Tsafes, ts)
+		}
+	}
 
 	var msg msgstream.TsMsg = &msgstream.QueryNodeStatsMsg{
 		BaseMsg: msgstream.BaseMsg{
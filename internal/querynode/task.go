@@ -172,6 +172,12 @@ func (w *watchDmChannelsTask) Execute(ctx context.Context) error {
 	hCol.addVChannels(vChannels)
 	hCol.addPChannels(pChannels)
 	hCol.setLoadType(l)
+	sCol.setLoadFieldIDs(w.req.LoadFieldIDs)
+	hCol.setLoadFieldIDs(w.req.LoadFieldIDs)
+	if w.req.ReplicaID != 0 {
+		sCol.setReplicaID(w.req.ReplicaID)
+		hCol.setReplicaID(w.req.ReplicaID)
+	}
 	if loadPartition {
 		sCol.deleteReleasedPartition(partitionID)
 		hCol.deleteReleasedPartition(partitionID)
@@ -372,11 +378,19 @@ func (l *loadSegmentsTask) Execute(ctx context.Context) error {
 				return err
 			}
 		}
+		// the segment loader skips the binlogs of the fields not loaded
+		for _, replica := range []ReplicaInterface{l.node.historical.replica, l.node.streaming.replica} {
+			col, err := replica.getCollectionByID(collectionID)
+			if err != nil {
+				return err
+			}
+			col.setLoadFieldIDs(l.req.LoadFieldIDs)
+		}
 	}
 
 	switch l.req.LoadCondition {
 	case queryPb.TriggerCondition_handoff:
-		err = l.node.historical.loader.loadSegmentOfConditionHandOff(l.req)
+		err = l.node.historical.loader.loadSegmentOfConditionHandOff(l.req, l.node.streaming.replica)
 	case queryPb.TriggerCondition_loadBalance:
 		err = l.node.historical.loader.loadSegmentOfConditionLoadBalance(l.req)
 	case queryPb.TriggerCondition_grpcRequest:
@@ -403,6 +417,10 @@ func (l *loadSegmentsTask) Execute(ctx context.Context) error {
 			return err
 		}
 		hCol.deleteReleasedPartition(partitionID)
+		if l.req.ReplicaID != 0 {
+			sCol.setReplicaID(l.req.ReplicaID)
+			hCol.setReplicaID(l.req.ReplicaID)
+		}
 	}
 
 	log.Debug("LoadSegments done", zap.String("SegmentLoadInfos", fmt.Sprintln(l.req.Infos)))
@@ -24,6 +24,7 @@ import (
 // TSafeReplicaInterface is the interface wrapper of tSafeReplica
 type TSafeReplicaInterface interface {
 	getTSafe(vChannel Channel) Timestamp
+	getTSafes() map[Channel]Timestamp
 	setTSafe(vChannel Channel, id UniqueID, timestamp Timestamp)
 	addTSafe(vChannel Channel)
 	removeTSafe(vChannel Channel)
@@ -46,6 +47,17 @@ func (t *tSafeReplica) getTSafe(vChannel Channel) Timestamp {
 	return safer.get()
 }
 
+// getTSafes returns the tSafe of all the vChannels
+func (t *tSafeReplica) getTSafes() map[Channel]Timestamp {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	ret := make(map[Channel]Timestamp, len(t.tSafes))
+	for vChannel, safer := range t.tSafes {
+		ret[vChannel] = safer.get()
+	}
+	return ret
+}
+
 func (t *tSafeReplica) setTSafe(vChannel Channel, id UniqueID, timestamp Timestamp) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
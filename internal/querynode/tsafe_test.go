@@ -31,3 +31,21 @@ func TestTSafe_GetAndSet(t *testing.T) {
 
 	tSafe.set(UniqueID(1), Timestamp(1000))
 }
+
+func TestTSafeReplica_GetTSafes(t *testing.T) {
+	replica := newTSafeReplica()
+	replica.addTSafe("TestTSafe-channel-1")
+	replica.addTSafe("TestTSafe-channel-2")
+	defer replica.removeTSafe("TestTSafe-channel-1")
+	defer replica.removeTSafe("TestTSafe-channel-2")
+
+	watcher := newTSafeWatcher()
+	replica.registerTSafeWatcher("TestTSafe-channel-1", watcher)
+	replica.setTSafe("TestTSafe-channel-1", UniqueID(1), Timestamp(1000))
+	<-watcher.watcherChan()
+
+	tSafes := replica.getTSafes()
+	assert.Equal(t, 2, len(tSafes))
+	assert.Equal(t, Timestamp(1000), tSafes["TestTSafe-channel-1"])
+	assert.Equal(t, Timestamp(0), tSafes["TestTSafe-channel-2"])
+}
@@ -14,6 +14,7 @@ package rootcoord
 import (
 	"fmt"
 	"path"
+	"sort"
 	"strconv"
 	"sync"
 
@@ -25,6 +26,8 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/credential"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -33,14 +36,20 @@ const (
 	TenantMetaPrefix       = ComponentPrefix + "/tenant"
 	ProxyMetaPrefix        = ComponentPrefix + "/proxy"
 	CollectionMetaPrefix   = ComponentPrefix + "/collection"
+	DatabaseMetaPrefix     = ComponentPrefix + "/database"
 	SegmentIndexMetaPrefix = ComponentPrefix + "/segment-index"
 	IndexMetaPrefix        = ComponentPrefix + "/index"
+	CredentialMetaPrefix   = ComponentPrefix + "/credential"
+	RoleMetaPrefix         = ComponentPrefix + "/role"
 
 	TimestampPrefix = ComponentPrefix + "/timestamp"
 
 	DDOperationPrefix = ComponentPrefix + "/dd-operation"
 	DDMsgSendPrefix   = ComponentPrefix + "/dd-msg-send"
 
+	// handoffSegmentPrefix is the prefix of the flushed segments the query coord hands off
+	handoffSegmentPrefix = "querycoord-handoff"
+
 	CreateCollectionDDType = "CreateCollection"
 	DropCollectionDDType   = "DropCollection"
 	CreatePartitionDDType  = "CreatePartition"
@@ -51,15 +60,19 @@ type metaTable struct {
 	client          kv.SnapShotKV                                                   // client of a reliable kv service, i.e. etcd client
 	tenantID2Meta   map[typeutil.UniqueID]pb.TenantMeta                             // tenant id to tenant meta
 	proxyID2Meta    map[typeutil.UniqueID]pb.ProxyMeta                              // proxy id to proxy meta
+	dbName2Meta     map[string]pb.DatabaseInfo                                      // database name -> meta, default database excluded
 	collID2Meta     map[typeutil.UniqueID]pb.CollectionInfo                         // collection_id -> meta
-	collName2ID     map[string]typeutil.UniqueID                                    // collection name to collection id
+	collName2ID     map[string]map[string]typeutil.UniqueID                         // database name -> collection name -> collection id
 	partID2SegID    map[typeutil.UniqueID]map[typeutil.UniqueID]bool                // partition_id -> segment_id -> bool
 	segID2IndexMeta map[typeutil.UniqueID]map[typeutil.UniqueID]pb.SegmentIndexInfo // collection_id/index_id/partition_id/segment_id -> meta
 	indexID2Meta    map[typeutil.UniqueID]pb.IndexInfo                              // collection_id/index_id -> meta
+	user2Cred       map[string]pb.CredentialInfo                                    // username -> credential
+	role2Meta       map[string]pb.RoleInfo                                          // role name -> role with its grants
 
 	tenantLock sync.RWMutex
 	proxyLock  sync.RWMutex
 	ddLock     sync.RWMutex
+	credLock   sync.RWMutex
 }
 
 func NewMetaTable(kv kv.SnapShotKV) (*metaTable, error) {
@@ -68,6 +81,7 @@ func NewMetaTable(kv kv.SnapShotKV) (*metaTable, error) {
 		tenantLock: sync.RWMutex{},
 		proxyLock:  sync.RWMutex{},
 		ddLock:     sync.RWMutex{},
+		credLock:   sync.RWMutex{},
 	}
 	err := mt.reloadFromKV()
 	if err != nil {
@@ -80,11 +94,14 @@ func (mt *metaTable) reloadFromKV() error {
 
 	mt.tenantID2Meta = make(map[typeutil.UniqueID]pb.TenantMeta)
 	mt.proxyID2Meta = make(map[typeutil.UniqueID]pb.ProxyMeta)
+	mt.dbName2Meta = make(map[string]pb.DatabaseInfo)
 	mt.collID2Meta = make(map[typeutil.UniqueID]pb.CollectionInfo)
-	mt.collName2ID = make(map[string]typeutil.UniqueID)
+	mt.collName2ID = map[string]map[string]typeutil.UniqueID{Params.DefaultDatabaseName: {}}
 	mt.partID2SegID = make(map[typeutil.UniqueID]map[typeutil.UniqueID]bool)
 	mt.segID2IndexMeta = make(map[typeutil.UniqueID]map[typeutil.UniqueID]pb.SegmentIndexInfo)
 	mt.indexID2Meta = make(map[typeutil.UniqueID]pb.IndexInfo)
+	mt.user2Cred = make(map[string]pb.CredentialInfo)
+	mt.role2Meta = make(map[string]pb.RoleInfo)
 
 	_, values, err := mt.client.LoadWithPrefix(TenantMetaPrefix, 0)
 	if err != nil {
@@ -114,6 +131,21 @@ func (mt *metaTable) reloadFromKV() error {
 		mt.proxyID2Meta[proxyMeta.ID] = proxyMeta
 	}
 
+	_, values, err = mt.client.LoadWithPrefix(DatabaseMetaPrefix, 0)
+	if err != nil {
+		return err
+	}
+
+	for _, value := range values {
+		dbInfo := pb.DatabaseInfo{}
+		err = proto.UnmarshalText(value, &dbInfo)
+		if err != nil {
+			return fmt.Errorf("RootCoord UnmarshalText pb.DatabaseInfo err:%w", err)
+		}
+		mt.dbName2Meta[dbInfo.Name] = dbInfo
+		mt.collName2ID[dbInfo.Name] = make(map[string]typeutil.UniqueID)
+	}
+
 	_, values, err = mt.client.LoadWithPrefix(CollectionMetaPrefix, 0)
 	if err != nil {
 		return err
@@ -125,8 +157,12 @@ func (mt *metaTable) reloadFromKV() error {
 		if err != nil {
 			return fmt.Errorf("RootCoord UnmarshalText pb.CollectionInfo err:%w", err)
 		}
+		dbName := getDBName(collInfo.DbName)
+		if _, ok := mt.collName2ID[dbName]; !ok {
+			mt.collName2ID[dbName] = make(map[string]typeutil.UniqueID)
+		}
 		mt.collID2Meta[collInfo.ID] = collInfo
-		mt.collName2ID[collInfo.Schema.Name] = collInfo.ID
+		mt.collName2ID[dbName][collInfo.Schema.Name] = collInfo.ID
 	}
 
 	_, values, err = mt.client.LoadWithPrefix(SegmentIndexMetaPrefix, 0)
@@ -174,6 +210,32 @@ func (mt *metaTable) reloadFromKV() error {
 		mt.indexID2Meta[meta.IndexID] = meta
 	}
 
+	_, values, err = mt.client.LoadWithPrefix(CredentialMetaPrefix, 0)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		cred := pb.CredentialInfo{}
+		err = proto.UnmarshalText(value, &cred)
+		if err != nil {
+			return fmt.Errorf("RootCoord UnmarshalText pb.CredentialInfo err:%w", err)
+		}
+		mt.user2Cred[cred.Username] = cred
+	}
+
+	_, values, err = mt.client.LoadWithPrefix(RoleMetaPrefix, 0)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		role := pb.RoleInfo{}
+		err = proto.UnmarshalText(value, &role)
+		if err != nil {
+			return fmt.Errorf("RootCoord UnmarshalText pb.RoleInfo err:%w", err)
+		}
+		mt.role2Meta[role.Name] = role
+	}
+
 	log.Debug("reload meta table from KV successfully")
 	return nil
 }
@@ -224,6 +286,320 @@ func (mt *metaTable) AddProxy(po *pb.ProxyMeta, ts typeutil.Timestamp) error {
 	return nil
 }
 
+// getDBName maps the empty database name of legacy requests to the default database,
+// the default database always exists and can't be dropped
+func getDBName(dbName string) string {
+	if dbName == "" {
+		return Params.DefaultDatabaseName
+	}
+	return dbName
+}
+
+func (mt *metaTable) AddDatabase(db *pb.DatabaseInfo, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	if _, ok := mt.collName2ID[db.Name]; ok {
+		return fmt.Errorf("database %s exist", db.Name)
+	}
+
+	k := fmt.Sprintf("%s/%d", DatabaseMetaPrefix, db.ID)
+	v := proto.MarshalTextString(db)
+
+	err := mt.client.Save(k, v, ts)
+	if err != nil {
+		log.Error("SnapShotKV Save fail", zap.Error(err))
+		panic("SnapShotKV Save fail")
+	}
+	mt.dbName2Meta[db.Name] = *db
+	mt.collName2ID[db.Name] = make(map[string]typeutil.UniqueID)
+	return nil
+}
+
+// DeleteDatabase drops an empty database, the default database can't be dropped
+func (mt *metaTable) DeleteDatabase(dbName string, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	if dbName == Params.DefaultDatabaseName {
+		return fmt.Errorf("can't drop the default database")
+	}
+	dbMeta, ok := mt.dbName2Meta[dbName]
+	if !ok {
+		return fmt.Errorf("can't find database: %s", dbName)
+	}
+	if colls := mt.collName2ID[dbName]; len(colls) > 0 {
+		return fmt.Errorf("database %s is not empty, it still has %d collections", dbName, len(colls))
+	}
+
+	err := mt.client.MultiSaveAndRemoveWithPrefix(map[string]string{}, []string{fmt.Sprintf("%s/%d", DatabaseMetaPrefix, dbMeta.ID)}, ts)
+	if err != nil {
+		log.Error("SnapShotKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
+		panic("SnapShotKV MultiSaveAndRemoveWithPrefix fail")
+	}
+	delete(mt.dbName2Meta, dbName)
+	delete(mt.collName2ID, dbName)
+	return nil
+}
+
+func (mt *metaTable) HasDatabase(dbName string) bool {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+	_, ok := mt.collName2ID[getDBName(dbName)]
+	return ok
+}
+
+// ListDatabases return all databases, the default database comes first
+func (mt *metaTable) ListDatabases() []*pb.DatabaseInfo {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+
+	dbs := make([]*pb.DatabaseInfo, 0, len(mt.dbName2Meta)+1)
+	dbs = append(dbs, &pb.DatabaseInfo{Name: Params.DefaultDatabaseName})
+	for _, db := range mt.dbName2Meta {
+		dbCopy := db
+		dbs = append(dbs, &dbCopy)
+	}
+	others := dbs[1:]
+	sort.Slice(others, func(i, j int) bool {
+		return others[i].Name < others[j].Name
+	})
+	return dbs
+}
+
+// credentialKey and roleKey end with a fixed leaf, since removals are done by prefix
+// and removing user "u1" must not remove user "u10"
+func credentialKey(username string) string {
+	return fmt.Sprintf("%s/%s/info", CredentialMetaPrefix, username)
+}
+
+func roleKey(roleName string) string {
+	return fmt.Sprintf("%s/%s/info", RoleMetaPrefix, roleName)
+}
+
+func (mt *metaTable) saveCredentials(ts typeutil.Timestamp, creds ...*pb.CredentialInfo) error {
+	meta := make(map[string]string, len(creds))
+	for _, cred := range creds {
+		meta[credentialKey(cred.Username)] = proto.MarshalTextString(cred)
+	}
+	err := mt.client.MultiSave(meta, ts)
+	if err != nil {
+		log.Error("SnapShotKV MultiSave fail", zap.Error(err))
+		panic("SnapShotKV MultiSave fail")
+	}
+	for _, cred := range creds {
+		mt.user2Cred[cred.Username] = *cred
+	}
+	return nil
+}
+
+func (mt *metaTable) saveRole(role *pb.RoleInfo, ts typeutil.Timestamp) error {
+	err := mt.client.Save(roleKey(role.Name), proto.MarshalTextString(role), ts)
+	if err != nil {
+		log.Error("SnapShotKV Save fail", zap.Error(err))
+		panic("SnapShotKV Save fail")
+	}
+	mt.role2Meta[role.Name] = *role
+	return nil
+}
+
+// AddCredential adds a user, the password in cred must be encrypted already
+func (mt *metaTable) AddCredential(cred *pb.CredentialInfo, ts typeutil.Timestamp) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if _, ok := mt.user2Cred[cred.Username]; ok {
+		return fmt.Errorf("user %s exist", cred.Username)
+	}
+	for _, role := range cred.Roles {
+		if _, ok := mt.role2Meta[role]; !ok {
+			return fmt.Errorf("can't find role: %s", role)
+		}
+	}
+	return mt.saveCredentials(ts, cred)
+}
+
+// DeleteCredential deletes a user, the root user can't be deleted
+func (mt *metaTable) DeleteCredential(username string, ts typeutil.Timestamp) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if username == credential.RootUser {
+		return fmt.Errorf("can't delete the root user")
+	}
+	if _, ok := mt.user2Cred[username]; !ok {
+		return fmt.Errorf("can't find user: %s", username)
+	}
+	err := mt.client.MultiSaveAndRemoveWithPrefix(map[string]string{}, []string{credentialKey(username)}, ts)
+	if err != nil {
+		log.Error("SnapShotKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
+		panic("SnapShotKV MultiSaveAndRemoveWithPrefix fail")
+	}
+	delete(mt.user2Cred, username)
+	return nil
+}
+
+func (mt *metaTable) HasCredential(username string) bool {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+	_, ok := mt.user2Cred[username]
+	return ok
+}
+
+// ListCredentials return all users sorted by name
+func (mt *metaTable) ListCredentials() []*pb.CredentialInfo {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	creds := make([]*pb.CredentialInfo, 0, len(mt.user2Cred))
+	for _, cred := range mt.user2Cred {
+		credCopy := proto.Clone(&cred).(*pb.CredentialInfo)
+		creds = append(creds, credCopy)
+	}
+	sort.Slice(creds, func(i, j int) bool {
+		return creds[i].Username < creds[j].Username
+	})
+	return creds
+}
+
+func (mt *metaTable) AddRole(roleName string, ts typeutil.Timestamp) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if _, ok := mt.role2Meta[roleName]; ok {
+		return fmt.Errorf("role %s exist", roleName)
+	}
+	return mt.saveRole(&pb.RoleInfo{Name: roleName}, ts)
+}
+
+func (mt *metaTable) HasRole(roleName string) bool {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+	_, ok := mt.role2Meta[roleName]
+	return ok
+}
+
+// DeleteRole deletes a role and removes it from all users, the admin role can't be deleted
+func (mt *metaTable) DeleteRole(roleName string, ts typeutil.Timestamp) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if roleName == credential.AdminRole {
+		return fmt.Errorf("can't drop the admin role")
+	}
+	if _, ok := mt.role2Meta[roleName]; !ok {
+		return fmt.Errorf("can't find role: %s", roleName)
+	}
+
+	meta := make(map[string]string)
+	updated := make([]*pb.CredentialInfo, 0)
+	for _, cred := range mt.user2Cred {
+		if !funcutil.SliceContain(cred.Roles, roleName) {
+			continue
+		}
+		credCopy := proto.Clone(&cred).(*pb.CredentialInfo)
+		credCopy.Roles = removeString(credCopy.Roles, roleName)
+		meta[credentialKey(credCopy.Username)] = proto.MarshalTextString(credCopy)
+		updated = append(updated, credCopy)
+	}
+	err := mt.client.MultiSaveAndRemoveWithPrefix(meta, []string{roleKey(roleName)}, ts)
+	if err != nil {
+		log.Error("SnapShotKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
+		panic("SnapShotKV MultiSaveAndRemoveWithPrefix fail")
+	}
+	for _, cred := range updated {
+		mt.user2Cred[cred.Username] = *cred
+	}
+	delete(mt.role2Meta, roleName)
+	return nil
+}
+
+// OperateUserRole adds the user to the role, or removes the user from the role if remove is true
+func (mt *metaTable) OperateUserRole(username, roleName string, remove bool, ts typeutil.Timestamp) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	cred, ok := mt.user2Cred[username]
+	if !ok {
+		return fmt.Errorf("can't find user: %s", username)
+	}
+	if _, ok := mt.role2Meta[roleName]; !ok {
+		return fmt.Errorf("can't find role: %s", roleName)
+	}
+	credCopy := proto.Clone(&cred).(*pb.CredentialInfo)
+	if remove {
+		if !funcutil.SliceContain(credCopy.Roles, roleName) {
+			return fmt.Errorf("user %s doesn't have role %s", username, roleName)
+		}
+		credCopy.Roles = removeString(credCopy.Roles, roleName)
+	} else {
+		if funcutil.SliceContain(credCopy.Roles, roleName) {
+			return fmt.Errorf("user %s already has role %s", username, roleName)
+		}
+		credCopy.Roles = append(credCopy.Roles, roleName)
+	}
+	return mt.saveCredentials(ts, credCopy)
+}
+
+// OperatePrivilege grants the privilege to the role, or revokes it if revoke is true
+func (mt *metaTable) OperatePrivilege(roleName string, grant *pb.GrantInfo, revoke bool, ts typeutil.Timestamp) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	role, ok := mt.role2Meta[roleName]
+	if !ok {
+		return fmt.Errorf("can't find role: %s", roleName)
+	}
+	roleCopy := proto.Clone(&role).(*pb.RoleInfo)
+	idx := -1
+	for i, g := range roleCopy.Grants {
+		if proto.Equal(g, grant) {
+			idx = i
+			break
+		}
+	}
+	if revoke {
+		if idx < 0 {
+			return fmt.Errorf("role %s doesn't have privilege %s on %s.%s", roleName, grant.Privilege, grant.DbName, grant.ObjectName)
+		}
+		roleCopy.Grants = append(roleCopy.Grants[:idx], roleCopy.Grants[idx+1:]...)
+	} else {
+		if idx >= 0 {
+			return fmt.Errorf("role %s already has privilege %s on %s.%s", roleName, grant.Privilege, grant.DbName, grant.ObjectName)
+		}
+		roleCopy.Grants = append(roleCopy.Grants, grant)
+	}
+	return mt.saveRole(roleCopy, ts)
+}
+
+// ListRoles return the roles sorted by name, only the role roleName is returned if it isn't empty
+func (mt *metaTable) ListRoles(roleName string) ([]*pb.RoleInfo, error) {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	if roleName != "" {
+		role, ok := mt.role2Meta[roleName]
+		if !ok {
+			return nil, fmt.Errorf("can't find role: %s", roleName)
+		}
+		return []*pb.RoleInfo{proto.Clone(&role).(*pb.RoleInfo)}, nil
+	}
+	roles := make([]*pb.RoleInfo, 0, len(mt.role2Meta))
+	for _, role := range mt.role2Meta {
+		roles = append(roles, proto.Clone(&role).(*pb.RoleInfo))
+	}
+	sort.Slice(roles, func(i, j int) bool {
+		return roles[i].Name < roles[j].Name
+	})
+	return roles, nil
+}
+
+// unlockGetCollectionID return the id of collection collName in database dbName
+func (mt *metaTable) unlockGetCollectionID(dbName, collName string) (typeutil.UniqueID, bool) {
+	collID, ok := mt.collName2ID[getDBName(dbName)][collName]
+	return collID, ok
+}
+
 func (mt *metaTable) AddCollection(coll *pb.CollectionInfo, ts typeutil.Timestamp, idx []*pb.IndexInfo, ddOpStr func(ts typeutil.Timestamp) (string, error)) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
@@ -233,7 +609,12 @@ func (mt *metaTable) AddCollection(coll *pb.CollectionInfo, ts typeutil.Timestam
 		(len(coll.PartitionIDs) != 1 && len(coll.PartitionIDs) != 0) {
 		return fmt.Errorf("PartitionIDs, PartitionNames and PartitionCreatedTimestmaps' length mis-match when creating collection")
 	}
-	if _, ok := mt.collName2ID[coll.Schema.Name]; ok {
+	dbName := getDBName(coll.DbName)
+	collNames, ok := mt.collName2ID[dbName]
+	if !ok {
+		return fmt.Errorf("can't find database: %s", dbName)
+	}
+	if _, ok := collNames[coll.Schema.Name]; ok {
 		return fmt.Errorf("collection %s exist", coll.Schema.Name)
 	}
 	if len(coll.FieldIndexes) != len(idx) {
@@ -260,7 +641,7 @@ func (mt *metaTable) AddCollection(coll *pb.CollectionInfo, ts typeutil.Timestam
 			coll.PartitionCreatedTimestamps[0] = ts
 		}
 		mt.collID2Meta[coll.ID] = *coll
-		mt.collName2ID[coll.Schema.Name] = coll.ID
+		collNames[coll.Schema.Name] = coll.ID
 		k1 := fmt.Sprintf("%s/%d", CollectionMetaPrefix, coll.ID)
 		v1 := proto.MarshalTextString(coll)
 		meta[k1] = v1
@@ -286,7 +667,7 @@ func (mt *metaTable) DeleteCollection(collID typeutil.UniqueID, ts typeutil.Time
 	}
 
 	delete(mt.collID2Meta, collID)
-	delete(mt.collName2ID, collMeta.Schema.Name)
+	delete(mt.collName2ID[getDBName(collMeta.DbName)], collMeta.Schema.Name)
 
 	// update segID2IndexMeta
 	for partID := range collMeta.PartitionIDs {
@@ -366,12 +747,12 @@ func (mt *metaTable) GetCollectionByID(collectionID typeutil.UniqueID, ts typeut
 	return &colMeta, nil
 }
 
-func (mt *metaTable) GetCollectionByName(collectionName string, ts typeutil.Timestamp) (*pb.CollectionInfo, error) {
+func (mt *metaTable) GetCollectionByName(dbName string, collectionName string, ts typeutil.Timestamp) (*pb.CollectionInfo, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
 	if ts == 0 {
-		vid, ok := mt.collName2ID[collectionName]
+		vid, ok := mt.unlockGetCollectionID(dbName, collectionName)
 		if !ok {
 			return nil, fmt.Errorf("can't find collection: " + collectionName)
 		}
@@ -393,20 +774,25 @@ func (mt *metaTable) GetCollectionByName(collectionName string, ts typeutil.Time
 			log.Debug("unmarshal collection info failed", zap.Error(err))
 			continue
 		}
-		if collMeta.Schema.Name == collectionName {
+		if collMeta.Schema.Name == collectionName && getDBName(collMeta.DbName) == getDBName(dbName) {
 			return &collMeta, nil
 		}
 	}
 	return nil, fmt.Errorf("can't find collection: %s, at timestamp = %d", collectionName, ts)
 }
 
-func (mt *metaTable) ListCollections(ts typeutil.Timestamp) (map[string]*pb.CollectionInfo, error) {
+// ListCollections return the collections of database dbName
+func (mt *metaTable) ListCollections(dbName string, ts typeutil.Timestamp) (map[string]*pb.CollectionInfo, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 	colls := make(map[string]*pb.CollectionInfo)
 
 	if ts == 0 {
-		for collName, collID := range mt.collName2ID {
+		collNames, ok := mt.collName2ID[getDBName(dbName)]
+		if !ok {
+			return nil, fmt.Errorf("can't find database: %s", dbName)
+		}
+		for collName, collID := range collNames {
 			coll := mt.collID2Meta[collID]
 			colCopy := proto.Clone(&coll)
 			colls[collName] = colCopy.(*pb.CollectionInfo)
@@ -424,6 +810,9 @@ func (mt *metaTable) ListCollections(ts typeutil.Timestamp) (map[string]*pb.Coll
 		if err != nil {
 			log.Debug("unmarshal collection info failed", zap.Error(err))
 		}
+		if getDBName(collMeta.DbName) != getDBName(dbName) {
+			continue
+		}
 		colls[collMeta.Schema.Name] = &collMeta
 	}
 	return colls, nil
@@ -709,11 +1098,11 @@ func (mt *metaTable) AddIndex(segIdxInfo *pb.SegmentIndexInfo, ts typeutil.Times
 }
 
 //return timestamp, index id, is dropped, error
-func (mt *metaTable) DropIndex(collName, fieldName, indexName string, ts typeutil.Timestamp) (typeutil.UniqueID, bool, error) {
+func (mt *metaTable) DropIndex(dbName, collName, fieldName, indexName string, ts typeutil.Timestamp) (typeutil.UniqueID, bool, error) {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
-	collID, ok := mt.collName2ID[collName]
+	collID, ok := mt.unlockGetCollectionID(dbName, collName)
 	if !ok {
 		return 0, false, fmt.Errorf("collection name = %s not exist", collName)
 	}
@@ -721,7 +1110,7 @@ func (mt *metaTable) DropIndex(collName, fieldName, indexName string, ts typeuti
 	if !ok {
 		return 0, false, fmt.Errorf("collection name  = %s not has meta", collName)
 	}
-	fieldSch, err := mt.unlockGetFieldSchema(collName, fieldName)
+	fieldSch, err := mt.unlockGetFieldSchema(dbName, collName, fieldName)
 	if err != nil {
 		return 0, false, err
 	}
@@ -823,15 +1212,15 @@ func (mt *metaTable) GetSegmentIndexInfoByID(segID typeutil.UniqueID, filedID in
 	return pb.SegmentIndexInfo{}, fmt.Errorf("can't find index name = %s on segment = %d, with filed id = %d", idxName, segID, filedID)
 }
 
-func (mt *metaTable) GetFieldSchema(collName string, fieldName string) (schemapb.FieldSchema, error) {
+func (mt *metaTable) GetFieldSchema(dbName string, collName string, fieldName string) (schemapb.FieldSchema, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
-	return mt.unlockGetFieldSchema(collName, fieldName)
+	return mt.unlockGetFieldSchema(dbName, collName, fieldName)
 }
 
-func (mt *metaTable) unlockGetFieldSchema(collName string, fieldName string) (schemapb.FieldSchema, error) {
-	collID, ok := mt.collName2ID[collName]
+func (mt *metaTable) unlockGetFieldSchema(dbName string, collName string, fieldName string) (schemapb.FieldSchema, error) {
+	collID, ok := mt.unlockGetCollectionID(dbName, collName)
 	if !ok {
 		return schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
 	}
@@ -878,14 +1267,14 @@ func (mt *metaTable) unlockIsSegmentIndexed(segID typeutil.UniqueID, fieldSchema
 }
 
 // return segment ids, type params, error
-func (mt *metaTable) GetNotIndexedSegments(collName string, fieldName string, idxInfo *pb.IndexInfo, segIDs []typeutil.UniqueID, ts typeutil.Timestamp) ([]typeutil.UniqueID, schemapb.FieldSchema, error) {
+func (mt *metaTable) GetNotIndexedSegments(dbName string, collName string, fieldName string, idxInfo *pb.IndexInfo, segIDs []typeutil.UniqueID, ts typeutil.Timestamp) ([]typeutil.UniqueID, schemapb.FieldSchema, error) {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
 	if idxInfo.IndexParams == nil {
 		return nil, schemapb.FieldSchema{}, fmt.Errorf("index param is nil")
 	}
-	collID, ok := mt.collName2ID[collName]
+	collID, ok := mt.unlockGetCollectionID(dbName, collName)
 	if !ok {
 		return nil, schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
 	}
@@ -893,7 +1282,7 @@ func (mt *metaTable) GetNotIndexedSegments(collName string, fieldName string, id
 	if !ok {
 		return nil, schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
 	}
-	fieldSchema, err := mt.unlockGetFieldSchema(collName, fieldName)
+	fieldSchema, err := mt.unlockGetFieldSchema(dbName, collName, fieldName)
 	if err != nil {
 		return nil, fieldSchema, err
 	}
@@ -984,11 +1373,11 @@ func (mt *metaTable) GetNotIndexedSegments(collName string, fieldName string, id
 	return rstID, fieldSchema, nil
 }
 
-func (mt *metaTable) GetIndexByName(collName, indexName string) (pb.CollectionInfo, []pb.IndexInfo, error) {
+func (mt *metaTable) GetIndexByName(dbName, collName, indexName string) (pb.CollectionInfo, []pb.IndexInfo, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
-	collID, ok := mt.collName2ID[collName]
+	collID, ok := mt.unlockGetCollectionID(dbName, collName)
 	if !ok {
 		return pb.CollectionInfo{}, nil, fmt.Errorf("collection %s not found", collName)
 	}
@@ -21,6 +21,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/credential"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -250,7 +251,7 @@ func TestMetaTable(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, uint64(1), ts)
 
-		collMeta, err := mt.GetCollectionByName("testColl", 0)
+		collMeta, err := mt.GetCollectionByName("", "testColl", 0)
 		assert.Nil(t, err)
 		assert.Equal(t, collMeta.CreateTime, ts)
 		assert.Equal(t, collMeta.PartitionCreatedTimestamps[0], ts)
@@ -259,7 +260,7 @@ func TestMetaTable(t *testing.T) {
 		assert.Equal(t, 1, len(collMeta.PartitionIDs))
 		assert.True(t, mt.HasCollection(collInfo.ID, 0))
 
-		field, err := mt.GetFieldSchema("testColl", "field110")
+		field, err := mt.GetFieldSchema("", "testColl", "field110")
 		assert.Nil(t, err)
 		assert.Equal(t, collInfo.Schema.Fields[0].FieldID, field.FieldID)
 
@@ -337,9 +338,9 @@ func TestMetaTable(t *testing.T) {
 			IndexParams: params,
 		}
 
-		_, _, err := mt.GetNotIndexedSegments("collTest", "field110", idxInfo, nil, 0)
+		_, _, err := mt.GetNotIndexedSegments("", "collTest", "field110", idxInfo, nil, 0)
 		assert.NotNil(t, err)
-		seg, field, err := mt.GetNotIndexedSegments("testColl", "field110", idxInfo, []typeutil.UniqueID{segID, segID2}, 0)
+		seg, field, err := mt.GetNotIndexedSegments("", "testColl", "field110", idxInfo, []typeutil.UniqueID{segID, segID2}, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(seg))
 		assert.Equal(t, segID2, seg[0])
@@ -355,7 +356,7 @@ func TestMetaTable(t *testing.T) {
 		idxInfo.IndexID = 2001
 		idxInfo.IndexName = "field110-1"
 
-		seg, field, err = mt.GetNotIndexedSegments("testColl", "field110", idxInfo, []typeutil.UniqueID{segID, segID2}, 0)
+		seg, field, err = mt.GetNotIndexedSegments("", "testColl", "field110", idxInfo, []typeutil.UniqueID{segID, segID2}, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(seg))
 		assert.Equal(t, segID, seg[0])
@@ -365,7 +366,7 @@ func TestMetaTable(t *testing.T) {
 	})
 
 	t.Run("get index by name", func(t *testing.T) {
-		_, idx, err := mt.GetIndexByName("testColl", "field110")
+		_, idx, err := mt.GetIndexByName("", "testColl", "field110")
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(idx))
 		assert.Equal(t, indexID, idx[0].IndexID)
@@ -381,11 +382,149 @@ func TestMetaTable(t *testing.T) {
 		}
 		assert.True(t, EqualKeyPairArray(idx[0].IndexParams, params))
 
-		_, idx, err = mt.GetIndexByName("testColl", "idx201")
+		_, idx, err = mt.GetIndexByName("", "testColl", "idx201")
 		assert.Nil(t, err)
 		assert.Zero(t, len(idx))
 	})
 
+	t.Run("database", func(t *testing.T) {
+		ts := ftso()
+		err := mt.AddDatabase(&pb.DatabaseInfo{ID: 300, Name: "db1", CreateTime: ts}, ts)
+		assert.Nil(t, err)
+		err = mt.AddDatabase(&pb.DatabaseInfo{ID: 301, Name: "db1"}, ftso())
+		assert.NotNil(t, err)
+		err = mt.AddDatabase(&pb.DatabaseInfo{ID: 302, Name: Params.DefaultDatabaseName}, ftso())
+		assert.NotNil(t, err)
+		assert.True(t, mt.HasDatabase("db1"))
+		assert.True(t, mt.HasDatabase(""))
+		assert.False(t, mt.HasDatabase("db2"))
+
+		dbs := mt.ListDatabases()
+		assert.Equal(t, 2, len(dbs))
+		assert.Equal(t, Params.DefaultDatabaseName, dbs[0].Name)
+		assert.Equal(t, "db1", dbs[1].Name)
+
+		// collection with the same name in another database
+		dbColl := proto.Clone(collInfo).(*pb.CollectionInfo)
+		dbColl.ID = collID + 1000
+		dbColl.DbName = "db1"
+		dbColl.PartitionIDs = []typeutil.UniqueID{partIDDefault + 1000}
+		dbColl.FieldIndexes = nil
+		err = mt.AddCollection(dbColl, ftso(), nil, ddOp)
+		assert.Nil(t, err)
+
+		collMeta, err := mt.GetCollectionByName("db1", "testColl", 0)
+		assert.Nil(t, err)
+		assert.Equal(t, dbColl.ID, collMeta.ID)
+		collMeta, err = mt.GetCollectionByName(Params.DefaultDatabaseName, "testColl", 0)
+		assert.Nil(t, err)
+		assert.Equal(t, collID, collMeta.ID)
+		_, err = mt.GetCollectionByName("db2", "testColl", 0)
+		assert.NotNil(t, err)
+
+		colls, err := mt.ListCollections("db1", 0)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(colls))
+		_, err = mt.ListCollections("db2", 0)
+		assert.NotNil(t, err)
+
+		err = mt.DeleteDatabase("db1", ftso())
+		assert.NotNil(t, err)
+		err = mt.DeleteDatabase(Params.DefaultDatabaseName, ftso())
+		assert.NotNil(t, err)
+		err = mt.DeleteDatabase("db2", ftso())
+		assert.NotNil(t, err)
+
+		err = mt.DeleteCollection(dbColl.ID, ftso(), nil)
+		assert.Nil(t, err)
+		err = mt.DeleteDatabase("db1", ftso())
+		assert.Nil(t, err)
+		assert.False(t, mt.HasDatabase("db1"))
+		assert.Equal(t, 1, len(mt.ListDatabases()))
+	})
+
+	t.Run("credential", func(t *testing.T) {
+		err := mt.AddRole("role1", ftso())
+		assert.Nil(t, err)
+		err = mt.AddRole("role1", ftso())
+		assert.NotNil(t, err)
+		err = mt.AddCredential(&pb.CredentialInfo{Username: "user1", EncryptedPassword: "xxx", Roles: []string{"role2"}}, ftso())
+		assert.NotNil(t, err)
+		err = mt.AddCredential(&pb.CredentialInfo{Username: "user1", EncryptedPassword: "xxx"}, ftso())
+		assert.Nil(t, err)
+		err = mt.AddCredential(&pb.CredentialInfo{Username: "user10", EncryptedPassword: "yyy"}, ftso())
+		assert.Nil(t, err)
+		err = mt.AddCredential(&pb.CredentialInfo{Username: "user1", EncryptedPassword: "yyy"}, ftso())
+		assert.NotNil(t, err)
+
+		err = mt.OperateUserRole("user1", "role1", false, ftso())
+		assert.Nil(t, err)
+		err = mt.OperateUserRole("user1", "role1", false, ftso())
+		assert.NotNil(t, err)
+		err = mt.OperateUserRole("user2", "role1", false, ftso())
+		assert.NotNil(t, err)
+		err = mt.OperateUserRole("user1", "role2", false, ftso())
+		assert.NotNil(t, err)
+
+		grant := &pb.GrantInfo{DbName: Params.DefaultDatabaseName, ObjectName: "testColl", Privilege: "Search"}
+		err = mt.OperatePrivilege("role1", grant, false, ftso())
+		assert.Nil(t, err)
+		err = mt.OperatePrivilege("role1", grant, false, ftso())
+		assert.NotNil(t, err)
+		err = mt.OperatePrivilege("role2", grant, false, ftso())
+		assert.NotNil(t, err)
+
+		creds := mt.ListCredentials()
+		assert.Equal(t, 2, len(creds))
+		assert.Equal(t, "user1", creds[0].Username)
+		assert.Equal(t, []string{"role1"}, creds[0].Roles)
+		roles, err := mt.ListRoles("role1")
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(roles))
+		assert.Equal(t, 1, len(roles[0].Grants))
+		_, err = mt.ListRoles("role2")
+		assert.NotNil(t, err)
+
+		// reload from kv
+		mt2, err := NewMetaTable(skv)
+		assert.Nil(t, err)
+		assert.Equal(t, creds, mt2.ListCredentials())
+		roles2, err := mt2.ListRoles("")
+		assert.Nil(t, err)
+		assert.Equal(t, roles, roles2)
+
+		err = mt.OperatePrivilege("role1", grant, true, ftso())
+		assert.Nil(t, err)
+		err = mt.OperatePrivilege("role1", grant, true, ftso())
+		assert.NotNil(t, err)
+
+		// dropping a role removes it from its users
+		err = mt.DeleteRole("role1", ftso())
+		assert.Nil(t, err)
+		assert.False(t, mt.HasRole("role1"))
+		assert.Equal(t, 0, len(mt.ListCredentials()[0].Roles))
+		err = mt.DeleteRole("role1", ftso())
+		assert.NotNil(t, err)
+		err = mt.DeleteRole(credential.AdminRole, ftso())
+		assert.NotNil(t, err)
+
+		// deleting user1 keeps user10
+		err = mt.DeleteCredential("user1", ftso())
+		assert.Nil(t, err)
+		err = mt.DeleteCredential("user1", ftso())
+		assert.NotNil(t, err)
+		err = mt.DeleteCredential(credential.RootUser, ftso())
+		assert.NotNil(t, err)
+		assert.False(t, mt.HasCredential("user1"))
+		mt2, err = NewMetaTable(skv)
+		assert.Nil(t, err)
+		assert.False(t, mt2.HasCredential("user1"))
+		assert.True(t, mt2.HasCredential("user10"))
+
+		err = mt.DeleteCredential("user10", ftso())
+		assert.Nil(t, err)
+	})
+
 	t.Run("reload meta", func(t *testing.T) {
 		te := pb.TenantMeta{
 			ID: 100,
@@ -403,20 +542,20 @@ func TestMetaTable(t *testing.T) {
 	})
 
 	t.Run("drop index", func(t *testing.T) {
-		idx, ok, err := mt.DropIndex("testColl", "field110", "field110", 0)
+		idx, ok, err := mt.DropIndex("", "testColl", "field110", "field110", 0)
 		assert.Nil(t, err)
 		assert.True(t, ok)
 		assert.Equal(t, indexID, idx)
 
-		_, ok, err = mt.DropIndex("testColl", "field110", "field110-error", 0)
+		_, ok, err = mt.DropIndex("", "testColl", "field110", "field110-error", 0)
 		assert.Nil(t, err)
 		assert.False(t, ok)
 
-		_, idxs, err := mt.GetIndexByName("testColl", "field110")
+		_, idxs, err := mt.GetIndexByName("", "testColl", "field110")
 		assert.Nil(t, err)
 		assert.Zero(t, len(idxs))
 
-		_, idxs, err = mt.GetIndexByName("testColl", "field110-1")
+		_, idxs, err = mt.GetIndexByName("", "testColl", "field110-1")
 		assert.Nil(t, err)
 		assert.Equal(t, len(idxs), 1)
 		assert.Equal(t, idxs[0].IndexID, int64(2001))
@@ -509,7 +648,7 @@ func TestMetaTable(t *testing.T) {
 		assert.Nil(t, err)
 
 		mt.collID2Meta = make(map[int64]pb.CollectionInfo)
-		_, err = mt.GetCollectionByName(collInfo.Schema.Name, 0)
+		_, err = mt.GetCollectionByName("", collInfo.Schema.Name, 0)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("can't find collection: %s", collInfo.Schema.Name))
 
@@ -739,16 +878,16 @@ func TestMetaTable(t *testing.T) {
 		assert.Nil(t, err)
 
 		ts = ftso()
-		_, _, err = mt.DropIndex("abc", "abc", "abc", ts)
+		_, _, err = mt.DropIndex("", "abc", "abc", "abc", ts)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection name = abc not exist")
 
-		mt.collName2ID["abc"] = 2
-		_, _, err = mt.DropIndex("abc", "abc", "abc", ts)
+		mt.collName2ID[Params.DefaultDatabaseName]["abc"] = 2
+		_, _, err = mt.DropIndex("", "abc", "abc", "abc", ts)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection name  = abc not has meta")
 
-		_, _, err = mt.DropIndex(collInfo.Schema.Name, "abc", "abc", ts)
+		_, _, err = mt.DropIndex("", collInfo.Schema.Name, "abc", "abc", ts)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s doesn't have filed abc", collInfo.Schema.Name))
 
@@ -766,7 +905,7 @@ func TestMetaTable(t *testing.T) {
 		mt.collID2Meta[coll.ID] = coll
 		mt.indexID2Meta = make(map[int64]pb.IndexInfo)
 		ts = ftso()
-		idxID, isDroped, err := mt.DropIndex(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName, ts)
+		idxID, isDroped, err := mt.DropIndex("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName, ts)
 		assert.Zero(t, idxID)
 		assert.False(t, isDroped)
 		assert.Nil(t, err)
@@ -783,8 +922,8 @@ func TestMetaTable(t *testing.T) {
 			return fmt.Errorf("multi save and remove with prefix error")
 		}
 		ts = ftso()
-		assert.Panics(t, func() { mt.DropIndex(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName, ts) })
-		//_, _, err = mt.DropIndex(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName, ts)
+		assert.Panics(t, func() { mt.DropIndex("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName, ts) })
+		//_, _, err = mt.DropIndex("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName, ts)
 		//assert.NotNil(t, err)
 		//assert.EqualError(t, err, "multi save and remove with prefix error")
 	})
@@ -865,12 +1004,12 @@ func TestMetaTable(t *testing.T) {
 		assert.Nil(t, err)
 
 		mt.collID2Meta = make(map[int64]pb.CollectionInfo)
-		_, err = mt.unlockGetFieldSchema(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
+		_, err = mt.unlockGetFieldSchema("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s not found", collInfo.Schema.Name))
 
-		mt.collName2ID = make(map[string]int64)
-		_, err = mt.unlockGetFieldSchema(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
+		mt.collName2ID = make(map[string]map[string]int64)
+		_, err = mt.unlockGetFieldSchema("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s not found", collInfo.Schema.Name))
 	})
@@ -915,8 +1054,8 @@ func TestMetaTable(t *testing.T) {
 			},
 		}
 
-		mt.collName2ID["abc"] = 123
-		_, _, err = mt.GetNotIndexedSegments("abc", "no-field", idx, nil, 0)
+		mt.collName2ID[Params.DefaultDatabaseName]["abc"] = 123
+		_, _, err = mt.GetNotIndexedSegments("", "abc", "no-field", idx, nil, 0)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection abc not found")
 
@@ -941,13 +1080,13 @@ func TestMetaTable(t *testing.T) {
 		err = mt.AddCollection(collInfo, ts, idxInfo, nil)
 		assert.Nil(t, err)
 
-		_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, "no-field", idx, nil, 0)
+		_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, "no-field", idx, nil, 0)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s doesn't have filed no-field", collInfo.Schema.Name))
 
 		bakMeta := mt.indexID2Meta
 		mt.indexID2Meta = make(map[int64]pb.IndexInfo)
-		_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil, 0)
+		_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil, 0)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("index id = %d not found", idxInfo[0].IndexID))
 		mt.indexID2Meta = bakMeta
@@ -955,8 +1094,8 @@ func TestMetaTable(t *testing.T) {
 		mockKV.multiSave = func(kvs map[string]string, ts typeutil.Timestamp, addition ...func(ts typeutil.Timestamp) (string, string, error)) error {
 			return fmt.Errorf("multi save error")
 		}
-		assert.Panics(t, func() { mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil, 0) })
-		//_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
+		assert.Panics(t, func() { mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil, 0) })
+		//_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
 		//assert.NotNil(t, err)
 		//assert.EqualError(t, err, "multi save error")
 
@@ -994,8 +1133,8 @@ func TestMetaTable(t *testing.T) {
 		mockKV.multiSave = func(kvs map[string]string, ts typeutil.Timestamp, addition ...func(ts typeutil.Timestamp) (string, string, error)) error {
 			return fmt.Errorf("multi save error")
 		}
-		assert.Panics(t, func() { mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil, 0) })
-		//_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
+		assert.Panics(t, func() { mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil, 0) })
+		//_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
 		//assert.NotNil(t, err)
 		//assert.EqualError(t, err, "multi save error")
 	})
@@ -1007,8 +1146,8 @@ func TestMetaTable(t *testing.T) {
 		err := mt.reloadFromKV()
 		assert.Nil(t, err)
 
-		mt.collName2ID["abc"] = 123
-		_, _, err = mt.GetIndexByName("abc", "hij")
+		mt.collName2ID[Params.DefaultDatabaseName]["abc"] = 123
+		_, _, err = mt.GetIndexByName("", "abc", "hij")
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection abc not found")
 
@@ -1033,7 +1172,7 @@ func TestMetaTable(t *testing.T) {
 		err = mt.AddCollection(collInfo, ts, idxInfo, nil)
 		assert.Nil(t, err)
 		mt.indexID2Meta = make(map[int64]pb.IndexInfo)
-		_, _, err = mt.GetIndexByName(collInfo.Schema.Name, idxInfo[0].IndexName)
+		_, _, err = mt.GetIndexByName("", collInfo.Schema.Name, idxInfo[0].IndexName)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("index id = %d not found", idxInfo[0].IndexID))
 
@@ -1137,29 +1276,29 @@ func TestMetaWithTimestamp(t *testing.T) {
 	c2, err = mt.GetCollectionByID(collID2, tsoStart)
 	assert.NotNil(t, err)
 
-	c1, err = mt.GetCollectionByName(collName1, 0)
+	c1, err = mt.GetCollectionByName("", collName1, 0)
 	assert.Nil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, 0)
+	c2, err = mt.GetCollectionByName("", collName2, 0)
 	assert.Nil(t, err)
 	assert.Equal(t, int64(1), c1.ID)
 	assert.Equal(t, int64(2), c2.ID)
 
-	c1, err = mt.GetCollectionByName(collName1, t2)
+	c1, err = mt.GetCollectionByName("", collName1, t2)
 	assert.Nil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, t2)
+	c2, err = mt.GetCollectionByName("", collName2, t2)
 	assert.Nil(t, err)
 	assert.Equal(t, int64(1), c1.ID)
 	assert.Equal(t, int64(2), c2.ID)
 
-	c1, err = mt.GetCollectionByName(collName1, t1)
+	c1, err = mt.GetCollectionByName("", collName1, t1)
 	assert.Nil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, t1)
+	c2, err = mt.GetCollectionByName("", collName2, t1)
 	assert.NotNil(t, err)
 	assert.Equal(t, int64(1), c1.ID)
 
-	c1, err = mt.GetCollectionByName(collName1, tsoStart)
+	c1, err = mt.GetCollectionByName("", collName1, tsoStart)
 	assert.NotNil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, tsoStart)
+	c2, err = mt.GetCollectionByName("", collName2, tsoStart)
 	assert.NotNil(t, err)
 
 	getKeys := func(m map[string]*pb.CollectionInfo) []string {
@@ -1170,22 +1309,22 @@ func TestMetaWithTimestamp(t *testing.T) {
 		return keys
 	}
 
-	s1, err := mt.ListCollections(0)
+	s1, err := mt.ListCollections("", 0)
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(s1))
 	assert.ElementsMatch(t, getKeys(s1), []string{collName1, collName2})
 
-	s1, err = mt.ListCollections(t2)
+	s1, err = mt.ListCollections("", t2)
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(s1))
 	assert.ElementsMatch(t, getKeys(s1), []string{collName1, collName2})
 
-	s1, err = mt.ListCollections(t1)
+	s1, err = mt.ListCollections("", t1)
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(s1))
 	assert.ElementsMatch(t, getKeys(s1), []string{collName1})
 
-	s1, err = mt.ListCollections(tsoStart)
+	s1, err = mt.ListCollections("", tsoStart)
 	assert.Nil(t, err)
 	assert.Equal(t, 0, len(s1))
 
@@ -44,6 +44,7 @@ type ParamTable struct {
 	DmlChannelNum               int64
 	MaxPartitionNum             int64
 	DefaultPartitionName        string
+	DefaultDatabaseName         string
 	DefaultIndexName            string
 	MinSegmentSizeToEnableIndex int64
 
@@ -53,6 +54,8 @@ type ParamTable struct {
 	Log log.Config
 
 	RoleName string
+
+	EnableActiveStandby bool
 }
 
 func (p *ParamTable) Init() {
@@ -79,6 +82,7 @@ func (p *ParamTable) Init() {
 		p.initMaxPartitionNum()
 		p.initMinSegmentSizeToEnableIndex()
 		p.initDefaultPartitionName()
+		p.initDefaultDatabaseName()
 		p.initDefaultIndexName()
 
 		p.initTimeout()
@@ -86,6 +90,7 @@ func (p *ParamTable) Init() {
 
 		p.initLogCfg()
 		p.initRoleName()
+		p.initEnableActiveStandby()
 	})
 }
 
@@ -193,6 +198,14 @@ func (p *ParamTable) initDefaultPartitionName() {
 	p.DefaultPartitionName = name
 }
 
+func (p *ParamTable) initDefaultDatabaseName() {
+	name, err := p.Load("common.defaultDatabaseName")
+	if err != nil {
+		panic(err)
+	}
+	p.DefaultDatabaseName = name
+}
+
 func (p *ParamTable) initDefaultIndexName() {
 	name, err := p.Load("common.defaultIndexName")
 	if err != nil {
@@ -238,3 +251,7 @@ func (p *ParamTable) initLogCfg() {
 func (p *ParamTable) initRoleName() {
 	p.RoleName = "RootCoord"
 }
+
+func (p *ParamTable) initEnableActiveStandby() {
+	p.EnableActiveStandby = p.ParseBool("rootCoord.enableActiveStandby", false)
+}
@@ -50,6 +50,9 @@ func TestParamTable(t *testing.T) {
 	assert.NotEqual(t, Params.DefaultPartitionName, "")
 	t.Logf("default partition name = %s", Params.DefaultPartitionName)
 
+	assert.NotEqual(t, Params.DefaultDatabaseName, "")
+	t.Logf("default database name = %s", Params.DefaultDatabaseName)
+
 	assert.NotEqual(t, Params.DefaultIndexName, "")
 	t.Logf("default index name = %s", Params.DefaultIndexName)
 
@@ -58,4 +61,7 @@ func TestParamTable(t *testing.T) {
 
 	assert.NotZero(t, Params.TimeTickInterval)
 	t.Logf("master timetickerInterval = %d", Params.TimeTickInterval)
+
+	assert.False(t, Params.EnableActiveStandby)
+	t.Logf("master enableActiveStandby = %t", Params.EnableActiveStandby)
 }
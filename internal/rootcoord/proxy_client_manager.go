@@ -120,6 +120,39 @@ func (p *proxyClientManager) InvalidateCollectionMetaCache(ctx context.Context,
 	}
 }
 
+func (p *proxyClientManager) RefreshPolicyInfoCache(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if len(p.proxyClient) == 0 {
+		log.Debug("proxy client is empty,RefreshPolicyInfoCache will not send to any client")
+		return
+	}
+
+	for k, f := range p.proxyClient {
+		err := func() error {
+			defer func() {
+				if err := recover(); err != nil {
+					log.Debug("call RefreshPolicyInfoCache panic", zap.Int64("proxy id", k), zap.Any("msg", err))
+				}
+			}()
+			sta, err := f.RefreshPolicyInfoCache(ctx, request)
+			if err != nil {
+				return fmt.Errorf("grpc fail,error=%w", err)
+			}
+			if sta.ErrorCode != commonpb.ErrorCode_Success {
+				return fmt.Errorf("message = %s", sta.Reason)
+			}
+			return nil
+		}()
+		if err != nil {
+			log.Error("call refresh policy info cache failed", zap.Int64("proxy id", k), zap.Error(err))
+		} else {
+			log.Debug("send refresh policy info cache to proxy node", zap.Int64("node id", k))
+		}
+	}
+}
+
 func (p *proxyClientManager) ReleaseDQLMessageStream(ctx context.Context, in *proxypb.ReleaseDQLMessageStreamRequest) (*commonpb.Status, error) {
 	p.lock.Lock()
 	defer p.lock.Unlock()
@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"path"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -42,6 +43,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/tso"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/credential"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
@@ -890,12 +892,13 @@ func (c *Core) BuildIndex(ctx context.Context, segID typeutil.UniqueID, field *s
 	return bldID, nil
 }
 
-// Register register rootcoord at etcd
+// Register register rootcoord at etcd, a standby rootcoord blocks here until the active one quits
 func (c *Core) Register() error {
 	c.session = sessionutil.NewSession(c.ctx, Params.MetaRootPath, Params.EtcdEndpoints)
 	if c.session == nil {
 		return fmt.Errorf("session is nil, maybe the etcd client connection fails")
 	}
+	c.session.SetEnableActiveStandBy(Params.EnableActiveStandby)
 	c.sessCloseCh = c.session.Init(typeutil.RootCoordRole, Params.Address, true)
 	return nil
 }
@@ -958,6 +961,10 @@ func (c *Core) Init() error {
 			return tsoAllocator.UpdateTSO()
 		}
 
+		if initError = c.initBuiltinCredential(); initError != nil {
+			return
+		}
+
 		m := map[string]interface{}{
 			"PulsarAddress":  Params.PulsarAddress,
 			"ReceiveBufSize": 1024,
@@ -1003,6 +1010,39 @@ func (c *Core) Init() error {
 	return initError
 }
 
+// initBuiltinCredential creates the admin role and the root user when the cluster starts for the first time
+func (c *Core) initBuiltinCredential() error {
+	ts, err := c.TSOAllocator(1)
+	if err != nil {
+		return err
+	}
+	if !c.MetaTable.HasRole(credential.AdminRole) {
+		if err = c.MetaTable.AddRole(credential.AdminRole, ts); err != nil {
+			return err
+		}
+		grant := &etcdpb.GrantInfo{
+			DbName:     credential.AnyWord,
+			ObjectName: credential.AnyWord,
+			Privilege:  credential.AnyWord,
+		}
+		if err = c.MetaTable.OperatePrivilege(credential.AdminRole, grant, false, ts); err != nil {
+			return err
+		}
+	}
+	if !c.MetaTable.HasCredential(credential.RootUser) {
+		encrypted, err := credential.EncryptPassword(credential.DefaultRootPassword)
+		if err != nil {
+			return err
+		}
+		return c.MetaTable.AddCredential(&etcdpb.CredentialInfo{
+			Username:          credential.RootUser,
+			EncryptedPassword: encrypted,
+			Roles:             []string{credential.AdminRole},
+		}, ts)
+	}
+	return nil
+}
+
 func (c *Core) reSendDdMsg(ctx context.Context) error {
 	flag, err := c.MetaTable.client.Load(DDMsgSendPrefix, 0)
 	if err != nil || flag == "true" {
@@ -1026,7 +1066,7 @@ func (c *Core) reSendDdMsg(ctx context.Context) error {
 		if err = proto.UnmarshalText(ddOp.Body, &ddReq); err != nil {
 			return err
 		}
-		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0)
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
 		if err != nil {
 			return err
 		}
@@ -1038,7 +1078,7 @@ func (c *Core) reSendDdMsg(ctx context.Context) error {
 		if err = proto.UnmarshalText(ddOp.Body, &ddReq); err != nil {
 			return err
 		}
-		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0)
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
 		if err != nil {
 			return err
 		}
@@ -1062,7 +1102,7 @@ func (c *Core) reSendDdMsg(ctx context.Context) error {
 		if err = proto.UnmarshalText(ddOp.Body, &ddReq); err != nil {
 			return err
 		}
-		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0)
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
 		if err != nil {
 			return err
 		}
@@ -1085,7 +1125,7 @@ func (c *Core) reSendDdMsg(ctx context.Context) error {
 		if err = proto.UnmarshalText(ddOp.Body, &ddReq); err != nil {
 			return err
 		}
-		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0)
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
 		if err != nil {
 			return err
 		}
@@ -1388,6 +1428,402 @@ func (c *Core) ShowCollections(ctx context.Context, in *milvuspb.ShowCollections
 	return t.Rsp, nil
 }
 
+func (c *Core) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("CreateDatabase", zap.String("dbname", in.DbName), zap.Int64("msgID", in.Base.MsgID))
+	t := &CreateDatabaseReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("CreateDatabase failed", zap.String("dbname", in.DbName), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "create database failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("CreateDatabase Success", zap.String("dbname", in.DbName), zap.Int64("msgID", in.Base.MsgID))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("DropDatabase", zap.String("dbname", in.DbName), zap.Int64("msgID", in.Base.MsgID))
+	t := &DropDatabaseReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("DropDatabase failed", zap.String("dbname", in.DbName), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "drop database failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("DropDatabase Success", zap.String("dbname", in.DbName), zap.Int64("msgID", in.Base.MsgID))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	log.Debug("ListDatabases", zap.Int64("msgID", in.Base.MsgID))
+	t := &ListDatabasesReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+		Rsp: &milvuspb.ListDatabasesResponse{},
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("ListDatabases failed", zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "ListDatabases failed: " + err.Error(),
+			},
+		}, nil
+	}
+	log.Debug("ListDatabases Success", zap.Strings("database names", t.Rsp.DbNames), zap.Int64("msgID", in.Base.MsgID))
+	t.Rsp.Status = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}
+	return t.Rsp, nil
+}
+
+func (c *Core) CreateCredential(ctx context.Context, in *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("CreateCredential", zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID))
+	t := &CreateCredentialReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("CreateCredential failed", zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "create credential failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("CreateCredential Success", zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("DeleteCredential", zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID))
+	t := &DeleteCredentialReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("DeleteCredential failed", zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "delete credential failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("DeleteCredential Success", zap.String("username", in.Username), zap.Int64("msgID", in.Base.MsgID))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) ListCredUsers(ctx context.Context, in *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.ListCredUsersResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	log.Debug("ListCredUsers", zap.Int64("msgID", in.Base.MsgID))
+	t := &ListCredUsersReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+		Rsp: &milvuspb.ListCredUsersResponse{},
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("ListCredUsers failed", zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &milvuspb.ListCredUsersResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "ListCredUsers failed: " + err.Error(),
+			},
+		}, nil
+	}
+	log.Debug("ListCredUsers Success", zap.Int64("msgID", in.Base.MsgID))
+	t.Rsp.Status = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}
+	return t.Rsp, nil
+}
+
+func (c *Core) CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("CreateRole", zap.String("role", in.RoleName), zap.Int64("msgID", in.Base.MsgID))
+	t := &CreateRoleReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("CreateRole failed", zap.String("role", in.RoleName), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "create role failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("CreateRole Success", zap.String("role", in.RoleName), zap.Int64("msgID", in.Base.MsgID))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("DropRole", zap.String("role", in.RoleName), zap.Int64("msgID", in.Base.MsgID))
+	t := &DropRoleReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("DropRole failed", zap.String("role", in.RoleName), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "drop role failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("DropRole Success", zap.String("role", in.RoleName), zap.Int64("msgID", in.Base.MsgID))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) OperateUserRole(ctx context.Context, in *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("OperateUserRole", zap.String("username", in.Username), zap.String("role", in.RoleName), zap.String("type", in.Type.String()), zap.Int64("msgID", in.Base.MsgID))
+	t := &OperateUserRoleReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("OperateUserRole failed", zap.String("username", in.Username), zap.String("role", in.RoleName), zap.String("type", in.Type.String()), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "operate user role failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("OperateUserRole Success", zap.String("username", in.Username), zap.String("role", in.RoleName), zap.String("type", in.Type.String()), zap.Int64("msgID", in.Base.MsgID))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	log.Debug("OperatePrivilege", zap.Any("entity", in.Entity), zap.String("type", in.Type.String()), zap.Int64("msgID", in.Base.MsgID))
+	t := &OperatePrivilegeReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("OperatePrivilege failed", zap.Any("entity", in.Entity), zap.String("type", in.Type.String()), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "operate privilege failed: " + err.Error(),
+		}, nil
+	}
+	log.Debug("OperatePrivilege Success", zap.Any("entity", in.Entity), zap.String("type", in.Type.String()), zap.Int64("msgID", in.Base.MsgID))
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (c *Core) SelectGrant(ctx context.Context, in *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.SelectGrantResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	log.Debug("SelectGrant", zap.Int64("msgID", in.Base.MsgID))
+	t := &SelectGrantReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+		Rsp: &milvuspb.SelectGrantResponse{},
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("SelectGrant failed", zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &milvuspb.SelectGrantResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "SelectGrant failed: " + err.Error(),
+			},
+		}, nil
+	}
+	log.Debug("SelectGrant Success", zap.Int64("msgID", in.Base.MsgID))
+	t.Rsp.Status = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}
+	return t.Rsp, nil
+}
+
+func (c *Core) ListPolicy(ctx context.Context, in *rootcoordpb.ListPolicyRequest) (*rootcoordpb.ListPolicyResponse, error) {
+	code := c.stateCode.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &rootcoordpb.ListPolicyResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	log.Debug("ListPolicy", zap.Int64("msgID", in.Base.MsgID))
+	t := &ListPolicyReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+		Rsp: &rootcoordpb.ListPolicyResponse{},
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Debug("ListPolicy failed", zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &rootcoordpb.ListPolicyResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "ListPolicy failed: " + err.Error(),
+			},
+		}, nil
+	}
+	log.Debug("ListPolicy Success", zap.Int64("msgID", in.Base.MsgID))
+	t.Rsp.Status = &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}
+	return t.Rsp, nil
+}
+
 func (c *Core) CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	metrics.RootCoordCreatePartitionCounter.WithLabelValues(metricProxy(in.Base.SourceID), MetricRequestsTotal).Inc()
 	code := c.stateCode.Load().(internalpb.StateCode)
@@ -1901,6 +2337,7 @@ func (c *Core) SegmentFlushCompleted(ctx context.Context, in *datapb.SegmentFlus
 		log.Debug("no index params on collection", zap.String("collection_name", coll.Schema.Name))
 	}
 
+	buildIDs := make([]typeutil.UniqueID, 0, len(coll.FieldIndexes))
 	for _, f := range coll.FieldIndexes {
 		fieldSch, err := GetFieldSchemaByID(coll, f.FiledID)
 		if err != nil {
@@ -1934,6 +2371,15 @@ func (c *Core) SegmentFlushCompleted(ctx context.Context, in *datapb.SegmentFlus
 		if err != nil {
 			log.Error("AddIndex fail", zap.String("err", err.Error()))
 		}
+		buildIDs = append(buildIDs, info.BuildID)
+	}
+
+	if err = c.saveHandoffEvent(in.Segment, buildIDs); err != nil {
+		log.Warn("save handoff event failed", zap.Int64("segmentID", segID), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("save handoff event error = %v", err),
+		}, nil
 	}
 
 	return &commonpb.Status{
@@ -1942,6 +2388,26 @@ func (c *Core) SegmentFlushCompleted(ctx context.Context, in *datapb.SegmentFlus
 	}, nil
 }
 
+// saveHandoffEvent tells the query coord the segment is flushed, the query coord hands off the segment
+// once the index builds are done and removes the event
+func (c *Core) saveHandoffEvent(segment *datapb.SegmentInfo, buildIDs []typeutil.UniqueID) error {
+	key := path.Join(Params.MetaRootPath, handoffSegmentPrefix, strconv.FormatInt(segment.CollectionID, 10),
+		strconv.FormatInt(segment.PartitionID, 10), strconv.FormatInt(segment.ID, 10))
+	value := proto.MarshalTextString(&querypb.SegmentInfo{
+		SegmentID:     segment.ID,
+		CollectionID:  segment.CollectionID,
+		PartitionID:   segment.PartitionID,
+		ChannelID:     segment.InsertChannel,
+		NumRows:       segment.NumOfRows,
+		SegmentState:  querypb.SegmentState_sealed,
+		IndexBuildIDs: buildIDs,
+	})
+	ctx, cancel := context.WithTimeout(c.ctx, RequestTimeout)
+	defer cancel()
+	_, err := c.etcdCli.Put(ctx, key, value)
+	return err
+}
+
 func (c *Core) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	log.Debug("RootCoord.GetMetrics",
 		zap.Int64("node_id", c.session.ServerID),
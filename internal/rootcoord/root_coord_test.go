@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"math/rand"
 	"path"
+	"strconv"
 	"sync"
 	"testing"
 	"time"
@@ -41,6 +42,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/credential"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -48,8 +50,9 @@ import (
 
 type proxyMock struct {
 	types.Proxy
-	collArray []string
-	mutex     sync.Mutex
+	collArray    []string
+	refreshCount int
+	mutex        sync.Mutex
 }
 
 func (p *proxyMock) InvalidateCollectionMetaCache(ctx context.Context, request *proxypb.InvalidateCollMetaCacheRequest) (*commonpb.Status, error) {
@@ -60,6 +63,21 @@ func (p *proxyMock) InvalidateCollectionMetaCache(ctx context.Context, request *
 		ErrorCode: commonpb.ErrorCode_Success,
 	}, nil
 }
+func (p *proxyMock) RefreshPolicyInfoCache(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.refreshCount++
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+func (p *proxyMock) GetRefreshCount() int {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.refreshCount
+}
+
 func (p *proxyMock) GetCollArray() []string {
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
@@ -373,6 +391,157 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, ttm2.Base.Timestamp, ttm.Base.Timestamp+1)
 	})
 
+	t.Run("create database", func(t *testing.T) {
+		status, err := core.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_CreateDatabase,
+			},
+			DbName: dbName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_CreateDatabase,
+			},
+			DbName: dbName,
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		rsp, err := core.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_ListDatabases,
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+		assert.Equal(t, []string{Params.DefaultDatabaseName, dbName}, rsp.DbNames)
+	})
+
+	t.Run("credential and rbac", func(t *testing.T) {
+		rsp, err := core.ListCredUsers(ctx, &milvuspb.ListCredUsersRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_ListCredUsernames,
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+		assert.Equal(t, []string{credential.RootUser}, rsp.Usernames)
+
+		refreshCount := pnm.GetRefreshCount()
+		status, err := core.CreateCredential(ctx, &milvuspb.CreateCredentialRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_CreateCredential,
+			},
+			Username: "user1",
+			Password: "password1",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+		assert.Equal(t, refreshCount+1, pnm.GetRefreshCount())
+
+		status, err = core.CreateRole(ctx, &milvuspb.CreateRoleRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_CreateRole,
+			},
+			RoleName: "role1",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.OperateUserRole(ctx, &milvuspb.OperateUserRoleRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_OperateUserRole,
+			},
+			Username: "user1",
+			RoleName: "role1",
+			Type:     milvuspb.OperateUserRoleType_AddUserToRole,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		entity := &milvuspb.GrantEntity{
+			RoleName:   "role1",
+			ObjectName: collName,
+			Privilege:  commonpb.MsgType_Search.String(),
+		}
+		status, err = core.OperatePrivilege(ctx, &milvuspb.OperatePrivilegeRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_OperatePrivilege,
+			},
+			Entity: entity,
+			Type:   milvuspb.OperatePrivilegeType_Grant,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.OperatePrivilege(ctx, &milvuspb.OperatePrivilegeRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_OperatePrivilege,
+			},
+			Entity: &milvuspb.GrantEntity{RoleName: "role1", ObjectName: collName, Privilege: "Unknown"},
+			Type:   milvuspb.OperatePrivilegeType_Grant,
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		grantRsp, err := core.SelectGrant(ctx, &milvuspb.SelectGrantRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_SelectGrant,
+			},
+			RoleName: "role1",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, grantRsp.Status.ErrorCode)
+		assert.Equal(t, 1, len(grantRsp.Entities))
+		assert.Equal(t, Params.DefaultDatabaseName, grantRsp.Entities[0].DbName)
+		assert.Equal(t, collName, grantRsp.Entities[0].ObjectName)
+
+		policyRsp, err := core.ListPolicy(ctx, &rootcoordpb.ListPolicyRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_ListPolicy,
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, policyRsp.Status.ErrorCode)
+		assert.Equal(t, 2, len(policyRsp.Users))
+		assert.Equal(t, credential.RootUser, policyRsp.Users[0].Username)
+		assert.Equal(t, "user1", policyRsp.Users[1].Username)
+		assert.True(t, credential.VerifyPassword("password1", policyRsp.Users[1].EncryptedPassword))
+		assert.Equal(t, []string{"role1"}, policyRsp.Users[1].Roles)
+		// admin role is granted everything
+		assert.Equal(t, 2, len(policyRsp.Grants))
+
+		status, err = core.DropRole(ctx, &milvuspb.DropRoleRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_DropRole,
+			},
+			RoleName: "role1",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.DeleteCredential(ctx, &milvuspb.DeleteCredentialRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_DeleteCredential,
+			},
+			Username: "user1",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.DeleteCredential(ctx, &milvuspb.DeleteCredentialRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_DeleteCredential,
+			},
+			Username: credential.RootUser,
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	})
+
 	t.Run("create collection", func(t *testing.T) {
 		schema := schemapb.CollectionSchema{
 			Name:   collName,
@@ -404,9 +573,10 @@ func TestRootCoord(t *testing.T) {
 				Timestamp: 100,
 				SourceID:  100,
 			},
-			DbName:         dbName,
-			CollectionName: collName,
-			Schema:         sbf,
+			DbName:           dbName,
+			CollectionName:   collName,
+			Schema:           sbf,
+			ConsistencyLevel: commonpb.ConsistencyLevel_Bounded,
 		}
 		status, err := core.CreateCollection(ctx, req)
 		assert.Nil(t, err)
@@ -423,7 +593,7 @@ func TestRootCoord(t *testing.T) {
 		assert.True(t, ok)
 		createMsg, ok := (msgPack.Msgs[0]).(*msgstream.CreateCollectionMsg)
 		assert.True(t, ok)
-		createMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		createMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, createMeta.ID, createMsg.CollectionID)
 		assert.Equal(t, 1, len(createMeta.PartitionIDs))
@@ -552,7 +722,7 @@ func TestRootCoord(t *testing.T) {
 	})
 
 	t.Run("describe collection", func(t *testing.T) {
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.DescribeCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -571,6 +741,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, collMeta.ID, rsp.CollectionID)
 		assert.Equal(t, 2, len(rsp.VirtualChannelNames))
 		assert.Equal(t, 2, len(rsp.PhysicalChannelNames))
+		assert.Equal(t, commonpb.ConsistencyLevel_Bounded, rsp.ConsistencyLevel)
 	})
 
 	t.Run("show collection", func(t *testing.T) {
@@ -607,7 +778,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Nil(t, err)
 		t.Log(status.Reason)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(collMeta.PartitionIDs))
 		partNameIdx1, err := core.MetaTable.GetPartitionNameByID(collMeta.ID, collMeta.PartitionIDs[1], 0)
@@ -661,7 +832,7 @@ func TestRootCoord(t *testing.T) {
 	})
 
 	t.Run("show partition", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.ShowPartitionsRequest{
 			Base: &commonpb.MsgBase{
@@ -682,7 +853,7 @@ func TestRootCoord(t *testing.T) {
 	})
 
 	t.Run("show segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		partID := coll.PartitionIDs[1]
 		dm.mu.Lock()
@@ -724,7 +895,7 @@ func TestRootCoord(t *testing.T) {
 				},
 			},
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 0, len(collMeta.FieldIndexes))
 
@@ -735,7 +906,7 @@ func TestRootCoord(t *testing.T) {
 		files := im.getFileArray()
 		assert.Equal(t, 3, len(files))
 		assert.ElementsMatch(t, files, []string{"file0-100", "file1-100", "file2-100"})
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.FieldIndexes))
 		idxMeta, err := core.MetaTable.GetIndexByID(collMeta.FieldIndexes[0].IndexID)
@@ -749,7 +920,7 @@ func TestRootCoord(t *testing.T) {
 	})
 
 	t.Run("describe segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 
 		req := &milvuspb.DescribeSegmentRequest{
@@ -809,7 +980,7 @@ func TestRootCoord(t *testing.T) {
 	})
 
 	t.Run("flush segment", func(t *testing.T) {
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		partID := coll.PartitionIDs[1]
 
@@ -827,6 +998,18 @@ func TestRootCoord(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, st.ErrorCode, commonpb.ErrorCode_Success)
 
+		// the query coord is told to hand off the segment once its index is built
+		handoffKey := path.Join(Params.MetaRootPath, handoffSegmentPrefix, strconv.FormatInt(coll.ID, 10),
+			strconv.FormatInt(partID, 10), strconv.FormatInt(segID, 10))
+		handoffResp, err := core.etcdCli.Get(ctx, handoffKey)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(handoffResp.Kvs))
+		handoffInfo := &querypb.SegmentInfo{}
+		err = proto.UnmarshalText(string(handoffResp.Kvs[0].Value), handoffInfo)
+		assert.Nil(t, err)
+		assert.Equal(t, segID, handoffInfo.SegmentID)
+		assert.Equal(t, 1, len(handoffInfo.IndexBuildIDs))
+
 		req := &milvuspb.DescribeIndexRequest{
 			Base: &commonpb.MsgBase{
 				MsgType:   commonpb.MsgType_DescribeIndex,
@@ -865,7 +1048,7 @@ func TestRootCoord(t *testing.T) {
 			},
 		}
 
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.FieldIndexes))
 		oldIdx := collMeta.FieldIndexes[0].IndexID
@@ -875,7 +1058,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
 		time.Sleep(100 * time.Millisecond)
 
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(collMeta.FieldIndexes))
 		assert.Equal(t, oldIdx, collMeta.FieldIndexes[0].IndexID)
@@ -903,7 +1086,7 @@ func TestRootCoord(t *testing.T) {
 			FieldName:      "vector",
 			IndexName:      Params.DefaultIndexName,
 		}
-		_, idx, err := core.MetaTable.GetIndexByName(collName, Params.DefaultIndexName)
+		_, idx, err := core.MetaTable.GetIndexByName("", collName, Params.DefaultIndexName)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(idx))
 
@@ -916,7 +1099,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, idx[0].IndexID, im.idxDropID[0])
 		im.mutex.Unlock()
 
-		_, idx, err = core.MetaTable.GetIndexByName(collName, Params.DefaultIndexName)
+		_, idx, err = core.MetaTable.GetIndexByName("", collName, Params.DefaultIndexName)
 		assert.Nil(t, err)
 		assert.Equal(t, 0, len(idx))
 	})
@@ -933,13 +1116,13 @@ func TestRootCoord(t *testing.T) {
 			CollectionName: collName,
 			PartitionName:  partName,
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		dropPartID := collMeta.PartitionIDs[1]
 		status, err := core.DropPartition(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.PartitionIDs))
 		partName, err := core.MetaTable.GetPartitionNameByID(collMeta.ID, collMeta.PartitionIDs[0], 0)
@@ -985,7 +1168,7 @@ func TestRootCoord(t *testing.T) {
 			DbName:         dbName,
 			CollectionName: collName,
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		status, err := core.DropCollection(ctx, req)
 		assert.Nil(t, err)
@@ -1806,6 +1989,17 @@ func TestRootCoord2(t *testing.T) {
 		assert.Greater(t, ttm.Base.Timestamp, typeutil.Timestamp(0))
 	})
 
+	t.Run("create database", func(t *testing.T) {
+		status, err := core.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_CreateDatabase,
+			},
+			DbName: dbName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	})
+
 	t.Run("create collection", func(t *testing.T) {
 		schema := schemapb.CollectionSchema{
 			Name: collName,
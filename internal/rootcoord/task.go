@@ -24,7 +24,9 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/credential"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -149,6 +151,8 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 		VirtualChannelNames:        vchanNames,
 		PhysicalChannelNames:       chanNames,
 		PartitionCreatedTimestamps: []uint64{0},
+		ConsistencyLevel:           t.Req.ConsistencyLevel,
+		DbName:                     getDBName(t.Req.DbName),
 	}
 
 	idxInfo := make([]*etcdpb.IndexInfo, 0, 16)
@@ -237,7 +241,7 @@ func (t *DropCollectionReqTask) Execute(ctx context.Context) error {
 		return fmt.Errorf("drop collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
 
-	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -336,7 +340,7 @@ func (t *HasCollectionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_HasCollection {
 		return fmt.Errorf("has collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	_, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, t.Req.TimeStamp)
+	_, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, t.Req.TimeStamp)
 	if err == nil {
 		t.HasCollection = true
 	} else {
@@ -363,7 +367,7 @@ func (t *DescribeCollectionReqTask) Execute(ctx context.Context) error {
 	var err error
 
 	if t.Req.CollectionName != "" {
-		collInfo, err = t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, t.Req.TimeStamp)
+		collInfo, err = t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, t.Req.TimeStamp)
 		if err != nil {
 			return err
 		}
@@ -390,6 +394,7 @@ func (t *DescribeCollectionReqTask) Execute(ctx context.Context) error {
 	t.Rsp.CreatedTimestamp = collInfo.CreateTime
 	createdPhysicalTime, _ := tsoutil.ParseHybridTs(collInfo.CreateTime)
 	t.Rsp.CreatedUtcTimestamp = createdPhysicalTime
+	t.Rsp.ConsistencyLevel = collInfo.ConsistencyLevel
 
 	return nil
 }
@@ -408,7 +413,7 @@ func (t *ShowCollectionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_ShowCollections {
 		return fmt.Errorf("show collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	coll, err := t.core.MetaTable.ListCollections(t.Req.TimeStamp)
+	coll, err := t.core.MetaTable.ListCollections(t.Req.DbName, t.Req.TimeStamp)
 	if err != nil {
 		return err
 	}
@@ -422,6 +427,353 @@ func (t *ShowCollectionReqTask) Execute(ctx context.Context) error {
 	return nil
 }
 
+type CreateDatabaseReqTask struct {
+	baseReqTask
+	Req *milvuspb.CreateDatabaseRequest
+}
+
+func (t *CreateDatabaseReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *CreateDatabaseReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_CreateDatabase {
+		return fmt.Errorf("create database, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	if t.Req.DbName == "" {
+		return fmt.Errorf("database name should not be empty")
+	}
+	dbID, _, err := t.core.IDAllocator(1)
+	if err != nil {
+		return fmt.Errorf("alloc database id error = %w", err)
+	}
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+
+	t.core.ddlLock.Lock()
+	defer t.core.ddlLock.Unlock()
+	return t.core.MetaTable.AddDatabase(&etcdpb.DatabaseInfo{
+		ID:         dbID,
+		Name:       t.Req.DbName,
+		CreateTime: ts,
+	}, ts)
+}
+
+type DropDatabaseReqTask struct {
+	baseReqTask
+	Req *milvuspb.DropDatabaseRequest
+}
+
+func (t *DropDatabaseReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *DropDatabaseReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_DropDatabase {
+		return fmt.Errorf("drop database, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+
+	t.core.ddlLock.Lock()
+	defer t.core.ddlLock.Unlock()
+	return t.core.MetaTable.DeleteDatabase(t.Req.DbName, ts)
+}
+
+type ListDatabasesReqTask struct {
+	baseReqTask
+	Req *milvuspb.ListDatabasesRequest
+	Rsp *milvuspb.ListDatabasesResponse
+}
+
+func (t *ListDatabasesReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *ListDatabasesReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_ListDatabases {
+		return fmt.Errorf("list databases, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	for _, db := range t.core.MetaTable.ListDatabases() {
+		t.Rsp.DbNames = append(t.Rsp.DbNames, db.Name)
+		t.Rsp.CreatedTimestamps = append(t.Rsp.CreatedTimestamps, db.CreateTime)
+	}
+	return nil
+}
+
+// refreshPolicyInfoCache tells all proxies to reload credentials and grants, error doesn't matter here
+func refreshPolicyInfoCache(ctx context.Context, core *Core, msgType commonpb.MsgType, ts typeutil.Timestamp) {
+	core.proxyClientManager.RefreshPolicyInfoCache(ctx, &proxypb.RefreshPolicyInfoCacheRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   msgType,
+			MsgID:     0, //TODO, msg id
+			Timestamp: ts,
+			SourceID:  core.session.ServerID,
+		},
+	})
+}
+
+type CreateCredentialReqTask struct {
+	baseReqTask
+	Req *milvuspb.CreateCredentialRequest
+}
+
+func (t *CreateCredentialReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *CreateCredentialReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_CreateCredential {
+		return fmt.Errorf("create credential, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	if t.Req.Username == "" || t.Req.Password == "" {
+		return fmt.Errorf("username and password should not be empty")
+	}
+	encrypted, err := credential.EncryptPassword(t.Req.Password)
+	if err != nil {
+		return fmt.Errorf("encrypt password fail, error = %w", err)
+	}
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	if err = t.core.MetaTable.AddCredential(&etcdpb.CredentialInfo{
+		Username:          t.Req.Username,
+		EncryptedPassword: encrypted,
+	}, ts); err != nil {
+		return err
+	}
+	refreshPolicyInfoCache(ctx, t.core, t.Type(), ts)
+	return nil
+}
+
+type DeleteCredentialReqTask struct {
+	baseReqTask
+	Req *milvuspb.DeleteCredentialRequest
+}
+
+func (t *DeleteCredentialReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *DeleteCredentialReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_DeleteCredential {
+		return fmt.Errorf("delete credential, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	if err = t.core.MetaTable.DeleteCredential(t.Req.Username, ts); err != nil {
+		return err
+	}
+	refreshPolicyInfoCache(ctx, t.core, t.Type(), ts)
+	return nil
+}
+
+type ListCredUsersReqTask struct {
+	baseReqTask
+	Req *milvuspb.ListCredUsersRequest
+	Rsp *milvuspb.ListCredUsersResponse
+}
+
+func (t *ListCredUsersReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *ListCredUsersReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_ListCredUsernames {
+		return fmt.Errorf("list credential users, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	for _, cred := range t.core.MetaTable.ListCredentials() {
+		t.Rsp.Usernames = append(t.Rsp.Usernames, cred.Username)
+	}
+	return nil
+}
+
+type CreateRoleReqTask struct {
+	baseReqTask
+	Req *milvuspb.CreateRoleRequest
+}
+
+func (t *CreateRoleReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *CreateRoleReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_CreateRole {
+		return fmt.Errorf("create role, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	if t.Req.RoleName == "" {
+		return fmt.Errorf("role name should not be empty")
+	}
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	return t.core.MetaTable.AddRole(t.Req.RoleName, ts)
+}
+
+type DropRoleReqTask struct {
+	baseReqTask
+	Req *milvuspb.DropRoleRequest
+}
+
+func (t *DropRoleReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *DropRoleReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_DropRole {
+		return fmt.Errorf("drop role, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	if err = t.core.MetaTable.DeleteRole(t.Req.RoleName, ts); err != nil {
+		return err
+	}
+	refreshPolicyInfoCache(ctx, t.core, t.Type(), ts)
+	return nil
+}
+
+type OperateUserRoleReqTask struct {
+	baseReqTask
+	Req *milvuspb.OperateUserRoleRequest
+}
+
+func (t *OperateUserRoleReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *OperateUserRoleReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_OperateUserRole {
+		return fmt.Errorf("operate user role, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	remove := t.Req.Type == milvuspb.OperateUserRoleType_RemoveUserFromRole
+	if err = t.core.MetaTable.OperateUserRole(t.Req.Username, t.Req.RoleName, remove, ts); err != nil {
+		return err
+	}
+	refreshPolicyInfoCache(ctx, t.core, t.Type(), ts)
+	return nil
+}
+
+type OperatePrivilegeReqTask struct {
+	baseReqTask
+	Req *milvuspb.OperatePrivilegeRequest
+}
+
+func (t *OperatePrivilegeReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *OperatePrivilegeReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_OperatePrivilege {
+		return fmt.Errorf("operate privilege, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	entity := t.Req.Entity
+	if entity == nil || entity.ObjectName == "" || entity.Privilege == "" {
+		return fmt.Errorf("object name and privilege should not be empty")
+	}
+	if _, ok := commonpb.MsgType_value[entity.Privilege]; !ok && entity.Privilege != credential.AnyWord {
+		return fmt.Errorf("unknown privilege %s", entity.Privilege)
+	}
+	dbName := entity.DbName
+	if dbName != credential.AnyWord {
+		dbName = getDBName(dbName)
+	}
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	grant := &etcdpb.GrantInfo{
+		DbName:     dbName,
+		ObjectName: entity.ObjectName,
+		Privilege:  entity.Privilege,
+	}
+	revoke := t.Req.Type == milvuspb.OperatePrivilegeType_Revoke
+	if err = t.core.MetaTable.OperatePrivilege(entity.RoleName, grant, revoke, ts); err != nil {
+		return err
+	}
+	refreshPolicyInfoCache(ctx, t.core, t.Type(), ts)
+	return nil
+}
+
+type SelectGrantReqTask struct {
+	baseReqTask
+	Req *milvuspb.SelectGrantRequest
+	Rsp *milvuspb.SelectGrantResponse
+}
+
+func (t *SelectGrantReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *SelectGrantReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_SelectGrant {
+		return fmt.Errorf("select grant, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	roles, err := t.core.MetaTable.ListRoles(t.Req.RoleName)
+	if err != nil {
+		return err
+	}
+	t.Rsp.Entities = grantEntities(roles)
+	return nil
+}
+
+type ListPolicyReqTask struct {
+	baseReqTask
+	Req *rootcoordpb.ListPolicyRequest
+	Rsp *rootcoordpb.ListPolicyResponse
+}
+
+func (t *ListPolicyReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+func (t *ListPolicyReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_ListPolicy {
+		return fmt.Errorf("list policy, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	for _, cred := range t.core.MetaTable.ListCredentials() {
+		t.Rsp.Users = append(t.Rsp.Users, &rootcoordpb.UserPolicy{
+			Username:          cred.Username,
+			EncryptedPassword: cred.EncryptedPassword,
+			Roles:             cred.Roles,
+		})
+	}
+	roles, err := t.core.MetaTable.ListRoles("")
+	if err != nil {
+		return err
+	}
+	t.Rsp.Grants = grantEntities(roles)
+	return nil
+}
+
+func grantEntities(roles []*etcdpb.RoleInfo) []*milvuspb.GrantEntity {
+	entities := make([]*milvuspb.GrantEntity, 0)
+	for _, role := range roles {
+		for _, grant := range role.Grants {
+			entities = append(entities, &milvuspb.GrantEntity{
+				RoleName:   role.Name,
+				DbName:     grant.DbName,
+				ObjectName: grant.ObjectName,
+				Privilege:  grant.Privilege,
+			})
+		}
+	}
+	return entities
+}
+
 type CreatePartitionReqTask struct {
 	baseReqTask
 	Req *milvuspb.CreatePartitionRequest
@@ -435,7 +787,7 @@ func (t *CreatePartitionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_CreatePartition {
 		return fmt.Errorf("create partition, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -527,7 +879,7 @@ func (t *DropPartitionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DropPartition {
 		return fmt.Errorf("drop partition, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	collInfo, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collInfo, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -626,7 +978,7 @@ func (t *HasPartitionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_HasPartition {
 		return fmt.Errorf("has partition, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	coll, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	coll, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -653,7 +1005,7 @@ func (t *ShowPartitionReqTask) Execute(ctx context.Context) error {
 	if t.Req.CollectionName == "" {
 		coll, err = t.core.MetaTable.GetCollectionByID(t.Req.CollectionID, 0)
 	} else {
-		coll, err = t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+		coll, err = t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	}
 	if err != nil {
 		return err
@@ -780,7 +1132,7 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 		IndexID:     indexID,
 		IndexParams: t.Req.ExtraParams,
 	}
-	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -794,7 +1146,7 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 		return err
 	}
 
-	segIDs, field, err := t.core.MetaTable.GetNotIndexedSegments(t.Req.CollectionName, t.Req.FieldName, idxInfo, flushedSegs, t.Req.Base.GetTimestamp())
+	segIDs, field, err := t.core.MetaTable.GetNotIndexedSegments(t.Req.DbName, t.Req.CollectionName, t.Req.FieldName, idxInfo, flushedSegs, t.Req.Base.GetTimestamp())
 	if err != nil {
 		log.Debug("RootCoord CreateIndexReqTask metaTable.GetNotIndexedSegments", zap.Error(err))
 		return err
@@ -842,7 +1194,7 @@ func (t *DescribeIndexReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DescribeIndex {
 		return fmt.Errorf("describe index, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	coll, idx, err := t.core.MetaTable.GetIndexByName(t.Req.CollectionName, t.Req.IndexName)
+	coll, idx, err := t.core.MetaTable.GetIndexByName(t.Req.DbName, t.Req.CollectionName, t.Req.IndexName)
 	if err != nil {
 		return err
 	}
@@ -876,7 +1228,7 @@ func (t *DropIndexReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DropIndex {
 		return fmt.Errorf("drop index, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	_, info, err := t.core.MetaTable.GetIndexByName(t.Req.CollectionName, t.Req.IndexName)
+	_, info, err := t.core.MetaTable.GetIndexByName(t.Req.DbName, t.Req.CollectionName, t.Req.IndexName)
 	if err != nil {
 		log.Warn("GetIndexByName failed,", zap.String("collection name", t.Req.CollectionName), zap.String("field name", t.Req.FieldName), zap.String("index name", t.Req.IndexName), zap.Error(err))
 		return err
@@ -892,6 +1244,6 @@ func (t *DropIndexReqTask) Execute(ctx context.Context) error {
 		return err
 	}
 	ts, _ := t.core.TSOAllocator(1)
-	_, _, err = t.core.MetaTable.DropIndex(t.Req.CollectionName, t.Req.FieldName, t.Req.IndexName, ts)
+	_, _, err = t.core.MetaTable.DropIndex(t.Req.DbName, t.Req.CollectionName, t.Req.FieldName, t.Req.IndexName, ts)
 	return err
 }
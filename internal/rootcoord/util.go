@@ -132,3 +132,14 @@ func ToPhysicalChannel(vchannel string) string {
 	}
 	return vchannel[:idx]
 }
+
+// removeString returns a copy of strs without str
+func removeString(strs []string, str string) []string {
+	ret := make([]string, 0, len(strs))
+	for _, s := range strs {
+		if s != str {
+			ret = append(ret, s)
+		}
+	}
+	return ret
+}
@@ -225,10 +225,7 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 
 		// stats fields
 		statsWriter := &StatsWriter{}
-		switch field.DataType {
-		case schemapb.DataType_Int64:
-			err = statsWriter.StatsInt64(singleData.(*Int64FieldData).Data)
-		}
+		err = statsWriter.StatsField(field, singleData)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -478,6 +475,125 @@ func (insertCodec *InsertCodec) Close() error {
 	return nil
 }
 
+// DeleteData holds the primary keys deleted from a segment and the timestamps they are deleted at
+type DeleteData struct {
+	Pks []int64
+	Tss []Timestamp
+}
+
+// Blob key example:
+// ${tenant}/delta_log/${collection_id}/${partition_id}/${segment_id}/${log_idx}
+// a delta log holds two delete events, the primary keys and then their timestamps
+type DeleteCodec struct {
+	readerCloseFunc []func() error
+}
+
+func NewDeleteCodec() *DeleteCodec {
+	return &DeleteCodec{}
+}
+
+func (deleteCodec *DeleteCodec) Serialize(collectionID, partitionID, segmentID UniqueID, data *DeleteData) (*Blob, error) {
+	if len(data.Pks) == 0 {
+		return nil, fmt.Errorf("delete data is empty")
+	}
+	if len(data.Pks) != len(data.Tss) {
+		return nil, fmt.Errorf("misaligned delete data, %d pks but %d timestamps", len(data.Pks), len(data.Tss))
+	}
+	startTs, endTs := data.Tss[0], data.Tss[0]
+	tss := make([]int64, 0, len(data.Tss))
+	for _, ts := range data.Tss {
+		if ts < startTs {
+			startTs = ts
+		}
+		if ts > endTs {
+			endTs = ts
+		}
+		tss = append(tss, int64(ts))
+	}
+
+	writer := NewDeleteBinlogWriter(schemapb.DataType_Int64, collectionID)
+	writer.PartitionID = partitionID
+	writer.SegmentID = segmentID
+	for _, payload := range [][]int64{data.Pks, tss} {
+		eventWriter, err := writer.NextDeleteEventWriter()
+		if err != nil {
+			return nil, err
+		}
+		if err = eventWriter.AddInt64ToPayload(payload); err != nil {
+			return nil, err
+		}
+		eventWriter.SetEventTimestamp(startTs, endTs)
+	}
+	writer.SetEventTimeStamp(startTs, endTs)
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	buffer, err := writer.GetBuffer()
+	if err != nil {
+		return nil, err
+	}
+	return &Blob{
+		Key:   strconv.FormatInt(segmentID, 10),
+		Value: buffer,
+	}, nil
+}
+
+func (deleteCodec *DeleteCodec) Deserialize(blobs []*Blob) (partitionID UniqueID, segmentID UniqueID, data *DeleteData, err error) {
+	if len(blobs) == 0 {
+		return InvalidUniqueID, InvalidUniqueID, nil, fmt.Errorf("blobs is empty")
+	}
+	readerClose := func(reader *BinlogReader) func() error {
+		return func() error { return reader.Close() }
+	}
+
+	var pID UniqueID
+	var sID UniqueID
+	result := &DeleteData{}
+	for _, blob := range blobs {
+		binlogReader, err := NewBinlogReader(blob.Value)
+		if err != nil {
+			return InvalidUniqueID, InvalidUniqueID, nil, err
+		}
+		deleteCodec.readerCloseFunc = append(deleteCodec.readerCloseFunc, readerClose(binlogReader))
+		pID, sID = binlogReader.PartitionID, binlogReader.SegmentID
+
+		var payloads [][]int64
+		for {
+			eventReader, err := binlogReader.NextEventReader()
+			if err != nil {
+				return InvalidUniqueID, InvalidUniqueID, nil, err
+			}
+			if eventReader == nil {
+				break
+			}
+			payload, err := eventReader.GetInt64FromPayload()
+			if err != nil {
+				return InvalidUniqueID, InvalidUniqueID, nil, err
+			}
+			payloads = append(payloads, payload)
+		}
+		if len(payloads) != 2 || len(payloads[0]) != len(payloads[1]) {
+			return InvalidUniqueID, InvalidUniqueID, nil, fmt.Errorf("invalid delta log %s", blob.Key)
+		}
+		result.Pks = append(result.Pks, payloads[0]...)
+		for _, ts := range payloads[1] {
+			result.Tss = append(result.Tss, Timestamp(ts))
+		}
+	}
+
+	return pID, sID, result, nil
+}
+
+func (deleteCodec *DeleteCodec) Close() error {
+	for _, closeFunc := range deleteCodec.readerCloseFunc {
+		err := closeFunc()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Blob key example:
 // ${tenant}/data_definition_log/${collection_id}/ts/${log_idx}
 // ${tenant}/data_definition_log/${collection_id}/ddl/${log_idx}
@@ -328,6 +328,31 @@ func TestDDCodec(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestDeleteCodec(t *testing.T) {
+	deleteCodec := NewDeleteCodec()
+	data := &DeleteData{
+		Pks: []int64{1, 2, 3},
+		Tss: []Timestamp{300, 100, 200},
+	}
+	blob, err := deleteCodec.Serialize(1, 2, 3, data)
+	assert.Nil(t, err)
+
+	partitionID, segmentID, resultData, err := deleteCodec.Deserialize([]*Blob{blob, blob})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), partitionID)
+	assert.Equal(t, int64(3), segmentID)
+	assert.Equal(t, []int64{1, 2, 3, 1, 2, 3}, resultData.Pks)
+	assert.Equal(t, []Timestamp{300, 100, 200, 300, 100, 200}, resultData.Tss)
+	assert.Nil(t, deleteCodec.Close())
+
+	_, err = deleteCodec.Serialize(1, 2, 3, &DeleteData{})
+	assert.NotNil(t, err)
+	_, err = deleteCodec.Serialize(1, 2, 3, &DeleteData{Pks: []int64{1}, Tss: []Timestamp{1, 2}})
+	assert.NotNil(t, err)
+	_, _, _, err = deleteCodec.Deserialize([]*Blob{})
+	assert.NotNil(t, err)
+}
+
 func TestIndexCodec(t *testing.T) {
 	indexCodec := NewIndexCodec()
 	blobs := []*Blob{
@@ -12,12 +12,142 @@
 package storage
 
 import (
+	"encoding/binary"
 	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/bits-and-blooms/bloom/v3"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+const (
+	// BloomFilterSize is the expected number of primary keys in a segment, used to size the pk bloom filter.
+	// All pk bloom filters share the same size and hash count, so filters of the same segment can be merged.
+	BloomFilterSize uint = 100000
+	// MaxBloomFalsePositive is the expected false positive rate of the pk bloom filter
+	MaxBloomFalsePositive float64 = 0.005
 )
 
 type Int64Stats struct {
-	Max int64 `json:"max"`
-	Min int64 `json:"min"`
+	Max int64              `json:"max"`
+	Min int64              `json:"min"`
+	BF  *bloom.BloomFilter `json:"bf,omitempty"`
+}
+
+// NewPrimaryKeyFilter creates an empty bloom filter for primary keys
+func NewPrimaryKeyFilter() *bloom.BloomFilter {
+	return bloom.NewWithEstimates(BloomFilterSize, MaxBloomFalsePositive)
+}
+
+// PrimaryKeyBytes encodes a primary key in the format stored in the pk bloom filter
+func PrimaryKeyBytes(pk int64) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, uint64(pk))
+	return buf
+}
+
+// FieldStats is the statistics of a scalar field in a binlog. The min/max of bool and integer fields
+// share the json keys of Int64Stats, so the stats of the primary key can be read as Int64Stats as well.
+// Fields are not nullable yet, the null count is kept for the format.
+type FieldStats struct {
+	DataType  schemapb.DataType  `json:"type"`
+	RowCount  int64              `json:"rowCount"`
+	NullCount int64              `json:"nullCount"`
+	Max       int64              `json:"max,omitempty"`
+	Min       int64              `json:"min,omitempty"`
+	FloatMax  float64            `json:"floatMax,omitempty"`
+	FloatMin  float64            `json:"floatMin,omitempty"`
+	StrMax    string             `json:"strMax,omitempty"`
+	StrMin    string             `json:"strMin,omitempty"`
+	BF        *bloom.BloomFilter `json:"bf,omitempty"`
+}
+
+func (stats *FieldStats) updateInt64(i int, v int64) {
+	if i == 0 || v > stats.Max {
+		stats.Max = v
+	}
+	if i == 0 || v < stats.Min {
+		stats.Min = v
+	}
+	stats.RowCount++
+}
+
+func (stats *FieldStats) updateFloat(i int, v float64) {
+	if i == 0 || v > stats.FloatMax {
+		stats.FloatMax = v
+	}
+	if i == 0 || v < stats.FloatMin {
+		stats.FloatMin = v
+	}
+	stats.RowCount++
+}
+
+func (stats *FieldStats) updateString(i int, v string) {
+	if i == 0 || v > stats.StrMax {
+		stats.StrMax = v
+	}
+	if i == 0 || v < stats.StrMin {
+		stats.StrMin = v
+	}
+	stats.RowCount++
+}
+
+// Merge merges the statistics of another binlog of the same field
+func (stats *FieldStats) Merge(other *FieldStats) error {
+	if stats.DataType != other.DataType {
+		return fmt.Errorf("can not merge stats of data type %s into %s", other.DataType.String(), stats.DataType.String())
+	}
+	if other.RowCount == 0 {
+		return nil
+	}
+	if stats.RowCount == 0 {
+		*stats = *other
+		if other.BF != nil {
+			stats.BF = other.BF.Copy()
+		}
+		return nil
+	}
+	if other.Max > stats.Max {
+		stats.Max = other.Max
+	}
+	if other.Min < stats.Min {
+		stats.Min = other.Min
+	}
+	if other.FloatMax > stats.FloatMax {
+		stats.FloatMax = other.FloatMax
+	}
+	if other.FloatMin < stats.FloatMin {
+		stats.FloatMin = other.FloatMin
+	}
+	if other.StrMax > stats.StrMax {
+		stats.StrMax = other.StrMax
+	}
+	if other.StrMin < stats.StrMin {
+		stats.StrMin = other.StrMin
+	}
+	stats.RowCount += other.RowCount
+	stats.NullCount += other.NullCount
+	if other.BF != nil {
+		if stats.BF == nil {
+			stats.BF = other.BF.Copy()
+			return nil
+		}
+		return stats.BF.Merge(other.BF)
+	}
+	return nil
+}
+
+func isFinite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
+
+func boolToInt64(v bool) int64 {
+	if v {
+		return 1
+	}
+	return 0
 }
 
 type StatsWriter struct {
@@ -47,6 +177,79 @@ func (sw *StatsWriter) StatsInt64(msgs []int64) error {
 	return nil
 }
 
+// StatsPrimaryKey writes the min/max value and the bloom filter of primary keys,
+// the primary keys are not required to be sorted
+func (sw *StatsWriter) StatsPrimaryKey(pks []int64) error {
+	field := &schemapb.FieldSchema{DataType: schemapb.DataType_Int64, IsPrimaryKey: true}
+	return sw.StatsField(field, &Int64FieldData{Data: pks})
+}
+
+// StatsField writes the statistics of a scalar field, the bloom filter is only built for the primary key.
+// Nothing is written for vector fields, empty data, or float data with NaN or Inf which has no range.
+func (sw *StatsWriter) StatsField(field *schemapb.FieldSchema, data FieldData) error {
+	stats := &FieldStats{DataType: field.DataType}
+	switch field.DataType {
+	case schemapb.DataType_Bool:
+		for i, v := range data.(*BoolFieldData).Data {
+			stats.updateInt64(i, boolToInt64(v))
+		}
+	case schemapb.DataType_Int8:
+		for i, v := range data.(*Int8FieldData).Data {
+			stats.updateInt64(i, int64(v))
+		}
+	case schemapb.DataType_Int16:
+		for i, v := range data.(*Int16FieldData).Data {
+			stats.updateInt64(i, int64(v))
+		}
+	case schemapb.DataType_Int32:
+		for i, v := range data.(*Int32FieldData).Data {
+			stats.updateInt64(i, int64(v))
+		}
+	case schemapb.DataType_Int64:
+		pks := data.(*Int64FieldData).Data
+		for i, v := range pks {
+			stats.updateInt64(i, v)
+		}
+		if field.IsPrimaryKey && len(pks) > 0 {
+			stats.BF = NewPrimaryKeyFilter()
+			for _, pk := range pks {
+				stats.BF.Add(PrimaryKeyBytes(pk))
+			}
+		}
+	case schemapb.DataType_Float:
+		for i, v := range data.(*FloatFieldData).Data {
+			if !isFinite(float64(v)) {
+				return nil
+			}
+			stats.updateFloat(i, float64(v))
+		}
+	case schemapb.DataType_Double:
+		for i, v := range data.(*DoubleFieldData).Data {
+			if !isFinite(v) {
+				return nil
+			}
+			stats.updateFloat(i, v)
+		}
+	case schemapb.DataType_String:
+		for i, v := range data.(*StringFieldData).Data {
+			stats.updateString(i, v)
+		}
+	default:
+		return nil
+	}
+	if stats.RowCount == 0 {
+		return nil
+	}
+
+	b, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+	sw.buffer = b
+
+	return nil
+}
+
 type StatsReader struct {
 	buffer []byte
 }
@@ -60,3 +263,21 @@ func (sr *StatsReader) GetInt64Stats() Int64Stats {
 	json.Unmarshal(sr.buffer, &stats)
 	return stats
 }
+
+// GetPrimaryKeyStats decodes the stats written by StatsPrimaryKey
+func (sr *StatsReader) GetPrimaryKeyStats() (*Int64Stats, error) {
+	stats := &Int64Stats{}
+	if err := json.Unmarshal(sr.buffer, stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// GetFieldStats decodes the stats written by StatsField
+func (sr *StatsReader) GetFieldStats() (*FieldStats, error) {
+	stats := &FieldStats{}
+	if err := json.Unmarshal(sr.buffer, stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
@@ -12,9 +12,12 @@
 package storage
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
 
 func TestStatsInt64(t *testing.T) {
@@ -35,3 +38,134 @@ func TestStatsInt64(t *testing.T) {
 	}
 	assert.Equal(t, stats, expectedStats)
 }
+
+func TestStatsPrimaryKey(t *testing.T) {
+	data := []int64{5, 3, 9, 1, 7}
+	sw := &StatsWriter{}
+	err := sw.StatsPrimaryKey(data)
+	assert.NoError(t, err)
+	b := sw.GetBuffer()
+
+	sr := &StatsReader{}
+	sr.SetBuffer(b)
+	stats, err := sr.GetPrimaryKeyStats()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9), stats.Max)
+	assert.Equal(t, int64(1), stats.Min)
+	assert.NotNil(t, stats.BF)
+	for _, pk := range data {
+		assert.True(t, stats.BF.Test(PrimaryKeyBytes(pk)))
+	}
+
+	// filters of the same segment can be merged
+	sw2 := &StatsWriter{}
+	err = sw2.StatsPrimaryKey([]int64{100})
+	assert.NoError(t, err)
+	sr.SetBuffer(sw2.GetBuffer())
+	stats2, err := sr.GetPrimaryKeyStats()
+	assert.NoError(t, err)
+	err = stats.BF.Merge(stats2.BF)
+	assert.NoError(t, err)
+	assert.True(t, stats.BF.Test(PrimaryKeyBytes(100)))
+
+	sr.SetBuffer([]byte("invalid"))
+	_, err = sr.GetPrimaryKeyStats()
+	assert.Error(t, err)
+}
+
+func TestStatsField(t *testing.T) {
+	cases := []struct {
+		field    *schemapb.FieldSchema
+		data     FieldData
+		expected *FieldStats
+	}{
+		{
+			&schemapb.FieldSchema{DataType: schemapb.DataType_Bool},
+			&BoolFieldData{Data: []bool{true, true}},
+			&FieldStats{DataType: schemapb.DataType_Bool, RowCount: 2, Max: 1, Min: 1},
+		},
+		{
+			&schemapb.FieldSchema{DataType: schemapb.DataType_Int8},
+			&Int8FieldData{Data: []int8{3, -1, 2}},
+			&FieldStats{DataType: schemapb.DataType_Int8, RowCount: 3, Max: 3, Min: -1},
+		},
+		{
+			&schemapb.FieldSchema{DataType: schemapb.DataType_Int32},
+			&Int32FieldData{Data: []int32{7}},
+			&FieldStats{DataType: schemapb.DataType_Int32, RowCount: 1, Max: 7, Min: 7},
+		},
+		{
+			&schemapb.FieldSchema{DataType: schemapb.DataType_Int64},
+			&Int64FieldData{Data: []int64{5, 100, -20}},
+			&FieldStats{DataType: schemapb.DataType_Int64, RowCount: 3, Max: 100, Min: -20},
+		},
+		{
+			&schemapb.FieldSchema{DataType: schemapb.DataType_Float},
+			&FloatFieldData{Data: []float32{1.5, -2.5}},
+			&FieldStats{DataType: schemapb.DataType_Float, RowCount: 2, FloatMax: 1.5, FloatMin: -2.5},
+		},
+		{
+			&schemapb.FieldSchema{DataType: schemapb.DataType_Double},
+			&DoubleFieldData{Data: []float64{0.25, 0.5}},
+			&FieldStats{DataType: schemapb.DataType_Double, RowCount: 2, FloatMax: 0.5, FloatMin: 0.25},
+		},
+		{
+			&schemapb.FieldSchema{DataType: schemapb.DataType_String},
+			&StringFieldData{Data: []string{"b", "c", "a"}},
+			&FieldStats{DataType: schemapb.DataType_String, RowCount: 3, StrMax: "c", StrMin: "a"},
+		},
+	}
+	for _, c := range cases {
+		sw := &StatsWriter{}
+		err := sw.StatsField(c.field, c.data)
+		assert.NoError(t, err)
+
+		sr := &StatsReader{}
+		sr.SetBuffer(sw.GetBuffer())
+		stats, err := sr.GetFieldStats()
+		assert.NoError(t, err)
+		assert.Equal(t, c.expected, stats)
+	}
+
+	// nothing is written for vector fields and empty data
+	sw := &StatsWriter{}
+	err := sw.StatsField(&schemapb.FieldSchema{DataType: schemapb.DataType_FloatVector}, &FloatVectorFieldData{Data: []float32{1, 2}, Dim: 2})
+	assert.NoError(t, err)
+	assert.Nil(t, sw.GetBuffer())
+	err = sw.StatsField(&schemapb.FieldSchema{DataType: schemapb.DataType_Int64}, &Int64FieldData{})
+	assert.NoError(t, err)
+	assert.Nil(t, sw.GetBuffer())
+	err = sw.StatsField(&schemapb.FieldSchema{DataType: schemapb.DataType_Double}, &DoubleFieldData{Data: []float64{1, math.NaN()}})
+	assert.NoError(t, err)
+	assert.Nil(t, sw.GetBuffer())
+}
+
+func TestFieldStats_Merge(t *testing.T) {
+	stats := &FieldStats{DataType: schemapb.DataType_Int64}
+	err := stats.Merge(&FieldStats{DataType: schemapb.DataType_Int64, RowCount: 2, Max: 10, Min: 5, BF: NewPrimaryKeyFilter()})
+	assert.NoError(t, err)
+	err = stats.Merge(&FieldStats{DataType: schemapb.DataType_Int64, RowCount: 3, Max: 8, Min: -1})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), stats.RowCount)
+	assert.Equal(t, int64(10), stats.Max)
+	assert.Equal(t, int64(-1), stats.Min)
+	assert.NotNil(t, stats.BF)
+
+	err = stats.Merge(&FieldStats{DataType: schemapb.DataType_Float, RowCount: 1})
+	assert.Error(t, err)
+
+	// the primary key stats can be read as Int64Stats
+	sw := &StatsWriter{}
+	err = sw.StatsPrimaryKey([]int64{3, 1, 2})
+	assert.NoError(t, err)
+	sr := &StatsReader{}
+	sr.SetBuffer(sw.GetBuffer())
+	stats, err = sr.GetFieldStats()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), stats.RowCount)
+	pkStats, err := sr.GetPrimaryKeyStats()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), pkStats.Max)
+	assert.Equal(t, int64(1), pkStats.Min)
+	assert.NotNil(t, pkStats.BF)
+}
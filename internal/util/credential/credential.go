@@ -0,0 +1,68 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package credential
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// RootUser is the built-in super user, it's allowed to do anything and can't be deleted
+	RootUser = "root"
+	// DefaultRootPassword is the password of RootUser when the cluster is created
+	DefaultRootPassword = "Milvus"
+	// AdminRole is the built-in role which has all privileges on all objects
+	AdminRole = "admin"
+
+	// AnyWord matches any database, object or privilege in a grant
+	AnyWord = "*"
+
+	// HeaderAuthorize is the grpc metadata key carrying base64("username:password")
+	HeaderAuthorize = "authorization"
+	separator       = ":"
+)
+
+// EncryptPassword returns the bcrypt hash of password
+func EncryptPassword(password string) (string, error) {
+	bytes, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
+}
+
+// VerifyPassword checks whether password matches the bcrypt hash encrypted
+func VerifyPassword(password, encrypted string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(encrypted), []byte(password)) == nil
+}
+
+// EncodeBasicAuth encodes username and password into the value of HeaderAuthorize
+func EncodeBasicAuth(username, password string) string {
+	return base64.StdEncoding.EncodeToString([]byte(username + separator + password))
+}
+
+// DecodeBasicAuth decodes the value of HeaderAuthorize into username and password
+func DecodeBasicAuth(value string) (string, string, error) {
+	raw, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid authorization value: %w", err)
+	}
+	parts := strings.SplitN(string(raw), separator, 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return "", "", fmt.Errorf("invalid authorization value, expect base64 encoded username:password")
+	}
+	return parts[0], parts[1], nil
+}
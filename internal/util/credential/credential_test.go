@@ -0,0 +1,44 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package credential
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPassword(t *testing.T) {
+	encrypted, err := EncryptPassword("123456")
+	assert.Nil(t, err)
+	assert.NotEqual(t, "123456", encrypted)
+	assert.True(t, VerifyPassword("123456", encrypted))
+	assert.False(t, VerifyPassword("1234567", encrypted))
+	assert.False(t, VerifyPassword("123456", "123456"))
+}
+
+func TestBasicAuth(t *testing.T) {
+	username, password, err := DecodeBasicAuth(EncodeBasicAuth("user", "pass:word"))
+	assert.Nil(t, err)
+	assert.Equal(t, "user", username)
+	assert.Equal(t, "pass:word", password)
+
+	_, _, err = DecodeBasicAuth("not base64!")
+	assert.NotNil(t, err)
+
+	_, _, err = DecodeBasicAuth(base64.StdEncoding.EncodeToString([]byte("user")))
+	assert.NotNil(t, err)
+
+	_, _, err = DecodeBasicAuth(EncodeBasicAuth("", "password"))
+	assert.NotNil(t, err)
+}
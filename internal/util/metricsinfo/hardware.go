@@ -0,0 +1,91 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package metricsinfo
+
+import (
+	"bufio"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	memInfoPath    = "/proc/meminfo"
+	procStatusPath = "/proc/self/status"
+)
+
+// the memory limit files of cgroup v2 and v1
+var cgroupMemoryLimitPaths = []string{
+	"/sys/fs/cgroup/memory.max",
+	"/sys/fs/cgroup/memory/memory.limit_in_bytes",
+}
+
+// GetMemoryCapacity returns the memory in bytes available to the process, the total memory of the host
+// capped by the cgroup limit of the container, 0 if it can't be detected
+func GetMemoryCapacity() uint64 {
+	capacity := readMemTotal(memInfoPath)
+	for _, path := range cgroupMemoryLimitPaths {
+		limit := readCgroupMemoryLimit(path)
+		if limit > 0 && (capacity == 0 || limit < capacity) {
+			capacity = limit
+		}
+	}
+	return capacity
+}
+
+// GetUsedMemory returns the resident memory in bytes of the process, 0 if it can't be detected
+func GetUsedMemory() uint64 {
+	return readKBField(procStatusPath, "VmRSS:")
+}
+
+// readMemTotal parses the MemTotal line of meminfo, which is in kB
+func readMemTotal(path string) uint64 {
+	return readKBField(path, "MemTotal:")
+}
+
+// readKBField parses the field of the proc files in the format of "Name:   1024 kB"
+func readKBField(path string, name string) uint64 {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || fields[0] != name {
+			continue
+		}
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return 0
+		}
+		return value * 1024
+	}
+	return 0
+}
+
+// readCgroupMemoryLimit returns 0 if the file doesn't exist or there is no limit
+func readCgroupMemoryLimit(path string) uint64 {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0
+	}
+	limit, err := strconv.ParseUint(strings.TrimSpace(string(content)), 10, 64)
+	if err != nil {
+		// "max" of cgroup v2 means no limit
+		return 0
+	}
+	return limit
+}
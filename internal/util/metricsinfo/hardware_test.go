@@ -0,0 +1,63 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package metricsinfo
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadMemTotal(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hardware")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	memInfo := path.Join(dir, "meminfo")
+	err = ioutil.WriteFile(memInfo, []byte("MemTotal:       16384 kB\nMemFree:        1024 kB\n"), 0644)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(16384*1024), readMemTotal(memInfo))
+
+	assert.Equal(t, uint64(0), readMemTotal(path.Join(dir, "not_exist")))
+}
+
+func TestReadKBField(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hardware")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	status := path.Join(dir, "status")
+	err = ioutil.WriteFile(status, []byte("Name:   milvus\nVmPeak:   4096 kB\nVmRSS:    2048 kB\n"), 0644)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2048*1024), readKBField(status, "VmRSS:"))
+	assert.Equal(t, uint64(0), readKBField(status, "VmSwap:"))
+}
+
+func TestReadCgroupMemoryLimit(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hardware")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	limitFile := path.Join(dir, "memory.max")
+	err = ioutil.WriteFile(limitFile, []byte("4096\n"), 0644)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(4096), readCgroupMemoryLimit(limitFile))
+
+	err = ioutil.WriteFile(limitFile, []byte("max\n"), 0644)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), readCgroupMemoryLimit(limitFile))
+
+	assert.Equal(t, uint64(0), readCgroupMemoryLimit(path.Join(dir, "not_exist")))
+}
@@ -38,9 +38,16 @@ type BaseComponentInfos struct {
 	// TODO(dragondriver): more required information
 }
 
+// HardwareMetrics records the hardware resources of a component
+type HardwareMetrics struct {
+	// Memory is the memory capacity in bytes, 0 if unknown
+	Memory uint64 `json:"memory"`
+}
+
 // QueryNodeInfos implements ComponentInfos
 type QueryNodeInfos struct {
 	BaseComponentInfos
+	HardwareInfos HardwareMetrics `json:"hardware_infos"`
 	// TODO(dragondriver): add more detail metrics
 }
 
@@ -41,6 +41,9 @@ func TestQueryNodeInfos_Codec(t *testing.T) {
 		BaseComponentInfos: BaseComponentInfos{
 			Name: ConstructComponentName(typeutil.QueryNodeRole, 1),
 		},
+		HardwareInfos: HardwareMetrics{
+			Memory: 1024,
+		},
 	}
 	s, err := MarshalComponentInfos(infos1)
 	assert.Equal(t, nil, err)
@@ -50,6 +53,7 @@ func TestQueryNodeInfos_Codec(t *testing.T) {
 	err = UnmarshalComponentInfos(s, &infos2)
 	assert.Equal(t, nil, err)
 	assert.Equal(t, infos1.Name, infos2.Name)
+	assert.Equal(t, infos1.HardwareInfos, infos2.HardwareInfos)
 }
 
 func TestQueryCoordInfos_Codec(t *testing.T) {
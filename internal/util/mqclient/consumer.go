@@ -80,6 +80,9 @@ type Consumer interface {
 	// Make sure that msg is received. Only used in pulsar
 	Ack(ConsumerMessage)
 
+	// Remove the subscription from the topic, the messages aren't retained for it anymore
+	Unsubscribe()
+
 	// Close consumer
 	Close()
 }
@@ -17,6 +17,7 @@ import (
 
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/milvus-io/milvus/internal/log"
+	"go.uber.org/zap"
 )
 
 type pulsarConsumer struct {
@@ -79,6 +80,12 @@ func (pc *pulsarConsumer) Ack(message ConsumerMessage) {
 	pc.c.Ack(pm.msg)
 }
 
+func (pc *pulsarConsumer) Unsubscribe() {
+	if err := pc.c.Unsubscribe(); err != nil {
+		log.Warn("failed to unsubscribe", zap.String("subscription", pc.c.Subscription()), zap.Error(err))
+	}
+}
+
 func (pc *pulsarConsumer) Close() {
 	pc.c.Close()
 	close(pc.closeCh)
@@ -52,5 +52,10 @@ func (rc *RmqConsumer) Seek(id MessageID) error {
 func (rc *RmqConsumer) Ack(message ConsumerMessage) {
 }
 
+// Unsubscribe destroys the consumer group of the subscription
+func (rc *RmqConsumer) Unsubscribe() {
+	rc.c.Close()
+}
+
 func (rc *RmqConsumer) Close() {
 }
@@ -29,22 +29,32 @@ const (
 	SessionNoneEvent SessionEventType = iota
 	SessionAddEvent
 	SessionDelEvent
+	SessionUpdateEvent
 )
 
 // Session is a struct to store service's session, including ServerID, ServerName,
 // Address.
 // Exclusive indicates that this server can only start one.
+// Stopping indicates that the server is draining its work before it quits.
+// ResourceGroup is the resource group a query node joins when it registers.
+// An exclusive session with active-standby enabled waits as a standby while another
+// server holds the session, and takes over once the session of the active one expires.
 type Session struct {
 	ctx        context.Context
 	ServerID   int64  `json:"ServerID,omitempty"`
 	ServerName string `json:"ServerName,omitempty"`
 	Address    string `json:"Address,omitempty"`
 	Exclusive  bool   `json:"Exclusive,omitempty"`
+	Stopping   bool   `json:"Stopping,omitempty"`
+
+	ResourceGroup string `json:"ResourceGroup,omitempty"`
 
 	etcdCli  *clientv3.Client
 	leaseID  clientv3.LeaseID
 	cancel   context.CancelFunc
 	metaRoot string
+
+	enableActiveStandBy bool
 }
 
 // NewSession is a helper to build Session object.
@@ -81,9 +91,16 @@ func NewSession(ctx context.Context, metaRoot string, etcdEndpoints []string) *S
 	return session
 }
 
+// SetEnableActiveStandBy makes an exclusive session wait as a standby in Init instead of
+// failing when another server holds the session. It must be called before Init.
+func (s *Session) SetEnableActiveStandBy(enable bool) {
+	s.enableActiveStandBy = enable
+}
+
 // Init will initialize base struct of the Session, including ServerName, ServerID,
 // Address, Exclusive. ServerID is obtained in getServerID.
 // Finally it will process keepAliveResponse to keep alive with etcd.
+// With active-standby enabled, Init blocks until this server becomes the active one.
 func (s *Session) Init(serverName, address string, exclusive bool) <-chan bool {
 	s.ServerName = serverName
 	s.Address = address
@@ -94,7 +111,12 @@ func (s *Session) Init(serverName, address string, exclusive bool) <-chan bool {
 		panic(err)
 	}
 	s.ServerID = serverID
-	ch, err := s.registerService()
+	var ch <-chan *clientv3.LeaseKeepAliveResponse
+	if s.Exclusive && s.enableActiveStandBy {
+		ch, err = s.registerActive()
+	} else {
+		ch, err = s.registerService(retry.Attempts(DefaultRetryTimes))
+	}
 	if err != nil {
 		panic(err)
 	}
@@ -167,7 +189,7 @@ func (s *Session) getServerIDWithKey(key string, retryTimes uint) (int64, error)
 // }
 // Exclusive means whether this service can exist two at the same time, if so,
 // it is false. Otherwise, set it to true.
-func (s *Session) registerService() (<-chan *clientv3.LeaseKeepAliveResponse, error) {
+func (s *Session) registerService(retryOptions ...retry.Option) (<-chan *clientv3.LeaseKeepAliveResponse, error) {
 	var ch <-chan *clientv3.LeaseKeepAliveResponse
 	log.Debug("Session Register Begin")
 	registerFn := func() error {
@@ -183,10 +205,7 @@ func (s *Session) registerService() (<-chan *clientv3.LeaseKeepAliveResponse, er
 			return err
 		}
 
-		key := s.ServerName
-		if !s.Exclusive {
-			key = key + "-" + strconv.FormatInt(s.ServerID, 10)
-		}
+		key := s.getSessionKey()
 		txnResp, err := s.etcdCli.Txn(s.ctx).If(
 			clientv3.Compare(
 				clientv3.Version(path.Join(s.metaRoot, DefaultServiceRoot, key)),
@@ -211,13 +230,109 @@ func (s *Session) registerService() (<-chan *clientv3.LeaseKeepAliveResponse, er
 		log.Debug("Session Register End", zap.Int64("ServerID", s.ServerID))
 		return nil
 	}
-	err := retry.Do(s.ctx, registerFn, retry.Attempts(DefaultRetryTimes), retry.Sleep(500*time.Millisecond))
+	retryOptions = append(retryOptions, retry.Sleep(500*time.Millisecond))
+	err := retry.Do(s.ctx, registerFn, retryOptions...)
 	if err != nil {
 		return nil, err
 	}
 	return ch, nil
 }
 
+// registerActive waits as a standby until the session key is free, then competes for it.
+// The server which fails to register goes back to waiting, so only one of the standbys takes over.
+func (s *Session) registerActive() (<-chan *clientv3.LeaseKeepAliveResponse, error) {
+	for {
+		if err := s.waitSessionKeyFree(); err != nil {
+			return nil, err
+		}
+		ch, err := s.registerService(retry.Attempts(1))
+		if err == nil {
+			log.Info("Session becomes active", zap.String("ServerName", s.ServerName), zap.Int64("ServerID", s.ServerID))
+			return ch, nil
+		}
+		log.Debug("Session register as active failed, keep standby", zap.String("ServerName", s.ServerName), zap.Error(err))
+	}
+}
+
+// waitSessionKeyFree blocks until the session key is deleted, by the active server quitting or its lease expiring
+func (s *Session) waitSessionKeyFree() error {
+	key := path.Join(s.metaRoot, DefaultServiceRoot, s.getSessionKey())
+	for {
+		resp, err := s.etcdCli.Get(s.ctx, key)
+		if err != nil {
+			return err
+		}
+		if resp.Count == 0 {
+			return nil
+		}
+		log.Info("Session is standby, waiting for the active one to quit",
+			zap.String("ServerName", s.ServerName), zap.Int64("ServerID", s.ServerID))
+		watchCtx, cancel := context.WithCancel(s.ctx)
+		watchCh := s.etcdCli.Watch(watchCtx, key, clientv3.WithRev(resp.Header.Revision+1), clientv3.WithFilterPut())
+		err = waitDeleteEvent(watchCh)
+		cancel()
+		if err != nil {
+			return err
+		}
+		if err = s.ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+func waitDeleteEvent(watchCh clientv3.WatchChan) error {
+	for wresp := range watchCh {
+		if err := wresp.Err(); err != nil {
+			return err
+		}
+		for _, ev := range wresp.Events {
+			if ev.Type == mvccpb.DELETE {
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Session) getSessionKey() string {
+	key := s.ServerName
+	if !s.Exclusive {
+		key = key + "-" + strconv.FormatInt(s.ServerID, 10)
+	}
+	return key
+}
+
+// GoingStop marks the session as stopping, the watchers receive a SessionUpdateEvent and move the work
+// of the server elsewhere before it quits
+func (s *Session) GoingStop() error {
+	if s.etcdCli == nil || s.leaseID == 0 {
+		return errors.New("session is not registered")
+	}
+	s.Stopping = true
+	sessionJSON, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	_, err = s.etcdCli.Put(s.ctx, path.Join(s.metaRoot, DefaultServiceRoot, s.getSessionKey()), string(sessionJSON), clientv3.WithLease(s.leaseID))
+	if err != nil {
+		return err
+	}
+	log.Debug("Session is stopping", zap.String("ServerName", s.ServerName), zap.Int64("ServerID", s.ServerID))
+	return nil
+}
+
+// Revoke deletes the session at once by revoking its lease, instead of waiting for the lease to expire
+func (s *Session) Revoke(timeout time.Duration) {
+	if s.etcdCli == nil || s.leaseID == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if _, err := s.etcdCli.Revoke(ctx, s.leaseID); err != nil {
+		log.Warn("Session revoke failed", zap.Int64("ServerID", s.ServerID), zap.Error(err))
+	}
+}
+
 // processKeepAliveResponse processes the response of etcd keepAlive interface
 // If keepAlive fails for unexpected error, it will send a signal to the channel.
 func (s *Session) processKeepAliveResponse(ch <-chan *clientv3.LeaseKeepAliveResponse) (failChannel <-chan bool) {
@@ -272,6 +387,7 @@ func (s *Session) GetSessions(prefix string) (map[string]*Session, int64, error)
 // SessionEvent indicates the changes of other servers.
 // if a server is up, EventType is SessAddEvent.
 // if a server is down, EventType is SessDelEvent.
+// if a server is stopping, EventType is SessionUpdateEvent.
 // Session Saves the changed server's information.
 type SessionEvent struct {
 	EventType SessionEventType
@@ -286,6 +402,7 @@ type SessionEvent struct {
 // in GetSessions.
 // If a server up, a event will be add to channel with eventType SessionAddType.
 // If a server down, a event will be add to channel with eventType SessionDelType.
+// If a server is stopping, a event will be add to channel with eventType SessionUpdateEvent.
 func (s *Session) WatchServices(prefix string, revision int64) (eventChannel <-chan *SessionEvent) {
 	eventCh := make(chan *SessionEvent, 100)
 	rch := s.etcdCli.Watch(s.ctx, path.Join(s.metaRoot, DefaultServiceRoot, prefix), clientv3.WithPrefix(), clientv3.WithPrevKV(), clientv3.WithRev(revision))
@@ -311,6 +428,9 @@ func (s *Session) WatchServices(prefix string, revision int64) (eventChannel <-c
 							continue
 						}
 						eventType = SessionAddEvent
+						if ev.IsModify() {
+							eventType = SessionUpdateEvent
+						}
 					case mvccpb.DELETE:
 						log.Debug("watch services",
 							zap.Any("delete kv", ev.PrevKv))
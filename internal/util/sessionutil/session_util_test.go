@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"net"
+	"net/url"
 	"strconv"
 	"strings"
 	"sync"
@@ -13,6 +15,7 @@ import (
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/stretchr/testify/assert"
+	"go.etcd.io/etcd/server/v3/embed"
 )
 
 var Params paramtable.BaseTable
@@ -165,3 +168,123 @@ func TestUpdateSessions(t *testing.T) {
 	assert.Equal(t, addEventLen, 10)
 	assert.Equal(t, delEventLen, 10)
 }
+
+func TestSessionGoingStop(t *testing.T) {
+	ctx := context.Background()
+	Params.Init()
+
+	endpoints, err := Params.Load("_EtcdEndpoints")
+	if err != nil {
+		panic(err)
+	}
+	metaRoot := fmt.Sprintf("%d/%s", rand.Int(), DefaultServiceRoot)
+
+	etcdEndpoints := strings.Split(endpoints, ",")
+	etcdKV, err := etcdkv.NewEtcdKV(etcdEndpoints, metaRoot)
+	assert.NoError(t, err)
+
+	defer etcdKV.Close()
+	defer etcdKV.RemoveWithPrefix("")
+
+	watcher := NewSession(ctx, metaRoot, etcdEndpoints)
+	_, rev, err := watcher.GetSessions("stoptest")
+	assert.Nil(t, err)
+	eventCh := watcher.WatchServices("stoptest", rev)
+
+	s := NewSession(ctx, metaRoot, etcdEndpoints)
+	err = s.GoingStop()
+	assert.NotNil(t, err)
+
+	s.Init("stoptest", "testAddr", false)
+	err = s.GoingStop()
+	assert.Nil(t, err)
+	sessions, _, err := watcher.GetSessions("stoptest")
+	assert.Nil(t, err)
+	assert.True(t, sessions["stoptest-"+strconv.FormatInt(s.ServerID, 10)].Stopping)
+
+	s.Revoke(time.Second)
+	sessions, _, err = watcher.GetSessions("stoptest")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(sessions))
+
+	eventTypes := make([]SessionEventType, 0, 3)
+	for i := 0; i < 3; i++ {
+		event := <-eventCh
+		assert.Equal(t, s.ServerID, event.Session.ServerID)
+		eventTypes = append(eventTypes, event.EventType)
+	}
+	assert.Equal(t, []SessionEventType{SessionAddEvent, SessionUpdateEvent, SessionDelEvent}, eventTypes)
+}
+
+func getFreeURL(t *testing.T) url.URL {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer l.Close()
+	return url.URL{Scheme: "http", Host: l.Addr().String()}
+}
+
+func startEmbedEtcd(t *testing.T) (*embed.Etcd, []string) {
+	cfg := embed.NewConfig()
+	cfg.Dir = t.TempDir()
+	cfg.LogLevel = "error"
+	clientURL := getFreeURL(t)
+	peerURL := getFreeURL(t)
+	cfg.LCUrls, cfg.ACUrls = []url.URL{clientURL}, []url.URL{clientURL}
+	cfg.LPUrls, cfg.APUrls = []url.URL{peerURL}, []url.URL{peerURL}
+	cfg.InitialCluster = cfg.InitialClusterFromName(cfg.Name)
+
+	e, err := embed.StartEtcd(cfg)
+	assert.Nil(t, err)
+	select {
+	case <-e.Server.ReadyNotify():
+	case <-time.After(60 * time.Second):
+		e.Close()
+		t.Fatal("embedded etcd took too long to start")
+	}
+	return e, []string{clientURL.String()}
+}
+
+func TestSessionActiveStandBy(t *testing.T) {
+	e, etcdEndpoints := startEmbedEtcd(t)
+	defer e.Close()
+
+	ctx := context.Background()
+	metaRoot := fmt.Sprintf("%d/%s", rand.Int(), DefaultServiceRoot)
+
+	active := NewSession(ctx, metaRoot, etcdEndpoints)
+	active.SetEnableActiveStandBy(true)
+	active.Init("standbytest", "activeAddr", true)
+
+	activated := make(chan struct{})
+	standby := NewSession(ctx, metaRoot, etcdEndpoints)
+	standby.SetEnableActiveStandBy(true)
+	go func() {
+		standby.Init("standbytest", "standbyAddr", true)
+		close(activated)
+	}()
+
+	select {
+	case <-activated:
+		t.Fatal("standby becomes active while the active session is alive")
+	case <-time.After(time.Second):
+	}
+
+	// clients look up the address through the session, they reach the new active one after the takeover
+	watcher := NewSession(ctx, metaRoot, etcdEndpoints)
+	sessions, _, err := watcher.GetSessions("standbytest")
+	assert.Nil(t, err)
+	assert.Equal(t, "activeAddr", sessions["standbytest"].Address)
+
+	active.Revoke(time.Second)
+	select {
+	case <-activated:
+	case <-time.After(10 * time.Second):
+		t.Fatal("standby does not take over after the active session expires")
+	}
+	sessions, _, err = watcher.GetSessions("standbytest")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(sessions))
+	assert.Equal(t, "standbyAddr", sessions["standbytest"].Address)
+	assert.Equal(t, standby.ServerID, sessions["standbytest"].ServerID)
+	standby.Revoke(time.Second)
+}
@@ -0,0 +1,348 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package typeutil
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// GetRowCount returns the number of rows held by a column-based field data
+func GetRowCount(fieldData *schemapb.FieldData) int {
+	switch field := fieldData.GetField().(type) {
+	case *schemapb.FieldData_Scalars:
+		switch data := field.Scalars.GetData().(type) {
+		case *schemapb.ScalarField_BoolData:
+			return len(data.BoolData.GetData())
+		case *schemapb.ScalarField_IntData:
+			return len(data.IntData.GetData())
+		case *schemapb.ScalarField_LongData:
+			return len(data.LongData.GetData())
+		case *schemapb.ScalarField_FloatData:
+			return len(data.FloatData.GetData())
+		case *schemapb.ScalarField_DoubleData:
+			return len(data.DoubleData.GetData())
+		case *schemapb.ScalarField_StringData:
+			return len(data.StringData.GetData())
+		case *schemapb.ScalarField_BytesData:
+			return len(data.BytesData.GetData())
+		}
+	case *schemapb.FieldData_Vectors:
+		dim := int(field.Vectors.GetDim())
+		if dim == 0 {
+			return 0
+		}
+		switch data := field.Vectors.GetData().(type) {
+		case *schemapb.VectorField_FloatVector:
+			return len(data.FloatVector.GetData()) / dim
+		case *schemapb.VectorField_BinaryVector:
+			return len(data.BinaryVector) * 8 / dim
+		}
+	}
+	return 0
+}
+
+// AppendFieldData appends the idx-th row of every field in src to the field at the same position in dst,
+// dst[i] is created with the meta of src[i] if it is nil
+func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx int64) {
+	for i, fieldData := range src {
+		switch fieldType := fieldData.Field.(type) {
+		case *schemapb.FieldData_Scalars:
+			if dst[i] == nil || dst[i].GetScalars() == nil {
+				dst[i] = &schemapb.FieldData{
+					Type:      fieldData.Type,
+					FieldName: fieldData.FieldName,
+					FieldId:   fieldData.FieldId,
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{},
+					},
+				}
+			}
+			dstScalar := dst[i].GetScalars()
+			switch srcScalar := fieldType.Scalars.Data.(type) {
+			case *schemapb.ScalarField_BoolData:
+				if dstScalar.GetBoolData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{}}
+				}
+				dstScalar.GetBoolData().Data = append(dstScalar.GetBoolData().Data, srcScalar.BoolData.Data[idx])
+			case *schemapb.ScalarField_IntData:
+				if dstScalar.GetIntData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{}}
+				}
+				dstScalar.GetIntData().Data = append(dstScalar.GetIntData().Data, srcScalar.IntData.Data[idx])
+			case *schemapb.ScalarField_LongData:
+				if dstScalar.GetLongData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{}}
+				}
+				dstScalar.GetLongData().Data = append(dstScalar.GetLongData().Data, srcScalar.LongData.Data[idx])
+			case *schemapb.ScalarField_FloatData:
+				if dstScalar.GetFloatData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{}}
+				}
+				dstScalar.GetFloatData().Data = append(dstScalar.GetFloatData().Data, srcScalar.FloatData.Data[idx])
+			case *schemapb.ScalarField_DoubleData:
+				if dstScalar.GetDoubleData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{}}
+				}
+				dstScalar.GetDoubleData().Data = append(dstScalar.GetDoubleData().Data, srcScalar.DoubleData.Data[idx])
+			case *schemapb.ScalarField_StringData:
+				if dstScalar.GetStringData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{}}
+				}
+				dstScalar.GetStringData().Data = append(dstScalar.GetStringData().Data, srcScalar.StringData.Data[idx])
+			case *schemapb.ScalarField_BytesData:
+				if dstScalar.GetBytesData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_BytesData{BytesData: &schemapb.BytesArray{}}
+				}
+				dstScalar.GetBytesData().Data = append(dstScalar.GetBytesData().Data, srcScalar.BytesData.Data[idx])
+			}
+		case *schemapb.FieldData_Vectors:
+			dim := fieldType.Vectors.Dim
+			if dst[i] == nil || dst[i].GetVectors() == nil {
+				dst[i] = &schemapb.FieldData{
+					Type:      fieldData.Type,
+					FieldName: fieldData.FieldName,
+					FieldId:   fieldData.FieldId,
+					Field: &schemapb.FieldData_Vectors{
+						Vectors: &schemapb.VectorField{
+							Dim: dim,
+						},
+					},
+				}
+			}
+			dstVector := dst[i].GetVectors()
+			switch srcVector := fieldType.Vectors.Data.(type) {
+			case *schemapb.VectorField_BinaryVector:
+				if dstVector.GetBinaryVector() == nil {
+					dstVector.Data = &schemapb.VectorField_BinaryVector{BinaryVector: make([]byte, 0)}
+				}
+				rowBytes := dim / 8
+				dstBinary := dstVector.Data.(*schemapb.VectorField_BinaryVector)
+				dstBinary.BinaryVector = append(dstBinary.BinaryVector, srcVector.BinaryVector[idx*rowBytes:(idx+1)*rowBytes]...)
+			case *schemapb.VectorField_FloatVector:
+				if dstVector.GetFloatVector() == nil {
+					dstVector.Data = &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{}}
+				}
+				dstVector.GetFloatVector().Data = append(dstVector.GetFloatVector().Data, srcVector.FloatVector.Data[idx*dim:(idx+1)*dim]...)
+			}
+		}
+	}
+}
+
+// compareScalarRow compares the i-th row of lhs with the j-th row of rhs, both must hold the same scalar type
+func compareScalarRow(lhs *schemapb.ScalarField, i int, rhs *schemapb.ScalarField, j int) (int, error) {
+	switch lData := lhs.GetData().(type) {
+	case *schemapb.ScalarField_BoolData:
+		l, r := lData.BoolData.Data[i], rhs.GetBoolData().GetData()[j]
+		switch {
+		case l == r:
+			return 0, nil
+		case !l:
+			return -1, nil
+		default:
+			return 1, nil
+		}
+	case *schemapb.ScalarField_IntData:
+		return compareInt64(int64(lData.IntData.Data[i]), int64(rhs.GetIntData().GetData()[j])), nil
+	case *schemapb.ScalarField_LongData:
+		return compareInt64(lData.LongData.Data[i], rhs.GetLongData().GetData()[j]), nil
+	case *schemapb.ScalarField_FloatData:
+		return compareFloat64(float64(lData.FloatData.Data[i]), float64(rhs.GetFloatData().GetData()[j])), nil
+	case *schemapb.ScalarField_DoubleData:
+		return compareFloat64(lData.DoubleData.Data[i], rhs.GetDoubleData().GetData()[j]), nil
+	case *schemapb.ScalarField_StringData:
+		l, r := lData.StringData.Data[i], rhs.GetStringData().GetData()[j]
+		switch {
+		case l == r:
+			return 0, nil
+		case l < r:
+			return -1, nil
+		default:
+			return 1, nil
+		}
+	default:
+		return 0, fmt.Errorf("unsupported scalar type %T for ordering", lData)
+	}
+}
+
+func compareInt64(l, r int64) int {
+	switch {
+	case l == r:
+		return 0
+	case l < r:
+		return -1
+	default:
+		return 1
+	}
+}
+
+func compareFloat64(l, r float64) int {
+	switch {
+	case l == r:
+		return 0
+	case l < r:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// findFieldData returns the scalar column of fieldID in fieldsData
+func findFieldData(fieldsData []*schemapb.FieldData, fieldID int64) (*schemapb.ScalarField, error) {
+	for _, fieldData := range fieldsData {
+		if fieldData.GetFieldId() == fieldID {
+			if fieldData.GetScalars() == nil {
+				return nil, fmt.Errorf("field %d is not a scalar field, can not be ordered by", fieldID)
+			}
+			return fieldData.GetScalars(), nil
+		}
+	}
+	return nil, fmt.Errorf("order by field %d not found in fields data", fieldID)
+}
+
+// rowComparator compares rows across several column-based results by a list of order-by keys
+type rowComparator struct {
+	orderBy []*internalpb.OrderByField
+	// columns[k][r] is the column of the k-th key in the r-th result
+	columns [][]*schemapb.ScalarField
+}
+
+func newRowComparator(results [][]*schemapb.FieldData, orderBy []*internalpb.OrderByField) (*rowComparator, error) {
+	c := &rowComparator{
+		orderBy: orderBy,
+		columns: make([][]*schemapb.ScalarField, len(orderBy)),
+	}
+	for k, key := range orderBy {
+		c.columns[k] = make([]*schemapb.ScalarField, len(results))
+		for r, fieldsData := range results {
+			// empty result has no rows to compare
+			if len(fieldsData) == 0 {
+				continue
+			}
+			column, err := findFieldData(fieldsData, key.FieldID)
+			if err != nil {
+				return nil, err
+			}
+			c.columns[k][r] = column
+		}
+	}
+	return c, nil
+}
+
+// less reports whether the i-th row of result lr sorts before the j-th row of result rr
+func (c *rowComparator) less(lr, i, rr, j int) (bool, error) {
+	for k, key := range c.orderBy {
+		cmp, err := compareScalarRow(c.columns[k][lr], i, c.columns[k][rr], j)
+		if err != nil {
+			return false, err
+		}
+		if cmp == 0 {
+			continue
+		}
+		if key.Descending {
+			return cmp > 0, nil
+		}
+		return cmp < 0, nil
+	}
+	return false, nil
+}
+
+// SortFieldsData returns the row offsets of fieldsData sorted by orderBy. Rows with equal keys keep their
+// original order. Only the first limit offsets are returned if limit is positive.
+func SortFieldsData(fieldsData []*schemapb.FieldData, orderBy []*internalpb.OrderByField, limit int64) ([]int64, error) {
+	rowCount := 0
+	if len(fieldsData) > 0 {
+		rowCount = GetRowCount(fieldsData[0])
+	}
+	offsets := make([]int64, rowCount)
+	for i := range offsets {
+		offsets[i] = int64(i)
+	}
+
+	if len(orderBy) > 0 {
+		comparator, err := newRowComparator([][]*schemapb.FieldData{fieldsData}, orderBy)
+		if err != nil {
+			return nil, err
+		}
+		var sortErr error
+		sort.SliceStable(offsets, func(i, j int) bool {
+			less, err := comparator.less(0, int(offsets[i]), 0, int(offsets[j]))
+			if err != nil {
+				sortErr = err
+			}
+			return less
+		})
+		if sortErr != nil {
+			return nil, sortErr
+		}
+	}
+
+	if limit > 0 && int64(len(offsets)) > limit {
+		offsets = offsets[:limit]
+	}
+	return offsets, nil
+}
+
+// RowLocation locates a row inside a list of results
+type RowLocation struct {
+	ResultIdx int
+	RowOffset int64
+}
+
+// MergeSortedFieldsData does a k-way merge of results which are each already sorted by orderBy, and returns
+// the locations of merged rows in order. Only the first limit rows are returned if limit is positive.
+func MergeSortedFieldsData(results [][]*schemapb.FieldData, orderBy []*internalpb.OrderByField, limit int64) ([]RowLocation, error) {
+	comparator, err := newRowComparator(results, orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	rowCounts := make([]int64, len(results))
+	var total int64
+	for r, fieldsData := range results {
+		if len(fieldsData) > 0 {
+			rowCounts[r] = int64(GetRowCount(fieldsData[0]))
+		}
+		total += rowCounts[r]
+	}
+	if limit > 0 && total > limit {
+		total = limit
+	}
+
+	cursors := make([]int64, len(results))
+	locations := make([]RowLocation, 0, total)
+	for int64(len(locations)) < total {
+		choice := -1
+		for r := range results {
+			if cursors[r] >= rowCounts[r] {
+				continue
+			}
+			if choice == -1 {
+				choice = r
+				continue
+			}
+			less, err := comparator.less(r, int(cursors[r]), choice, int(cursors[choice]))
+			if err != nil {
+				return nil, err
+			}
+			if less {
+				choice = r
+			}
+		}
+		locations = append(locations, RowLocation{ResultIdx: choice, RowOffset: cursors[choice]})
+		cursors[choice]++
+	}
+	return locations, nil
+}
@@ -0,0 +1,142 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License.
+
+package typeutil
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func genLongFieldData(fieldID int64, data []int64) *schemapb.FieldData {
+	return &schemapb.FieldData{
+		Type:    schemapb.DataType_Int64,
+		FieldId: fieldID,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{
+					LongData: &schemapb.LongArray{Data: data},
+				},
+			},
+		},
+	}
+}
+
+func genDoubleFieldData(fieldID int64, data []float64) *schemapb.FieldData {
+	return &schemapb.FieldData{
+		Type:    schemapb.DataType_Double,
+		FieldId: fieldID,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_DoubleData{
+					DoubleData: &schemapb.DoubleArray{Data: data},
+				},
+			},
+		},
+	}
+}
+
+func genFloatVectorFieldData(fieldID int64, dim int64, data []float32) *schemapb.FieldData {
+	return &schemapb.FieldData{
+		Type:    schemapb.DataType_FloatVector,
+		FieldId: fieldID,
+		Field: &schemapb.FieldData_Vectors{
+			Vectors: &schemapb.VectorField{
+				Dim: dim,
+				Data: &schemapb.VectorField_FloatVector{
+					FloatVector: &schemapb.FloatArray{Data: data},
+				},
+			},
+		},
+	}
+}
+
+func TestGetRowCount(t *testing.T) {
+	assert.Equal(t, 3, GetRowCount(genLongFieldData(100, []int64{1, 2, 3})))
+	assert.Equal(t, 2, GetRowCount(genFloatVectorFieldData(101, 2, []float32{1, 2, 3, 4})))
+	assert.Equal(t, 0, GetRowCount(&schemapb.FieldData{}))
+}
+
+func TestAppendFieldData(t *testing.T) {
+	src := []*schemapb.FieldData{
+		genLongFieldData(100, []int64{1, 2, 3}),
+		genFloatVectorFieldData(101, 2, []float32{1, 1, 2, 2, 3, 3}),
+	}
+	dst := make([]*schemapb.FieldData, len(src))
+	AppendFieldData(dst, src, 2)
+	AppendFieldData(dst, src, 0)
+
+	assert.Equal(t, int64(100), dst[0].FieldId)
+	assert.Equal(t, []int64{3, 1}, dst[0].GetScalars().GetLongData().Data)
+	assert.Equal(t, int64(2), dst[1].GetVectors().Dim)
+	assert.Equal(t, []float32{3, 3, 1, 1}, dst[1].GetVectors().GetFloatVector().Data)
+}
+
+func TestSortFieldsData(t *testing.T) {
+	fieldsData := []*schemapb.FieldData{
+		genLongFieldData(100, []int64{10, 11, 12, 13}),
+		genDoubleFieldData(101, []float64{0.5, 0.1, 0.5, 0.9}),
+	}
+
+	offsets, err := SortFieldsData(fieldsData, []*internalpb.OrderByField{{FieldID: 101}}, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 0, 2, 3}, offsets)
+
+	offsets, err = SortFieldsData(fieldsData, []*internalpb.OrderByField{{FieldID: 101, Descending: true}, {FieldID: 100, Descending: true}}, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{3, 2, 0}, offsets)
+
+	offsets, err = SortFieldsData(fieldsData, nil, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{0, 1}, offsets)
+
+	_, err = SortFieldsData(fieldsData, []*internalpb.OrderByField{{FieldID: 102}}, 0)
+	assert.Error(t, err)
+}
+
+func TestMergeSortedFieldsData(t *testing.T) {
+	results := [][]*schemapb.FieldData{
+		{genLongFieldData(100, []int64{1, 4, 7})},
+		{genLongFieldData(100, []int64{2, 3, 9})},
+		{},
+	}
+
+	locations, err := MergeSortedFieldsData(results[:2], []*internalpb.OrderByField{{FieldID: 100}}, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, []RowLocation{
+		{ResultIdx: 0, RowOffset: 0},
+		{ResultIdx: 1, RowOffset: 0},
+		{ResultIdx: 1, RowOffset: 1},
+		{ResultIdx: 0, RowOffset: 1},
+	}, locations)
+
+	locations, err = MergeSortedFieldsData(results[:2], nil, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 6, len(locations))
+	assert.Equal(t, RowLocation{ResultIdx: 1, RowOffset: 2}, locations[5])
+
+	descResults := [][]*schemapb.FieldData{
+		{genLongFieldData(100, []int64{7, 4, 1})},
+		{},
+		{genLongFieldData(100, []int64{9, 3, 2})},
+	}
+	locations, err = MergeSortedFieldsData(descResults, []*internalpb.OrderByField{{FieldID: 100, Descending: true}}, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 6, len(locations))
+	assert.Equal(t, RowLocation{ResultIdx: 2, RowOffset: 0}, locations[0])
+	assert.Equal(t, RowLocation{ResultIdx: 0, RowOffset: 2}, locations[5])
+
+	_, err = MergeSortedFieldsData(results, []*internalpb.OrderByField{{FieldID: 101}}, 0)
+	assert.Error(t, err)
+}